@@ -19,207 +19,236 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9iXIbt7bgr2D6vSrbN1y02I6sqVf30ZJsMzElWYvznFBzA3aDJKJuoA2gRdF5qpp/",
-	"mD+cL5nC1o3uRnMTneTe8b1VsdjYDw4Ozo7fg5AmKSWICB4c/h7wcIoSqP4MKUP/uNsdIQF3/0FTRGCK",
-	"/9EbcRpnAp1DMZWVIsRDhlOBKQkOgwuUMsRlXwASAE1dMMYxAikU007QClJGU8QERmqQ1NvP1RQVrWUV",
-	"ICiAuh9KgJgiwOdcoKQDTqlAQEyhAJDMAbrHXGAy0VVnOI7BCAF6h9iMYSEQkTNA9zBJYxQcBt07yLox",
-	"nXRhmnZiOglagZinsoQLhskkeHjIv9DRbygUwUOrATAp/ogYV/OvLqd33jdlIEJjTBBXS7jT31AENNQB",
-	"HQMxxRwwC0YoO5CfIQF6/A64REw2BHxKszgCISV3iAnAUEgnBH/Je+MSZnKYGArEBcBEIEZgDO5gnKEW",
-	"gCQCCZwDhmS/ICNOD6oK74ABZQhgMqaHYCpEyg+73QkWndsD3sG0G9IkyQgW825IiWB4lAnKeDdCdyju",
-	"cjxpQxZOsUChyBjqwhS31WSJXBTvJNG/McRpxkLE1a6QLAkOfwkMYINWMI7xZCpCEcvBis/BTXWXWsF9",
-	"WzZv30FGYCIx65eg2JCPedPi2xvbd5/6ik+SVMzlQPftCW1XcKIRA9IrVdGHzbILvb8IwDSNcaj21l24",
-	"OogcBa3gcwajGIlADkQExASxoBVMUZwEreAuWRkAaj5Hebfmw4e897xGMYj59E6PZX59TIKbBau2i5H9",
-	"ICIkAGAcn42Dw19+D/6doXFwGPxbt6AzXYOgXW+Hb3CMbE8PrQ06uEAxFPhOkyjZA0OfM8xQJIGi6M1N",
-	"7VCvsrxjxGUvlwIKzyabUhDjMQrnYYwAlxXBmDJFC/17zjJC9AZyQdMURavvrW9aF3l3DRUu7SgrrPeE",
-	"3H2ETFPpEs1GRQGMIizrwvi8VKV2YsrAOiF3mFGSICLAHWQYjmIEbtG8rQgPSCFmvAUwkZuDIhBlshvA",
-	"MiJwgjpAHqhbNFckTLdAMJyCJONCkvsREjOECNhVFfZe7INwChkMBWKK0lT2fg0Sn8PmnDJRxwH5FSQw",
-	"TeVsMZF7n0ABhsGUciELD/PzLH8NA/AUdSadFhgGBzsHO4cHO8PgWfmGMt/lvQmFJODBYfC/hsPou0P5",
-	"n3+vX1grzJ3ROxwh9hpyDx4f0SShBBQ7rjE4jl0UVuSM129zSAjVl9ZjsKPHRlgwyOYgQQJGUEDgdNwB",
-	"1xxF+XUWz8ForsiquoRoDNIYEmQhW7pDZpTdxhRGiqA/A7MpIkAwSLjcKLlntSUCKG9WEiEGFOoFrYAh",
-	"GJ2ReB4cCpYhD+7A4h5Ym3bZO0TDpERuHkFSm4jYw01rDSqmWBMHQi0AOUgoV7wHIiKeA46E3Q1Jz7pc",
-	"QCZAhO5wiEDvvM874ALBqE1JPD8EodpVeWJluwgzFAq9nXKU+f8EshowrJA8T7JfvRsoKu0VT1EIIhTj",
-	"O1VkGB84QUTUt+yhFUg62sB1Or3KWjlR2f2///v/lEkJiCmZtIBe4wyLKYAgRvKQAsoAyZIRYprNMqce",
-	"EApmkiHiKQxRx3d2GVLdvUUEMSi8zOQRzSTqA0xChiQFRZGFuWntAFwTRom6Vc4DKD5T1UfRX2FbcmjI",
-	"kz1BTBFm9+q25+pmE3ptaN5likIFUixBmmACBWXyg6HaWu7RDFPDCTP8lNN5iU9rbGUqlNspnq6hieTB",
-	"yrUtX9jQwDB25TZ3jf1/LPX+kNPy+ak6HTm8H1oBJWhT2uMB10ZcnWdxG/Xj3YiNeqruz0adVDZhFb7s",
-	"wkhN73GCtdBeFcJ1OYhVBUO7a2JH+eYO08xDas6vdSfylMtp8Q54o3kaSToYVtzZCMr7mJLa7VnmZHY6",
-	"37/wkbwEJZTN64MP1HczviKvNNXMBJBC5yNmsvfiZfIoSb+2FYt2IaSECwYxWXUr4nxfH3PRV7Bko+VJ",
-	"LiHjfqFWlyndBOCYTOLy7WK0NPomciWec4ZSaASbS3n76D8LyeWEMSol0WtyS+hMkjBJQmIkUKSaaAHG",
-	"/CWbrC0x6am7E6kVOjOrlXmFLF1k514rKBZTK3JX55mHXa6/SK1/hZ285ojVBTmWkR7380EZRwp2Vmeh",
-	"1W3qc40RtKqoEZIiGsgkJyCFNMwlkyF5CdWD7A1qzZfqRp5pTJTaLr8+uUdHAp7isf09itGzDjhGY5jF",
-	"ItdwmVlBUfAXciZcDvd0ongpKScwSsUzgMdqSpI3wWOMojofVtb6XBtIuJ/b/BanbUuP2ilV0ohhLzc5",
-	"aR9pnCUVNr/KmWvFIVRsaQTuVAu5dMX+1VUM5a32c7zXBH/OEHA32u3X7JCHYnkY1zCGODmnMQ7nj6Vd",
-	"GhoXpS6r3KBakIcV/P0xzEo/gROkRy9xjBtd7QPJqG+rMzWzxh5vVmIcPC1rJEFvv4covMdcKGrvHExT",
-	"WSIEFijh29n3oDhAkDE4Dx5xoi6qaBlp0qEuaElyglbDOZvSmUNNppBEsTp95nxo3cEUATojVc2BkqwS",
-	"eqdpi734zHg3G2hs9Fo02V98E2+FKpzWyEHDkR8jhkiIfMyPKbIUOkJpTOcoAmdH/bbEjBhDKTxKrJbS",
-	"srxtxzAUYATDWwnPhWP7SIE7n00kRH6ZJQlk8xV5nrI+jDfzO+8QjMV0HrSCYzRhMFKXeZ3HOaXuXNbn",
-	"acrTLwZtrOLMprGOh50pV/CyNeUq1YU1bcURFDCmk75AyQUaLxDdK3JLqdlGBKg8siaoFQyrjLIyfnm6",
-	"blSLjmHMUU0VCph7lCAwMwGS3rYAjCmZaOFISnohJWM8yViTjKfb+hk+27HiBXJeSg4j6VkKmaT+XjoQ",
-	"TiEhKPaYPYkjsqURFAiYumA2xeE0t88qciUoSPVW55UzxDWhhXcQx5L5805ATtK/KJexcSEnoYVi/3Lu",
-	"Gq244A7GOLJmWw2kMGNM6z6V6ZlbfZg72nIKZnfGrKWYxOqYJrd5LI8MusQTeSVdoM8Z4h4rRWPVwu4s",
-	"LzpmPir1P+B4QlAEwqItGDOaqLUe9TyWgJI5fAOmIG/+0ApuMYnq6/gRk0jiJgQaqsYUlC/CXiMXJ5dX",
-	"wJqatfCigemstzCrJ3AOMBlbMSdfJCKRYvP17sZYcgU8GykNiwGVlEc64ChXn2o0jjqgT8ARTFB8BDn6",
-	"6kZ1ZbdoS5Dxjl/fom0qG+3LmQLcAAkou+KGoK1PcptQ0DDIAc95nS32rfusq5VzVDOY5sDIrPHxxzBX",
-	"PJdQ+CcG0xRJ9odmJAJQSbLtkCGJN+Do8qIFEhqhWGu1brMRYgQJxAGmCl9gijvOkeSdu93OwinUDyq6",
-	"T7G+MC5RSEnkVWWp9toQm7ukKGKIxTxXajkTkcNo46fW5+/vBXX1fitA94LBRYbCXKqoYXFZQKjZl2XH",
-	"AAp9flCuVSgUBRbGin5JOKc0zWLoGFN6533AFVGQsFf15cqhJDpJkonKdVQgBmsivPJGGkGOXj5vIxLS",
-	"CEXg/GRQ/P3j0eW/7e7I6XTAAIpwavyEJAp2cnKMURzJWwa6+LCIpmvCV9qS0VwgH21QVJ6deqWCPok0",
-	"khl7j8UJ3UYbuhQ1/pzBWGlW1PUrx00weY/IREyDw13PqBn2UPfr/vEfsGvOJDic+OTea/U9Vxep6wYp",
-	"SfgWzYFu5UDDXP+Y86x8XZaE5KXobNVvi4WzPwAwFWJpcbuEKlugjg2ibYFzMJXMIYy7ESIYxt0xxHHG",
-	"tHk6y8+3WrpjceUNmwHwuHAX9PgxOFX9x9h0WeeKWgU0AZVce74RKx1ASYFx7j9RNfzaMi16FhZVsysd",
-	"8KOUxkDoVGQI9BToUNQCx4hg+a+E0BuIYz2pzVU3+Yy8KpsSg1usa3VsqVsOt+YGUfKB2Ugh53GUemw/",
-	"DbrCx+ozm2TpzcynK4npm6k4SYxJ82xvHtbAHIuXj0OYvJ8cTVKPH9NjOtYG9sphEX4vh5qzTt5LQe4i",
-	"JCCOtaGREgSgvLdELgJrYdX49FhvY3kzXORcwlKY+t3G5NeC8EiJLFMSChjTOKYzKYv9WLArckhXbAHX",
-	"HBkXGrlbStURSX7YXLuh9p9FJEs89lLIxRWDhGuI4ibXHlmvcIUp5irytijS8p6EnLlY5EwIFVPESvRb",
-	"CnZt2ZdfwuKSLajP4l2WQAIYgpG6H0w9gPUtJ2Fk9w+OaCbMjPPpefkWOlK3erTIZUiuvmNlms4kr1no",
-	"eQpozCDXblzKlp6leliXmX/53MvMMwS5X23ydMQwGj8DukYhL9gxn/CVVvoYmdAO1SADmq5bPlzKV1Zs",
-	"7PqEaLnuvgSRlkJBOgZXLEMt8EapBoHRtrraZVketAJVwdEnr6Y+rszO9FX5aruufM5HWrr0Bp944w9f",
-	"IB52lSTOEi37ErSCq/PBR8SUbKFVzrZAMzYKEJKrqVcNQ8Q5HsWo+sMSvnPIuHYrmJNQ/fFRyreyhiRg",
-	"mejL62jCEJdocp1G0LgHyMvJVh1kscBpjM5mBDGu5nWHQ3SMQpokmHNMyeoOCyeE0ThOEBGGSXbWWysr",
-	"L7eRz3a6aKyTw7KxRg7kxhrl6VyglHIsKJt7QS8h3lhQ2x+3MN+rNzFCwu6C+uHbNb0bzt7pD+4O6i+r",
-	"7uMC3B/jSdVw8Qg+7i0Wnj434rKKW/gShQyJbfFt25rfOyFSX1+LgF136PvXFhSUN883acPFjSprnlLm",
-	"8410IzS257egokE8Whzmugduw5nP68e3OjeSZrajASWSIOcWyfyslKGY6GrLw9oK8woFptFyZZLbu9eT",
-	"Z4PQr/ryFlAORsnJfSpvB7+nPaMEoLyCDeeR/KucRZTFysSEE8Q7QyLBYWpgDn79GzD///UQtMEAk0wg",
-	"fgh+/duvIDG63Z32i1cd0AbvaMZqRXv7sugYziV4B5SIabnGbnt/V9bwFu3uOY1/Qui22vvLzpBcZqk8",
-	"JSgCcsuhoHISbVnxMFc/QzI3ZjUTTyO7wQRM5ZTz/tAdYnP17Zkc99f2r4fgApJJ0WqnffCrAtzuHugN",
-	"JJYcgN5A1279egiUd5GtvNva3TO1uVDKqt09MQWJgqFu0/31EFwKlBbT6to2ejLVFpfaRbW8loMCJJL9",
-	"PnCaDMmJ9heWkAM77YPW7sv23r7Z0s7KcVdHGRc00fxDn4zpImtHVW5SxiAdMByBUHVk47LMrnjnUdVf",
-	"O51gojFUaX6ViFl2WlmNjhyjFJEIkXAuGTB9WV+gcSHjrOHgsLAv1yBdCjIZYzJBLGWYFPZptb/aA8I6",
-	"ErAnHKB7E/Yc5SN59L0lhuO0MTqo5E9QHkojlSqZYAEoA++urs5tLUMdZftn3k1zVuQf2l2ymUEOjlCH",
-	"y5opyOEVoyrA5bteC/Ap3HvxUjZSMxrRaN4CPx5IMV8ygLnux1hB/fOTgvG1tmf3xCr6FXe+4VQSgwg8",
-	"xR3Usf5gZjPyyc8gtxbzZ6vqWupa5uo23myG1FvAZT8K8zkJp4wS/EWfQgdMWmtXx1eMjHOfPqctEMJU",
-	"ZCrWqhac6EPrCzT2cUKItXV5O0dhd88k2VU7apxx5AgtpRnKXR9NmJmewiO5qcU0ZXOPUE15195IszDH",
-	"HSadzjkO1b7kLn42oqTs7tKUt0B7sZjOg7IjRgxHKFaQG0vpVWK9cVTNoxWD8cu9aDx6Pn4R7YXRaPRq",
-	"f//V/su90Yvx7sF4L0R7Lw+i71+8fP5qFIUHOzs7++MdtPN879Ue/B6ND8J9BbRvTjrfnHRWP5JWO/JI",
-	"7avpaAP3m5v1jnktRqbuL7ql4GyUjFAUIQ/C/zRFYoqYL9zWNrJOAiNKRah9oB0kGFEaI0iCxjDlihXB",
-	"5UmWR2nAaN7A2qh4ZaOStcE42k0TMqSGm4OVg0FUXLHn1jnNR7F1gDVgNAXHeSwNWwpbwhywTHnvm5Cl",
-	"/hiMYkhuW95g6YzY8CUVyqT6NB90kEA11GjrkUWPOoX+uL6HVnPIR2GcMFXyuIIqKLccAbLg1vf6/Uuk",
-	"drCuVdhz8nPaWj98vEZTyo7tPomN6woW+6bKDb8SNeOJFSiTKGzExIWn3pXktNHQ3qSKaSsFwH41s9ri",
-	"8IkGI9ua8D+CKRzhGOcEfHU2zm0q2Qit7DD+UIZ5zZMRlPeA8gGNNrslzlTTHAJrrVWxvU3odeRY7wub",
-	"oVmHZuXrC7EyYmNusgsrRFq39qZ+lzmGlce5WXvlnMbetEpOcVWYCs3nkBKCQmNFzM9FHRhcK/L6x/7L",
-	"wxSD/rFro66M4D9DuuXA4doqpCHH2nyUPLeNuVTlvI1b4X+U0iCFkLhBEphggWGMv2g5O08shliCCYxb",
-	"+ZwFtc1aAImwaQ/LOThKB7ayqpYDwDX31zWd+UL9bZofpZOyki6Iyga33M+ttrECsgkSj2BO3fldqc78",
-	"njl6nEcs3um8ft/mPqb6AOpUPFUgJEhMaVQ+pq69/JogZR1W1vBQUDa/QLw06UVW50UzdnpeVK086mLQ",
-	"9CVXx7CYH01ReNtE+Zrr1tQrJdqIbQsQyiYgRUyeMu1Nv+EV3PZewYUytTqmntG2b95miGzx6m3sfokb",
-	"yxpgL5DWBhJeE27tEq4/R+5OsA4a+xZQjLSojjuH5nr57JqrFPNeEdaNnkKGtWzC8Bo9SCu2BTVKhIjA",
-	"Yr5lnFNBeOtyrcWRURxrsZIl/KqsnUO1fo/jRIoBSWoBUun8TrUspJbVPPq2d1JN7g69mVYCE2my9R3Z",
-	"LgWoT3tlGtB4UTkeQvlB8tOBjc585fy1/OVNR3gJsajTiSXn+71NqLcRd5+n49uCtFi1+1Vy/X2tu6oC",
-	"gC1eU76em7DTTcPrg239PtKudgZFyv5f5S9r4mll1lVMqxSXZuEp901tSbXlOHvG6/4hC/I8QALOjvqe",
-	"nA7KsVtAj3Sq6i5JH7FSt41qtnUz9SyeSjmI3EjMjYH2XyEpwbKQgBOslM+O43wBuzyTQEuBcVFmgUqM",
-	"uxZAGeI0vtNCaGlTGBp3gqWotAndO7tcGWc+ltUXZ5c1xbqTMECWHONJY5RmpMqqfRn7uranH8KdTqfz",
-	"bLlmRM+3POiaFC73eV0Lhrn32TLe0OT+25CwlyeXZ6/F/HbrnRapArfYbdWHIM2CfCSzjkdt12LnOV7y",
-	"ntMbWM6HX+Ry+QkyQ9yPGBY4hLEnlcw6d1B5om6mmnppMbiv1JmQr9hO0le2NJLAsUM2aAYudZ5ZWTBS",
-	"EbpNmO6q4VdLcWV9I7boLFr2iq05jWqNa/PsKn5H/NEhnHW3bd+cOI1Rg9EttnALBb4rdKZGWfhoxxBX",
-	"P+yN/C+zPttRAqrQqsfM2DBPVS/gaqZzpn00cwpgfGLLqXweCcKKV6wPiNpOGq1pYLnKLayRii3H8rIp",
-	"ewBX/ImhCKfnOpG914BmUUlVBCblfXn5NZub9vyz88gIFsom3jJJY3WiSSnz8Gw8xveG++FTFMdtLuYx",
-	"ApOYjuxgav5qdDiBmHA3xU9MYYT0EGpOCby3eQ32Xrwspej/Zaf9Cra/9No/Hw6H7X90hup/vwyHN/9j",
-	"OGwPh38bDv9+893T/1yt3rO/Px0OO7/oir5i70MAy12mtPPLI5I2OiE1ppsmVnUxcd/M3a5o6uqB/cIu",
-	"d7LkmesBmLYJVEonHGtbfigyGBcxso+9Tayh0c2X6lzzj6WMdZ8Xz/GGdUPtdoasmMTlLlfstZsSfLeb",
-	"1RMj5GigczMq1xJrdZdb6Y2Cdnf4qyRDcC/1zS/AwjSsbj3XX3ILLpeuJtcouban77R620uEyCrOw+bM",
-	"6FhkRPI3JfQdA56enl2dHGovlNwh3qQaZkhkjJTynqzqTmzUBr9xStp4QihDud9NriTangZsGxxG3tHj",
-	"Io28UqbkCh5NKmrkQV/TNhJi016LTsq8i58Cl1iD7dBePYPommDRTHWNa+mjL9aoQafv0MQSYMv0PvCT",
-	"fxdn3DOf0yqFncUiCmxwT8OakvHmDlQOVZhCFs0gQ8pFXYcuYTKxrn6lN+W271hl5mCzQ3w11yoPvLao",
-	"Ml8r163fenOmon79aW0v0IhSE2N9TmeIoehsPC6Zd3oziIUKGDeuNTrFwDjGoTiHGV9Tm15akDO1Wpkz",
-	"W09pWU9RKnLX5CkuLdNTXtXvlwp9wPBUq8JnyR6XKO1qMWdnNl2rOUxOBj10n1JeXKvab25ITmA4VZnQ",
-	"QsoY4iklETf6ZCvB6lNlolpy9nDeGZLl0Wt6EaVDGdI41s/C5W7/jQy6nGSj55tkO3rKcddR5Nfm43ry",
-	"N/Th1GhwM/T2LPHJ54r2mlIB+sfrdKWDAze+VWtBipI1sYRVb4F/6Wc59b201HfFOVejAFwo56Cpz6JV",
-	"3tM1yV5NTl3igpWqmsoZMIEETnSGHnUB6GtRPVIYxlkkS1RclPnuPIkR0RkxigN5YZnUbR4PDVPvUocW",
-	"b8Zp6hXmXeRMyFY7fdgE6tFGBiA9+61avd1r3Ma2feVrvASBLV7j9X7XsHsXoM2N3ukVPYYqMeFZJs7G",
-	"5m8nYcomRobSJJ0hPKXuqN7Glcwt5dKldgTMb5fmP9hOyoHWXyyRgpfIGWWUom66A0XfML/VaU3Xeaxb",
-	"vxJI2Tx/rdsqsmX35T4Xr2XdB3qPs0VZzxJ4j5MsKRIXwzimMzfYSLuNCwpC8w6Tfl42b1AQ8vzFIABV",
-	"QCiVJOPOuDohZjLdmYc3tC5DP1RWJFrIP6rMnIfgV65zFnCderkFfk30B52GQH6Y6g8q4UKn/Azs078f",
-	"/rLbfnUzHEZ/e/b34TD6hSfTm9XfhD0hIZU31yreyMjU1YiqfNHVzkIBK8+VuxQljfUTKzrr8cqJr/RQ",
-	"56ax/f3adNK8nEpSrPqaalUWpLw3CWclami355KecLPY3/oUy6GeJtJ3dz96ub8XHbzc/34/hBBF8OXz",
-	"CD7febE3fvXi+zGE3z/fG4ff77zY2dl7+f3zg1H4/audly/Cg4PdV9HuaMcN+ww5Cw6Dtvzf65O3/VNw",
-	"dHJx1X/TP+pdnYCLkw/XJ5dXqnRIBv3+69e/Hb1mH/qve8ev3w+ub2cXs0/HHz98OD7Z6d0P9j7sDb78",
-	"cHt2/OnL6ZfT3z799Cb++e3J3unbi+npcW93SAbJpxenV1Hy6aeT/dPjH5JPX8LZ6VVvNvjt0/7p8RR/",
-	"+hK+GBx/2v30ZfJ8cBXfDn7qzwZvbmcns0/vfqQ/94fky287R70Pn/ry15ffdo57H8LjD5PeybvXg6P9",
-	"ndOLH65+2D/96SxG+NWnn25fD7qDL/T0+O18cPFj9uVkpzsk4Y+38//6+AO6f/d5575P9vY+HZ2e7v98",
-	"fHp/P/vp5fv4w2Qf//aW3F2KD2ejl73eoEffHh19fns5eP7qdW9wNCS9nUlvcHJ91P9wfMnu8ctbFh39",
-	"GL4/mkaD1/uz7/ufk+P45+nFydvRu8HRyeVH8pLz815/8vP77z6wH8RsSA4uvmPPUww/3f18Kxi/3Z8f",
-	"9bMv+9P+9zH9lPzX+X508B9DosB+cnq8YEu+BW1/C9peq5sahdlC/Ha9zz/gJYWG9IcwXoGq26pFXly/",
-	"KJDTeMcIZIi9epq+Md4I2jyKCzKWz5xAcHuxTCEHI4QIsB34476LbBAbvqD+XnUgry6Vq74UdQH6kstM",
-	"YxgiU00eRhhzBJ6apBPPWkBPQYV+J4hNzGOAWu1jE4ZEtpZzlGuw8w6nYs3cMRRDAW1Un2bEwBjreDkB",
-	"lDVGPbPmG7/hFQZnzNLLb/5YUf14fLFtdQBQZheiHsIwr74pBFKr/LowXBdkStfgHUk21uYtL/rVX93W",
-	"qP74k+tY+TeXt5rpQl3QWTKTZeTBcRl4LKFoypmkBAIoTKIFl1TMIC9RidUCVWyL1/PlCaxM3RXkS6fX",
-	"lrukFXKUL9uCDfw2PIAvDuLqWOlXAHqraV7IqainU6v7hAMdIap9Czyetpz598X3Doz7XgXX6XVdRPNc",
-	"AWVXmkfmaWkFSp9ysSyA/MrNROYPIle0zcSzdn7jlICn1iO/KdvYIy/Ann2kxdC8PNG5dSFQT8/BNI3n",
-	"+hENzHOngyki6jU9B80w993YBR3cXrqAkn2Xs0dQ3SZ9a0PF9U5irZMm0qf5te1RecfRaNkbLe6Zqj/U",
-	"0ln7+ZX64wnID4e/6oMqb3CMjnS2vYY3IE0qPrXFYxz7U8o1t1fqHiDQvQBPr6/etA+eSaap8uSVM4jO",
-	"Exg37oWsZ7U/G6KRo+Fa3W4hAdWcy0GW5tkb6hCaMJqlTTkcY/SEA1Wj5egTkYnasa97S6iRLEEMh6B/",
-	"XH7vfBgwSsUw2DzqqRUkhlg1zjBFzPhBq/fnOuATzZT8ruesbXuJlLbHMMExhgzQUMDYMLggRlApC78g",
-	"Rm221Z2Xz58rfID6Mg1xYhronA++Ns/3dp51wCUSGY66HImJ/Efg8HYORkaJCvIQTMUml55213mcKotR",
-	"2j25TknxC7jK6fnTTWXm7fxGaNGZegDtK+7nV32GXuLzFgwhLnXZKHlz6dRtlt18xGmcCXQOxVT1UDMs",
-	"5GRlHRODP/t+LbXcBAsT7edjkEqxdm9VilbHw988zbaOycUaWpxctCaTTPHeQkN2Nlu8XEYouio9JFh3",
-	"NVds7wW6w4uYRF0qJ51x5/3YhfOt5RnKJ18btdVkPGrKpLckpe/KL4+bnV/5In6H4uSPea3gXySbv7IN",
-	"pTBsMp5qoSuv5UslaJ6gT0y6r9x+EyTzNkzTdjGE701oGGeL0o/p3Ek1jZJzvnUP3hyH1j4or7MRFgwy",
-	"HM8BMa9r2oe0eMXqlIfBusc5IBNM7tXJmASHwW5nb1d7dOsnUA5/DxCBo1gir57ylHLBFX7Iv4JDO0In",
-	"pIk5T7pY06Ggaz5qk2FwztAY36u32YzKDYfwiGaSQdxvBUbYsu8xBIcHOzlwj+KMC8T6536+TMNLXgkL",
-	"QissUNVlLgmrkueMbs7Zb6D6MXksY6istmpprkeOelNVx2OxCDEwQmOq1WysUKHpEUtb8YuZq6wUZTpH",
-	"2BwmcWAX0aZ3iDEcId6ZJ7GkEqu+A+qNJL7Z/AGIhrdVajfZVIh0xauMuGnOl11mcqPOvRea/GqvLy2H",
-	"P9H7aj0RBXV0ZFaEqPr6q6lwdIeYY7afMSwEIo++Cln9KrQ3GSxye4MFl6SOP/MtnuXi0PXFexN0TxOJ",
-	"smNhTAhSipKlHdDXEfna8QyBzxlSbhcMJkggxgHPwimA/BAMg67E8q6gXWtj+7uq/R+qto/nXHjd5tv3",
-	"x9+wFiNXRvWFt9fhP0kei1UJwCIYDCQ19rz1U0+goOporqyYaCKb61TzhXwjsWoBEDZjNUzqV3V8lDVV",
-	"XyMbdKd7UgtvzMage18PlOdZHBexkrk9KeiPT6k418qzoNXgUl2W+p64bZ50wE9TRJTKUpb14hmc8yda",
-	"etXbgDlIsziWsqV63kTl+y+3OpUlpUZJxgWAsU7drJ46bk7ApscMWtXFqF5XdNyR8Mn7kT8qfclPpr+F",
-	"cPZjK6FCszuu35vaxIeviolbOIOlDj05DGziAnWJ6JuFjg3BaCvbHoZE1ElHB5zcw1DEc/tc5JP8cn4i",
-	"6z0pJ6J5ojHCdWX7w3LXtIK0dHo22xnnBKptMYtdifIuAqR+T5+hCeaCzTvgaoq1v8oI6UgGnRDdaajf",
-	"hM3dNiXBtJ21JD8QUzKR/xpBn7KEW2G9IK7cdcTcahqjYIMXlhe8A4Z1RM2CgH1X0jLs2dYyZhRapSX8",
-	"sZ7lIxjkxqcMD9eHSC6V6Lf566R3c9jkGjtP0OHX5cMaQdwA0IWvTR76xPxtzF1KnnK0VdVxzsvUuuE/",
-	"qVY/I2JD8arkgmDehSpEKMMEeiG92p4VYPV2sEC3Uyh1lnf1z6up9xw1VyFVbG395PlbFwdg5bM6UDmv",
-	"vr0Q6YJkVeHpFCYoMs9k6ONSVonIpXoYWMNM1hjYryP7rCnzOB6p9UDRvEyKJnkqe6W5gHEMUsQ4VgrM",
-	"IgudYj6n8A61DK0xGgyuWujJqCdGmKmrL06PZwEhVBRpVzZ0ESkq6/fuSykm/I8hyvmYZ+FVjuEFPl06",
-	"/YjyhZ5BbpayhiNXhGK0yVg4jlXQoWy+znj66Rp/IE0PcPQ5U9eleSWo5AnuJJsseik8gHQWe+0QBc5p",
-	"msXQCRDV908HXCAYtSmJ56UpYyJePvcaZZd7CDnJmV7uLw00HkCVMdr4u9+iudYNGw2zmwbTvsNA2QQS",
-	"/EWnIQihQBPK5M+nPKSp/spRjELxzOK2F6lWuz91/VrOKd+61B3n20THUR8KeRVy7fdkv7ckhzBUjttd",
-	"OfYwMO/ZNr3x5L4a6RmQAJrCzxmyQFXDYpWBPA8r0priJ7wIEHc8yCBx1l2/KFakYoV/VZ2KXeq4XqSc",
-	"vLSnw1AT5WEAcO7KbZOAclM9AncYmue7KAMsTdqUC4as4sUEAcvOUhjeVrsjVNdrj6DEpfIsMAfwDuIY",
-	"jsoZia3iynS4okpGr75v2upf57aHJpCdQxFOnRiqXFBZmJyr8oBOA8EyAd3aCmweBnCXCaMoyJ231V8J",
-	"vVMUq5w3tUBEv4W8B364PDsF55rRyjWWfsuef6o66EUKCJHr/N2p3as0XWR6rqeC8oD8QwajGIlvT9j/",
-	"//qE/SZdL1bnPNysrve4MFZRv/R4UY6RNAZUJT76LQFNR7Le1mpFLBtwSoW5nyAxOmt5daj6lrehd4g5",
-	"Rr3Cks9Z2MUkQved3/gjbgwrIvRixMSFSZqQNmdNqa9zWn4opuI4ruz/sm+/F3djPLONdFYBNJb3k8Bw",
-	"RHd4h5i6gbixGuSvKxpzthoYk0kHvFF3++HiOOYn/Ek5QPlJ8qQcoPxk+qQxQHk4jL5rjklOEQsREV7L",
-	"2ZX22zPlEmp6RdqPm+HJREoJPkhqDlXrgO7QxmnsSkhwaXryp26wwzh7V1pcmdW82QgNSzOoh2qb0hp2",
-	"2VvVmzlaZXpZjY1onEvRcWMVZ8TGOnoqyyBh89fK9WO5/gQTaD4kME2Nl/DR+XXTVh+lmU8B0AqOVbpy",
-	"f6OmvBGtYGDykfvbNetTCnl/rt+aLyk6JA+/+Q3WsMSNLphFK9gkvqQBkPKeatT6rIgUC9P5+DNdwJJL",
-	"Y0XFYGn/oqTNqhJgslYHnBFjhtNfU8SApQyKp9c0dTuJnIubyZfKWV6HmEz6kvH1xsvmF8kIiRlCJM/V",
-	"oZrKxfwBd0OeuaLpgligCmy5++NZ8co0tvGxLR3qgjXDIzJmHPjUakIY24iiiJIn1g0JaIugI+t/xbj9",
-	"0OvBf5lNJtptUHlCmXmF1uldSfs6iqcFdgA23vJame7qXPb3vDqXb8kCtposgHMv67MK++jmypJwtEJ1",
-	"06Pf3M+nJjCcYoIah5pN55UB5EYbO9JQvdqVMTQM7MNRKk5Z1tcooJ48T4VS9DGkE/SWY8tyRQfogQs1",
-	"TRDGkGn9kPUt5DZYN0JglMlDh3SqX+tXCbBYkrdrUWrLAnjgTHlRHIJhcJmFIeJ8GEjB31npV0cbnqKw",
-	"DUnUbnyLa4WkC/nz14pM5BhQIN3qBFLnue2plx0k3FCzpWeKJ9N2LFeqM+2q5yCKhOclbZZ+KkJNLaYq",
-	"7kcZl/PPY/ssm+1EVYhQ6WcCJXEikBhN0ZghPtVF2VqJyuqr7NmJ1IsunBnXS/vFGuqF+WNzDQPahdWL",
-	"j8srr1cYlGDhm7UDnXrx0uRppsmJch9fggjax7wcKKkwwsb6WSywzugt+1ebZcRYKGJMblWR/sMpgTGG",
-	"XG0/1zX0H04NOTIO9es9dgRMdI6yILd1qM869Z/2TxvByEGdVrAe9jigOcnX1Vh2kU+2XuW9XXpT0aLG",
-	"PQOdesnAwqupaFG3lxak9aLjAsj1wn4B9nrhW2cjPAjmbE299DX0tyoS7XpgL2+jpTj+nsJoCYZLCrAC",
-	"fnORjSQGU5NxmFDRHtNM0egRjNocCXOgkUk8rPOEuMi9ESXLl3CpZ1D9/N7OqFpwSsUbM8Fq0WsYXebz",
-	"rRbaxMnV7wO7nlpBBRnzglUpkZN6vaaegwVhe0x29+qtVzUaei/BZt7Nxv6opIAlxeVZisjl5TtjDQMR",
-	"RIm+rh1b387zAw+LgwrkfsxKq2T9QSPto/stHyUVAjHKO/Udq5lmIFoKSMZzxhqXCyYiBxzLCLG8QGHt",
-	"fV4WNGH7y077VfvmO68WUg7kn03+kpCNRh4GnE+jjnERGAbPKupnp3Ap26aGLeNTeTfdHWiVMNqB4sp8",
-	"3BVO0M/UOixax/X3VOvl6k8ogy+UoMJYy7iR/BUC93unPWMaBr2Lk173/dlR76p/dtoCM8Xpy4/lzIqS",
-	"G8ZEhf0zQEMESUtZyG3L3CVLZdaETOAwiyEDHAukAiSweYsSMgRb6igZwIOe8taC3VM0+8cnym5b4CST",
-	"1KB7Dhm2ipmMwGSEJxnNONhvh1PIYKgia+xaK7Hk4OkweDu4GgZy16+vjsxmr5ZX87qWcrnq8D3GxBrA",
-	"TS2dczITVIpGYZ40WumpSORLNy1wYkutX5laDs18yTg28yY6YpSc3Mt9tIoHLiATbxkMkZt4dX1VoG38",
-	"0AqEg5trd5Qjdk0uEoF3tisfmY9/UIhuxXSWjWLMp+eUiQWBj1PKRVvQ9kQlP5IoC4wCvAgx/TjoAJWd",
-	"HxHB5tqjyjnE5vwOVeCnHO5QdSb/slJvvaSbMipoSONhYB5DHQYHOwc7hwc7tpH52RVhag5Nru+sPI92",
-	"892h/udp96kI0//OovS/eSjSZ5s+Z/YXs/yuYp6tamQ+DsCMslvFX1pfE+Mp9UZllj0SsXl7QaUp/jgA",
-	"kaQmyi3DRixEKMZ3Kh2T497qRJ9187AHnY1DO3V7XfjBG8psef7ipEmrod1IjKueDpEwvtQfMRNA/ieD",
-	"8UDrmMCn3uC9tuMS7SuZqChZv6PdMs/G2sEY1F0uNUU1Ppmr2qh1P6njLl0Ef6g4PXNDqM412S1HAwdd",
-	"JMKuCsPuyul0okNGN016/aBit3WmezWPUC0dJRDHwWEgEEz+0004HFh3MsW/aIQBJrMhuEIwkfIEk02t",
-	"6qrUuuYU90u5i5unvmbPjPLXJNZSWaFRGEOmTf2OaxMdg3GMkFCqNxRNrC+6drUTU4RZjv1cPw8S4xAR",
-	"jpzcyL0UhlME9jo7tcXMZrMOVMUdyiZd05Z33/ePTk4vT9p7nZ3OVCSxvnSE2q4KkHrn/aAV3OXpnG0G",
-	"Z/0ghsTD4DDY7+x0dovo+N+DrpNXymRqs7poHfvuy0V9pMNCIDgqGl/qxkVy6sI+lasp+1HeuLFloNEL",
-	"cfGaRvNK3ibnnHd/M5phTcg24xEaJ/FQRnMTpqBfieH6FO7t7P6ps/NtSSR3+/nOztedWJ5DtzaL1zAC",
-	"+STlTHb/rJlcE5iJqXJrNUDZ/7Om8oayEY4iRPQ8Xv1Z8yi/xKQms/enTeaKUjCAZG7RReXFe/HnbdKl",
-	"vgOuSW7Y0XwanCjlVCOVDG5ktQVUtPu7pP4PKhALCZ+HGoy0OJVH9jQe/DoxfYvEIkpapFZQnOViR+3l",
-	"xBwICiba5oplDyYvlbneTPhOmWq2nO2qKgEzgj9nqK95bB1OdFMjsjt/ISJ79uM3qtZA1Z7/WfPI1b7f",
-	"6NkW6Znhbg3x6tokuY1U7C0SJtOMyaZrMtI2s4FvkbD5eXXy3nXJlW5lSFJ5cF71ndoOxXqop+nO3/9V",
-	"mv98Bh+LV/nUsCp1TTGuNzvxonH/cLJotqSRBu7pA189isCJd/9Lkck/kzyBgj79eczfX5ftc6iSJhp+",
-	"ElR4xKRQhP5AcRsE7mR7Pl5Gh1SzUvrwzeiQyyOpGW6L5tysIxC31dDfbWEPS5FUK4nDfzJJ+ib2fmMQ",
-	"l1PgbxxihUMETSxiToxbQZp5WL5r8wDpugT3QscibpnkFk+C/uE0d4t07RuJ/Wcksd9I2+pcXfEyyepm",
-	"BgJ8j18uti/4nqv8w450ffC/gj2hYVbf7Ajf7Aj/IqLkX5qfqlG+Roq4zGTwVr2RtxZRfIuEjyKuxXU1",
-	"j7dVu8CfoO1aiTJ+U/5/k+3+pWnRg371wBID7aDShSnu3u3qRKS6dS0VkaU0HFBSlc2Ui5EhBIYRrGvR",
-	"yz000xm3s/oSHm4e/l8AAAD///WK6b4T/AAA",
+	"H4sIAAAAAAAC/+x9i3LcNpbor+Byt8r2pLv1sh1bW1uzsiQ7mlgPS7KzTuQ7RpOnuxGRAAOAktqzqrr/",
+	"cP/wfsktvEiQBPuldpKZ8m7VxGqAeBwcHJz3+UcUsyxnFKgU0e4/IhFPIMP6nzHj8PebrSFIvPV3lgPF",
+	"Ofn73lCwtJBwhuVEdUpAxJzkkjAa7UbnkHMQaiyEKcK2LxqRFFCO5WQQ9aKcsxy4JKAnyYPjXE6g+lp1",
+	"QZIhbMZhFMkJIDEVErIBOmESkJxgiTCdIrgjQhI6Nl1vSZqiISB2A/yWEymBqhXAHc7yFKLdaOMG842U",
+	"jTdwng9SNo56kZzmqkVITug4ur8vf2HDXyGW0X2vAzA5+QBc6PU3t7N3dmTbUAIjQkHoLdyY3yBBBuqI",
+	"jZCcEIG4AyNWA6ifMUVm/gG6AK4+RGLCijRBMaM3wCXiELMxJV/K0YSCmZomxRKERIRK4BSn6AanBfQQ",
+	"pgnK8BRxUOOignoj6C5igI4ZB0ToiO2iiZS52N3YGBM5uH4hBoRtxCzLCkrkdCNmVHIyLCTjYiOBG0g3",
+	"BBn3MY8nREIsCw4bOCd9vViqNiUGWfJvHAQreAxCnwotsmj3l8gCNupFo5SMJzKWqZqs+jn61DylXnTX",
+	"V5/3bzCnOFOY9UtUHciH8tPqt9du7CMWaj7McjlVE931x6zfwIlODMgvdccQNqshzPkCwnmeklifrb9x",
+	"fREFRL3otwInKchITUQlJhR41IsmkGZRL7rJFgaAXs9+Oaz94V05etmjmsT+9IOZy/71IYs+zdi124wa",
+	"B6hUAMBpejqKdn/5R/TvHEbRbvRvGxWd2bAIuhEc8DVJwY1031thgHNIsSQ3hkSpETj8VhAOiQKKpjef",
+	"Wpd6ke0dgFCjXEgsA4dsW1FKRhBP4xSQUB3RiHFNC8NnzgtKzQEKyfIcksXPNrSs83K4jg4XbpYF9ntI",
+	"b15zll3oKxogashcXoXVQG8IZzQDKtEN5gQPUxAdW2/Qfw4jchca3bSo/+RAE0gcOVMw0HPieBKcGI04",
+	"ywwhNSsctMl6LxIQc5DnCrdWwLEL9zVwoDHod8JHs2r0T4s/ID7kP2Bu4eNDC8yRtMFlDkl0nkUPEapm",
+	"hwQlhQIB4gWVJIMBOlRwvIap+hYj7raUILNHNISYZaAf9NDQA/ShPHAB6plBYJaPJL4GdX4xJEDNMRAJ",
+	"mVgJ4p2IWb3QmHM8VX9DBT+cJER9g9OzGiRb+FCH52EIr65h2tcvI8ox4bNgqii+Aql6Y80XGluzQih4",
+	"oiHIWwCKtnSH7Wc7KJ5gjmMJXAyiFsLcr4BCZ4zLNpqoX1GG81ytllB1QzMs0VU0YUKqxt3ywVF/XUXo",
+	"MQzGgx66il5svtjcfbF5FT2ps1D2d3WxsZTA1TT/++oq+W5X/c+/h67e3LVzdkMS4K+wCNCdfZZljKLq",
+	"Yhg6k6Y+odHvrWiTG0wpM1zVQ7Bjjw+J5JhPUQYSJ1hi5A08QO8FJCW/lU7RcKopl+aSWIryFFNwkK0x",
+	"ObeMX6cMJ5rjeIJuJ0CR5JgKdVDqzFpbRFgiDjQBjjTqRYoO4eSUptNoV/ICAriDK0ZllWuovzUwqb2H",
+	"D3jzu17Z+0+9JZ5ZTfI9CPUQFihjQjPHQGU61RTKnoZ6cDeExFyiBG5IDGjv7EgM0DngpM9oOt1FsT5V",
+	"dWPVdwnhEEtznGqW6X8g1Q1ZXl3dJzWuOQ1IamclcohRAim5AV49ZXgMVLaP7L4XqUeuQyzyRlW9SqKy",
+	"9f/+z/+tkxKUMjruIbPHWyInCKMUpASOGEe0yIbAjRxgbz2iDN1OiASR445nk4Me7g1Q4FgGpZ19VlA1",
+	"B6ExB0VBIXEw59AEuCGMCnWbrDEiwvWH5M9wLCU0CJUwBt569N29WuXJdzTvIodYg5QokGaEYsm4+sFS",
+	"bSOYG46+44ZZht8bvCZIdH5lO9S/00JHxydKSKj3doJLxwdW8qh/c9M5/ofa6PclLZ+e6NtRwvu+FzEK",
+	"q9KeALhWEjsCm1tpnOBBrDRS83xWGqRxCIsIDudWrH9LMiJFm0C4dpTqDpZ2w2xBIc6LAKk5e28GUbdc",
+	"LUsM0GvD03BQREtzZ0Os3mNGW69nnZPZHHz/LETyMsgYn7YnP9a/2/k1eWW5YSZQQYl8wEq2nz3PHqSK",
+	"ah3FrFOIGRWSY0IXPYq0PNeHPPQNLFlpe4pLKERY62LajFQjCB2n9dfFqhHNS+SL5Gcccmwl7wuJuTT/",
+	"rETrQ84Zj3rRe3pN2a0iYYqEpCAh0Z8YCdv+S32ytEhvlu4vpNXorazVFtQCmCa39lZDtZlWk7+7wDrc",
+	"dsNNev8LnOR7Abwt7/KC7okwH1QI4E4VUOmD9c8tRtDpSoegRDRU0AS4EtKIUEwGZdKMoEbDRjWrh1F3",
+	"mlCtVy6fTxFQ4qHHZOT+HqbwZIAOYISLVJYqWLsqLCv+Qq1EqOkejzUvpeQEzph8gshIL0nxJmREIBlE",
+	"Ifyp1JLvLST8n/vimuR9R4/6OdPSiGUvV7lpH1haZA02v8mZG8021mxpgm70F2rrmv2bpwgKc7zvKfmt",
+	"qHQ+WmvujWtPKECxAoxrnGKSnbGUxNOH0i4DjfPakE1uUG8owAr+4yHMylGGx2Bmr3GMKz3tx4pRX9dg",
+	"emWdI35aiHEIfNkiCeb4A0ThLRFSU3vvYtrOa9M82VvQUjmtfqPOm2iZGNKhH2iJiaLI4Xs2YbceNZlg",
+	"mqT69tn7YXQHE0DsljY1B1qyytiNoS3u4bPzfVpBY2P2Ysj+7Jd4LVThpEUOOq68U88GmB/b5Ch0AnnK",
+	"ppCg0/2jvsKMlGAqEVFYraRlzCUZ4ViiIY6vFTxnzh0iBf56VpEQxUWRZZhPF+R56vow0c3v/AA4lZNp",
+	"1IsOYMxxoh/zNo9zwvy1LM/T1JdfTdrZxVtNZ58AO1PvEGRr6l2aG+s6in0sccrGRxKycxjNEN0bckvt",
+	"s5UIUH1mQ1AbGNaYZWH8CgzdqRYd4VRASxWKuH+VMLIrQYre9hBWKigjHClJL2Z0RMYF75LxzLdhhs8N",
+	"rHmBkpdS0yh6lmOuqH+QDsQTTCmkASMT9US2PMESkO2r1GDxpHQg0ORKMpSboy47FyAMocU3mKTGHPI6",
+	"BZDC7FmRt0KyfpFrXEa5JvNoxNLUUm83n2SIwi1w5xQg9IcZliRWrGFwX2rvYVj5/JJ/IOoQIA1D6abT",
+	"e0GZMEjiVmZgHxecG5WqdrkQTs3mzzafMLoDt3upFrE4AoMizOomwgUZq5fuHH4rQASMH51dK38LoS1g",
+	"5kdtVUCCjCkkKK6+deZFQPt7bRTGNTeQFXiN8vP7XnRNaNLex4+EJgrlMTJQtRamchPudTo/vLhEzsXC",
+	"yEQGmN5+K3cS5QpC6MhJT+UmgSZaetB/xCkBKpEohlpxY0GlxJwB2i+1suZ2JAN0RNE+ziDdxwK+ujOJ",
+	"Nof0FcjEIKzGMaaalc7lVAPuGCRWQwlLJ5en5F0oaPnuSJQs1BrHNmO2tdUlqllM82Bk9/jwa1jqs2so",
+	"/BPHeQ4cYc4KmiCsBeR+zEHhDdq/OO+hjCWQGmXZdTEETkGCQIRpfME5GXhXUgxutgYzl9C+qHCXE/MO",
+	"XUDMaBLUkOnvjX23dMXSxJDIaakr8xaipjE2VWMm2NmO2lYDpemTHM+yP5bCSguLm6buhtlaDYywNPcH",
+	"SmVFpX9wMNb0S8E5Z3mRYs9Go4wyQhMFBXvdX+1cmYlIlhVSv3JRADF4F+FVL5LSfD5/2gcaM+XLcXZ4",
+	"XP37x/2Lf9vaVMsZoGMs44n1j1MoOCjJMYFUGVUR9vFhFk2vnD/KIxlOJQSdQRSV5ydBYeOIJgbJrBnJ",
+	"4YT5xtjPNDX+rcCpVtjo51fNmxH6FuhYTqLdrcCsBQlQ9/dHB7/DqXmLEHgcEqff699LLZR+bkAL2Mqx",
+	"wXzlQcM+/0SIov5c1mTvuejstHqzZb7fATANYulwu4Yqa6COHRJzhXM4VzwnTjcSoASnGyNM0oIbq3dR",
+	"3m+9dc+QKzoOQ+kWSzfZgHuE1zV8je2Qba6oV0ETMRpDdRALXUBFgUnpltG0J7s2I9FWhlp7KgP0oxLy",
+	"UOx15ID2NOgg6aEDoAQSA6HXmKSQ1LByFROdmSioCaoxuNW+FseWtkFybd4VNdea+97DBnNuag8dp0MF",
+	"+VA1aZeIvppVdiHpfzXNKU0J7V7tp/slMMfh5cMQphynRJM84B71kIGN3b5xWWTYeaLlA1SOUpG7BCQm",
+	"qbFfMgoIq3dLliKwEVatq5Dzslcvw3nJJcyFadgbTf1aER4lkRVaQrEyvpLFfqzYFTWlL7YoTzGrKVCn",
+	"pTUoieKH7bMbG79xpVcLmGGxkJccU2EgSro8hlS/ysOmWqssv4XEyHsKcvZhUSuhTE6A1+h3giX01Vhh",
+	"CUsotqC9ih+KDFPEASf6fbD9EDGvnIKROz88ZIW0Ky6XF+Rb2FC/6sksTyS1+4GTaQbjsmelPqqgcYuN",
+	"/6ox0Rc5o7WNEyqfPw0y8xywCKtNHg85gdETZHpU8oKb85FYaKcPkQndVB0yoB26F8KlcmfVwS5PiOab",
+	"BGoQ6WkUZCN0yVWIymutcURWiesrrVV71It0B09NvZhWurE6O1bjVzd04+dyprlb74gFsXEgFeIRX0ni",
+	"bdGxL1Evujw7/gBcyxZRz28wjI0GBElDXeMYhCDDFJp/OMJ3hrnQXS+mNNb/+KDkW9VDEbBCHqnnaMxB",
+	"KDR5rzQ71jUih9h1PS5SSfIUTm8pcKHXdUNiOACl1CFCEOb8Efwl2BkXO7NDylmaZkClZZ09KLTa6kDo",
+	"5L69ITr7lBDu7FGCvrNHfTnnkDNBJOPT4IEoqHQ2tE7NbyxPUGug3dnoP0Jnac7IO1Hzg3+u5peFT9fy",
+	"SLXV29/cWc+4NSMyblpSHsABviEyMOZK/Fn1fpuIiHVxfOta3w9S5usa64OyPa9rsNOYhIaahQRtz8d/",
+	"bdFHuz19k5983GgKGznjISdSP5RlfQ4eatSQXor7fpTr8HoMOjwuzl/lhRvomFEiWUkyq7tSh2Jmus0P",
+	"UK0MRgzZj+arx/zRgy5PKwRxtrc3g3JwRg/vcvWyhUMSOKMIyg4u7klx5GoVSZFqoxlRvkJXVIHD9iAC",
+	"ff4Lsv//eRf10TGhhQSxiz7/5TPKrLZ6s//s5QD10Q+s4K2m7R3VdIB1hN0xo3JS77HV39lSPYJNW9ve",
+	"xz8BXDdHfz64ohdFrm4JJEgdOZZMLaKvOu6WCnWl9jOGQht4pIYhFE3Uksvx4Ab4VP/2RM37uf95F51j",
+	"Oq6+2uy/+KwBt7WN9o4VlrxAe8emd+/zLtJuWK7zVm9r2/YWUqvftrblBGUahuabjc+76EJCXi1rw31j",
+	"FtP84sL48tb38qICiRIoXnifXNFD41itIIc2+y96W8/72zv2SAcLB6jtF0KyzPA+R3TEZtlvmpKgNm+Z",
+	"0P8ExXogF8BmTyW4jqZG3huEUIOhWpethea6d89idOTAxNbSeKoYNPNYKz+QUmpbwhNk5li+ib0WjTMi",
+	"dAw854RWFnd9vsZVxHlc8EcCwZ1NYJCUMwU02DWG46QzjKrmIVGfyiCVbhkTiRhHP1xenrleljqq758E",
+	"D83bUXhqf8tsVAdHbALf7RLU9JrJlujih70eEhO8/ey5+kivaMiSaQ/9+EIgE25carOsXTe8PiXqvzcW",
+	"+j25iMbIX288UcQgQY/JAAbOcc4eRrl4pUqxPgBPFtUetfXmzWP8tBpSrwGXwygspjSecEbJF3MLPTAZ",
+	"PWQbXwlYL0hzT3soxrks1Lm3ozhDaH0OoxAnpEznur1forB/Zors6hM1Z6Jn6GldV+kjatZjl/BAbmo2",
+	"TVndddZQ3qUP0m7Mc/DJJ1NBYn0ujlqWoTd1B56uDCTGL8cOHtVdS1I8hFRDbpSCDoVzHr1lWGc0er6d",
+	"jIZPR8+S7TgZDl/u7Lzceb49fDbaejHajmH7+Yvk+2fPn74cJvGLzc3NndEmbD7dfrmNv4fRi3hHA+2b",
+	"29E3t6PFr6TT7DxQn2wHWsGh6NNy17wVTNR2rF1TFDtkQ0gSCCD8TxOQE+ChuGT3kXN7GDImY+Ms7iHB",
+	"kLEUMI0647kbdhGfJ5kfzoKTaQdrowO7rZLZRS0Zf1bMQU83RQtHzegA7MCrc1LO4vogZ5LpiiIM2E7W",
+	"FN9FBOKFDnOwsV1HIzRMMb3uhU6PF9TFeemYLz0mFl40RTMma+0hWA+6heEASOXC2xUbU5lbbJcyAKMJ",
+	"yjWHysx49YMBEgqpPazrVRaq8p72lo+zb9GUegRAMLeR6eCwb6LjFRrhRYGgijqJIlZMnHnrfUnOmEHd",
+	"S6qZNh93v56hcHacSYfZcEn47+McD0lKSgK+OBvnf4o4WGXHcOpBv8raUD8DJo5Zstorcao/LSGw1F41",
+	"29uFXvueP0JlBbX7MKx8eyNORuzMMnhuO5SO+l3jznN1q8/zaemdC5YGE6R5zU1hKrY/x4xSiK1dtLwX",
+	"bWAIo8g7Ogg/HrYZHR34VvfGDOE7ZL489ri2BmkosbacpUwCZB9VtW7rKPmftXxRMaZ+NAmhRBKcki9G",
+	"zi5TBALPCMVpr1yzZO6zHgIZd51hPVlJ7cI2dtXzALjk+fpmv1BOBAsKo5Nyki5K6sbC0nOvdbAS8zHI",
+	"BzCn/vou9WBhXyMzzwM27w3efm9Lr1lzAU3OoiYQMpATltSvqe8B8J6Ctmxr+34sGZ+eg6gtepbFfNaK",
+	"vZFndavPOhs0R4qr40RO9ycQX3dRvu6+LfVKjTYS9wWK1ScoB65umYkPWPEJ7gef4EqZ2pzTrGjdL283",
+	"RNb49HYOP8cxZwmwV0jrIi7fU+HsEr6HSukKsQwahzZQzTSrj7+G7n7l6rq7VOteENadvk+WtezCcDaa",
+	"idHm96MEqCRyumacU3i0NNdaXRnNsVY7mcOvqt4lVNvvOMlASJzlDiCNwW/0l5XUspiP4vpuqk1yYg7T",
+	"SWAyz9Z+IuulAO1lL0wDOh8qz7upvEhhOrDSnW/cv164vesKzyEWbTox536/dZkHV+Luy7yFa5AWm3a/",
+	"RlLEr/VWNQCwxmcqNHIXdvoJtUOwbb9Hxk3QokjTd83/ZUk8bay6iWmN5toqAu2hpc3pNh9nT0XbP2RG",
+	"QgxMVRqMQPIL7aoucUA61X2j3YcP26lmWzal0eyl1MPircTcmZHgK2RvmBfkcEi08tkLBahgV6Zc6Gkw",
+	"Ij5/azZczgigHARLbyBpHQqH0SCai0qr0L3Ti4Vx5kNdfXF60VKsV8RMtxyQcWfcaaLbmmNZ+7qxp+/i",
+	"zcFg8GS+ZsSstz7pkhTuHMZESD49JpwzvqJtumsgqxnTKSlKy4XT/phHRNkAELffokx/HI4s0SxIkC+b",
+	"QOUbYEbQFn/1kRETIFmcH0tZPCN4w7W686sWvHjwyaX2r9ARGN6Kbd43DZLO2NiydbZxyA7JQeTMz1Rv",
+	"QP/IB03YOuQOpGvx9rj0FSbCzgfJfIwtB/YA7W+rVzvqpXHZ+p4vRQ9KT8p5co5N+Lkik1JfXJmymojr",
+	"tQ9a5Qdd47CNg1TAKGey+3jQcc12BBU1T1BzgPUqLVUCp58wd/7/nOh0NYH8UcvwU/WF+ump2q3V5KFW",
+	"b0GhZrfIUNvcOB/Ppt6h5bowyaVVw1DHz3dhum9SWiyvnfPzWaPjc93Du+UAbawH3atr+NCJBwdYtyMQ",
+	"QmsSLIUOA3Lq4BZLclPp/63i+8FOTr6tI5iXo87Gr0ehrUZmD1mxFQS8N8cwEAEY2oaKbuv+RqeNa2Jn",
+	"XqSpMIyV0N5mjrE3/jwPg3Sd0Znrm98s1MCN57Tr41za65nIxEOXWPNVD63ReC8kS7J6l6XfQ6JzWBD1",
+	"bNb98hte/ljGkzNThyNo1naXQndEtmJHffvNT6w/rltHQYnUnio9m/Pa5MlVmghRjEbkzsokYgJp2hdy",
+	"mgIap2zoJtPr17PjMSZU+KnEUoYTMFPoNWX4zuVP2X72vFZh5JfN/kvc/7LX/3n36qr/98GV/r9frq4+",
+	"/a+rq/7V1V+urv766bvH/7VYvyd/fXx1NfjFdAw1B+uYzHdkNC5pD8g56wXp2WG6BMjZz9TqgkbbOhNW",
+	"QQkvyad96JD9Vvn4SY5JqjviWBY4rWLxH/ouOvN/1bnGsDyUxrc90QLXG7fdJ9YzZcNRRZ1yw4ti1afL",
+	"H2bxBCwlGuijNA5fzhdGHWUw24J/wl8l6YrPnqz+lFcOG/r99r2Y1+AI7dtXrOp5fVYIZ025AKCLuPTb",
+	"O2NyHgAtS+KYNwY9Pjm9PNw1vmFlmEopMcuC01p+pUWd/K0y71fBaJ+MKeNQesOVqtv16aXXwSuVA83l",
+	"lqyWIZ6YWzVtsE1O11LmySx1A8Jmp3sonQpqltYeyRiU/BV/82Ci1yJ0huFwkVarjloNUufCwm9JjclZ",
+	"zytiVpC8p0R2H4p1XX8wi5B02Aw96l4DbP3lisIPmY8zPvUqqa6+Z9UmKmzw7/WS2orVHTQ9+jbBPLnF",
+	"HLRQYkIjld+NAQCqVZ9dv+OmXYPLp/PVXDcD8FqjSW6ppONh6/CpznYQzi9+DkPGbP6JM3YLHJLT0ahm",
+	"Pt67xUTqZBrWdc8kZRmlJJZnWDnFLaVdqm3IW1qrzVttoLWuO6o1+XsKNNe2GWhv2g9rjSFgBLo14TPn",
+	"jGuUdrGY1lOXN9teJi/nKNzlTFQMgvHLvaJlndGYcasqF9Ze5WRxc6ts1FzJ6E4HV3R+dKzZRO1SxsrQ",
+	"qusulWFFnaKGWmSnZ6163PfGuhZoZShsrcePFOoYw+vR4cYcHFnhU8jV9RVjUvm4LjGUCT5e+VVtBUEr",
+	"JssRVnME4a2fuk7owlHfBdfcjDLyoVyCpr2KXv1MlyR7LYl7jotnmdidowxTPDY5zfQDYJ5FXS02TotE",
+	"tei4S/u7V5soYbfUqkC0Fs0kuwx4gNl++zWZbemzNHv0BrnvlUNfmKwI8ICByyFK/uYrrLeoT7WO1d6v",
+	"ginJSkZxs/q1egL5rIeL9/3KrEcNAmtkPdrjLuELVIG2dATKL9kB1ulnTwt5OrL/9hJg/WTeVvWkkhvg",
+	"45of2zI8Rm3l3ryBVn8pwY8b6blqrd6C243eHkIDz7VvEXE9N8fMetK69P5kyWqChN6qFjWFNwNoGk/E",
+	"tUmG3b7FOVaK67BTCgftWY9UH2/xevj6mLP3oucIpEztPNViVq7MDN+RrMiqdPdY5TD1AzpNaI5kKLZF",
+	"AU2t8/KD6jEry9chrIPumSJBN9adFLjNj2qrQBnNlKmaWSWzKX/U+Zx30Wdh8sIIk7C/hz5n5geT6kX9",
+	"MDE/6KQ2g3pN8sd/3f1lq//y09VV8pcnf726Sn4R2eTT4gXKD2nM1Ou9SMQH2L4GUXW8jz5ZLHGlQi09",
+	"lx2FylNT78vkyl84MaKZ6sx+7P5+ZQfp3k4jaWJ7T60uMwql2DTlCjVMaAnCD86v0F5iPZzeZlPY2kme",
+	"72wnL57vfL8TYwwJfv40wU83n22PXj77foTx90+3R/H3m882N7eff//0xTD+/uXm82fxixdbL5Ot4aYf",
+	"Wh8LHu1GffV/rw7fHJ2g/cPzy6PXR/t7l4fo/PDd+8OLS916RY+Pjl69+nX/FX939Grv4NXb4/fXt+e3",
+	"Hw8+vHt3cLi5d3e8/W77+Mvfrk8PPn45+XLy68efXqc/vzncPnlzPjk52Nu6osfZx2cnl0n28afDnZOD",
+	"v2Ufv8S3J5d7t8e/ftw5OZiQj1/iZ8cHH7c+fhk/Pb5Mr49/Oro9fn19e3j78Ycf2c9HV/TLr5v7e+8+",
+	"Hqm/vvy6ebD3Lj54N947/OHV8f7O5sn53y7/tnPy02kK5OXHn65fHW8cf2EnB2+mx+c/Fl8ONzeuaPzj",
+	"9fS/P/wN7n74bfPuiG5vf9w/Odn5+eDk7u72p+dv03fjHfLrG3pzId+dDp/v7R3vsTf7+7+9uTh++vLV",
+	"3vH+Fd3bHO8dH77fP3p3cMHvyPNrnuz/GL/dnyTHr3Zuvz/6LTtIf56cH74Z/nC8f3jxgT4X4mzvaPzz",
+	"2+/e8b/J2yv64vw7/jQn+OPNz9eSi+ud6f5R8WVncvR9yj5m/322k7z4zyuqwX54cjDjSL4lxviWGGOp",
+	"YVoUZg05Mtpj/g71dzrS4+J0AaruulbZ1MOiRUnjPZMegnK07phO7PLszqhz4busuodlggUaAlDkBgh7",
+	"T1YZd7pUV3NUR2/1AOrp0hVOapFtKpMEhzzFMdhu6jLiVAB6bBP7POkhswSdXiPTTLdN6j4GKl1SpsT1",
+	"8q5yC3bB6XQ8rz+HZiiwi5w2jBgaEROTLJG2rSmqE5y/o3aPN2etDGk4Hp9RyVlaHVsbANohVg+qyyfZ",
+	"EqQagfQuvy4MlwWZ1rcEZ1Ifa4CG0a91qS2qP/zmej4bq8tbnaMHBJ05K5lHHjwHkIcSiq68dFogwNIm",
+	"s/FJhbJk+1RiMedz98Wr6fwkgbbvAvKlN2rP39IClS3mHcEKXjgBwFcXcXGsDCtBg90ML+R1NMtp9X0k",
+	"kInC16sOeYALHj6XUPUwv8qRMOnXfUQLPAF1x6gH5sLqRVqfcj4vSceln+0xnKhD0zabM2CgnCXQYxf1",
+	"1JXR8YEP4J4r7WVpXung6RxCdB1UpY+emtgGIkoXkglQXdrVQzMiQi92RQfXl5KlZuMW/AFUt0t/29Fx",
+	"uZvYGqSL9OF0JajMe0MUFOZV9vLvVLu812Dpol3tkjsQhsOftQzXa5LCvsloGoaYS3eqj3hE0nDazu7v",
+	"tboHSbiT6PH7y9f9F08U09QolOhNYnKxpp1nofo57c+KaORpuO7vlwFUd74c1VpmyGlDaMxZkXflyU2V",
+	"g5Tu0fP0iWAjI9UrY15ornLeAScxOjoYoAPDUmqG/irijMmraPXI0l6UWWLVucIcuPXP11VLB+gjK7T8",
+	"btZs7JsZ44BGOCMpwRyxWOLUMrgoBayVhV+AM5fRevP506caH7B5TGOS2Q9MXp3QN0+3N58oBYIsSLIh",
+	"QI7VfySJr6doaJWoqAxz12wyZbICrMmV19iM1u6pfSqKX8FVLS+c0q8QwGdCi93qsplf8TxXSci3FLav",
+	"wRDiU5f73ooDlLdupRH2hoKlhYQzLCd6hJZhoSQry5gYwpVXWuk7x0TaiOoQg1SLZ36j02B78Rq2oOcy",
+	"JhdnaPHyfVtPzKoeT0cMqGueLyNUQ9XKz7bGNGzvOdyQWUyiaVWLLoRXdXzmelvxn+XiW7P2uoxHXdlK",
+	"56RNn78aeyHtyS/8EP8Aafb7VIT5F6mYom1DOY47ztAKXWWvULpWlECesmlmUyqW9psom/ZxnverKQLo",
+	"rfXfM+QRk5+upVHy7rcZIbSw0j6onrMhkRxzkk4RtTWZXflF0bA6lakG/Osc0TGhd/pmjJUdabC9Zfzz",
+	"TYms3X9EQJWrReKWPGFCCo0f6l/RrpthELPM3ifTbOhQtGF/NCbD6IzDiNzpip5W5UZivM8KKqPdnV5k",
+	"hS1X8ybafbFZAnc/LYQEfnQW5ssMvNSTMCNQxgFV9dKEVctzVjfnnTfS49hcwSnWVlu9Nd8rSVFPbKLr",
+	"eAIcDWHEjJqNVyo0M2PtKH6xa1WdksLkYZziTIXo2gZ2A5yTBMRgmqXRJ09AmB+A1c7W8Gn1IjsddbVa",
+	"L5kyniz4lFG/lMS8x0wd1FnwQVO/tgIJVHfnjSmZpyNzIkQzckMvRcANcM9sf8uJlEAf/BTy9lPoXjJc",
+	"1U9AMx5JE00Y2jwvxaH3529tYhOWKZQdSWtCUFKUah2gI5P1xDjfAfqtAO12wXEGErhAolChiGIXXUUb",
+	"Css3JNtwNra/6t7/qXuHeM6Zz215fL//C+swcmFUn/l67f6T5ApalADMgsGxosaBemrtJDW6j+HKqoVm",
+	"6nNTzqOSbxRWzQDCaqyGmf9CXx9tTTXPyCol//RIeuOdGW/M6MuB8qxI0yrytbQnRUejEybPjPIs6nW4",
+	"ldelvkf+N48G6KcJUK2yVG176S2eikdGejXHQISOSYfElpDSNVXqX52oltpHWSEkwqlJj68L5HcnuTRz",
+	"Rr3mZvSoCzruKPiU46g/GmOpn+x4M+EcxlbKNDbU/d70Id5/VUxcwx2sDRjIreESauhHRPfSKjhNMPra",
+	"tkcwlW3SMUCHdzhWcea2yPCj8nF+pPo9qif7emQwwndl+93yg/WivHZ7VjsZ7wbqY7GbXYjyzgKk5v3K",
+	"yEblAUiMv8oQEHZmQuDeh6aSeOm2qQimG6yn+IGUKfOwQFbQZzwTTliviKvwHTHXmiouWqEu/4xai0R/",
+	"OCv9gi9pWfZsbZlcKq3SHP7YrPIBDHJnGdvd5SFSSiUxB20xaJLe1WFTauwCgZdflw/rBHEvWr7S8G5I",
+	"zF/H2pXkqWZbVB1nettrr2291bptIyIjq8w1GgaiGqTNDGL2VokFdqGMj3FZTE3Ti1si4J/UYlBQuaLo",
+	"VnNvsNCsxDPLYAZPcTF8sKfXNcAMvVF1nHao1rEP0FvAN4Agy6W2FFeY0zr2h8D3n9fGECASFbK06UT4",
+	"i+q6LkxZjnXmuG81g32QLCrqqeqTiS2cZC5gXYGjthpgty3r22K3v46ktqSE5vnPtkN7yzadbNJV9NB6",
+	"FpymKAcuiFa3VrkcNas8wTfQs9TL6luE/sIsRhed4raveebb1F07LVcpf1Z0aKk6a4Gvnt4kXB5XrYcw",
+	"Wmadn5P41Hyp/c7MVpZwO0sghVXmUk44KkxUfb7MfKaYWTjsR7n+/FZoEm3rxtX81r30w9Uolb+SqWti",
+	"3LfQGcuLFHshveZFG6BzwEmf0XRaWzKh8vnToAl5vj+Tlxjs+c7c0PBjrGsImGYVgm402VYf7idGdpV5",
+	"LC9iEkfEWMKYcfXnYxGz3PwqIIVYPnG4HUSqxV5k07+V7yy0L/2uhQ7RCyvAUj1/wnhpud97iue40m7m",
+	"G2ruq8hWOO+q+ufXEQ5MSBHL8W8FOKDqaYmuSVEGQRm99iNRhfR7/m6YevtuPxQLUrGYLCJ9rI9RZjFZ",
+	"WNNfF3KtmlmrHvJCTJx782fGsdC/fFakkwlAKZ4CN/aXguZYpeZFjNZyCgfopp1phvXb7rOmSbWcaJml",
+	"qKW9b1r/GB9vmDX0y+wvPtpuPn0RokCzeXbx6M/u5SMefSUvn83NZb18dl48fbKo885iLjsPEVcIrdma",
+	"KhWRKJHXGRTVrefxhNyUKH4NkKvPCG9YGv/DzOHdBGuXMvU97TeSyBRQxTF00LJOtZcxho+Rjrn108RX",
+	"GkPj5CtstCrCur9RVGXsxmgsKNza7+umzuhmK5DDct0OJrpJ8+ExCQJgtrAkHv1zCEs101pJ7PzjXUWS",
+	"svR8cba5cj9us80XJvUHaB9oQyKujBRwFSFSRjq5OgTCdk/QDcG2gjDjiOdZnwnJwdklbJ4QNZi6T83h",
+	"KDP9+srkmaD6KohA+AaT1GW4d/YLZ9exAy5osTC7P7Lfmr/O3AhdIDvDMp54IcalHm9mJtL648Y63g6b",
+	"88U4SdnaZP42cZJEZWyT/pe6tApT66UbqssSdiDbQ3+7OD1BZwZBS4Ne2PElvFTdpJaJEz82atDCTJbP",
+	"8sxq570MgRx4DFQGjaxVGyIGFy0HZ9jBOvefV51Nr8HC0fLvCpykIH8fv62HDHZIbz5gLh48jiJwDx4k",
+	"oI74Z/VLW2Xo2RYX5Te1KKFuZA1fLvFx/eNS2QOiTOIZzoBe2pKbWdB1hEDJY+uc6EinQ6uKkUhunapq",
+	"7lQjnOqkgkMcXzuDWDkOkQLSUSAJ+KwcpVkwq/u055g8M72cwFSzXXpZ60zg/taVIVF8J6FHZtitUJ7S",
+	"RWqi9JBjH9JpI6VIqa52cpU7Gg7OO6D0qbVQqbNvvxV4OiBsmSIrDvQr4ulbrxjOyvjqBjGmGYthNQwM",
+	"JZwSEAfT1ikbchNOiN0At+Er2onMywGvqzJOFc4SKdDl24uOKCI/fntmCSAz5SOBJkxY7x935Chn3ITu",
+	"lmetcxyMUgCJJGR5ajOvSS2oWcu05/3VQ7oOlGRWnaP0XUQqGcfuVGOSKy+n1FIDtFdVJdJkwBbTElbs",
+	"N7YP+6gSgVhGZCAFoZf5t8K48oDUIvp44LmX7j7b3Nyci4olKJdAQCN3hcW/83q+F9PVyGZhr6Yu/qn9",
+	"rbPwOiXhCZNWe4Wp9b/RbInq7zSfCu88B8UKcILHG4QmcDf4VTxAn+QMCHspcHluE8rl3Vkw2/uc1AsL",
+	"N4Jg1X6xGjsckdqZm8llbXIsm9YM60voSd43wLW4ICxvZ5KzQ+LeEj2x4uHQa83q7c7OyfRIPKonW3qU",
+	"PaonW3o0edSZbOnqKvmuO79SPoNBvTRqDNuuoGZ2ZJ5cTsZj4CIISaO/NvbsG1g5wXoNCS7sSOG0dm4a",
+	"7+xqm6sT6k8roWFtBe20U7a1hV1OBApWZ9KZOxeT+TrXUg3c2cWbsbOPWco8SLjKKmr/RO0/IxTbHzKc",
+	"5zbicf/sfddR7+dFyDyoUv2J666PunLg9aJjW/Mr/F23tbWyBk5PTPYq3wx633sIq9+xxZU48Vk7WCVW",
+	"vgOQ95/qN6pmE14QKWamZw1n7cM1L4SGIt3R/lnlhHQnxFWvATql1qXQ/JoDR44yaB7M0NT1lBiqXqZQ",
+	"kSH1HBI6PqISeDD3T/mQDEHeAlAHFKQ/BfG7vA1lFr6uB2KGo0DPP5/AjhemsRfOh6JLObzXdHtqui1Z",
+	"gUkYBWnlu9RGqcXsTxddjjkh1eXi++wqQq9/NzKCCQyxKip1ajFOXRaIhNFHLnTEeGX5Fs+vmGstDtpj",
+	"Lorx2IR6acHDrit2gcra5mkyL/TQJiLWSGLA6lued7aDlpBvCd7WmuCtoyLrImyyny+ZiErT21WnVYT5",
+	"8QzHE0Khc6rbybQxgTpoe7WvdDX7giuFu1mPyS2l+hsUIML5uk2A6z8pq+cDKbXvaE/5QQhGUZxibqzk",
+	"Lh5MuARLCaBhoS4dmGI7LhYOETknd/OskgwV8NCp9nxXwU4XRRyDEFeRkt69nX51tFFibx/TpN9Zo36B",
+	"RHl245ZMlBhQId3iBNLUZ9nTVSIV3KDb321CxpN+qnZqKsTo0pJVybGaiUW3maWlTFtxtUNw+fMIE1MF",
+	"3g2iOyRQ+zPDhEqgmFrzxYiDmJimYqm81O1d7rmFtJvOvRW3W4+qPbQbX7tddUzoNtZuPgA8u8NxDRah",
+	"VXvQaTfPTXhtPznUIb9zEMHEBdeT22iMcPlZHBa4AOKe+1efF9T6aaWEXkNS/sNrwSnBQh+/MD3MP7we",
+	"amYSm0rAbgZCTV7pqPT40j+b9O8mpmiIEw91etFy2OOB5rDcV2fbebnYdpe3butdTbM+3rPQabccO3h1",
+	"Nc0a9sKBtN10UAG53XhUgb3d+MY7iACCeUfTbn2Fw19VBWICsFev0Vwcf6sqPMzGcEUBFsBvIYuhwmBm",
+	"K+VQJvsjVmgaPcRJX4C0FxpswZzMJaUvkXslSlZu4cKsoPnzW7eiZsMJk6/tAptNr3ByUa632egK/jR/",
+	"P3b7aTU0kLFsWJQSeSXDWmpIXBG2h1Qla756TdfJ4CPYzbu5fA06kXtNQXuaA724+MH6BKIEQ8Zowzsm",
+	"7DoGFXI/ZKdNsn5vkPbB49avkg5bH5aDhq7VrWEgehpI1g3GudhWTEQJOF5Q6niByuf1aV2gxv0vm/2X",
+	"/U/fBbWtaqLwaspavs637CoSYpIMrKP0VfSkvhi/cS7bpqet41P9NP0T6NUw2oPiwnyccqb+mbkgMxds",
+	"/JYZ/WNj7yQD9IVRqFxWeenjpRD4aO9kz3lE7J0f7m28Pd3fuzw6PVG2ROCgf6xnw48ZlYQClYqfZjFg",
+	"2tOmKfdl6V+lOueYSxIXKeba3lS5YmKJMAfc01fJAh7tadcrvHECt3//yPh1Dx0WnOWwcYY5cQqoguJs",
+	"SMYFKwTa6ccTzHEsgSPp9trI/4UeX0Vvji+vInXq7y/37WEv5t3RKMWzz7IMhyTnPRSbJrO1sfZYwq72",
+	"kVIlKakW7nTcZxLQxvGCdiXfs+MyJQDDHcSF9Tz/vDEkdENMUD/+XIcjRmYUs5h4AvF1lcEYoyojCUoJ",
+	"1dIcSTrS7SmosmK1MI6yBkfLkFwsYSR4Hyip1Fm2lzU8OJWd0JVg1sCwwe/aypaBdDajsn6b6sulKEtU",
+	"KZWHKfuQamNveaa6HojxHQAlI/vxAGYkUI4PGiuJdlAzAfeSKfjHoDxZqzQewlZl6umAQjrtlR+ocH6S",
+	"2nhjs01XoTYD2fPmUxXzjEOGKTkm7BOlthlXIKo5uaJKNm0mdNRoF4S2afHgqXeqkVubrbV4v+kBiBe0",
+	"8vjAAnHGjDlbf623whLnWbyOSNiOaxtQJWf4bj8v1OO20kSeB5rx83ilX6vpW/X0rWfE1xzgQjK+au6C",
+	"+oD2Gh7emUgFXaMxZA7XvYSPKYYA2WqMJnoE7CgoV8No3CUmmz1GQwOIsCeEXcV7qr0TIDkBecv49UIL",
+	"eVQmaEScFbLKtoOoGUURtWuDneqiWCffGNJUv0NUl67j1yZNul1AeJnCgP1sdrI943SjfYytq7gwNNcW",
+	"w6mfYdOreOMG866UO8sQSL80XDORxIhQC0JHZ/TCcCFZhiWJa9RO3ctAKT9JMtdauqXYxyGgIF/twdjn",
+	"jB7e5RyEU45ravyG4xj8gk4PeInMi+b4p6UHKpmvlu5ORsHVLvzMfVA48fsG+dyoKZcLhjcZMX7AYkL2",
+	"Gc+RXjX68UMZ8bRtOwoEdEwo/Asmwv0WIvOgiH5U1setBeorquliXzTC6ZFdF3Xo+uQJtVnZXWW5IGbP",
+	"SY9an9gLD/vxQwB9K7EQkjFsGJe1DZmK6CsGuei7uUKYyz9LToBamIvNwbt8WEtJwRYns79ThtWGt2Ax",
+	"TImYnDEuZ+StnDAh+5L1x7p2hfb5tD4/VYbQD8f2AgGVfGoYaE+et6L8lc7bqabb1YOpfzkDWLtlI+dM",
+	"spilV5EhLOgqerH5YnP3xab7yP65IePcys8lc+7rZzb7Lz99t2v+83jjsYzz/ymS/H9ELPMnT/4aVNq0",
+	"Uve08yP8qaICFnHdb+oGPhwjxZhqVbOLhbKi4mtdGHBfprZ8uK4y+eFYUWtCjbRnE04lkJIbXU3Du89e",
+	"8sCNMiTRvCEmJ08wA5NyfnHtjkMQ9tUwYU4GsZwfsUl884FwidT/FDg9NuZm9HHv+K2h1NQkj8h0ktNw",
+	"5oF5qR5aF+O4nYPCMK42ScWibrlmnNx7v6rcXfpFtsoiPbihXw0X+Q2Q8YbOoquswaNBssvZqjVL7+97",
+	"ZeFjvQ4TzAwZJmm0G0nA2X/59SIjF18fXZYIg2xhKnQJOFOmBa4+dVbs2tetLAG/1If49Dj02RPrB2Lr",
+	"oijAJKAM+sa72Qu9YyPjh66t8OqFtMyBUUWYAFaH/cJUuE9JDFRAxc1GezmOJ4C2B5utzdze3g6wbh6o",
+	"mGz7rdh4e7R/eHJx2N8ebA4mMksNby/1cTWAtHd2pB6LshqnRUJb013hYbQb7Qw2B1tVcuN/RBueQ78t",
+	"tOPcUlRzzkKlRPdNVi+M9quPL8zHVW3RyiWv9Fg4SsqPO7+MDHqBkK9YMm2U3fDu+cav1knEELLVRLHO",
+	"RdzX0dyyzBxEztS5qNm2N7f+0NWFjkRrf55ubn7dhZUlEFureIUTVC5SrWTrj1rJe4oLOdF5PixQdv6o",
+	"pbxmfEiSBKhZx8s/ah2mRUnfKTGP1dPtP2wxl4yhYxUbcu6ozX0vevbHHdKFeQPe09LHy/BpeKzt1J1U",
+	"Mvqkus2gohv/UNT/XisFQIaUfzgxglopynRe/DYxfQNyFiWtYqM0ZzlbUptPzJXsNDbul0SNYEUa+7zp",
+	"/zSpZs87rqaoVVDyWwE2aNDIT59aRHbzT0RkT3/8RtU6qNrTP2odpQfIN3q2RnpmuVtLvDZcjcNOKvYG",
+	"pI1ANh2dPaWbDXyj/PVNZ5OWZVlydVBabMbNyUUzXGQ9FOv+vhdaVIqFRNoJqFyB9XAtp9WVB6p5g8Ul",
+	"Z837u5NFeySdNHDbXPjmVUReuuI/FZn8I8kTqujTH8f8/XnZPo8qGaIRJkGVc3yOZRzW3DutvFes82Ae",
+	"HdKf1aq/rkaHfB5Jr3BdNOfTMgJxX0/93RrOsJbpZyFx+A8mSd/E3m8M4nwK/I1DbHCIqItFLIlxL8qL",
+	"AMtnPDKWJ7jnJlfWmkmu9Vf7I2juGunaNxL7z0hiv5G2xbm6qrD84mYG2i41Pte+0Pri97QrtCf/M9gT",
+	"Olb1zY7wzY7wLyJK/qn5qRbl66SI80wGStm2JFF8AzJEEZfiurrnW6td4A/Qdi1EGb8p/7/Jdv/StOje",
+	"FK12xMA4qGzgnGzcbJk6cngcohOnjtLoyKSGbKZdjCwhsIzgfW/2CN10xh+svYX7T/f/fwAGu+4EAB8B",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
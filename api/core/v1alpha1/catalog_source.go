@@ -0,0 +1,58 @@
+package v1alpha1
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ParsePublicKey parses the PEM-encoded public key that verifies the catalog index signature.
+func (s *CatalogSource) ParsePublicKey() (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(s.PublicKey))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// VerifySignature verifies that signature was made over data with the private key matching the
+// source's public key. ECDSA (ASN.1) and RSA (PKCS #1 v1.5) signatures are over the SHA-256 digest
+// of data, as produced by `openssl dgst -sha256 -sign`; Ed25519 signatures are over data itself.
+func (s *CatalogSource) VerifySignature(data []byte, signature []byte) error {
+	key, err := s.ParsePublicKey()
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], signature) {
+			return errors.New("ECDSA signature verification failed")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("RSA signature verification failed: %w", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, data, signature) {
+			return errors.New("Ed25519 signature verification failed")
+		}
+	}
+	return nil
+}
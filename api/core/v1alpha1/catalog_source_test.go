@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestCatalogSourceVerifySignature(t *testing.T) {
+	data := []byte(`{"items":[]}`)
+	digest := sha256.Sum256(data)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSignature, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSignature := ed25519.Sign(edPrivate, data)
+
+	tests := []struct {
+		name      string
+		publicKey crypto.PublicKey
+		signature []byte
+		data      []byte
+		wantErr   bool
+	}{
+		{name: "ECDSA", publicKey: &ecKey.PublicKey, signature: ecSignature, data: data},
+		{name: "RSA", publicKey: &rsaKey.PublicKey, signature: rsaSignature, data: data},
+		{name: "Ed25519", publicKey: edPublic, signature: edSignature, data: data},
+		{name: "tampered data", publicKey: &ecKey.PublicKey, signature: ecSignature, data: []byte(`{"items":null}`), wantErr: true},
+		{name: "signature from another key", publicKey: &rsaKey.PublicKey, signature: ecSignature, data: data, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := CatalogSource{PublicKey: encodePublicKey(t, tt.publicKey)}
+			err := source.VerifySignature(tt.data, tt.signature)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
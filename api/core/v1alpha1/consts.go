@@ -11,6 +11,13 @@ const (
 	CatalogItemDeploymentKind     = "CatalogItemDeployment"
 	CatalogItemDeploymentListKind = "CatalogItemDeploymentList"
 
+	// CatalogIndexMediaType is the media type of the layer that holds the catalog index in the OCI
+	// artifact a catalog source points to.
+	CatalogIndexMediaType = "application/vnd.flightctl.catalog.index.v1+json"
+	// CatalogIndexSignatureAnnotation is the manifest annotation that holds the base64-encoded
+	// signature of the catalog index layer.
+	CatalogIndexSignatureAnnotation = "io.flightctl.catalog.signature"
+
 	VulnerabilityKind              = "Vulnerability"
 	VulnerabilityListKind          = "VulnerabilityList"
 	VulnerabilityGroupKind         = "VulnerabilityGroup"
@@ -20,4 +27,13 @@ const (
 	VulnerabilitySummaryKind       = "VulnerabilitySummary"
 	DeviceVulnerabilitySummaryKind = "DeviceVulnerabilitySummary"
 	FleetVulnerabilitySummaryKind  = "FleetVulnerabilitySummary"
+
+	VulnerabilityExceptionKind     = "VulnerabilityException"
+	VulnerabilityExceptionListKind = "VulnerabilityExceptionList"
+
+	// VulnerabilityExceptionAnnotationOpenVEXDocument records the @id of the OpenVEX document an
+	// exception was imported from.
+	VulnerabilityExceptionAnnotationOpenVEXDocument = "vulnerability-exception/openvexDocument"
+	// OpenVEXContextPrefix prefixes the @context of every OpenVEX document version.
+	OpenVEXContextPrefix = "https://openvex.dev/ns"
 )
@@ -19,171 +19,210 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PcNrLoX8HOnipLyczoYSc30VZqryI/ohO/VpK1VTfybjAkZgZHJMAA4MgTH+1v",
-	"v9UNgASH5Lwsy3bCL7aGBNFAo9EvNLrf9yKZZlIwYXTv6H1PR1OWUvzzeDxmkWHx04QxAw9oHHPDpaDJ",
-	"ayUzpgxnunc0polm/V7MdKR4Bu97R71XgpExfEekImbqfiRMa0InE8Um1DByw82UxGzGI0aoiAlP6YSR",
-	"SObCaDKWilBycvlk2Ov3sgDe+x51A3uMn+KjKnT3gnBBzJTrciTlKCZK5hnxPZHRHEfpwI2lSqnpHfW4",
-	"MN8+6vV7Zp4x+5NNmOrd9ntjLmIuJg3AXzM1iPmEaUN8I5zpqsEAYG5Yil3+l2Lj3lHvr3vl6uy5pdm7",
-	"zBPBFB3xhJv5M/j01LAUBuWGSZWicxwkQHhJU1YfJS4qSZmhMTV0KGjK+oSlmZkj5luWbFjiQhvFxaSA",
-	"Au3qUC5UzsjNlLmpK3lDFMsU0zAht/SaCGmIvBF2GaiFG0AaSZkwKnq3t/2eYr/lXLG4d/RLMLtwDP0a",
-	"eQSL9bboVI7+h0UGhn+c8UumNA54cfzHr0/dOxKzMRdMI2Zm9hmLiV0TIsdugn5yFDqAx1QQC2pIzpmC",
-	"D4meyjyJSSTFjClDFIvkRPDfi940MRLBJNQAFQHVKUETMqNJzvq4VVI6J4pBvyQXQQ/YRA/JC6kY4WIs",
-	"j8jUmEwf7e1NuBlef6eHXAJRpbngZr4XSWEUH+VGKr0XsxlL9jSfDKiKptywyOSK7dGMD3CwAialh2n8",
-	"V8W0zFXENCwTE3kK6zE7oEk2pQe4HHwyNZFJAFrx/O0i8fR77wbw9WBGFVCghm7K9bgsOywfPvVdn8rL",
-	"oON3g4kc1EjzhBqayMlK5sXe0TRLcJvQgBxa5tHvXXMR946K7vs9v5GgB4EbrsfiCRvQLNMwEJ2xCMmL",
-	"6yyhc7sne0/iCSPHWZbwCAlGI40vMLsKeS7jCwEh3/ohLhL0zxz4rCaUWCRZginpFh4B6Z09Ob8gfpUt",
-	"bVsyDvZvSdFAjVyMmXIcTskUe2EiziQXBn9ECWfCEJ2PUm5gq/yWM22A2IfkhApgAiNG8iymhsVDcirI",
-	"CU1ZckI1++j0DISjB4Ay3cjiwuVdtgSzgxEz9ODfMmOCZvzfrxBnL5ihIREs68GR1Dk0hU8MNble9yPb",
-	"eJFPBhTkyCKYkBtVE2N0vaJw2Uz6B1+G/J4KQktiJ4alGbA4Sy+URParITk1JFNyxmMGMmJM88QAtxzz",
-	"Sa7sp5bNETOlhkRUAOFEuTYyRSaIAgeG64i5AlSGEuaL2WofQpTBamxBifCZpca7J6tjZfiYRpsql8eC",
-	"UPclUWzMFBMRG5KLKSMAjYw5SxD1gN6Yw6cpF9SARgmyM9eW1Qj+W85K3YwVvWqScN1AIKJRlXqV2XGT",
-	"aZ5SMVCMxnSUIGTg9QS+ckoVkIODgZLTi53eP05e/fOQPOb6mpyCFty03vbB2ovmkXsBn932e7niDQqO",
-	"x+Obs1PEhMyNV2/IbzlN+JgzZXHrHxcoJzuGTkCTteruLlI77DUWE2qQppPc7jqesuqEf8vpHFi3YvGU",
-	"mj01ZclgJKWJBr9F8uYQaImL50xMzLR3dFDDxgIt4ls7xTVJ7sIhswUd1gKoEs+QvFiXcsipiJIc+Jed",
-	"E1o2g1HOk5gpInOT5R5GRYUC8UW5YKrX73k80JSDZaAl/C1oJEVMB/bnLI2v4b8p7D1Fb3r93iRi/ttB",
-	"zPX1oOzybYj/ENIaSlkLBk+CXlqa/MNNo+X1Mc6u5eUpzrLtS4eLpY0uLYba3iLiWl6eIT5bXj5DNC+b",
-	"MuzlEj1vq1R4Qg2bSDW3FIgSDuy2Uk71GkQqfoE2hReVBOzFkH70XIO07le6ervpCntY5763hnfHIYCF",
-	"yXlRPUoatthJRZAjq64KchAcwHWQh8AESQJ6HNmhhTagd5GRyxlTiscxSPuSa2HrIXGyaWA/dgrDOE8S",
-	"MJyyhEYMO6++3wEtNGVqwuLdOvu3Oki7sDIqr6tCTXrLDhOzS6p0n2RSGd0nM5nkKdP9Qg/QfcJMNNyt",
-	"MM33Pfcd/Pn81bN/P39y+eQ5+iXGEvgc9gaL+f3+9/tH8A+uTY0f2omco8zYbDr/ff7qJbEfWosYtJgo",
-	"WHCSUUVTZsAsgDUyU8YVzJvHiIJAkynHAyKzSbI+ZobyhMUkllGeerO6TzIUQXSUgNlBUqquY3kjHENt",
-	"0JVul8uExyxL5Bz6317PLfuoaLyemiMSlw1qWzjQSlGcouNiSI6rjajWMuJgHYXtcetMqSZCFg4Vrok2",
-	"PElgZTSPmYLNFIwAug7GAxqnSOYhAHTNFU4gXEnYGvCEi5jPeJzTpIQnSEQ106B5K4ag0KnHNck1UMjC",
-	"dAPYbuenhRXgPgW8lRaCFHevtQcsssVFJlVl2M6fyMiD4NMH0AQ5Yh/fobKH7qCq3SHtp252OGv7O1yF",
-	"hQUYkjOn41hvaNBdZVyN1kFUuj4WfHJgi7vP3UCjko6rWlrpw2iH4O3DVihoBroZcx1OOZJKMZ1JEaMb",
-	"oAJaTLh41wh2SoVgSQtI+7JP+JhQgWtCEbVcGCZiu3VGjBhFo2uH1wADhUCtQbWjZvGFbAbsFta7dZvn",
-	"C1RLufCTrdKxZ/0/N1qTT7iZMkUoeVwAerrgJy0H67tqpuuLBTJdGPqa/PMTGb2zNldtaKFUydqxWEcI",
-	"fiGbp7nKwI0Kj19I/eWw3q4raZ5zfSfSBvqxCAfDA2be2EzfHd8sziiqo3u+EvxahxvNYrnhbKPzb34G",
-	"/k1YdOtT2sw5ZElh9WZRzJkYm+2U4EtEKqiFaG3gmWKFLUjlucaQvMaFj6yKA2IduQX2xGJiXbH1fZQy",
-	"remkgc8Wwtu1IOxdllCrMt9M55aWeAUG6Fk3KDkkiSXhQhtG46pgvMDPYOyVb4fkjWYEpeYgS3Idftwg",
-	"INAC8ipviz8rFBLBF1UE7gRWFGiRuw1iHAe0kt96RK4giw/jnEv55Sfhklvxxo4j/hk5InYMRJkkr8a9",
-	"o18+4DDq/aJvYx1zwRSsZ8QSKSZ2Kc95yhOqgGehNylD34ogP+cjpgQz7pR4HaNiAWd+UHW0vF3c4i8c",
-	"KpHHV8+f/FE1efIObABNSkRYO9dPT0cy42LS6KJYPA3ZmgHB15VggurJVl1ENfAk72xe4sC2zhkk9/Ls",
-	"odIteYyDiIkUEftb3buvrQN/xgij0bQ8ceEiZhnYUsIk8yG5mGdsyZGKP0gplv+X9x65oQsaDyeKQ4FM",
-	"yZSZKct18Ccu+qZ8sjhhusUDhVP7+UGdeUaBR3bNvgsnLpqH1jG5yeehm9RamKG6s76SXHwFnYTxBYvE",
-	"8dOS8yk9lTfCOnIsjcDCcTFp5o4Vf9wblTQJOnENHIG9c8ErlU8aO53KlGWNmhTwIP+WvDl73mi2k0xJ",
-	"DLRp6ptHTTYjdCUVQb7x5uy00W6ELxGeRxcX5M1pIxB3aK0aYsLcG4CW5aOEazDmm8DtwHJTMce4OUZT",
-	"XJ3dRnB6KpV5HMKpcQMyUpyNiRRskHABpn7xugl6M5g8y6Qy7UvsGpScFnEaLjfib8IMKhtTlmTDOzjf",
-	"9OeajnE1McMZ5QmSum/j3JEnXERcCGo4SWXMEqs7OxXX8stM8ZSqOQFh1Sf6mmfaxhVogz06R5N7gyFa",
-	"KYs5NSWwRbbnPkEHve2l1++NqTYg30q2604aHGs86ukpPfzm2yP6kMXffxNRNto/HI/Zt99Fcfz9OP7u",
-	"0aP9b7/9bp+y7x/G3z58GI0Ovn10eBjv77Pv6P+JDg+//+ab0aNv40eB2q97R73D4aNHw/1ev4cTgCEd",
-	"Dh89HO7DWAofCzz7ZriP6kI4+tWDnrn+a0AfItAKBGy3BW8PtO0qN28+HC6lZkAzKxSu5lNiFHlyXN24",
-	"TuaBoutlSXgyJ20cokpvqILRxIrPUPKFUnDKkrTXBzEYJ8zgyzSTGtuDmrjxSR6M9NV5rzanp+VAFt48",
-	"9uNaeN5y0guvfrKjXnj6j2IStZ78nBZB4xSrCxDYXuspve0CtqbxFgRdO6J0b6zS5BWjQOUNDba65bBM",
-	"zbgTIV/dds1q6Pvl8RM19ZlmeCzllTyMozHShWRXwjyG5Gc211blS6kB1dA25wJPbYbFRhuSVyKZk2s2",
-	"Z3EQIUEVI7RgzYVy6t0w1RPPj8EJfWyFZVOWwQG6QvwdLDKGaohpSrNfWk7/39bcLHp98j1Hkm+wb0AF",
-	"AtlVCjNno4tScPXLI1mQ7F6E5dlE0ZihKBuiRL/m2RkVVtPaZFz2o/rgzlk6Y4ooeA1kVIjb4lzfjyHm",
-	"ikUmmdsQZzt+K32BDn4faKNA6bE+AVQkptKM+btFG/Iq399/yH44GO4P9wn+iA6GD+1KOolW0waKfbLV",
-	"+NYU/iAaB3ia2qwG9HDIvX7vYHhgpe1aYs/TRZ2jzDblkK0kds5SKgyPCgLjYONhABjZYcPJsE8OhofD",
-	"h31yCHMYqOhgd+GIsjiAUTFDZxMVcYHbiaLZtLqMfvstSOxZ4SgpmHSF663hDzgOD4MWfL5jmSTyxm+T",
-	"RWXQHWL75bsSwK+EjO05d0ArOB+coR8lsraEjuBP3KBSu0+HV+JNsA1ty9gZ4KN5qXvu2E2+63XOnTRP",
-	"DM/wiVRXoti7ZEcHu243CDlrEYFhrM2VcLEzlSAYb8QOr8QSF8j2/tdW3+u9+1039rl2/tY/m791ey+f",
-	"8/DBm1Gjjy/kSMOCJJElFOqOtUITGdEkmQ9SKuiExSvOKu7J+bOdL+Xe3CifyoPyKZ0nSwLczosbJFtQ",
-	"Mn4bBrThfHOlkM/YtxX5aifRGDppgTeZW0F/bHE1yw7XYteL7OHEw13pJQhG2MgVZuwEL6b+OD9nM6a4",
-	"mW+KU3uvVY5hCxguIkNOLp8UQWVSTajgv9slH83JlE+mTBuiHbQGnCpueESTphjb3IYZlpBcWwS55hVX",
-	"GME6ff/EJ9NN+k3kzTrdPpc3m/QKCniertPxC2y5Sd9CCrYWlmE98UhJSDztl9waDDzNwKreObk8Pyc6",
-	"AlG7v7smcCNN0xpfwOMF0DRSUusaOa0JKBfXQt6IzSbqPgKulQucWVwh2ZVwF511ONt+SdyODosFtvTj",
-	"1qQcdNOetaFyd79tfdhrcXu8YQv37aVuq93PwhvbW+3ol3k6sqLOA79B42KRS/gr56CB+t4+aLtvAxje",
-	"fQgv2AZmIm8+kFFsA9X29UFcZBuw0NOHcY8A6iJV47amhiSMgs0k2AL5FuMQd85otsGF6+zz4DWV1Azn",
-	"eZpSNT/DCGfNNuQ7nl0RbbtxkQHOB+iuCNyd2Zw2HrziXcTgwh/anT5C38bDkeMRqIY+y0MRTuyuJCgm",
-	"7P2DV+fWmdxsUsCbx+hebhuET6nxkUbQbMyfefscXv+N0OSGzjVpX+sWGwDfbZTWw6++63ct69bDaSJP",
-	"jBVvGvH6fsPl4+uvIO4qmdo4EbvgTflbPE/iwdJrIkeaqVkpb4tw9U03fhX8Gt5Emy2lygeRe4D6HiU5",
-	"MiMX/OIIFdPYDJfi/qNwhnu52968L1on+iHbop1yP3RPOEd4bXLb+MErXm18CytAjWEKuvzXzv7//nIw",
-	"+P7t1VX81e7V1XDp752/Hw12dv5+FDz7X/jnFzr4/Xjw/wZvf9kffO//xubQw9rtd7/a3f07fvT1Tvjm",
-	"a9tR5RG2/a/GO8JN+VbCY6ImvJanRJEU2ijKhfE4bTjSQfzuDsl5RiM20Cyjyl5/YyrVfRuXhuqK9CHL",
-	"QPJ40X/Hdef6jfwfzD/okx/65D998q9ddx7g0w81nUP22gZXXeVfXDPb4D//evsVIPPt1w6rb7/eKf7a",
-	"/fvOoMT0cIBPrq6+rj0jH6HT3a82WNJt/EfOcYRucMVMrtzVV2RVEU0Sd/QWS/HA+BYSbzjZ/XmHJwKR",
-	"jJuIMZ8Am2Yx+eni4rUfArQtT6StftEn+4SP8fqjroubh4eNKm53NnCnZwMtNyyOayk36t7Z0ktp8YgM",
-	"oi0EUTGqm93AKY2mXLBWUMWFjgKAvXWIY7jqPaU8yRW76hVK66kbkCUBrl3yNYOHj2jjVa6uFAETQ3JM",
-	"znCYJEqoArGEmXWQjN1kkYxHOewvn16tPO5rFsbFLm/cyA6XJfLIK8GIHB+Rq955HkVM66sekSqc6Ucn",
-	"G52xaEBFPChv5mx+oc9N3LGJggL6S2+jVNSRTZPXFGEcFW1y5+Tyya63a4ckhMDdcfEkkSPrwcWccSp2",
-	"e/1C5drw8fxvsEZzYo+rDTFMUGEGNrFeEAV/Ac+jub09BJLUn0E33AXF8yj2DliIkvlkSm6mPJqWcH7L",
-	"meL2LuUCs45nXEs1P23ggpdMxKCxuiahemUzNxWk3kSpq5M/trrpRm6Lnlw+GZKnxUX7YJL2QpZTZw/+",
-	"hqKgighufGIhUXNWhGYJUbkQNgLCWTHuSr2Fb7sOfSeDG9iedTBoR4Se3ZupTLZyu2wtQGesaRmBDhc1",
-	"Y5ja4HD/8NHg4PDho+ZDt2im9XkkVZNH/fL8nIyoZs5HXieHYprjRFJTdm8XoxbXVmNnU6lMYTc5rlbZ",
-	"iO3uidXekR17YU6RN2fPd0s5XKWybfwfae6PERY9Iev2r3XeZOq8DLMVFPsRG7sFPQMNiZo+eXn5eNcu",
-	"SOFvW9OJcrdaz7HdnJcrFy3h4rr5mNql1wQCjjHDiCYZnbAheQ3qEqbfdNMm5yzK0dbOpDI0wV3r3zmE",
-	"cab7Vne94RqDJl9ePm4+2HZH1vGxaQpPc+h3rTBn1gLCC+oHLW0ADRpleXDQsbDc8H1iU416JwKGK4WR",
-	"yielN/Qn6w194b2hz9Eb+tJ6Q9/UvKGb3KNHnhKMdaWYxUS3G8taWGN/EON4qBS4R1NUTmBD6T6h2lkA",
-	"VkogBx/oSGbuTm6dUVelN8YzeX1bD8kTsEy9kzo4PgfYdhNzUcpSHOUNtfIYJJUNwNow6fHCgeAy6eeD",
-	"1XGGX5b02CzvcmHKBZMGhIPeMi+nf8dpl++QDTbzu2c+X3TdVKLvTtrl60/uHGVRzrqdQZOkQN16wjal",
-	"714vY2rPbQZj2sjbVkHdksn9U6rwqKgBSpEXvMi7/Wl534oE1S2Et6XZATvBcybnOPYpqkL1oh84tgF3",
-	"3Os6znPj74aiLMVMiXg34HMyBF42Kei45wvl3CboKYa+Bhv8ArXXMVfanDMmmnboP4vk7F4IYfOFs5Zm",
-	"LC3doFuotO2q8Rkb65X6d3BAVPB9JxDWYP0t1xFLrn5POnSntjYcHDhe7p14yMOqS/xRuXhIyxW+XRLn",
-	"eox7i9j513TCBZ56+KD5erdB9OhORXdt8jHsfvRg+3LIS4darGDmDuW3VMM+nQr2nLcwrc8nmr0y6FNH",
-	"rhuS4Fnh4TCSUEFenQNh3Uh1nUjqy7aMXCw5mEutysC6hVsq8pm9owuhACE/z6gNHka5vlPk0BwlVBui",
-	"aMxzrDuybrTlNq6e4ceTf02MqFftfI1VzzbPsf5jiL9KgAEwX6dUI7ZtnteyWIxTtu7wAG8tC7LgJtWV",
-	"dyEWn9Ir1sYlmRo01AJS8kYvrYy0Nqes1m9am0k2x3Y0UNQXor5sZxJXPAcVx35z5aYPt5XX08TXHMVS",
-	"TevDpdMG9vemiLxXUzwQoQ1W+ZpS9e6VOlKpr/QpdLW7VdM+1e3JJsXtS9PZFiMtNyM06xSuOqtdgb3R",
-	"fHGTMsyPD2jNEwx2sfnw8TZ7ePELCGF3q/tIxTWkxUju8vC0OoK7uaqEN5TuGOTyW0zP5c1dA1x5wcnd",
-	"a7pjsCvuPlVKHN7btafZYnhE6eR10+cF59p24quvQ1Xn/jnfhLrD6OMneE2z6RDMOyZLNfXO9P+F61tL",
-	"L+w3XNT8EF23PYR5PVd/OI6mpWm/sbp1os+iix+pbsp8tE6itdY+bdq1phRba8TRF734i+66sAUwHkYw",
-	"QnUGYtxfA67fDeaaHL8+JX7phkuRiBioLTs8JcWFX1A1cowyCzKDlMlLEVoYembTweAtKVgedIrEcXiQ",
-	"gumQQJGtbwIwTi8UFdoik7cl6EcjFn2pLn+AG6spvmWxDQEBpLm4P3TPoFG0iTHQElyJCQNIEe/ok1i7",
-	"3NiAI790dCRz40ZcDK9Rx/K2zDMmXCRh8+yHRWHaSdGyLJpRYgOsJNBTwHCLSZ6tHQvVHui5gxf+d4lt",
-	"UWaa9DAf6LVmul7hxla6bSnlWEQrNpDRmrGLq0CuCAEt8NC3gRRjcqFy1idPUVaQN+VtPG/Iwftev4cN",
-	"ltlrzbnsqqNzfS089V0vPC4gLZv1igx/BaXxMCA6mN1xlik5Y8DsL16/uGQYkBtjxdrixWMm7LOnWM2o",
-	"3hQDaLlNCFn54Znca6o0Nj2fiwj/uKQJh//PgGPl5lS8VnKibAHkN1mMGxTaZyzyTV+4TEKvbgRTuufv",
-	"LD5mkUxTrkFywUfrLcwToWSSpEyYMxtKHsy39q463RPghmNMsH/OJwCz3kVrmwKXrS0KJLe2qA7njGVS",
-	"cyPVvBH1gPHWF7X1CV8Wa2WvMLlVwB9Nq2ZXI1g7+yBcQftk3XVsIPvCVm1MkIQZscvENZ4HW8ar58JM",
-	"meFR4BrAHIBTOmNhtEDCtfMJz6jiMtdFCL672UGOS8s9pXPswBqZjqO8LxN094kf2G1jUhHDRd6wf1/Q",
-	"OZaSY4Zwy7dyzRT+piThKTe+IFMZxWtLPPkwMFtmuywEUQgfFGAKL5diCBliKIjOxzo0PqZfZvS3nBUV",
-	"u0c4DqxBhO5kL639dQzvIw1ubgDmrfsPNR90FxkJw1Sczax2INg7a67LcZBYsUD3iUWTq8MlhebagE6F",
-	"fcGw3OWPTFoa9yhzM63mLoJ5R1MqJvZ0EVFgphTrc7EbknKRA7pwTTOqNYstSvyK+3LqtkZrEXSHLnSb",
-	"nNdmKndL61B5w5MEhmj9/RFNPKYcpp2Bi/5S7/Lvk1yga3EuczsexSLGC1Qaec2EqzomCFMKpmMFZ8vd",
-	"kNQW77CJ1fKm0hkXFYrS+UjDwgpTrR+GiLfRfz5Y326fINwC2xSnF/a2CPNPLbH4XFGxjeFEfypiVbOE",
-	"RUYqjSXJFum8mIcfVHGH3hfsc914pCdsbMCkdXXgZMqNYTGJc3TQaaY4TXwkemWguI5pljDDyA6zBaxG",
-	"LKK5Zi7AHaYeTXNxDT3J8q2PxDdeE8ZGu+V8FHOosxS4OCc7Ea4/ZCb+epXEmq1I47OD4cE3JJb+MlgA",
-	"w1J5UWUMJlFUTlmkG5jZV0wbniKr/8ruNv47c7mUZALrh4M4wWtbmuipzBNbg08x5JRtfdv6Ztr61+0P",
-	"PEBd1wWxUmEMykjUS8eUlRX4ogChSUIy2P14665RiNg9wX0iQ/jCcTHk365tpBg1TbkPhJCGFkmm2vLi",
-	"rsiEWza2lsW84IUu4UK9miaMx2nh2tA0a+YLhTHnHNVgu9ipxOtbazFL2Daw3AbAzzeBN1liqB0Ty92i",
-	"grtU3PSBPRwYcUXNOY1JQ9GuH5LXMssTasp7SLaQ7ZCcMRpjOtV1vbKYfHNpWmT6zqdF/vbhunmR3WHE",
-	"NZt7zaZWf94LdhdvY4W+ywQOP3dshAI8tfx5N7zqWiMqsVbpPtseOgimdfhN07zkjWg66z4OT1GoIfJG",
-	"uLzX/jnoduQKPVp7APuq115otSw9eNlWp+9YeK3IIdUW5vMH+TpQPR643LpByl7kkCKY9xqJ8BYZ2Gtq",
-	"oqkzBWB8xXnWBgksZMvGK29/GgncDjAVmos0xlLdNtsr/pXKGe68aihHEH1HzbRp2bASLx6dM0WgUXO5",
-	"RCDUlmKJqDo555VUPgXtsOZ0kFnPDaPJn1BLqqfd4T1WCbbYGjGqmDrO7Uzsr6d+M//3Py96/R56RKAj",
-	"+7acy9QYjPCSatIUBQIzefPm9HGxI8I0eiFFsyCg6wXNnLOs0r6UWEMgdDzrFFiWhql5z+9JGMm/0Rzz",
-	"U8/4zwzmftu3lZh92nIbdAMiOukd9Qyj6f8dJ3wyNZFJhlyWPcIknuIbVNaVTMgFoynWxUkcDvTR3l7l",
-	"6xqv+qXaxdudps923emqlWc2IyeLEgpUOwN7TNAJ81WKXXyPHGPS4vJKSVHX2ceA6eGVuBIX3jfrN+vO",
-	"7IAm2ZQe7Jb+Xnww0MbGU3klwGoIioHOjBLLSGdm2LswCY+YO6twODvOaDRl5BBzMFTRdHNzM6T4eijV",
-	"ZM99q/een548eXn+ZHA43B9OTZog+XKDCR4W0H/8+rRSpsJPBCnR8pHeUe/hcH944DYHEvqeS1tZsJQJ",
-	"My3JiMNUruH5VZihuOAmQPn42XGShB8ibF9vG88JGpitS41RNATc2ihopzvrPDFB3gDLfENL1u2tsocg",
-	"+b9ZbPXA224PnPbtA/UUm6E7oGratGyywrZ3rIE2qG63/SaVxNo+1kiGlpEpLRI0f5zJ6TVLq3Fw5RJ3",
-	"Y10sTION5YtmTM3N1FUFaxoofnXuoN7jaG02775PIIsGlEt+Aii+ZuTBDw/65MEP8C/s2Ad/+eFBkWul",
-	"d83mBz/guh30r9n88C/2x+FVb7dtpghxu5kCKaX0HU/ztGKJWsorJhnax6Xte1H6IlCPtYZXO6FVPid8",
-	"XCVz9o5rYztdcDKkdG7tyxHzqiuLCdUB3XMBvLM06xFDrZTBU24qeKolDnE46R0d7O/vYw0K+3O/wTTD",
-	"ejt2UshaDvf3vaTxlevLAuF7/+OON0rga9YawWgmlGULpt3PwPse3SHQ4oyjButHGhOvoCHQg3sA+kbQ",
-	"3ExRWY8t1If3APWpVCMexwxPuh8dfn8PIC+kJC+omHsUawD9zb3M9typHW9E4ae0Ojud6ErBx9t+793A",
-	"qwcgDkK5etsvBO16QrYaT1eXql7mfgJx2knTTpp20vQPKU07SdpJ0s9Ckmay6Q7SCfqeCa0JybqMtE19",
-	"KRzrHWLa/Cjj+V1vGjvX0v1kVM5ua3v14OOAbUJQ3LOXWhByBRH+Fn4VV1GtSVWpCEptw8AcWx7JeP7X",
-	"Pe9+Qj8rLudjdNiflMW5q8DiyutFQI5zrobyjJlWEJPy3R30XwYZtUE59yFOW8IK9LlT74WpwkoWW3zI",
-	"Ap1Zr2kr+lT1/dbTqsJpQ6NqarUlzNtOSH1sIbV/H0LqRIpxwq2zvhOLKwzMqnG59979dbu3qUfXxpX4",
-	"Z0vNzrU8uYsnf01Cu0xXzOIJG9As016ZxuOb0hysSPJS0G5mb32AMfzZmal3b45+iJHWORQ7CXNHEubR",
-	"PYB8KQ15KnMRdyJmK8sLdol1hrSKi5MVVsXnJy/eflQz0abea6CLcqqI1TK4RQZxY/duXrYNt8HErFh8",
-	"zSZmXGuy1ILBRRhiiEi5kFvYbs2DmVTff8SRVE2g5tGoepuPNqLOQPojiq97t8m+EMNor372tmge7b2H",
-	"nXFrBR6wqKYETxgNXhV9q2ylxyv43RdgLLWMqCKjhs3w8b+NJe9H0uvbCaxT5/+Q/PDz406NDphneLNr",
-	"E6bybJne0nGUe+MoKzTkjq10bOV+THVqomlTfiwTTTcy1fGLPzxrwfsNMNM75THr+gsGCPrrzehk6T2Q",
-	"tY6dO67Xcb3OuPxAPpubpjxM6LbZiM+erXL1dErcZ+6QLW6cfXac9xO4gDt+3/H7zplYcybuxSxL5DyF",
-	"kbWGXzxzMczBVntcfubSl2dUGR7lCVUF43dCY5ljIOinEy/3Fh/yJd497KLyv5iQlHJTd8EpndvpMxSP",
-	"odCrCsmNT9iWhPU/XhI9vrF0A85ux9SdY3U7v1PFu0C4zQ7tlvCpZ21XULZiUhObAf4LORfrWFQXOfun",
-	"jJxd6zhuCdMIj+Hugm10h10dK+tYWadtfRHMs+mMDTOLr2cYni27tLoV98wR+JdxlPXZscd7vu/eMeSO",
-	"IXcM+d6vGtvjrrJuTKvJrIuCLJsZz43pALY6xeps546/dbbzl2k7b8Y9Qiv6M+QfnQndcbSOo/25DdrN",
-	"GNrZ6gxJXwZL+/LN2o5ldUZmZ2Teh5G5UC57eRqrmGvDRWTaimzXqmzYUrjjMYuwmBYWiQiKQNfzXl0u",
-	"DKcrYvAnTWT8FFFrJNFSmYVq4h7BuKyj1gBE+PLHeQVsbDNOw0tfeLsswBM8imZan0dSwfJk+Sjhesri",
-	"Y2PLdp/GDdV46jM4h4FLFTNVVAp2Y26vHhMz1TJe6DssFoS/8OE6Y+kCUD/vANRKWflnSuZZe/Tp8JMo",
-	"SMNPoyENP4GKNPyE6sPQ6g8H96SfnaZZgiWdWEx2qkx2zCjWvXelOJkABSO21SW5dgxhd/hJ9Z1hReGp",
-	"jL+u9ixqOg3az140Y3rvPfL42z2eZq5OV6NGdIZck2RMDbAYFopM/AtLy46wOKmiMc/9NZOTyydlNTvV",
-	"6I+vMIJTO4A1TNBqz2QH4Ln7JH14OTjcP3w0ODh8+Gh36AuxBx9oog1wdisIyOH+vtPcBGFp5iUumNFe",
-	"lSvLc+2EE9211XqFtMoTqBhY5ZMDCnIRt5jDVqh2V0o2mO+Z0/0coQ0coSl5gxqfU7WxsiMZsTFMn04m",
-	"ik18CVurBLKYXLM5rM+v2PZXslP51A6gTwKCci1/wGrle+ncUv+vu0PyqtAmXZ1W8usPv/bJrz/gv3+B",
-	"f22NSzPQZg49cfEr2SO/CmngrxtbvNfyDtzeH1mtrOxRh7qttEm/L2y5dR0qarU3iK6X0GmnRXZa5EfT",
-	"Ip3w6FTIToX87FXIu1XiXC3S4EJUu0er5shCdu1qVHMxSXxp00CoFrXLfSXYmhZnmf2GvizkrmGRWa9m",
-	"2N66W7mdM61zpnVqUKcGbaQGdX60T6wE3e9ZY6d4fTaK157O05Sq+SoHmtUirLQg7hvnMKtqYDfcTGVu",
-	"yJg51xJ8Oc6TBN1fwCjXVMbm525kn71G9kfVUD4m+29f7zMHspMHnTzo5MHHlwfo6dzSDne+ahY7UWCP",
-	"WEZz98dqGxzd03dlgmNnnQXeWeCdBd5Z4J0F3oWzdGpXp3Z9EWrX3VnhVvHa0givaWMfbIPfl0rWmeCb",
-	"75jW1e4s8E4UdKLg/kTBmsw/vLMxuOGxDSi09zSAm3nBsDpkseTq96NcdnylC3f5M+3x237P9mN1pVwl",
-	"vaPeHs343uygBzLddby40V/5XathPIu3VNGEX8guX1eFgj5AMaxOXjHQcDRqMXV2YvkQrwCqTv327e3/",
-	"DwAA//+oOHn9cCQBAA==",
+	"H4sIAAAAAAAC/+x9C3Mct5HwX8Ft8pXIZHf5kOyLmXIlNEU7jPUKSSlXZ+lk7EzvLsIZYAxgSG10ut/+",
+	"FRrADGYXsy9SlGRPVcoRd/BoNBr9RuN9LxF5IThwrXpH73sqmUJO8Z/H4zEkGtLvMwBtfqBpyjQTnGYv",
+	"pChAagaqdzSmmYJ+LwWVSFaY772j3nMOZGz6ESGJnro/MlCK0MlEwoRqIDdMT0kK1ywBQnlKWE4nQBJR",
+	"cq3IWEhCycmr02Gv3yuC+d73qAPsMXbFn5qzuw+EcaKnTNWQ1FBMpCgL4kcioxlC6aYbC5lT3TvqMa6/",
+	"ftTr9/SsAPsnTED2PvR7Y8ZTxieRyV+AHKRsAkoT3whXugoYMzHTkOOQv5cw7h31frdX786e25q9V2XG",
+	"QdIRy5ie/WC6nmnIex8qMKmUdIZAmhme0RwWocRNJTlomlJNh5zm0CeQF3qGmG/ZsmGNC6Ul45NqFtNu",
+	"cZZLWQK5mYJbuhQ3REIhQZkFua1XhAtNxA2320DtvMFMIyEyoLz34UO/J+GXkklIe0c/BasLYegvkEew",
+	"WW+qQcXoX5BoA/5xwV6BVAjwPPzHL87cN5LCmHFQiJlr+xukxO4JEWO3QL84agYwP1NO7FRDcgHSdCRq",
+	"KsosJYng1yA1kZCICWf/rkZTRAucJqMalCaMa5CcZuSaZiX08ajkdEYkmHFJyYMRsIkakqdCAmF8LI7I",
+	"VOtCHe3tTZgeXv1JDZkwRJWXnOnZXiK4lmxUaiHVXgrXkO0pNhlQmUyZhkSXEvZowQYILDeLUsM8/Z0E",
+	"JUqZgDLbBLzMzX5cH9CsmNID3A42mepEZ2a26vc388TT770bmN6DayoNBSozTL0fr+oB6x+/90OfiVfB",
+	"wO8GEzFYIM0TqmkmJiuZF7yjeZHhMaEBObSso9+7YjztHVXD93v+IJkROB64HqQTGNCiUAYQVUBivqVM",
+	"FRmd2TPZO00nQI6LImMJEoxCGp9jdg3yXMYXAkL+4EGcJ+gfmeGzilBikWQJpqZb85MhvfPTi0vid9nS",
+	"tiXjuqmqKdpQI+NjkI7DSZHjKMDTQjCu8Y8kY8A1UeUoZ9oclV9KUNoQ+5CcUG6YwAhIWaRUQzokZ5yc",
+	"0ByyE6rgo9OzIRw1MChTURYXbu+yLbg+GIGmB29FAZwW7O1zxNlT0DQkgmUjOJK6ME1NF011qdbtZBvP",
+	"88mAghxZBAtyUMUYoxsVhctm0j/oGfJ7ygmtiZ1oyAvD4iy9UJLYXkNypkkhxTVLQRm2S8tMG245ZpNS",
+	"2q6WzRE9pZoklBvCSUqlRY5MEAWOAdcRc2NSEUqYL+ao3YYog93YghJNN0uNd09Wx1KzMU02VS6POaGu",
+	"J5EwBgk8gSG5nAIxs5ExgwxRb9CbMtM1Z5xqIa3sLJVlNZz9UkKtm0E1qiIZUxEC4VFV6nlh4SbTMqd8",
+	"IIGmdJQBcbyemF5OqWKqmmPYC8RO7x8nz/95SB4zdUXOjBYc22/7w9qb5pF7abp96PdKySIKjsfjy/Mz",
+	"xIQotVdvyC8lzdiYgbS49T9XKCc7mk6MJmvV3V2kdnPWICVUI01npT11LIfmgn8p6cywbgnplOo9OYVs",
+	"MBJCJ4NfEnFzaGiJ8SfAJ3raOzpYwMYcLeJXu8Q1Se7SIbMFHdYCaBLPkDxdl3LIGU+yMgVF7JrQshmM",
+	"SpalIIkodVH6ORoqlBFflHGQvX7P44HmzFgGSph/c5oIntKB/fM6T6/M/03N2ZP0ptfvTRLwfQcpU1eD",
+	"esg3If7DmdZQyloweBKM0tLkH24ZLZ+Pc9b+8UyJ9o/HDhdLG72yGGr7Ok3bP57Tm/aPPyTQ/hGXbM5y",
+	"jZ43TSo8oRomQs4sBaKE6x31AjnVi4hU7IE2hReVhGnIQ/pRM2Wkdb8x1JtNd9jPdeFHi3w7DieYW5wX",
+	"1aMscsROGoIcWXVTkBvBwcaOh5gFkszocWSHVtqA2kVGLq5BSpamwE1Tz56w9ZA42TSwnZ3CMC6zzBhO",
+	"RUYTwMGb33e40CQHOYF0d5H9Wx2kXVhpWS6qQjG9ZQf49SsqVZ8UQmrVJ9ciK3NQ/UoPUH0COhnuNpjm",
+	"+57rZ/755PkPb5+cvjp9gn6JsTB8Dkczm/nN/jf7R+Y/uDcL/NAu5AJlxmbL+fvF82fEdrQWsdFikmDD",
+	"SUElzUGDVLhHegpMmnWzFFEw7EXgMSIzJlkfg6Ysg5SkIilzb1b3SYEiiI4yY3aQnMqrVNxwx1AjutKH",
+	"5TLhMRSZmJnxt9dz6zEaGq+n5oSkdYOFIxxopShO0XExJMfNRlQpkTCqIQ3b49GZUkW4qBwqTBGlWZaZ",
+	"nVEsBWkOUwCBGbr+yzQXPJuFE6BrrnIC4U6ao2F+YTxl1ywtaVbPx0lCFSijeUsg1H0wA5fKUMjccoO5",
+	"3cnPKyvAdTV4qy0Ewe9eaw9YZIuLTMgG2M6fCORB0PUBSRxH7OM3VPbEeMHuELarWx2u2v4d7sLcBgzJ",
+	"udNxrDc0GK4BV9Q6SGrXx5xPztjirrsDNKnpuKml1T6M9hm8fdg6yxlSuF0xU+GSEyElqELwFN0Ajan5",
+	"hPF30WmnlHPIWqa0H/uEjQnluCcUUcu4Bp7aozMCoiVNrhxeAwxUAnVhVgs1pJciPrHbWO/Wja/XUC1l",
+	"3C+2Scee9f8YtSZPmZ6CJJQ8rib6fs5PWgPrh4rT9eUcmc6Bvib//ERG73Wbqza0UMR4YVMrQvAbOeyt",
+	"MipiBm5SefxC6q/BerOupHnC1J1IGzOORbgxPMzKo83U3fHNKkbRhO7JyunXCm5Eu8diG51/8zPwb5pN",
+	"tz6lzZxDlhRWHxYJzsTY7KQEPRGpMrf/tjHFBlsQ0nONIXmBG59YFSehGt2PdiRIiXXFLp6jHJSikwif",
+	"rYS3a0HgXZFRqzLfTGeWllhjDsqN95JqI6VSQRhXGmjaFIyX2M3A3ug7JC8VEJSagyIrVdg5IiDQAvIq",
+	"b4s/KxQSQY8mAncCK8pokbsRMY4AreS3HpEryOJ2nHMpv/wkXHIr3thxxN8iR8SBDVFm2fNx7+inWwSj",
+	"3s/7NtYxF3TFekaQCT6xW3nBcpZRaXgWepMK9K1w8mM5AslBg2qyhCVGxRzOPFCLaHkzf8SfOlQij2/G",
+	"n3yompy+08BTRWpEWDvXL08lomB8EnVRzEdDtmZApncjmaAZ2VoUURGe5J3NSxzYOAOCp+rYQ2NY8hiB",
+	"SIngCfx50buvrAP/GgjQZFpHXBhPoQCeAtfZbEiMw3NJSMUHUqrt/+m9R27ogsbgRBUUKKTIQU+hVME/",
+	"cdM35ZMeH3ikGT+z3Q8WmWcSeGTXHLty4qJ5aB2Tm3QP3aQ4REPdWV9JrnqZQcL8gnni+NuS+JSaGg8a",
+	"qz0LZuMYn8S5Y8Mf91JmMUHHrwxHgHcueaXRJTroVORQRDUpw4P8V/Ly/EnUbDcxY0y0iY3NkpjNaIYy",
+	"/jaqKQbAYnaj6YnzeXQxTl6eRSdxQWsZyQlzX8xsRTnKmDLGfGy6HbPdlM9MSw00x93ZjU6npkLqx+E8",
+	"C9yAjCSDMREcBhnjQILPsdnj05SF8Su3b7FrUHNaxGm43Yi/CWhUNqaQFcM7iG/6uKZjXDFmeE1ZhqTu",
+	"2zh35AnjCeOcakZykUJmdWen4lp+WUiWUzkjRlj1ibpihbJ5BUrjiM7R5L5gilYOKaO6nmye7bku6KC3",
+	"o5hMKaq0kW8123WRBscaj3pqSg+/+vqIPoT0m68SCqP9w/EYvv5TkqbfjNM/PXq0//XXf9qn8M3D9OuH",
+	"D5PRwdePDg/T/X34E/3P5PDwm6++Gj36On0UqP2qd9Q7HD56NNzv9Xu4AAPS4fDRw+G+gaXysZjfvhru",
+	"o7oQQr8a6Gs3/sKkD3HSxgzYbgveHmjbTW4eDw7XUjOgmRUKVzxKbH41x6dxcJ3MY1pVHuEwMidsHqLM",
+	"b6g00KSSXaPkC6XgFDLjTPqlpGkGGj/mhVDYnmq6eSTPQPr8orewpu9rQOa+PPZwzf3eEuk1n/5moZ77",
+	"9R/VIhZG8muanxqX2NyAwPZaT+ltF7ALGm9F0AshSvfFKk1eMQpU3tBgW7QclqkZdyLkm8curoa+X54/",
+	"saA+0wLDUl7JM80Nb7cp2Y00jyH5EWbKqnw51UY1tM0Zx6jNsDpoQ/LchJWuYAZpNbQiVAKhFWuulFPv",
+	"hmlGPD8GJ/S5FZZNWQZn0BXi72CeMTRTTHNa/NQS/X+z4GZR65PvBZJ8xL4xKpCRXbUwczY6rwVXvw7J",
+	"GsnuRVhZTCRNAUXZECX6FSvOKZ/ApnDZTovAXUB+DZJI89mQUSVuq7i+hyFlEhKdzWyKs4XfSl9DB/8e",
+	"KC2B5s4ngIrEVOgxezdvQ74u9/cfwrcHw/3hPsE/koPhw+G+X15MG6jOyVbwrSn8jWgcYDQ1rgb0EORe",
+	"v3cwPLDSdi2x5+likaNcb8ohW0nsAnLKNUsqAmMpcI0JYGQHhpNhnxwMD4cP++TQrGEgk4PduRCl7ylk",
+	"CuhsojytcDuRtJg2t9EfvzmJfV05Siom3eB6a/gDjsNg0JzPdyyyTNz4YzKvDLogtt++15xKIFykNs5N",
+	"eXM9uEIPJbK2jI7MP/GACuW6Dl/zl8ExtC1TZ4CPZrXuuWMP+a7XOXfyMtOswF+EfM2rs0t2VHDqdoOU",
+	"sxYRGObavOYud6aRBOON2OFrvsQFsr3/tdX3eu9+1419rp2/9bfmb73AjdmO0G1fZ22PAImBTcxRr/gQ",
+	"T+Fd5QpICcXs+OcnZ3V+MAk8rw+Uy0ChEkjOpBSG40qgqZU1FT3YcQ1PuoJCo0o244l1czLd7kqM+1wK",
+	"qqcLfgI7g+sXuvokTJjScmaFBbkGngq55/rFXRiIgORHmEVcJqdPB8ATkUJKTk8eXxz3yfnFsdEITtPD",
+	"r746+MZiLzHqpZXj1yCNsLJeVYNvaogxCn9bUEwopoWMQDOfM2F26rxqX59jMW6iAgGbCjyFawGhqZyA",
+	"Podr1p7m0FDHV+yPFo5eVuc7BOuvreXeAkjhri07P1t7yZ2H3J+dRR95KNGHFUvHs1GZC2o+EKD65GbK",
+	"0JENBGw2TU45nUBKMpFQkxgqZOvhokRCLjQQu9GLJ+mePK/bOTLvzYf5qdyX8RkqFr7O5Snb+P4cnkuS",
+	"Ui+qW19bnB7sGyahIppKKVE3sF8bOnEbRSeC28ljLpJgvAUWWw+4loo1L9JP/LwxpctwgoxFY194yuPs",
+	"0Np5eO3WaUHVOU9L6VWyjCqN8nJT7fBixpMTB1gMaDOwaXPJ2vLxNMshAvcNVRYqD27jdnpKNQxMzxjx",
+	"i5EyumP6GKVEfNaccjYGpZeKEsRdFJDWOX8ADpLqVgHmJ5hU7ZbMsvIy/nzQuCbbZeIp3LLtDlowQqjq",
+	"cZdY1DA7V1Dh4uFjrVm1oRqyMngjgarYLvxzagseIKitIC3XF5hNfXRTRHF9DSdYzeG72QUY9UzPNsU1",
+	"djfLTVEqJtrUaKgysYWcUM7+bUloNCNTNpmCOcRutghTk0yzhGaxiykl182ZXFuccs26EAaCdcb+G5tM",
+	"Nxk3EzfrDPtE3Gwyag4pK/N1Bn6KLTcZmwsOa2HZ7CcaKFxgipxg1svG8oImmuycvLq4ICoREsj+7pqT",
+	"a6Fje3xpfp6bmiZSKLVATmtOVPIrLm74Zgt1nYzaUHJcWdog2U1Znl1tvyZuR4fVBlv6cXtSAx07sza/",
+	"/O6Prb8rUpVciRzhvq2EYl1i12GZk61O9LMyH1kV1U9+gx65eS7h67QQpogf7VbHfZuJzbfb8IJt5szE",
+	"zS0ZxTaz2rFuxUW2mdaMdDvuEcw6T9V4rKkmGVClMSjTJN8KDn7njGYbXLjBPg9e06hndFHmJmh1jteC",
+	"1Kb+N8+uiLLDuHQ6Fzhz9+ruztecR7OV8AJ/cEse/Qf+WptNIifHIwVc+9JIHjR/j08Ct5f2nl/YCGzc",
+	"FWC+tKn3Fghfh+ojQRD3gJ97Z5j5/GdCsxs6U6R9r1uyjvDbRrWw/O67cddyCft5YuSJF6xiEK8fbFsO",
+	"X38FcTfJ1CZX2g2PFT3zPIkFW6+It8k866nueG168JvTrxGCQ/TN8UHkHoowjFUZZmRH9YSKtd+GS3H/",
+	"UTjDvRSEiZ+L1oXe5li0U+5tz8TzAvir0/967Lxdm90eP+bE9a/cZWQniDZNy9EwEfmeKIBfw7s9VUCy",
+	"67JI9LSKYJaqrtjHcnTF2XCqtcEj1vRfE8E1vGtxg3iYXCOTYdpH+nCB6iog5uAapnC9x9Xe9f7QRMJj",
+	"+/RXlsbnOjs/83H1mfc7eVxER6KlngoZH8x+q+5xLhsGHXW5r/K4OJRZf90mNuRaDjGHygs/UswbplkO",
+	"StO8iLklgDcmRpcQU6pc3/U1R+DV1jdwsIS0X0iRlsmmlE0K2w3DeI6eqvlcVRxFtOjXWRWu3GFBkyuf",
+	"1ywkYU3tIULLbaTlIagmkE0qLq4mRyJhe7Qo/mpTqf7fw+PhcGhm9Zn3Qk6C70fD4TCeqk3VdHn22Yp8",
+	"sxM5K7TADAqWEDucJzq/Dps3ZnCUTYRkepq7yKKa0sHhV1/vRi9q1Ku/DXxBspASY21yJQO8qho2Mw7Z",
+	"KUqZ9UlSwOGh/b+HMeA+tBNdfWA2JDsnwBZIbpFurDvlrQpnmhPYEmBgjpi9qciraNvNdBbujA21caEr",
+	"Yz5KJP8qlUFXssQR3GhiM2es45QL/dYP3lxULGKFQC09FC4oHDmQm7I2zx4ijK0u1tfOX0tbZKZaXJ/U",
+	"/zJ5bql1CqUg3zJ+DUqzCSJnt5Wrl+otFxrUsv3EBr4GRX2RdWG8hrK2JkIaasZiKlfja4WjJfz31TwM",
+	"6x8HrAEQdo9zY2Su8SIMNGNUxXD5HAPGIQtw3Kox3/LM4Hl64a3lGZqLaGPoJ69OB4f7h48GB4cPH60Z",
+	"YXTZf0fv7yD5r5HKh18NPqnWIM2Q/7Oz/78/HQy+efP6dfqH3devh0v/3vnL0WBn5y9HwW//a/7zEx38",
+	"+3jw34M3P+0PvvH/xuZmhLXb7/5hd/cv2OmPO+GXP9qBGj9h299HC6PFisyGubExvNapsYngSkvKuPY4",
+	"jeSxIn53h+SioAkMFBRU2po/IE0SA17GQ3eT8Pe0DQFjdcMdN5wbN/H/AP9Dn3zbJ//XJ/+z65IgvRIS",
+	"S77utQHX3OWfXDPb4P/+580fDDLf/NFh9c0fd6p/7f5lZ1BjejjAX16//uPCb+QjDLr7hw22dJsAvO1k",
+	"c/8k6FK6el94Zk2Cics3TgV/oH0LgXzFns87TINMRBojxnIyAWWI6W+Xly88CKZtnYZvGXSf7BM2Rhmv",
+	"Ft0FDw+jLsouIfJOEyJbykocL9QZXcyKqdM8LB4LF2bfKEZ8THKaTBmH1qmqKhbVBLbUEsLwuvc9ZVkp",
+	"4XWvcjqeOYAsCTDlKs5rzLhGH32jXkd1S8QULDtHMEmSUZfmR7klY7dYJONRac6Xrylf5zgv0Z4ixyTA",
+	"HgTII+ZVAzE+Iq97F2WSgFKve0ZdC1b60clGFZAMKE8HrVrcOg4eu3DHJioK6C8twfEK3v19tUbvta2m",
+	"Zm+dbc+E9m9KEHiXQOFJsi5/6tia0WjfukPfq9XSDN4aiNf4yvhbeAdJqeFtQfU00irB8/92ZP7kWoos",
+	"g/TtaPaWpoa/UEyCZDxjHN7mzGvh6i3NzGGYvYV3TOkAT8su5M1j7sQv85nQL6plzLd6VQF8IlLYrOkZ",
+	"P7Wrf2EXv7yDZYXfwUmFiO9mxwEa5nufIVqe1lg5tkg5tTgxtLKeDh+v7lzdcwoHMWkAp7s+hjUk4QzM",
+	"3aeYZGJkszXwUQWZOrlwKRH62Z/NeZ5VxqsGTrke2JcngjIRl+b3ZGbL6xity1/SiBRLq3yGeipFOZm6",
+	"HNNqnl9KkCzmkaTpNVNCzs4iEvMVZk4T3yRUxW1p84otRh2GK19HaQ3Jjxw7N8+gkO+rSpTBIm3FIue6",
+	"PvgzGS8ggmlfeZsvBCbDEASRJef2ipDzebmak3Z+O3QYJx3csBQi02DMIMziuJmKbKsQ69bK1jXEttHQ",
+	"4bwV1bTZ4kZ9cq3URSJkLHvGpMKMqAKXD7NIDtUyx5mguh7ebsaCo2tB9Jmk3CpG0mbiLoBccfNIeDpI",
+	"V2uwhVPfx2rFdgh357Y65uj6V+DilLYNpP67PfY2mNTihFozRrtjs+qk8cLu1tpkk/63icLmpU9mmo/H",
+	"rju+UiXI5XitOQU2dqR2bvR8qvvk2avHuxaBVdR/zVDu3erux5ZtvFpJThnjV/Ekd/cyjjlaKRYHVqQw",
+	"0WnyQjDMdRZ+2eQCkhIjfiZARDPkJ/6bQxgD1bcW2A1TeN/52avH7RdV1BTSYx27WerQ71rZbN4mwtdL",
+	"2lVButXcdpv+mX0lyDWy/tJQfzqpczL+ZnMynvqcjCeYk/HM5mS8XMjJ2KQEJnK7ANaoshg96RtqAvFB",
+	"sN6zcvFAxwbQZ2yIgqrAL834xFyadJeraOKYhzSvLIyF7LsAIp1Y0cS0whJQYCpFxRhNBmNNRIlZfXPZ",
+	"acgwrVGSWomk7ENN0eYZU1qRkuPjWnGm5mxVSOfuw2/yPFHLJkRfK0quwcql/f88/M8BlugSjZeLnJDr",
+	"VQLMNETYCiZBmXPRO9w//Hqw/83g4f7l/v4R/u+/ewuBgN6LH08uDg7JmGVOceOGlEhBpcJyVGZflGeO",
+	"6s/VhVsTk+DupvE/HhrE4HYZ6Mb2jbpeCLim2qzsnKmrY7f33ftKv/X3leJn4k4fuIlPEVxFO4fxhpzw",
+	"vFJUsPJ7s46bMbsrBSyIbIXszTw9EugdpuOD+i0ac2cs4nxcWhMwdg3B3b7zRN56K+FOLje01Ap0o6+/",
+	"MVvcZ28fqHm9Pd7u/m67L5l/85cmq/7dXfjfzF34Fm7ppe4GR+ay5kWUR9mV0boo1l4R3L/h5etKqlh2",
+	"3tz7A5sT8hxL9k+ZruZM2MylWXvfhm7hwn0s6IwKjiumZHxDsuSbW5OX0zk7skqHcbk6u0uhAGmhWKMM",
+	"/rqEsPkd7/aBgjt01onW0IcrLdmWyI7dA4l7Yc5qD4zbvWqoOQdji38mp++qOlqHf+qvqqsV6MOt2W31",
+	"DiktCvto2gz9Hcfh2fDvseGBKZicVRtacs2yaicz0JvcDF2Rl+OvBkbRP4eQR/vffL0SI5WSvoVehl1r",
+	"ZX6rMbArVkpaDGAsHW6+/YLK4S1hhNIDOY/gDfiqX+S86V+HTXKgXIWK3XwmlvdTN312JDSC4oPUA5RW",
+	"gqKZPKWKjABq67lPSlVisQRLg9RkLfmnu2zwwNi8oVcigL/Xb5pja8ZP2rHVHHtJw+a081uAr2hvHKcw",
+	"Dgd/Yc35nwVHL2IupEdun1DlIu12d1B4DJBmLDdbdHIvegsq5caUsaY1cwzv+Qvu5iSM13EIhPKG2lgG",
+	"5uq95gu8c2XMYO7i5LLIgS8JY10pX5TnfbNH3auUiWDRempjPrN6+Xf8pvsdatpxj+wP/jH6Rb2Uvjtp",
+	"j038zd03m49RuJNBs6xC3XqBipy+e7HM7frEPo9Oo97XVbNu6Yb9p5DhlbrILMS/81896v9pvbMrXr9v",
+	"IbwtQ7bmJLjp/AUb//5dqLj2gwtABndVzrvLkPKF57VwGVPY67MKoj6LBTfxzFeBTaSCGvQ12GAX+bvD",
+	"yN+YSaUvAPgSVdwzb2omNie7eVsuvn9LWccW4cB2Q/Acxmpl7DK44ldJJDvwOkJpZZLyPcUfu5Df4ol0",
+	"X6o0PuSuzS3+qPIlpOWGRKmJcz2RsoWL9QWdMI55z1FnKg4bFODaaWjVscyR3Y/uda1BXgpqtYOFu1a9",
+	"pYL46ZTDJ6yFaX2mjsszR663CrpwcxVeSHIj5FUmaOpY+MhV8aM8bVdTVmZk2Q9NzQHe0bnL3CE/L6it",
+	"v4Yax071dPAIy2dJmrJSESlu1q2Xs02azPDjyb8YI+o1B19j1wu6cW2v70L8Na6IY3aBVfcR2zbUj//E",
+	"ML5TA+8whX8t27biJs2dd5fkP2VGURuXBDmoyLVGnxQ3roqKRSRi11KJTadYm1N6fxDegV+fScZv50co",
+	"6gtRX7Yz1hs+jUa6ZmO3Ktv29lb8epr4mlAs1bRuL5028Axsish7dRIEIjTiL1hTqt69UhckYX8iXe1u",
+	"1bRPFSiPKW5fms42Xytnw2gzuqubbnQrRozCNndIgYzLLDNoLTONLocdvD3kq4742rmGEHa3qijpD/VC",
+	"La46Jb4Jwd0UmzQM5K6nXF6H0pSfvOMJV5aotPzxrqddUb2ymuReC1dez196qd3Pbvms4lzbLnx1Qcvm",
+	"2j/nWpZ3WD/qFIO7sfCcd5nWauqd6f9zBTiX9Y2V2r2NrttehGq9IEQIR2xr2ot+b/2+cTXEd1TFHnxb",
+	"533J1jHta5OxlwXXqIRWjeIzJFVlC+AtJw6EqgKSugL3Ynl1psjxizPit264FImIgYVtN7+Sqjw2UVqW",
+	"mKMWPIhUv9mMs4WJa/YVLHPkcXvQKZKmYYjHoIEYRXbxEBjj9FJSriwyW+ugm3ZBMfQKVl31hdRm/Bmk",
+	"uZu/BhKORtEmxkDL9Wp8qoFUN55dO2J4XkJREfNbR0ei1A7iCrxb1UUfej1pOF8ZvYkNYyUp0Gi4paQs",
+	"1r7h1n7VewefWtgltkX9wK6f84Faa6X1leqtTpmruzB/zqr7yhEyWvP28qopV1wCr/DQtykeY3OpFPrk",
+	"e5QV5GVdT9UbcuZ7r9/DBsvstfgTnk3o3Fhzv/qh536uZlq26hUPm/pmhIV5scHqjgvzUAgm3Vy+ePrK",
+	"vrxj/go+PAZufzO342NN8Qo9s+/gNv7wTO4F3gjp9Xum1j7+4xXNmPn/c8OxSn1m3mycSFCGOF4WKR5Q",
+	"076AxDd96h5Qe37DQaqerzr7GEweL1NGctlODRDcjOtt1yk3mU85cH1u84wDLCx8ayLhBKTLFYMLNjGQ",
+	"LA7R2qbCcGuLCvWtLZrg1G8aRTfEYKX1w8KuhR+rHbSlKd3e4B+xvbR7FOyo/SHcV/vL2rvrkn0b0Acv",
+	"OUAaPTCVlRvNsTdfgrRVz70ty1YzrqegWRI4FTCfeUqvIcyAsLfBKE/JNZVMlKoq3+GqwpDjaghMfjcD",
+	"WPPU8aL39T2YPvGAfYi+6KIZLyMn/ymduTxrwizHKxVI/JuSjOVM++ph9a1u+yKZT22z192cmARViy0U",
+	"fRIzCTEtDjEUVPbAele+HogoqCkHa4bKLUhY7FML64j2ct7n83vvapD6T7WdMbU6U8ZsKwlaMri2egWH",
+	"d9bQF+MakhrdJxZNZm+o4YWKKQ1c27EMWO72QCEsJXmUuZU2H6sy606mlE9sXBJRoKeUE0rGcENyxkuD",
+	"LtzTgioFqUWJ33GnXLuEeI9t63y3r5njOv3WOlTesCwzINpIgXE9OEzZz940Rk+rDxb0/Y3EmSgtPBIS",
+	"YBUqtbgC7l7D4gSkNMuxIrelrkxuLwPZlyhL3pZZX1GUKkfKbCzXjrgcnIj4+vUug36f+lElamQsWIqr",
+	"NAP+V0ssTvmG1FcalA6rCjJItJAKr4TO03m1Dg9UVT8d6bQqTFshHe+JlhwPD0+JyJnW9UtDCiQzN3ld",
+	"TZkQUNxHc9NTA9lxb5SNIKGlApfsbZaeTEt+ZUYS9VdfmaF6xggb7dbrkeBQZylwfk12IUzdZiX+fo7I",
+	"UrybQzm5PhgefEVS4QtJBXNYKmdcAzfbaBZRPZ43TzdmZX8ApVmO4uAP2Eyxf4N7ZcekHScWiBO896OI",
+	"mlaP2khATtk2thae8wnp/sDQ67rOi5WqZs2cI2UFq2+EzQsQ49YpzOlXGtK4ELFngvmXX00Px8WQf7u2",
+	"iQSqY3XvORf2vbLblEqtG1ubZFbxQldsfwFBCI/T31sKATfexLI90eqxS9ngsgPejthiLncANr5cMVli",
+	"4h0Ty92Sirs0HPyBJV2P4g9FCgpfWUaPgAkeFmVGg3x/NVN4GfTcP1u4rj/XcMKl2x9c9/j64boPydvP",
+	"pkyu12yyEoL3tgPB7jJ1rNBPqIaJkObPHZvbQLnnz7thmbwFomovphne3LLt526xHH4VW5e44bEo+XEY",
+	"f6GaiBvuUhf970a3I6/RF7Zn5n7dI3YjWqRkQ85HJuReK3JIxWmrFAAVqB4P3GPkwRvnyCF5sO41LnvN",
+	"M7AXVCdTZy74t8lWF0ds8hrRcvDqynFaGG5nMBUamjRNe9VD+vivXFybf2iI1d/6gKUpp7Ft+/vF82c2",
+	"6I61BvQ0XgHXEGocVPzk3V5C+je7hwvuClH0HBgxT0QzIogxZRv2vzDeEYutEVAJ8rjU0/qv7/1h/vs/",
+	"L/HSkWndO3Jf67VMtcbcMCEnZy2Vwl++PHtcnYjwCbWQoiFIBXtKC+dma7SvJdbQEPoQS6b1jnqYbNrz",
+	"Z9JA8palNYS0YObx2A9m8YyPhTdNXLqOEdFZ76ingeZ/DUtc1COaRXyPX4grWkYugZrYQCkzhwNza7fR",
+	"e4FX/dQc4s1OrNuui8taeWZfUYUko4Zqr8E9IZuDC43YzCAxxlfe62syVk1kdfaYeWP9Nb/0Xl1/WHd8",
+	"EY/d2lOMPwyUppN6RxwnpRKMzowSSwtnZtj7PRlLwEU5HM6OC5pMwZTvXUDTzc3NkOLnoSkA7/qqvSdn",
+	"J6fPLk4HpurrVOcZki/TWBx2Dv3HL85MkT3PxHp+IUiJlo/0jnoPh/vDA3c4kND989AVS5mAbrnPHr7l",
+	"G0a+wifdK25ylrpux1kWdsS5Jc1BY334n6LM1rI0UjU0uEWS9rqzCakFF88t8w0tWXe26hFQD8sNHyV6",
+	"vtUDb7s9cNq3T/GTcI3ugKZp03LI/CCeNdCI6vahv7DeyvaxRrJpmejaIhHj2uT0mqXVOJi0VpQakscw",
+	"pogRLQhcg5zpqUnhbwEUe124We8RWsSt6vvXe9GAcoWTDYqvgDz49kGfPPjW/Nec2Af/8e2Dqk5z7wpm",
+	"B9/ivh30r2B2+B/2j8PXvd22leKM263Uvs76juVl3rBELeVViwzt49r2vax9EajHWsOrndAa3QkbN8kc",
+	"K13aQeecDMYVgvblCLzqap+yr+mecUJDsx4x1EoZLGe6gaeFosMOJ72jg/39fbx5bP/cj5hmb/o9vyhk",
+	"LYf7+17S+IcWzHVqe013718uMFJPvsbDv4anYB4UyrI50+5Hw/se3eGkVXRkYa7vaEq8goaTHtzDpC+5",
+	"fX/GFCWzsz68h1m/F3LE0hQwRv7o8Jt7mPJSCPKU8plHMZaX+upeVnvh1I6XvPJTWp2dTlRY9cb4rt8N",
+	"vHrQO/IfrFz90K8E7XpCtpmJtyhVT/xg9y9OO2naSdNOmv4qpWknSTtJ+llI0kLEbi+doO+Z0AUhuSgj",
+	"bdOTqiSdi0B9J9LZXR8au9ba/aRlCR8WzurBx5k2hqC0Z6/D4MwNRPjKAk1cJQtNmkpFUIew93u/rOFI",
+	"pLPf7Xn3E/pZcTsfo8P+pK5c2JwsbXyen8hxztWz/AC6dYoJ6Dscv05PapvlwidHbTlXoM+deS9Mc65s",
+	"vsVtNujcek1b0Seb37deVnOeNjTKWKst5/zQCamPLaT270NInQg+zliiO7G4hoHZNC733rt/fdjb1KNr",
+	"80r8b0vNzrU8ufORv5jQrp86w7rRtCiUV6bdqzHeHGxI8lrQbmZv3cIY/uzM1Ls3R29jpHUOxU7C3JGE",
+	"eXQPUz4TmnwvSp52ImYry8ucEusMaRUXJyusis9PXrz5qGaiLScYoYt6qYjVOrlFBHlj925etoEbMTEb",
+	"Fl/cxEwXmiy1YHAThpgiUm/kFrZbHJgJ6HuCpGkCxaGRi20+GkSdgfRrFF/3bpN9IYbR3mLsbd482ntv",
+	"TsYHK/AyiBV/ttxtTvStspUer+B3X4Cx1AJRQ0YN4/Pj/20seT+SXt9OYJ06/6vkh58fd4o6YH4AvSFT",
+	"+QF0x1E+A46yQkPu2ErHVu7HVKc6mcYqa+lkupGpjj1+9awF7zeYld4pj1nXXzDAqf+4GZ0svQeyVti5",
+	"43od1+uMy1vy2VLHKjih22YjPnu+ytXTKXGfuUO2unH22XHeT+AC7vh9x+87Z+KCM3HPvuyYG8ha0y9+",
+	"cDnMwVF7XHdzhc8LKjVLyoxK3444obHMMRCM04mXe8sP+RLvHnZZ+V9MSkp9qLvklM7t9BmKx1DoNYXk",
+	"xhG2JWn9j5dkj28s3QxntzB1cazu5HeqeJcIt1nQbgmf+gH0HTKpCegvgUMtuZjUsaguc/ZXnzm7Vjhu",
+	"CdMIw3B3wTa6YFfHyjpW1mlbXwTzjMXYsPr4eobh+bJLq1txzxIn/zJCWZ8de7zn++4dQ+4YcseQ7/2q",
+	"sQ131S/OtJrMqnrKZTPjOVoOYKsoVmc7d/yts52/TNt5M+4RWtGfIf/oTOiOo3Uc7bdt0G7G0M5XV0j6",
+	"Mljal2/WdiyrMzI7I/M+jMy5h7aXl7FKmdKMJ7rtee6FVzbsI7rjMST4mBY+EhE8H71Y9+rVHDjdIwa/",
+	"0ULG3yNqtSBKSD33DrlHMG7rqDUB0fT8btaYNrUVp81H/2R3/QBP8FNyrdRFIqTZnqIcZUxNIT3W+AXO",
+	"Io+lRlZwYQAXMgVZvTHsYG5/PSYF2QKvGTuAleJf+OM6sHQJqLdPQF1A6pl9jI6MGTevm5qlJVC4l8po",
+	"85H5U/yE7/ad+lZVv4RKiUtvqJLguzi8Mhn8ZB8WbFm4eyXPTxSnKPd2lVvmSIgMKP/IzrcGSn6Qoiza",
+	"k2yHn0QPHH4aRXD4CTTB4SfUkoZWTTq4JzX0zFysyIGbU7fTlCVjoLqU4F8cBW70qNQ+osmU43u7w0+q",
+	"1g0bel0D/kXtbl6hiyh5e8k1mEs1RpR92GN54Z4jiyp+5ygcSAFygG9+oWaA/8IXdEf4BqukKSv9bZqT",
+	"V6f1o30yGnZoMIIzC8AalnZzZLJj5nPXZvrm4+Bw//DR4ODw4aPdoX+pPuigiNJGgFl5Rw73952Cygnk",
+	"hVcsxLjWWOtXyHbChe7aR4m5sDqi0aTwMVNmUFDytMXqR4R3N2c2We+5U3EdoQ0coUlxg4qtsyjwAUsy",
+	"grFZPp1MJEz8S71W14XUPNBp9udnbPsz2Wl0tQD0SUBQruW3+HD7Xj6z1P/z7pA8r5RmJ2jJz9/+3Cc/",
+	"f4v//Q/zX/uUpx4oPTMjMf4z2SM/c6HNv27sG8WWd4yyVozdmfbcOKMOdVspzf5c2JfnVaiPLnxBdD0z",
+	"g3bKcqcsd8ry7ZRlJyM7TbnTlD97TfludVUrp8Prbe3+yQW3JEol9+I445PMP1Qb6A7VS/T+Xd8FZdXK",
+	"tA09k5fTeuhhyKnsaN0d68412rlGO22v0/Y6bW9R2+u8op9Y17vfAHmnX342+uWeKvOcytkqd6hVlqxQ",
+	"JK6Pc382FU3jYBSlJmNwjkLTc1ya1/xfnaI8WFPnnF04yD57xfPXqoh9TPbfvt/nbspOHnTyoJMHH18e",
+	"oN96S3eDizxA6kQBjmWYm/3HalcDBhvuytOAg3WOhs7R0DkaOkdD52joHA1dDlanXXba5eejXd6dswGH",
+	"3dbXsKB03trVcF+aZ+dp2PzEtO5252joREEnCu5PFKzJ/MP7VIMbltosWHuHynAzLxhW59nWXP1+lMuO",
+	"r3TJS7/hMz6rLMUVtyrjRmqde776pmTdq7sxeZc6YkZHkKkheWwNdmWGMOx2po163erzML2287j9dnyL",
+	"nTfs834SI85eujcxPmHVhk9Z0KC7qbd5AYZF/aEQKqIE2OKard7qJRVrbM94t97HqRjTMtlaBWQO7gWK",
+	"eOXSfi9j/AoBsW+btAxx9H4Ox+my1k1l673n87+vRNVIpLPf7Xkn2R5+N0DO22ZLAJiAvuvZXaWjdSGQ",
+	"S5tvCcWHTmB89DI/v6maO52IugsR1WrihubsElPXXCcXEhcUl3Zn+N2alZpqsO8wmkvX3Nys5a9O/4uk",
+	"IinN70bVXmkdk4t6GLzO7aq+meu2b/291J+JkOTnMXtn/jmCRORAngl97D7XUV1FVCIKwLQHAyPL6QRI",
+	"yiZgzS+gyZQUUqRloofkuZ7i7WEPgLWr3GdVBWQob4zTJ1QCUVesKCAdknMYsNxbbLRevC2MrpqBaEWY",
+	"JokVau7G86JeYHHc7iH4GIqB27vHDvr7LinX2SudvdIJg89NGGzwJuHGxs/j9RXzlWU7V0z+a3vAsKtz",
+	"+asszdsxvbtgekveI9yYR/0A+t4Y1BfyeOH6rptOSetYZMciP0s/dtnuxhaSOH/hNgzzfANX410wzWQe",
+	"6M+8YPtt3O+fjod/Wtd/J0Q6R3MnQ75M38KHfs8uxvL7Uma9o94eLdje9UHvw5tqgnlR8NxLFWWQMv8q",
+	"B6ZU+bQi+633ob9kDJNs39wBCYWQWqG/dzFF0+Z2ssZETRR8ePPh/w8A815uopFyAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
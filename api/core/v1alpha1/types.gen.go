@@ -4,6 +4,8 @@
 package v1alpha1
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	externalRef0 "github.com/flightctl/flightctl/api/core/v1beta1"
@@ -41,6 +43,15 @@ const (
 	CatalogItemTypeQuadlet   CatalogItemType = "quadlet"
 )
 
+// Defines values for VexJustification.
+const (
+	VexJustificationComponentNotPresent                         VexJustification = "component_not_present"
+	VexJustificationInlineMitigationsAlreadyExist               VexJustification = "inline_mitigations_already_exist"
+	VexJustificationVulnerableCodeCannotBeControlledByAdversary VexJustification = "vulnerable_code_cannot_be_controlled_by_adversary"
+	VexJustificationVulnerableCodeNotInExecutePath              VexJustification = "vulnerable_code_not_in_execute_path"
+	VexJustificationVulnerableCodeNotPresent                    VexJustification = "vulnerable_code_not_present"
+)
+
 // Defines values for VulnerabilitySeverity.
 const (
 	VulnerabilitySeverityCritical VulnerabilitySeverity = "Critical"
@@ -51,6 +62,12 @@ const (
 	VulnerabilitySeverityUnknown  VulnerabilitySeverity = "Unknown"
 )
 
+// Defines values for VulnerabilityExceptionState.
+const (
+	VulnerabilityExceptionStateNotAffected  VulnerabilityExceptionState = "NotAffected"
+	VulnerabilityExceptionStateRiskAccepted VulnerabilityExceptionState = "RiskAccepted"
+)
+
 // Defines values for VulnerabilityGroupSeverity.
 const (
 	VulnerabilityGroupSeverityCritical VulnerabilityGroupSeverity = "Critical"
@@ -164,7 +181,7 @@ type Catalog struct {
 	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
 	Metadata externalRef0.ObjectMeta `json:"metadata"`
 
-	// Spec CatalogSpec describes the configuration of a catalog. Catalogs are containers for locally-managed CatalogItems.
+	// Spec CatalogSpec describes the configuration of a catalog. Catalogs are containers for CatalogItems, which are either managed locally or mirrored read-only from a remote source.
 	Spec CatalogSpec `json:"spec"`
 
 	// Status CatalogStatus represents the current status of a catalog source.
@@ -411,7 +428,22 @@ type CatalogList struct {
 	Metadata externalRef0.ListMeta `json:"metadata"`
 }
 
-// CatalogSpec CatalogSpec describes the configuration of a catalog. Catalogs are containers for locally-managed CatalogItems.
+// CatalogSource CatalogSource describes a signed catalog index published as an OCI artifact. The catalog's items are mirrored read-only from the index and kept in sync with it.
+type CatalogSource struct {
+	// Artifact The path of the catalog index artifact within the registry (e.g. vendor/catalog).
+	Artifact string `json:"artifact"`
+
+	// PublicKey PEM-encoded ECDSA, RSA or Ed25519 public key that verifies the signature of the catalog index.
+	PublicKey string `json:"publicKey"`
+
+	// Repository The name of the OCI Repository resource of the registry that hosts the catalog index.
+	Repository string `json:"repository"`
+
+	// TargetRevision The tag or digest of the catalog index artifact to mirror.
+	TargetRevision string `json:"targetRevision"`
+}
+
+// CatalogSpec CatalogSpec describes the configuration of a catalog. Catalogs are containers for CatalogItems, which are either managed locally or mirrored read-only from a remote source.
 type CatalogSpec struct {
 	// DisplayName Human-readable display name shown in catalog listings.
 	DisplayName *string `json:"displayName,omitempty"`
@@ -425,6 +457,9 @@ type CatalogSpec struct {
 	// ShortDescription A brief one-line description of the catalog.
 	ShortDescription *string `json:"shortDescription,omitempty"`
 
+	// Source CatalogSource describes a signed catalog index published as an OCI artifact. The catalog's items are mirrored read-only from the index and kept in sync with it.
+	Source *CatalogSource `json:"source,omitempty"`
+
 	// Support Link to support resources or documentation for getting help.
 	Support *string `json:"support,omitempty"`
 }
@@ -433,6 +468,27 @@ type CatalogSpec struct {
 type CatalogStatus struct {
 	// Conditions Current state of the catalog source.
 	Conditions []externalRef0.Condition `json:"conditions"`
+
+	// Conflicts Items of the catalog index that could not be mirrored during the last sync.
+	Conflicts *[]CatalogSyncConflict `json:"conflicts,omitempty"`
+
+	// LastSyncTime The time the catalog index was last mirrored.
+	LastSyncTime *time.Time `json:"lastSyncTime,omitempty"`
+
+	// ObservedDigest The manifest digest of the catalog index that was last mirrored.
+	ObservedDigest *string `json:"observedDigest,omitempty"`
+
+	// ObservedGeneration The catalog generation that was last mirrored.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+}
+
+// CatalogSyncConflict CatalogSyncConflict describes an item of a catalog index that could not be mirrored.
+type CatalogSyncConflict struct {
+	// Item The name of the catalog item.
+	Item string `json:"item"`
+
+	// Reason Why the item could not be mirrored.
+	Reason string `json:"reason"`
 }
 
 // CveCountsBySeverity Counts of distinct CVEs in the organization by highest severity.
@@ -540,6 +596,70 @@ type FleetVulnerabilitySummaryResponse struct {
 	Summary FleetVulnerabilitySummary `json:"summary"`
 }
 
+// OpenVEXDocument An OpenVEX document (https://github.com/openvex/spec). Only the fields used by the import are described.
+type OpenVEXDocument struct {
+	// Context The OpenVEX context URL, for example https://openvex.dev/ns/v0.2.0.
+	Context string `json:"@context"`
+
+	// Id The IRI identifying the document.
+	Id *string `json:"@id,omitempty"`
+
+	// Author The author of the document.
+	Author *string `json:"author,omitempty"`
+
+	// Statements The VEX statements of the document.
+	Statements []OpenVEXStatement `json:"statements"`
+
+	// Timestamp When the document was issued.
+	Timestamp            *time.Time             `json:"timestamp,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// OpenVEXProduct A product an OpenVEX statement applies to, identified by a package URL or image reference.
+type OpenVEXProduct struct {
+	// Id The product identifier, for example pkg:oci/app@sha256%3A... or quay.io/org/app@sha256:....
+	Id *string `json:"@id,omitempty"`
+
+	// Hashes Cryptographic hashes of the product keyed by algorithm (e.g. sha-256).
+	Hashes *map[string]string `json:"hashes,omitempty"`
+
+	// Identifiers Additional software identifiers keyed by type (purl, cpe22, cpe23).
+	Identifiers          *map[string]string     `json:"identifiers,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// OpenVEXStatement A single OpenVEX statement.
+type OpenVEXStatement struct {
+	// ImpactStatement Free-form explanation of why the products are not affected.
+	ImpactStatement *string `json:"impact_statement,omitempty"`
+
+	// Justification The justification label of a not_affected statement.
+	Justification *string `json:"justification,omitempty"`
+
+	// Products The products the statement applies to.
+	Products *[]OpenVEXProduct `json:"products,omitempty"`
+
+	// Status The VEX status (not_affected, affected, fixed or under_investigation).
+	Status string `json:"status"`
+
+	// StatusNotes Free-form notes on the status.
+	StatusNotes *string `json:"status_notes,omitempty"`
+
+	// Vulnerability The vulnerability an OpenVEX statement refers to.
+	Vulnerability        OpenVEXVulnerability   `json:"vulnerability"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// OpenVEXVulnerability The vulnerability an OpenVEX statement refers to.
+type OpenVEXVulnerability struct {
+	// Aliases Other identifiers of the vulnerability.
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Name The vulnerability identifier, for example CVE-2024-1234.
+	Name                 *string                `json:"name,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // SemVer Semantic version identifier (e.g., 1.2.3, 2.0.0-rc1)
 type SemVer = string
 
@@ -567,6 +687,9 @@ type Status struct {
 	Status string `json:"status"`
 }
 
+// VexJustification The OpenVEX justification for a NotAffected exception.
+type VexJustification string
+
 // Vulnerability A single vulnerability (CVE) finding. Vulnerabilities are global CVE records from Trustify; they are not tenant-owned resources. Tenancy is determined by the device or fleet context through which they are queried.
 type Vulnerability struct {
 	// AdvisoryId Vendor advisory identifier when available.
@@ -587,6 +710,9 @@ type Vulnerability struct {
 	// Description Short summary of the vulnerability.
 	Description *string `json:"description,omitempty"`
 
+	// Exception Name of the VulnerabilityException that excepts this finding. Only set when excepted findings are included.
+	Exception *string `json:"exception,omitempty"`
+
 	// Image Image reference (name or URL) from the device context.
 	Image *string `json:"image,omitempty"`
 
@@ -612,6 +738,81 @@ type Vulnerability struct {
 // VulnerabilitySeverity Normalized severity label.
 type VulnerabilitySeverity string
 
+// VulnerabilityException VulnerabilityException marks the findings of a CVE as not affecting, or as an accepted risk for, the images in its scope. Excepted findings are left out of vulnerability summaries and counts, and out of vulnerability lists unless excepted findings are requested.
+type VulnerabilityException struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata externalRef0.ObjectMeta `json:"metadata"`
+
+	// Spec VulnerabilityExceptionSpec describes which findings are excepted and why.
+	Spec VulnerabilityExceptionSpec `json:"spec"`
+}
+
+// VulnerabilityExceptionCatalogItemRef Reference to a catalog item. The exception applies to the images built from the item's artifact URIs.
+type VulnerabilityExceptionCatalogItemRef struct {
+	// Catalog The name of the catalog containing the item.
+	Catalog string `json:"catalog"`
+
+	// Item The name of the catalog item.
+	Item string `json:"item"`
+}
+
+// VulnerabilityExceptionList VulnerabilityExceptionList is a list of VulnerabilityExceptions.
+type VulnerabilityExceptionList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of VulnerabilityExceptions.
+	Items []VulnerabilityException `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata externalRef0.ListMeta `json:"metadata"`
+}
+
+// VulnerabilityExceptionScope The images an exception applies to. Exactly one field must be set.
+type VulnerabilityExceptionScope struct {
+	// CatalogItem Reference to a catalog item. The exception applies to the images built from the item's artifact URIs.
+	CatalogItem *VulnerabilityExceptionCatalogItemRef `json:"catalogItem,omitempty"`
+
+	// Fleet The name of the fleet whose devices the exception applies to, whatever image they run.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// ImageDigest The image digest (e.g. sha256:...) the exception applies to, wherever it is deployed.
+	ImageDigest *string `json:"imageDigest,omitempty"`
+}
+
+// VulnerabilityExceptionSpec VulnerabilityExceptionSpec describes which findings are excepted and why.
+type VulnerabilityExceptionSpec struct {
+	// CveId Identifier of the excepted vulnerability (e.g. CVE-2024-1234).
+	CveId string `json:"cveId"`
+
+	// ExpiresAt When the exception stops applying. An exception without an expiry applies until it is deleted.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Justification Why the findings are excepted.
+	Justification string `json:"justification"`
+
+	// Scope The images an exception applies to. Exactly one field must be set.
+	Scope VulnerabilityExceptionScope `json:"scope"`
+
+	// State NotAffected means the images are not affected by the vulnerability. RiskAccepted means the images are affected but the risk has been accepted, usually until a fix can be rolled out.
+	State VulnerabilityExceptionState `json:"state"`
+
+	// VexJustification The OpenVEX justification for a NotAffected exception.
+	VexJustification *VexJustification `json:"vexJustification,omitempty"`
+}
+
+// VulnerabilityExceptionState NotAffected means the images are not affected by the vulnerability. RiskAccepted means the images are affected but the risk has been accepted, usually until a fix can be rolled out.
+type VulnerabilityExceptionState string
+
 // VulnerabilityGroup A CVE grouped across one or more images, as returned by fleet-scoped and organization-wide vulnerability list endpoints. Each finding represents one image in which the CVE was detected.
 type VulnerabilityGroup struct {
 	// AffectedDevices Total distinct devices affected by this CVE within scope.
@@ -656,6 +857,9 @@ type VulnerabilityGroupItem struct {
 	// Description Short summary of the vulnerability.
 	Description *string `json:"description,omitempty"`
 
+	// Exception Name of the VulnerabilityException that excepts this finding. Only set when excepted findings are included.
+	Exception *string `json:"exception,omitempty"`
+
 	// FirstSeenAt When this CVE was first observed in this digest.
 	FirstSeenAt *time.Time `json:"firstSeenAt,omitempty"`
 
@@ -862,6 +1066,9 @@ type ListVulnerabilitiesParams struct {
 
 	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeExcepted Include findings excepted by a VulnerabilityException. Excepted findings carry the name of the exception in their exception field.
+	IncludeExcepted *bool `form:"includeExcepted,omitempty" json:"includeExcepted,omitempty"`
 }
 
 // ListVulnerabilitiesParamsSortBy defines parameters for ListVulnerabilities.
@@ -886,6 +1093,9 @@ type GetVulnerabilityImpactParams struct {
 
 	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeExcepted Include findings excepted by a VulnerabilityException. Excepted findings carry the name of the exception in their exception field.
+	IncludeExcepted *bool `form:"includeExcepted,omitempty" json:"includeExcepted,omitempty"`
 }
 
 // GetVulnerabilityImpactParamsSortBy defines parameters for GetVulnerabilityImpact.
@@ -910,6 +1120,9 @@ type GetDeviceVulnerabilitiesParams struct {
 
 	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeExcepted Include findings excepted by a VulnerabilityException. Excepted findings carry the name of the exception in their exception field.
+	IncludeExcepted *bool `form:"includeExcepted,omitempty" json:"includeExcepted,omitempty"`
 }
 
 // GetDeviceVulnerabilitiesParamsSortBy defines parameters for GetDeviceVulnerabilities.
@@ -940,6 +1153,9 @@ type GetFleetVulnerabilitiesParams struct {
 
 	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeExcepted Include findings excepted by a VulnerabilityException. Excepted findings carry the name of the exception in their exception field.
+	IncludeExcepted *bool `form:"includeExcepted,omitempty" json:"includeExcepted,omitempty"`
 }
 
 // GetFleetVulnerabilitiesParamsSortBy defines parameters for GetFleetVulnerabilities.
@@ -954,6 +1170,21 @@ type GetFleetVulnerabilitySummaryParams struct {
 	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
 }
 
+// ListVulnerabilityExceptionsParams defines parameters for ListVulnerabilityExceptions.
+type ListVulnerabilityExceptionsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the parameter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // CreateCatalogJSONRequestBody defines body for CreateCatalog for application/json ContentType.
 type CreateCatalogJSONRequestBody = Catalog
 
@@ -977,3 +1208,458 @@ type PatchCatalogStatusApplicationJSONPatchPlusJSONRequestBody = externalRef0.Pa
 
 // ReplaceCatalogStatusJSONRequestBody defines body for ReplaceCatalogStatus for application/json ContentType.
 type ReplaceCatalogStatusJSONRequestBody = Catalog
+
+// CreateVulnerabilityExceptionJSONRequestBody defines body for CreateVulnerabilityException for application/json ContentType.
+type CreateVulnerabilityExceptionJSONRequestBody = VulnerabilityException
+
+// ImportVulnerabilityExceptionsJSONRequestBody defines body for ImportVulnerabilityExceptions for application/json ContentType.
+type ImportVulnerabilityExceptionsJSONRequestBody = OpenVEXDocument
+
+// ReplaceVulnerabilityExceptionJSONRequestBody defines body for ReplaceVulnerabilityException for application/json ContentType.
+type ReplaceVulnerabilityExceptionJSONRequestBody = VulnerabilityException
+
+// Getter for additional properties for OpenVEXDocument. Returns the specified
+// element and whether it was found
+func (a OpenVEXDocument) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for OpenVEXDocument
+func (a *OpenVEXDocument) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for OpenVEXDocument to handle AdditionalProperties
+func (a *OpenVEXDocument) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["@context"]; found {
+		err = json.Unmarshal(raw, &a.Context)
+		if err != nil {
+			return fmt.Errorf("error reading '@context': %w", err)
+		}
+		delete(object, "@context")
+	}
+
+	if raw, found := object["@id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading '@id': %w", err)
+		}
+		delete(object, "@id")
+	}
+
+	if raw, found := object["author"]; found {
+		err = json.Unmarshal(raw, &a.Author)
+		if err != nil {
+			return fmt.Errorf("error reading 'author': %w", err)
+		}
+		delete(object, "author")
+	}
+
+	if raw, found := object["statements"]; found {
+		err = json.Unmarshal(raw, &a.Statements)
+		if err != nil {
+			return fmt.Errorf("error reading 'statements': %w", err)
+		}
+		delete(object, "statements")
+	}
+
+	if raw, found := object["timestamp"]; found {
+		err = json.Unmarshal(raw, &a.Timestamp)
+		if err != nil {
+			return fmt.Errorf("error reading 'timestamp': %w", err)
+		}
+		delete(object, "timestamp")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for OpenVEXDocument to handle AdditionalProperties
+func (a OpenVEXDocument) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["@context"], err = json.Marshal(a.Context)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling '@context': %w", err)
+	}
+
+	if a.Id != nil {
+		object["@id"], err = json.Marshal(a.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '@id': %w", err)
+		}
+	}
+
+	if a.Author != nil {
+		object["author"], err = json.Marshal(a.Author)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'author': %w", err)
+		}
+	}
+
+	if a.Statements != nil {
+		object["statements"], err = json.Marshal(a.Statements)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'statements': %w", err)
+		}
+	}
+
+	if a.Timestamp != nil {
+		object["timestamp"], err = json.Marshal(a.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timestamp': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for OpenVEXProduct. Returns the specified
+// element and whether it was found
+func (a OpenVEXProduct) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for OpenVEXProduct
+func (a *OpenVEXProduct) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for OpenVEXProduct to handle AdditionalProperties
+func (a *OpenVEXProduct) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["@id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading '@id': %w", err)
+		}
+		delete(object, "@id")
+	}
+
+	if raw, found := object["hashes"]; found {
+		err = json.Unmarshal(raw, &a.Hashes)
+		if err != nil {
+			return fmt.Errorf("error reading 'hashes': %w", err)
+		}
+		delete(object, "hashes")
+	}
+
+	if raw, found := object["identifiers"]; found {
+		err = json.Unmarshal(raw, &a.Identifiers)
+		if err != nil {
+			return fmt.Errorf("error reading 'identifiers': %w", err)
+		}
+		delete(object, "identifiers")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for OpenVEXProduct to handle AdditionalProperties
+func (a OpenVEXProduct) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Id != nil {
+		object["@id"], err = json.Marshal(a.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '@id': %w", err)
+		}
+	}
+
+	if a.Hashes != nil {
+		object["hashes"], err = json.Marshal(a.Hashes)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hashes': %w", err)
+		}
+	}
+
+	if a.Identifiers != nil {
+		object["identifiers"], err = json.Marshal(a.Identifiers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'identifiers': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for OpenVEXStatement. Returns the specified
+// element and whether it was found
+func (a OpenVEXStatement) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for OpenVEXStatement
+func (a *OpenVEXStatement) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for OpenVEXStatement to handle AdditionalProperties
+func (a *OpenVEXStatement) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["impact_statement"]; found {
+		err = json.Unmarshal(raw, &a.ImpactStatement)
+		if err != nil {
+			return fmt.Errorf("error reading 'impact_statement': %w", err)
+		}
+		delete(object, "impact_statement")
+	}
+
+	if raw, found := object["justification"]; found {
+		err = json.Unmarshal(raw, &a.Justification)
+		if err != nil {
+			return fmt.Errorf("error reading 'justification': %w", err)
+		}
+		delete(object, "justification")
+	}
+
+	if raw, found := object["products"]; found {
+		err = json.Unmarshal(raw, &a.Products)
+		if err != nil {
+			return fmt.Errorf("error reading 'products': %w", err)
+		}
+		delete(object, "products")
+	}
+
+	if raw, found := object["status"]; found {
+		err = json.Unmarshal(raw, &a.Status)
+		if err != nil {
+			return fmt.Errorf("error reading 'status': %w", err)
+		}
+		delete(object, "status")
+	}
+
+	if raw, found := object["status_notes"]; found {
+		err = json.Unmarshal(raw, &a.StatusNotes)
+		if err != nil {
+			return fmt.Errorf("error reading 'status_notes': %w", err)
+		}
+		delete(object, "status_notes")
+	}
+
+	if raw, found := object["vulnerability"]; found {
+		err = json.Unmarshal(raw, &a.Vulnerability)
+		if err != nil {
+			return fmt.Errorf("error reading 'vulnerability': %w", err)
+		}
+		delete(object, "vulnerability")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for OpenVEXStatement to handle AdditionalProperties
+func (a OpenVEXStatement) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.ImpactStatement != nil {
+		object["impact_statement"], err = json.Marshal(a.ImpactStatement)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'impact_statement': %w", err)
+		}
+	}
+
+	if a.Justification != nil {
+		object["justification"], err = json.Marshal(a.Justification)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'justification': %w", err)
+		}
+	}
+
+	if a.Products != nil {
+		object["products"], err = json.Marshal(a.Products)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'products': %w", err)
+		}
+	}
+
+	object["status"], err = json.Marshal(a.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'status': %w", err)
+	}
+
+	if a.StatusNotes != nil {
+		object["status_notes"], err = json.Marshal(a.StatusNotes)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'status_notes': %w", err)
+		}
+	}
+
+	object["vulnerability"], err = json.Marshal(a.Vulnerability)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'vulnerability': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for OpenVEXVulnerability. Returns the specified
+// element and whether it was found
+func (a OpenVEXVulnerability) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for OpenVEXVulnerability
+func (a *OpenVEXVulnerability) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for OpenVEXVulnerability to handle AdditionalProperties
+func (a *OpenVEXVulnerability) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["aliases"]; found {
+		err = json.Unmarshal(raw, &a.Aliases)
+		if err != nil {
+			return fmt.Errorf("error reading 'aliases': %w", err)
+		}
+		delete(object, "aliases")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for OpenVEXVulnerability to handle AdditionalProperties
+func (a OpenVEXVulnerability) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Aliases != nil {
+		object["aliases"], err = json.Marshal(a.Aliases)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'aliases': %w", err)
+		}
+	}
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
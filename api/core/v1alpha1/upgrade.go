@@ -0,0 +1,205 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// UpgradesFrom reports whether there is a direct upgrade edge from the given version to v, that is
+// whether v replaces it, skips it, or its skipRange covers it.
+func (v *CatalogItemVersion) UpgradesFrom(version string) bool {
+	if version == "" || version == v.Version {
+		return false
+	}
+	if v.Replaces != nil && *v.Replaces == version {
+		return true
+	}
+	if v.Skips != nil && slices.Contains(*v.Skips, version) {
+		return true
+	}
+	if v.SkipRange != nil && *v.SkipRange != "" {
+		satisfied, err := SemverRangeSatisfied(*v.SkipRange, version)
+		return err == nil && satisfied
+	}
+	return false
+}
+
+// InChannel reports whether v is part of the given channel.
+func (v *CatalogItemVersion) InChannel(channel string) bool {
+	return slices.Contains(v.Channels, channel)
+}
+
+// UpgradeTarget returns the version that a consumer of the given version should upgrade to when
+// following the given channel, or nil if there is none. Candidates are the non-deprecated versions
+// on the channel with a direct upgrade edge from the current version; the newest candidate wins.
+// Upgrades advance one edge at a time, so a consumer reaches the head of a replaces chain over
+// several upgrades unless skips or skipRange provide a shortcut.
+func (s *CatalogItemSpec) UpgradeTarget(current string, channel string) *CatalogItemVersion {
+	var target *CatalogItemVersion
+	for i := range s.Versions {
+		candidate := &s.Versions[i]
+		if candidate.Deprecation != nil || !candidate.InChannel(channel) || !candidate.UpgradesFrom(current) {
+			continue
+		}
+		if target == nil || CompareSemver(candidate.Version, target.Version) > 0 {
+			target = candidate
+		}
+	}
+	return target
+}
+
+type semver struct {
+	major, minor, patch uint64
+	prerelease          []string
+}
+
+func parseSemver(v string) (semver, error) {
+	if err := validateSemver(v); err != nil {
+		return semver{}, err
+	}
+	v = strings.SplitN(v, "+", 2)[0]
+	parts := strings.SplitN(v, "-", 2)
+
+	var sv semver
+	if len(parts) == 2 {
+		sv.prerelease = strings.Split(parts[1], ".")
+	}
+	coreParts := strings.Split(parts[0], ".")
+	numbers := []*uint64{&sv.major, &sv.minor, &sv.patch}
+	for i, part := range coreParts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semver{}, fmt.Errorf("version component %d must be numeric", i+1)
+		}
+		*numbers[i] = n
+	}
+	return sv, nil
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (a semver) compare(b semver) int {
+	if c := compareUint(a.major, b.major); c != 0 {
+		return c
+	}
+	if c := compareUint(a.minor, b.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(a.patch, b.patch); c != 0 {
+		return c
+	}
+
+	// A version without pre-release identifiers has higher precedence than one with them
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		if c := comparePrereleaseIdentifier(a.prerelease[i], b.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(a.prerelease)), uint64(len(b.prerelease)))
+}
+
+// comparePrereleaseIdentifier compares numeric identifiers numerically and others lexically, with
+// numeric identifiers having lower precedence.
+func comparePrereleaseIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// CompareSemver returns a negative number, zero or a positive number when a is older than, equal to
+// or newer than b. Versions that are not valid semver sort before valid ones, and lexically among
+// themselves.
+func CompareSemver(a, b string) int {
+	av, aErr := parseSemver(a)
+	bv, bErr := parseSemver(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		return av.compare(bv)
+	case aErr == nil:
+		return 1
+	case bErr == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// SemverRangeSatisfied reports whether version satisfies the semver range r, a space-separated list
+// of constraints that must all hold. Each constraint is a version prefixed by one of the operators
+// >=, <=, >, <, = (or none, meaning equal), ~ (same major and minor, at least the given version) or
+// ^ (same major, or same minor for 0.x versions, at least the given version).
+func SemverRangeSatisfied(r string, version string) (bool, error) {
+	if err := validateSemverRange(r); err != nil {
+		return false, err
+	}
+	v, err := parseSemver(version)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	for _, constraint := range strings.Fields(r) {
+		bound := strings.TrimLeft(constraint, ">=<~^")
+		op := strings.TrimSuffix(constraint, bound)
+		b, err := parseSemver(bound)
+		if err != nil {
+			return false, fmt.Errorf("invalid semver range: %v in %q", err, constraint)
+		}
+
+		c := v.compare(b)
+		var satisfied bool
+		switch op {
+		case ">=":
+			satisfied = c >= 0
+		case "<=":
+			satisfied = c <= 0
+		case ">":
+			satisfied = c > 0
+		case "<":
+			satisfied = c < 0
+		case "", "=":
+			satisfied = c == 0
+		case "~":
+			satisfied = c >= 0 && v.major == b.major && v.minor == b.minor
+		case "^":
+			if b.major == 0 {
+				satisfied = c >= 0 && v.major == 0 && v.minor == b.minor
+			} else {
+				satisfied = c >= 0 && v.major == b.major
+			}
+		default:
+			return false, fmt.Errorf("invalid semver range: unsupported operator %q in %q", op, constraint)
+		}
+		if !satisfied {
+			return false, nil
+		}
+	}
+	return true, nil
+}
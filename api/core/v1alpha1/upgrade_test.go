@@ -0,0 +1,139 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareSemver(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.0.0", b: "1.0.0", want: 0},
+		{a: "1.0.0", b: "1.0.1", want: -1},
+		{a: "1.10.0", b: "1.9.0", want: 1},
+		{a: "2.0.0", b: "1.99.99", want: 1},
+		{a: "1.0", b: "1.0.0", want: 0},
+		{a: "1.0.0-rc1", b: "1.0.0", want: -1},
+		{a: "1.0.0-alpha.2", b: "1.0.0-alpha.10", want: -1},
+		{a: "1.0.0-alpha", b: "1.0.0-alpha.1", want: -1},
+		{a: "1.0.0-1", b: "1.0.0-alpha", want: -1},
+		{a: "1.0.0+build.2", b: "1.0.0+build.1", want: 0},
+		{a: "not-semver", b: "1.0.0", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			require.Equal(t, tt.want, CompareSemver(tt.a, tt.b))
+			require.Equal(t, -tt.want, CompareSemver(tt.b, tt.a))
+		})
+	}
+}
+
+func TestSemverRangeSatisfied(t *testing.T) {
+	tests := []struct {
+		r       string
+		version string
+		want    bool
+		wantErr bool
+	}{
+		{r: ">=1.0.0 <1.3.0", version: "1.0.0", want: true},
+		{r: ">=1.0.0 <1.3.0", version: "1.2.9", want: true},
+		{r: ">=1.0.0 <1.3.0", version: "1.3.0", want: false},
+		{r: ">=1.0.0 <1.3.0", version: "0.9.0", want: false},
+		{r: ">1.0.0", version: "1.0.0", want: false},
+		{r: "<=1.0.0", version: "1.0.0", want: true},
+		{r: "=1.2.0", version: "1.2.0", want: true},
+		{r: "1.2.0", version: "1.2.1", want: false},
+		{r: "~1.2.0", version: "1.2.5", want: true},
+		{r: "~1.2.0", version: "1.3.0", want: false},
+		{r: "^1.2.0", version: "1.9.0", want: true},
+		{r: "^1.2.0", version: "2.0.0", want: false},
+		{r: "^1.2.0", version: "1.1.0", want: false},
+		{r: "^0.2.0", version: "0.2.3", want: true},
+		{r: "^0.2.0", version: "0.3.0", want: false},
+		{r: "<1.0.0", version: "1.0.0-rc1", want: true},
+		{r: ">=1.0.0", version: "v1.0.0", wantErr: true},
+		{r: ">=", version: "1.0.0", wantErr: true},
+		{r: "=>1.0.0", version: "1.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.r+"_"+tt.version, func(t *testing.T) {
+			got, err := SemverRangeSatisfied(tt.r, tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCatalogItemVersionUpgradesFrom(t *testing.T) {
+	version := CatalogItemVersion{
+		Version:   "1.3.0",
+		Replaces:  lo.ToPtr("1.2.0"),
+		Skips:     &[]string{"1.0.0"},
+		SkipRange: lo.ToPtr(">=1.1.0 <1.2.0"),
+	}
+
+	require.True(t, version.UpgradesFrom("1.2.0"), "replaces")
+	require.True(t, version.UpgradesFrom("1.0.0"), "skips")
+	require.True(t, version.UpgradesFrom("1.1.5"), "skipRange")
+	require.False(t, version.UpgradesFrom("0.9.0"))
+	require.False(t, version.UpgradesFrom("1.3.0"))
+	require.False(t, version.UpgradesFrom(""))
+}
+
+func TestCatalogItemSpecUpgradeTarget(t *testing.T) {
+	v := func(version string, replaces string, channels ...string) CatalogItemVersion {
+		cv := CatalogItemVersion{Version: version, Channels: channels}
+		if replaces != "" {
+			cv.Replaces = lo.ToPtr(replaces)
+		}
+		return cv
+	}
+	hotfix := v("1.1.1", "1.1.0", "stable")
+	hotfix.SkipRange = lo.ToPtr(">=1.0.0 <1.1.1")
+	deprecated := v("1.2.0", "1.1.1", "stable")
+	deprecated.Deprecation = &CatalogItemDeprecation{Message: "broken"}
+
+	spec := CatalogItemSpec{
+		Versions: []CatalogItemVersion{
+			v("1.0.0", "", "stable", "fast"),
+			v("1.1.0", "1.0.0", "stable", "fast"),
+			hotfix,
+			deprecated,
+			v("2.0.0", "1.1.0", "fast"),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		current string
+		channel string
+		want    string
+	}{
+		{name: "newest candidate wins", current: "1.0.0", channel: "stable", want: "1.1.1"},
+		{name: "replaces chain", current: "1.1.0", channel: "stable", want: "1.1.1"},
+		{name: "deprecated versions are skipped", current: "1.1.1", channel: "stable"},
+		{name: "other channel", current: "1.1.0", channel: "fast", want: "2.0.0"},
+		{name: "replaces within channel", current: "1.0.0", channel: "fast", want: "1.1.0"},
+		{name: "unknown channel", current: "1.0.0", channel: "candidate"},
+		{name: "unknown version", current: "0.1.0", channel: "stable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := spec.UpgradeTarget(tt.current, tt.channel)
+			if tt.want == "" {
+				require.Nil(t, target)
+				return
+			}
+			require.NotNil(t, target)
+			require.Equal(t, tt.want, target.Version)
+		})
+	}
+}
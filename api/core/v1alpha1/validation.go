@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/opencontainers/go-digest"
 	"github.com/santhosh-tekuri/jsonschema/v5"
 )
 
@@ -20,10 +21,27 @@ func (c Catalog) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateResourceName(c.Metadata.Name)...)
 	allErrs = append(allErrs, validation.ValidateLabels(c.Metadata.Labels)...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(c.Metadata.Annotations)...)
+	if c.Spec.Source != nil {
+		allErrs = append(allErrs, c.Spec.Source.Validate()...)
+	}
 
 	return allErrs
 }
 
+// Validate checks that a catalog source references an OCI artifact and carries a usable public key.
+func (s *CatalogSource) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&s.Repository, "spec.source.repository")...)
+	allErrs = append(allErrs, validation.ValidateString(&s.Artifact, "spec.source.artifact", 1, 2048, validation.OciImageNameRegexp, validation.OciImageNameFmt, "vendor/catalog")...)
+	if !validation.OciImageTagRegexp.MatchString(s.TargetRevision) && !validation.OciImageDigestRegexp.MatchString(s.TargetRevision) {
+		allErrs = append(allErrs, fmt.Errorf("spec.source.targetRevision: must be an OCI tag or digest, got %q", s.TargetRevision))
+	}
+	if _, err := s.ParsePublicKey(); err != nil {
+		allErrs = append(allErrs, fmt.Errorf("spec.source.publicKey: %w", err))
+	}
+	return allErrs
+}
+
 // ValidateUpdate ensures immutable fields are unchanged for Catalog.
 func (c *Catalog) ValidateUpdate(newObj *Catalog) []error {
 	return validateImmutableCoreFields(c.Metadata.Name, newObj.Metadata.Name,
@@ -750,6 +768,79 @@ func validatePodmanMemoryLimit(memory *string, path string) []error {
 }
 
 // validateImmutableCoreFields validates that immutable core fields haven't changed.
+// VulnerabilityException validation
+
+const vulnerabilityIDFmt = `[A-Za-z0-9][A-Za-z0-9._:-]*`
+
+var vulnerabilityIDRegexp = regexp.MustCompile("^" + vulnerabilityIDFmt + "$")
+
+func (e VulnerabilityException) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(e.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(e.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(e.Metadata.Annotations)...)
+
+	spec := e.Spec
+	allErrs = append(allErrs, validation.ValidateString(&spec.CveId, "spec.cveId", 1, 128, vulnerabilityIDRegexp, vulnerabilityIDFmt, "CVE-2024-1234", "GHSA-35jh-r3h4-6jhm")...)
+	allErrs = append(allErrs, validateVulnerabilityExceptionScope(spec.Scope)...)
+
+	switch spec.State {
+	case VulnerabilityExceptionStateNotAffected, VulnerabilityExceptionStateRiskAccepted:
+	default:
+		allErrs = append(allErrs, fmt.Errorf("spec.state must be %q or %q", VulnerabilityExceptionStateNotAffected, VulnerabilityExceptionStateRiskAccepted))
+	}
+	allErrs = append(allErrs, validation.ValidateString(&spec.Justification, "spec.justification", 1, 4096, nil, "")...)
+
+	if spec.VexJustification != nil {
+		switch *spec.VexJustification {
+		case VexJustificationComponentNotPresent,
+			VexJustificationVulnerableCodeNotPresent,
+			VexJustificationVulnerableCodeNotInExecutePath,
+			VexJustificationVulnerableCodeCannotBeControlledByAdversary,
+			VexJustificationInlineMitigationsAlreadyExist:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("spec.vexJustification %q is not a valid OpenVEX justification", *spec.VexJustification))
+		}
+		if spec.State != VulnerabilityExceptionStateNotAffected {
+			allErrs = append(allErrs, fmt.Errorf("spec.vexJustification may only be set when spec.state is %q", VulnerabilityExceptionStateNotAffected))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for VulnerabilityException.
+func (e *VulnerabilityException) ValidateUpdate(newObj *VulnerabilityException) []error {
+	return validateImmutableCoreFields(e.Metadata.Name, newObj.Metadata.Name,
+		e.ApiVersion, newObj.ApiVersion,
+		e.Kind, newObj.Kind,
+		nil, nil)
+}
+
+func validateVulnerabilityExceptionScope(scope VulnerabilityExceptionScope) []error {
+	allErrs := []error{}
+	set := 0
+	if scope.ImageDigest != nil {
+		set++
+		if _, err := digest.Parse(*scope.ImageDigest); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.scope.imageDigest %q is not a valid digest: %w", *scope.ImageDigest, err))
+		}
+	}
+	if scope.Fleet != nil {
+		set++
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(scope.Fleet, "spec.scope.fleet")...)
+	}
+	if scope.CatalogItem != nil {
+		set++
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&scope.CatalogItem.Catalog, "spec.scope.catalogItem.catalog")...)
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&scope.CatalogItem.Item, "spec.scope.catalogItem.item")...)
+	}
+	if set != 1 {
+		allErrs = append(allErrs, errors.New("spec.scope must set exactly one of imageDigest, fleet or catalogItem"))
+	}
+	return allErrs
+}
+
 func validateImmutableCoreFields(oldName *string, newName *string, oldApiVersion string, newApiVersion string, oldKind string, newKind string, oldStatus, newStatus interface{}) []error {
 	allErrs := []error{}
 
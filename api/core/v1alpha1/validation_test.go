@@ -1,9 +1,13 @@
 package v1alpha1
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
 	"strings"
 	"testing"
 
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
@@ -1854,3 +1858,103 @@ func TestValidateReplacesGraph(t *testing.T) {
 		})
 	}
 }
+
+func TestVulnerabilityExceptionValidate(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	valid := func() VulnerabilityException {
+		return VulnerabilityException{
+			ApiVersion: "flightctl.io/v1alpha1",
+			Kind:       VulnerabilityExceptionKind,
+			Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr("cve-2024-0727-edge")},
+			Spec: VulnerabilityExceptionSpec{
+				CveId:         "CVE-2024-0727",
+				Scope:         VulnerabilityExceptionScope{ImageDigest: lo.ToPtr(digest)},
+				State:         VulnerabilityExceptionStateNotAffected,
+				Justification: "PKCS12 parsing is not reachable",
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		mutate      func(e *VulnerabilityException)
+		errContains string
+	}{
+		{name: "valid image digest scope", mutate: func(e *VulnerabilityException) {}},
+		{name: "valid fleet scope with expiry", mutate: func(e *VulnerabilityException) {
+			e.Spec.Scope = VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge-os")}
+			e.Spec.State = VulnerabilityExceptionStateRiskAccepted
+		}},
+		{name: "valid catalog item scope with VEX justification", mutate: func(e *VulnerabilityException) {
+			e.Spec.Scope = VulnerabilityExceptionScope{CatalogItem: &VulnerabilityExceptionCatalogItemRef{Catalog: "default", Item: "edge-os"}}
+			e.Spec.VexJustification = lo.ToPtr(VexJustificationVulnerableCodeNotInExecutePath)
+		}},
+		{name: "missing CVE", mutate: func(e *VulnerabilityException) { e.Spec.CveId = "" }, errContains: "spec.cveId"},
+		{name: "invalid CVE characters", mutate: func(e *VulnerabilityException) { e.Spec.CveId = "CVE 2024" }, errContains: "spec.cveId"},
+		{name: "empty scope", mutate: func(e *VulnerabilityException) { e.Spec.Scope = VulnerabilityExceptionScope{} }, errContains: "exactly one"},
+		{name: "two scopes", mutate: func(e *VulnerabilityException) { e.Spec.Scope.Fleet = lo.ToPtr("edge-os") }, errContains: "exactly one"},
+		{name: "invalid digest", mutate: func(e *VulnerabilityException) { e.Spec.Scope.ImageDigest = lo.ToPtr("sha256:xyz") }, errContains: "spec.scope.imageDigest"},
+		{name: "invalid state", mutate: func(e *VulnerabilityException) { e.Spec.State = "Ignored" }, errContains: "spec.state"},
+		{name: "missing justification", mutate: func(e *VulnerabilityException) { e.Spec.Justification = "" }, errContains: "spec.justification"},
+		{name: "VEX justification on accepted risk", mutate: func(e *VulnerabilityException) {
+			e.Spec.State = VulnerabilityExceptionStateRiskAccepted
+			e.Spec.VexJustification = lo.ToPtr(VexJustificationComponentNotPresent)
+		}, errContains: "spec.vexJustification"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := valid()
+			tt.mutate(&e)
+			errs := e.Validate()
+			if tt.errContains == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			require.Contains(t, errors.Join(errs...).Error(), tt.errContains)
+		})
+	}
+}
+
+func TestCatalogSourceValidate(t *testing.T) {
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicKey := encodePublicKey(t, edPublic)
+
+	valid := func() CatalogSource {
+		return CatalogSource{
+			Repository:     "vendor-registry",
+			Artifact:       "vendor/catalog",
+			TargetRevision: "stable",
+			PublicKey:      publicKey,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		mutate      func(*CatalogSource)
+		errContains string
+	}{
+		{name: "valid tag", mutate: func(*CatalogSource) {}},
+		{name: "valid digest", mutate: func(s *CatalogSource) {
+			s.TargetRevision = "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		}},
+		{name: "missing repository", mutate: func(s *CatalogSource) { s.Repository = "" }, errContains: "spec.source.repository"},
+		{name: "invalid artifact", mutate: func(s *CatalogSource) { s.Artifact = "Vendor/Catalog:1" }, errContains: "spec.source.artifact"},
+		{name: "invalid revision", mutate: func(s *CatalogSource) { s.TargetRevision = "not a tag" }, errContains: "spec.source.targetRevision"},
+		{name: "invalid public key", mutate: func(s *CatalogSource) { s.PublicKey = "not a key" }, errContains: "spec.source.publicKey"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := valid()
+			tt.mutate(&source)
+			errs := source.Validate()
+			if tt.errContains == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			require.ErrorContains(t, errs[0], tt.errContains)
+		})
+	}
+}
@@ -18,9 +18,12 @@ const (
 	CertificateSigningRequestKind       = "CertificateSigningRequest"
 	CertificateSigningRequestListKind   = "CertificateSigningRequestList"
 
-	DeviceAPIVersion = "v1beta1"
-	DeviceKind       = "Device"
-	DeviceListKind   = "DeviceList"
+	DeviceAPIVersion              = "v1beta1"
+	DeviceKind                    = "Device"
+	DeviceListKind                = "DeviceList"
+	DeviceStatusHistoryListKind   = "DeviceStatusHistoryList"
+	DeviceRenderedVersionKind     = "DeviceRenderedVersion"
+	DeviceRenderedVersionListKind = "DeviceRenderedVersionList"
 
 	DeviceAnnotationConsole         = "device-controller/console"
 	DeviceAnnotationRemoteSession   = "device-controller/remote-session"
@@ -33,6 +36,8 @@ const (
 	DeviceAnnotationAwaitingReconnect = "device-controller/awaitingReconnect"
 	// After restore when device has a new spec version than what we know,
 	DeviceAnnotationConflictPaused = "device-controller/conflictPaused"
+	// When this annotation is present, the device was rolled back to the given rendered version and is not rendered from its spec
+	DeviceAnnotationRollbackVersion = "device-controller/rollbackVersion"
 	// This annotation is populated after a device was rolled out by the fleet-rollout task
 	DeviceAnnotationTemplateVersion = "fleet-controller/templateVersion"
 	// This annotation is populated after a device was rendered by the device-render task
@@ -73,6 +78,10 @@ const (
 	FleetAnnotationDeviceSelectionConfigDigest = "fleet-controller/deviceSelectionConfigDigest"
 	// Per-application fleet-level lifecycle default (desiredState only), as a JSON-encoded map keyed by application name
 	FleetAnnotationApplicationLifecycle = "fleet-controller/applicationLifecycle"
+	// The catalog item upgrades that the auto-upgrade policy will apply next, while they wait for approval or the schedule window
+	FleetAnnotationAutoUpgradePending = "fleet-controller/autoUpgradePending"
+	// Approves the pending auto-upgrade when set to the value of FleetAnnotationAutoUpgradePending
+	FleetAnnotationAutoUpgradeApproved = "fleet-controller/autoUpgradeApproved"
 	// The requestID related to an event
 	EventAnnotationRequestID = "event-controller/requestID"
 
@@ -90,6 +99,14 @@ const (
 	RepositoryKind       = "Repository"
 	RepositoryListKind   = "RepositoryList"
 
+	SecretAPIVersion = "v1beta1"
+	SecretKind       = "Secret"
+	SecretListKind   = "SecretList"
+
+	BulkOperationAPIVersion = "v1beta1"
+	BulkOperationKind       = "BulkOperation"
+	BulkOperationListKind   = "BulkOperationList"
+
 	AuthProviderAPIVersion = "v1beta1"
 	AuthProviderKind       = "AuthProvider"
 	AuthProviderListKind   = "AuthProviderList"
@@ -144,15 +161,17 @@ var KnownExternalRoles = []string{ExternalRoleAdmin, ExternalRoleOrgAdmin, Exter
 type UpdateState = consts.UpdateState
 
 const (
-	UpdateStatePreparing      = consts.UpdateStatePreparing
-	UpdateStateReadyToUpdate  = consts.UpdateStateReadyToUpdate
-	UpdateStateApplyingUpdate = consts.UpdateStateApplyingUpdate
-	UpdateStateRebooting      = consts.UpdateStateRebooting
-	UpdateStateUpdated        = consts.UpdateStateUpdated
-	UpdateStateCanceled       = consts.UpdateStateCanceled
-	UpdateStateError          = consts.UpdateStateError
-	UpdateStateRollingBack    = consts.UpdateStateRollingBack
-	UpdateStateRetrying       = consts.UpdateStateRetrying
+	UpdateStatePreparing            = consts.UpdateStatePreparing
+	UpdateStateReadyToUpdate        = consts.UpdateStateReadyToUpdate
+	UpdateStateApplyingUpdate       = consts.UpdateStateApplyingUpdate
+	UpdateStateRebooting            = consts.UpdateStateRebooting
+	UpdateStateUpdated              = consts.UpdateStateUpdated
+	UpdateStateCanceled             = consts.UpdateStateCanceled
+	UpdateStateError                = consts.UpdateStateError
+	UpdateStateRollingBack          = consts.UpdateStateRollingBack
+	UpdateStateRetrying             = consts.UpdateStateRetrying
+	UpdateStateInhibited            = consts.UpdateStateInhibited
+	UpdateStateWaitingForConditions = consts.UpdateStateWaitingForConditions
 )
 
 type DecommissionState string
@@ -44,6 +44,20 @@ func (d *Device) IsRebooting() bool {
 	return updatingCondition.Status == ConditionStatusTrue && updatingCondition.Reason == string(UpdateStateRebooting)
 }
 
+// IsUpdateWaiting() is true if the device's agent has the updating condition set with state Inhibited or
+// WaitingForConditions, i.e. it has a pending update that it deferred without making any changes.
+func (d *Device) IsUpdateWaiting() bool {
+	if d == nil || d.Status == nil {
+		return false
+	}
+	updatingCondition := FindStatusCondition(d.Status.Conditions, ConditionTypeDeviceUpdating)
+	if updatingCondition == nil {
+		return false
+	}
+	return updatingCondition.Status == ConditionStatusTrue &&
+		(updatingCondition.Reason == string(UpdateStateInhibited) || updatingCondition.Reason == string(UpdateStateWaitingForConditions))
+}
+
 func (d *Device) isRenderedVersionUpdated() bool {
 	if d == nil || d.Metadata.Annotations == nil {
 		// devices without a rendered version cannot be out-of-date
@@ -28,6 +28,12 @@ func (t RepositorySpec) GetRepoURL() (string, error) {
 			return "", fmt.Errorf("failed to decode HTTP repository spec: %w", err)
 		}
 		return httpSpec.Url, nil
+	case string(VaultRepoSpecTypeVault):
+		vaultSpec, err := t.AsVaultRepoSpec()
+		if err != nil {
+			return "", fmt.Errorf("failed to decode Vault repository spec: %w", err)
+		}
+		return vaultSpec.Url, nil
 	default:
 		return "", fmt.Errorf("unknown repository type: %s", repoType)
 	}
@@ -18,490 +18,595 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9iXIbN9YwDN8KHj5Ple0ZklrsOI7eSs0rS7KjxLIULc7rifxnwG6QRNTdYAC0ZCav",
-	"q/57+O7wu5KvcLA0uhu9UJKVjTNVsdjYDw4ODs762yBi6YJlJJNisPPbQERzkmL4cxcvTji7pjHhZwsS",
-	"qU8xERGnC0lZNtipVkC6dEIEwhnazQSdJATt5pKlWLVAJwmWU8ZT9Hh39+QJWpi2KGLZlM5yDrXGg+Fg",
-	"wdmCcEkJzAMv6AVP6sOfzwmimSQ8wwna3T1BuyeH6OL0jepBLhdksDMQktNsNvg0HOBczhmnv8IYjd0d",
-	"7+Zyvo1KlRHJ4gWjmWzsO0ooyeRh3NqnroQO91u6OCMRJ7JPNwJqBruKqVgkePkWp6Te0zd5irMRJzjG",
-	"anNMXZThlKAp40jOiduXYO8kUw3NUqc4T+RgR/KcDCsD/TAnck5Uh1TA5rjdpgKZTrwBJowlBGdqBMZn",
-	"ODOwV4s44WRKP9aXcgx/4AQtoAJMXw3kt4eFiTE6zCKW0mymfyPMCSIfF0yQGGFhO/gnlAZXbSd/DgWh",
-	"7VFNEJsC6pBM0kiP78OSZHk62PlxgPFi8CEwiIjYQmN8ufs3VEjVtcEAXQ1Jhjj5JScCsIBKkkLTWq/m",
-	"A+YcL+E3uyKdBwAqdSH+p+FAzYByhQ4/lmE0tKc2cPK8OXhnp3IGHDgKSLHJzySSag27E8GSXJITLOf1",
-	"dZySBSdCETRFh7Cpi6Y0IWiB5bxOYRbBfhQ8XGtVRcEc635YBkdFLIUk6Ri9ZZIgOccS4WyJyEcqpMI2",
-	"qHpDkwRNCGLXhN9wKiUBGkc+4nSRqHVtXGO+kbDZBl4sxgmbBSFdh8GCviNcwFRrhPnk0JShmExpptBl",
-	"TtC1/kZipKm8Qio4n9xCTCOtQuMM6aHG6Ixw1RCJOcuTWBHra8Il4iRis4z+6noDlFTDJFgSIQvSfI2T",
-	"nAwRzmKU4iXiRPWL8szrAaqIMTpiXNH0KdtBcykXYmdjY0bl+OqFGFO2EbE0zTMqlxsRyySnk1wyLjZi",
-	"ck2SDUFnI8yjOZUkkjknG3hBRzDZTC1KjNP4vzkRLOcREf5xvN6aEIm3BsPBNKGzuYxkogYrPtcP63Dw",
-	"caSaj64xB4qi+ik25J1rWnx7Zfs+ZKHig3Qhl2qgj6MZG9UO8e5i0U16FOzxYpEY2uOvEe54oY7lLzmO",
-	"EzhfCoaYZoQPhoM5SdLBcHCd9l4rzGfPdWs+fO96dzWKQcynb/RY5te7dPBBL9DOWzUhGdyCOEmOp4Od",
-	"H38b/A8n08HO4L83Cm5lw6DdxiuaENvo07C97ilJsKTXmnKoyiUKpj7W6U1lfvtEqAZnEsvAhphSlNAp",
-	"iZZRQpBQFeF2UtQovD88zzINbCHZYkHi/vsQmtap666hwpkdpby0g+z6HeaaJJYIJCkKcBxTffGelKrU",
-	"+ZASXA6ya8pZlirm5RpzCuzHFVmO4OijBaZcDBHNFMhJjOJcdYN4nkmakjFSeH5FlkBEdAuCozlKcyEV",
-	"bZ0QeUNIhragwvYXT1E0xxxHknDh3Vx2R8P01IHhjd27vTnOZiTeJxLTJAAWHMkg/VWzLRBA19LXww0W",
-	"9trW/I/FALXvsP2Yq+PDif5rZTRwc9+FUc90t20V9IDNNU7tVBQXvViE+Uq1YmAi63QI3cyZICgm1zQi",
-	"o0QRaw846lbkNCZqwxSswywtbEA3BdT14KzFVFVKaYYl42iRc0WoSnS/ZcPvAPYSysCMP1QZJW81BUSH",
-	"Fpk+tOPmCeOBB4L6ilK8WKhDQzMFgRRLdDmYMyFV4Y6j9urX5QA9JuPZeIguBy82X2zuvNi8HDwpcyXm",
-	"u+KVsFQ3+WBn8P+7vIz/uaP+8z+hbfKnaZjBl1gE9myPpalmjs1h0uQxSUp4o/oXgedgljHNqNyFHu3y",
-	"CZUc8yVKicQxlhh5HY/RhXobWBYmWaLJEvAaGA+WoEWCM2KBWOIbbhi/ShiO4RJ/gm7mJEOS40yoPVHb",
-	"U1siwoqbytTbCIgdHH8cH2fJ0r6tahiBC4ag7bqzfINefuna6ne3Nt17nz4MV7j4gMn01j1Ur66UCeAi",
-	"SSaTJRJEWhgrUrgBNMcQDfWwF2N0SnA8Ylmy3EER7JWi/KpdTDmJpN4kNcryfyFVDRmmVh0I1a+GsXry",
-	"eTsgtOAioddQZFhYPCOZrG/Ep+EgayR/fq9ACu3ltPX//v//n/KVhBKWzYZIr/GGyjnCKCHqlCHGUZan",
-	"E8I1w2yOLcoYulGsrVjgKPxENTfGa5IRLUgJHbtcITSiWcSJuolJbGFuWnsA1xesQsgaQYcXA9Qn8R9h",
-	"Wxw01HmdEV57mtrT0kVbq9Iu/w5RHwyFVX9ajrrh3BjO2Ou8xHE3tjIVyu2AO29oorjpcm3L4Tc0MCx6",
-	"uc11Y//vSr1/csTYyJccaD8NBywjPShKADJdTHtgyl1NgpDsalSFZVf9CmwqzPSpeWy+oSmVIiSm0OUo",
-	"gQpO/FZ5IpQvv2iRB871yYXuRB2piHH1kn6lOQB1TjkFlnqC1ZXGstoFVL73N8dffhGiLylJGV/WBz+C",
-	"72Z8oGXMCubUW/0OM9n+4nnaVxZSg3obwCOWCckxzfpCPXFb2POurOx916TVnZqLMH+ry0AmgwTNZkmZ",
-	"FhtBlKbbPnt7wskCG94VmHz9Z/E0POCcqWf5RXaVsRtFBdTRTIgkMTTRL0Tzl2qyMlOsp+5PpFbozaxW",
-	"FnzF6iI791pBsZhakb+6wDzscsNFsP7ypl0IwutPQp5nuyLMIOSCcP+NpIWH8LnGIVlp24SoNzDK1RWp",
-	"XsFUqNtXXbLQg+oNa+EedKPOH81ACOkuGxF6kz2mU/t7kpAnY7SvhflOiGdmhWVx8aqZCDXc4xkwGYot",
-	"5ozJJ4hOYUrq0qZTGnrElQVbFwYS/ueRuKKLkaUdIxA8KwAD39Vxft6xJE8rXG2VO9ViUAysWYyuoYVa",
-	"JbBAdclMeVfDXN9FRn/Jy+9ev1+zGQHqEmDeogTT9IQlNFquQGf0wk9LravMD8w9wPn81vPCPkzxjOiB",
-	"SgxS1+14pLjNW7SD8Robf6hes4FKtUOpd6VFteIfDVO5pFVZaTvqWpde6HtaxQGnXxucEnWUB8MGpJ6z",
-	"G++UznEWJ4DqBhn1E3ROELvJqg9QYOVTdl0WRpnxPrS/8fW0NZFsv7fu5bS9rR2zhqM0JZxkEQkxAKbI",
-	"ErmYLBK2JDE63jscqa1NKFYPE4WB6iWm7qYpjiSa4OhKga517NC58+fT8foQZ3maYr7syQyUhSWimRH4",
-	"huBEzpeD4WCfzDiO4ZarX/5vmT+X1S/78vSLQRureLNprBO458sVgvd9uUp1YQrquZzvgdFBQKZb0qu1",
-	"H3xXE+QrcFotIWrHX1O5TVtcQ2xfry0OfDV8SO9eqq0V3rqJFrWVxg3r4e1k2simQsJrTBOQ5jcsZgVK",
-	"msu5g1+IiJbf9A76wYOVy/n+MsMpjY49UOwKQWeghQhoTbuaIAx/CmCOgFMqQ7l41+Ry7pm3KLIeEGRq",
-	"ct+oev727PitUzuD7FHV1zyZYe4051cyeKCx2oIpJdxKJ3+8HMw4yxficjBEl4PNy8EHRdt+vBxEuZAs",
-	"1Z8Zn10OPjxZzZagzVTD3l21W+scTEycyUZtBcBOOfE047ORkU23ngg1/Fk+7Te8gIp9hh8BXMLDy059",
-	"RKlj7PDIp86xRrjAXVvBd6n1BQXSdGD9KUtIT2wvV0Xko+Q4kgJxpviIKWdpEKNRLoCdKDD17jiuhtwA",
-	"dDXoXkfiD/AL5uZ+EJykP+EoIsJguS1eEaGFeqpaaV+BRDs1LDqzFQGJGJ/tqBGt3uWxpe+Pdh49GaNT",
-	"gKM5s5aNcEMBcRaLBMQ3FZoyAiOYWO+E7Ui9K1guKz3MEjbBCQiNQdgKxipJUiZRt8RjWNtD4e8q5LqB",
-	"TsceY6xpNSCxfmSXMFlBTi9MS5lr0GqTAdu1t1xn7VfQcLAgXMsRWm5EXaWxCyGxbJ/EGdRo6KAu0pUr",
-	"yXN7DNDdQTuY+vTQDqVPTcjW3iyIc61NUMQJlvD6Msezcr0ocgGaFYWXdXrZ50ZVLdW9NOpztUJlI5iJ",
-	"2m461+vnvm17z+iz37328PWjXY0o1Mjx+6WFjZ22SgzzymVTaCTyxYKBfBRNmJyj48P9PaDw2kwzaCp9",
-	"q8fLFc0Cb4nvaBYjCrgMcDGWN24l9io7PTg7R9a2TlNZDSJv0YUdYYqXiGZTK/Q0lJkU1qaa19VmzvkE",
-	"dCPGZEbx3GO057SM+SLGoIM8zNAeTkmyhwX57FaEoLQfKZCF71NrUNC1BccAoyMiMbAeRnLV94GkxWHN",
-	"jyKzqd50zBhdeKwed+24DM8/wIvEPgT9S1XcH146zq3h/Vkb9h7emevT8LucBrWn+iyshtN6x7uQuo9K",
-	"H+NFI8ZUXGGGg6sXoqnydy9EpTJTiLrdSAeAmFeb0LiRp1PXQLX6gmRiTqeNav/jBcnOVIWKLL7K/JWs",
-	"+HszgbUZdbFsgTV3NmlYQcdZr+xcV/3q5inOsdHLwcoS+7y1K49s/4mi39nVp0jrw+X+niaVufd/T1Qa",
-	"3t87otZx7/dDtWUTVWh9rwR3r62FEwuq53b7cxMcSIwWX8O5xKd2vwe6LW9LfO/NnCiscvOyvigWzz4f",
-	"b22wqK9YoLbO9q3rc+BCNYutsuAXRFoJh7Aik86TV1H5q7ZNRuDCdW98z9QYMInSaCv6cN1FYrPizujV",
-	"hbbjJRYElLUHmdSWQWFb3ClORM0/cBdF6pVjrIGMyo2ojjyNApgyoOO9Q8TJjArJl3Xor+LumOAJSZCY",
-	"s5vMWh9eHBYPzj2SyeOzpicnTDE8DEBByzE9pb+dczFARDLJxGjCmIw2/B9mzBR/fEOymZwPdra/+GI4",
-	"SGlmf2+FDiqehRSvJCGRhPWqCoUBroZxIVAVkhOcfqUFpvrH1mZNZurNaWv7RXVOnm34j5eXNx/Uf8aj",
-	"D79tDre2v/wUtBJPaXaoO9/qUPEUEDdrDWOhjALSZfgM7HqGSALWrmrLJ/BZKAY602rSgKVX+Gil+CNN",
-	"89SY5yLG0YJwtYmgLJ4azSsccM2JWxSDMdVQ/S7CE9crXH0pzdSwPrScmesHEFmr7dYMQCt/rXD/zFZW",
-	"DXOQl5/PORFzlsRdHfjz+tS0EWcGsg0bYotLbomWSAKcNAAnBJGPJMolGOG37JdoHG+33K8ekTq5bq+H",
-	"osatdpxVDJMiZLNOu51TliQsl2e2ehXdXT8hNN/DEidspmZxSqYtRssV/UupWdcMy4MEJQyVDrunaue1",
-	"0t3AfUsNjMygSG3aEOGEZTNtaqpewx3RA0zb8Jm2HQMD5azd1DCKdCwwVwgUdtif4ywjAS/q3cwzgNV0",
-	"wNRFN3MazR0PBtYwklnGwlXOidAmO+4eDF9HkqTd3KAPOQUtkoSXc93oSoyucUJj6zts1M8559ptA1yd",
-	"haV0/mjdBjJ2Z8xaikkEkUrt6FThPDmjs4xms1MtBQmYQzdVLclgrRRF20Mg8+6KiraFLGZvdy1p/ZtJ",
-	"WhtxyIpNhDN7u103uvl9yW8bxwkLc1urlyW7jVUfTMjbOoNe13gz7VgLf/+qwt/2A1w3m+N4sQB7AJZn",
-	"McJaS6mVuTHaOzsdopTFJNH2XVf5hPCMSCIQZQBMvKBj7+4Q4+utcesU6seHfFxQzcSckYhlcdCDxXiO",
-	"o9iqCNlUX9BULp2G1ZuIGkYbpeh3w9PtQf0ZMRyAyU+bN21/2UTF7V91jLDUyEWcL0LhXmBhDBetgvOC",
-	"LfIEe76JuyeHSMCJUbCH+iB3UicyTXNZYZEKHOBNHMI5vMoEef5sRLKIxSRGJwdHxd/f7Z3999amms4Y",
-	"HdlXyZyAg8PY8Q2UJPA6wT4+tDEfmiqUtmSylCR0cIAd4Q3ShizWSGakDBYndBvtNwqk6pccJ+CP4QIb",
-	"dQgUchogfReH+w+wa94kBJ6F5GkX8N05mWgTC7ghrsgS6VYeNAxLSoXIy3zdaqI267TTbs/7AICpEEaL",
-	"2yVUWY0QNhjuF+iFF+ptgpONmGQUJxtTTJOca8l17o4yrNLzVRYNcEd0WkRHCpnDFlUbYr3pLuuc+rAA",
-	"HGLq0ehg3uusKWJLXTyBqsu0LdPW9oUvsg2+hb7L2E2GIq8iJ2gXQEfiIdonGVX/Kgi9wtSEPevHt9g+",
-	"O42hvSUEcWBOoqtTsmCCSsaXxxEFiaX3glrhdW7pmWQoUv3CviJn0HO8d2gkjYoGgYiJWlluixi3RboK",
-	"ew89qoO40S5m7ZKyoj2WTmhm3LPKHcyZIuuWCSvg5Uj30PBpjKcay6d5kpi5FSILO49fcrwELus+pb6N",
-	"ItJ++35KBFhqrbjjqpEJC+ZL4w0CPJZ4po81rJ9xAxK7+zShcvkk8GBw2NHsxyDd5ivmBs/qWOVvYdiT",
-	"gXDO+B6LQwqC8/MTS8/U5Y84kTnPCmpdWq4Wy3ijCwQAG6PdCYQ0cK5WllQaZ02cITWSiX4D8zFokhF5",
-	"w/gVBFpgufRVDz5/plocEaEuufoiwEsGpbrYRuFUL5Kb+TIIQJiSW0b3ZeNtVD80O8ezz0Jc9EIcuole",
-	"GqI/MWnhfsUHoS+gaWkDlAK+2x2wZnUH307sq/EX9aHvQ3nUrh8K42Y93MRtgt+U4hl1WiTUI6qt0KTB",
-	"ZXYFZ90m7UBn0IxeOoZO/90soVnzHD58Cm+TZXV6745r4vZkEQgA1bMPbe7Uz+y3FvrI9VKwwDrGl1a+",
-	"sowgrEiYdFJ5LT83EZJsFE71MDh1j0QfKOEoWuprwXciIXkOgho0ZUnCbtQF8F3xMFW9+9IbdCGIiT2k",
-	"wA2Kljj2iS3YEpAsTwNqUizkOceZ0MCjTbRV1StiCBVzla4tiTVZVEAy97CaScbU7V9i32MsyUj1FRY0",
-	"NdyNYAGAnAWAvSSpfuQoGNmtwhOWSzNjN72wJfwE3m9xW6wltfqxlVSNZ65moWUqoHGDhY5/Bf6D+UIP",
-	"64ttnj8LsgWcYBFW2jyecEqmT5CuUUiG7JiPRK+V9pRy214bpNqml2EIbdwiij1spQ/d7ualdQ4BsdgU",
-	"nfOcDNEr4DmQ8Rr2rWJU+WA4gAqeX3Q/N+jK7Exfla+268pnN5K/yoZQh8a4p8Ac6gt7y7EN4fk5GA7O",
-	"T47eEQ5iIO0lbQv0wxTWrF6l9aoF01f5YYnUCeZCh4hZZhH88Q4nVP1rVN2HivbPOBFq8y8WMTahXtRN",
-	"YKse5Ymki4Qc32SEC5jXNY3IPolYmlIhKMv6B585yDhLkpRk0jCS3nprZeXlNspJvC4a6zhYNtZwQG6s",
-	"UZ5OwSIGQa8g3lhQ2x+/0O3Vq4QQaXcBfoR2Te+Gt3f6g7+D+kvffdRoPqWzquFCPwbnNZWB5p1Wve4e",
-	"1OHFb8HQ3GLUb6RchJoZGNQDlP3BOVNwlvorcbIf6lzjgvFQsDY/wOqtQsNA3NaAfJn78cpWjC4WDCzW",
-	"cHvWkK2s36msthyY1YWSKoWO0cFTUtBo1iPq/9nBOBzsLXJb44hlihQ7UyZ3RsuLTnW17jjFhYKYIdOo",
-	"Wwjj9x4M59Qe97y+Ek2HOMsOPi4UtQ/HDOUsQ8RVsB7uCi1U33GegOqbpkSMLzO1SFODCvSffyDz///s",
-	"oBE6olkuidhB//nHf1Bq1Gqboy++GqMR+oblvFa0/VQV7eOlAtoRy+S8XGNr9HRL1QgWbW17jX8g5Kra",
-	"+/PxZXamPSxJjNRGYsnUJEaq4o7T/OFsadT9xnZWdUMzNFdTdv2RawJynpw/UeP+Z/SfHXSKs8Li9j+b",
-	"oxf/AcBtbaPdI7X3L9Duka49/M8OAoMHW3lruLVtagsJyoOtbTlHKcBQt9n4zw46k2RRTGvDttGTqbY4",
-	"044H5bW8KECiOOcXXpPL7EAHY1SQQ5ujF8Ot56Ptp2ZLgw+GPQgpoq/+w2zK2nTK1TcLqNy1YWiMdGwS",
-	"GyLabEBDcPCyltDrhGYaGUG/Bs+7coik2pnfJwuSxSSLljqO9z6REI++MQL8Z4lM3jSLYECuKc1mhC84",
-	"zRoU3Rm5QV4lI6UDrkyis292n7hHkw5drgi7Gb4p0DCQku/IMjygo25XZGnj0SytgUzRudGXmkGt7HBG",
-	"5U66HHGyYBspplnYGr8toro/vzJ4PrTuuGKMNbd2SqbFM3MF4XVrX77xYSnssb831hYRzqk2bHWOJ48E",
-	"Ih9NSpXyFlX0qCWOs5/TUGUosxuqZEYlYhy0F7aW2V3VPuwp0YmS/pLNDBw4Ip3Jw0xBDV+g6hCJOd7+",
-	"4rlqBDOasHg5RN+9ECYhlpOfGaOh8PwSLOSFtpfalX0EV/58HcLSMRlXUdpO/gYLa5H1pK8Qq67SrW5j",
-	"N/6ecDYh+qn5e5GsyjSCNAvUWeHRSUmX5TQmU+hMIeiEPABVMsN9LqKk19+9nfdAhcLERyyzaM5ZEZWk",
-	"QHBhlDpVSkOJCeaor88hivBC5hC3vZa+IESQTsk09CAgfKTLR474+KdNMT5wFo11vBphCMJSp2o1Iev1",
-	"FPo/KtoJf68QnprNWXl7zHQ9U/TFfCloBNB20RttGOyyXW1TjipttWpnVDa8BK83gMc0IRAY3gZscfkM",
-	"BtPn2/F08mz6RbwdxZPJV0+ffvX0+fbki+nWi+l2RLafv4i//OL5s68mcfRic3Pz6XSTbD7b/mobf0mm",
-	"L6KnAJ+1gfzfyEC+EAP21xOYNrcwff/QePpq4bpDET1XzZVC0gmJ47bwmoGUGLaRc/tjTBqLhbBZStbs",
-	"sloorBqSKjXcgThuuP2s0+LUjwuu/ZEwJzDcEvUOVg25PwLU/K0bxdZBVlfWFGg/oNS6pwjqVCCeQ7g9",
-	"Ez39cIomCc6uhsGEJnlmI6lDVHXo03zQcZWrUc/vPch532MUThzwadgc5rpQjpkqLhRzFWq3j3rdcnEG",
-	"oyIrVPVwaVhoCd3pG7Ymbqmd/3LY35CEQegKFn3mEKS4Ev87EEm5Yk5kxBqtx9aXPGhG0N5QwM2UEl/c",
-	"hwq2PY50g0K2Gap7eIHBbM+S0P78jd8U7Je4Z/tseDWXx6cS7EIcGSO91rsKarl1Na0AGLomVNjzDDAK",
-	"rbGZneZHA3EezBO1Me3qqX3DWmfJpn67TLvL43xoW6RgSTALpVdcZf4j8zliWUYio0d26BrysQbR7+F+",
-	"mCibYnS475sZVEYIo7ZueeQxKZUT69DOjeKytZnLCvKJax+Ar0upJCOc+V624PuNE/qrftG7nKmEq2dt",
-	"MnRz1t7nqtkQERk1bVc5/1TpcFVWNfQA2LyVvp40lGTH5qgDKaZ9hKG4rF31M0JXjKEwn+nM4t0Mmj+V",
-	"c2gXto7SXfZbktdP/XZyHh36sOg8ctWlpUTOWVw+Ur4E4iIjoOAHg4ZIMr48JYL0zaDZNmOv57Zq5VEd",
-	"FA4VZ8OpXIKVbBNBaq5be7qXSBa1LYxB5oJwdSK0m9otb7FR8BYr5OfVMfWM7nB5NS/+drdXY08dVkMr",
-	"ALPAOpt/4CITVpfk29Q4k45V8DC0gGKktjr+HJrrudk1VynmXQdrow2WYa+aULR2dquCSRgFRHNyeXuk",
-	"AZ/iVZm0Ar2BQSsm3cGeqdoOVvX7kaaKwU0Xdu2Vzq+hZcF69zN2vNWpMsms9BbZF4NcpHeB860PZn0y",
-	"vY9m4wXgGU85/A4fz1sdxcqxaFhS08nqOMP141scuzdYyDNCsqZLw5ZXLwpANaEKpI+FuPH8JY0D1TUi",
-	"hv/Qlqskc6lbCbd930Ll4SbQjEEuz/I3jF1ZxLEY8JJMGfdt1XanknDvt65wSiaM+TWKD6tgRmkqtaED",
-	"daqzaezGn2BTP96c68C51bPHJe69hydvVdleyQp8D9xCZa23YxRCnTQRIvdiaIBYnSPQBqeGGpStIMtf",
-	"ViRJlVlXiUqluDSLQHloah3VyuQpGGGkKCuHE9HfHy5CtDdeT60QKFDWcUH+aHFBhgMjvOu3g5a3uL+A",
-	"IiEr59/LPKh5JkF1O9h30WwGRt4thwXUgzZM6RxLbRhWYbf6hk5o04ZXJtQX3KdEsOS6Bdw2rC1UbzA9",
-	"gTW6OMtYIKYqo8cZuHJPUcb0F5Dvq48Y/JNLecZ947MH2mC79uAGLzi5piwXR6tstNlj2zZZGjvA+JYb",
-	"ro0ckrzZf+Ubk6cyYtk0oZE2k+FmYT4AtKEirAYyE9q/YF37RGfx/bCyAYY3t2aUOxZ1M9+WNJLG+7ie",
-	"MlL7AONZgz9yR3bKXt02ap9WzaXbPpVyEEEj224MtHi3UJNdrpYHFNSvwXgTzkN6CBBrCyJZCWeoRcUW",
-	"E2vw52Q6LiHIbdjr47PemPCurD44PqtpkStRiffprDHOUQxl1b6MaZM2p9vBm+PxuIdJk55vedCWkwSE",
-	"a04X2or1d7klq3MIks+M3LTcGBm5MXeEvjvcTWES5/a7KCyZbRnIVgmPlrGM9BmqmQg275TzD1sJsZ3P",
-	"RZdgL1rk/bi28jyskCqm4uou7VOSsr58Y6iHqmHmIh+4Ts3s+oK2HcdFyTdEA7uM1EVa3R8wN8+1PU4l",
-	"jXASyOq7yquyPFE/aXC9tBg8VOpNKFRsJxkq851hXTnkxu4XTARnS3Nll+VKfuhrcPryiyGQm1f8oSUo",
-	"CYfpuFjfLuOriSxvOkE4izcYNyHi7Ncx2pUoIVhR44wUldNcwFPNGEDGFfO/8ux3BiS7ppxBWP+vF5zF",
-	"OShYh5IS/vWUgwFyPKiZ45UXGbKNsNPRq5RcMWh+eG4vvrmBghb6UbNOHVLAM6ExjkBY+GEIyiARRWx8",
-	"5yuv8PJrPdjW0EiLFnMsyH99fUKymGaNSeEqkLrfNWoryl5rLCODt8YrstzSWuqt4RVZbv+X/rHdaE/c",
-	"TFTgUIgFywRZPZoTNNNiBVimDoPgJCUe8mkoxOZRPdh5+qluFVGu0WwT5oCreK4bwgkyIeinORhV6Y5C",
-	"RmE1A4nSkM3ENxzrsyizsZIgmGDT7eXbBrWkIvMsem6RkazRz7X2yIpc3vLwRCquHGKVaHN1B+fQ8KI7",
-	"3wmOJL0u7ECMAcSqYjhr3hKMMlqWWq5s2ABxPHrOwzwJq26mFeqipla6wI3DZjlAfX8YVFw2g+mKwf4x",
-	"XpEAnDvLydgqbETFE7Xi16qe3yc6WJNoy7Sg5UUmrFN5pTVSbJIgmXnkGdWSp6F+4TJepDmG9KHmTSfm",
-	"JElGQi4TnfHYDgbzh9HxDNNM+DHqE4ZjoocQtXhYz8thqDZHX+HRr7ujf+9cXo5+Gl/C/368vPzwX5eX",
-	"o8vLf1xe/uvDPx//7371nvzr8eXl+EddMVT8P83pl9r8DrRM94QlNOrJ1l54LZre2o5o3s7ppGjqKyLD",
-	"SqHiHeHILjJtUwwqd5poy9tI5jgp4iTdlUpb+0OPWPts9gq0qW53HjifuG6VuXLvFatWRYIrxpk9CKnf",
-	"on/EU7ePOrEYWHJbG1m1F8FQVjgkOrxllFP/tut1XRQmn3BH+B4+q/kD+ZYkRkd/K3sLayJyP3p19Pjt",
-	"8fnBjtYKOddoE9CxGrly9+Swr++hER3+LFg2orOMceJM0p2O81Zq2RVvWdemdziHoPxiVWVR7YTpW8n6",
-	"r/fooKhfvpXDVKh06a1Mf/Rg8UVGZTPlMWq/VW6HuMGqxyMWJciUydsgTO38rfTPkjvZgB/FfIud81Gv",
-	"hcO/tcm/d9rmmMc3kH4zs3Eg1IvIeJe4BXweVwAzBxv77j6cAQKguZ19RL2LDjOtulXWMQRPAnHPjGPt",
-	"1WElQL6dywlTL8L4eDotmW3t3mAqIUaWsSXXAdRAfXSCc7Gi6URpQd7UamXebAOlZRFWqahuu1MqLi0z",
-	"UF415igVhoARqFaFT7GdJbLWLyzHsc1lZU6Dl8qBfFwwUdw32qnjMjvA0RycrCPGOcgaYmE0MPYhpI+F",
-	"8TB27MxyfJl1B/jQiyidqoglCWi/C0uJRjZRTbLRgUPdx7vg7OVpuWrz8Y0fGvrwajT4wAR7VqgTcrN4",
-	"yZhEh/urdKXjp/S5wmohWz5BOmlNBDW0w6s8dpTyzFLKntOr2mT4AHVQqM9iWN6+ZrpVe+50+BwsoCYo",
-	"llKc4VkhDzP2M2KIaBYleayjapPMfkdizvIkRhOCYnaTmaemukdMuoCAmbOpd6bDJ3UyVnoxrra73G/b",
-	"/lMH2OJbqTf1nO7VdNC/Hq37//1dj6XF3u56rHexgvFgATBnObg4Z/sYclQc5/J4av72LEZvo9cpTdIb",
-	"IlDqjxpsXDFdLZfWVDfv8iQj3ND2vWvi6X+rQDKRi+NS3OQFRHICYO29O0DXfneIXIcDz0XX5DDAeqsO",
-	"TNAP6uLb7L07GG1vbj8bbW0/ffZkjI4Oz08PjHBJlb1///79yGYY9ZoPkTVgKixBISFQIgnX6ZicRb8n",
-	"bHr+rCRrUiP8uDn66sNvzz7ZP4bhlLmfUUFe3qR3Bw0xpriQh22WBjoDsrE1cKE5FNTVUxbaq9npWxrs",
-	"LqiwwHs8p0IyTiOcbOA8pia10hD5JgoNBgr+3IzVSatHk7N/KIxD7me2qwaE0XjaTFt8eVHHm8bqVaxH",
-	"JNgNOGkALG9KDL664FJgStUqRuuSA/7WJ0K1jTSy89unepbXCSf4Sl2HrSuZLNGlP6/LQd2GvIDe6sIx",
-	"C+mqkExUn5Z/ADCYObWDQDKJkwZCoYq8YBChkXrGHjdMyB8JOkaI0Aadqg8rgGoYQPvq/lcW3H1w7+BC",
-	"/9IuWfh7NLGOdWMfx63uvcWZ/vPszB7LM9k0q7Ee3dyzQHGHaIGjKzwjT8ZIoaJ6IV4Ocs01XA5QpPsr",
-	"KYnn+JoY2aZ5Wegsw+7BGNzg+sZQcdUZynXl6KnDP1j41+ADxyg54GWjOwCuhYornTEvELkXy3mTJSEH",
-	"g4Ylgjw5xeQtw+H12b4WGCOQWEPvFc9h1Jd5bFzWK6qmSo1yLnlIsMSmCCcJu1EMpautb0KuY5wjCgRk",
-	"YQKd18Ew4yxfvFw2C3G1kccVWYJww7gKI2imQGxDIXjjT2C6JTmvxw4+/nF39G88+lUxgj+O3N8/bYw/",
-	"/OPJv7zCHkpDYDsvMpdAPLyfKc1omqfedWD3qEg97s5jnAPmGPCZXJKquZ9EyKMcKc12O4bHHyvD51l9",
-	"XLePK40fpAIsuiJ8N9fovYpuExqadwHO5Vy9A7yD5WWfokHXplzO+wSgOo7orq36ST0VhLhhPG4wFjWl",
-	"kNWLXRE9FZdvqjzN0pXu+g3m3mzKdlkKv9QxVIe0x67RG85bbfBmzdvSrFhEcjlxLc64kFM6yIlkSEE9",
-	"IZLoK8g1KIQ4NrsoeIZgBDkY6LXxPybcpNbRIi6s1XZ5RuUYFYGk3UfIBLmD/iN0TGahs/oO0X9S/UGH",
-	"WVYf5voDBJQG/PHIwr92ftwaffXh8jL+x5N/XV7GP4p0HqYBB1nEYprN+kTZIKauvpMgSAoQcSxxofZ1",
-	"G2qfjIsE02wwHOjcub1zcuihTkxj+/ul6eSTn5pjz+l7K8mRXY2R0YV2naaizzPToIqIgT5DyFfLGxJI",
-	"wFetUg7v6DIGM+5yqips1BMoacxvF/axPsWyi50J8rj1NH7+dDt+8fzpl08jjEmMnz+L8bPNL7anX33x",
-	"5RTjL59tT6MvN7/Y3Nx+/uWzF5Poy682n38RvXix9VW8Ndn0YwNGgg92BiP1v5cHrw/for2D0/PDV4d7",
-	"u+cH6PTg+4uDs3MovcyODg9fvvx57yX//vDl7v7LN0cXVzenN+/3333//f7B5u7Ho+3vt49+/fbqeP/9",
-	"r29/ffvz+x9eJf9+fbD99vXp/O3+7tZldpS+/+LteZy+/+Hg6dv9b9P3v0Y3b893b45+fv/07f6cvv81",
-	"+uJo//3W+19nz47Ok6ujHw5vjl5d3RzcvP/mO/bvw8vs158393a/f3+ofv368+b+7vfR/vez3YNvXh7t",
-	"Pd18e/rt+bdP3/5wnBD61fsfrl4ebRz9yt7uv14enX6X/3qwuXGZRd9dLf/Pu2/Jx29+2fx4mG1vv997",
-	"+/bpv/fffvx488PzN8n3s6f059fZ9Zn8/njyfHf3aJe93tv75fXZ0bOvXu4e7V1mu5uz3aODi73D7/fP",
-	"+Ef6/IrHe99Fb/bm8dHLpzdfHv6S7if/np8evJ58c7R3cPYuey7Eye7h7N9v/vk9/1beXGYvTv/Jny0o",
-	"fn/97yvJxdXT5d5h/uvT+eGXCXuf/p+Tp/GLry8zAPvB2/2WLVnH6/y7xeuskYjVQnfWm98iiqeZaS8i",
-	"u2voZA9ia6sW2ffC+gRHej0rJVRcAs3Bs7DNANWSK/vGCwxq6f0cCzQhJEO2g3Ac0CI+b9NLvUMl+gY6",
-	"UDcKJEQvxSpCh4qtXyQ4IqaaTVqLHpvX/ZOhMW6HUKAp4TObwhTUbTYwc2xreceuBrvgcOCn5I8B/Aa2",
-	"0eg0S4amVMd5kwhMkEBaGRq/IdW/N6beJyO5CAcpVMeXJcW21QEALC506h4fFoFglZ8XhquCDDSOwZFU",
-	"Y23TFUS/2vk1qL7SIfWkgL3kKc2nvS7I6Bi069B7pqZ3Pf5NuQKA4cfShNP1CcANFqWz3y+8k23xctmd",
-	"uMHU7SE/8nod+kvqkd+0awtuYe8bAHxxvIK4Fo4zEqxWDjlSq/JgwUeCI/ey8asz9OuIJH+0iCT3FVgk",
-	"zJl1Yzo4MFHtueQq6jNWq/tIIB2uU9tpB9TmosEp+eTgaATCAhKjk+/2zv57axNFRQpLJHQOS596BriV",
-	"sl9B/xjxwwGoB067Iu+e+xlkwtF3AWVNcNHxz4Jl6LENkNDiTXgXtmxXs2NTexO7zL/WmvuGqtf/YpEs",
-	"Fe/mK5lBVK3OkEcmqQjxkQUe3Sp6csnQV/CeCNpgINRQcbX7oRe5Lt4Gt2IzPF+KApW78d/EjPHahE3v",
-	"2rwr6pm/yR3uiRbfiWYr7vY9PivEa027a6q0sV5zdmPkrYpsA6XQ3DB6BZIsZDhwH8G94IF1AXohYV5Z",
-	"8Aci/09DX96X05G9ucLbfnH6xu7OxWFxcnUmgFxohzmdokZ9//4UKRTR6WpodqVz6MB4RYqhRlvN20o0",
-	"mwSbFXgVAzTCoBdKWNVJB1qoagVqeHxBRXXiI43Oe3YL1NBdj7wjOQqHEt+Dil7y5X0scTFN/5iDEByu",
-	"C2ynrvpHU5po1cf5m7PwwdeTuSLL1kl8R5YrDX5Fll1jVw97A1TqU+y18f1JQg/KYGPCq2PBbrnp3roU",
-	"UjFOZSPIi7q7tmoz9H3OxvXsfxWNBzgU1UVzzyACUcQjjjkRznC2c+HosWWE50xI9erbWTAue1iatQDI",
-	"TTa484pjDmzztX6meSoNY0UGVpiaPLIIXAFd+hvtLxAg5uHgDNWHLeRfAa2ZhoUmwZzOZsDjwdtDDa41",
-	"efqNA/wUBNIgU/pRK+mIDhiluttBj0HLBrbH6oN44o1gSnEuWareJ841Oswd3vbJGBdGsK20Xq3NGsyC",
-	"F+I1xKbSgt9+4mEXxWv9WLz3xyKkJwzZZs7L9qaVp1k1gL+Co3ZyaLBov51CgBMsQs+kXSTmjMshSnE0",
-	"pxkp5mm2H05ZERRDy7JUX06Xrg+dpxO2tlF7nBgPvtIXyjIXE9sWXDhnv/KXWkUb6q/yxe+zHtmh4XOl",
-	"xd7JRS1O0d7JRTWy0d7JxVt1gRWVjiDwU62t/lxtrr9Wetin4qrWXn2stlbfKm39BP4lJzSvoOa75pVV",
-	"4zrtU2EuZD9qecCLreJUVv3swlN6BZVe93Ru1JoLgvledz5wDYJuB5X9rNqx1wBcrVCbcbVCdTeOz8DK",
-	"3AaSaxSHt5XhpDLthiiu7fFPB358m3c4oeUvh9m1+XZofOzOsbhyA/sfTwhPcQZhMrzDB1YvjC93IToP",
-	"nSSk9Pkww+UCc83ERZXihIOduZ0j/CimBz9PtUVXQT78r2cS8/pXN9VSB0YpUv3+Estovk/FQgc3rZQa",
-	"qJHEwr3W1O/X+ZlDxtA0pdLbMb+wArmioAa7ougEc1HrSH2k2axGGSFGwVIBPfDRw7GGROIteYMHQ+Ni",
-	"eUqEZNxgc8SXcIkc0Rm3ZtJmZwKFPsw84uOCA7QFY9RL6cUfnemqTvTRZnPrMYzHGXzRlHWIzDn37zRH",
-	"dC0N+NCtXWmX/pbZN3dDF6yEGcCtf2gY5UY2vdGbCkpHLj6qYTiH6oq3XlYubJ3h35cLeGWVfIZ04J/F",
-	"wkRXatvG7qBW1SZ28i0o2hmmI5yhvw2ze0X+CKTQ7iDWrZLs9oDhHXR+hZ6rsbGbgrB2xOBoCNnadEe2",
-	"99bkANhKZRt6bG7R0qtH9vt2WzQJ97vSRDvmWLl8enRYbhHutR3Z6zXDvVj63qMbU7XoJ8B2NHRTrxnu",
-	"pc6n9Oiw1qjou41nafQBaWzi91tiENoxJVi53lfnvErVPJmBDRD0Vlt/eu6Hn4YDlpEVHF9qnfcK6NNA",
-	"TPq1biect+mjSiK7+mhGzlVaNmJhVyet6NHduBNbu7poOeKrNF1t0a3Uc5XGDcR85S7uNIkwue6Hu02X",
-	"Z3frdgapf/sGbqirgx5s36cPKwUpB462wf7HFlVsfhqiA3wuQx83XD/rHhCzry16/rIWPd7jNPgodbPQ",
-	"AlcKWVAWLAOxQF3UWtF+2cbdSpQVx+lQKrlxQ2t+RRMrsGtaMxRqI48pDaWZi9rag+8RkuSjRI8vzl+N",
-	"XoDyRnsiFfq7YhDwDTfDhEw0VD3ritStefc8q4LOhWr5zXmsVanLXN3ga9qQrogm5JHQbqVDzzvNqLXA",
-	"SU3vOEdZnhJOI3S4P0b72iwZzBQuB5wxeTm4fcqY4SA1pkWNM1wQbgTtSNUdo/csBxqj56wDQqWKIkxx",
-	"ShOKOWKRxIk1C0kIBn+0XwlnNmD65vNnz2CXsbZyi2hqGugk2KE2z7Y3nygiJ3MabwgiZ+ofSaOrJZoY",
-	"lzzksmyCqbUiYg6wQx0PqrwYOClqnQLFHlzV9Mbh2AiiMf2UhhZk+Pis+znYGVwU3pW3zgwEqHtsVVR+",
-	"ss3ICWxNHhQvimM/x8BS15781/986voufbZvog9mhqu58/u0qpOd8w92J+szgSRT5ASDxdFvdad3R3oa",
-	"3N+Be1zRP/mVCfjiq+eJn87g/vigNYPyp3D2AoxYzcFLN7lfpy7oM8y3u6Iy364T1z0Y314M14tv14dz",
-	"zbf/Vfn27sd/zS99ApkJg1c9FAG3Ug7MVUSweJg4by2y9mCsNyNfDb4tXKgOXasaiwmW3DN+lMn9ckJ4",
-	"RDIZtKaBRBW6mmLJTD3L3N9isGmedC2sqHmXxUmSLhIsSavDgv9SOy83sBbHVBg0Uk83Y0wMRvMsiD+S",
-	"piQ+zmXXIqEedHSXNd46zFj/Udoi6FVhPDSHMYRaQxfpy8MEh+se4HqRhbpY8S9BFzxtWYgw/C44fRsE",
-	"6NrDbqr+2eHdToLvEdIl3FIQt77DEO/ljgDvAnRY/P3w0K7oWYO3nqr+tjHykQ9sDVLnEmI8thRWE8ga",
-	"QGws8iB87293W4aG9HVZTFbd4AIKq292WUnw8Jts9MIPep4MF/T5T1JF//bw0LXmACHwcluFY0lmAad1",
-	"04d6BkENZ3BU2FtBHoaXn/32KV85d75vqivvsY1Bx8l6ndV8JmscREWurp0OX3bxJIZhKzKnabKi+tXs",
-	"YkNuxvCanUxuRUlWi18zrLPTl9nAoV9+tNNSZfDlKfKHtj4/S8lGPQy9RTY+27SIGtkQl7cScPSzCZC8",
-	"9Jm1qPhhaU81sK4FRuORuFWaOa/lLU5I7yRzUHuICMjncZIsES0eMd6Jc/FpwZkssgFqIYcEKbly0Qxh",
-	"dDNvUnut5i/s0OHu+dXiWrDx/gGzPdrfS3JWJoIrOii/pjKQoLR2Ec6oDIaB1wECbMh3cDx8TWU5NSfS",
-	"nnGrhMS1gXC1shUSr5ojW5jdBJlA7oq7b7KiKyclDDOWQBVPyTVtC5KgS9Wkc5sDuHO+tfy7bvK1UYdN",
-	"wX2Hg6wXe13JX9s9G6PiMjvfgDvf5JPDTHKmTjSYrwdjbDRULCIMQ6BV6pejXKg7UrdEuyeH6PHJ8dk5",
-	"2vCzoW38pgWyP9H40wZ08sRLJH28m8v5to/XRn57qDPJ6B9nJOJEx5B8iQWNEPhZq/KL0zeAiHXEbTaL",
-	"L6+hyo/NqJznkyAflvOkFF5rYEXEeEHHut04YukgdM15QJpgAU72Zc1muC9Ys26rfg7RJJcowhmaEKTT",
-	"HNFfTfxSM8JBJglfcCqIEZt3Y5FsMj56rfBqwW7BzSgCUxwVq+w1YXJtwFiBMgauxujxIp8kNNJNngzR",
-	"N+fnJxvqP2dQDglwz86+gR9qPRmTOv92sQgFvz2bV0+Iufn7Qy3Yo1exg3J/U9T85PfZlaTQVWz1zvDA",
-	"A0+U0qOkgpE9tcrefim+/bVq6ONtACn9aajDJBmKEpZp6liKyjrwFCIGOzdM4YbqRGGtDk1tk75sdSGe",
-	"mtiwGf2+IUnqWdT1V3IHUoq/xIKAWrqf8S+4uzVnJu/gOrDECZsdgiPRtLGXD/Uw+rCTC9xkO2V4f1er",
-	"iPLsJfWNySJhy9S6qrrtS5cjvFiMiiECFA60dC2MKYTmq8cT9PgI3UNoYt6xx3xCJcecJkuUEQEe59ZD",
-	"p5qFHxIo6MNesA2DbEazj3ADzwY7g63x9pb2FIeI9tpUA09Al6OnPGdCCth19ddgx45g6LW6QnSx5ncG",
-	"G+ajFisMTsCrXm3ZBxNwkUYYskAMdp6WgpioBQ52Xmw64O4luZCEH56En4saXq9oMK281dVaoIIZjmLg",
-	"IG6Siczo7TeCfsDOh5MEQ/RuWJqflQ34cZ2wnceEowmZMh1kkRcBFPWIpa340cxVVYpzuDzHS5yqE2wK",
-	"2DXhnMZEjJdpok6049G7E337ZEFveTA4X51GMHa1G9XJQyUT27Q12TPIR9JcgGY4NZxGOXr6hCDykUS5",
-	"1BGoer0+1NxaXyCSpoQ1qaz+0KHd0SPxqBzZ/VH6qBzZXaHco/mju0d3/xTK+NGPjBfYcZpn9viWPwbC",
-	"rV+/w/wuwc8OsmvKWQaP4GvMKUQZuCLLkTaCWGDKIaniz1pibdM75JmCcTjEa541WtOmCtBlDPUzNuJs",
-	"iTCf5SlICzTHLiTOYsxjJOYkSZBYZhJ/VMhDTU4dayYoUGrcSOxIAi3oAsTsM4geO1QYReH5skQ3hBeT",
-	"QHmmyAtW/O4cjSJtoPoxrOW7YfxqnzYYDqpCnbrFJmHRy4W4sDqzSZ5l1qTETLTHUy4PC5/Lx3ZnFVxz",
-	"zU6wnB8vOjmFUpuDjwt1ewGt6JyXV7ke6iJDxBV7xI0o/INUkgyC7Kqtc6KHMM0zuV1IHNy10JJr54k1",
-	"mPe64B+PxROkxtd3lwTTZpKwG22OClIGtQSBJRXGmBa+uqn3N2IqGXQGCHKztAMb80Yn9tCG3Ai0CQ4t",
-	"HahBOhZpz487gjmUP2iooBrEkdLjZoUHW5mLU5NUzy+ddFRRgoDoDo8jHri7dG4LZM3SOWMS7e0G8adn",
-	"mhcTm0gbDgTm1Su9i7iiC/0gfke4e4sG4lZd0QXiJGWSGKEYuvYahMOYy0T0Asb5mzMdT80aw/eauur9",
-	"iiz7935Flv07Z1ckazJlsbl17gz9FZLrtI3VzRl4J6BdWqpesz3FpZmeST+BqaIKJ0Eyor5aEamWPT/S",
-	"PL3NLy6ZF0fbunO4ZJYmsTRMRRCFlwV/d8OplCS7s7iV18WtVlpq4q+LZRahFkGsyKfqpRRYPHeuKSBn",
-	"UKQyYqki+VNpkgcUkrFDLeXSbAxBv+QEUq9xnBJJuEAij+YIix10OdhQFHFDsg1rBfovqP011A55CrSK",
-	"dN32PbwU12JkE12/pSgOEMZZyIpabjyXH7WE33XEvq3c7B4kYGroniIwH1Dq8f4NNG0TggF8rOgLJ0lY",
-	"6OXJCzYiK2ZslXXBs9hkQz5rOBVqWH1iNDPLskSnYrdNFQOvjUCNpqWAGUjRuUAphFJUR9SeLc3Cw0sP",
-	"bl+zOMsxT5YWRfU5Fkhhk3oWwEyIMC8BCCk4J8lCU2OpYw2aFVlkV/Bx2NWN6h0Sv1axW922okKlj/cO",
-	"TYZjdY1wSac4kmbyeBbIz54GTW9X77Z9zU3iDLPcI5Zn8h1L8pS0L1fX0WqrYk6paq6YTM+Tq0El4tbb",
-	"KfnUQxUxhlIt6mpvqRvBchpgYDtqhMUxL8tO675NfwoMuIPTWftUUKTBg9RzxwZ7D7HmPhA7leR1kAdm",
-	"Vs+MYdz23JXigcnxT6C5wVX1c2gVhiPQqi1uwlXU4M/JdOyw5SRPksLSpVDLHU7fMnmiDSRqyrhjsxVl",
-	"7dsjv82jMfphTjLwJFNlu8kNXopH2j9Sz4MKtMjBNEhxYkuQjlVavVUlpUbwMsQJJzheIvIRhLtZJUC2",
-	"vfL0mINhdTHQa8+7UMHH9aN+VPpSn0x/FqR/ZUIbprEBVaIhUp/ui37eZppNLvT7pQClhkVnUwO+EUiC",
-	"Kc5kHZBjdPARRzJZIqaDwD1yp/KRqveoTDUeOYG4u1Y+B6EZDhalU9wJWu/QA1xtnN8+2NUGHm2mZDO7",
-	"GhYoxUsFARcxm3CvYcZ01jNjX6qw13YGUtGEKe5NIGNrw3jquLgC08ua8fu8SQaHWUKzNm6qUVsCDUMh",
-	"dK2voq9vNA/V3kI4b0KFI3KHQkhPqJ9GSC+7jwige51O46Y9vuvkurfY0WJ7VeL4eZ+UjYALBSp7WAvr",
-	"QKC0kM0N4Zzxo6aY02p0qIFMLEkbwNkqA6aYJjkPiyoYpzOa4cRFfu8Vt4YTyZd7lh8uT+dtyctMk2iJ",
-	"xVWRDVG1piUxby9/rxIUqjPv2t3GKFwPv9H1WHafYc8XdpA/yu7fYGE33mrbtXV5ivmV1g8sCsAYz4o7",
-	"oog30T748u2N7GEjGKrVw0Dw2x/OfckBMHTf/vDdWSjbTUzDt/nBx4XWltoqKEowTa1phBGrfvvDeSiu",
-	"Sd7D3LBEzTvsFYYDKkQeinPipqkr+JO8wxx1Z0E0/vnmSlw0ibYUkNHjb8+O36IfyAR9R5bojMgnhTQQ",
-	"pEW+DNDY4V2RJVx7Ztdg0pACCjsTnQYQrW5w+fON7I4xbAIE29WGUPi7F6JdflKp4MX6x+i7fEJ4RiQR",
-	"G8cLkp3N6VS667ZLMooXtHELqKF+3ghgBHpx+ibs7krFIsHLsDveN5UEC7oucqoToH7NPMKwsIrynssh",
-	"m64fXEZfKtB3L0QBCiqQ6SSsCWN8hjP6K0BqVyiUSXvQV4Xyx+GWlT4VYIw1Vg089mlvkqA4kPjtAVjG",
-	"TkZDQBVrEKq1fVT3lybJupN/okem4iNtayBI2ITBgqj7+qwkg/J3zB6Kqxci7G82wdFbEe7+9OXuXsU2",
-	"sAjmFD6znCVktV06LbcwfTTJt92OGCG3ZBAPZKGFmMY0TnWp560BnEEEcvqr8b8yZSDu1rpgsEkZcZIQ",
-	"LIhn/wbtOfH7FcZPxUKliA2uBzSRs6aQjyiSyQjHKc1Gl/nm5tPItYKfpEfyoRIODC1hCFIrRw60cXv7",
-	"S+W+XgnDgYDR+vqJeERLN/yTBnDLM3lLnWwpt7GGgad3NcL3IKT77VkB1mAHLcbAxUnv7urPG5Qt8Kj1",
-	"LZiLre10yzOtiwMQOpbg2RgO21RIBWIqJM0iaZKbDg3ZITiaa5E2BQPoFEupr5LLwRVZfg1c4OVgfJmV",
-	"zWpJYS74dWFbCzz8jLLs61yMCBZytAXBOgj/eoKjK5LFq1jYmpWdeQ6etdWpCsi6gJrYVPBNa9/ZtZb2",
-	"6/Bq1jxA6LuUEwFX6RSlWEZzk/8eZ7H+XViraevR3bf7JB6jg3QhlxtZniSV0YVupnjkuclPUXH/rPTa",
-	"dXUdVesrslDM9E6ZblO8UAv/7Yosh7DHn7SJZ8CSMySmc7GcgubfqsTjVK3bqzGJW2ZyTiQ4zlhdrjM/",
-	"841AFebq7bjGnLJcOAdRmIYYo93C4CPFS31rgjbaCIt/Kxxph8hO7FM4jinN8gDNOtKyVIU/1MtzBxn8",
-	"UUJT6rQhRfAcQG9nAqNtimkW63SE5aTChIOUBcJsAoTwNaaJ4lT9NHmQdAz/khODm0unFZdMP7OcXNem",
-	"ZjciWy/GGNa+reDxSoUmCxB2Qj3xr7UePiMfpT0rbiYFuPc0mEC/r+5tQQVY+0BfalomTNmC6Xw5FmRm",
-	"pWVTJLVua2uo2CMFAjnHGcJoSm6sRbbe0wUWQh09c2hhx22YAG034AyOgBnTL3hYp93aSsZBGmteNrGQ",
-	"Kr12p5QLaUPvkiHKs4QIgZYs1/PhJCLUgdJYnEEK0Kws5WmwbUoxzWgGOoUGsUw1xtVEqI3NpEEuM08A",
-	"vL7pFYFS4DfGRyaro91ouxR4w7uWFlmsniA2BA2Cp4JBtaVsoBCs4rlbh52UQHkG6b8BTzUgVTcW6AmZ",
-	"SpRncHiyGLGUSs+UXBBOFQdt/G78iXphcNBjc8lPSIRzQRCFYvDpnucZmFyzohRAYNJ5JliYSk+K9XBi",
-	"QKcxsLomvRBnYX6rldhAgCyJ4XWKM3S9Nd76AsVMy1OI9MbQWK4Yikxto1qEs3Or4o1a2T+IkDQFq5t/",
-	"6NNGfzVe8RFLEi2/GCOdyVZYNlCNywlQyqa+tfENUAPuTPVxJPvGAavdGZXrrP5gCJqLnsPOKbS8Ikuf",
-	"eporXzsIiaYIa9pguymBqTPnLhyUgIDALVvJMXWouJu3TMK/Bx/V5TQYDvYZEW+ZhN/Bx2/hnRZYV9lV",
-	"SjI98CpSvQq/qEDoLfpD9zaINqZRMzSFXX7/0JvVzf4EhmeHuulWndPTiRdtCo0jllHJOnV+qa7WLbzw",
-	"7UJNo+53sd/7h5DRS59kIP5KwJGnt+XUW5ySGF1r+yl4MtRFegE7AKOor9kB3NkaqtkKSgt/S0L2gFSl",
-	"XqmQwju77bLUtbbetoRnxge+YWVNIQWGIMptaBRUMAwHfBp9+fz5duPW6+J6y3qKH7lacp/mjtsbNi2+",
-	"q11w/Z+aUaAdoQM6Ak+anRkdQn8Bts6TrW/ZRlG26bRUuaRKaEkMfxi39mli9h7ut3Sh5WR9umkRhPwB",
-	"xevVveqSsNMqcWgNfxSgJy3qKw+WRoGlufspJRw9zq0AtlJm5NiKTVaUpyFr+OfQDNyrzJ2pOttNYd7u",
-	"LCcXEVu0OXkbuOtq+j0Jb4rVFJOwA11HWF8QnUdXvc9pNmVd3dl6/XpUx2kvwTQtHZMfBwuQPXMS/+Sc",
-	"hWo2p6DK9AMPOc8hrWg1jzk3IftYAzmmc3uf6i4EmWmtgVEC/HgZmMPl4AOUKKY+sT9EPrkcfHhyB+ay",
-	"qiioEmBvI8v74BHUCmFsPGE19A3eOof7ex13TqVG5cY53N/rfd903AmqqzvfCF4nf7L7oATJztugjZKr",
-	"ngytvjh9Y/HcRRqKIsWHivGMsZl2bfmzUm4aR78f3VZQviPVfiC6+O0P54b2/8HpocHqz0bsiqCQdTLn",
-	"ytTZK8vbcZKgBeEgrI3DMnctQjSiQwEtjNAX9sTU1eakAUY8y5jELh7iLVUSRWWQORnHUROkJhhfAuZD",
-	"WXZOUyIkThsUuhADBCwqdI6OGyzMUuKSKCvGkoxU5XBA94TcZiwjL4Tmq4w3I5mXwqkqntHC4MgJY0sZ",
-	"SjzflqIXK0OMiVDYayKxohO2yBMFCQdvUCCP0SnB8YhlybJnboGkUyOV4o/W7fD502EXNhxp9ZTJwwKW",
-	"XVoRpAVlnteN1YOYo6V1JBGWZKZ4E4IeA5WDr1pm+MQpNAa39pbV9VUH3rK2vwitC5TUoU30EshgidgN",
-	"hPTRGf/g+1ARwEvIh7KhiZjRzzYoFUpqkWB8DaNEMkDV8ZXtS0l4mppHorAAs45O2lujWHcPp3ZNlE6b",
-	"nR12q5YbfrTMimh4nbDn/hL29MNxtzdx67aXpM86d4+97usYEVHFrgQwocwuKT71eO/QOrZQbbzZJvyL",
-	"WXSljllDGFwohaHrMjjFqq2WZNvvrmWZK3OJ4WVbftEsMcQxHkf0ln72arjCTcg6EtUdfCo3Pnh3H7kU",
-	"k9aHUd0aNd/FXe0K7vIyatzSA+2gS2ikaRvi9tZBjxd5kjwZmuIfOJXEr3OjPuhKQNkXuZg/8YFlZuIa",
-	"B8E2wYKAe1Y4jjPci1YTInkO/BNEewAZu/BU5FbZWnhigVeloS3anRJGQi9zCmpAQ4sWVG0qEjmf4kgT",
-	"YaEoDey+Ynj1naX18Au5kgrmpV3eQSZ18OcqB38P0XBYcaRbRXqm2idtIKRg1PD8K/B/ieZMSEVMhujV",
-	"9/tvwS338AThOOYKo8AknznzWcalfQT8kuPlmLJhsR+cxHMs4Vu6dF8jlu58sbm5OURbX22Pt56/GG+N",
-	"t8yXH3d2tj7A3+H3JayMBELr1g4AxEuA2oDAEcsyEum7iZVOQy16xND0+OHBQwPdPfwFi2hPj1+PeimS",
-	"eawa1sOdGKRpicPgbOA7REKhahW5kLOmB2HhWiUR6AqslfcUg8KSkwRnpHm9DpqmFdw4nCVoodr9mbwK",
-	"Am4Wd5J1PYDWYlXfg5LfweMFZz/Dm8mYsx9mEUsV6dKW6BhCJ9a9D2AbgBijRyxajB6hfyLbVZMfgioE",
-	"w8ZXNJEhiB1OfdcjYBNMM2GDvVBhbEXswxus1GLCrfVYxV60MIq2ll/wwkKPrshS+5c7G9hHYJIEo6qK",
-	"V2QJ8RRM7NkhhKw007GzwcbYFj3mZIZ5DEZk1tzjSWGLZky2jPu2xiZhiPVITR9NASQK7lMwbpLqFJn4",
-	"ezhriGp1v9LKBcmEwvxGkeXf1p3iz6cla5NjBm9WjybUzbbWuan/0rmp/c0PJhhqTd/bhU5ht4VqjXLS",
-	"ab/04XJP10bt9QgrHZ11Juq/aibq2iFpRen6i8PnuuoY3c0JI8cJA+sl5uzGpgziYViRj1rCG3pRHJgy",
-	"dLjvJN6VCfaR/4KEKMx9HJ/5SbdSFpMxutS2i5eDkrcEQcdnoNSC6jG6phhNGJORYs/4Ih0xITmx0ao0",
-	"XgrV2QJHV9XuMqbrjSZYMRLlWVBPouOzPjYMnOmw76sWVn9o2upfJ7aHT8PBCZbR/FSfLojrYqlJq/Ru",
-	"xSjVCgUMm+ivCMfxQGeE0A5pnKTsWmdMbLBiDseY3kWgwz3R/m8ugF/YBjo8VU3YJEM4Bj8QM6lx7Wiy",
-	"RVuqqipZbctSXpRZzwBDZA3zX6KyXhpzXSu4wBPnshwCUuHQrC1eQUtnche4rRKIZa0qkKJm8x0V6NVw",
-	"t5eDGZGXA/WHukb1X1oNqv/WJ0f/DVml9Z9ac6n//ocRwYJ+2I3wZDUu1i6wSbxk7jI3bZPwTs8AEumJ",
-	"+mycVvtJn8i5ZgJDH6QhpCp2NcylOKg7ObDnuq7nBgQ4kKCuqNfcrd9ZMYRnK9GbCfHQs9OmwZtZCCbf",
-	"5zhOiLz3DEc92x2YPBcrNLkQoKLuXT9gm//HSsHU0Ut7BLVPH4IqK6eljYu8gheax3vYwEstEwlLHm4X",
-	"KByEMzdYOEZ2tczC3qhhaOo8RmH3/dMiDyouUh6B/344BG7T5Vtva925rBnHWyaNfQHOTPROuOhUfSt+",
-	"YteEe6HYiyjSgkcbNIvJx/HPoh/H54vxg+t2pfbmtThSiRJdSSQ3tOqQ/kqFakq58uS+0d3V1Q76WxNC",
-	"lRJ7eptYSUkHcRZRKYR5JT+Y/0QdOMGTeiNdb02IxFv2+eGPOSg/cLQW3/Y6UuP7T3pfR+vpQX3928Do",
-	"yTw7mIhCHy7Vr5/q9kfQyqxlP38j2U+BfNaBqUCNnu3CyYM7HtoNOav90xlmycrlZbGRKzOGFQ8iNeKV",
-	"QXvxa96ZX4uM/qoio8rZakHlWuC3ciSF8r3Z4SLZ4iLoTIjMdduSKMOrqq6MZqMPV/Guvo/+/DoTlPkz",
-	"7KpcmmTHPjna17hTt8h0X96+O2aaL3d213Tzq2V8ty7Puwnh8jTXnE71yeCtoM7QzitK/aLYrg+rvsPW",
-	"AnmTvfS+PSiW56Sp5nq9IFn4mnCQHwojFmITEy3FxD6FgWk2G6NXsJ877fkouzNNtmWZvLyM/9mUWHI4",
-	"WLTIts51KFkrsmJTsyIdN4HT2UxR9RAktSm5jrZ2TTiVy+5bytvvM9NIW1JWEMf16G1TaR1lo4tO5CoN",
-	"Vrd4MqU1nLFPih8wz/TDYY9TiAIzGA4Osynr/bZomEvRcWMVb8TGOnoq3qK/C974p+4SV3ecs8O4phiW",
-	"vXty6C96T+EvGJCQMzpT07TC5+HgIOMsSVKSyeLbPsjdBsPBq4QQ+35yDxE79tkyU5fAOUkXCZakuAl3",
-	"czm3gofgw70SIMGoORqvrr2Ti0YCtshD0RaGg30qrhpteKm4CrfSkSga41o0xqmo33B+AIneF13Darqu",
-	"sbZ5dVgzN0BCXYaN4TDqGxhmYs5qmbtMN9pTpVnaje0lEopPYj3AoBLiqtYYHdvAX/rrAsJ0GUpAhRWN",
-	"r8CDV2+zACsu1NubZjMIF36Nk5bLZ0LkDSGZXT+CpmreD3CfuJTFLdmKm7Z66G9FYMVtxBqoQyPdUqVl",
-	"OUrJHQRiuJnAYDqlgUl2UQjxmM7sBwY1hhZqBYvT0d9W5lKibi1SFzW+/5i2mdidQqIsLKyIa4YDnRz/",
-	"lFxTM7EU02wtglmLYGp0SOHiqkIYr+V9i2GKrvdMZLZmRYEO89eZgEBXEzoGUJxH1iuRClQiGRoDxkE/",
-	"RLXRVH6DRUBgrr5anlDHgoPK4dfE59FtBKDWnEyiE2BQS4CbRq7I8eoAa9NxeKAclrawNL0u7LBiugcS",
-	"thmqu8yilS/6M0PK1+K2v6i4rUJHW/mSishNmqDTj8UTx3XogJ+t4pvmzOxaWTcNJmSnWS1p6qGq6Wpo",
-	"f7KigbFiN3582kY9xBBpu/SMKdSxraliQQ9wNDcBxMtdaZdx24GasM+VFeGUS3rDEvPj+0dvPnvx0Kme",
-	"O5LdVtmv0PjWZZ6bWoH9KS0fOLjywp89656JuWr6UqqgnKWUFLqythbjqQCj0H44biHl9NvfUc6Jb0fn",
-	"W+Scw4EV9+3BpdcUhdTxDGiueAlnRKDm0RBQ33b8uiWgg+vci9cQ6LtP0NVbiGsdNpVcGadG6tMdU1ME",
-	"OA5tVSnKEfehS0QrSYR8BskOajI0riiOswspBFbl73u2V2/xv5ONS2nwIAeYkZvjcOQIoInkRmc/QI+p",
-	"S2w4SbR7SpYnYCpm/dkCjkHkmrJctAxgq9xhFMOAvKIkiVt4Ngh6bEJ63BDuGJeCzBbU3J1z55UEK3Dh",
-	"R8yLRf8ztl5e9rc0QsogvFsVHyW+uLyu4MlqitNZp6oNNXvkJzt9tYdUW0UXsxjzGJyjOjOG6fAoniOo",
-	"tlMsOYDV6fNt02TZSKkhiDfmtncrCy1+Nc8mk4Erb8hnc8qShOVSi7p1momwBskxgnN2Awwg1DXJV7Sp",
-	"J9d9dalgX2IZzc9M/J5mz32/Ul2wLCTHksyW/aXKlR5bgOHnpi6hql9sVWlm0WihvxouDch4wItB3wWa",
-	"6p3TlLC8M7qZFZ/qN3ltm1q5pfDmasdinsO6XubxjHRPolr/03AgcrCvOp9zIuYsiXuY4FplV9h0Ts/2",
-	"zO5s8GTYfdfSEkZNwiwNGIOUhkMt74x/JsuoEDqZZ2KuM9GuGExkr2SWoGZ2dvYNkhxnYsF4ACMWnF5j",
-	"Sb4jyxMsxGLOsWjSabpyLQYX8xPXtsQbqYo3jMeDhw6ZUJpSZ0gNs3IA0FXvJYQQp4lhN0w61XJ1mfPM",
-	"iCggBgVOEnPnxix7JG0NnUTEi491P2KbyEWKKc0wn80IBFUBU0kzhaiIE0Ntxpch2nR8I6klIHi6HRQF",
-	"ruU29yq3aUhs28doo3gHajhar4uGQGZYhK1DUhzNaUYah7qZLysDqI02bOTl4JXOq3s5MPMxKUaoKLLs",
-	"kHQhlyYrCNXeYv7DtsjNs4tOYZooSjDX4dOsxa9ZLKDxJFfni+j0JOyacE5jghpEzqL9IFs/PAc8dAxJ",
-	"jnbQ5eBMX0aXA8S4v9LPjjaKLRvhLB4ZkHYyZCHxnVm4IRMOAwqkC3ErZ2DhHu9Gkl4TBSLS/Eib09l8",
-	"lKhFIbVahFUjvac6DqLvGgcdwiwShmP96KSZ+6zzHA+GA9sJVIhJ6WeKIX8Lzox33VQxCbrIZMjp+bSt",
-	"r3LXTqRedOrNuF56WKyhXvjKrqphQLuwevF+eeX1CkclWIRm7UGnXnxh4VXs+QEEyOjYcx1Fo2wcB5s/",
-	"pWXvThsHxkWEGfE8M2E5E5pdQZH+wyvBCcVavCl0Df2HV0ONTCP9GrAj0EyLXQcuwCd8Bg6J6kCwE4hb",
-	"ZrFkOFgNUTzQHLh1NZadusnWq7yxS28qamu8a6BTLzmy8Goqauv2zIK0XrRfALleeFiAvV742tuIAIJ5",
-	"W1MvfYnDrS7c9gVgr+4YH53fMBx3ILM61z1QWch8opCV4RiWkzE5mrIciOwExyNBpDmmoMADCstnHvre",
-	"lj65JZzpGVQ/v7Ezqha8ZfKVmWC16CWOz9x8q4UHZv7V70d2PbWCCt65ggB9ucioLLjqauRDR5m6WOCG",
-	"G6oa6jZ4YTWzVDa2lUKAst4BYlOdfWNfLDEmKct6aWBIgZ09F1UlwZ801q3SRRnt4UU9ce1DR+BGX+FD",
-	"WLpJw2oD+RTXuAMHz7PM3sZF5OFnZbsoPPp1c/TV6MM/g4a2aqDwbOD4FUGuhuhSvf/isQlXfTl4UnGl",
-	"8wo7eSQYtowl5T3ygT0soaQHxRDTVDXTrK+tXKFsneWHAkZWmHp/j8T1e+1PYY9UQZHVTJKqje/XKqnS",
-	"e9hDLFCp7CZWqfBwrmKhgXtpNqvHem3E8lc1Ygkdvi4Mr3mPlei4kR03k3Otkw2nYQeF7s2ciaIDGwd5",
-	"Cn4mrFsioPvvs1hHYfpFmzCCf2sCf0fHKg2n+zE2MFi9K1uSSJRS+Dvg3mChLQW8sAV9EkqsYhlQy9MS",
-	"3IfVrD/cAgzujWF/aUr+zbKK4cEbpr1jKnNQMPmVZcSLkiqMhbyOrr37dtcG8Nk9PdjdeHO8t3t+ePzW",
-	"JrpXH8v8jE4NrXaaccQigjOdq9u2dDYKqvICc0mjPMEcCap2gso5NWYamBM8BM7ccHxoNyWcRnjjLbn5",
-	"6T3jV0N0kCv82zjBnFpfhTzD6YTOcpYL9HQUzTHHkVRU065Vx1cV+WLBuCQxenw5eH10rqPfXJzvGS6z",
-	"Rp7O2RXJvMhSq4SD94NIcucLFEqE9RONm1I8WuJebFWXKZZ6XDJNiWMyI9mIfJQcjySeaRrEeDrY8Qb+",
-	"1KhU2C1FVXbKhFKw5Z/g84xjHZeh3ZKh59RYTIYsVbRBPe/t/H7SeqOQlcXJd3sHen62zn3OxQ1cmRQs",
-	"+qewOt9sHlSpa/K1mO4nQI1q8jcAaFhk0D1db0qaTmlhzU85p41ztJXQxekhemxJW+tOIzotJUcvh982",
-	"uP7kvvbAX0VlC8qQDBjaQbHNKg8h0bwG94u2pa4r84RotY07AKX3NQ3orDR85cLycGTokYEg16Cpn06h",
-	"eDfyZ/oIZ79o2j8b3V1nvYBK4fiLIMJqag6lQB6aG//UKkcqdeQVNQSDXFBOxE80JBPQEYVVDWOaqO4n",
-	"mllftLAjBo0bAXS4v4cO9w2UH3/7w/mTMTrR17IO/qwNnKCeyfFAMhoXKBfQGbYeKUc0vJMV7AdKGqij",
-	"BkOVLL4kmAcdXEOqem35chbNSWy846tmRkU6bGFqWZrGFH8VoZjdZEbLA7yKiXc5NKQNHmw0taUuOYbU",
-	"1jaBp2yn8cseZ1k5j7uQmMvXHEdk3/O572vFIz2ur/VRa+vVHk9yEJxDiBhcCMIPsym7LT1QKGj7aCYI",
-	"DUf5oP0Mh7MwvcqTBCSLnYH9Ay8XNdVSpNb7i1McSOhYZ2lsHZfJMazozichYxAtUCjzjD0OlSeJKe/K",
-	"dZOQ8zWV/hs4nGaw4eVkOw0h27v03qMiVsyl8klCxfyEcdkiRpozIUeSjWaKodFpcYz9oXDag3dHxumD",
-	"ZJIvdXpH7zFl3lGXA9WXGm4HOlN/WRuDesnGgjPJIpZcDkzqh8vBi80XmzsvNm0j83NDRgvzeHG46Uvl",
-	"N0dfffjnjv7n8cZjGS3+bx4v/q+I5OLJk38FRfU1893q7jxIAMc+oRerVi3vjtAN41eg4rMxjk0yxlfg",
-	"pLwnE4RnJJM6g8W7I98jJ8VLk1+SXoMDIKFgwqVTpR7vHep4xxvq9TrFETx1sXqxq4lak27zVMokDPKK",
-	"cVtuEwMI7XFkAiCbbKDGRQij7/IJeUe5ROo/OU6OtJ0Oer979EZ7FSlSEKPrdLzEaRJMu6iDbh6FPR7h",
-	"cyXokY6ueg3N+jpe6X7A9cpYBRWpz4DRME9tnQdlUXciH2wQGW1kM5p93FDTGcc7nHWnVwj73UCklSjn",
-	"VC7PIDsUzHwCDIVNqaV/vbISnm9/OB8UmadMaTE+RG3St0RTnqCLi3BI71ISSs/oHqEjvADvjUqQ8iLL",
-	"7NgSesU8Dn7JCXgfGT95xmeKUS8O6IJ+RwyDT7MpM7I4iSPYd0jQO9gZSILT/+376Bc9nruDgUz2IXRO",
-	"cGqsvE0Orp2NjVLrWn7RH8tdfHgcavbEyMb15WBMbaMEcx110wsdzqZaGAriLhLPiDMNV2yYnBPK3SkX",
-	"48sMTEoiYjgSs7LdBY7mBG2PN2uLubm5GWMoHjM+2zBtxcabw72Dt2cHo+3x5ngu00QzWBJwtQKk3ZPD",
-	"wbC4FAc26MEnCIOc4QUd7AyejjfHW8ZVDNBxQz2TNyJnDTwLyYJfE1lNIVNLlOXs1hRmgpTGmBhDRGjg",
-	"q2DA7c1NixMm+bVHpDZ+NqaBmuD2SY1tRgGEqzB336m1P9t6cW/jOXVWbSzIIpaxgoiRGAbf/uoBBj9n",
-	"DB3hbImMTFAr3PQT/MdBeeN05jW965Ug1Y1bD/70naGwIRJTMZZhEsOo8ZrIE2/wz4gilRDfAei1BvmG",
-	"TdzceoBNvMiswMqgzt8Sb4eDL+5x85uHPrTpj7VOE2l7o37HRqG1vdqCZ6b8qnQRgtEJZx9tImYjj7TR",
-	"3gvwN+Xq0kFwJKfkWgeH97Uy4VNmp/A5z1ftAR5C7cps14dqfaiqh+oaJzQ2xmHBQ/XOVFB8auWIOHlf",
-	"/QjYVsDycJwSSbiAN2KddQ71qk6dnZpjgecEx8CWW77O1zRY/h0HkmXoiGuf6SS2oYRaCSxDH72HGPQl",
-	"ji0KPtx5PzcOpcVa1wf+D3rgf7MXmzpEnzacZH/BgvniSskPP+poRaGr1VdsixVu18cnu0cmF+uTuprR",
-	"6JkTdqPlCKDbNcKEMOE5N2rUVqrz1ot80nLt56KgPSBrcJTHh+HAF0poVV0HIQIgvWTx8t5QpWSZoPba",
-	"7+rj6ObmZqS4gFHOE+Moeeu+P1WX++kz0tayzrGR8DgFwj1T2c7hS8S2z/Fzgr/G+xaeRX6Q13I0oDLG",
-	"q8p+XdGF+buZl9jdVlS4DuIlF30IIos4+0Jt+K6lpNqQ0Zwd6EF1AILLFEtjVlWq9EibA+XkkQ5JYUWE",
-	"LhIGPHHtFjbJu2wnrdf8sO7b6DIS61iWktOo/LDW3rEkts65RkZMuU4xXAm1Qq4JX8q5SVcWmii0OvMi",
-	"ZDzQbHW4j6GljjSbGZdGxhWIrwh69PWjIXr0tfovzmL06L++flRY2V+R5ZbOx7w1vCLL7f/SP7atOiGw",
-	"UhjxditVmJTijzTNU5S5sHsW8dwiDaaUEQTQ0PhiQKodYUQlTYhWao7otIzlkLtHd2rbG/xNMcA2QxNS",
-	"RNWBbNzu4ICYXuQTAV7/Up+iRsygKfgfFHCqOVsbmAx2tjY3N8GyS//cDMQk+vCZBXyWpjTJb4yY76/L",
-	"1NYesZtPH2DUV4xPaByT7HfnZB9itWdGBXCROTFg7SJduGjnn4YNbOoe5FtUT9TgzVm/OHWDUiz1z8OZ",
-	"lYboxT1tfcaxQ1Czbrgw/D5kpSw19DNkAuziep0y1+EUL//jiPaExcv/3rCarQ0oVxN6TWT7YLNKhVuP",
-	"dKrzn7aPxgOVbjnipzVx/NzEcfMhiOMey6YJ1cr0NTmukuOPoyLt7KD8wKk9eTZ+A5GDpt6KhIQM9dT3",
-	"Fej4fhct+rEr8mlwIMV/6zk2CABu9/B/cAnkmkd7CDL07AGGfMsk0h79azoUoEPN5hO9ScnrVk7j9nRk",
-	"pgNr/dGJSBezuCYla1Ly93hhYhkFbBxPQLrZn5xA/c9CUGCC90pS+j57RzD0P1e0BFJtfif9wZqo/T2J",
-	"2vpl+PuT0TzAkWlHrRWo6GmnQOb2dLTI3vPghPRzyg8fmnr+HhLLNdFeE+010X5wcV5UpLoVOtWttfhp",
-	"N2doTJHbZdvQ2HBt6LA2dFgbOqwNHe5KOxsJzNrqYW318Lvdy433bA8TiB6XbZM5RFsm+8/xtmke74EN",
-	"JTom0tNqormXBhOKNnjf3p5ihWnM2mrf1dJihXnwrha3nosWODR2vLtQDK5OL1+eUt6z4do6ZG0dsn5O",
-	"9rm2Sm/Llpdk+0OzhxFJbIxI/JsQmeOLCooSMiTpS4E6hY7dl/DaxGRNy9Z64T8rMQvKujjBsZYjuUd0",
-	"1EJQauYnD0x97s0wBRI6/JKTQx1zCrj13+fVviZQawK1JlDdViy3EhJA2wemUWtblzVRXBPFtQ71T0uG",
-	"8yCfCOKuCqu415tVPF1NXHZPpPhPYS5zR5Hy70qNf3eJ9vpGWN8I6xvhzyQG3cCeAiN412hFBUEQYjVb",
-	"trH+dY7/4lZKkDvcN5IhXJ7w+r5Zc/9rWr+m9X9lWl9QcUX0dYBrHEEw8A1ORK5TQoTNPk6h3EXFnmBB",
-	"YsQybdNXmNnhLN5gxnbOfQ2Z26vedII/8ZmsPnTveqTfiViWp9Ac3mtNJ9fGXp+dhJTO++ADUI0JjmxS",
-	"dOhDv711PGFLT8yRtxTiU5XeVMsdaekw1taHo8syu6ARazPstRn22gz7r2+GHUCfCWMJwRmaJnimUMik",
-	"gUQsS5Yw0TTFfFnO9CvG6Ae1SIAiQ/Bus6lRNMQAyDYLDnQFRMF05kdf1+leYS/YTUb4I41opSPxqABf",
-	"Ne0r5MR7ZDpWXT1CVMCMmkDq1Q0hoIFHCFivaKI20PFpS7T37gAd7ps1aBQUrlznfj4+01mGUExn6nk8",
-	"x6IiNL7Ok4xwPKEJlcsxOlJ0cUIQRkeH56cHIyGXiZ/ZFz3ee3cwev/+/fuRRqGIDBGklFLftze3n422",
-	"tp8++6LxDEbX5DAuLT3FH2322efPhn66KdUl5Jr67dkn+8fwUyDL1Ge1FdAX1dqcf83h/c4cXh/b/Qrv",
-	"1WSor6t91vfZQ5vg+6P2sLePWGpSxZiGARP7Wp1bW5Fr49DmkbzSu1juNw0wc0V37/0NFvKM6JDvDaO4",
-	"KncfzZyZ5rFMhbuMdEqymHASt0CvUuWung1NI/FS8f2M0gRBHqi09kVY+yKsBbO1OzckFfHFISvEpey+",
-	"oPebL4NOvVil87WHwJrCrA1w/xQkpjn8ZDfFeN3A3d2GXPxJYk02M/trWrGmFX91EUC7ZX4nvYCK90Yx",
-	"1gb2a6q1plpre5o/IJ1sCyDZTSZPW4QxtyGUfwrz91Vktw9HGB9WTrymxGtKvKbEv4MAbcObptj4DS8W",
-	"5nNhyigxl622jKoCZJouukIsQ3JOrW58jM6IFAibn6OEXJMEmb5fk8zcAYhdE85pTNBjmsVkQbKYZNIl",
-	"fS26f6Q6jhKsml1r1foQTRNCJJIkXSTqumEcCYmzGCcss3YMT/6XTXErOUvQIsGZ+pUucmlSzWfko0Qz",
-	"NyNtBQOjz9RUzJRFdUIoFzSbwVd1a4zAOHTBqZqIaYPcVadtGKhEbAImKro3naZXG5k4OFChR9H2oZIt",
-	"LDC40Y6UJqHggLA0hUjSlMD8Rc6vqRqnAiLOkoTlUgyRoFlE1JSoQBm5Jgp2jDtLFlk2B3kkYChjBpES",
-	"nNFsNs0TdDOnCQlullC3mtoQCYu6HPA8U60uB+PLLGTSqkCm743doqs7sgT3xQgMu8b1Vj9UIIzJlHq2",
-	"Sg6KjbvYMFNzPNcyofWdvr7T/2Z3+so2xqWbPaFTEi2jpMXmuKn+yjxDB8dwdlt+wc3p8/MJSM6x/KPf",
-	"voe19cKME8EQu1HXzWSJsBkVMvFTKVSJ6n2hd0ndTDhPJGTxhw0oXV03cxrNYUJmBvKGIbPN6AYLRIXI",
-	"SYxSBtazEclkskQSXxGByHRKIhm63c/Wd/v6bl/f7eu7fX23/wnvdrZou9rZYn2z3/lmD96ZbLG+MtdX",
-	"5vrKXF+Z6yvzj3Vl+l4LjTFd1Mrj3EhHdQfaVtRrW7dL7XCHuJ11atHpn0Iz6kNhbT6ypuhriv63UlqW",
-	"yWuA/CZYSGG8oxptesHHGwuJVE3g4IXE6aKFM24w+G1wtLql4W/jvKY60sufwyjYwaTFmuRZfV/eMrRn",
-	"JrEmpWv74b8dYXOEK0DU7FO4k6i5N7ORr4QoV6sr5V0oV2VwG+PAvNzvkYYFRQxAN68ydpO5ibwjvMTX",
-	"ViIhQOXTct3BH1VasKaZa/ZzzX7+7lTaUeIAlRbO0buVRutqip6u4loWdBBfO5itid2aQfybOZitTEM8",
-	"d7N7oyJrp7M1JVtTsjUlu4sL2MqE7LQzYs7aLWxNutaka/3i/Au9OM2rUr03ScZZkqQkkxHLpnTW+tQs",
-	"KpcCroZemAeu6p7udwWiinvmntLRoqcQyN4aCnvx9MF+ecHZNY1JPHQxpBXgdDDZOYmuEO3KPmJizorw",
-	"IP8fe1fS20aOhf8KoUt3A14mwFwmgxwCJ20YGEwadpC5JAe66knipEQKJCVH0+j/PuBSO6tYpdWK3slw",
-	"iVuRrO9x+d73LE2LeQZaQhUUcrcsP8H0MsLNHrkhD5zQLCNCz0HavK6RlV6uVuTUhG3Ln4HAYqk7NX4T",
-	"JU926NgaeER6XKReCO6WX25nvI8W3tZBuAj01CvGX35jTVjs0OVvZUCJfpToR4n+y5DoP46198CCkuoo",
-	"qf7K7G+/ujrvsaZdSuutHAcSXW/Xc2T99Y4GRKXYfXzJdvaWYjXtSrmjLPuAqtOOhLvIjg+odhZKtdc6",
-	"e/TVu9LuKks+4L1lV8q91x1RR99zH6BQOgqlX/ZOthaduP14hJL6OGP8YRCAR+9vuqtErXUEKbxZQVyM",
-	"4WK30Ps4QLuPLw13RLMzYeoN2ncgquEtwgWdYvQKxI/DGZvpwEiDbD5EO0Q7XMOdDb72CcuPg9fHYSdd",
-	"OwLsWXAMtzzBPgm2nuzgHHEdcR1x/TWeWd666ymadaru+JsuIiRJgW+CpqJtId4Pu/XawkJoQWi9Sedm",
-	"Id7nXX5qS5E3BM9V8QQCkTSKpCVW9kPqeJfm3Q9Rt3PswaNUBDIEsgs7St0Je8IHq4dAHzxeRQREBMRt",
-	"+M9wvLoT5D6OIfXhkSviLeItrjhf29a56pC9Ni3p3B4/gpYM1qAILXy9XJZQUAfr++cKjPn7XYxL2ZOQ",
-	"mgiZgvQxqUoXL/NCuUBu3Z3vF1PGL+RXDi/GKEyZVLqzcbbwWqN8ECzrdKCSydUE+Gphpgu1/9mH3662",
-	"dYdz4+/GzQxR7s8Wc5Xcs5/Z1WX5kB700MaMKLrSoSvd6eyYmYEB2+WMiTFUNiRRxFH9dxu2KOKc/rsr",
-	"CB3S0SEdHdIvwSG91akPXjLHtGixoHJTj1qm8v6wkNPVSJp6+XH15AoJDeyzEBlQfmDzbRENzTea75OZ",
-	"b/ulDPB+b1joLod3m+pATu6u7CM7tlcqjTqzOzdDl6PDiTzvn+2duDuKn+W/7Fx2j1N49fet6zFw99nH",
-	"p/SBDwK1ZaFUzTrd5B3hAd7RebL6665e5r2dKNtp0JscvcnxSqhpjWqbSb+DLDeTt3/av3/d5oFu1xUg",
-	"Ce4y7Qq5CIu7LhGlvc2MwE7waki88Epo+mY1HRdB04qx3DL4DG52cbOLm11UX4sgcgPScMeJO87XaePb",
-	"Bn2A0R+gG5N63ZiWbe7Qiml8MDsvAQ63AmgSUwbWjII0iEjI/ngFIBjcrUigqVuqF+uUKHDdg0bUOiZq",
-	"NXsb4QvhC9dwsTXcYIm/6I3Dh84T9Sh7t140qvch2iDanO1iyernRdHiPnxBtgVU7NGf8zIIDohViFUX",
-	"yKfo1eGL4pVNtyfEQh9QBCwELPT7fHUQ2SelF0XIx27WzhYYeRYumyMocEeDxKOy7RCCEYIRgo/IsyrU",
-	"7fI2qts/6XLpHyfuidJU2ncJc4ifzM+EclIphtBECqUcAcfvbkmykhK4zjb2WiJ1ZBim/G6XPIFWhLr/",
-	"rjNYQ0YyNoVkk2Rmg2xZPeRXxlNYAk+B6xztK/X+okgKSUaNHVm7+5XfiJ5TTZhy6SAlghMtlnluaQqT",
-	"kNaabzJal0qazMkCLOXFvwXVPov1EXXkHFP4SosF1SyhWbYhjM9BMu1eMt/c23b8V1T3+CSjGqSNUEzz",
-	"26CkqClTgsypIkwr02VErEFKloJ3WM1fybf5VwVAbvPYzkOH1nSEJDc3N26Yf7siL3OWzM3AFbc3L4L4",
-	"DOTFNMdFP14IS9RJ3JBq+h0UgekUEu3bR7V/k5BLsp011iC8L5u4m50/2LFNs9pKp14RaqbclFUIUHZk",
-	"7Uy0w5ifl3c0z4/JqzmAxi0S2me0z8ewz9Y8P9PENiPxed1GxaJB8+KthuWFabQlh+7pupKPN/9i2Wf9",
-	"xRKNPxr/kcZfLNH2o+1H24+2H23/KW1/RIXZMhVLTb46ZzE/mg3fxG8nvHfQ+3iEToROvAo/7lV4Q9Rz",
-	"xMX4vgAEr8cRxBDEEMS2uKz2eg4jV0CPMRUIvL9GzELMQsw6hHdGRULYKSIMkhBOmdKMJ7pQLnB5C2Xc",
-	"EvJKUNosoUtr+F+u5gGoZ0rxYgIF1knfsKIRUiy6yNDfGU97oS9X2HWU6UHquu/JlGVeaKPZFsGzjZPa",
-	"KNQs3WFnKacxY2vgLn2hEHEQ+Yk9tNIpL8RauXfpiHK6ufaeWrJ4u4MB+EEXy8zlcC/y0T2xKOC5+W8n",
-	"/mGpaWE+qiz/Qqx4hVMMXzMp+AK4freUIl0l/lRcwowJ/m6lroEqff1mcjXRDOS7Z5p8B55OvhlwGAY6",
-	"9rtEeQiUhziZ8bLzvm28/OdgrJaQM8rZ/9zZ5Sj9+1rOG0I+GRR0uKLqPzowNECzUiDtNRtNElAGicLi",
-	"xJ9qrbpUEf1DHqBWexghCiHq6BBVWmyrWS4aX3yOYNXnbSCr5zJ4JmEpFNNCMoiopD/mKTcxqfTHapko",
-	"mI4acqghhxpyu+FlCT5ofNH4nmx/UFjLzRDV8oDF7JIuL5MeSL+8UsGRRcybNUeVzPMecT32tOFJW8o6",
-	"aadp9ZuBSPO3MmgDlK2vvLRLpdkdcuq1Mdte97yvolnt513Fwftqkq0kKA2O0uBIiwvifm1PVdtBNbdU",
-	"YySnBpmLD/3QE727DVSCClSIPXijejbg0yNDNQhB7nvWFNvCx5mwYPuXoogfiB+XsGntl4YahCGeBbpn",
-	"FEEqLCIZIhn6Q71i7OzVjBoEnY+Rg5ZtwfMsKLhjTyGPC5jHP/VElEaURpQ++fHcbTKH5Pu1SNg1W9AZ",
-	"dOtJ3JmEhNUkET7dPRCbzWotWKIWe87A3cVKmDGl5YYkgk/ZbCXdjW3YWNhL3zKHhBS4ZjRT9n48EZxD",
-	"4jQgQGvGZ4pQe3FM05IbYV4oDZYeYEPb1ynTfkrYg33/PZkkzyat9oF/g1dupzr65USL/XZrHi1XAJf+",
-	"F2FUyHXwA0sFKMKFdoQRtAMj7EAL7+N2QdPZOKvgLIKmMzc+Vjyfcmsszs0mfKYztAihXkF7gPYA7cFP",
-	"ZQ8Mzjtr4CnOG55EidElCylOjS7TIjcaudHIjUZu9O5HjSWmIDsa2dEnNLelzRzGjw4Yzm6GdB/Xd+8f",
-	"0vFZ0s26ozzpnArYx5NO22l24yr3VTZrJNidr9xXmwwkQs4ycpbxUqQDjRvbn+r+prXjGcdbHgTjH2JQ",
-	"NOBQKVARspcRhZB9eEYw1MtfHoQk973rjO1h5GxYzP1LRUQSRJLL2F7GmMyD0MTTeA+AJ8hnRkxDTEOu",
-	"3CtH0QineRCIPkYPY7aH0TNhNo89Ozw2eJ7itBIxGzEbMfvoR3lrkIq5pnXutpWv06cN7rK/+HIOiF15",
-	"FT1rPrw+vIxZns/a1gTPfzBT+0Xdrt8MjCSYCK6E0zsOfgaflsAJJf+B5yeRfAdNfAaiQJkKzeKjETtS",
-	"rji3bA3HVnCy3cFvx/1UiSB451szclnkyjlpJEHTD56o6RVo9xQs8KpPcz0wGGIJ/IZ8nSiQjGZfJ/aB",
-	"IpRo+KGJBrlgnGb/JF8na55Ufv7y7zuylOLHhugV55D18JZMlZ9NM4eotrt2TK5MdW3tdjOLTcrrNZWm",
-	"AjvJ78oqnvLclWdfLMC3O+ZhSmwjbCxLG2zTzsxMAk031zSxIUWbPZYHBDGjans1GJuTcaWBpib1lLLM",
-	"zO4XpueEkr//7R8kt8M5D9ku89OiCqZIypSfHJBarpIWWUpe5p3Umqkwn3W1P30E1cnbKc0UFP34LEQG",
-	"lAeOU984o9DAlxemk7lp/x9SaJEIJ9nsF6BD1ouDbEJ8NRZfPEXXOoNAO/BeD1yD5DQjT45z9VFKIV3q",
-	"QNPuqYYXuiGf2QLEStfQOC0iEgRCAc5sXJkKROeAXEPiHH9bYQD7U3eh/D7gfBBovy6k/nnm/nlP7ehs",
-	"riZwlEc3a1Yym7yd3NIlu12/mZjx9A0JTGDpFeANUpsRAK79B3JTMbW1HyZta1EpSHDyfqXnf0ixZinI",
-	"Oj+5Ut7SJ4iWdgdSs6mpG57YzCyG/MgFi07K1MqllsXM66+n8TVVC/Xj11uC6cA8VrWLD90qwD+PtuQj",
-	"lyLLFsB135tCkWrQGzovGBslwHy1sDaL6Gpx5kG0afVIXNX8LgzPmCb4YCc+OnrKplOQwMOluzgJY0qv",
-	"6ucHi6wJl8feu0uL3JdV4f3HS+oi7xdlVfbeA944AWZfOLC/9iUW25lvf/0/AAD//+AsXgC4kQMA",
+	"H4sIAAAAAAAC/+z9i3LcNrYwjL4Kpveukj3TrYvteBztSu1flmRHk8jWSLLzZyJ/E4hEdyNiExwAlNzJ",
+	"p6rzDucNz5OcwsKFIAleWjfbMfdX38Rq4rqwsNbCuv4xitgiYylJpRht/zES0ZwsMPxzB2dHnF3SmPCT",
+	"jETqp5iIiNNMUpaOtqsNkP56TgTCKdpJBT1PCNrJJVtg1QMdJVhOGV+gRzs7R49RZvqiiKVTOss5tFof",
+	"jUcZZxnhkhJYB87oO57Upz+dE0RTSXiKE7Szc4R2jg7Qu+Mf1QhymZHR9khITtPZ6Ho8wrmcM05/hzka",
+	"h3u7k8v5E1RqjEgaZ4ymsnHsKKEklQdx65i6ETrYaxnihEScyD7DCGgZHCqmIkvw8g1ekPpI3+cLnE44",
+	"wTFWh2PaohQvCJoyjuScuHMJjk5S1dFsdYrzRI62Jc/JuDLRT3Mi50QNSAUcjjttKpAZxJvgnLGE4FTN",
+	"wPgMpwb2ahNHnEzpx/pW3sI/cIIyaADLVxP5/WFjYh0dpBFb0HSm/0aYE0Q+ZkyQGGFhB/gbfA3u2i7+",
+	"FD6Ejkd1QWwKqENSSSM9vw9LkuaL0fYvI4yz0YfAJCJiGRH14X+kQqqhDQboZkgyxMl/ciIAC6gkC+ha",
+	"G9X8gDnHS/ibXZDOCwCNuhD/ejxSK6BcocMvZRiN7a0N3DxvDd7dqdwBB44CUuz8NxJJtYedc8GSXJIj",
+	"LOf1fRyTjBNBUgl0CJu2aEoTgjIs53UKkwXHUfBwvVUTBXOsx2EpXBWxFJIs1tEbJgmScywRTpeIfKRC",
+	"KmyDplc0SdA5QeyS8CtOpSRA48hHvMgSta+NS8w3EjbbwFm2nrBZENJ1GGT0PeECllojzEcH5huKyZSm",
+	"RMBqL/VvJEaayiukgvvJLcQ00io0TpGeah2dEK46IjFneRIrYn1JuEScRGyW0t/daICSapoESyJkQZov",
+	"cZKTMcJpjBZ4iThR46I89UaAJmIdHTJOEE2nbBvNpczE9sbGjMr1ixdinbKNiC0WeUrlciNiqeT0PJeM",
+	"i42YXJJkQ9DZBPNoTiWJZM7JBs7oBBabqk2J9UX8X5wIlvOICP86Xm6dE4m3RuPRNKGzuYxkoiYrfq5f",
+	"1vHo40R1n1xiDhRFjVMcyHvXtfjtlR37gIU+7y8yuVQTfZzM2KR2iXeyrJv0KNjjLEsM7fH3CDxeqGv5",
+	"nxzHCdwvBUNMU8JH49GcJIvReHS56L1XWM+uG9b88E83umtRTGJ++l7PZf56vxh90Bu061ZdSApcECfJ",
+	"2+lo+5c/Rv/NyXS0PfqvjUJa2TBot/GKJsR2uh63tz0mCZb0UlMO1bhEwdSPdXpTWd8eEarDicQycCDm",
+	"K0rolETLKCFIqIbAnRQ1Cp8Pz9NUA1tIlmUk7n8OoWUdu+EaGpzYWcpb208vX3G2OIFLEiArSF8fhWwk",
+	"vaScpQuSSnSJOVUMXTTsskJsG/j5juXDGScZSWMSW4Kitgtz4mgenBhNOVtoUqZXGGTiWmY6VsjRjiQn",
+	"tiHhJI1IjdMVAwWZUwme7zE3u/ZhQDSg60DQoBeNEB4jmqqJSIziXG0M8TyVdEHW0b6CzgVZqr4Ycbv6",
+	"GOntoHMSsYWWzUNDr6P37hgFkYhCM7V8JPEFUacSkZikGrhO3miDYyNmBcQSUoAKxzHVot1RCWh1SbcE",
+	"uv0QYlyQ5QSYC8ow5W3gU5RUQU+xKd0D0G2RCwU6dE7kFSEp2oIGT755iqI55jiShIv1UQ0NrtsR40dL",
+	"HXbnOJ2ReI9ITJMAouBIBjm8Wm1BYnQrLYBcYWEFQy1hWxqjKAsQGMwVgeZE/2tlQuPWvgOznuhh2xro",
+	"CZtbHNulqHdaloVfLqceIahwOnQ1Z4KgmFzSiEwSJQ54wFFyF6cxQZGGdfjRBAfQzWN1O6BzMVWNFjTF",
+	"knGU5TxjoixZtBz4LcBeQhlY8YcqgfJ2U0B0bJGpg2gdMR54gqpf0QJnmbo0NFUQWGCJzkZzJqT6uO3k",
+	"CfXX2Qg9Iuuz9TE6G73YfLG5/WLzbPS4LPea3xWDwFISrqb5P2dn8d+21f/8d+iY/GWa58ZLLAJntssW",
+	"C/38MpdJs6YkKeGNGl8EFA5pyrQofBt6tMPPqeSYL9GCSBxjiZE38Dp6J0jshORkic6XgNcg2rIEZQlO",
+	"iQViSTK9YvwiYTgGMfExupqTFEmOU6HORB1PbYsIS8RJGhOOgNjB9cfx2zRZ2td7DSNwIXJ20Hhoprdf",
+	"Eoz6SW9NktX1h/EKohXwfm/fY4QFWjAB7xSSymQJTM3AWJHCDaA5hmgo1ZFYR8cExxOWJsttFMFZKcqv",
+	"+sWUk0jqQ1KzLP8HqWbIPJvUhVDjahiTuHQCQqvGEnpJeCHT4BlJZf0grsejtJH8+aOqVo45bf3//j//",
+	"3zJLQglLZ2Ok93hF5RxhlBApCUeMozRfnBOun2Tm2qKUoas5lURkuEF+MhzjNUmJVtWFrl2eqjloGnGi",
+	"ODGJLcw5qQJcM1iFkDWCToVtT+LP4VgcNGgqyYzwmkhob0sXba3qU30eon4wFFb9077ZGu6NeXt5g5fe",
+	"dI29TINyP3j/NXRR77Vya/uGbOhgHoHlPpeN478vjX7tiLHRYDrQKtVgSnpQlABkup6FgSV3dQlCsqtT",
+	"FZZd7SuwqTzXjo0640e6oFKEFGH6O0qggVPwtj7PoiwP3Oujd3oQdaUixolYR6+0BMCJkJyCSH2OFUtj",
+	"aY0Blfn+5vrfvwnRlwVZML6sT34Iv5v5gZYxq/rNUypvsZIn3zxf9NW21aDeBvCIpUJyTNO+UE/cEfbk",
+	"lZWz71q04qm5CMu3+pt+NgqazpIyLTaqTk23ffH2iJMMG9kVhHz9z0L5sM8546Px6F16kbIrRQXU1UyI",
+	"JDF00ToI8y/VZWWhWC/dX0jto7ey2regnkR/smuvfSg2U/vk7y6wDrvd8CfYf/nQ3gnC609Cnqc7Iiwg",
+	"5IJw/42k1dPwc01Csvrcc6LewChXLFK9gqlAVKCUST2CGg1r9TEMo+4fTUHN7ZiNCL3JHtGp/fs8IY/X",
+	"0Z42Fzk1sVkVlgXjVSsRarpHMxAylFjMGZOPEZ3CkhTTplMaesSVVafvDCT8nyfigmYTSzsmYNogXDP4",
+	"rvvzniX5oiLVVqVTrWjHIJrF6BJ6qF2CCNSlFQtLfe9S+p+8/O71xzWHEaAuAeEtSjBdHLGERssV6Ize",
+	"+HGpd1X4gbUHJJ8/ejLsgwWeET1RSUDq4o6HStq8QT+Yr7HzhyqbDTSqXUp9Ki3GO/9qmMY30aMZPKwp",
+	"0Hqh73EVB5wFd3RM1FUejRuQes6uvFs6x2mcAKobZNRP0DlB7CqtPkBBlF+wy7Iyysz3of2Nr5etiWQ7",
+	"37qT2/amds0arpJVDAcEAPPJErmYZAlbkhi93T2YqKNNKE4logoDEeNI8aYpjiQ6x9GFAl3r3KF756+n",
+	"4/UhTvLFAvNlT2GgrCwRzYLA9wQncr4cjUd7ZMZxDFyuzvzfMH8tqzP78vKLSRubeKtpbBPg8+UGQX5f",
+	"blLdmIJ6Lue74NYS0OmWLLftF9+1vB7b22oJUTv+msZt/gg1xPY9J8S+7+gR8uwotdYuFbqLVrWV5g17",
+	"etjFtJFNhYSXmCZq5KbNrEBJczl38AsR0fKb3kE/eLFyOd9bpnhBo7ceKHaEoDOwQgRMXF1dEIZ/ChCO",
+	"QFIqQ7l41+Ry7jlQKbIeUGRqct/o3PCPk7dvnGMD6B5Vey2TGeFOS37+IhCN1RFMKeFWO/nL2WjGWZ6J",
+	"s5HS926ejT4gxtXPUS4kW+ifGZ+djT48Xs1bpc0ZyPKu0TiwN88pqLYDEKeceprx2cTopltvhJr+JJ/2",
+	"m17k057TTwAu4ellpz2iNDB2eORT51gjXIDXVvBdantBgTQdWH/MEtIT28tNEfkoOY6kQJyB1ZizRRCj",
+	"US5AnCgw9fY4rqbcAHQ16F5H4g/wF6zN/UFwsvg3jiIiDJbbzysitCAZ5lbbVyDRdg2LTmxDQCLGZ9tq",
+	"Rmt3eWS6orXttcfr6BjgaO6sFSPcVECcRZaA+qZCUybgZhXrk7ADqXcFy2VlhFnCznECSmNQtiqIKvrs",
+	"DyduiMewt4fC31XIdbgtij3BWNPqwgWhjMmY241pLXMNWm06YLv3FnbWzoLGo4xwrUdo4Yi6SeMQQmLZ",
+	"vogTaNEwQF2lK1fS5/aYoHuAdjD1GaEdStdNyNbeLYhzrV1QxAmW8Poy17PCXhS5AMuKwss6vezDUVVP",
+	"xZcmfVgrNDaKmaiN07lR75vb9l7RvfNee/n60a5GFGqU+P2vhRen9nsNy8plZ3sk8ixjoB9F50zO0duD",
+	"vV2g8NoROOiMf6PHywVNA2+JH2gaIwq4DHAxnjduJ5aVHe+fnCLrvamprAaRt+nCU1V5mdJ0apWehjKT",
+	"wp9Zy7rakT4/B9uIcZkRSLJ1tOusjHkWY7BBHqRoFy9IsosFuXc/VTDaTxTIwvzUOhR0HcFbgNEhkVj1",
+	"EkZz1feBpNVhzY8ic6jecswcXXisHnftuKxaaLxI7EPQZ6ri7vDSSW4N78/atHfwzhxuwye5DepM9V1Y",
+	"Daf1iXchdR+TPsZZI8ZUgq3Go4sXoqnxDy9EpTFTiPqkkQ4AMa92oXGjTKfYQLV5RlIxp9NGs//bjKQn",
+	"qkFFF18V/kpxIr2FwNqKukS2wJ47uzTsoOOu42yl9tXDu/5QxsYSfKwusc9bu9ym9ETR7+zqU6T14XJ3",
+	"T5PK2vu/Jyod7+4dURu49/uh2rOJKrS+V4Kn19bDqQXVc7v9uQkhSsaKr+FcklO73wPdnrd+D2X64cRb",
+	"l412snh2f7K1waK+aoHaPtuPrs+FC7UsjsqCXxBpNRzCqkw6b175jKBvkxO4cMOb6EbJzCJKs60YJXgb",
+	"jc2KJ6N3FzoO5dsLxtr9VPJlsy/uFCeiFoG6gyL1yjHeQMbkRtRAnkUBXBmUcQ5xMqNC8mUd+qsE1Cb4",
+	"nCRIzNlVar0P3x0UD85dksq3J01PTlhieBqAgtZjekZ/u+ZigoikkonJOWMy2vD/MHMu8McfSTpT2tIn",
+	"33wzHi1oav/eCl1UPAsZXklCIgn7VQ0KB1wN40KhKiQnePGtVpjqP7Y2azpTb01bT15U1+T5hv9ydnb1",
+	"Qf3P+uTDH5vjrSd/vw56iS9oeqAH3+ow8RQQN3sNY6GMAtpl+BnE9RSRBLxd1ZGfw89CCdAmVibg6RW+",
+	"Wgv8kS7yhXHPRYyjjHB1iHhmgg+U5RUuuJbELYrBnOujvozwyI0KrG9BUzWtDy3n5voBVNbquLUA0Cpf",
+	"K9w/sY1Vxxz05adzTsScJfFou/+6rpsO4sRAtuFA7OdS4KslkgAnDcBzgshHEuUSnPBbzks0zrdTHlfP",
+	"SJ1et9dDUeNWO84qgYljSWadfjvHLElYLk9s8yq6u3GCaJ4nF2+zRtfu0mftGEBEIWsw90kyRC4JXxp0",
+	"RQu1RW3G0dQRp/GGMmtQksTIItc6OnDuX8s0mnOWslwkOh6Lk4xxKRCVQl2JiRk542zGiRDQZoppknMi",
+	"1GmqdgJM8yV/z7KWoCncV7/NK+AovzK1+8gItjPJOIvzyDovWyVLUroM2yNBJfnunPAEvGzgq2bt4Btj",
+	"uObMOEEKnQTCC9PT7yY3zzW8x+xRjY4UiH/UY15fDyq7r0plV0JU++YWzmeqf1fd5a4UfqWx9+DCvtJX",
+	"NERJzY2G54XthCJwUDXHqimOercGJDTo3e/1EpxJEQ9wrGg4OyHwLDD2T/Nl00AVV6l2QdhbfzFbJ1DD",
+	"atRak7IetfT54fSo53lygVhp3n78sUSFB03qn1WTWjrnQ9PjyAreK7z6dHSsfgSb+7cmtOAhwOHSC8Gs",
+	"v7MNM66hE1nCiPr7OtJ/Q7oXToBAGWSBv+ksZVxrPlbxC5G3iDW1C8RxrDZpU82QsY6XkXNCuU2y0i9Y",
+	"vXQgR3MsGkhrpj5pH9xSl3W0k1bookB0iqj0yDrPU7Vc/XNBOnG6DIWbkDSuRpicqBcG0S6er6B/T7fS",
+	"+vaK4evfignr3/wl1L/aRVUhaq0FK2B2rb+RnYXHagAJNNSJhmRcYVCSaeeddbQjUUKwkIilxInhLqTU",
+	"KKPiMcIqlNThEcowxwsivaiPaM4E8Q67M6C6N8EvEwIdlGz9bFfPEiCZjocdQ/yvQjwbk2odByBWaMeP",
+	"IlahOd4PAFCTrsD/uczZApdVkW4q+rBVLSrt+T2uxyN4LJ14D/FaZhbzTZMpHQjXghg6KJeaV5iORhdF",
+	"xI/xMsMC/ZCfE54SSUTlwSYKpZOl8OsK8t/pCbfG7ld2lRL+l+9eJYTIDePJ0aQCqzyb7m6fMHC/fRod",
+	"Xn2fF2S59R1Q0a3xBVk++Yv+40nrZm6J8cx/kfcextnWrlLCw7cEPsGdIFJBClyGjHDs7gORb6GZW8XY",
+	"qpwU5NDZSB/qm53D/bMRkHyiEllZqStKCOSPsdN1S8LFdjulhKawkEAjp0CQc09rEGBade9SHdKnQEqb",
+	"6I2LYPfYHU2pmGsZQOPYaHukJL6JahzClYilkqZ5E5dlgtrUbGqmlHyUKKuoBa/mNKkuhApkckvB6dBU",
+	"Es7zTJLYa8SJyBfWD5dKxLFJ3YjTQsidUi5k84tmPNL8W5OvBmOFVWwWa+794HNQpKl8/mxU11SOR1YD",
+	"FJ57tRmRiv339r21uVlomDAniEMAU0XK630/y+/hgDgISrMbQBNLZLpqIueIWBGi5fZu8yv0g27jI3gH",
+	"zZUNBDkbiGlp08HatxbLZcQ8K6J/6WrIlFmJszdItYx6bRIcrXRbDSDcTel9aYWV/W6F9Spj04rIXk29",
+	"CXuvYU1ggdVr2kllm1Mi1WVK9Q+dNMuqfANSvKeqHOu/djzpcDyyPAeiyTwhyMSYl7NKVMQz9TaoCWf6",
+	"x7xk8e39NFDbLy85/Lm8h1obb1O1b5Vd1vvW9xNow7KOJkHAhFoBpK7Ho10sccJmyipxTKYtSUwqHLPU",
+	"resKlycJehxWBgxhbGCUVW3F3I/cxMhMihRpLz2BqBRd+apN3/CtsQPDI8VFv6tpEBXqcaXUZeEU0XOc",
+	"piSQtxce2jYhhrYLmrZKJIjmzicDomMls2871zgnhj84u/g6AsFOR4IYb2I2yTOIp0QZBBCjqbI2XZkn",
+	"oJ5PMpSSK8JtqlnwbID035EK5g/uS+29+yHnH4g6BJKEoXTZmBNX6T9obFemYR/lnOvsUJCzV1jp1p+t",
+	"W2i1B272UiwiiKuES+WXjiU5oTPFb461irC+5MamJVdvq2LUYZfIuHdFRd9ChtvdGRy6vzLrUCMOrWYp",
+	"ah7mbq1GjfOEjR2tzcuGj8amD2YDaV1BrzdE4wiDZeRPaxlpv8B1kyTHWQZhhyxPY4R1MJSOGYvR7snx",
+	"GC1YTIym/aLQelEGwMQZXfd4h1i/3FpvXUL9+pCPGTXaFxKxNA4myjIJalFsI5HYVDNoKpdO/+QtpPos",
+	"evok+EqFyOI2Q0p/i0wlu7AaGGGpkYs45XeRxcjCGBitgnPGsjzBXgpElfNQwI1RsIf2VqdMF4scPOoC",
+	"5hmNSEEJ4RScvwR5/mxC0ojFJEZH+4fFv3/YPfmvrU21nHV0aJ2f5gTyKK07uQH0ujRF2MeHNuGjSLLt",
+	"juR8KcNPZDpLCW9wakxjjWTGmdHihO6j01MCqfpPjhNI++QqdHT4LeY0QPreHew9wKl5ixB4FtIJvIPf",
+	"XS4roMXaR1jln9a9PGgYkZQKkZflutUMjDY3WHvakAcATIUwWtwuocpqhLBBEVygF87UkwcnGzFJKU42",
+	"jCbP+IjZvcMuvZSoogHuyl7pynyEsm4UTcM31gxZl9THBeAQSyNSwLzXXVPEljorWzUzq/3m6Zr8e7eO",
+	"flB5blDkNeQE7QDolB1wj6SUxBpC2qzZX26xY3bmXPG2EMSBOYkujgmowxlfvo0oOEZ7L6gVHv2ml4JD",
+	"pMaFc0Uublg5hWuHZkWDwJOVWpfxFm/xFiduOHsYUV3EjXZv7i5nbrTLFuc0NVngygPMmZCFEFbAy5Hu",
+	"sZHTGF9oLJ/mSWLWVmhC7Dr+k+MlSFl36Vze6Ind79yVoipZ/cRVJ1Pfxnf6NwjwSOKZvtawf8YNSOzp",
+	"04TK5ePAg8FhR3O6JOkOn3Gk5qlhlX+E4YRJhHPGd1kcikM4PT2y9Ewxf8SJzHlaUOvSdrW2x5tdIADY",
+	"Oto5FySVhbnAkkqTExKnSM1kkuzDegyapESqxOSgX2e5fLwels9Uj8MmUwIk4wrZD67myyAAYUluG93M",
+	"pmjbE81O8exeiIveiEM30SsQ5QsmLdxv+CD0BQI62gClgO9OR704iotvF/bt+jf1qe8iRqU9DCWMm/Ws",
+	"1jfJsV8qm3A97t3PlrJZoUtDZs4VcoI2GR06c3P3Ml10pglNE5o2r+HDdfiYrKjT+3RcF3cmWcD3r+cY",
+	"2vOjX3aRWoUFN0ohAutSInCfEUsJwoqESaeV1/pzU4jBlpNTD4Nj90j0gRIu1qF+LeROJCTPQVFjLAyK",
+	"AXjuOWp0X3ujXHqMnUKB27pBesRWbRspO18gGgsLecpxqt0rmq3Gql1hOi7WKl1fEmuyqIBk+LBaScoU",
+	"9+9vUm40s38fNrJT/cjRxlZ9VPic5dKs2C0vnHDnHN5vcVtJB7X7defLNXMtC+NVAQ1lyYYyG5CmMM9Y",
+	"Wtp4s3MBJ1iEjTaPzjkl08dItyg0Q3bONdFrpz213HbUBq22GWUcQhu3iXYP/uoULQlYS/scA2KxKTrl",
+	"ORmjVyBzIJOc1Dewq+/gDZuAS4Bp0dP2XVmdGavyqx268rObyd9lg/uAiSEuMIf6yt5yCSV4fo7Go9Oj",
+	"w/eEgxpoNPY/6Idp4QFca1oIfZU/LJE6wlxA05NlGsE/3itVpGqhI+oO0iPjNaZAqjTUxv84I5Ftepgn",
+	"kmYJAUO/gHVVPTl1p9ISzIz9jmc/5SxJFiSVRrz0oFD7VgZCo/bEG6KxjYNwYwsH+sYW5eUUgmPwQBRU",
+	"Gj/UTs3/6E4QDNj2bOCP0FnqM/JOVP/gn6v+pffpGtmjtHrzmz1rfUGmdFb1pOgnGr2mMtC9M+2I46C6",
+	"HuANRKEbzPq9lNkNur1XPsI36Pc2ojfopQES6miOql7o5TMXvSHp3J9JVP9QF4vBo7e9UN2NUuyrAUIK",
+	"dO7XfVmxSkuwQEuDeFBDtqNKrGNpt+UCd64kRynwQSehX4DJdjT+s4FxPNrNctvikKVUMkdQizta3vRC",
+	"N+uu91hYwBkynbq1TP7owbIY7RWK6zvRdIizdP9jxokIOzip74i4Bja2QaGFGjvOE7Dt0wUR62fp6dxF",
+	"P1CBfv0rMv/v1200QYc0zSUR2+jXv/5q/IkF2px88+06mqDvWc5rn548VZ/2MFSfPWSpnJdbbE2ebqkW",
+	"wU9bT7zOPxFyUR39+fpZeqIzVTrHdaYWMUG//vXXbWfaVFYZ7c9gYjZ+/euviKZorpbsxtPZCdRvj9W8",
+	"v05+3UbHOC0yl/y6OXnxKwBu6wnaOVRn/wLtHOrW41+3EXh02MZb460nprWQYB3ZeiLnaAEw1H02ft1G",
+	"J5Jkrs9fN2wfvZhqjxOdVKG8lxcFSOScoBdel7N0Xyc52Ea//hVtTl6Mt55Pnjw1Rxp8Ee1CanYtxRyk",
+	"U9ZmNK8+ysCnQCfYiJHO8W5LbZoDaAiDKptBvUFoqpERDIjwfg3GTxd3fk/XiE6jpY743CMS6vo2VtK9",
+	"lwqvTasIFjaZ0nRGeMZp2mDJT8kV8hrpg0cgYEp08v3O4yLiTk0Wo9hN31SwEUjJD2QZntA2AMOzyeu/",
+	"tB5AxeDGIGwmtcrRGZXbi+WEk4xtLDBNw1FQbZVp/fWVwfOh9cSVHK2lNeXt697RK2jnW8fyvStL5SP9",
+	"s7HOlnBPtUOwS+C1JhD5qOu6Vo6oGlzkS5z9AhorU5nTUF9mVCLGwTxjW5nTVf3DEWqdKOlvmU3L4Ih0",
+	"zX2zhBmVHqqOkZjjJ988V51gRecsXo7RDy8E0tXTnYLQeEU1RdAJ+U47hO3IPpo5f70OYek6Wa+itF28",
+	"UlkZl7PHfbV0dZt19Ri78feIs3MTHfypSFZlGUGaBfa68OykZKwr4sRgMIWg5+QBqJKZ7r6Ikt5/93He",
+	"ARUKEx+bDMlldy8QXBirVZXSUGJiGzX7HKMIZzJXN7ZeBjpEkI7JNPQgUG6E8H3iiI9/25TgA3dR3yaY",
+	"YQzaYGdL1usxS+j/qGgn/L1KoWkxZ+XjMcv1fO2z+VKoWAY/sulG6aXMisqepUXA8DQhBKiQSXzv6kKP",
+	"ps+fxNPzZ9Nv4idRfH7+7dOn3z59/uT8m+nWi+mTiDx5/iL++zfPn317HkcvNjc3n043yeazJ98+wX8n",
+	"0xfR0yE/1NcWAVBoNPsbQkyfG/j2f2i8fbWyp6HKaKvWnCeLcxLHbWXKAqXFbSeXPpExaVwywn43aXPq",
+	"z8Ii15B2ooEH4njZFS3q1VfVcVw67hjHS9S76CfE/AWo+Rs3i22DrDGwqWBxwGp3R5VodZC6IgymCu3B",
+	"FJ0nOL0Yh06P5ynCXg4HGBMLrz5ltXrsnReL7XuNwgWYVZRaU7nQwvpnmriSllWo3bx6aAvjDFaXVKjq",
+	"4dK4MIO62zduLYBfu//l8onBNB+6gUWfORR7rNRRDVSkrPhLGbVG67X1NQ9aELQcCqQZH/nuxMbcXo+z",
+	"weLcDNVdnGHwS7QkdIVUWV5XkyGjcBc0shpcqDpkmTg0XoitvApauX017QAEuiZU2PU8TAqzuFmdlkfr",
+	"y7NP1PdNwaDHpoGLBm0at8t3vTzPh7ZNChZyDy19rgr/kfk5YmlKImMod+ha37fQqt+DvTBRNp/RwZ7v",
+	"R1GZIYzauuehJ6RUbqxDOzeLFQlKGWN0kIPOm4MyTLlQz5PUj06mKZUUJ/R3/aK3j1xJuHrWJmO3Zsls",
+	"tzEiMmo6LhyrXB6aclcuV2VXYw+AzUe5V0nmVAaEHcxoMe0jDMVlQ7Hzuq+docR8RmTX1aov5RT6hd2/",
+	"9JD9tuSNU+dOLmRFXxahZqhtbUHknMXlK+VrIN6lBHwVwGMjkowvj4kora/NB6Jtxd7Ibc3KszooHKSS",
+	"zDiVS3ADbiJIzW1rT/cSyaK2h/E4zQhXNyKULLQ3F5sEuVihP6/OWeREviHzat78zbhX40gdblErALPA",
+	"OlvH+V0qrC3Jdxpy3imr4GFoA8VMbW38NTS3c6trblKsuw7WRiczI141oSibtqKk/v0AVHNyeXOkUYiw",
+	"spBWoDcIaMWiO8Qz1drBqs4f6YIIiReZ3Xtl8EvoWYjePRME3eRWadiYI7IvBpktbgPnG1/M+mJ6X81G",
+	"BuD5gTn8Dl/PG13FyrVo2FLTzeq4w/XrW1y7H7GQJ4SkTUzDfq8yCkA1oT5IHwtx4/1LGieqW0T0GMY1",
+	"l9iMiBC4SSPSF5Ur+OMW0IxBP9IpiZZRQr5n7MIijsWAl2TKuO92tzOVhHt/6wbHRKlmvBbFD6tgRmkp",
+	"takDbaqraRzGX2DTON6a68C50bMnsb3v4MlbNbYXg9+VtFDZ680EhdAgTYTIvRgaIFaXCLTvrKEGVYdO",
+	"/5cVSVJl1VWiUvlcWkXge2hpHc3K5CmYQqX4Vs6Xon9/uAzx3nw9rUKq/ZD45LNLfDIeGeVdvxO0ssXd",
+	"ZUwJOWx/Kveg5pUEze02caLOvtZ8WcA8aMu9uaSfFXGrb26INmt4ZUF9wa2cO5LLFnDb8oBvmxMU6z3a",
+	"hggLk7L4UQqx6lOUMv0L6PfVjxgCsLWeJ+B89kAHbPcePOCMk0vKcnG4ykGbM7Z9k2Upx+vqB66dHJK8",
+	"OUDne5vXj6XThEbaTYabjfkA0I6KsJvRePSG2X/BvvZIQsKY3uWA4a2tGeXeirqbb1XN6yd1TL2UDowj",
+	"zCWd4kjq2EGJZw0B16Pt2w/baH1qNTNdh3yH25ZSzpJodNuNCSpvl6KzK5Z0n4L5NZhQw4WAjwFiiHfv",
+	"wvggalWxxcQa/DmZrpcQ5Cbi9duT3pjwvmw+eHtSsyJXqjvu0VljIqcYvlXHMq5N2p1uG2+ur6/3cGnS",
+	"6y1P2nKTgHDNaaa9WD8Jl6yuIUg+U3LVwjGU/6zmEZp3OE6h67bE/RiFJbMtE9km4dlSlpI+UzUTweaT",
+	"UlEVrxi/wvyTHJI3/UoPodqyXXHrjPGG63C6e4TUV6vS3bOFu0zYsSjiHNS4IpA7uyFJnLXyxOGJizRh",
+	"avqJGd/Ot6I7YTGX2Wvz2R6bHBuHlHPGb+hR2DSQK4Fw5fnFWHOnVg4oD5Mi0ccCOod1T6BaJHFHgnU9",
+	"AogOqpNW1a9SEyFhUUtQuv1qD6tYcP+g+lNwN4XIcm/FJrEMgKQxu5v72u56ZIbkRGQsjYusW15pKgua",
+	"sO+RPZCmxeuv+lpQYeYL1t2uGa/NwB6g/W2NS0fdhrYdxvZgM/0U5wE7vFe2y4oOtkyFLrkQUGFW5BtO",
+	"2jzGHYLaSbVwq1fSHzs7nQxOfWf5sKNBaYNhNGNJco6ji7fTnhMAIugdCakwYax4Emgn/P3GJKGX0PF8",
+	"qQ7CTBNcw+pehpIssgRL0gobzTZtU28DFbiUzgdULGZLDoxUc2KzFxh3deeNsYc3vbF9j06nPTFeNbU5",
+	"rawFnU6N4CvQOZFXhKRIXrHaoYp2c8B5Y3W2Gnaok8PqBzzDNBUyeN6ZrbvXWqxZNfLSD1+udA3sOrqP",
+	"6VyXtrh0p6RX1/uE2rSegaZlJagjOa0nMlaSp86kyh8wn3RtTSvqTquEe1Cl/llzSNvzNmkyVnoU2/MT",
+	"XU4BUZb3xTt/HdbAHVNxcZv+C7JgfHnzESqwVrtxg5rV9QVt+9NLlOLKNbDLb63vwdtVTfsT5jatCKdQ",
+	"RENZqyAmadXUPqGFFhOFvhaTh756Cwp9tosMffMzBbnv+aJ3GlecLo26r2yT9ksHQsII/3O5guKHusLK",
+	"y9ioC6K5ck06nRVLK5UBmwrZ9yusWQkdqtaL92q/f1dUfh9LSvh3U85SSdJ4VAvluXmZSMvxvDKKBgoP",
+	"UCrSWJqhjNVfvjOVWO++RGSPPT58mcjrNqICl0JkLBVk9VS30E3zUdhmUZBLVJAPPntVzJ5e1z2qyy2a",
+	"40lKteiuCCcIipAJofKALg3A4/XuomaVKVuIr3m7NEp55nshLXAyMe8fBRPcLOb5ZaRv4GauRd+WsYuF",
+	"lPUCd+l6Hq4TUXzz3iTNW/XDLpolUq+Vi1C/WfKYcgqhmlyqHfSbF1KJkherZCqvZ48KTS9Y0lTvz5IZ",
+	"HEl6WbjYG03gqlK6jRwIVqgoO4TcqLIw67kOY23zNFJavRgAgvlQSJDQnlqi4D3cszxJhLZzCIggttYz",
+	"Lf/3BlVZ49mZdqjCMVSbklBmEviUC72JFVZTSuETWo6Oh4tXJOqnLpIuttovUclMVMlzpN7KRzo7cWjn",
+	"DlehITJ5jMs7rXYxOS/sOtSTCTwRTK15pfGlcs5y9UqbTulHY+MTc5IkEyGXCUGzhJ3byWD9MLtRSXhF",
+	"2RKGY6KnELUE0M/LeZc3J9/iye87k39tn51N/r1+Bv/3y9nZh7+cnU3Ozv56dva/H/726P/p1+7x/z46",
+	"O1v/RTcMff7vkGTSHYeuH6ZHUD+v37V75/Vosr06Sn9zk0E9giHsJFi8DR2vQKaver9LrixKqiGOZI6T",
+	"IjHwbVmLjUcrGpeeTisQ1HoccuB+4nqU3sqjV6IcFd+oBOv1oP5+j/4lPtw5wlnoyF4bM6nOIpi7GYdc",
+	"SW5Y1sNn0b14XBECCIzNz/iwWn6IYhTniX4j/3sbMnA3ftbo0Zu3p/vbWrXlUmU5Q1O5VMPO0UHfXDTG",
+	"leQ3wdIJnaWMExei7Hxeb+Smu6Jo4Pp0CgfG5BbNNV4vK1KCNTy6UpwlY9DKGs6ARfU2OQmDirRVPR5r",
+	"ZEGzUpuErccARfuyKBEmnSVOvTLR1JPF71IqmwFoFK6rsLS4ITTFo3AlyJRp8ihMov2j9AmAI0eA1MV6",
+	"i5Pz78uHDg77PRWS8eV+GjTLNrVEnEQM/BTmpE50EZ5KYuu3gumr0MSWA5tW4Z6n80LDWNbfrpWSCXxJ",
+	"PFS7B70CZVR4x5nOZzH1JZZSCjsgLbQK2BX2XzpdWEoIADejn5+UFLkApA7TeQE5Y51VqL2K9fwOKUax",
+	"5ip2+Ne7uPJhwlGhLj4dYaIvSdCoEHg0mcLeJds/dERXcyZ8eAqT5kUD1HJB03iup/GNBV07bNtLt72g",
+	"vrsCYk0t3rl1NLXYKa+vqdmxt+6mNm+FZ0YofW4z/NYals2+Nbr7OZh764u6DdHSzGsw+f65Tb43z7Pj",
+	"Eao55vEV5gQUdDr5sjpuk9LJEZf7yb9TJpZ3koEnAJqbBSXWh+iIja6HQr+FwgpA32Yc61RK1nTqB5ce",
+	"MWVKid9Op6VY6Z0rTCXU2DAJXHRZFojZOMK5WDFesbQhb2m1b95qA1/Ltt/Sp3rAbOlzaZuB79UIytLH",
+	"EDACzarwKY6z9Azrlwv7rYncsLfBKxBNPmZMFI96nUnpLN1XfrYqs2nEuPEKFSbswWqb9bUwaT2dzmi5",
+	"fpZ2Z9XWmyjdqoglCYScFQS2URenFtlqztpRLfzQktp6fJrZMIbXoiHxVHBkhTqh3EYvGZPKa3uFoXTS",
+	"8j5P7lqedCXYWyKooR3e5VvbCJ1YStlzeVUe4APUQaG+inH5+JrpVk2n3JHoJ4OWECiwwCmeFYZkw3bF",
+	"GNE0SvJY1+okqf0diTnLk1gx6JhdpUafD4YeXYS4joK23W5J09l2Qno7XvvrsRvlRFc+IP3GcK3dA+V2",
+	"q8jLo664huuOA4xvFN2k13SnmQN8Rq2Hv0tGXdrszRh1fYgVcgcUAHOJA7JTtoehBvfbXL6dmn97CSN+",
+	"0pxIMSB6SfislOJkFY5cWrk3b+Crv5Rg50o6i9JXb8H1j94eQgPXvLne50lKuOFau5fEi1SqAt1UeoxL",
+	"dSYzKAwBwN99v48u/eEQuQzXsYkuSSigRw1gcohTly5/9/3+5Mnmk2eTrSdPnz1eR4cHp8f7xjapvv38",
+	"888/T4R6aKQR8bqPkY2HLhJLwDswkYSrJxGNnSe/Z6t8/qxkqlQzKDPkhz+eXdt/jK//e/SwQcvlQ3q/",
+	"31Cyggt50Ba4CB9t6KLL9K2grvRC0F+tTssfzOjdDPAeaXWG8iLcwHlMpfYbGyM/4rEh3tFfmwlibU2Q",
+	"5sIpi1jTu1ntqvnlNZ420ypfVdrxWrOuVjbBIsRTOGMSbG9KDL66WhWvbHREox65y4z8R5+KnjZx+fYf",
+	"NRF1B51zgi8Ui27dyfkSnfnrOhvVU9IU0FvdtmohXbWxiuqj+TMAg1lTOwgkkzhpIBTqk5dbOjRTz1qt",
+	"Rqj5nKBjlD9t0KnqjAFU4wDaV8+/suHui3uLjLwv7ZaFf0bnNk/fuo/j1h23JTfv/ZzMLstT2bSqdT27",
+	"4bNAcccow9EFnqnKVgoV1dv3bJRrqeFshCI9XslvdI4viTGNmzcTaAKLp3DwgOsHQ8VFZ2W4lYuxjT+z",
+	"anLBp1tkyoWoN5seAKQWKi5QLkyKgvIuMiznTYkJOPg4L5Fq4y3eChzemO17gTkChcj1WfEcZn2ZxyYD",
+	"bkV1X2mBdPEwk0NP6WxBS69KhiuB0rXWnJDr6q+IAgHJTAnYOhhmnOXZy2WzHl77fV+QJahtTOZRBN0U",
+	"iG1mZW/+c1huSVvviYOPftmZ/AtPfleC4C8T9+9/b6x/+Ovj//U+9vA5A7HzXYovMU3CscmnEJOc0kW+",
+	"8NiBPSPkerr7GOeAOQZ8INnq7qPtrRDlWNB0p2N6/LEyfZ7W53XnuNL8QSrAogvCd3I5byaKYdc46Gje",
+	"BTiXc5JK/2KpvCOFc2tApsrlvE89i7cR3bFNIfxSiCvG4ybjtv6KFJ6xC6KX4rxnyssssXQ3bsgca0sg",
+	"tFdz6JiqQ49l9+hN5+02yFnztrL0FpFi08rhjL2DWOdMlwziTRMiiWZBrkOhnjIRxzrRFEZQnZpemnSm",
+	"RFE6MzaEGIMdPE+pXEdFXUr3I5hwt9GvQpd4FCRiaSzG6NeF/kFXbVQ/zPUPUJ8S8McjC/+7/cvW5NsP",
+	"Z2fxXx//79lZ/ItYzMM0YD+NmFK99UnaTUxbzZMg5zoQcSxxYaJzB2qfjFmCaap0j1iQ5896VyvXUx2Z",
+	"zvbvl2aQa79o+a5zFyzfIeJaTIwrXddtKsY8MR2qiBgYM4R8tYrqddjWmpSrRRlbJUAYQ511nChs1Aso",
+	"OVzerIpUfYllm6O+0qOtp/Hzp0/iF8+f/v1phDGJ8fNnMX62+c2T6bff/H2K8d+fPZlGf9/8ZnPzyfO/",
+	"P3txHv39283n30QvXmx9G2+db/pJACLBR9ujifq/l/uvD96g3f3j04NXB7s7p/voeP+f7/ZPTuHrWXp4",
+	"cPDy5W+7L/k/D17u7L388fDdxdXx1c977//5z739zZ2Ph0/++eTw939cvN37+fc3v7/57eefXiX/er3/",
+	"5M3r4/mbvZ2ts/Rw8fM3b07jxc8/7T99s/ePxc+/R1dvTneuDn/7+embvTn9+ffom8O9n7d+/n327PA0",
+	"uTj86eDq8NXF1f7Vz9//wP51cJb+/tvm7s4/fz5Qf/3+2+bezj+jvX/Odva/f3m4+3TzzfE/Tv/x9M1P",
+	"bxNCv/35p4uXhxuHv7M3e6+Xh8c/5L/vb26cpdEPF8v/9/0/yMfv/7P58SB98uTn3Tdvnv5r783Hj1c/",
+	"Pf8x+efsKf3tdXp5Iv/59vz5zs7hDnu9u/uf1yeHz759uXO4e5bubM52Dvff7R78c++Ef6TPL3i8+0P0",
+	"4+48Pnz59OrvB/9Z7CX/mh/vvz7//nB3/+R9+lyIo52D2b9+/Ns/+T/k1Vn64vhv/FlG8c+X/7qQXFw8",
+	"Xe4e5L8/nR/8PWE/L/7fo6fxi+/OUgD7/pu9liMZyn99beW/aiRitUpg9e43KApmVtqLyO4YOtmD2Nqm",
+	"tlJWU9CCI72+v2XBBJprceiOoURHB2ms5B5SzqdkBkJzLNA5ISmyA4RT+xTl/ppe6h3G3h9hACQZEkRW",
+	"Sh+oIlqcZAmOiGmmLo4Sc9Ej87p/PDbxruBytgAdvw7iAUOirfMY21betavBLjgdpD3z5wB5A9viNlok",
+	"Q1Oqy8ZIBB7soK0MzR/UeZXm1OdkNBfhmkfq+rKkOLY6AEDEhUHd48MiEOzyfmG4KsjAlhqcSXUGgIbR",
+	"r3Z/DaqvdEk9LWAvfUrzba8rMjom7br0XqTSba9/ayIpLE11Pp8AKGuCf/f7+cTaHi+X3XWgTdse+iNv",
+	"1LG/pQ89cnh2HMENwsUCgC+uVxDXwn6cwWZlL85akwdz1QzO3MtTs9ZzcNH807pohiWzbkxXzfRBew31",
+	"Hau1XRNIV/+CqxjK2iMacpwe7R9OQFlAYnT0w+7Jf21tokj1A90y0XEq5UJmAWmlHJbav+TseATmgeM7",
+	"yLEHKGtqla2rQDn0yOZbbkkwchuxbEeLY1PLib2UpToY8Iqq13+WJUud9qEwMoOqWt0hj0xSEZIjCzy6",
+	"UTHGUsiV4D0RtMHhqKHhavyhF7ku3gY3EjMK9PJQuRv/TQp6r0/YqbAtOLcabau2f3M+0RJ62xxP137G",
+	"J4V6rel0TZM20WvOroy+VZFtoBRaGkavQJOFjATuI3hjIs+yhnllxR+o/K/Hvr4vpxPLucLH/u74R3s6",
+	"7w6Km6sLC0MYnWRIV7xXv//zGCkU0dXvaXqhS/LDfJbftnih3lSj2aTYrMCrmKARBr1QwppOOtBCNStQ",
+	"w5MLyssqIQ1oRm+CGnroiXclJ+HKpLvQcLdot4clLpbpX3M1gGYX2C5djY+mNNGmj9MfT8IXXy/mgixb",
+	"F/EDWa40ubKUd8xdvewNUKkvsdfB9ycJPSiDLTGbzrS7+00O3duXQirGqWwEedF2xzZthr43MnIj+7+K",
+	"xgscShKvpWdQgSjiEcecCOeI27lx9MgKwnMmpHr1bWeMyx6eZi0AcosNnrySmAPHfKmfaZ5Jw3iRgRem",
+	"Jo8sgkwSRagieKMHiHk4X1v1YQvl3Bl3sIA5JKezGch4cm4m15Y8/cYBeQpy65Ep/aiNdETXn1DDbaNH",
+	"YGUDX2b1g3jszWC+4lyyBZakyKwTlg5v+mSMCyfYVlqv9mYdZiGJxSWUutCK337qYVcUZHgs3vljsSGR",
+	"/A6al/1NK0+zaj1gBUcdvtHgIX8zg4DOZR9anpgzLpX/cjSnKSnWaY4fblk5ObUey9nS9aXzbMLWN2pX",
+	"Z80ejcu/UJa6Epv2QxEZXP6l1tBWDqr84o9Zz2bW8HOlx+7Ru1rq0t2jd9Vkp7tH794oBlY0OoRcsLW+",
+	"+udqd/1rZQTljlbrr36s9la/Vfp6IdPl8DrvQy0qz/tWTfW6R4VhyF77g0B8XiVcrvqzq3blZ2Mk8UuV",
+	"j7GaoHE3IZiXx6gsQPFEkspaoIP5vR7i4DoEgxsqR191ea+dRbVBbXPVBtWDe3sCDum2hE2j5rztG04q",
+	"y26oH9deeW3kp398jxNa/uUgvTS/HZhAw1MsLtzE/o9HhC9wCgnZvHsKDjKML3cgtyfVBSOKnw9SXP5g",
+	"OFJcNCmIAbik2zXCH8Xy4M9j7fxVUBr/1xOJef1Xt9TSAMZ+Uv39pYrq2KMCUsvXvhqokcTCvdbVH9dl",
+	"5Fim0a4iQtI7Mf9jBXLFhxrsik9HmAsSB35UpeSqRFR9U/8/+KOHYzZ7lsbaEn75ibWOODsvbpSOOTw2",
+	"NSa0dw9fAr85pDNuPaq5bProw8yjUy4NVXGHaiWEinEDn1iWFYe0k0v2LgOqaLIKB76ES01pcPUS1050",
+	"U6eJaXMB9uTXtyn8ogn9GBla4rNYxwPMt+5Kel3K6LI06QSGQrIxE7j9j43c3vhqaAzugq8TV/3NyL9j",
+	"JIqgL5dY2zwnlhk8+kohTDqNZZaZBKdtqNKdV7baxS6+5Rp0Jp0rtw+NWL09vfLYeR38MVsYQqtivb0c",
+	"agcvWWHkauXPphJzHRmRGgrSBW58+0CBemlNvLx9oKaYxjo5aRqn2q4yQpiftI0V7NEyqsfg+g5bdAmP",
+	"u9JCO9ZYYbM9Biz3CI/afuXqLcOjWI7TYxjTtBgnIGA1DFNvGR6lLpH1GLDWqRi7TTprDIxp7OKPWxKF",
+	"2jEl2Lg+Vue6Ss08RYpNuvlGu8R6MZnKhJaSFaKBaoP3SvLWQNL69W4n3zcZo0qou8ZoRs5VejZiYdcg",
+	"rejR3bkTW7uGaLniq3RdbdOt1HOVzg3EfOUhbrWIMLnuh7tN7Le7d7uY1r9/g0zWNUAP4bMfBAKSTC/g",
+	"BySO6w/lR0NH5VkQ5Bu8sOyniudVQ46G+3K3ctP187FSzQe/qj+vX5X3Jg++xd0qXNVUnYgLNC51hXfF",
+	"Bmk7d5uyVpynw7Tn5g3t+RVNrC60ac/wUbvaTGkSsq629YcIMCTJR4kevTt9NXkBJjQdD1ZYUYtJ1M7s",
+	"NCFHGdXOBoR1Xlg/vu36umH7hx7CldevviJXeSoc8RvetdrBmtDBvWMvRtAYFyFUUJ84V+GshNMIHeyt",
+	"oz3tHK5uKjobccbk2Wi9KXm7+nEiLmg2sX5pEyABhLtc7gvj4NW4woxwY+5Aqu06+pnlQGP0mnXCsQXj",
+	"BE3xgiYUc8QiiRPrnJMQrCCMfiec2UpWm8+fPYNTxtrXMKIL04HlsqHPsyebjxWRkzmNNwSRM/UfSaOL",
+	"JTo3gZFI2IhJcHhPmSwAO4Z1VjYDN0XtU6DYg6ta3no4Q4VoKtxuoAVl2+/1PEfbo3dFjGu/Y25C7LfW",
+	"UKjjIbVqMHK6cFPc3ksA3C88szS0p1r3fz52Y5d+to+wD2aFqyVV8GlVpwjjX+xOWetcsCSX5AiD39cf",
+	"9dQDjvQ0JCEAiWnFKPFXJu2O7yRB/DpzdycHDQLKFxFyBxixWpid7nK3oXVN4n8txjrCEids1kOg0Q0L",
+	"t0gKuUaFTW8eZvZznKakIS+R+ViMmOu1mnh7sf5gWdGq0OpLRYNA1m8plaSMs0Wr83qpbLusV0V36cpi",
+	"dE6mjBMfSkHo0MaMpKGjhBO04qkZNg6OK9mtNuINr8jDiknTDIaa3RVIVYawv8g+N6IoxLUqva8Nglgm",
+	"hbdvmkpmfcpoZLcuQuAX4PBfP3FhhTOz2XX005ykCEP6ee4AbsI+TaNiJkexPRQyfcalaASbq1X9Zlcx",
+	"NkFtWswQelJ0Wi7rH6qICQdYjuFti8f8yYRhYpRp+6wjALZerg19sOhPbUAEpI8FJ8BqVypq9RPgUCaR",
+	"drlMCN/ANcswAn5lPFYNR3VzL1HEsqVqRaUt1dk2pnXz8AYNxwCLO0wOC5gZVtS4T2VFDfz8cIqaYrpe",
+	"ihpoPihq/rSKmm71ck1UOVfNwgwIPgHJLOfDLBJHPaAgEdxVOMWqseAFGbbLkKVbVVMgwpZ7pm00VZiP",
+	"CI9IKoNOrGpK0wxlrp1lWTeYbJonXRsrWt5mc7LMmLrFnwons1ILFQaNFAsxMTwQq8bCIhFdkPhtLrs2",
+	"Ce1goNvs8cbZPfvP0iaDVWE8NpcxhFpjl2DTwwSH6x7gepGFuuHqT0EXim0FCcMnwembIEDXGXZT9XuH",
+	"dzsJvkNIl3BLQdym7IA0a7cEeBegwwbWh4d2eR1hrqeav2lMOOgD27wjK5XDFVYThcqC2OImQfje3em2",
+	"TC2ZCUJf8YALKKx+2GUz9MMfsp7/Ye+TkYLu/yZVPDweHrpmAUHwctuEY0lmgVwxZgwkTAvnWFv4FUNh",
+	"p5f3zn3KLOfW/Ka68x7HGMxXUG+zWqqCmgRR0afqWP+XXTKJEdiKevearHCIoKkArDXDbaFwvoEqqyWd",
+	"COyzM4UI9n1fV/NIMQq4Aqn7lcY/LjWGOFxFiRnv6ghpwk5sYw/NVwTcqacqKzI+N+TUL0Pr/swORXRP",
+	"7V412AgqrRwwGu9VV9b/Fiy76TVbR6arUsRIrsipSe2b48Svowytx4iovVKcJEtEi5dQ0aLILQ+B4JFN",
+	"Lg+VrUgpDJumCCs9aIOzxGq5Phw63L60flwrFNK/2IV313pd1jIlXTG5yGtq8voe6dx0Lvt+xQGDymAJ",
+	"l1Nfea2TBrymsqiTp5ohHdW+Sjp7m8Reu+ioseyVLbxDg5Ikd5+72WExlFM1BsfUpPWYXNK2BEf6q1p0",
+	"Lkihg2xdb+WovMXXZh03JeYfj9JeMroBo0lB2EM6M44R5uQbcOf7/PwglZypGw3xZMH8WA0Ni+oAkCSd",
+	"+t9RrgLmkO6Jdo4O0KOjtyenaMMvnLzxh9bq/pvG1xswyON19E4YQ8lblVTiiY/XRgl8oOvb6T9OSMSJ",
+	"zv/8EgsaIdULvqs8MwrodcRtjiEr76Eq1M2onOfnQWEu52VTzMjqmXFG13W/9Ygpu1p9Ug9IyttLLbzs",
+	"DxMeC/as+6o/x+g8lyjCKTonSBdfpL+T2GuF9lNJeMapIEb33o1Fssll9bXCq4zdQCRSBKa4KtZFyKS4",
+	"t8neBUrBuDdHj7L8PKGR7vJ4jL4/PT3aUP9zAt/HiHF0cvI9/KH2kzLp2clgEwp+mkoqdZmYm39/qCVq",
+	"9hp2UO7vi5bX/pgd3U5cw9ZQRg88qlH5ZVPByJ5WdO+8lPD/WnX08TaAlP4y1GWSDEUJSzV1LGVUH3lW",
+	"FYOdG+bjhhpEYa0uK2ELtm11IZ5a2LgZ/b4nycJz/O7vGuV1sqRFZcsHZ6Z+MSoQfx4YxpWuaZU6tFX6",
+	"ACJ7p42jfKiXwIGTzHCTx615QLhWRYWGYg4Ukyxhy4VNM+GOb7Gc4CybFFMEKByY+loEU0irW88F7MkR",
+	"eoTQwrxrj/k5lRxzmixRSgRki7HhrKKSxh+KH+nLXogNo3RG04/AgWcqMf/6ky2d5QWq0YzAwQ+fg0FI",
+	"L3nOhBRw6upfo207g6HXioXoz1reGW2YH7VuYnQEGXHUkX0wyZJphKGC02j7aSkBmdrgaPvFpgPubpIL",
+	"SfjBUfjNqeGl/PNaDL4WqKoVCHCQ89BkVfbOG8E44B3KSYKh8gZsza8VC/K4koER4zHh1iEgF4QXyY/1",
+	"jKWj+MWsVTWKc2Ce60u8UDfYfGCXhHMaE7G+XCSjD56M3lFvp0IW9JEHE+vWaQRjFztRnTyU7xUNiMVF",
+	"jVmtZLGeEgsiC/R1lU/OCSIfSZRL7dbT6/Wh1tb6ApF0QVguv8CyLGhNrJWrsqwt1spVWRTKrc3Xbl+Z",
+	"5TpUrasfGS+w4zhP7fUt/xgolXL5HvPbJC7dTy8pZyk8gi8xp4oSqSx0E7gnKMOUQ6nn37Ta29xjnqcK",
+	"xuH07HnaGIOxUIAuY6hfRxqnS4T5LFerEUZiFxKnMeYxEnOSJEgsU4k/KuShph6edS4XaGGiHe1MAmU0",
+	"A139DFyOxgqjKDxfluiK8GIRKE9jcEk6x2KOJpEOa/gYNhVeMX6xRxvczdVHoHSugJreLuR011XJ8jS1",
+	"filmoT2ecnlYg12+ttur4Jrrpnyn32adkkKpz/7HTHEvoBWd6/Ia19NUpYi4zx5xIwr/sAQdgGKL6uic",
+	"6iFM80xdtgZfxtCWa/eJNQSFuMRdj1QeudRwNywhIIYkKgGq0zKoLQgsqZgui1/d0vt7QpXCAAIEuVnb",
+	"gY1TvFN76PAfxLiPlg7UoB2LdIDiLcEcqv03VlAN4kjpcbPCg60sxalFqueXLkCuKEFAdYfXIx7gXbou",
+	"FbLBTJwxiXZ3gvjTs0SbySuovQ8C6+pVmk2FjOgH8XvC3Vu0PvPJBc0QJwsmiVGKoUuvQ9j9UCaiFzBO",
+	"fzzRuVBtCFWvpavRL8iy/+gXZNl/cKWSafKHsXXxbg39FQrjtc3VLRl4N6BdW6pesz3VpaleST+FqaIK",
+	"R0Eyon61KlKte17TMr3J9anmKmpg2CBAV4j6XJM+WIogCi8L+e6KUylJemt1K6+rW6221NROEcs0Qi2K",
+	"WJFP1UspsHnuAhpBz6BIZcQWiuRPpSn8U2jGDrSWS4sxBP0nJ1A2leMFkYQL5e82R1hso7PRhqKIG5Jt",
+	"WFfS/4XW30Hrs1EYbRpVuu74Hl6LazGyia7fUBUHCGNhU9bE6ZBAW9u8hN91xL6p3uwONGBq6p4qMB9Q",
+	"6vH+PXRtU4IBfKzqCydJWOnl6Qs2IqtmbNV1wbOYxjqFW8OtUNPqG6OFWZYmSzgU21UJ8NqT1FhaCpiB",
+	"Fp0LtIA0yOqK2rulRXh46QH3NZuzEjN44QOK6nsskMKmdGZWQoR5CUA64DlJMk2N5Zy4ZRXZWBV8HHZ1",
+	"o3qHxq9V7VZ30KhQaVVIFnQHEG3LJZ3iSJrF41kdo51q6bbDtu+5SZ1htnvI8lS+Z0m+IO3b1W202apY",
+	"00J1JzHCXvxvg0nE7bdT86mnKhLyLbSqq72n7gTbaYCBHagRFm95WXdaj4j9IjDgFqHK7Uspx5tdNsUP",
+	"RSUgdhrJ6yC/vu5R1coEezuW4oHJyU9gucFV83NoF0Yi0KYtbrIq1eDPyXTdYctRniSFp0thljuYvmHy",
+	"SDtI1Ixxb81RlK1va36fNRMiJggEKa3tJFd4KdZ0uJdeBxUoy8G/iFwSxb+U5qbc6436UuoEL0OccILj",
+	"JSIfQbmbVopbWJan51QZy8qbgVF78kIFHzeO+qMylvrJjGdB+mcmtGEaGzAlGiJ1fVf08ybLbEq8sldK",
+	"Lm5EdDY14JuoBSUUp7IOyHW0/xFHMlkipjOmrrlbuabarZWpxppTiDu2ch+EZjzKSre4E7TepQe4mi30",
+	"wq428Gg3JVuV3YhAylnqnCBX7YJwr2PKgABaJ1WFvXYw0IomTElvAhlfG8YXToorML1sGb9LTjI6SBOa",
+	"tklTjdYS6BhKf28DHn17o3mo9lbCeQsq0ld0GIT0gvpZhPS2+6gAuvfpLG46gLdOrnurHS22VzWO9/uk",
+	"bARcKJ/mw7pp1+cP+twQzhk/bKoXoWaHFsgkXrbFF6wxQLm65zysqmCczmiKE1e1pVe2M04kX+5aebi8",
+	"nDelUDUTCo7FRVHJWPWmJTVvr6CxEhSqK+863cZkkQ9/0LWl3MeZZ3aSz+X0VRlbc/DW2q5d1BeYX2j7",
+	"QFYAxoRn3BJFvIX2wZd/XMkePoKhVj0cBP/x06mvOQCB7h8//XAS8oGPaZib73/MtLXUNkFRgunCukYY",
+	"teo/fjoNZcPKe7gblqh5h7/CeESFyAlvWaZu4C/yFmvUgwXR+LerC/GuSbWlgIwe/ePk7Rv0EzlHP5Al",
+	"OiHycaENBG2RrwM0fngXZAlsz5waLBrKN2LnotMAotUdLn+7kt0J+aVGcrvbEAr/8EK0608qDbw6PRj9",
+	"kJ8TnhJJxMbbjKQnczqVjt12aUZxRhuPgBrq580ATqBKyx2CYkxFluBlOKbv+0pxJN0WOdMJUL9mGWFc",
+	"eEV5z+WQT9dPrho/FeiHF6IABRXIDBK2hDE+wyn9HSC1IxTKLHrQV4Xyb8M9K2MqwBhvrO0/Gp72poCZ",
+	"A4nfH4Bl/GQ0BNRn+BX29lHxL02S9SB/Q2um4Zr2NRAk7MJgQdTNPiuFHP0Ts5fi4oUIB62d4+iNCA9/",
+	"/HJnt+IbWKQADN9ZzhKy2ikdl3uYMZr02+5EjJJbMqQmz7QS07jGqSH1ujWAUyjXQX83QVzmG6i7tS0Y",
+	"fFImnCQEC+L5v0F/TvxxhYlTsVApCmnoCU2+xSnUEoxkMsHxgqaTs3xz82nkesGfpEfhwBIOjC1hCFIr",
+	"Rw60c3v7S+WuXgnjkYDZ+saJFKtEuuMXmvYzT+UNbbJYejZZDQPP7mqU70FI9zuzAqzBAVqcgd3nHkN9",
+	"uak8A49a34O5ONrOsDzTu7gAoWsJkY3h3E+FViCmQtI0kqYw+diQHYKjuUvlp70ipGYlZ6MLsvwOpMCz",
+	"0fpZWnarJYW74HeFby3I8DPK0u9yMSFYyMmWAi8l/DtVBI6k8SoetuNROWYztDvVANkQUJPgCn7T1nd2",
+	"SXiRlNO6BwjNSzkRwEqnaKFicmEy7XUMfxfeatp7dOfNHonX0f4ik8uNNE+SyuxCd0Mpk3NTzKkS/lkZ",
+	"tYt1HVbbK7JQrPRWVeoXOFMb/+OCLMdwxtfaxTPgyRlS07mEUEH3b/XFk1Rt2KtxiVumck4kjYrjKNzP",
+	"fCdQhbn6OJQ/KsuFCxCFZYh1tOOGAKWnGkBbo42y+I8ikHaM7MKuw9mvaZoHaNah1qUKIo2/qK5Rq/7G",
+	"KKEL6qwhRQYeQG/nAqN9imka61LCRUYJ46eltCyQnBkghC8xTZSk6pe4hYKh+D85Mbi5dFZxyfQzy+l1",
+	"TfYxq7L1EpVhHdtKYi0fA1mQzDzxL7UdPiUfpb0rbiUFuHc1mMC+r/i2oEKSVOqx1LJMrrOM6QJ2FmRm",
+	"p2VXJLVv62vIuAaBnOMUYTQlV9YjW59phoWwCf/sidtcA9pvwEJbC2P6BQ/7tEdbqRZMYy3LJhZSpdfu",
+	"lHIhbcJ2MkZ5mhAh0JLlej2cRIQ6UBqPMyjfnZa1PA2+TQtMU5qCTaFBLVNNlHUu1MGm0iCXWScAXnN6",
+	"zHVgs74+NvmhPWi7FXjDu54WWaydIDYEjXEDVUfZwCBYxXO3D7sogfL0ImVXOoeiBqQaxgI9IVOJ8hQu",
+	"TxojtqDScyUXhFMlQZu4G3+hXi4d9Mgw+XMS4VzYnJBq69E8T8HlmhVfAQSmFHeChWn0uNgPJwZ0GgOr",
+	"e9IboeI2O7HZBFkSw+sUp+hya33rGxQzWLcg0ptDYzlNJUnVMebCiUp1vFE7+ysRki7A6+av0EzQ301U",
+	"fMSSROsv1pGuQi+sGKjm5QQoZdPY2vkGqAF3rvrKJNczmViNZ1TYWf3BEHQXPZ0Tg5aqJL5HPQ3L1wFC",
+	"oilNm3bYbio+7ty5iwAlICDAZSsFGQ+UdPOGSfjvvjKEQz05RsQbJuHv4OO3iE4L7KscKiWZnngVrV5F",
+	"XlQg9Db9ofsYRJvQCMvx/PL75++sHvY1OJ4d6K5bdUlPF022lZ4OWUol67T5LXSzbuWF7xdqOnW/i/3R",
+	"P4ScXvrUrPJ3AoE8vT2nlNIoRpfQUr/Z6iq9gB+AMdTX/ABu7Q3V7AWllb8lJXtAq1JvVGjhnd92Weta",
+	"229bdVATA9+ws6aUAmNQ5TZ0ChoYxiM+jf7+/PmTxqPXn+s965Xo5Go16JoHbu/YtPmufsH9XzejQDtC",
+	"19v42uzU2BD6K7BzOWfccNlGVbYZtNS4ZEoI56039pXWMXUjpVhoHkLryfoM06II+QzV69Wz6tKw0ypx",
+	"aE1/FKAnLeYrD5a6iZHup5Rw9Ci3CtjKN6PHpqmmPOJxg8H17i0Dd6pzZ6rNk6ZccbfWk4uIZW1B3gbu",
+	"upl+T8KbYjXDJJxA1xWGRt1XNxeE03TKuoaz7fqNqK7TrjKLlq6J0p2TKeGcxP+2rUY1n1MwZfqJh2xT",
+	"Y2ilqfsVFmQfa6DHdGHvUz2EIDNtNTBGgF/OAms4G32AL0qoT+wfIj8/G314fAvhsmooqBJg7yDL5+AR",
+	"1AphbLxhNfQNcp2Dvd0OnlNpUeE4B3u7vflNB09QQ92aI3iDfGH8oATJTm7QRsnVSLoBWPoNnrtMQ1Gk",
+	"5FCxPmNspkNbvlTKTePo09FtBeVbUu0HoovKi0PT/s+cHhqsvjdiVySFrJM59w3Rqr4dJwnKCAdlbRzW",
+	"uWsVolEdCuih5xVwJqatdicNCOKuzMltTBJFY9A5nS+d6phG4fwSsB7K0lO6IELiRYNBF3KAqLF0T3Bs",
+	"01uJS6qsGEsyUY2DJJck5CZzGX0hdF9lvhlJvcJ/VfWMVgZHThlbKnPixbYUo1gdYkyEwl6TzhUdsSxP",
+	"FCQcvMGAvI6OCY4nypTSs0BB0mmRWuCPNuzw+dNxFzYcavOU/qw9u7QhSCvKvKgbawcxV0vbSCIsyUzJ",
+	"JgQ9AioHv2qd4WNn0BjdOFpWt1cDeNt68k1oX2CkDh2iV4UGS2XLFpqV2t/HiKbKCEvTeEMTMWOfbTAq",
+	"lMwigQlTa0QyQIVp3UtJeJaaNVF4gLlKUxCtUey7R1C7JkrHzcEOO1XPDT9bZkU1PFT9ubuqP/1w3Csd",
+	"1nbsJe2zLgBk2X0dIyKqxJUAJpTFJSWnqmgTE9hCiehS/sUsuiC8SUbag68wdV0Hp0S105X0cP5wLdtc",
+	"WUoMb9vKi2aLIYnxbUT7hI7cnUMWi2jvNBTlECOTAwEs9Fku5iTWrtW/Mo4F/PKrkkeYICjBS8K160We",
+	"Zsp9JEYslczzewoII2amlvS/Zp+lWD1qzWA6kqqeWqKalpDx2YZew6SIrPd4weazFyG23u53JtY+93LT",
+	"Yu2eyk1vbq5abvrpi2eP+1aR7lc7+jYudzQtJUJxyEWFQ16b6VBdfEXBLx2KXxCSqW6UV1Ig/o+ew7sJ",
+	"JmmKyVOm+0gqExKuNugLCI18WGfpnOm0UDMiZPWKKCzTudR1zj2Eob0OE1RVogGwKbky/cs5GEeXW1VB",
+	"6fndZ9iGT0BXI3oDd0Kx9mW4E5byvjhi5x/vTfwLDT1vYGY3zOGiCH8RgupIay14tELAIXPIoaNCNj5e",
+	"vUhqcfE70LioFO/TcJVmR3XScjPi9kWj8jYnyeOx+fwTp5L4bdQNI7oRvBoUT3rsM2KzEtc5yJLPsSAQ",
+	"+huuEQBvLmtllzyHt7nqoyNshed+ZR15iihfiNg3cqumdzATeplTcDExFyKjSmBAIudTHGkBXxBEUpAs",
+	"FFbr9xBMopGvv3n/pd3efip1YYGqdugOMq2xQlxsNReZZtfjkYVRg2qxkK2WaM6EVNg/Rq/+ufcGUj4c",
+	"HKmkMpwIU32XudAMxqVlO//J8XKdsnFxHpzEcyzht8XS/RqxxfY3m5ubY7T17ZP1recv1rfWt8wvv2xv",
+	"b32Af4d1l7AzEkjbXrsAkIsHWgMCRyxNSaTfPax0G2qZicZmxA8Pnnbu9qmVWER7ZpPwqJcSx9+qjnWS",
+	"apCmJcePi6/qMDeEmlVsDraJNkQN5u7AUBAJs6uLKx8lOCXN+3XQNL2QqcmMMtXvS4pYC4Tw3cqO8gAW",
+	"8VXj2vy+6FHG2W+gjzOhUgdpxBaKdMHfIO2GItvUV02M0RqLsska+huyQzXFuKmP4DT/iiYyBLGDqf+E",
+	"ADHBdBM2kRgVxg/RKnXBAzom3HomV2IRioAb61UM2ju0dkGWOneJi69Yg3cMzKoaKkdH6sIXwYPcLceu",
+	"BptADvSIkxnmMTgoW1fCx26N1h3YpAbR2CQMsZ6o5SvpVxIFKziicyIl4Ta3K04bMiberSUsI6lQmN9o",
+	"DvtqQ/W+PA+MNhtZkLN6NKGusrppMfpBX/wJqsSvXrbOP/xg8brW+vJd6BQOiau2MEFj9jp5X0Uwav5G",
+	"+OhuYsMlrs7a6xHm9wpd6uESfIJL4CLjVkJle+JdKN3w7Ki0KL84fKmrjtHdkjBykjCIXmKuInx0gmUe",
+	"hhX5qK2HoRfFvvmGDvacNbWywD62RdAQhaWPtyd+QUetoz7TfvFno1IkHkFvT8BhAprH6JJidM6YjBDj",
+	"iGeLCROSE5sJUeOlUIMphW51uJTpdhOlxolReRXU0+j4oo9NMWoG7Puqhd0fmL76ryM7wvVYaauj+bG+",
+	"XQo+jpq0au9WrICgUMCIif6OcByPdLUhHezMiVIQq1MlDREy4foFOwj8g460MtQlhw3H14SXCp/UMnEM",
+	"MYZmUeu1q8mytjKIVbJ6RHhEUhnM81R8s1Fnhsga4b9EZbOisW4V3OCRs2WEgORZOiCaQo1ra+64oxKI",
+	"pa3m9aJlM48KjGqk27PRjMizkfqHYqP6X9rFRv9b3xz970zhpv6n9orR//6rUcGC75Gb4fFqUqzdYJN6",
+	"SX8tlm2KqeoVQJFWUV+N7SYe98nKbhYw9kEaQqriVMNSioO60wMXJ60rlWEgwPWz9No1D+sPVkzh+eH1",
+	"FkI89Oz0l/NWFoLJP3McJ0TeefW8nv32TQ2lFbooa84q7QNxX59Xeb+OUdqzc6pSVYFjdR5AcWG2e6dl",
+	"vIdN6teykLDm4WZFKEA5o7wMrSC7Wul7b9YwNLW2+pByzngnU6+ml/U7O5UzEWgBv3jpCTRthITRokgH",
+	"bYryGbujqa3tbCpQfhPt42huxgMmyE39vlLlvilOEsWrVFqQqq0CUSlIMg0k0NCLDJ+G3QGblgYbW/Wb",
+	"nh5KfmFOkEtp2YvKlQH3I4uw7BPV2m6PKpZoLU1QSMGvaudURNYpxp4CJzaXt3tqGQCUbe/GXNWDczkj",
+	"iIVyN/Y5ONwGC+0g+k1q8KaEV3VEoKkgUc5DApgy21ZAorPAZAmmpigO4863BOxVSx3wL1wxIq0NJ2Gt",
+	"e8Ka7GEFGq4JMDCG7IjqArljhUeurk1vK/CLMXhV4KU1nnslZMYI9N6SGTUzwkhQqXxRzE4BaczVBf2w",
+	"SpJiF6wvt0lTL4x7FlHpbKyUSoXLZeBg6NxdREaiMnK5A1KLmOB1r94IWDc7sc6BMoxr2hUm7JFz7JQQ",
+	"gCm6qXaXCReSaHpm1PvapAjWGfoNk8ZLF6cmBz6I9Kq9VbQrFPMKGhUwEjzaoGlMPq7/Jvq9bX2DZXDf",
+	"7qt9Y1huGHBZ88oxj63hl0UU6p8qu1S/B2e1QPN4VCtXMx5VDa3lX97r6cz+mthqybHHO+BK0WfIZI5K",
+	"RYIqFXh9Rd3Iqd+Vpuhy65xIvGWVMP6co7KaR/tL2lEnan5fsel7qnjeID7Vr5NfBXs1BmQyMrmHNOFU",
+	"gAbb9KAB/4o04AXy2RQBBWr07Kfbr6huhLV9aKA+euDww7T8vaw8d9+M6/KD6M55ZdKe8py784Pi/M+q",
+	"OK/crRZUrqVWLucqK/PUjiQkLUk4nCOlYcUtpei8poplNLu+eQ0vjatYOLWL+uga3zYVib+ZznrB/na6",
+	"Gvs76mpb2VIHCjiy2ogE0MKXO2iq1bYKB/A5y/VltFkI9RPFx4xaVkHH2QMuoBxutMSyQXTrRcdaKrVX",
+	"bpG3mjCgNL3aSQiXx7kWoqo6GW8HdTl6XvGaKj7b/WE1dtgdK28KdtwzX5yoSxda2Pad7S8JBwONMHp3",
+	"dm5SHRplA0ys9OvoFZzndnsx+e4y8W0l4s/O4r81VYUfj7IW48Gpjlww3xXU9I60TobT2YxwEYSkjgNV",
+	"40ORVSqX3QzQO+8T00mHQVUQx43oHVNpH+XnfSdylSaru5SarzWcsS+ZnzBP9Ttll1NI4ajqjaVT1vsp",
+	"07CWYuDGJt6MjW30UrxN/xAUJo6dfKDYp3N0UxZIte2dowN/07uFTuKEztQyrXVvPNpPOUuSBUll8ZsO",
+	"1xiNR68SQuxzzb1x7Nwny1SxjVOjeCiYrHL3sZrdoGa0kt3M2JEbueLu0btGApbloVRp49EeFReNAXhU",
+	"XIR76TRyTf2ak8zV+aGf/a03W2zYTRcja1tXRyhiAySuP5QvcSmXXf0Aw/LRSa3srhlGh5k3mxOxZSKh",
+	"5II2fQM0Qly1WkdvbdZe/WtGOLJ0B0RuTZxXEO+r3Cwg5Qv1rFcpL1NJ+CVOWpjPOZFXhKR2/wi6EvEg",
+	"/OSXrcm3H87O4r82MZWWtIVj/ygCO24j1kAdGumW+lpW0ZTiPtVR2qy+uh6ZqVRXWEmYLsstWfFW0hZs",
+	"5wR1U3VOibq1KHTU/P47XWsIRxt2PaJsjalogsYjifmMyGNySc3CFpimg3Zn0O7U6JDCxVX1O17Pu9bw",
+	"FEPvmrTKzZZYnaO7s3qYbiZ0As84j2xKESqQP5/BgHD8rTpoKr/HIqCnV79amVAncobG4dfE/RiPA1Br",
+	"rgTXCTCirZUkhXxWhK8OsDYjsgfKcekIS8vrwg6rAXwgPZ6eWFHllRn9iSHlgybvT6rJq9DRVrmkos2T",
+	"JsT7kXjspA44nHb1jYnRb08kgZHEHDGOZr/TDCr1ciIgIxHmLsrfWRiNn4MK2mRTZ8HyFoGODbSAKJW/",
+	"UuN8oVDE+uKpVrbwQyl2suIFABZmk6hi43Jr/cm6xHx99nuvpBV3l1LDZANp3HDp4232q/Nz+LvutdOw",
+	"ibh83mD1BY7BIc5ridxG7YogmNj8agoNO7cUPxsKsmdsEAWiwHAxso7tLiYyEWXWLQDixUKyM4AfpUxR",
+	"GdubEmE8c2ADlaHk3B9ArdUX4IuyOSUgl+TkbuDe0JvKT92e66So2jafRqh4fy5oaqffCsxdldRD89vU",
+	"aNy0qp8rPPcQRrOSMXqMMDrnOI3mYyTxDA5fs180x2JuOlUxf2xyaLTk3ChdDTWnug29rkiNcFRuB6Zp",
+	"5ciePWuHYf/8MTa8uy/XDuocS1kuGh2zAzJyO1+4gYLf739LFT++mYjTouIfj6ymexcQrql6hpAFVjvv",
+	"RLUIEhv0rOG0pl3dWKzaLXBKp0TIRkx+5flrVDuffL8zefLN81pfTRGDEchmz69bciS6fXspEKs771nH",
+	"5AZGFIfXpQwOU6OL7S5TIQLvAB1MIspZZ2BIRCs5kPxni5000v6/KyrJ7UYKNXL59107qrf5T+TaW5o8",
+	"+C5LydXbcDJGNW1KrnQGIPSITnVVmUgZssDrX1V7U3/YMP5APDS5pCwXLRPYJreYxTwLXlGSxC0vKagj",
+	"ZFx5rwh3z4mCoxWM05EgC0lY3chl9DR6BP2fdRvcbv+2PotBeLeaI0uv1fK+gjerqfRFneA3tOxR8vv4",
+	"1S5SfRXJTmPMY4gJ7yzCrTOOevkvdHhGKe69zjpuWnnaFh8JQTxvCuB2OwttfrWAbmmOrKFE7LHSe+dS",
+	"G6B05cawXdc9z+bsynNojV2iMY64HqvL5+Kliig6MSlxmxMW+Y3q5h4hOZZktuxv66mM2AKMI5bQKOTs",
+	"6H+2Bm6zaZTpXw0/BDIeCN7UvEBTPZWbmOWdCcOtUUNrymrH1Cq3hQ/3Gs6H57Cvl3k8I92LqLZXitEc",
+	"HCpP55yIOUviHpFH1gQd9qPVqz2xJxu8GfbctQ6TUVODWgPGIKV5DJRPxr+TZVQI3cymxDj6d6S2K5Ag",
+	"qbEiQfbuYh2iyJsZcQLpWnAi4KFRGIZVBhUpbIpkzAkiacSXGZRzlYgTIU3wBkEpuSS8KJp4viwZnW9k",
+	"dnE5zgMGF3AP91buq89t0wwLccW4Gip68v63aPF+c3Q9GEy+MoOJxqLVU0G0mDv0kA+bH9fWa9YI07dw",
+	"uSEGpYyWgfD4r7eU+UlTKXOt2VJ5k9jUb0mFS5gKEIaRTROhdg7btxkWnabtFlmzT76SeuY3r2Fe3Inm",
+	"2xp2PS++ld3O9e8P53Euivl6aXD0+gbz1J/WPHViy/P3rnRQJfU0LWhSO72/JRkK3c3mLTX4hblvFSub",
+	"MXZoZWexhMqbxRzRDevEmMogUBJEVSWGdLITm05WS7/r6AeytFVrdJ1yWFOSzbGln9EccxzpoMq1ydoY",
+	"rf1bZ+VbX1tH7wshWvEPAomD/wcS4djK7mrDC7TA4qIU4d1AAxvzVZ2IuRZNVoyh3S0FKKinwsnJ90hy",
+	"nIqM8QDYM04vsSQ/kOURFiKbcywabZv2O4wrxPzI9S0pTZ3I/tApZEtL6kwxbHYOALrovYXQi7LJyKB/",
+	"1yTbYIcm2Qp+EdYR9FiimKVr0rbQdkyvFs3d8K4omL//JJ8p9TqJrRIelhAVebOpMGaPMdp0CmVSK/b9",
+	"9EnQc2fgY3fKx4QIxgf0ibEobFcajjYLTXAmTrAIB3MscDSnKWmc6mq+rEygDtpwsbPRK0yTnKv8WHo9",
+	"ppw/NeoJ1VbHnesK/FRnz/KNcS5ZFtpRxagES1VVOK5LFVlbktksoPF5ru4X5OGSECDCaUxQg4eYaL/I",
+	"9uHlgIfepoq1qpTyJ1pLdTZSzMLb6b2jjXoWTHAaTwxIO3l7SJwxGzdkwmFAgXRBHgVPsXgnUropBSLS",
+	"bL2Z09l8kqhNIbVbhFUnfaa65pifKgwGhFUkDAp8jMYjmrqfp5gmRK3aDgINYlL6c4FpKkmKU5NtbMqJ",
+	"mOtPeXqRsqu0p82rvssdu5D6p2NvxfWvB8Ue6h9f2V01TGg3Vv+8R3B7g8MSLEKr9qBT//zOwqs4831I",
+	"GNxx5jqrcDmWDQ5fvWv9A9cN45HLkD3heWpK4CU0vSCx+4f3BScUaxcToVvof3gt1Mw00mYCOwNN9XN+",
+	"5Irpwc8gIVFddPEcxx6WjEerIYoHmn23r8Zvx26x9SY/2q03fWrrvGOgU/9yaOHV9Klt2BML0vqnvQLI",
+	"9Y8HBdjrH197BxFAMO9o6l9f4nCvd+74ArBXPMZH5x8ZjjuQWd3rHqgsZH6ukJXhGLaTMjmZshyI7DmO",
+	"J4JIc03B3xYoLJ956HtT+uS2cKJXUP35R7ui6oc3TL4yC6x+eonjE7fe6sd9s/7q74d2P7UPFbxzHwL0",
+	"5V1KZSFVVyvBOMrUqWEJc6jq4zHIsJpFKpvrXyFA2YMKcvWffG9fLDEmC5b28oIjBXb23FSVBF9rrFtl",
+	"iDLag6nt3PUPXYErzcLHsHWjIrSJzQs27sDB8zS13Lio8vmsHMaEJ79vTr6dfPhbMC5WTRRejfriJf1X",
+	"6RmFmMfrpjTs2ehxeTH+x04ZCaYtY0n5jHxgj0so6UExJDRVoyrreys3KAdT+WU3XWaou3skDu+1L8Ia",
+	"VkGR1SKIqp3vNoioMnpYYR9oVNbcVxo8nAo/NHEvXX6l46DU/9Mq9UOXrwvDa3lkSnTcOJU0k3PtrBk2",
+	"EapPJgekHcDWhZsqTJCsWyOgx++zWUdh+mXfNR5BNmL9lnlQitqut3eQNli9I1sKtpdszA64ylMYXIi9",
+	"NK59irev4jL8YdyBTzfwWHcbMLi3DudLF+RfLK14JP/IdDKLyhoUTH5nKfGqRrnKn1BtcOfNjk1ovnO8",
+	"v7Px49vdndODt2/GpkCO+rEszyjqQNWxIcYRiwhOx+CMZHs6o7dqnGEuaZQnmEN2yyKaCEuEOcFjNTky",
+	"Eh/aAXsO3nhDrv79M+MXY7SfK/zbOMKc2tQCeYoX53SWs1ygpxNn+0HS7lUbe0SeZYwrNfmjs9Hrw1Od",
+	"Dfzd6a6RMmvk6VT5U3qZ9lcpj+kX1eEudUfl7gDp/jcNMJRyPbXiqLrCYdTjkmlKHJMZSSfko+R4IvFM",
+	"0yDGF6Ntb+LrRqPCTqnKnDMmlIrP/Rt+nnGcym4X555LYzEZs4WiDep5b9f3b203CvlYHP2wu6/XZ9vc",
+	"5VrcxJVFwab/HfbzNYcHTeouvlpN929AjdF4VAfo6MPNlustSdMpraz5d85p4xptI/Tu+AA9sqSt9aSV",
+	"AclWHoPEDyVEMbj++K7OwN9F5QjKkAwEB8Fncwd1BVivw92ibWnoyjqhelfjCcDXu1oGDFaavsKwPBwZ",
+	"e2QgKDVo6icylgpyO/JnxghXA246PzOGbqSHClJpYlOlB7sTkws9Js2d/92qRyoN5H1qKI6TUU7Ev2lI",
+	"JwDQgBb6rgB/oqlNHRPOm0DjRgAd7O2qQjsayo/+8dPp43V0pNmyLoanIx+gnclVTVIaFygXqiPedqUc",
+	"0fBuVnAc+NJAHTUYqmTxJcE8mI8qZKrXLvFONFTxcTj0LNuBKDclioB4MYNSPjg1Qp/yjKZSpeei0mFI",
+	"GUN53qBYdOMy9boiH0mUS+1r/evGOU03xBxNol/LsgxGehS9mGhOogsoOqjdAFEGqTUAMRKagt2Uxkn4",
+	"nOSq0QDVEMw8nMutAtiQTO++2UTazrMzUQKnEfZhi+Cig3UK7QWRNmGf6oRn5mHApUAxu0qNxQ2SP2dZ",
+	"Aknb3UlBCU6d2Z+olzWWhV+7HokoF1F9n0ALoVS+kIYo4ywiUGZzKnWFACipHOcJGStOhtPl2HVQ1bcp",
+	"nJQT7BHVRucFkWNvviusMEdhACcZU5vQumS1zagAUcn5FRW23eobCpApCG39xYMn7BRQFq408OVND0A8",
+	"T4t6DFjoGtxqodAbtsJiOl3e5DHWcO8CL7MF/rib5Uo1vUqgB8i2L0GtvPxRaRlW7vyKE3IiGTcuFv37",
+	"mtthC5gdsauQrGmitoV/qpoEmOLcBEiCLZGGMjUM4JkOtVXR5Hp7Yd8ns4p3KVQEIPEbIq8Yv+i1kDWB",
+	"zOMPcZbLIqYepXoURVYuNCYppDb+xxFJEniNpYyjBeYXuiKvWUB4mUJD+Kg9o4LWZ0CCMO3uTYWmerYS",
+	"a+m4qg7PG5eYhxyeWxjCibnYoYA0Ex7oXX8r5DL14I5KREhdF015xNjIuupnSRf2q6v6YChxQLfZSZ13",
+	"OUv3P0JOEaPfAHr4muOI7Hk5U/vGe0lPDdCq5bTtato0OQquIcgpBOEqG+ZNBUQlk9gxmiXEBtluv12o",
+	"C3vRvlLFQ9SnzsrXAUKsllry3b27Qp4Z6Pg4if+dW+/9+hvXtkG2TXATIj8PeQdqDXNZidDjUkEG5p0s",
+	"U6WQleC34hHvFDGyxNXZJghGRWZYeDwqWjNncbgq/mmPjBN6obZxe0yKo04QE1dfBVSM1IEnCMsqUcJZ",
+	"xllCNF0qrJLm51FDgeum2vt2etWmqfS+DkfoGkG3MmP0838tXXwLO7deb+IPjaiRB2uulEuCKyILrbsC",
+	"fbHeSvsZe8hoHhmt7eGpAa3rMcHYw5d+McG1Iftgpbfi61ZINjutGMSEyv6S+bHnWntawNc+ppxuycC0",
+	"nw9KaSmnZojSjzt2PLvwhw28u9Qp4fvF25krAZlJVao8ust4ZqjPD++d7eaJaSgQSWc0JV9oDF5Mmlco",
+	"1vxCorpk7s8sB/OiXrN+DOh8rnhBE4o5YpHEicYvjBKiBaPfCWfWU2Pz+ebmY8TMNmMS0YXpoAwX4T5P",
+	"Xzx7DO7CpZQm+mFVWSOKPSBtPn/2LKweubPwQ9E7/FDcffhhd24yb2Iv/9oP7wPoW/AlEs/Ihi7ftSET",
+	"Mbpl4q7jQOIuNtU5XS4tDfqTRkbWk1bdJEzSUbBGVuAqXqwmbFUpnPFdE7Uk39zcSos2JlfiShQxwusR",
+	"l52hOaB52N0JIsXF5WE4h2pJPmtZVbucpttWxTRhsxgEXwxQsi+8IA3TfYUdGaeCFCX+zCN7/cGjpJoz",
+	"zFSSJ5bzK/lSwio14kq4Wa741piXBscxJ8IFXPiIWT4X63qiaYhfZ/DFk83NDounlQusNNrroVB3FeSJ",
+	"BbM/XuM1LcTAWz+KMNIe7gZATql+R2+hBlV+gdZuwlu9G/Q0QXgVHl7lLV02OU+qeoCeb41mfdxqKtuX",
+	"ZAcNrmRx59WnyzvK8vOEivkR47LFPW3OhJxINpnlREhdNtS8g4TzSn5/aOQOkkq+1Lpbz0lDHxU6G6mx",
+	"1HTbMJj6l41dqn/ZyDiTLGLJ2UjLY+hs9GLzxeb2i03byfy5IaPMOEU4FYfv7bs5+fbD37b1fx5tPJJR",
+	"9n/zOPu/IpLZ48f/G3QBruULq57OgxTK7lPiumpCen+IlPYUQgd0nj5ne3gFSXN2ZaKtAqCFV+29bKtK",
+	"yD5X8mdCLyEPuCfc6ISLUPR3w08biwWisFCbQ864YED8tU64aL7b55IwIjSOLlRNIRsbDelfMfohPyfv",
+	"KZdI/U+Ok0Md/4d+3jn8UYutis7H6HKxvsSLJBDwPB7p4uYNTBt+rpRV01XsL6Fb33qtepzMk9vNJoiu",
+	"6WtdeGBwljk9nJe2lshoQwkIHzfUctbjbd5dHjlrFOGgEDGVS6VZXuiVn4Oh0pJ+/dcrSzn/8dPpaDwC",
+	"bAN+DV+L+RWjM8rGJo3Ou3cHe6HcMFpvz65SUc7yh9Ahzmy5X7+DQNYRct3qC2kKRUsJyLD6Wa6WohwA",
+	"igua0R+IcRyg6ZQZHz9pUkSTBabJaHskCV78P37OqGLEU3cx0C5LJWcJOiV4YdLKFdy+1LuWCeCX8hAf",
+	"HoW6PTY+t5o5mJxaKpJUZ+TWt3VhkhaAfyRII+pZ5HLRaeswodzdcrF+lkKoWkSMYtvsbCfD0ZygJ+ub",
+	"tc1cXV2tY/i8rpJTm75i48eD3f03J/uTJ+ub63O5SLSeXgKuVoC0c3QwGjv+tT2ySbgUvmQkxRkdbY+e",
+	"rm+ub5kXCKDjhuLBG5HLMjAL+Zi+JrKSsrB8WRVyuHjYg9jIKbs2t7ZVz8OESiIzOEE0LfCI1MZvJuRY",
+	"E9xOr+5iFkC4io3gB7X3Z1sv7mw+5yZ/HZLLQClh4ULArPnsybcPMPkpY+hQVZ82vobakV+79vwyKh/c",
+	"COrM6FMvNCai9eihrIZNk2Fjrz19SyF2eHMZW0MYNV4TeeRNfo8oUkwDUQgB6P3YtjM4xM2tBzjEd6l1",
+	"hCPx14u349E3m5sPMDUUuVL2V/3ARjqOsd+1UWhtWVvwzpSNk64GOTri7CMllgHDlm3VhwL8VUJrRTQE",
+	"tbAkp+SSwM3yvb3Dt8wu4T7vV82OG0LtymqHSzVcquqlusQJjU3QafBSvTcNlJxauSJOA1G/ArYXiDwc",
+	"g1eKgDdiQN8UGFXdOrs0JwLPCY5BLLdyne/BPBp7cKy+Gz7c401sQwm1E9iGvnoPMelLHFsUfLj7fmoy",
+	"WBd7HS78Z3rh/7CMTV2i6w2nZsyYkI2ew9K4QOuiZSHW6gfMiBW466OjnUNEhcgJf1wPXzDxK0p9BnoE",
+	"iBkxyoQw4bH271aq88YzjrWw/VwUtMeYjgzl8WE48pUSOgSggxABkF6yeHlnqFKKeFJn7Q/1cXJ1dTVR",
+	"UsAk54mx8tx47Ovqdq/vkbaWYxkaCQ93Le6WynZOXyK2fa6fU/w18lt4Fvm1nsuVnsoYrxr7bUUX5u+k",
+	"hQuka6hwHdRLrrJUnlgTo1XiE661pDpA2twdGEENAIrLBZbGJl9qtKbDDHOypmtgWBWhK70BT1x7hE36",
+	"LjtIK5sf17aLbHEMU9JWchqVH9Yu37pJ+md0xJQjXWyjbKIkl4QvpUrW1LRQ6HXileR4oNUCbMXYUkfw",
+	"yQdcYVyB+IKgte9UQs3v1P8q5dnaX75bK7J3XJDl1ndwblvjC7J88hf9xxNrTgjsFGa82U7BPQp/pIt8",
+	"gVJXfdMintskTYvNOwRBpw4l0RVNEiSIbEW0Unfl013CcvKRCmMBsP0N/ioTgLrGyg5QODlg4V0cUNOL",
+	"/FwoGpBKfYsaMYMuqCzBqZbE0cBktL21ubkJ9lP952agCNKHe1bwWZrSpL8xar4/r1Bbe8RuPn2AWV8x",
+	"fk7jmKSfXJJ9iN2eGBPAu9SpAWuM1PJM8FoIi6m7nJgnapBz1hmn7uA3Ht2PZFaaopf0tHWPc4egZtP7",
+	"wfR7JCEVuGz/UYFdXG9Tljqc4eW/HdE+Z/HyvzasZWsDvqsFvSayfbIZkXcz0zHJEhx1bI0HGt1wxuuB",
+	"ON43cdx8COKo7FwJjeRAjkPk+OPE0tjRdumrGNWePBt/gMpBU29FQkLxXglZiY7vddGiX7qcY4MTKflb",
+	"r7FBAXCzh/+DayAHGe0hyNCzB5jyDZNIZwod6FCADjW7T/QmJa+JvBc6MiPySyAiXcLiQEoGUvJ1vDCV",
+	"GjMYlRTNVyAn0P5eCAos8E5JSt9n7wSm/tuKnkCqzyeyHwxE7eskasPL8NOT0Twgkel8HytQ0eNOhczN",
+	"6ajJUfQpCOl96g8fmnp+Co3lQLQHoj0Q7QdX553nyYWjzx0uDC/z5OKtbdvlw1BqPDgxDE4MgxPD4MRw",
+	"W7pYIiqDF8PgxfDJ+GyJb/ZwY2hgnvqCl4p7wm7gQi/TaM5ZynKRLB2Rt7kugaspakqlcPRZaBKqfso4",
+	"m0HOB8ioarLt01Q3d9lPQy4UpZXekw9FeY4HdqIITN7Ti6Lcs8GNogq/m/tRdEw3I/JO5hr8Gga/hq+A",
+	"QJdeQqXPoafQCq4NjbQdGigajZEp3eeReiFZJhB1ubgVYU7JR6nSEkeQaMzExf8P0jEaAuFEJ9O2LEIy",
+	"2wbyTV+QTDb5U7QRik59W3h/g0fFQAAHM+iXRAGbXSqaSFjIpeKeSMkX4lTRKTwO5GQgJ4NApQSqiHCp",
+	"cycSQWdK/LHRpO165t2i34nuZ6DRpXNu7Djonwf986B/HvTPt6WejQRm0EUPuuhPxpkb+WwfvXQ3s23S",
+	"Ezf2vCedcfN8D6w/7lhIT11y8ygNeuU2eN9cx7zCMmZE3sMajD/YCuvgXT1uvBZTVa1p4J1MOU/gpL6k",
+	"vGfHQUM/aOgHDX0ftlV6XLa8JNsfmj20+LHV4nu3F5nriwqKElKq96VAnVqxbiY8KNsHWjZox75UYhbU",
+	"dSlbntYjuUd01EJQanr4B6Y+d6afH4/ylP4nJwe6noEu8fNJXu0DgRoI1ECguiMkb6QkgL4PTKOGOMqB",
+	"KA5EcYjP+WLJcB6UE0HdVREVd3uLiserqcvuiBR/EaGYt1Qpf1Jq/Mk12gNHGDjCwBG+JDXoBvYMGEFe",
+	"ow0VUOY3JumyTfSvS/zvbmQEuQW/kQzh8oIHfjNI/wOtH2j9n5nWF1RcEX0dAIIjHbTCicgXpLn4xzF8",
+	"d1Ej51iQGLFU+/QVbnY4jTeY8Z1zv4ZSuajR9vRg9+T1oUfXM30iYlleQnPpiIFODs5e905CSvddlcr9",
+	"OOHnOILlRGYM/faGC+noie7nKMR1ld5UvzvS0uGsrS9Hl2d2QSMGN+zBDXtww/7zu2EH0OecsYTgFE0T",
+	"PFMoRNMoyWOCWJosYaGLBeZLezEN9VlHP6lNAhQZgnebLbutIQZAthXWYSj12Q7mV/ZEb+3XNXaVEr6m",
+	"Ea10JdYK8OmgWoOwJEZXah1rZmA11BqiAlbUBFKvbQgBDTxCwHpFE3WATk5bot33++hgz+xBo6Bw36/m",
+	"TBD09kRXsEcxnREh0RyLitL4Mk9SwvE5TahcrqNDRRfPCcLo8OD0eH8i5DIhiMYkVaKnKuW2+35/8vPP",
+	"P/880SgUkTFSV1KtZvJk88mzydaTp8++abyD0SU5iEtbX+CPP5J0Juej7efPwO4oCVc9/48a8pfNybcf",
+	"/nh2bf8xvv7v0Tj8OL9XWW9w5x8kvE8s4fXx3a/IXk2O+rrZvb7PHtoF35+1h799xBamDLnpGHCxr7W5",
+	"sRe5dg5tnsn7ehvP/aYJZkTe2eg/YiFPCElbZnFNbj+buTPNc5kGt5npmKQx4SRugV6lyW0jG5pm4qXP",
+	"dzNLEwR5oNEQizDEIgyK2RrPDWlFfHXIKomBOhn0XjMz6LSLVQYfIgQGCjM44H4RJKYlD08nxXhN5J2R",
+	"iy8k5U6zsD/QioFW/NlVAO2e+Z30AhreGcUYHOwHqjVQrcGf5jOkk23FibrJ5HGLMuYmhPKLcH9fRXf7",
+	"cITxYfXEAyUeKPFAiT+BAm3DW6bY+ANnmfm5cGWUmMtWX0bVAOEUeUMhliI5p9Y2vo5OiBQImz8nCbkk",
+	"CTJjvyapzUTLLgnnNCboEU1jkpE0Jqm09N0bfk0NHCVYdbvUpvUxmiaESCTJIksUu2EcCYnTGCcstX4M",
+	"j/8HRlKnxFmCsgSn6q9FlkuirfSQ+3vmVqS9YGD2mVqKWbKoLgjlQjnTqF8V15iAc2jGqVqI6eMlHQcf",
+	"BioROwcXFT2aTi9uSlFYOHiVKBREWWaBwY11pLQIBQeEpfmIJF0QWL/I+SVV81RAxFmSsFyKMRI0jYha",
+	"EhUoVf5LSEjGnSeLLLuDrAmYyrhBLAhWjrHTPEFXc5qQ4GEJxdXUgUjY1NnIpGI/G62fpSGXVgUyzTd2",
+	"iqFuKRLclSAw7prX2/1YgTAmU+r5KjkoNp5iw0rN9Rx0QgNPH3j6V8bTV/YxLnH2hE5JtIySFp/jpvYr",
+	"ywwdEsPJTeUFt6b7lxOQnGP5uXPfg9p+YcWJYEh5VsbKYRGbWa+onENxE3ali57oU0Kx9ltGU8b1AZRY",
+	"19WcRnNYkFmBvGLIHDO6wgJRIXISowUD79mIpFL5duILIhCZTkkkQ9z9ZODtA28fePvA2wfe/gXydpa1",
+	"sXaWDZz91pw9yDNZNrDMgWUOLHNgmQPL/LxYph+10JjTRe08zo12VA+gfUW9vnW/1I5wiJt5pxaDfhGW",
+	"UR8Kg/vIQNEHiv5VGS3L5DVAfudUSMaXjQkSXpvQb9NOkUbI5TKlEU6lKcOOJMepoFqzFSaeY5SSKyIk",
+	"mlIuZItPsAbF92ZZd+IgDJ/MSu0+pozfFf2uSfBefHMJMpxEjMdKQpfqeYWnkBlCPe8kXTRGYKskEeGY",
+	"dsWuJ6rraHwHizI1nDvXI9kdrCacnsBfl2R2teah05W/4guqwRbA9CFce3APH/hWwbcsXwqwrAQLKUxA",
+	"byvPUg2Ragn0TEi8yFqUOQ38qCE2+DasKLSuO+RHD5BbwsKkxQHyWf1c3jC0axYx0LSBpn11NM0RrgBR",
+	"s9rbTqJmG1qTQIhytUb/34ZyVSa3aXmMsvk+ZepTSzcvUmWEtwt5T3hJFVORCaHxcbnt6HNVcA80c9CY",
+	"DBqTT06lHSVuodKXmpR05JXUFFNiMNfZrsj2bSC3a6JMVbsUJ0VqygqdE3dA901iv+rK75vQhxIXVtfw",
+	"59cPVM5z0BAM0vRAp+t02tHiHvR64w/zr+tWORs3k+2eVLtFoVAXRz9bN5Ta5lsTbly6DX1OuooquAcK",
+	"OlDQgYLeAQXdiOl02qmuUI0IJymkACbyCrSeV+z2cnF/Crun1vmFUVmFB5iTO6S0q06N8AzTVMhydvbW",
+	"Jw3KOIlIrGI518xPa03SuCrbMfqsOAOgycAdBu4wcIf+3IElyTmOLtoySe4mBHPwa4/A29z2aXQUEcy4",
+	"mYPjtyMyUCyDygob0GQqkDZazWruuV3k7fUhEexFzku7+HPknxsozaDj/WqSKimSELzjcKUla9MA6CQT",
+	"egZFoBaYX+iYlJheEj7zSVUltCVPJU3K5IMKTVRIHEjoZBrdmRlPzQpb/CJcl+32B7flgUAPBPorEz7d",
+	"3a8LncIVCGjVPOhmihqukpI4WFhgSEw8ELvh3fuVJSZemYZ4aYrvjIoMyYoHSjZQsoGS3SZ18MqE7Liz",
+	"0tKQTnggXQPpGl6cf6IXp3lVqvcmSdXzc0FSGbF0SmetT82icalQb+iFue+a7upxVyCqGO0SLrXZg5yo",
+	"CNx0ZoBcorK6yrhKSIdTm2AuKnpC3ruMs0sak3jsao/TyBYhnhOllNREvHlGU6tYhCeB9D7UZC6KsCCu",
+	"TDJ1TrG6/HQVIuvoIEU4SRCTc8Khr16kB2V/Il2FGlZ+ThBZZLKxNnQk+CezN9cOfqD0g5D6ldDd4uYa",
+	"ea1Ogmv0tkyEud1Tq7N9cceqZFGEneZrHTqF3J0UMfg3TrwK9Y7oloregwlIujr62mikq94bERlGUAMs",
+	"ciHRwukcSo2aKu5nnFxSlotK5f4m2mcGGa3kILSDhKkor53tVctIFgX/2bQg5Oz8NxJJqPIu54RyBBXp",
+	"Rdl5iFwSvpRzms6aFlqqY/+AqwXYirGtlK88mDTCMK5AfEHQ2ndrY7T2nfpfqLj/l+/W0CNVR36MzkYX",
+	"ZLn1HZzb1viCLJ/8Rf/x5GzUWFYeZrzZTpsiJDTiuU3StNi8QxB06lBSs01BZCuilborwaCE5eQjFVIP",
+	"avsb/F1ggG2q+HJRvB8L7+LQFGEk8nNdl1/qW/QniN6oEZYhcmMoxf+Z8d/2qvxpCzdtqtBf63FPxfrr",
+	"8zxw3f6GBXSW8N/J1LsrAKdapXPc1PKW5fx7TB03NLxNufoe086IvOc5W+ryN7W9bTn7HvvmTS3vfO6O",
+	"qvp3DIOhwP5QYP/rfslyb/mBt+wKFfhXY8Z7vQh4p/2mecqhRv9ApAbLykAXu+hic3D1agTtNZH3TM2+",
+	"EE+9Xu+OgaoNVoSvSIvR5r+3Ip2BTvdMaQZvvoHaDdRukOG+GPra4lW4Ink97qfpuiWB/SJ8DG+owf4k",
+	"tPWTKc4Huj7Q9YGuf446yw1tnsJJY7UmY+lCjKOYpMsgq6hziJ1+Vq8bcAjJEC4v6UvjEDsW5J+aU9iF",
+	"DHrVQQMxUNJOSlrQynaSunpI8+2VqDcL7BlUqQMhGwjZV6ZKvRXtCStW74P6DOrVgQIOFHB4hv8Z1Ku3",
+	"IrnHqzj1DSrXgd4O9HaQOD+3p7MfkH2pVtL4PD4mklNySQTCLtZLd1k/S8Oxf3rArni/ryak7IRxiRiP",
+	"CYfQcTkvQrzOl0WVynI435oaYw098usTNS4OBi8tKtZDQdCBiEbjEUnzhUIXDH/Bjx9uXEpYn/8nrxI0",
+	"/rpiSO9VaaNOdAilG0LpPh0fUxgY4F2amShGBfl+OwLVX6k2XcHpr/RAQ0D6EJA+BKR/DQHpNaAemJQ5",
+	"akWLBeZLewNNwiILDyA5TYvEsakBLE70IKGDPWcsITi9Z/YNFG1g3wP7/mTsG25Kj+j3CoduCniHVvcU",
+	"5K7HfuDAdm/SzmB2HWaoezQEkVv43DyIu2H4GZF3NHZLULj//cbzKHJ3aoo/vPdKBpdnS0KtqnNq5F0h",
+	"ArwBeNz/etso81Yg8nqbIZp8iCYfTEJVblR6TMLP/mNy4w/47/WGrSLTWXscJGTb2pVVDD4zO8hO0DTE",
+	"rlIt4CvpszZNgyFo6jHLG9YrHB67w2N3eOwO2dc6KHKFpA0vzuHF+Xny+DpD78H0e+SN0b8jXOPNDbli",
+	"Khfm1iLA/UkAVceUnjMPCWkGijR4f3wGRDD4WuEEx1pUd3JKJ+F6TeRAtR6SalWhPZCvgXwNMlyXDNc7",
+	"xV+nxWGvUaPe6b1bHnrI3jdQm4HafLHCEuTP66QWr4m8I1Jxh/GcX4eDw0CrBlr1FfpTtObh66RX0O6O",
+	"KNYQAzoQrIFgDXGfnx2JbEul10khj5u9dm5AI7+IkM0VXOAejCQ+qLfdQIIHEjyQ4Af0s3LZ7ewaxcYf",
+	"OMvMz5H+RUjMYS9hH+IT9RnhFHnDIBxxJoR2wDGvWxTlnJNUJkswS8TaGYYK89pFJ0QKhPVfk4RckgQl",
+	"dEqiZZSoBzJ49aBHNI1JRtKYpNJSe2/eNYFiEiVY8ZFLbV95jOQcS0SFbkdixFIkWWZ7czUYJ3Fp+aqj",
+	"akBwNEcLAi4vZhdYmi4QI6qdc9TguWQLLGmEk2SJaDonnEq9Sfu4h3X8xvw3PkqwVL5aB6rasbEGRW6m",
+	"RDA0xwJRKRTIELsknNOYmIBVKkprfiQIQRtmst5HqwDB0fr6uj7mx2N0NafRXB2chZC8Ysh0QFdY2OrH",
+	"CwaOOpE+UokviEBkOiWRNOvD0uwkFJIMWAMMYadY4u34/L2pbarTekAdI6xQbko9Byg42TVhNu+MXw3L",
+	"M2fy2SighyfSwJ8H/vwQ/BnY8zmOYBmR6asfKkANqoa3Ei13rHF0Hebzjc1XZ/8sa+P+LBuY/8D8V2T+",
+	"LBt4/8D7B94/8P6B939K3t+RhRk8FYucfGWfRauaDVvib5Z4717t8QPpHEjnYAp/WFN4JannCobxuyIg",
+	"g3l8IGIDERuI2A2M1Safw4oS0HFXFojBfj3QrIFmDTTrPqIzvBTCOiNCrxTCMRWSppF0mQt0X5cZtyB5",
+	"BVFaZqQp1/CPeuYeVE+NYpIJOFrHzcLcIjhbNDlDX9A0biV9NsOudpnulV13B01pYhJtVNfC0mQJC3Ir",
+	"NqrdIp3GjF6SVLd3GSLuJf3EHaxSZ17oWuWdp44o0E2v91OnLL6ZYoB8xIss0T30Rvb1L+oH4+A/2h6Z",
+	"H92e4FIl9oZA8gqdMfyScpYuSCq/yziL88hoxTmZUZZ+l4sJwUJOtkbjkaSEf3eOowuSxqMP19c+INqI",
+	"DtzLIT3EkB7ikzEvwPs68zLXQXEtxmc4pb/DslbLf1/quY7QW0UFNV0R5Y+aGCpCkwvCwcyGo4gIRYnC",
+	"yYnfllb1tSbRv08Fqg/hgUQNJOrBSVTBsX+ES1q58ZaC+b/XCVm5l6JnnGRMUMk4JR1Z0o9ty2VXqvRj",
+	"f8whYfqQQ27IITfkkLsdvSyIz8B8B+b7yd4Hjlsu+2QtD3DMptTlRdN7yl/uTfDAScyrM3dmMrcQ0RA7",
+	"WaZRPZV1VG9Tg5sikeq/3qH1yGw9NqldvGU3pFMvndnN8563TTQj8i5mMSaftpl4rcmQGnxIDT64xQXp",
+	"fulNVXpBVZ9Uq6Sc6sUu9tpJT6ftNjDJkIFqoD2DRfWLIT4taah6UZDXRN45+fhCvGDbRdGBfgz042t4",
+	"tLanhupFQ4wX6B1TkcEVdqBkAyUb4qE+Y9rZmjOqF+k87lC03JR4fhEuuKtqIR+WYD681nOg0gOVHqj0",
+	"J1fPbURzEl1MWEQndIFnpDmfxK5qiGgpJcLb3QME3RC1jlr0PCHaFqvcI4XkSxSxdEpnOdcW2zCzAKNv",
+	"0YOTmKSS4kSAfTxiaUoinQOCSGVQFwiD4RjHhW+E2lAcHD3gDQ3bKdq+jegB7P+OWJLxJvVhYHbwmfOp",
+	"Brh8ImG/vppj8BUYRP+vgqmgSfCCxYwIlDKpHUYGPrACH6jR+26+IPFsNa6gOYLEM30+kDwfp8AsvjSe",
+	"cIpnA0cIQWXgBwM/GPjBn4ofKDqvuYFuKZZp1OkYXXghdbtGF20H3+jBN3rwjR58o2+vaixoyuAdPXhH",
+	"f0J2W/DMfv7RAcbZ7CHd5ut75xfp4b2kq3N3+klbV8A2P+m43uZ2vsptk82IvJuZnI2sbTYeaDT4LA8+",
+	"y4NRpIEaV54/xVdRf/Gs5rfci4zvdZGiHkqlwESD9/JAhQbvwy+IDLX6L/eiJK+JvBcy8sV4MbeLigMl",
+	"GSjJ1/G87PJk7kVNjBvvPdCTwZ95oGkDTRt85T5zKtrh09yLiB53KmNuTka/EM/mVXWHD008P4W2cqDZ",
+	"A80eaPaDq/IEiTiRHW4LJ9Coy2HhxAw1uCoMrgqDq8LgqnBLEgjUZHBSGJwUPhkv1byxj3tChUE2OSbo",
+	"ZvfkkmAGf2BnBH/Wnm4IpkuDA4KD0c1dD5ommBF529HN47VpBl76PLgYDC4Gw7ukRktLLxL9e+ktsopD",
+	"QSfh3WsmKp1qpsrgg/vAQGEGo98XQWJaHAc6KcZrIu+MXHwhbgLNQtxAKwZa8Wd/2rUatTrJxXGLyH8T",
+	"kvFFmLBWeWs+HJl62HftQBcHg9XwMHyQh+El4YLq5TRKdsLMY9oG5br3Zpx7pFF2ihZZalAffx2YbbG2",
+	"htr2g0LtK7FxubURQ01Xl0vEW63Y+ANnmf45YqlgCWm8Bm8zooxBP5HzExZdEIlMBySIUBMq8QKnyBsd",
+	"8TxNwU6n7VS6tmzw7uhPO0XfXbOaFUUePU5JoLoLQWfcNa+/a8lsNhFTJjGwAgP12y/CFgYOHAbLSLqO",
+	"zkaCcIqTsxH8IBBGknyUSBK+oClO/gedjS7TyPv8/s0uyjj7uEQyT1OStFis1ZSny6x9H7a0sF7HaKym",
+	"qxcYVlisWk4uMVcTAJLvFlOc2N7eb+/VQAHAHEwRLAJJfEEQU4ZUhZkJJzheTnAk6SWpQcycpFCnClDV",
+	"RZ2pKB0uTYUkOFatp5gmCruvqFRevs82v0WW99pkOSC8x24KKlBMhUEOZWpNYyRZEqOreaNRdcrUtfbh",
+	"GWtz/Wh7ihNBHBzPGUsITgOP+S3NFCr05YrKSNn50RFnkkUsEZ7Q2UdG7MUTuiWwboGpU77pRbQD+zpI",
+	"JeHKU+REW9v3OWdctw4s7TWW5Aov0SldEJbLEjWOXdnsjxN+jiFKFEem48xY5RyJtgS5RIkt/b2uEvT2",
+	"1k1U/i7IeS+i/XlR6j8P7n/ZqN2JzZ0IPKUJEf3RV/MqXbE4YhmFkseCprOEqArwoP1gvPD5MngNhFpy",
+	"nIop4YpAQwYeVePU0OcIg38MJyKHn6ZScxOqAMzzTDY9B/QEr2hCTs3wn7Mwg88FS3JJkBrcLgDgxtIa",
+	"vPCMpFJXz8dRRDIpoJupF405QThJ2BWJlfcWVZ52NCETB2WbbQ6X0q1V+J7Z5C229dOcyDnhxU6o0JgR",
+	"V7EAPYrZVZowHD9G2jfN/5Zn8KVpoTHlpKhB3yUE2YlG45Eety4JfVUMfOtpsIFUCKao3Y+Yz8ifgB5q",
+	"atZIDc3nJlqYMS6njF9hHt+MIprOHk083T3ykzaqxxtS05Tv+5pACWPZOVZpJRUIp7hVGDhiXL4yC/2M",
+	"qZ3afH2zlZdbI6ljXDaTOvV1YsDdk9IxLlu31OxF+fybb55+47lRbvVwoxxeA58pifAveSOhqDbSfsL6",
+	"fuU8GW2PNnBGNy63Rtcf3IICpEKjpIBHrjoqkkoaOTS1WorSh9H1uGUglqKdXM6POLukMeFld35vvMw0",
+	"6BztZZ5cuF+Cw53nyYWjQ53j7RIu6VTthZzQmdJLGYwIjh0VrYVuzR3Kt89ToWP+oAYvrscdB6LbIY0y",
+	"9QHM750r2U85S5IFSWXbTolr1WuHOmuu5JRcKnJBLkkqS8OpHzqX9iohJLycqfqy0hJ0GAPCEWdCKVim",
+	"U8JJGh4d2q40+ls+wyn9vRkLmdegc9+BfKn+WF6e0O6RmpJ9urG8WJ2u0UIxOGYcY0LpAbOIUABZwFhi",
+	"xjK/jK4/XP//BwCtim6RN1oEAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -95,6 +95,26 @@ const (
 	AuthStaticRoleAssignmentTypeStatic AuthStaticRoleAssignmentType = "static"
 )
 
+// Defines values for BulkOperationPhase.
+const (
+	BulkOperationPhaseFailed    BulkOperationPhase = "Failed"
+	BulkOperationPhasePending   BulkOperationPhase = "Pending"
+	BulkOperationPhaseRunning   BulkOperationPhase = "Running"
+	BulkOperationPhaseSucceeded BulkOperationPhase = "Succeeded"
+)
+
+// Defines values for BulkOperationType.
+const (
+	BulkOperationTypeDecommission       BulkOperationType = "Decommission"
+	BulkOperationTypePatchAnnotations   BulkOperationType = "PatchAnnotations"
+	BulkOperationTypePatchLabels        BulkOperationType = "PatchLabels"
+	BulkOperationTypeRestartApplication BulkOperationType = "RestartApplication"
+	BulkOperationTypeResume             BulkOperationType = "Resume"
+	BulkOperationTypeSetOwner           BulkOperationType = "SetOwner"
+	BulkOperationTypeStartApplication   BulkOperationType = "StartApplication"
+	BulkOperationTypeStopApplication    BulkOperationType = "StopApplication"
+)
+
 // Defines values for ConditionStatus.
 const (
 	ConditionStatusFalse   ConditionStatus = "False"
@@ -104,6 +124,8 @@ const (
 
 // Defines values for ConditionType.
 const (
+	ConditionTypeCatalogAccessible                    ConditionType = "Accessible"
+	ConditionTypeCatalogSynced                        ConditionType = "Synced"
 	ConditionTypeCertificateSigningRequestApproved    ConditionType = "Approved"
 	ConditionTypeCertificateSigningRequestDenied      ConditionType = "Denied"
 	ConditionTypeCertificateSigningRequestFailed      ConditionType = "Failed"
@@ -192,6 +214,11 @@ const (
 	DeviceOwnershipChanged DeviceOwnershipChangedDetailsDetailType = "DeviceOwnershipChanged"
 )
 
+// Defines values for DevicePortForwardDetailsDetailType.
+const (
+	DevicePortForwardDetailType DevicePortForwardDetailsDetailType = "DevicePortForward"
+)
+
 // Defines values for DeviceResourceStatusType.
 const (
 	DeviceResourceStatusCritical DeviceResourceStatusType = "Critical"
@@ -201,6 +228,15 @@ const (
 	DeviceResourceStatusWarning  DeviceResourceStatusType = "Warning"
 )
 
+// Defines values for DeviceStatusHistoryField.
+const (
+	DeviceStatusHistoryFieldApplications DeviceStatusHistoryField = "applications"
+	DeviceStatusHistoryFieldOs           DeviceStatusHistoryField = "os"
+	DeviceStatusHistoryFieldResources    DeviceStatusHistoryField = "resources"
+	DeviceStatusHistoryFieldSummary      DeviceStatusHistoryField = "summary"
+	DeviceStatusHistoryFieldUpdated      DeviceStatusHistoryField = "updated"
+)
+
 // Defines values for DeviceSummaryStatusType.
 const (
 	DeviceSummaryStatusAwaitingReconnect DeviceSummaryStatusType = "AwaitingReconnect"
@@ -215,10 +251,12 @@ const (
 
 // Defines values for DeviceUpdatedStatusType.
 const (
+	DeviceUpdatedStatusDiverged  DeviceUpdatedStatusType = "Diverged"
 	DeviceUpdatedStatusOutOfDate DeviceUpdatedStatusType = "OutOfDate"
 	DeviceUpdatedStatusUnknown   DeviceUpdatedStatusType = "Unknown"
 	DeviceUpdatedStatusUpToDate  DeviceUpdatedStatusType = "UpToDate"
 	DeviceUpdatedStatusUpdating  DeviceUpdatedStatusType = "Updating"
+	DeviceUpdatedStatusWaiting   DeviceUpdatedStatusType = "Waiting"
 )
 
 // Defines values for DeviceVulnerabilityCveDetailsDetailType.
@@ -262,6 +300,10 @@ const (
 	EventReasonDeviceMultipleOwnersDetected    EventReason = "DeviceMultipleOwnersDetected"
 	EventReasonDeviceMultipleOwnersResolved    EventReason = "DeviceMultipleOwnersResolved"
 	EventReasonDeviceOSImageChanged            EventReason = "DeviceOSImageChanged"
+	EventReasonDevicePortForwardStarted        EventReason = "DevicePortForwardStarted"
+	EventReasonDevicePortForwardStopped        EventReason = "DevicePortForwardStopped"
+	EventReasonDeviceRollbackCleared           EventReason = "DeviceRollbackCleared"
+	EventReasonDeviceRolledBack                EventReason = "DeviceRolledBack"
 	EventReasonDeviceSpecInvalid               EventReason = "DeviceSpecInvalid"
 	EventReasonDeviceSpecValid                 EventReason = "DeviceSpecValid"
 	EventReasonDeviceUpdateFailed              EventReason = "DeviceUpdateFailed"
@@ -272,6 +314,8 @@ const (
 	EventReasonEncryptionMigrationStarted      EventReason = "EncryptionMigrationStarted"
 	EventReasonEnrollmentRequestApprovalFailed EventReason = "EnrollmentRequestApprovalFailed"
 	EventReasonEnrollmentRequestApproved       EventReason = "EnrollmentRequestApproved"
+	EventReasonFleetAutoUpgradePending         EventReason = "FleetAutoUpgradePending"
+	EventReasonFleetAutoUpgraded               EventReason = "FleetAutoUpgraded"
 	EventReasonFleetInvalid                    EventReason = "FleetInvalid"
 	EventReasonFleetRolloutBatchCompleted      EventReason = "FleetRolloutBatchCompleted"
 	EventReasonFleetRolloutBatchDispatched     EventReason = "FleetRolloutBatchDispatched"
@@ -315,6 +359,11 @@ const (
 	FileOperationUpdated FileOperation = "updated"
 )
 
+// Defines values for FleetAutoUpgradeDetailsDetailType.
+const (
+	FleetAutoUpgradeDetailType FleetAutoUpgradeDetailsDetailType = "FleetAutoUpgrade"
+)
+
 // Defines values for FleetRolloutBatchCompletedDetailsDetailType.
 const (
 	FleetRolloutBatchCompleted FleetRolloutBatchCompletedDetailsDetailType = "FleetRolloutBatchCompleted"
@@ -459,9 +508,10 @@ const (
 
 // Defines values for RepoSpecType.
 const (
-	RepoSpecTypeGit  RepoSpecType = "git"
-	RepoSpecTypeHttp RepoSpecType = "http"
-	RepoSpecTypeOci  RepoSpecType = "oci"
+	RepoSpecTypeGit   RepoSpecType = "git"
+	RepoSpecTypeHttp  RepoSpecType = "http"
+	RepoSpecTypeOci   RepoSpecType = "oci"
+	RepoSpecTypeVault RepoSpecType = "vault"
 )
 
 // Defines values for ResourceAlertSeverityType.
@@ -573,6 +623,17 @@ const (
 	Bearer TokenResponseTokenType = "Bearer"
 )
 
+// Defines values for VaultAuthType.
+const (
+	VaultAuthTypeAppRole VaultAuthType = "appRole"
+	VaultAuthTypeToken   VaultAuthType = "token"
+)
+
+// Defines values for VaultRepoSpecType.
+const (
+	VaultRepoSpecTypeVault VaultRepoSpecType = "vault"
+)
+
 // Defines values for ListEventsParamsOrder.
 const (
 	Asc  ListEventsParamsOrder = "asc"
@@ -590,6 +651,12 @@ const (
 	ConsoleTypeVnc    GetDeviceApplicationConsoleParamsConsoleType = "vnc"
 )
 
+// Defines values for GetDeviceFileTransferParamsDirection.
+const (
+	Download GetDeviceFileTransferParamsDirection = "download"
+	Upload   GetDeviceFileTransferParamsDirection = "upload"
+)
+
 // AapProviderSpec AapProviderSpec describes an Ansible Automation Platform (AAP) provider configuration.
 type AapProviderSpec struct {
 	// ApiUrl The internal AAP API URL.
@@ -653,8 +720,20 @@ type ApplicationContent struct {
 // ApplicationDesiredState Desired lifecycle state for an application.
 type ApplicationDesiredState string
 
+// ApplicationEnvFromSource A source of environment variables for an application.
+type ApplicationEnvFromSource struct {
+	// Prefix A prefix prepended to the name of each environment variable from this source.
+	Prefix *string `json:"prefix,omitempty"`
+
+	// SecretRef A reference to a Secret resource in the same organization.
+	SecretRef SecretReference `json:"secretRef"`
+}
+
 // ApplicationEnvVars defines model for ApplicationEnvVars.
 type ApplicationEnvVars struct {
+	// EnvFrom Sources of environment variables, injected during runtime. Each key of a referenced Secret becomes an environment variable. Variables set in envVars take precedence.
+	EnvFrom *[]ApplicationEnvFromSource `json:"envFrom,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 }
@@ -929,6 +1008,120 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// BulkOperation BulkOperation applies a single operation to every device matching a label and/or field selector. It runs asynchronously and reports its per-device progress and failures in its status.
+type BulkOperation struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec BulkOperationSpec selects the devices to operate on and the operation to apply. At least one selector must be provided, along with the parameters of the chosen operation.
+	Spec BulkOperationSpec `json:"spec"`
+
+	// Status BulkOperationStatus reports the progress of a BulkOperation.
+	Status *BulkOperationStatus `json:"status,omitempty"`
+}
+
+// BulkOperationDeviceFailure A device the operation could not be applied to.
+type BulkOperationDeviceFailure struct {
+	// DeviceName The name of the device the operation failed on.
+	DeviceName string `json:"deviceName"`
+
+	// Message Why the operation failed on the device.
+	Message string `json:"message"`
+}
+
+// BulkOperationList BulkOperationList is a list of BulkOperations.
+type BulkOperationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of bulk operations.
+	Items []BulkOperation `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// BulkOperationMetadataPatch Changes to a device's labels or annotations.
+type BulkOperationMetadataPatch struct {
+	// Remove Keys to remove. Keys that are not present are ignored.
+	Remove *[]string `json:"remove,omitempty"`
+
+	// Set Keys to add or overwrite, with their values.
+	Set *map[string]string `json:"set,omitempty"`
+}
+
+// BulkOperationPhase The phase of a BulkOperation. An operation fails if it could not run or if it failed on any device.
+type BulkOperationPhase string
+
+// BulkOperationSpec BulkOperationSpec selects the devices to operate on and the operation to apply. At least one selector must be provided, along with the parameters of the chosen operation.
+type BulkOperationSpec struct {
+	// Annotations Changes to a device's labels or annotations.
+	Annotations *BulkOperationMetadataPatch `json:"annotations,omitempty"`
+
+	// ApplicationName The name of the application to start, stop or restart for the StartApplication, StopApplication and RestartApplication operations.
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// Decommission Metadata about a device decommissioning request.
+	Decommission *DeviceDecommission `json:"decommission,omitempty"`
+
+	// FieldSelector A selector to restrict the devices to operate on by their fields. Uses the same format as Kubernetes field selectors (e.g., "metadata.name=device1,metadata.owner!=Fleet/default").
+	FieldSelector *string `json:"fieldSelector,omitempty"`
+
+	// LabelSelector A selector to restrict the devices to operate on by their labels. Uses the same format as Kubernetes label selectors (e.g., "key1=value1,key2!=value2").
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Labels Changes to a device's labels or annotations.
+	Labels *BulkOperationMetadataPatch `json:"labels,omitempty"`
+
+	// Operation The operation to apply to each matching device.
+	Operation BulkOperationType `json:"operation"`
+
+	// Owner The owner to set on each device for the SetOwner operation, in the form "Fleet/NAME". An empty string clears the owner.
+	Owner *string `json:"owner,omitempty"`
+}
+
+// BulkOperationStatus BulkOperationStatus reports the progress of a BulkOperation.
+type BulkOperationStatus struct {
+	// CompletionTime The time the operation finished.
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+
+	// Continue The position of the next page of devices while the operation is running. An interrupted operation resumes from it rather than from the first device.
+	Continue *string `json:"continue,omitempty"`
+
+	// FailedDevices The number of devices the operation could not be applied to.
+	FailedDevices int64 `json:"failedDevices"`
+
+	// Failures The devices the operation could not be applied to. Only the first 100 failures are retained.
+	Failures *[]BulkOperationDeviceFailure `json:"failures,omitempty"`
+
+	// MatchedDevices The number of devices that matched the selectors when the operation started.
+	MatchedDevices int64 `json:"matchedDevices"`
+
+	// Message A human readable message describing the outcome of the operation.
+	Message *string `json:"message,omitempty"`
+
+	// Phase The phase of a BulkOperation. An operation fails if it could not run or if it failed on any device.
+	Phase BulkOperationPhase `json:"phase"`
+
+	// StartTime The time the operation started running.
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// SucceededDevices The number of devices the operation was applied to.
+	SucceededDevices int64 `json:"succeededDevices"`
+}
+
+// BulkOperationType The operation to apply to each matching device.
+type BulkOperationType string
+
 // CatalogItemRefApplicationProviderSpec defines model for CatalogItemRefApplicationProviderSpec.
 type CatalogItemRefApplicationProviderSpec struct {
 	// CatalogItemRef A reference to a catalog item, along with its configuration.
@@ -940,7 +1133,7 @@ type CatalogItemRefSpec struct {
 	// Catalog The catalog name that the item is part of.
 	Catalog string `json:"catalog"`
 
-	// Channel An optional update channel which will be used to provide update cues when available.
+	// Channel An optional update channel which will be used to provide update cues when available. Fleets with an auto-upgrade policy follow the channel to newer versions automatically.
 	Channel *string `json:"channel,omitempty"`
 
 	// Item The name of the catalog item itself.
@@ -1054,6 +1247,9 @@ type ComposeApplication struct {
 	// DesiredState Desired lifecycle state for this application, as most recently set by the stop/start device APIs. Read-only: cannot be set directly by apply; only present in the rendered application spec delivered to the agent.
 	DesiredState *ApplicationDesiredState `json:"desiredState,omitempty"`
 
+	// EnvFrom Sources of environment variables, injected during runtime. Each key of a referenced Secret becomes an environment variable. Variables set in envVars take precedence.
+	EnvFrom *[]ApplicationEnvFromSource `json:"envFrom,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
@@ -1129,6 +1325,9 @@ type ContainerApplication struct {
 	// DesiredState Desired lifecycle state for this application, as most recently set by the stop/start device APIs. Read-only: cannot be set directly by apply; only present in the rendered application spec delivered to the agent.
 	DesiredState *ApplicationDesiredState `json:"desiredState,omitempty"`
 
+	// EnvFrom Sources of environment variables, injected during runtime. Each key of a referenced Secret becomes an environment variable. Variables set in envVars take precedence.
+	EnvFrom *[]ApplicationEnvFromSource `json:"envFrom,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
@@ -1446,6 +1645,82 @@ type DeviceOwnershipChangedDetails struct {
 // DeviceOwnershipChangedDetailsDetailType The type of detail for discriminator purposes.
 type DeviceOwnershipChangedDetailsDetailType string
 
+// DevicePortForwardDetails defines model for DevicePortForwardDetails.
+type DevicePortForwardDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType DevicePortForwardDetailsDetailType `json:"detailType"`
+
+	// Port The TCP port on the Device that the session forwards to.
+	Port int32 `json:"port"`
+
+	// SessionId The ID of the port-forward session.
+	SessionId string `json:"sessionId"`
+}
+
+// DevicePortForwardDetailsDetailType The type of detail for discriminator purposes.
+type DevicePortForwardDetailsDetailType string
+
+// DeviceRegistryMirrorStatus DeviceRegistryMirrorStatus reports whether the device can reach a registry mirror.
+type DeviceRegistryMirrorStatus struct {
+	// LastChecked The time the mirror was last checked.
+	LastChecked time.Time `json:"lastChecked"`
+
+	// Location The location of the mirror.
+	Location string `json:"location"`
+
+	// Message The reason the mirror is not reachable.
+	Message *string `json:"message,omitempty"`
+
+	// Reachable Whether the mirror responded to the device's last check.
+	Reachable bool `json:"reachable"`
+
+	// Registry The registry that is mirrored.
+	Registry string `json:"registry"`
+}
+
+// DeviceRenderedVersion DeviceRenderedVersion is a rendered version of a device's specification retained by the service.
+type DeviceRenderedVersion struct {
+	// CreatedAt The time the version was rendered.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// RenderedVersion The rendered version of the device specification.
+	RenderedVersion string `json:"renderedVersion"`
+
+	// RollbackOf The rendered version that was restored, if this version was delivered by a rollback.
+	RollbackOf *string `json:"rollbackOf,omitempty"`
+
+	// Spec DeviceSpec describes a device.
+	Spec *DeviceSpec `json:"spec,omitempty"`
+
+	// TemplateVersion The fleet template version the specification was rendered from, if the device is owned by a fleet.
+	TemplateVersion *string `json:"templateVersion,omitempty"`
+}
+
+// DeviceRenderedVersionDiff DeviceRenderedVersionDiff describes the differences between two rendered versions of a device.
+type DeviceRenderedVersionDiff struct {
+	// Base The rendered version compared against.
+	Base  string       `json:"base"`
+	Patch PatchRequest `json:"patch"`
+
+	// Version The rendered version compared.
+	Version string `json:"version"`
+}
+
+// DeviceRenderedVersionList DeviceRenderedVersionList is a list of retained rendered versions of a device, newest first.
+type DeviceRenderedVersionList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of rendered versions.
+	Items []DeviceRenderedVersion `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
 // DeviceResourceStatus Current status of the resources of the device.
 type DeviceResourceStatus struct {
 	// Cpu The types of resource statuses.
@@ -1483,6 +1758,12 @@ type DeviceResumeResponse struct {
 	ResumedDevices int `json:"resumedDevices"`
 }
 
+// DeviceRollback DeviceRollback requests re-delivering a retained rendered version to a device.
+type DeviceRollback struct {
+	// RenderedVersion The retained rendered version to re-deliver to the device.
+	RenderedVersion string `json:"renderedVersion"`
+}
+
 // DeviceSpec DeviceSpec describes a device.
 type DeviceSpec struct {
 	// Applications List of application providers.
@@ -1500,6 +1781,9 @@ type DeviceSpec struct {
 	// Os Either a specific OCI image reference, or a reference to a catalog item version that can be resolved to an OCI image ref.
 	Os *DeviceOsSpec `json:"os,omitempty"`
 
+	// RegistryMirrors Mirrors of the registries that the device pulls images and artifacts from.
+	RegistryMirrors *[]RegistryMirror `json:"registryMirrors,omitempty"`
+
 	// Resources Array of resource monitor configurations.
 	Resources *[]ResourceMonitor `json:"resources,omitempty"`
 
@@ -1545,6 +1829,9 @@ type DeviceStatus struct {
 	// Os Current status of the device OS.
 	Os DeviceOsStatus `json:"os"`
 
+	// RegistryMirrors The reachability of the registry mirrors in the device's spec.
+	RegistryMirrors *[]DeviceRegistryMirrorStatus `json:"registryMirrors,omitempty"`
+
 	// Resources Current status of the resources of the device.
 	Resources DeviceResourceStatus `json:"resources"`
 
@@ -1561,6 +1848,51 @@ type DeviceStatus struct {
 	Updated DeviceUpdatedStatus `json:"updated"`
 }
 
+// DeviceStatusHistoryEntry DeviceStatusHistoryEntry records the state of a device after a significant status transition.
+type DeviceStatusHistoryEntry struct {
+	// Applications The statuses of the device's applications.
+	Applications []DeviceApplicationStatus `json:"applications"`
+
+	// ApplicationsSummary A summary of the health of applications on the device.
+	ApplicationsSummary DeviceApplicationsSummaryStatus `json:"applicationsSummary"`
+
+	// ChangedFields The parts of the status that changed in this transition.
+	ChangedFields []DeviceStatusHistoryField `json:"changedFields"`
+
+	// Os Current status of the device OS.
+	Os DeviceOsStatus `json:"os"`
+
+	// Resources Current status of the resources of the device.
+	Resources DeviceResourceStatus `json:"resources"`
+
+	// Summary A summary of the health of the device hardware and operating system resources.
+	Summary DeviceSummaryStatus `json:"summary"`
+
+	// Timestamp The time the transition was recorded.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Updated Current status of the device update.
+	Updated DeviceUpdatedStatus `json:"updated"`
+}
+
+// DeviceStatusHistoryField A part of the device status whose transitions are recorded in the status history.
+type DeviceStatusHistoryField string
+
+// DeviceStatusHistoryList DeviceStatusHistoryList is a list of status transitions of a device, newest first.
+type DeviceStatusHistoryList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of status transitions.
+	Items []DeviceStatusHistoryEntry `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
 // DeviceSummaryStatus A summary of the health of the device hardware and operating system resources.
 type DeviceSummaryStatus struct {
 	// Info Human readable information detailing the last device status transition.
@@ -1594,9 +1926,15 @@ type DeviceSystemInfo struct {
 
 // DeviceUpdatePolicySpec Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 type DeviceUpdatePolicySpec struct {
+	// DownloadConditions Conditions on the device's local state that must all be met before the agent starts downloading or applying an update. They are evaluated by the agent each time it is ready to proceed, after the schedule, if any, is ready. While a condition is not met, the agent waits and reports the unmet conditions in the device status.
+	DownloadConditions *UpdateConditions `json:"downloadConditions,omitempty"`
+
 	// DownloadSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 	DownloadSchedule *UpdateSchedule `json:"downloadSchedule,omitempty"`
 
+	// UpdateConditions Conditions on the device's local state that must all be met before the agent starts downloading or applying an update. They are evaluated by the agent each time it is ready to proceed, after the schedule, if any, is ready. While a condition is not met, the agent waits and reports the unmet conditions in the device status.
+	UpdateConditions *UpdateConditions `json:"updateConditions,omitempty"`
+
 	// UpdateSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 	UpdateSchedule *UpdateSchedule `json:"updateSchedule,omitempty"`
 }
@@ -1957,6 +2295,39 @@ type Fleet struct {
 	Status *FleetStatus `json:"status,omitempty"`
 }
 
+// FleetAutoUpgradeDetails defines model for FleetAutoUpgradeDetails.
+type FleetAutoUpgradeDetails struct {
+	// Catalog The name of the catalog that the item is part of.
+	Catalog string `json:"catalog"`
+
+	// Channel The channel that the upgrade follows.
+	Channel string `json:"channel"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetAutoUpgradeDetailsDetailType `json:"detailType"`
+
+	// FromVersion The version that the fleet template referenced before the upgrade.
+	FromVersion string `json:"fromVersion"`
+
+	// Item The name of the catalog item that is upgraded.
+	Item string `json:"item"`
+
+	// ToVersion The version that the fleet template is upgraded to.
+	ToVersion string `json:"toVersion"`
+}
+
+// FleetAutoUpgradeDetailsDetailType The type of detail for discriminator purposes.
+type FleetAutoUpgradeDetailsDetailType string
+
+// FleetAutoUpgradePolicy FleetAutoUpgradePolicy opts the fleet into automatic upgrades of the catalog items its template references with a channel. When a newer version on the channel upgrades from the referenced version, the service updates the template, which creates a new TemplateVersion.
+type FleetAutoUpgradePolicy struct {
+	// RequireApproval Whether a pending upgrade must be approved before it is applied. The pending upgrade is recorded in the fleet-controller/autoUpgradePending annotation and is approved by copying it to the fleet-controller/autoUpgradeApproved annotation.
+	RequireApproval *bool `json:"requireApproval,omitempty"`
+
+	// Schedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
+	Schedule *UpdateSchedule `json:"schedule,omitempty"`
+}
+
 // FleetList FleetList is a list of Fleets.
 type FleetList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -2082,6 +2453,9 @@ type FleetRolloutStatus struct {
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
 type FleetSpec struct {
+	// AutoUpgrade FleetAutoUpgradePolicy opts the fleet into automatic upgrades of the catalog items its template references with a channel. When a newer version on the channel upgrades from the referenced version, the service updates the template, which creates a new TemplateVersion.
+	AutoUpgrade *FleetAutoUpgradePolicy `json:"autoUpgrade,omitempty"`
+
 	// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 	RolloutPolicy *RolloutPolicy `json:"rolloutPolicy,omitempty"`
 
@@ -2649,6 +3023,36 @@ type OciAuth struct {
 // OciAuthType The type of authentication for OCI registries.
 type OciAuthType string
 
+// OciConfigProviderSpec defines model for OciConfigProviderSpec.
+type OciConfigProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// OciRef The reference to an OCI artifact, such as one pushed with `oras push`, whose layers are unpacked onto the device.
+	OciRef struct {
+		// Artifact The path of the artifact within the registry of the repository.
+		Artifact string `json:"artifact"`
+
+		// Group The files' group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// Mode The files' permission mode. You may specify the more familiar octal with a leading zero (e.g., 0600) or as a decimal without a leading zero (e.g., 384). If not specified, the permission mode defaults to 0644.
+		Mode *int `json:"mode,omitempty"`
+
+		// MountPath Path in the device's file system into which the artifact is unpacked. Files of archive layers keep their relative paths; file layers are written under their title annotation.
+		MountPath string `json:"mountPath"`
+
+		// Reference The tag or digest of the artifact. Devices follow a tag as it moves to new digests.
+		Reference string `json:"reference"`
+
+		// Repository The name of the Repository resource of type oci.
+		Repository string `json:"repository"`
+
+		// User The files' owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"ociRef"`
+}
+
 // OciRepoSpec OCI container registry specification.
 type OciRepoSpec struct {
 	// AccessMode Access mode for the registry: "Read" for read-only (pull), "ReadWrite" for read-write (pull and push).
@@ -2816,6 +3220,9 @@ type QuadletApplication struct {
 	// DesiredState Desired lifecycle state for this application, as most recently set by the stop/start device APIs. Read-only: cannot be set directly by apply; only present in the rendered application spec delivered to the agent.
 	DesiredState *ApplicationDesiredState `json:"desiredState,omitempty"`
 
+	// EnvFrom Sources of environment variables, injected during runtime. Each key of a referenced Secret becomes an environment variable. Variables set in envVars take precedence.
+	EnvFrom *[]ApplicationEnvFromSource `json:"envFrom,omitempty"`
+
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
@@ -2845,6 +3252,24 @@ type ReferencedRepositoryUpdatedDetails struct {
 // ReferencedRepositoryUpdatedDetailsDetailType The type of detail for discriminator purposes.
 type ReferencedRepositoryUpdatedDetailsDetailType string
 
+// RegistryMirror RegistryMirror configures mirrors that the device pulls the images and artifacts of a registry from. Each mirror is tried in order before falling back to the registry itself.
+type RegistryMirror struct {
+	// Mirrors The mirrors of the registry, in the order they are tried.
+	Mirrors []RegistryMirrorLocation `json:"mirrors"`
+
+	// Registry The registry, optionally followed by a namespace, whose images are pulled from the mirrors.
+	Registry string `json:"registry"`
+}
+
+// RegistryMirrorLocation RegistryMirrorLocation is a mirror of a registry.
+type RegistryMirrorLocation struct {
+	// Insecure Pull from the mirror over plain HTTP or without verifying its TLS certificate.
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// Location The mirror's host with optional port and namespace. In fleet templates, it may contain parameters, e.g. to select a site's mirror by a device label. A location that resolves to an empty string is omitted from the device's spec.
+	Location string `json:"location"`
+}
+
 // RelativePath Represents a relative file path.
 type RelativePath struct {
 	// Path A relative file path on the system. Note that any existing file will be overwritten.
@@ -2986,14 +3411,20 @@ type ResourceSyncList struct {
 
 // ResourceSyncSpec ResourceSyncSpec describes the file(s) to sync from a repository.
 type ResourceSyncSpec struct {
-	// Path The path of a file or directory in the repository. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources.
+	// Archive The path of a tar or gzip-compressed tar archive relative to the URL of an HTTP repository. Required for HTTP repositories and not allowed for other repository types.
+	Archive *string `json:"archive,omitempty"`
+
+	// Artifact The path of the artifact within the registry of an OCI repository. Required for OCI repositories and not allowed for other repository types.
+	Artifact *string `json:"artifact,omitempty"`
+
+	// Path The path of a file or directory in the repository, or in the contents of the OCI artifact or HTTP archive. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources.
 	Path string `json:"path"`
 
 	// Repository The name of the repository resource to use as the sync source.
 	Repository string `json:"repository"`
 
-	// TargetRevision The desired revision in the repository.
-	TargetRevision string `json:"targetRevision"`
+	// TargetRevision The desired revision in the repository. For a git repository, a branch, tag, or commit hash. For an OCI repository, a tag or digest of the artifact. Required for git and OCI repositories and not allowed for HTTP repositories.
+	TargetRevision string `json:"targetRevision,omitempty"`
 
 	// Type The type of resources this ResourceSync manages. Defaults to fleet if not specified.
 	Type *ResourceSyncType `json:"type,omitempty"`
@@ -3004,7 +3435,7 @@ type ResourceSyncStatus struct {
 	// Conditions Current state of a resourcesync.
 	Conditions []Condition `json:"conditions"`
 
-	// ObservedCommit The last commit hash that was synced.
+	// ObservedCommit The last revision that was synced. For a git repository, the commit hash. For an OCI repository, the manifest digest of the artifact. For an HTTP repository, the SHA-256 digest of the archive.
 	ObservedCommit *string `json:"observedCommit,omitempty"`
 
 	// ObservedGeneration The last generation that was synced.
@@ -3070,6 +3501,69 @@ type RolloutPolicy struct {
 // RolloutStrategy The strategy of choice for device selection in rollout policy.
 type RolloutStrategy string
 
+// Secret Secret holds sensitive data for devices, such as credentials or certificates. Its values are encrypted at rest and are never returned by the API.
+type Secret struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec SecretSpec describes the content of a Secret.
+	Spec SecretSpec `json:"spec"`
+}
+
+// SecretConfigProviderSpec defines model for SecretConfigProviderSpec.
+type SecretConfigProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// SecretResourceRef The reference to a Secret resource of the organization.
+	SecretResourceRef struct {
+		// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// MountPath Path in the device's file system at which the Secret should be mounted. Each key of the Secret is written to a file of the same name in this directory.
+		MountPath string `json:"mountPath"`
+
+		// Name The name of the Secret.
+		Name string `json:"name"`
+
+		// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"secretResourceRef"`
+}
+
+// SecretList SecretList is a list of Secrets.
+type SecretList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of secrets.
+	Items []Secret `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// SecretReference A reference to a Secret resource in the same organization.
+type SecretReference struct {
+	// Name The name of the Secret.
+	Name string `json:"name"`
+}
+
+// SecretSpec SecretSpec describes the content of a Secret.
+type SecretSpec struct {
+	// Data Map of keys to base64-encoded values. Keys must consist of alphanumeric characters, '-', '_' or '.'. Values are write-only; reads return them masked.
+	Data map[string]string `json:"data"`
+}
+
 // SshConfig Configuration for SSH transport.
 type SshConfig struct {
 	// PrivateKeyPassphrase The passphrase for sshPrivateKey.
@@ -3192,6 +3686,9 @@ type TemplateVersionStatus struct {
 	// Os Either a specific OCI image reference, or a reference to a catalog item version that can be resolved to an OCI image ref.
 	Os *DeviceOsSpec `json:"os,omitempty"`
 
+	// RegistryMirrors Mirrors of the registries that the device pulls images and artifacts from.
+	RegistryMirrors *[]RegistryMirror `json:"registryMirrors,omitempty"`
+
 	// Resources Array of resource monitor configurations.
 	Resources *[]ResourceMonitor `json:"resources,omitempty"`
 
@@ -3265,6 +3762,39 @@ type TokenResponse struct {
 // TokenResponseTokenType Token type.
 type TokenResponseTokenType string
 
+// UpdateConditionCommand A command that gates an update by its exit code.
+type UpdateConditionCommand struct {
+	// Run The command to be executed by `/bin/sh -c`, for example a script that checks whether a production line is idle.
+	Run string `json:"run"`
+
+	// Timeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// UpdateConditions Conditions on the device's local state that must all be met before the agent starts downloading or applying an update. They are evaluated by the agent each time it is ready to proceed, after the schedule, if any, is ready. While a condition is not met, the agent waits and reports the unmet conditions in the device status.
+type UpdateConditions struct {
+	// Commands Commands that must each exit with code 0. They are run in order as root and must not modify the device.
+	Commands *[]UpdateConditionCommand `json:"commands,omitempty"`
+
+	// MaxCpuLoad Percentage is the string format representing percentage string.
+	MaxCpuLoad *Percentage `json:"maxCpuLoad,omitempty"`
+
+	// MinBatteryLevel Percentage is the string format representing percentage string.
+	MinBatteryLevel *Percentage `json:"minBatteryLevel,omitempty"`
+
+	// MinFreeStorage Percentage is the string format representing percentage string.
+	MinFreeStorage *Percentage `json:"minFreeStorage,omitempty"`
+
+	// RequireExternalPower Requires the device to be connected to external power, if it has a battery.
+	RequireExternalPower *bool `json:"requireExternalPower,omitempty"`
+
+	// RequireUnmeteredNetwork Requires the device's default route to use a network link that is neither cellular nor marked as metered.
+	RequireUnmeteredNetwork *bool `json:"requireUnmeteredNetwork,omitempty"`
+
+	// StoragePath The path whose filesystem is checked for minFreeStorage. Defaults to "/var".
+	StoragePath *string `json:"storagePath,omitempty"`
+}
+
 // UpdateSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 type UpdateSchedule struct {
 	// At Cron expression format for scheduling times.
@@ -3285,17 +3815,103 @@ type UserInfoResponse struct {
 	// Error Error code.
 	Error *string `json:"error,omitempty"`
 
-	// Name Full name.
-	Name *string `json:"name,omitempty"`
+	// Name Full name.
+	Name *string `json:"name,omitempty"`
+
+	// Organizations User organizations.
+	Organizations *[]Organization `json:"organizations,omitempty"`
+
+	// PreferredUsername Preferred username.
+	PreferredUsername *string `json:"preferred_username,omitempty"`
+
+	// Sub Subject identifier.
+	Sub *string `json:"sub,omitempty"`
+}
+
+// VaultAppRoleAuth Authenticates with the Vault AppRole auth method.
+type VaultAppRoleAuth struct {
+	// AuthType The method used to authenticate with Vault.
+	AuthType VaultAuthType `json:"authType"`
+
+	// MountPath The path the AppRole auth method is mounted at. Defaults to "approle".
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// RoleId The AppRole role ID.
+	RoleId string `json:"roleId"`
+
+	// SecretId The AppRole secret ID.
+	SecretId string `json:"secretId"`
+}
+
+// VaultAuth Authentication for Vault.
+type VaultAuth struct {
+	union json.RawMessage
+}
+
+// VaultAuthType The method used to authenticate with Vault.
+type VaultAuthType string
+
+// VaultConfigProviderSpec defines model for VaultConfigProviderSpec.
+type VaultConfigProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// VaultRef The reference to a secret in a HashiCorp Vault KV version 2 secrets engine.
+	VaultRef struct {
+		// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
+		Group string `json:"group,omitempty"`
+
+		// Mode The files' permission mode. You may specify the more familiar octal with a leading zero (e.g., 0600) or as a decimal without a leading zero (e.g., 384). If not specified, the permission mode defaults to 0644.
+		Mode *int `json:"mode,omitempty"`
+
+		// MountPath Path in the device's file system at which the secret should be mounted. Each key of the secret is written to a file of the same name in this directory.
+		MountPath string `json:"mountPath"`
+
+		// Path The path of the secret within the KV secrets engine.
+		Path string `json:"path"`
+
+		// Repository The name of the Repository resource of type vault.
+		Repository string `json:"repository"`
+
+		// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
+		User Username `json:"user,omitempty"`
+	} `json:"vaultRef"`
+}
+
+// VaultRepoSpec HashiCorp Vault server specification for reading secrets from a KV version 2 secrets engine.
+type VaultRepoSpec struct {
+	// CaCrt Base64 encoded root CA.
+	CaCrt *string `json:"ca.crt,omitempty"`
+
+	// KvMount The path the KV version 2 secrets engine is mounted at. Defaults to "secret".
+	KvMount *string `json:"kvMount,omitempty"`
+
+	// Namespace The Vault Enterprise namespace to use.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// SkipServerVerification Skip remote server verification.
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+
+	// Type The repository type discriminator.
+	Type VaultRepoSpecType `json:"type"`
+
+	// Url The address of the Vault server.
+	Url string `json:"url"`
+
+	// VaultAuth Authentication for Vault.
+	VaultAuth VaultAuth `json:"vaultAuth"`
+}
 
-	// Organizations User organizations.
-	Organizations *[]Organization `json:"organizations,omitempty"`
+// VaultRepoSpecType The repository type discriminator.
+type VaultRepoSpecType string
 
-	// PreferredUsername Preferred username.
-	PreferredUsername *string `json:"preferred_username,omitempty"`
+// VaultTokenAuth Authenticates with a static Vault token.
+type VaultTokenAuth struct {
+	// AuthType The method used to authenticate with Vault.
+	AuthType VaultAuthType `json:"authType"`
 
-	// Sub Subject identifier.
-	Sub *string `json:"sub,omitempty"`
+	// Token The Vault token.
+	Token string `json:"token"`
 }
 
 // Version defines model for Version.
@@ -3353,6 +3969,21 @@ type ListAuthProvidersParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListBulkOperationsParams defines parameters for ListBulkOperations.
+type ListBulkOperationsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListCertificateSigningRequestsParams defines parameters for ListCertificateSigningRequests.
 type ListCertificateSigningRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3389,12 +4020,36 @@ type ListDevicesParams struct {
 	CveId *string `form:"cveId,omitempty" json:"cveId,omitempty"`
 }
 
+// GetDeviceStatusHistoryParams defines parameters for GetDeviceStatusHistory.
+type GetDeviceStatusHistoryParams struct {
+	// From Only return transitions recorded at or after this time.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only return transitions recorded before this time.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit The maximum number of transitions to return in the response.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetRenderedDeviceParams defines parameters for GetRenderedDevice.
 type GetRenderedDeviceParams struct {
 	// KnownRenderedVersion The last known renderedVersion.
 	KnownRenderedVersion *string `form:"knownRenderedVersion,omitempty" json:"knownRenderedVersion,omitempty"`
 }
 
+// ListDeviceRenderedVersionsParams defines parameters for ListDeviceRenderedVersions.
+type ListDeviceRenderedVersionsParams struct {
+	// Limit The maximum number of rendered versions to return in the response.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDeviceRenderedVersionDiffParams defines parameters for GetDeviceRenderedVersionDiff.
+type GetDeviceRenderedVersionDiffParams struct {
+	// Base The rendered version to compare against. Defaults to the retained rendered version preceding 'version'.
+	Base *string `form:"base,omitempty" json:"base,omitempty"`
+}
+
 // GetEnrollmentConfigParams defines parameters for GetEnrollmentConfig.
 type GetEnrollmentConfigParams struct {
 	// Csr The name of a CertificateSigningRequest resource to query for an issued certificate. If provided, the service will check if the CertificateSigningRequest contains an issued certificate and in this case include it the returned EnrollmentConfig. In all other case, the enrollment certificate field will be empty.
@@ -3527,6 +4182,21 @@ type ListResourceSyncsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListSecretsParams defines parameters for ListSecrets.
+type ListSecretsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetDeviceApplicationConsoleParams defines parameters for GetDeviceApplicationConsole.
 type GetDeviceApplicationConsoleParams struct {
 	// ConsoleType The type of console session to open. "serial" opens a text terminal; "vnc" opens a VNC proxy tunnel.
@@ -3539,6 +4209,24 @@ type GetDeviceApplicationConsoleParams struct {
 // GetDeviceApplicationConsoleParamsConsoleType defines parameters for GetDeviceApplicationConsole.
 type GetDeviceApplicationConsoleParamsConsoleType string
 
+// GetDeviceFileTransferParams defines parameters for GetDeviceFileTransfer.
+type GetDeviceFileTransferParams struct {
+	// Path The absolute path of the file on the Device. The agent only accepts paths that are allowed by its file-transfer configuration.
+	Path string `form:"path" json:"path"`
+
+	// Direction Whether the file is copied from the Device (download) or to the Device (upload).
+	Direction GetDeviceFileTransferParamsDirection `form:"direction" json:"direction"`
+}
+
+// GetDeviceFileTransferParamsDirection defines parameters for GetDeviceFileTransfer.
+type GetDeviceFileTransferParamsDirection string
+
+// GetDevicePortForwardParams defines parameters for GetDevicePortForward.
+type GetDevicePortForwardParams struct {
+	// Port The TCP port on the Device to connect to. The agent only accepts ports that are allowed by its port-forward configuration.
+	Port int32 `form:"port" json:"port"`
+}
+
 // AuthTokenJSONRequestBody defines body for AuthToken for application/json ContentType.
 type AuthTokenJSONRequestBody = TokenRequest
 
@@ -3554,6 +4242,9 @@ type PatchAuthProviderApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceAuthProviderJSONRequestBody defines body for ReplaceAuthProvider for application/json ContentType.
 type ReplaceAuthProviderJSONRequestBody = AuthProvider
 
+// CreateBulkOperationJSONRequestBody defines body for CreateBulkOperation for application/json ContentType.
+type CreateBulkOperationJSONRequestBody = BulkOperation
+
 // CreateCertificateSigningRequestJSONRequestBody defines body for CreateCertificateSigningRequest for application/json ContentType.
 type CreateCertificateSigningRequestJSONRequestBody = CertificateSigningRequest
 
@@ -3581,6 +4272,9 @@ type ReplaceDeviceJSONRequestBody = Device
 // DecommissionDeviceJSONRequestBody defines body for DecommissionDevice for application/json ContentType.
 type DecommissionDeviceJSONRequestBody = DeviceDecommission
 
+// RollbackDeviceJSONRequestBody defines body for RollbackDevice for application/json ContentType.
+type RollbackDeviceJSONRequestBody = DeviceRollback
+
 // PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchDeviceStatus for application/json-patch+json ContentType.
 type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
@@ -3644,6 +4338,12 @@ type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
 type ReplaceResourceSyncJSONRequestBody = ResourceSync
 
+// CreateSecretJSONRequestBody defines body for CreateSecret for application/json ContentType.
+type CreateSecretJSONRequestBody = Secret
+
+// ReplaceSecretJSONRequestBody defines body for ReplaceSecret for application/json ContentType.
+type ReplaceSecretJSONRequestBody = Secret
+
 // Getter for additional properties for DeviceSystemInfo. Returns the specified
 // element and whether it was found
 func (a DeviceSystemInfo) Get(fieldName string) (value string, found bool) {
@@ -4636,6 +5336,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.EnvFrom != nil {
+		object["envFrom"], err = json.Marshal(t.EnvFrom)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'envFrom': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -4699,6 +5406,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["envFrom"]; found {
+		err = json.Unmarshal(raw, &t.EnvFrom)
+		if err != nil {
+			return fmt.Errorf("error reading 'envFrom': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -4834,6 +5548,84 @@ func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpe
 	return err
 }
 
+// AsVaultConfigProviderSpec returns the union data inside the ConfigProviderSpec as a VaultConfigProviderSpec
+func (t ConfigProviderSpec) AsVaultConfigProviderSpec() (VaultConfigProviderSpec, error) {
+	var body VaultConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromVaultConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided VaultConfigProviderSpec
+func (t *ConfigProviderSpec) FromVaultConfigProviderSpec(v VaultConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeVaultConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided VaultConfigProviderSpec
+func (t *ConfigProviderSpec) MergeVaultConfigProviderSpec(v VaultConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOciConfigProviderSpec returns the union data inside the ConfigProviderSpec as a OciConfigProviderSpec
+func (t ConfigProviderSpec) AsOciConfigProviderSpec() (OciConfigProviderSpec, error) {
+	var body OciConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOciConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) FromOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOciConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) MergeOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSecretConfigProviderSpec returns the union data inside the ConfigProviderSpec as a SecretConfigProviderSpec
+func (t ConfigProviderSpec) AsSecretConfigProviderSpec() (SecretConfigProviderSpec, error) {
+	var body SecretConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSecretConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided SecretConfigProviderSpec
+func (t *ConfigProviderSpec) FromSecretConfigProviderSpec(v SecretConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSecretConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided SecretConfigProviderSpec
+func (t *ConfigProviderSpec) MergeSecretConfigProviderSpec(v SecretConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ConfigProviderSpec) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -4928,6 +5720,13 @@ func (t ContainerApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.EnvFrom != nil {
+		object["envFrom"], err = json.Marshal(t.EnvFrom)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'envFrom': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -5010,6 +5809,13 @@ func (t *ContainerApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["envFrom"]; found {
+		err = json.Unmarshal(raw, &t.EnvFrom)
+		if err != nil {
+			return fmt.Errorf("error reading 'envFrom': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -5676,6 +6482,62 @@ func (t *EventDetails) MergeApplicationLifecycleChangedDetails(v ApplicationLife
 	return err
 }
 
+// AsDevicePortForwardDetails returns the union data inside the EventDetails as a DevicePortForwardDetails
+func (t EventDetails) AsDevicePortForwardDetails() (DevicePortForwardDetails, error) {
+	var body DevicePortForwardDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDevicePortForwardDetails overwrites any union data inside the EventDetails as the provided DevicePortForwardDetails
+func (t *EventDetails) FromDevicePortForwardDetails(v DevicePortForwardDetails) error {
+	v.DetailType = "DevicePortForward"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDevicePortForwardDetails performs a merge with any union data inside the EventDetails, using the provided DevicePortForwardDetails
+func (t *EventDetails) MergeDevicePortForwardDetails(v DevicePortForwardDetails) error {
+	v.DetailType = "DevicePortForward"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFleetAutoUpgradeDetails returns the union data inside the EventDetails as a FleetAutoUpgradeDetails
+func (t EventDetails) AsFleetAutoUpgradeDetails() (FleetAutoUpgradeDetails, error) {
+	var body FleetAutoUpgradeDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetAutoUpgradeDetails overwrites any union data inside the EventDetails as the provided FleetAutoUpgradeDetails
+func (t *EventDetails) FromFleetAutoUpgradeDetails(v FleetAutoUpgradeDetails) error {
+	v.DetailType = "FleetAutoUpgrade"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetAutoUpgradeDetails performs a merge with any union data inside the EventDetails, using the provided FleetAutoUpgradeDetails
+func (t *EventDetails) MergeFleetAutoUpgradeDetails(v FleetAutoUpgradeDetails) error {
+	v.DetailType = "FleetAutoUpgrade"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t EventDetails) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"detailType"`
@@ -5702,8 +6564,12 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsDeviceMultipleOwnersResolvedDetails()
 	case "DeviceOwnershipChanged":
 		return t.AsDeviceOwnershipChangedDetails()
+	case "DevicePortForward":
+		return t.AsDevicePortForwardDetails()
 	case "DeviceVulnerabilityCVE":
 		return t.AsDeviceVulnerabilityCveDetails()
+	case "FleetAutoUpgrade":
+		return t.AsFleetAutoUpgradeDetails()
 	case "FleetRolloutBatchCompleted":
 		return t.AsFleetRolloutBatchCompletedDetails()
 	case "FleetRolloutBatchDispatched":
@@ -6367,6 +7233,13 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.EnvFrom != nil {
+		object["envFrom"], err = json.Marshal(t.EnvFrom)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'envFrom': %w", err)
+		}
+	}
+
 	if t.EnvVars != nil {
 		object["envVars"], err = json.Marshal(t.EnvVars)
 		if err != nil {
@@ -6435,6 +7308,13 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["envFrom"]; found {
+		err = json.Unmarshal(raw, &t.EnvFrom)
+		if err != nil {
+			return fmt.Errorf("error reading 'envFrom': %w", err)
+		}
+	}
+
 	if raw, found := object["envVars"]; found {
 		err = json.Unmarshal(raw, &t.EnvVars)
 		if err != nil {
@@ -6557,6 +7437,34 @@ func (t *RepositorySpec) MergeOciRepoSpec(v OciRepoSpec) error {
 	return err
 }
 
+// AsVaultRepoSpec returns the union data inside the RepositorySpec as a VaultRepoSpec
+func (t RepositorySpec) AsVaultRepoSpec() (VaultRepoSpec, error) {
+	var body VaultRepoSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromVaultRepoSpec overwrites any union data inside the RepositorySpec as the provided VaultRepoSpec
+func (t *RepositorySpec) FromVaultRepoSpec(v VaultRepoSpec) error {
+	v.Type = "vault"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeVaultRepoSpec performs a merge with any union data inside the RepositorySpec, using the provided VaultRepoSpec
+func (t *RepositorySpec) MergeVaultRepoSpec(v VaultRepoSpec) error {
+	v.Type = "vault"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RepositorySpec) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
@@ -6577,6 +7485,8 @@ func (t RepositorySpec) ValueByDiscriminator() (interface{}, error) {
 		return t.AsHttpRepoSpec()
 	case "oci":
 		return t.AsOciRepoSpec()
+	case "vault":
+		return t.AsVaultRepoSpec()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -6770,6 +7680,95 @@ func (t *RolloutDeviceSelection) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsVaultTokenAuth returns the union data inside the VaultAuth as a VaultTokenAuth
+func (t VaultAuth) AsVaultTokenAuth() (VaultTokenAuth, error) {
+	var body VaultTokenAuth
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromVaultTokenAuth overwrites any union data inside the VaultAuth as the provided VaultTokenAuth
+func (t *VaultAuth) FromVaultTokenAuth(v VaultTokenAuth) error {
+	v.AuthType = "token"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeVaultTokenAuth performs a merge with any union data inside the VaultAuth, using the provided VaultTokenAuth
+func (t *VaultAuth) MergeVaultTokenAuth(v VaultTokenAuth) error {
+	v.AuthType = "token"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsVaultAppRoleAuth returns the union data inside the VaultAuth as a VaultAppRoleAuth
+func (t VaultAuth) AsVaultAppRoleAuth() (VaultAppRoleAuth, error) {
+	var body VaultAppRoleAuth
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromVaultAppRoleAuth overwrites any union data inside the VaultAuth as the provided VaultAppRoleAuth
+func (t *VaultAuth) FromVaultAppRoleAuth(v VaultAppRoleAuth) error {
+	v.AuthType = "appRole"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeVaultAppRoleAuth performs a merge with any union data inside the VaultAuth, using the provided VaultAppRoleAuth
+func (t *VaultAuth) MergeVaultAppRoleAuth(v VaultAppRoleAuth) error {
+	v.AuthType = "appRole"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t VaultAuth) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"authType"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t VaultAuth) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "appRole":
+		return t.AsVaultAppRoleAuth()
+	case "token":
+		return t.AsVaultTokenAuth()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t VaultAuth) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *VaultAuth) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsImageApplicationProviderSpec returns the union data inside the VmApplication as a ImageApplicationProviderSpec
 func (t VmApplication) AsImageApplicationProviderSpec() (ImageApplicationProviderSpec, error) {
 	var body ImageApplicationProviderSpec
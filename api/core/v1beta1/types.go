@@ -28,6 +28,13 @@ type DeviceRemoteSession struct {
 	// an explicit takeover apart from an unrelated close-then-reopen of the same app, so
 	// it knows to cancel exactly that session and report the reason to its client.
 	ReplacesSessionID string `json:"replacesSessionID,omitempty"`
+	// Port is set only for TCP port-forward sessions, in which case AppName and ConsoleType
+	// are empty. The agent connects the session to this port on its loopback interface.
+	Port int32 `json:"port,omitempty"`
+	// FilePath and FileTransfer are set only for file transfer sessions: FilePath is the
+	// absolute path on the device and FileTransfer is "download" or "upload".
+	FilePath     string `json:"filePath,omitempty"`
+	FileTransfer string `json:"fileTransfer,omitempty"`
 }
 
 type DeviceConsoleSessionMetadata struct {
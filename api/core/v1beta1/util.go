@@ -36,6 +36,9 @@ const (
 	HttpConfigProviderType       ConfigProviderType = "httpRef"
 	InlineConfigProviderType     ConfigProviderType = "inline"
 	KubernetesSecretProviderType ConfigProviderType = "secretRef"
+	VaultConfigProviderType      ConfigProviderType = "vaultRef"
+	OciConfigProviderType        ConfigProviderType = "ociRef"
+	SecretConfigProviderType     ConfigProviderType = "secretResourceRef"
 )
 
 type ApplicationProviderType string
@@ -115,6 +118,9 @@ func (c ConfigProviderSpec) Type() (ConfigProviderType, error) {
 		HttpConfigProviderType,
 		InlineConfigProviderType,
 		KubernetesSecretProviderType,
+		VaultConfigProviderType,
+		OciConfigProviderType,
+		SecretConfigProviderType,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -488,6 +494,69 @@ func preserveHttpConfig(newConfig, existingConfig *HttpConfig) {
 	preserveValue(newConfig.TlsCrt, existingConfig.TlsCrt)
 }
 
+func hideVaultAuth(auth *VaultAuth) error {
+	authType, err := auth.Discriminator()
+	if err != nil {
+		return err
+	}
+	switch VaultAuthType(authType) {
+	case VaultAuthTypeToken:
+		tokenAuth, err := auth.AsVaultTokenAuth()
+		if err != nil {
+			return err
+		}
+		hideValue(&tokenAuth.Token)
+		return auth.FromVaultTokenAuth(tokenAuth)
+	case VaultAuthTypeAppRole:
+		appRoleAuth, err := auth.AsVaultAppRoleAuth()
+		if err != nil {
+			return err
+		}
+		hideValue(&appRoleAuth.SecretId)
+		return auth.FromVaultAppRoleAuth(appRoleAuth)
+	default:
+		return nil
+	}
+}
+
+// preserveVaultAuth preserves masked credentials when the auth method is unchanged.
+func preserveVaultAuth(newAuth, existingAuth *VaultAuth) error {
+	authType, err := newAuth.Discriminator()
+	if err != nil {
+		return err
+	}
+	existingAuthType, err := existingAuth.Discriminator()
+	if err != nil || authType != existingAuthType {
+		return nil
+	}
+	switch VaultAuthType(authType) {
+	case VaultAuthTypeToken:
+		tokenAuth, err := newAuth.AsVaultTokenAuth()
+		if err != nil {
+			return err
+		}
+		existingTokenAuth, err := existingAuth.AsVaultTokenAuth()
+		if err != nil {
+			return nil
+		}
+		preserveValue(&tokenAuth.Token, &existingTokenAuth.Token)
+		return newAuth.FromVaultTokenAuth(tokenAuth)
+	case VaultAuthTypeAppRole:
+		appRoleAuth, err := newAuth.AsVaultAppRoleAuth()
+		if err != nil {
+			return err
+		}
+		existingAppRoleAuth, err := existingAuth.AsVaultAppRoleAuth()
+		if err != nil {
+			return nil
+		}
+		preserveValue(&appRoleAuth.SecretId, &existingAppRoleAuth.SecretId)
+		return newAuth.FromVaultAppRoleAuth(appRoleAuth)
+	default:
+		return nil
+	}
+}
+
 func preserveSshConfig(newConfig, existingConfig *SshConfig) {
 	if newConfig == nil || existingConfig == nil {
 		return
@@ -548,6 +617,19 @@ func (r *Repository) HideSensitiveData() error {
 		}
 		r.Spec = spec
 		return nil
+	case string(RepoSpecTypeVault):
+		vault, err := spec.AsVaultRepoSpec()
+		if err != nil {
+			return err
+		}
+		if err := hideVaultAuth(&vault.VaultAuth); err != nil {
+			return err
+		}
+		if err := spec.FromVaultRepoSpec(vault); err != nil {
+			return err
+		}
+		r.Spec = spec
+		return nil
 	default:
 		return fmt.Errorf("unknown repository type: %s", specType)
 	}
@@ -655,11 +737,75 @@ func (r *Repository) PreserveSensitiveData(existing SensitiveDataPreserver) erro
 		r.Spec = spec
 		return nil
 
+	case string(RepoSpecTypeVault):
+		vault, err := spec.AsVaultRepoSpec()
+		if err != nil {
+			return err
+		}
+		existingVault, err := existingRepo.Spec.AsVaultRepoSpec()
+		if err != nil {
+			return err
+		}
+		if err := preserveVaultAuth(&vault.VaultAuth, &existingVault.VaultAuth); err != nil {
+			return err
+		}
+		if err := spec.FromVaultRepoSpec(vault); err != nil {
+			return err
+		}
+		r.Spec = spec
+		return nil
+
 	default:
 		return nil // Unknown type, nothing to preserve
 	}
 }
 
+func (s *Secret) HideSensitiveData() error {
+	if s == nil {
+		return nil
+	}
+	// Mask into a new map so the caller's copy of the data is left untouched.
+	masked := make(map[string]string, len(s.Spec.Data))
+	for key := range s.Spec.Data {
+		masked[key] = MaskedValuePlaceholder
+	}
+	s.Spec.Data = masked
+	return nil
+}
+
+func (s *SecretList) HideSensitiveData() error {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Items {
+		if err := s.Items[i].HideSensitiveData(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PreserveSensitiveData keeps the existing value of every key whose new value is the masked
+// placeholder ("*****"), so a Secret read from the API can be edited and written back.
+func (s *Secret) PreserveSensitiveData(existing SensitiveDataPreserver) error {
+	if s == nil || existing == nil {
+		return nil
+	}
+	existingSecret, ok := existing.(*Secret)
+	if !ok {
+		return fmt.Errorf("existing object is not a Secret")
+	}
+	for key, value := range s.Spec.Data {
+		if value != MaskedValuePlaceholder {
+			continue
+		}
+		if old, found := existingSecret.Spec.Data[key]; found {
+			s.Spec.Data[key] = old
+		}
+	}
+	return nil
+}
+
 func (a *AuthProvider) HideSensitiveData() error {
 	if a == nil {
 		return nil
@@ -1196,3 +1196,28 @@ func TestAuthProviderPreserveSensitiveData(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretHideSensitiveData(t *testing.T) {
+	data := map[string]string{"password": "czNjcjN0", "username": "YWRtaW4="}
+	secret := &Secret{Spec: SecretSpec{Data: data}}
+
+	require.NoError(t, secret.HideSensitiveData())
+
+	assert.Equal(t, map[string]string{"password": MaskedValuePlaceholder, "username": MaskedValuePlaceholder}, secret.Spec.Data)
+	assert.Equal(t, "czNjcjN0", data["password"], "the caller's map should not be modified")
+}
+
+func TestSecretPreserveSensitiveData(t *testing.T) {
+	existing := &Secret{Spec: SecretSpec{Data: map[string]string{"password": "czNjcjN0", "username": "YWRtaW4="}}}
+	updated := &Secret{Spec: SecretSpec{Data: map[string]string{
+		"password": MaskedValuePlaceholder,
+		"username": "cm9vdA==",
+		"token":    MaskedValuePlaceholder,
+	}}}
+
+	require.NoError(t, updated.PreserveSensitiveData(existing))
+
+	assert.Equal(t, "czNjcjN0", updated.Spec.Data["password"], "masked values should be preserved")
+	assert.Equal(t, "cm9vdA==", updated.Spec.Data["username"], "new values should win")
+	assert.Equal(t, MaskedValuePlaceholder, updated.Spec.Data["token"], "keys without an existing value should be left for validation to reject")
+}
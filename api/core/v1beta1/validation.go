@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -32,10 +33,16 @@ const (
 	privilegedPortRangeStart    = 1
 	nonPrivilegedPortRangeStart = 1024
 	portRangeEnd                = 65535
+	// maxSecretDataSize bounds the decoded size of a Secret's data, as Kubernetes does for its secrets.
+	maxSecretDataSize = 1024 * 1024
+	secretKeyFmt      = `[-._a-zA-Z0-9]+`
 )
 
+var secretKeyRegexp = regexp.MustCompile("^" + secretKeyFmt + "$")
+
 var (
 	ErrStartGraceDurationExceedsCronInterval = errors.New("startGraceDuration exceeds the cron interval between schedule times")
+	ErrStartGraceDurationBelowCheckInterval  = errors.New("startGraceDuration is shorter than the auto-upgrade check interval")
 	ErrInfoAlertLessThanWarn                 = errors.New("info alert percentage must be less than warning")
 	ErrInfoAlertLessThanCritical             = errors.New("info alert percentage must be less than critical")
 	ErrWarnAlertLessThanCritical             = errors.New("warning alert percentage must be less than critical")
@@ -123,9 +130,58 @@ func (r DeviceSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateSystemdName(&matchPattern, fmt.Sprintf("spec.systemd.matchPatterns[%d]", i))...)
 		}
 	}
+	if r.RegistryMirrors != nil {
+		allErrs = append(allErrs, validateRegistryMirrors(*r.RegistryMirrors, fleetTemplate)...)
+	}
+	return allErrs
+}
+
+func validateRegistryMirrors(registryMirrors []RegistryMirror, fleetTemplate bool) []error {
+	allErrs := []error{}
+	seenRegistries := make(map[string]struct{}, len(registryMirrors))
+	for i, registryMirror := range registryMirrors {
+		path := fmt.Sprintf("spec.registryMirrors[%d]", i)
+		allErrs = append(allErrs, validateRegistryLocation(&registryMirror.Registry, path+".registry")...)
+		if _, ok := seenRegistries[registryMirror.Registry]; ok {
+			allErrs = append(allErrs, fmt.Errorf("%s.registry: duplicate registry %q", path, registryMirror.Registry))
+		}
+		seenRegistries[registryMirror.Registry] = struct{}{}
+
+		if len(registryMirror.Mirrors) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.mirrors: at least one mirror is required", path))
+		}
+		for j, mirror := range registryMirror.Mirrors {
+			mirrorPath := fmt.Sprintf("%s.mirrors[%d].location", path, j)
+			containsParams, paramErrs := validateParametersInString(&mirror.Location, mirrorPath, fleetTemplate)
+			allErrs = append(allErrs, paramErrs...)
+			if containsParams {
+				// the location is validated once the parameters are replaced for a device.
+				continue
+			}
+			allErrs = append(allErrs, validateRegistryLocation(&mirror.Location, mirrorPath)...)
+		}
+	}
 	return allErrs
 }
 
+// validateRegistryLocation validates a registry host with optional port and namespace, such as
+// "registry.example.com:5000/myorg".
+func validateRegistryLocation(location *string, path string) []error {
+	if errs := validation.ValidateOciImageReference(location, path); len(errs) > 0 {
+		return errs
+	}
+	host, namespace, _ := strings.Cut(*location, "/")
+	if strings.ContainsAny(namespace, ":@") || strings.Contains(host, "@") {
+		return validation.FormatInvalidError(*location, path, "must not contain a tag or digest")
+	}
+	if _, port, ok := strings.Cut(host, ":"); ok {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return validation.FormatInvalidError(*location, path, "invalid port")
+		}
+	}
+	return nil
+}
+
 func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 	allErrs := []error{}
 	seenPath := make(map[string]struct{}, len(configs))
@@ -186,6 +242,30 @@ func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 			}
 			configName = provider.Name
 			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case VaultConfigProviderType:
+			provider, err := config.AsVaultConfigProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			configName = provider.Name
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case OciConfigProviderType:
+			provider, err := config.AsOciConfigProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			configName = provider.Name
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case SecretConfigProviderType:
+			provider, err := config.AsSecretConfigProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			configName = provider.Name
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
 		default:
 			allErrs = append(allErrs, fmt.Errorf("unknown config provider type: %s", t))
 		}
@@ -412,6 +492,86 @@ func (c KubernetesSecretProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+func (c SecretConfigProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.SecretResourceRef.Name, "spec.config[].secretResourceRef.name", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.SecretResourceRef.Name, "spec.config[].secretResourceRef.name")...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.SecretResourceRef.MountPath, "spec.config[].secretResourceRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.SecretResourceRef.MountPath, "spec.config[].secretResourceRef.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.SecretResourceRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].secretResourceRef.mountPath: %w", err))
+		}
+	}
+
+	return allErrs
+}
+
+func (c VaultConfigProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.VaultRef.Repository, "spec.config[].vaultRef.repository")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.VaultRef.Path, "spec.config[].vaultRef.path", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateString(&c.VaultRef.Path, "spec.config[].vaultRef.path", 1, 1024, nil, "")...)
+		if strings.HasPrefix(c.VaultRef.Path, "/") || strings.Contains(c.VaultRef.Path, "..") {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].vaultRef.path: must be relative to the KV mount and must not contain \"..\""))
+		}
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.VaultRef.MountPath, "spec.config[].vaultRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.VaultRef.MountPath, "spec.config[].vaultRef.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.VaultRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].vaultRef.mountPath: %w", err))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.VaultRef.User.String(), "spec.config[].vaultRef.user")...)
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.VaultRef.Group, "spec.config[].vaultRef.group")...)
+	allErrs = append(allErrs, validation.ValidateLinuxFileMode(c.VaultRef.Mode, "spec.config[].vaultRef.mode")...)
+
+	return allErrs
+}
+
+func (c OciConfigProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.OciRef.Repository, "spec.config[].ociRef.repository")...)
+	allErrs = append(allErrs, validation.ValidateString(&c.OciRef.Artifact, "spec.config[].ociRef.artifact", 1, 2048, validation.OciImageNameRegexp, validation.OciImageNameFmt, "myorg/device-config")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.OciRef.Reference, "spec.config[].ociRef.reference", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams && !validation.OciImageDigestRegexp.MatchString(c.OciRef.Reference) {
+		allErrs = append(allErrs, validation.ValidateString(&c.OciRef.Reference, "spec.config[].ociRef.reference", 1, 128, validation.OciImageTagRegexp, validation.OciImageTagFmt, "v1")...)
+	}
+
+	containsParams, paramErrs = validateParametersInString(&c.OciRef.MountPath, "spec.config[].ociRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFilePath(&c.OciRef.MountPath, "spec.config[].ociRef.mountPath")...)
+		if err := validation.DenyForbiddenDevicePath(c.OciRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].ociRef.mountPath: %w", err))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.OciRef.User.String(), "spec.config[].ociRef.user")...)
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.OciRef.Group, "spec.config[].ociRef.group")...)
+	allErrs = append(allErrs, validation.ValidateLinuxFileMode(c.OciRef.Mode, "spec.config[].ociRef.mode")...)
+
+	return allErrs
+}
+
 func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateConfigName(&c.Name, "spec.config[].name")...)
@@ -624,6 +784,7 @@ func (r Fleet) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
 	allErrs = append(allErrs, r.Spec.Selector.Validate()...)
 	allErrs = append(allErrs, r.Spec.RolloutPolicy.Validate()...)
+	allErrs = append(allErrs, r.Spec.AutoUpgrade.Validate()...)
 
 	// Validate the Device spec settings
 	allErrs = append(allErrs, r.Spec.Template.Spec.Validate(true)...)
@@ -631,6 +792,25 @@ func (r Fleet) Validate() []error {
 	return allErrs
 }
 
+// FleetAutoUpgradeCheckInterval is the interval at which fleets with an auto-upgrade policy are
+// checked for newer catalog item versions on their channels.
+const FleetAutoUpgradeCheckInterval = 5 * time.Minute
+
+func (a *FleetAutoUpgradePolicy) Validate() []error {
+	if a == nil || a.Schedule == nil {
+		return nil
+	}
+	var errs []error
+	for _, err := range a.Schedule.Validate() {
+		errs = append(errs, fmt.Errorf("spec.autoUpgrade.schedule: %w", err))
+	}
+	// A shorter window could open and close between two checks, so that upgrades never apply.
+	if grace, err := time.ParseDuration(a.Schedule.StartGraceDuration); err == nil && grace < FleetAutoUpgradeCheckInterval {
+		errs = append(errs, fmt.Errorf("spec.autoUpgrade.schedule: %w: %s is less than %s", ErrStartGraceDurationBelowCheckInterval, grace, FleetAutoUpgradeCheckInterval))
+	}
+	return errs
+}
+
 // ValidateUpdate ensures immutable fields are unchanged for Fleet.
 func (f *Fleet) ValidateUpdate(newObj *Fleet) []error {
 	return validateImmutableCoreFields(f.Metadata.Name, newObj.Metadata.Name,
@@ -651,7 +831,47 @@ func (u DeviceUpdatePolicySpec) Validate() []error {
 			allErrs = append(allErrs, err...)
 		}
 	}
+	if u.DownloadConditions != nil {
+		allErrs = append(allErrs, u.DownloadConditions.Validate("spec.updatePolicy.downloadConditions")...)
+	}
+	if u.UpdateConditions != nil {
+		allErrs = append(allErrs, u.UpdateConditions.Validate("spec.updatePolicy.updateConditions")...)
+	}
+
+	return allErrs
+}
 
+func (c UpdateConditions) Validate(path string) []error {
+	allErrs := []error{}
+	percentages := []struct {
+		name  string
+		value *Percentage
+	}{
+		{"minFreeStorage", c.MinFreeStorage},
+		{"maxCpuLoad", c.MaxCpuLoad},
+		{"minBatteryLevel", c.MinBatteryLevel},
+	}
+	for _, p := range percentages {
+		if p.value == nil {
+			continue
+		}
+		if err := validatePercentage(*p.value); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.%s: %w", path, p.name, err))
+		}
+	}
+	if c.StoragePath != nil && c.MinFreeStorage == nil {
+		allErrs = append(allErrs, fmt.Errorf("%s.storagePath: requires minFreeStorage", path))
+	}
+	allErrs = append(allErrs, validation.ValidateFilePath(c.StoragePath, path+".storagePath")...)
+	for i, command := range lo.FromPtr(c.Commands) {
+		commandPath := fmt.Sprintf("%s.commands[%d]", path, i)
+		allErrs = append(allErrs, validation.ValidateString(&command.Run, commandPath+".run", 1, 2048, nil, "")...)
+		if command.Timeout != nil {
+			if timeout, err := time.ParseDuration(*command.Timeout); err != nil || timeout <= 0 {
+				allErrs = append(allErrs, fmt.Errorf("%s.timeout: must be a positive duration", commandPath))
+			}
+		}
+	}
 	return allErrs
 }
 
@@ -742,6 +962,22 @@ func (r *Repository) Validate() []error {
 		if gitRepoSpec.SshConfig != nil {
 			allErrs = append(allErrs, validateSshConfig(gitRepoSpec.SshConfig)...)
 		}
+	case string(RepoSpecTypeVault):
+		vaultRepoSpec, err := r.Spec.AsVaultRepoSpec()
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("invalid Vault repository spec: %w", err))
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateString(&vaultRepoSpec.Url, "spec.url", 1, 2048, nil, "")...)
+		if u, err := url.Parse(vaultRepoSpec.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, fmt.Errorf("spec.url: must be an http or https URL"))
+		}
+		allErrs = append(allErrs, validation.ValidateString(vaultRepoSpec.KvMount, "spec.kvMount", 1, 256, nil, "")...)
+		allErrs = append(allErrs, validation.ValidateString(vaultRepoSpec.Namespace, "spec.namespace", 1, 256, nil, "")...)
+		allErrs = append(allErrs, validateVaultAuth(vaultRepoSpec.VaultAuth)...)
+		if vaultRepoSpec.CaCrt != nil {
+			allErrs = append(allErrs, validation.ValidateBase64Field(*vaultRepoSpec.CaCrt, "spec.ca.crt", maxBase64CertificateLength)...)
+		}
 	default:
 		allErrs = append(allErrs, fmt.Errorf("unknown repository type: %s", specType))
 	}
@@ -749,6 +985,131 @@ func (r *Repository) Validate() []error {
 	return allErrs
 }
 
+func (s *Secret) Validate() []error {
+	if s == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(s.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(s.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(s.Metadata.Annotations)...)
+
+	// Errors name the offending key but never echo its value.
+	size := 0
+	for _, key := range slices.Sorted(maps.Keys(s.Spec.Data)) {
+		allErrs = append(allErrs, validation.ValidateString(&key, "spec.data", 1, validation.DNS1123MaxLength, secretKeyRegexp, secretKeyFmt)...)
+		if key == "." || key == ".." {
+			allErrs = append(allErrs, fmt.Errorf("spec.data: key %q is not allowed", key))
+		}
+		decoded, err := base64.StdEncoding.DecodeString(s.Spec.Data[key])
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.data[%s]: must be a valid base64 encoded string", key))
+			continue
+		}
+		size += len(decoded)
+	}
+	if size > maxSecretDataSize {
+		allErrs = append(allErrs, fmt.Errorf("spec.data: total size of %d bytes exceeds the maximum of %d bytes", size, maxSecretDataSize))
+	}
+	return allErrs
+}
+
+// bulkOperationReservedKeyPrefixes are the key prefixes of the metadata managed by the service,
+// which a BulkOperation must not modify.
+var bulkOperationReservedKeyPrefixes = []string{"device-controller/", "fleet-controller/"}
+
+func (b *BulkOperation) Validate() []error {
+	if b == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(b.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(b.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(b.Metadata.Annotations)...)
+
+	spec := b.Spec
+	if lo.FromPtr(spec.LabelSelector) == "" && lo.FromPtr(spec.FieldSelector) == "" {
+		allErrs = append(allErrs, errors.New("spec: at least one of [labelSelector,fieldSelector] must be specified"))
+	}
+
+	// Only the parameters of the chosen operation may be set, so that a typo in the operation
+	// cannot silently apply a different change than the one requested.
+	params := map[string]bool{
+		"labels":          spec.Labels != nil,
+		"annotations":     spec.Annotations != nil,
+		"owner":           spec.Owner != nil,
+		"decommission":    spec.Decommission != nil,
+		"applicationName": spec.ApplicationName != nil,
+	}
+	var required string
+	switch spec.Operation {
+	case BulkOperationTypePatchLabels:
+		required = "labels"
+		allErrs = append(allErrs, validateBulkOperationMetadataPatch(spec.Labels, "spec.labels")...)
+		if spec.Labels != nil {
+			allErrs = append(allErrs, validation.ValidateLabelsWithPath(spec.Labels.Set, "spec.labels.set")...)
+		}
+	case BulkOperationTypePatchAnnotations:
+		required = "annotations"
+		allErrs = append(allErrs, validateBulkOperationMetadataPatch(spec.Annotations, "spec.annotations")...)
+	case BulkOperationTypeSetOwner:
+		required = "owner"
+		if lo.FromPtr(spec.Owner) != "" {
+			allErrs = append(allErrs, validation.ValidateResourceOwner(spec.Owner, lo.ToPtr(FleetKind))...)
+		}
+	case BulkOperationTypeDecommission:
+		required = "decommission"
+		if spec.Decommission != nil && !slices.Contains([]DeviceDecommissionTargetType{DeviceDecommissionTargetTypeUnenroll, DeviceDecommissionTargetTypeFactoryReset}, spec.Decommission.Target) {
+			allErrs = append(allErrs, fmt.Errorf("spec.decommission.target: unsupported value %q", spec.Decommission.Target))
+		}
+	case BulkOperationTypeStartApplication, BulkOperationTypeStopApplication, BulkOperationTypeRestartApplication:
+		required = "applicationName"
+		if spec.ApplicationName != nil {
+			allErrs = append(allErrs, validation.ValidateString(spec.ApplicationName, "spec.applicationName", 1, 253, nil, "")...)
+		}
+	case BulkOperationTypeResume:
+	default:
+		allErrs = append(allErrs, fmt.Errorf("spec.operation: unsupported value %q", spec.Operation))
+		return allErrs
+	}
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		switch {
+		case name == required && !params[name]:
+			allErrs = append(allErrs, fmt.Errorf("spec.%s: required for operation %s", name, spec.Operation))
+		case name != required && params[name]:
+			allErrs = append(allErrs, fmt.Errorf("spec.%s: not allowed for operation %s", name, spec.Operation))
+		}
+	}
+	return allErrs
+}
+
+func validateBulkOperationMetadataPatch(patch *BulkOperationMetadataPatch, path string) []error {
+	if patch == nil {
+		return nil
+	}
+	allErrs := []error{}
+	if len(lo.FromPtr(patch.Set)) == 0 && len(lo.FromPtr(patch.Remove)) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s: at least one key must be set or removed", path))
+	}
+	keys := slices.Concat(slices.Collect(maps.Keys(lo.FromPtr(patch.Set))), lo.FromPtr(patch.Remove))
+	for _, key := range keys {
+		for _, msg := range validation.ValidateLabelKey(key) {
+			allErrs = append(allErrs, fmt.Errorf("%s: invalid key %q: %s", path, key, msg))
+		}
+		for _, prefix := range bulkOperationReservedKeyPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				allErrs = append(allErrs, fmt.Errorf("%s: key %q uses the reserved prefix %q", path, key, prefix))
+			}
+		}
+	}
+	for _, key := range lo.FromPtr(patch.Remove) {
+		if _, ok := lo.FromPtr(patch.Set)[key]; ok {
+			allErrs = append(allErrs, fmt.Errorf("%s: key %q cannot be both set and removed", path, key))
+		}
+	}
+	return allErrs
+}
+
 // ValidateUpdate ensures immutable fields are unchanged for Repository.
 func (r *Repository) ValidateUpdate(newObj *Repository) []error {
 	return validateImmutableCoreFields(r.Metadata.Name, newObj.Metadata.Name,
@@ -763,7 +1124,25 @@ func (r ResourceSync) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
 	allErrs = append(allErrs, validation.ValidateResourceNameReference(&r.Spec.Repository, "spec.repository")...)
-	allErrs = append(allErrs, validation.ValidateGitRevision(&r.Spec.TargetRevision, "spec.targetRevision")...)
+	if r.Spec.Archive != nil {
+		// HTTP archives have no revision, their content digest is reported instead.
+		allErrs = append(allErrs, validation.ValidateString(r.Spec.Archive, "spec.archive", 1, 2048, nil, "")...)
+		if strings.HasPrefix(*r.Spec.Archive, "/") || slices.Contains(strings.Split(*r.Spec.Archive, "/"), "..") {
+			allErrs = append(allErrs, fmt.Errorf("spec.archive: must be relative to the repository URL and must not contain \"..\""))
+		}
+		if r.Spec.TargetRevision != "" {
+			allErrs = append(allErrs, fmt.Errorf("spec.targetRevision: not allowed together with spec.archive"))
+		}
+		if r.Spec.Artifact != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.artifact: not allowed together with spec.archive"))
+		}
+	} else if !validation.OciImageDigestRegexp.MatchString(r.Spec.TargetRevision) {
+		// OCI artifacts may be pinned by digest, which the git revision format does not allow.
+		allErrs = append(allErrs, validation.ValidateGitRevision(&r.Spec.TargetRevision, "spec.targetRevision")...)
+	}
+	if r.Spec.Artifact != nil {
+		allErrs = append(allErrs, validation.ValidateString(r.Spec.Artifact, "spec.artifact", 1, 2048, validation.OciImageNameRegexp, validation.OciImageNameFmt, "myorg/fleet-config")...)
+	}
 	allErrs = append(allErrs, validation.ValidateString(&r.Spec.Path, "spec.path", 0, 2048, nil, "")...)
 	return allErrs
 }
@@ -813,6 +1192,32 @@ func (d *DeviceSystemInfo) IsEmpty() bool {
 	return reflect.DeepEqual(*d, empty)
 }
 
+func validateVaultAuth(auth VaultAuth) []error {
+	authType, err := auth.Discriminator()
+	if err != nil {
+		return []error{fmt.Errorf("invalid Vault auth config: %w", err)}
+	}
+	switch VaultAuthType(authType) {
+	case VaultAuthTypeToken:
+		tokenAuth, err := auth.AsVaultTokenAuth()
+		if err != nil {
+			return []error{fmt.Errorf("invalid Vault token auth config: %w", err)}
+		}
+		return validation.ValidateString(&tokenAuth.Token, "spec.vaultAuth.token", 1, 8192, nil, "")
+	case VaultAuthTypeAppRole:
+		appRoleAuth, err := auth.AsVaultAppRoleAuth()
+		if err != nil {
+			return []error{fmt.Errorf("invalid Vault AppRole auth config: %w", err)}
+		}
+		errs := validation.ValidateString(&appRoleAuth.RoleId, "spec.vaultAuth.roleId", 1, 256, nil, "")
+		errs = append(errs, validation.ValidateString(&appRoleAuth.SecretId, "spec.vaultAuth.secretId", 1, 256, nil, "")...)
+		errs = append(errs, validation.ValidateString(appRoleAuth.MountPath, "spec.vaultAuth.mountPath", 1, 256, nil, "")...)
+		return errs
+	default:
+		return []error{fmt.Errorf("spec.vaultAuth.authType: unsupported value %q", authType)}
+	}
+}
+
 func validateHttpConfig(config *HttpConfig) []error {
 	var errs []error
 	if config != nil {
@@ -1084,6 +1489,7 @@ func validateContainerApplication(app ApplicationProviderSpec, appName string, f
 	}
 
 	allErrs = append(allErrs, validateEnvVars(container.EnvVars, pathPrefix)...)
+	allErrs = append(allErrs, validateEnvFrom(container.EnvFrom, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(container.Volumes, appName, AppTypeContainer, fleetTemplate)...)
 
 	return allErrs
@@ -1190,6 +1596,7 @@ func validateComposeApplication(app ApplicationProviderSpec, appName string, fle
 	}
 
 	allErrs = append(allErrs, validateEnvVars(compose.EnvVars, pathPrefix)...)
+	allErrs = append(allErrs, validateEnvFrom(compose.EnvFrom, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(compose.Volumes, appName, AppTypeCompose, fleetTemplate)...)
 
 	return allErrs
@@ -1239,6 +1646,7 @@ func validateQuadletApplication(app ApplicationProviderSpec, appName string, fle
 	}
 
 	allErrs = append(allErrs, validateEnvVars(quadlet.EnvVars, pathPrefix)...)
+	allErrs = append(allErrs, validateEnvFrom(quadlet.EnvFrom, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(quadlet.Volumes, appName, AppTypeQuadlet, fleetTemplate)...)
 
 	return allErrs
@@ -1382,6 +1790,21 @@ func validateEnvVars(envVars *map[string]string, pathPrefix string) []error {
 	return validation.ValidateStringMap(envVars, pathPrefix+".envVars", 1, validation.DNS1123MaxLength, validation.EnvVarNameRegexp, nil, "")
 }
 
+func validateEnvFrom(envFrom *[]ApplicationEnvFromSource, pathPrefix string) []error {
+	if envFrom == nil {
+		return nil
+	}
+	allErrs := []error{}
+	for i, source := range *envFrom {
+		path := fmt.Sprintf("%s.envFrom[%d]", pathPrefix, i)
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&source.SecretRef.Name, path+".secretRef.name")...)
+		if source.Prefix != nil {
+			allErrs = append(allErrs, validation.ValidateString(source.Prefix, path+".prefix", 1, validation.DNS1123MaxLength, validation.EnvVarNameRegexp, "")...)
+		}
+	}
+	return allErrs
+}
+
 func validateApplicationVolumes(volumes *[]ApplicationVolume, appName string, appType AppType, fleetTemplate bool) []error {
 	if volumes == nil {
 		return nil
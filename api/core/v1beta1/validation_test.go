@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"strings"
 	"testing"
 
@@ -323,6 +324,110 @@ func TestValidateScheduleAndGraceDuration(t *testing.T) {
 	}
 }
 
+func TestValidateUpdateConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions UpdateConditions
+		errMsgs    []string
+	}{
+		{
+			name: "valid conditions",
+			conditions: UpdateConditions{
+				MinFreeStorage:          lo.ToPtr("20%"),
+				StoragePath:             lo.ToPtr("/var/lib"),
+				MaxCpuLoad:              lo.ToPtr("70%"),
+				RequireExternalPower:    lo.ToPtr(true),
+				MinBatteryLevel:         lo.ToPtr("50%"),
+				RequireUnmeteredNetwork: lo.ToPtr(true),
+				Commands:                &[]UpdateConditionCommand{{Run: "/usr/local/bin/line-idle", Timeout: lo.ToPtr("30s")}},
+			},
+		},
+		{
+			name:       "invalid percentages",
+			conditions: UpdateConditions{MinFreeStorage: lo.ToPtr("20"), MaxCpuLoad: lo.ToPtr("150%")},
+			errMsgs:    []string{"test.minFreeStorage", "test.maxCpuLoad"},
+		},
+		{
+			name:       "storage path without minimum",
+			conditions: UpdateConditions{StoragePath: lo.ToPtr("/var")},
+			errMsgs:    []string{"requires minFreeStorage"},
+		},
+		{
+			name:       "relative storage path",
+			conditions: UpdateConditions{MinFreeStorage: lo.ToPtr("20%"), StoragePath: lo.ToPtr("var")},
+			errMsgs:    []string{"must be an absolute path"},
+		},
+		{
+			name:       "invalid commands",
+			conditions: UpdateConditions{Commands: &[]UpdateConditionCommand{{Run: ""}, {Run: "true", Timeout: lo.ToPtr("0s")}}},
+			errMsgs:    []string{"test.commands[0].run", "test.commands[1].timeout"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := tt.conditions.Validate("test")
+			require.Len(errs, len(tt.errMsgs), "%v", errs)
+			for i, errMsg := range tt.errMsgs {
+				require.Contains(errs[i].Error(), errMsg)
+			}
+		})
+	}
+}
+
+func TestValidateRegistryMirrors(t *testing.T) {
+	mirrorTemplate := `{{ getOrDefault .metadata.labels "registry-mirror" "" }}`
+	tests := []struct {
+		name          string
+		mirrors       []RegistryMirror
+		fleetTemplate bool
+		errMsgs       []string
+	}{
+		{
+			name: "valid mirrors",
+			mirrors: []RegistryMirror{
+				{Registry: "quay.io", Mirrors: []RegistryMirrorLocation{{Location: "10.0.0.5:5000", Insecure: lo.ToPtr(true)}, {Location: "mirror.example.com/quay"}}},
+				{Registry: "registry.example.com:8443/myorg", Mirrors: []RegistryMirrorLocation{{Location: "mirror.example.com"}}},
+			},
+		},
+		{
+			name:          "parameters in fleet template",
+			mirrors:       []RegistryMirror{{Registry: "quay.io", Mirrors: []RegistryMirrorLocation{{Location: mirrorTemplate}}}},
+			fleetTemplate: true,
+		},
+		{
+			name:    "parameters outside fleet template",
+			mirrors: []RegistryMirror{{Registry: "quay.io", Mirrors: []RegistryMirrorLocation{{Location: mirrorTemplate}}}},
+			errMsgs: []string{"spec.registryMirrors[0].mirrors[0].location"},
+		},
+		{
+			name: "invalid mirrors",
+			mirrors: []RegistryMirror{
+				{Registry: "quay.io/myorg:latest", Mirrors: []RegistryMirrorLocation{{Location: "mirror.example.com:port"}}},
+				{Registry: "docker.io"},
+				{Registry: "docker.io", Mirrors: []RegistryMirrorLocation{{Location: "mirror.example.com@sha256:abc"}}},
+			},
+			errMsgs: []string{
+				"spec.registryMirrors[0].registry",
+				"spec.registryMirrors[0].mirrors[0].location",
+				"at least one mirror is required",
+				"duplicate registry",
+				"spec.registryMirrors[2].mirrors[0].location",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := validateRegistryMirrors(tt.mirrors, tt.fleetTemplate)
+			require.Len(errs, len(tt.errMsgs), "%v", errs)
+			for i, errMsg := range tt.errMsgs {
+				require.Contains(errs[i].Error(), errMsg)
+			}
+		})
+	}
+}
+
 func TestValidateParametersInString(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -2679,6 +2784,178 @@ func TestKubernetesSecretProviderSpec_Validate_ForbiddenPaths(t *testing.T) {
 	}
 }
 
+func TestVaultConfigProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		mountPath     string
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{"When path and mountPath are valid it should pass", "edge/site-a", "/etc/site", false, false},
+		{"When the path is absolute it should fail", "/edge/site-a", "/etc/site", false, true},
+		{"When the path escapes the mount it should fail", "edge/../admin", "/etc/site", false, true},
+		{"When the mountPath is forbidden it should fail", "edge/site-a", "/etc/flightctl/certs", false, true},
+		{"When the path is parameterized in a fleet template it should pass", "edge/{{ .metadata.name }}", "/etc/site", true, false},
+		{"When the path references an unsupported field it should fail", "edge/{{ .metadata.annotations }}", "/etc/site", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := VaultConfigProviderSpec{Name: "site-credentials"}
+			spec.VaultRef.Repository = "vault"
+			spec.VaultRef.Path = tt.path
+			spec.VaultRef.MountPath = tt.mountPath
+
+			errs := spec.Validate(tt.fleetTemplate)
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestSecret_Validate(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte("s3cr3t"))
+	tests := []struct {
+		name    string
+		data    map[string]string
+		wantErr bool
+	}{
+		{"When the data is valid it should pass", map[string]string{"password": encoded, "tls.crt": encoded}, false},
+		{"When the data is empty it should pass", map[string]string{}, false},
+		{"When a value is not base64 encoded it should fail", map[string]string{"password": "not base64!"}, true},
+		{"When a key contains a slash it should fail", map[string]string{"etc/passwd": encoded}, true},
+		{"When a key is a relative path element it should fail", map[string]string{"..": encoded}, true},
+		{"When the decoded data exceeds the maximum size it should fail", map[string]string{"blob": base64.StdEncoding.EncodeToString(make([]byte, maxSecretDataSize+1))}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := Secret{
+				Metadata: ObjectMeta{Name: lo.ToPtr("db-credentials")},
+				Spec:     SecretSpec{Data: tt.data},
+			}
+
+			errs := secret.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+				for _, err := range errs {
+					require.NotContains(t, err.Error(), "s3cr3t")
+				}
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestValidateEnvFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		envFrom []ApplicationEnvFromSource
+		wantErr bool
+	}{
+		{"When the secret name is valid it should pass", []ApplicationEnvFromSource{{SecretRef: SecretReference{Name: "db-credentials"}}}, false},
+		{"When the prefix is a valid variable name it should pass", []ApplicationEnvFromSource{{SecretRef: SecretReference{Name: "db-credentials"}, Prefix: lo.ToPtr("DB_")}}, false},
+		{"When the secret name is invalid it should fail", []ApplicationEnvFromSource{{SecretRef: SecretReference{Name: "DB Credentials"}}}, true},
+		{"When the prefix is not a valid variable name it should fail", []ApplicationEnvFromSource{{SecretRef: SecretReference{Name: "db-credentials"}, Prefix: lo.ToPtr("1-DB")}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateEnvFrom(&tt.envFrom, "spec.applications[app]")
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestKubernetesSecretProviderSpec_ValidateRequiresNamespace(t *testing.T) {
+	spec := KubernetesSecretProviderSpec{Name: "db-credentials"}
+	spec.SecretRef.Name = "db-credentials"
+	spec.SecretRef.MountPath = "/etc/db"
+
+	require.NotEmpty(t, spec.Validate(false), "a Kubernetes secret reference must have a namespace")
+}
+
+func TestSecretConfigProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		secretName    string
+		mountPath     string
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{"When the reference is valid it should pass", "db-credentials", "/etc/db", false, false},
+		{"When the name is empty it should fail", "", "/etc/db", false, true},
+		{"When the name is invalid it should fail", "DB_Credentials", "/etc/db", false, true},
+		{"When the mountPath is forbidden it should fail", "db-credentials", "/etc/flightctl/certs", false, true},
+		{"When the name is parameterized in a fleet template it should pass", "{{ .metadata.labels.site }}-db", "/etc/db", true, false},
+		{"When the name is parameterized outside a fleet template it should fail", "{{ .metadata.labels.site }}-db", "/etc/db", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := SecretConfigProviderSpec{Name: "db-credentials"}
+			spec.SecretResourceRef.Name = tt.secretName
+			spec.SecretResourceRef.MountPath = tt.mountPath
+
+			errs := spec.Validate(tt.fleetTemplate)
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestOciConfigProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		artifact      string
+		reference     string
+		mountPath     string
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{"When the reference is a tag it should pass", "myorg/device-config", "v1", "/etc/app", false, false},
+		{"When the reference is a digest it should pass", "myorg/device-config", "sha256:" + strings.Repeat("a", 64), "/etc/app", false, false},
+		{"When the reference is not a tag or digest it should fail", "myorg/device-config", "v1/latest", "/etc/app", false, true},
+		{"When the artifact is empty it should fail", "", "v1", "/etc/app", false, true},
+		{"When the mountPath is forbidden it should fail", "myorg/device-config", "v1", "/etc/flightctl/certs", false, true},
+		{"When the reference is parameterized in a fleet template it should pass", "myorg/device-config", "{{ .metadata.labels.channel }}", "/etc/app", true, false},
+		{"When the reference is parameterized outside a fleet template it should fail", "myorg/device-config", "{{ .metadata.labels.channel }}", "/etc/app", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := OciConfigProviderSpec{Name: "app-config"}
+			spec.OciRef.Repository = "registry"
+			spec.OciRef.Artifact = tt.artifact
+			spec.OciRef.Reference = tt.reference
+			spec.OciRef.MountPath = tt.mountPath
+
+			errs := spec.Validate(tt.fleetTemplate)
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
 func newOciAuth(username, password string) *OciAuth {
 	auth := &OciAuth{}
 	_ = auth.FromDockerAuth(DockerAuth{
@@ -2779,6 +3056,72 @@ func TestRepository_Validate_OciRepoSpec(t *testing.T) {
 	}
 }
 
+func TestRepository_Validate_VaultRepoSpec(t *testing.T) {
+	tokenAuth := func(token string) VaultAuth {
+		auth := VaultAuth{}
+		_ = auth.FromVaultTokenAuth(VaultTokenAuth{AuthType: VaultAuthTypeToken, Token: token})
+		return auth
+	}
+	appRoleAuth := func(roleId, secretId string) VaultAuth {
+		auth := VaultAuth{}
+		_ = auth.FromVaultAppRoleAuth(VaultAppRoleAuth{AuthType: VaultAuthTypeAppRole, RoleId: roleId, SecretId: secretId})
+		return auth
+	}
+
+	tests := []struct {
+		name   string
+		spec   VaultRepoSpec
+		errMsg string
+	}{
+		{
+			name: "When using token auth it should pass",
+			spec: VaultRepoSpec{Url: "https://vault.example.com:8200", VaultAuth: tokenAuth("s.token")},
+		},
+		{
+			name: "When using AppRole auth with a KV mount it should pass",
+			spec: VaultRepoSpec{Url: "https://vault.example.com", KvMount: lo.ToPtr("edge"), VaultAuth: appRoleAuth("role", "secret")},
+		},
+		{
+			name:   "When the URL is not http or https it should fail",
+			spec:   VaultRepoSpec{Url: "vault.example.com", VaultAuth: tokenAuth("s.token")},
+			errMsg: "spec.url",
+		},
+		{
+			name:   "When the token is empty it should fail",
+			spec:   VaultRepoSpec{Url: "https://vault.example.com", VaultAuth: tokenAuth("")},
+			errMsg: "spec.vaultAuth.token",
+		},
+		{
+			name:   "When the AppRole secret ID is empty it should fail",
+			spec:   VaultRepoSpec{Url: "https://vault.example.com", VaultAuth: appRoleAuth("role", "")},
+			errMsg: "spec.vaultAuth.secretId",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.Type = VaultRepoSpecTypeVault
+			repoSpec := RepositorySpec{}
+			require.NoError(t, repoSpec.FromVaultRepoSpec(tt.spec))
+			repo := Repository{
+				ApiVersion: "v1beta1",
+				Kind:       "Repository",
+				Metadata:   ObjectMeta{Name: lo.ToPtr("vault")},
+				Spec:       repoSpec,
+			}
+
+			errs := repo.Validate()
+
+			if tt.errMsg == "" {
+				require.Empty(t, errs, "unexpected validation errors: %v", errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			require.Contains(t, errors.Join(errs...).Error(), tt.errMsg)
+		})
+	}
+}
+
 func TestRepository_Validate_BaseImages(t *testing.T) {
 	makeBaseImage := func(imageName string, tags ...string) BaseImageEntry {
 		return BaseImageEntry{ImageName: imageName, Tags: tags}
@@ -3292,6 +3635,35 @@ func TestDisruptionBudgetValidateGroupBy(t *testing.T) {
 	}
 }
 
+func TestFleetAutoUpgradePolicyValidate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name    string
+		policy  *FleetAutoUpgradePolicy
+		wantErr bool
+	}{
+		{"nil policy", nil, false},
+		{"no schedule", &FleetAutoUpgradePolicy{RequireApproval: lo.ToPtr(true)}, false},
+		{"valid schedule", &FleetAutoUpgradePolicy{Schedule: &UpdateSchedule{At: "0 2 * * 6", StartGraceDuration: "2h", TimeZone: lo.ToPtr("UTC")}}, false},
+		{"invalid cron", &FleetAutoUpgradePolicy{Schedule: &UpdateSchedule{At: "every night", StartGraceDuration: "2h"}}, true},
+		{"invalid time zone", &FleetAutoUpgradePolicy{Schedule: &UpdateSchedule{At: "0 2 * * *", StartGraceDuration: "2h", TimeZone: lo.ToPtr("Nowhere/Special")}}, true},
+		{"grace duration of the check interval", &FleetAutoUpgradePolicy{Schedule: &UpdateSchedule{At: "0 2 * * *", StartGraceDuration: "5m"}}, false},
+		{"grace duration below the check interval", &FleetAutoUpgradePolicy{Schedule: &UpdateSchedule{At: "0 2 * * *", StartGraceDuration: "4m59s"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
 func TestDeviceSpecValidate_OsSpec(t *testing.T) {
 	validCatalogItemRef := &CatalogItemRefSpec{
 		Catalog: "my-catalog",
@@ -3396,3 +3768,89 @@ func TestApplicationStatusTypeConstants(t *testing.T) {
 	require.Equal(t, ApplicationStatusType("Stopped"), ApplicationStatusStopped)
 	require.Equal(t, ApplicationStatusType("Stopping"), ApplicationStatusStopping)
 }
+
+func TestResourceSyncValidate_Sources(t *testing.T) {
+	tests := []struct {
+		name           string
+		targetRevision string
+		artifact       *string
+		archive        *string
+		wantErr        bool
+	}{
+		{"git branch", "main", nil, nil, false},
+		{"oci tag", "v1.2", lo.ToPtr("myorg/fleet-config"), nil, false},
+		{"oci digest", "sha256:" + strings.Repeat("a", 64), lo.ToPtr("myorg/fleet-config"), nil, false},
+		{"http archive", "", nil, lo.ToPtr("fleet-config/v1.2.tar.gz"), false},
+		{"missing revision", "", nil, nil, true},
+		{"invalid revision", "bad revision", nil, nil, true},
+		{"empty artifact", "v1.2", lo.ToPtr(""), nil, true},
+		{"invalid artifact", "v1.2", lo.ToPtr("MyOrg/Fleet Config"), nil, true},
+		{"empty archive", "", nil, lo.ToPtr(""), true},
+		{"absolute archive", "", nil, lo.ToPtr("/fleet-config/v1.2.tar.gz"), true},
+		{"archive escaping the repository URL", "", nil, lo.ToPtr("../fleet-config/v1.2.tar.gz"), true},
+		{"archive with revision", "main", nil, lo.ToPtr("fleet-config/v1.2.tar.gz"), true},
+		{"archive with artifact", "", lo.ToPtr("myorg/fleet-config"), lo.ToPtr("fleet-config/v1.2.tar.gz"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := ResourceSync{
+				Metadata: ObjectMeta{Name: lo.ToPtr("fleet-config")},
+				Spec: ResourceSyncSpec{
+					Repository:     "repo",
+					TargetRevision: tt.targetRevision,
+					Artifact:       tt.artifact,
+					Archive:        tt.archive,
+					Path:           "/fleets",
+				},
+			}
+			errs := rs.Validate()
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestBulkOperation_Validate(t *testing.T) {
+	labels := func(set map[string]string, remove ...string) *BulkOperationMetadataPatch {
+		return &BulkOperationMetadataPatch{Set: &set, Remove: &remove}
+	}
+	tests := []struct {
+		name    string
+		spec    BulkOperationSpec
+		wantErr bool
+	}{
+		{"When labels are patched by label selector it should pass", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypePatchLabels, Labels: labels(map[string]string{"env": "prod"}, "stage")}, false},
+		{"When the owner is cleared by field selector it should pass", BulkOperationSpec{FieldSelector: lo.ToPtr("metadata.name=dev1"), Operation: BulkOperationTypeSetOwner, Owner: lo.ToPtr("")}, false},
+		{"When an application is restarted it should pass", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypeRestartApplication, ApplicationName: lo.ToPtr("app")}, false},
+		{"When devices are resumed it should pass", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypeResume}, false},
+		{"When no selector is given it should fail", BulkOperationSpec{Operation: BulkOperationTypeResume}, true},
+		{"When the operation is unknown it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: "Reboot"}, true},
+		{"When the parameters of the operation are missing it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypePatchLabels}, true},
+		{"When the parameters of another operation are set it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypeResume, Owner: lo.ToPtr("Fleet/f1")}, true},
+		{"When a label value is invalid it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypePatchLabels, Labels: labels(map[string]string{"env": "not valid!"})}, true},
+		{"When a key is both set and removed it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypePatchLabels, Labels: labels(map[string]string{"env": "prod"}, "env")}, true},
+		{"When an annotation uses a reserved prefix it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypePatchAnnotations, Annotations: labels(nil, DeviceAnnotationRenderedVersion)}, true},
+		{"When the owner is not a fleet it should fail", BulkOperationSpec{LabelSelector: lo.ToPtr("site=a"), Operation: BulkOperationTypeSetOwner, Owner: lo.ToPtr("Device/dev1")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := BulkOperation{
+				Metadata: ObjectMeta{Name: lo.ToPtr("bulk-op")},
+				Spec:     tt.spec,
+			}
+
+			errs := op.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
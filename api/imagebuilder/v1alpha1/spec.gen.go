@@ -19,140 +19,180 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PcNhLgX0Fxtyr23sxIspN96GqrTpbtrDZ+aCU5+RD7UhiyZwYrEqABUPIkpar7",
-	"EfcL75dcoQGCIAnOQ5H8iOeLrSFBPBr97kbjtyQVRSk4cK2Sw98SlS6goPjnUcl+BKmY4OZXBiqVrNT4",
-	"Mzk6PXHvSAYzxkERvQByZZ9BRmw/RMyIXjBFJJQSFHBNTQfmMeVETP8LqZ6Qc5DmQ6IWosozkgp+BVIT",
-	"CamYc/ar700RLXCYnGpQmjCuQXKakyuaVzAilGekoEsiwfRLKh70gE3UhLwUEgjjM3FIFlqX6nBvb870",
-	"5PLvasLEXiqKouJML/dSwbVk00oLqfYyuIJ8T7H5mMp0wTSkupKwR0s2xslysyg1KbI/SVCikimoSTJK",
-	"gFdFcvhzcnVA83JBD5JRMsvZfKFTnZvR/PN3o0QvS0gOE6Ul4/NklHwYm6/HV1RyWoAy3TT78WPTYfPw",
-	"ed31ifgx6PjDeC7G7d5vRsmR1GxGU30qRSHM7M811RVuO80yZp7Q/FSKEqRmZvgZzRWMkjJ49FsyE7Kg",
-	"OoIdrndiG0zI28TAkzIO8m1inuI2nhR0Dk8qlmeEui/+JxEcLNYAgQ+lkPo59qHcDuLHzRT9hwjw3jLL",
-	"apoztYCsP8cLWQG5XgC3CGpn+o3yHRIJM5DAUyALqsgUgBNVpSkoNavyfEmuJdPafG5x8phqmov5iYbC",
-	"bciEPHcLZZxpRnPipjMiNM/diGZAIH6ehGpRsJTm+dJ+TgvgWWGoc9R8kWUGoxklp0cXx//aO31zQZSm",
-	"UhOqmq7+iVuGRKEl5QohZmbbtNABDIDJcA2kpDpd2BVDFkJ3KkQOlBvwSqDZchVoqSY5UKVxVxvoNUCu",
-	"+YNdGmGK0CvKcjrNYWhIJfIryJ4hbvTHfkULjz+IX7YhqQmT6AXV5JrlOZkCeSAkuabqIakUZA4v/Wwm",
-	"5GhqWJbHV4/DzfwNdM3remu40GQJZjiaLSMoiSt4XzFpUPLnmoBqSIYI2/AEyybN4p8wnjE+v8DnPagv",
-	"gJgvzOqntqGfOTOQIFNDaiFjAipzM6rhpxsyoWAKz9zXwaMX2NHNKMF37kV/qvjWTzIVfMbmlbSiYUyg",
-	"mEKmSGr4zIylVBsCapZhFtBmQ3pTePTX/m7dDuHb2F48+8CUZnwe0MwFlXNAnKR5/nqWHP78W/JnCbPk",
-	"MPnTXiNm95w020P89BzYfv2EKkhuRlux4bSZgkH/1UQR0rgWpCozqiHKPF2367ssqTRk4nr2pBbt1CB6",
-	"rL/XpWPoToUY50bmEtu8oUz3lgDXcjkhL6m8zMQ1N4xDVaWhdMgGxi1zmlp49fFEMT7PvfpihzIsq/5q",
-	"ZLUcg624YMkKKpekKueSZkAgm8dXqy5ZeUb5PLLgcyiuQBJp3hpAurGVZVAp5b73jElIdb60ksbN7AFM",
-	"5kauvq329x/DPw8m+5N9gj/Sg8njyf7b5OHgjCJAOGok6nYTMYMwDUVAiMFo7gGVki6b393BnzLzq2Cc",
-	"astKEcga6QFJOKTbCN1FqHiUXA2prg7w9V7bL/yoHK5bJNJGuChD77CGd6POgKeWp/sFGbDSsgSeKUIb",
-	"nBNGJQa3unAOE3KGCi1khBUFZIxqyJeE9ek5E2BFEHYzsWyq0aHWyw0nhrVwulcgPLRoqbRFdpmMkvep",
-	"uH5kMECJ+tc4Y+py7EXlhmKlO80fXz79IenP/j/Hr396FHl+cv56qPVTpi6Pm9ncjJJG7Vyr7rYhFeir",
-	"3p4xWzh1j95XxipBBTXQFbzQgg+0KHPcARqYVgM2wSi5ZDxrjZqMkgI0zaimphOOjDlJgWuhxlMhdDpW",
-	"WgIt/jHGKSG9l5CaxtNGFrvtQKF/g2vUhvQcseB0Lc9PiuW4UiD3WkOkldKiMJtuWl7QeXKYXCH/QT2m",
-	"FIppIZf2cwlzprRE4rdSoTtG2HdroPYIbmHdId5XdDlmIrkxhNiWirRlvq6Sw4Ghe+MGtegfY5SGlRlS",
-	"iSmXjmc2lgNyyWYDJ+Q1z5ekFGVloJ9ZJfma6YXtSJH3FcilEai0AG2QRxGjpLe47FqVwunGEf5rUaq7",
-	"ph8Yz1D1rpkhmloNjtdi7+zZ+UWoSBsJgEwvIIfGmjeWOOMzqHVqKQpr1fGsFIxb3pLmzKgOqpoWTKua",
-	"hoyhPyHHlBteNgWnpGQTcsLJMS0gP6YK7t2WN8BTYwOyuHEZ0uKqPUmFhF+uDqag6cEvogROS/bLawTc",
-	"S9A0pNK1W4todG5am6+8zb7hd7Z9V8UNCMVhSLA2N7eYBtx0PKjo95oQ0xubMVAD6n8th72tkoXKgRmh",
-	"oGXpBrM6/MC6WyaIs3EGmhqzpW7Z8JGl41C48JtRIjhsoNS3hjWK/KrGrYHbsulYcCuYNrcnoojm+/GW",
-	"Rdx42gyFfG+oS2xoLPU0It8LbndLrHr3WRwYZ0BVTKmzz2OepTNjWRsksx2ESswp1MiBTe2fp5Va2L++",
-	"Bw4GK/n8/Mnrl8koORZGfmswBPKcshz/OKY8hdx+Yf9uGe+rlJ7B9TUTG2wSzHi4G7+UwSa9NQ62DBc/",
-	"2MhDZbibAFxrGhk4xrFgQJF1Smyw+dFtR4y4/RaZYVwXrdk9betRQ4wwaBYwQ4O0gSLWYoN6yO8RqFEx",
-	"pZ4HJrqodFkFHRX0wwvgc71IDh99990oKRivfx9ERF2jiEWtBzrfYJyDR3/vjlNSrUGabv73z2/fXr8z",
-	"/0zG737bHx08+tvNnwfseK/9rVv0mW/baC3mlcGT18cn1hdqDLM2oJ2psxIkHcYXzGoUbEsAuNUC9AVT",
-	"ehXSmPdWQ8vNX20kb8Uc7kgHrnXN9oRerBl8cxX1SW2f7DTUz1VDNZtt9dPt9EWLBKvx/RVcuy7OLDy3",
-	"tMTdV2QqsmXouvFOnWrqsaDezT51BAz3ZJDBvb4CKVlmfZCGYU+CzyY1fU/IyYyIgmkN2Shwin6jkDsy",
-	"hRGGe+GIfNg/Gzq1IjTbEwSP1woC++E20HJDfXJAdZAYobYaSc9gdu5dFkOc2TcKhDklDvWsOEG+QvnQ",
-	"DkRket3zehEX6RO9+goIVSTY5tXbuj6C0nRVq1HNTDeMoow6i1sN/fWg78HdAKQN+a4xeWv9Kez2PvWn",
-	"FeN8fvqT83HWwrc394+oQJ07B8qtvLnmY2JfTms0skiVNto45d0oahuZAgfrZtpP4HDouGA3+z60N9A7",
-	"MRVUxt0wP2GIX1YwIowrTfPcrtJ7nsfN1+Ts9CWGtYHTaQ4q2misQFflRIG8YqmlsxmTSqPnlmSAT9uO",
-	"nStGCSXHIr0smR5PqYKMBKNes1+pzCbEmJlUs2kO6BclU6EXBP08OKmcavDRXPIUZrTKURkjuMfxnIHG",
-	"8byhn8y2vxkllQJ5HC5j807e9D7t4rybVnv7Rx6R1iC89/0NYjW2CCMVqM5WEoO11nfoErECD3XfhAh0",
-	"0DjLCFvUrKNjt/Zjy7U1HVnCsZti06Yv7W5hbTQOtYjZgTM9q/328XXOqjx3PKDx8PfXa0OzI/K+okuj",
-	"5Qs538MXhrUeajqPB2ZzqvQ5AI+Pbd4SzQpo5Bm5NhIegJMHC6BST4Fq7LrOykqMSTI2H0VtA8rZDJR+",
-	"yuYQMz7NqBm+i62x/nqjiGiIt29iFDWEwr3GgbA3pLnOd9zGZMytSS9hQPLZ1+QSGouiP0Z060wzPqhG",
-	"1G+367XDK/wQo2AZgxyiSY7aVh76gFYT3uR1LLgOcGrhUzQNS0YXCmIF8uGMzZA26uQ/9XtDn24x0dhn",
-	"sRxDNoexneHYaNr90Kenhzpa3QlENtr2qliqh3Wg995h1HHn9/jCI3MWTW8RmnMf3kNszvb82QWSOtO6",
-	"60hSNyY/GYLHNrEk1+lGwaRjyxy74aR7iR5Fl9QJH0XbtCa5oqt2CGmgq05kKNqqHRqKd9SNDa1oFQaH",
-	"Ygi1OjrktvNuwkOR4TvxoTAx6XRhyCeugZhXqJLXCYkuH8uKW9WZ6PsKKoRoGu5l6XcsdftiVEIL/K0X",
-	"FUz5P/Vo8ddD+BQ0ieJSqws/33gDh0IdsK6InTQNIsGTPp/4mNGT2Oi7DJ9d/GRt/MSpC6u8o2GTTdyj",
-	"Vntel2wT6L1rbe3Gdf5702mifb6LAeNiQx9251BEs/hNHNvrGGYzlSDM2Z3uLX2VzdebOyub1Q2d3FqZ",
-	"y9RNHt7OmdZC1UHvl5vKOoRf5e8Km2zu8Ho2AJvf4ZtqutyWl6/0Tm3jG3LG+sd2DtlhMUU99A/1zlRt",
-	"4S/yJ2NuQyz+Y6IlTS9Vo1O5I1aEag1FqWPhOS0IbWXgJ1+Qmf+5GMTN0dLtbeLusdQ7NYt955+dZdyf",
-	"2V0bxw1ZROzj/vDbmMhN1yus5J8oM9j8XMj6jLBCIxlJcoWdjHTZ/uXtwFFyVB+Sfb61nTO05vhEV37S",
-	"WsXKlj2Leahh22geatUGzvqmAeRWNu6BdRhRNrC5G/y4G7M7PomO5e0brbASW20ihmKUZj6mrTgwgc1V",
-	"jEaSfhqL8UuxrRqx45XcbfSOzMDFm1r1GfEH6mFw6r6POa0qBysOxtY4GSl+4I+fa0EYT/Mq80emh88y",
-	"EnQ5edS6psY8Z5xEOB+puGb54Gn+0KGBJw9tlQa4AswcQYPfHq93GuBGmBszPqJh2+FsqVfrMqUMujYg",
-	"YIrkjF9Chs6Ji/DE/x6GhBqAl1JkVQoZmS5tLzYkawgmv6ZLVW9Dtn4ftsyU2TCHqq19bYfIT0GZ0dBk",
-	"gtBi6jOiPjpvYR92yc1ssD9Cv+3B+WED0/W5AagGbMzXUwXy6rYAqXHG9h47gX4KchyUHaEZ46AUUVVR",
-	"UEOorzlYXImdb3nQr0rxEOOywn9VggzIMOQ3E/LGOt4IbSqfKDKFVBTQlOIgQpoGHK5bVUsidUg2pu6h",
-	"AjQRIl9pjzd2uPOJ1IIptPubSgV+v3I2g3SZ5nBLSbrWXkflCbKjmPXMClCaFmU9rUIoLHdkHaVNlZRa",
-	"/yIP2AQmI1+qxZr8gSBwRr8LFxjbf211moe+vAnrwmaBe30F0la9oXYlm3sPfBGTjRZfF8cJFu6nMiHn",
-	"YMRN2pSpCeaJKWeGYNIFKOKVarvlE/JK6NqvbNi0qqbKEAHXQVGdTRe13knRFP/olBvw/tfrBUhbj2ch",
-	"rgOVoKstWNGDTssZAytV8HxQ6Jxd562NlUkYFLRDlUxGySu43qCHdquaH/9Oz+9Ap+uUhqG13Lwb2LIn",
-	"0XjcsSgKRDHIM0XUgkqLRTTPSawXckUlow6j7q48SyoB2ZcMCrWsyTW+i7Itt0oX36iMxyes0xFJSA9h",
-	"NeptVDO5dxuS/8UWgGn42EClkw7tjTYrfbKBwdzMtTfEUMPo0DejJDzA3Ddig2TdXpUnlyRsXitjWVhm",
-	"btTsOyrwFC9qtXF9pyj7+aJKO1ne8fFKO2VMlTldxjv1ZuyiKigfGy0XNUv3EeHd80od9/v2haSMUher",
-	"ItXvfosyUndQwKhDc19e7SKLV4rQPiCHChW1oik2sd9MeM6ugJMOjhOaYzU850pA9/SZw7sfor6qM2/P",
-	"L0tQqAwrZ5i0jSWQ5Oj0JNyMVnGddrppx3MW26chg9FFItF9JkFXkjv3mdmp1B2/oJpkgn+j6xZCL0A6",
-	"59kdOhhTkcWqj1XzuTUH/3VxcVpPwbRtInY2XjMi+2YHudBEgW7py4zrx4+CxFiuYQ5yl4Vy51koStFY",
-	"CbmjHjNtXvsDZT7sbeFYwopEdjkQ7jkiBU0XjMPgUNeLZWcAs9HO9/UW4ziVhLeJmw+eArUF/RAFmCJQ",
-	"lNr0YWwkpggXCHGDaaazpiooOSIu+pTmVLoDl9yisVssovG00k09NFEfS2U6XhVvNSHXxQ088NAlI2aH",
-	"5G1ybs3Wt4lR0oOV3jvaqBLSMeXZ2IF0rc4b8427hTs24TGgQbqYarRBELQHSfO0CfwYBlDZA0Mzkefi",
-	"2pD+D9UUJAcjWo5OT0i4XPJGufNgqH5hgD7LDCNxqS9WETRcvc85c6r0ha+Ae8GGDmq08yiauTbVcyGz",
-	"7AX93xY1sGwfsu4tsiuGCPpfhpyJpzHXjhjdNsUiLSQDTVmuCJ2KSrsZ++lFUVs452Vd6UUMZJFM6jDJ",
-	"ZO5bWinVhoZNLNHEnuCrSjtsKBP++m1UJgwzlwdTyWD2kMh2VNmP+Y3aaKWb5ResRt6BfANPJhFcuhOi",
-	"Od+IAXmIjOoy2Rd4qPM5mg7kDb/k4roVVTXvMY6eK/O/a7Gh1diZneur87TuuvPYjzS0dB/Ci8Y+zZsg",
-	"y63GTYuQasn1AjRLg8J7RaU0WdArPOKa5hXanDnGoijP0DsjKuXFodOyyFGjZxg9wnRABM+XNXx/a5Jf",
-	"RqSe2E00Z0wzXkVo+iVdGtXCkIxztOL5L/ObkpwVTBNhxSSviqnRAGdouiinlEFmC9s7JlDXGETZjhoQ",
-	"umsLI2sQQoGkNGTt5aso6fsKfI38Kdia11oQphS+sApocMqro0UZyNs4CEpujEdqYaYpGVyBM0E+oJ6I",
-	"QZImfFCD+9iCyewNlshUTGmjl2FfZlpOESuFUngG2IHMrbTtQzDrTheUzyEzshdBoBeUE0pmcE0KxisD",
-	"LtzTkiplFDsDknrH6wsMrJO1hrZ1NFfKKqNMkXprHSjr0uEMo70pzWtIOUjbvaxd06oU3JBmxXNQiixF",
-	"ZecjIQXmQanFJXCfiAZSmuVYXjKgpxX23L2xmY5FxQdS9BqMCpzfFrncPBHw1wuWLjCiY8DfjlzWG10v",
-	"xWluUD+1yFLHoDKS0ynkBM+DG6gqyCE1ig3W+ujiuV9HPSlFKss3bNn6Re0Br4Gew0yTiiPx8KyuI0Ky",
-	"Cs0JBZLRnP3qdLRworiPNj5AHgBDTJ9CSitldEKrcGqSLip+aXoSzVsEgfPAoxTCRg+b9UhwoLMY2F2T",
-	"XYgxTG6/ktrUEWjCIo5fHUwOviOZqA2zYAyL5UbycrONlfLenD7emJX9BZRmBeoXf7HUxn51x1BSkZv9",
-	"w0kcownlb+kw40pATjnUtxY15zMSHX/AB5rqjRSGm01laJCeGInc1u/MQttShOY5KQ0LQDM4KkksYTiC",
-	"UPiFY2XIxF3bxs/WsdiNWUl94DLu9YsUze54YHxjq3YtPUNkLT9cACUzH6edYKRtwHVaa7rOtjaKnV3K",
-	"FqG+DHK4zViOCvDzbcabr9Bij4hlcalnMS3PQmAsBBquo4wsTHmYkFNfobeG91I5BxfNxkZB2FDpRXa4",
-	"cvuDCi9/fTxahw0vKcZNnf/kEpa1eoN3o/iK7YF0F3JOuSFo084oEHMhzc8HKhWlfWqZ9MPQ99RDKr5Z",
-	"mUF7o0+krlTfLrnmIGOb2L6pQ1xjJXq8Qsg+NwoeeYsG7J4Z214oE88tt/eFBMI+MiCvVSMHVByWtXO5",
-	"rP7xjQquHHLKQ+smo83ixlEudkp1ugiKofmUhC2CBWKA+hqfjBaG5RlwhUYCzbLE35CAfxXiCsnPTCbm",
-	"+Cyp2dj+3v37/PUrcioQSsQ0iof8DLbGp2qVKGfeC1lfwDDpWWSiTNw0YsZWOyHD2IaQVpLp5bmxAl3B",
-	"HqAS5FFlVxI1GdsfxU3HoJuhvW2PZH89r3nHv3+6SEb22i0sW4NvG6gttC4HOxZyfpLFAfnmzclTT5WW",
-	"BQQmvaOqRheeEPKSls6d0fqgEZsTQ22YLsOx8jpgsSZXiUHI+S8sqJJAS/YDYDKMn+StQWx7wGILjM9E",
-	"bW/RFCnF6B15cphooMX/CgtKNJMzALHXY6EFIkVOLoAWySipZO6ArA739lpf30TPlZA6kBDWPmr3PXnL",
-	"L9B/7loUlGNxjKB8VKBumO+nrpivr6fhAqbhDQJq8pYnoyRnKXDrYHOLOyppugDyCOvvt9dzfX09ofh6",
-	"IuR8z32r9l6cHD97df5s/GiyP1noIkeSYTo33XXg1F700elJEBU/bG42M/tsdys5TB5P9icHjjyR1PZo",
-	"yfauDmwZHFwsPo7my2Dm81CBUc/JDEZh06alwhFd4XyFEdq+fmCtEWu2GoaU6sZGQIPEGYG1mmfFP5PW",
-	"rlFD2I9vz13vNTnTiHZ3M7rTWdn0lKFZ4dvbzcpQTEE/sKIqWvaawnJbfkKhFektxCEYsYLp1ix6gSQ3",
-	"YnJ4sL+/j1ko9ud+zDyICnIX+20uUNDC3angjDO7AB8ksoJ9aMreq7MV7Izd6WqtOcNBglUm43dGME6A",
-	"posQ6VltU60GaXBzRGuKma2L5tWEbkm0m3eoGGHfSImP9vdrrgrWmUDLMncnSvf+6/y2zQCbndjFMxfI",
-	"tjtW2Q+GX3x7h2N6t21vrCc0I7VahYMefIRB33Ba6QXq2Zkd9fFHGPW5kFOWZYBh328f/eMjDHkhBHlJ",
-	"+bIGMebvfvdRVnvupOsb7v2MVt2mc+Xz5Kf+/HUpYud/jm163XBx1rbAsc1bOQPOA/ZE2IsQ75iC7MIb",
-	"vdfwlZse7R7c28gxaGWohfBLHNweJWvfqNSGWdpt0ZbSXo35s2d2U5Et/7RXa51o4+HOBtBvCpx1Bus1",
-	"6e3QndYfWzPpW9UgW93nQBmym1HyFH0pq7Yi67a49VZ8D3rVQPPW67sZ5YWYrxnItLjlWDc7eXTf8mj/",
-	"Y8ijY8FnObM+gJ0EbEvAD+NasNXcw1liN6OYgbb3m6GNGyszsSxTP+0Rn28sPZ+uZj8/36LWuVeM0RPk",
-	"9WLHKdtyc5UOf5/68PAGfiI9ePKVMZ5vP8KQr4Qmz0XFsx3n6XOeqJ/ne4x8bsY5vl+hT3xKtnEXpv8f",
-	"wM7f8bYdb9tpVdtoVXvWKsaq5HHHBL4nlMiKYzJJ7xJZ25/hIK7c64jUdwKOiJDEVdt0559dUDh1lV8i",
-	"ro3VZvrPt7yIxg74JahpnyU723Gze+ZmH9UqJWNLoi7NzhEHZkgile746+b8teagq9lsbp1GgwpoLuaq",
-	"LpgZ0xPJc/Mu1ezKBW7ViNhrAZT9NmdXrlXqyw/UDW2UzOWuf7e/T3LGY2HM71c7sT5PBddCwQLBhclE",
-	"pfIleZCzSyCX1RRSndv349nDKCQvAUr8mtscQyJK4OugSXPXK+Yz5ULBcPwTj5bctcas4YPegyvg2t0Q",
-	"0aaKTjRbzOs7UalyeZzjc+CaPLuy2ZQWjg8w69hO+J8GwhYrQ3g9jGcXmdmUOWV882lgc2K+bI+LMCHu",
-	"BGt3B2LD77T+nda/k0qh3DECZ7VIam4pXaH9u7Bk9+LODCQeGvAnBVxVBiI4jEgqyqXNO1eY6moPzPmc",
-	"CLxl0J2DxOOzOrjbbcKEmdnYTW3sRqgTmG1qSipkhkLMnUtYHRxt7njdVqC5ogP37e+9z8Bt/4LbzzKS",
-	"u7Np/li8mowjxONPQSO7+CRmTzAbZEq28APvV3vYCZsthE0gSroyB5y3eH2WZewikoE0y8YFvcuz3OVZ",
-	"fuw8y3t3/gXXCe08gLukxU/G+S3v3jxrscPBV2rmQ1lxd01Fn0TdDYfeJnNxMJuw1+TWqWxB7svQaFmv",
-	"ye1HE9c8FzRbPV6k0e9O1RsabN5+f0fjrMwJbJrskgJ3SYG7pMC4hOkbF7XpMGBSbJ8XuFY+PV3D+TaL",
-	"gESG2aUG7hzpO0f6Z81/1uYGruUe369SLf6wrGONwrvjHzv+sdNfVugvt8zAcxd82hQ812MrB6+5B/t3",
-	"ZuHdHTv7AvPwPjvGtuNrf7BEPEcju0y8O2C1Q7l4HY5bO5wGg1K126qr+zXX3NXhBAlzplxR+44tudar",
-	"9QWphCLVEM8y8yGdKeMUQykb5GaRMamv3SLTXExJPezNKHm8/6i/IXVM+QwyJiF15T4t6G0Pb85eJKNk",
-	"ATRzvrUXIvXl2YbBcIMj/i1SugiKUkgql82Y9zT8ToTsVOO749cfA5dO6tpzNo2UPJNSyC9RXHhBsEZg",
-	"bJ293WXaYdKx63uD/G3fctsE7qGIwyeVOPeTwu1htFEOdw+iX2ESt4PBJ8viXjH+znu0E5E7k6Yto2KJ",
-	"3P7Gvk3y6gYubR9IrTttut5l1+2y6/6A2XUew3cJdrsEu08rAMrmUr9Nc+z63Dy8wJkGelZY5dfdDKLE",
-	"TF9TCfVNhysz9JobB+8xSa8Z5FPk6XVG3x1N+QqSr8g4Qkudi0D5wO2fO67V51p9zTXQTocV1+2zt/qc",
-	"b2UCV8i+tveBxAfbpXHtDOmvOVy544FxHrg2d2wT3lU7b79GxrVeG9sxsJ0n8KsxBKlOI7ca4c1MqwxB",
-	"e1fk2fNj8td/7D9ydyCZj1yamOc3yl2jb5rvqRLSPdvDnnU62iuBFHkgJNZwSBcszyTwhxglaY6nWCce",
-	"XjpI0xRKvLHcRY9mro+CLu09plMgNMsgIw9oWQK3l5c9tFcE+uXbe+zc3ZuMk+eU5fZSTXf/v09hs5fQ",
-	"tDkorvXz56GbmtJjxIP/sR0irr/TayND+ytg7TvOvlNNvwJZUkVU0zN7md0q9dRKDCMbJu5JRzYEXJz8",
-	"v//zf61/sZq662PtPcqGmRu+T1RVgnS3MeP94ZWU9XXLVqr4u92cUHFXQ7trlSfkKM+JvRcaKwrZSI0f",
-	"oXcHstJCgr+OUkhCybf7+4Q1wZY7lTwOoH8c2XO/btyPL112ruOdWPtCE8RTwWt2WZUZnt5wL3c+6Vv5",
-	"pPEWVrzHHJmyvalyLzEM0vXZu727MZwEH7wT0jHmoGhSP6oe6SlW9yjsymWJbNTXQK5H2F0DqZt3N/8/",
-	"AAD///4Ti0Z14QAA",
+	"H4sIAAAAAAAC/+x9i3LcNrbgr6A4UxV7trv1sJOb0dbUXUW2M76xY11JTmor8k7Q5OluXJEAA4CSOylV",
+	"7UfsF+6X3MKTIAmy2YrkR9I1VROriefBwXnjnN+SlBUlo0ClSI5+S0S6ggLrfx6X5AfggjCq/spApJyU",
+	"Uv+ZHJ++tN9QBgtCQSC5AnRtfoMMmXEQWyC5IgJxKDkIoBKrAdTPmCI2/y9I5QydA1cdkVixKs9Qyug1",
+	"cIk4pGxJya9+NIEk09PkWIKQiFAJnOIcXeO8ggnCNEMFXiMOalxU0WAE3UTM0GvGARG6YEdoJWUpjvb2",
+	"lkTOrr4WM8L2UlYUFSVyvZcyKjmZV5JxsZfBNeR7giynmKcrIiGVFYc9XJKpXixVmxKzIvsLB8EqnoKY",
+	"JZMEaFUkRz8l1wc4L1f4IJkki5wsVzKVuZrN//5uksh1CclRIiQndJlMkvdT1Xt6jTnFBQg1TH0eP9QD",
+	"1j++cEO/ZD8EA7+fLtm0OfrtJDnmkixwKk85K5ha/bnEstLHjrOMqF9wfspZCVwSNf0C5wImSRn89Fuy",
+	"YLzAMoIddnRkGszQZaLgiQkFfpmoX/UxvizwEr6pSJ4hbHv8T8QoGKwBBO9LxuULPYawJ6g710v0HTXA",
+	"O9ssq3lOxAqy7hoveAXoZgXUIKhZ6RfCD4g4LIADTQGtsEBzAIpElaYgxKLK8zW64URKoA4nT7DEOVu+",
+	"lFDYA5mhF3ajhBJJcI7sciYI57mdUU0IyK8TYckKkuI8X5vuuACaFep2TuoeWaYwmmB0enxx8s+907cX",
+	"SEjMJcKiHuof+sj0pZAcU6EhplZbt5ABDIDwcA+oxDJdmR1DFkJ3zlgOmCrwcsDZegi0WKIcsJD6VGvo",
+	"1UB29MFsDRGB8DUmOZ7n0DelYPk1ZM81bnTn/h4XHn80fpmGyF1MJFdYohuS52gO6BHj6AaLx6gSkFm8",
+	"9KuZoeO5IlkeXz0O1+tX0FWf3dFQJtEa1HQ4W0dQUu/gl4pwhZI/uQvkIBkibE0TDJlUm/+G0IzQ5YX+",
+	"vQP1FSDVQ+1+bhr6lRMFCTRXVy0kTIB5rmZV9HQkEQqW8Nz2Dn56pQe6nST6m/3QXar+6heZMrogy4ob",
+	"1jBFUMwhEygFBWSSYqkuUL2NWdImQ3IsPLp7f7fphPTX2Fk8f0+EJHQZ3JkLzJegcRLn+ZtFcvTTb8lf",
+	"OSySo+QvezWb3bPcbE/jp6fApvc3WEByO9mKDKf1EhT6D1+K8I5LhqoywxKixNMOu3nIEnN1TezI/qpF",
+	"B1WIHhvvTWkJuhUhprniucg0r2+m/YqASr6eodeYX2XshirCIapS3XTIeuYtc5yC6M6s8EQQusy9+GKm",
+	"YhSQ6zUxUo7CVr1hTgrM16gqlxxngCBbxncrrkh5hukysuFzKK6BI66+KkDauYUhUCmmfvSMcEhlvjac",
+	"xq7sEcyWiq9eVvv7T+AfB7P92T7Sf6QHsyez/cvkce+KIkA4rjnqdgtRkxAJRXARg9nsD5hzvK7/bk/+",
+	"jKi/CkKxNKRUA1nq+6CvcHhvI/cucosnyXWf6GoB787a9PCzUrhpXJEmwkUJeos0vJu0Jjw1NN1vSIEV",
+	"lyXQTCBc4xxDmCKwuwvXMENnWqCFDJGigIxgCfkake59zhgYFqSHmRkyVctQm/mGZcOSWdkrYB6SzQxD",
+	"Fe7ac3zjOmAOSBE4DkIx0hsiV2j5KykbUnCRXSWT5JeU3RwqpBHM/TXNiLiaeu6q2CG+SSYJLkgySa5X",
+	"mfo3xSmjGZ6qfuNYVXvrP7x+9l3Shch/nrz58TDy+8vzN32tnxFxdRIst93oDN9Efj1+/TLy6w//fBZr",
+	"a7erFqH4qYb8caB8xM8xVE+skqVPb4KwMDIOoejNyUvzKyI0g/dNXQUX2VdPFbx58dXTkYDuLO7YDtL9",
+	"YEZ1G9Ky/0ado7nNumOtVKp7NLc//VKBMCIbDgQ2LznAe1yUuQYfDvTbHsVsklwRmjVmTSZJARJnWGI1",
+	"CNXcMUmBSiamc8ZkOhWSAy7+PtVL0kS3hFQ1ntcCkQWrlrxu9R6lon+WYunlGsabFOtpJYDvNaZIKyFZ",
+	"kUxMywu8TI6Sa80EtDBZMkEk42vTncOSCMk1BTasuT1HOHZjouYMdmPtKX6p8HpKWHJ7e9sWTXDDhjAk",
+	"DAXWhls7qaFBMW6l+InC75iEbxlXrb5pVlUf4Ay9ofkalaysFPQzo6kokmUGEuiXCvgalZjjAiRwRASS",
+	"vGqyuo1ynRksxgQNSrX39B2hmZoJO46k9d0ax53scfb8/CLUZhQb1pynbipqk4oyhxC6AKfYcFboUYBm",
+	"JSPUEPg0J0AlEtW8IFK4OyQ0yT/BVDGUOVhJMZuhlxSd4ALyEyzgwQ0qCnhiqkAW1/DDuzh0Jinj8K/r",
+	"gzlIfPAvVgLFJfnXGw241yBxeEs3Hq1Go3PVWvXyhpOR/Uz7tp4RXBSLIcHe7Npiakg9cEhna2tOH/Hs",
+	"tg7JqcIKszHH7A15ZQstfIaH1lXHcItNbYRLg68pxYNRww4iOzipuFY26jadFcpVd4Xj762GzokbPXZ/",
+	"C0zJAoR8RpYgZJwRZ/qbW43htq5fbI1Owy0rbQUK2PNmI0ID3sM40quRd5oghXFkQUD06OlOYPZGhSyU",
+	"4tUMBS5LO5lRtnvA3rAVWGNET1NlX3Ata16ztlxMb/x2kjAKI7TvxrS3k+HGjYmb8kuNK6MV/ygx8uN4",
+	"E0DcyrElBmuhf6RVo6O6+FH0cTdEL2/njgPjDLCIaV/m95gJ+Axwtq7vdSiUnoJDDt3U/PO0Eivzr2+B",
+	"gsJKujz/5s3rZJKcMCXjSVBE9AUmuf7HCaYp5KaH+XfDyrZRwI3tr15Yb5Ngxf3D+K30NunssbdluPne",
+	"Rh4q/cME4NrQSMExjgU9GqfVNusO8WPXGHH3I9KamBmiuTotO5NfsWcxd1I/msOgDNIccxAoXWGqtGRc",
+	"ljmBzPkH3pxbqo4XEgzy4yVQbfMmVEic50q4OsXplVayMYf6d7QgXMiJ6kXRguTqO82sQYZxYttrx4Dx",
+	"gy0IVU4EpIQvMUFXwCnkCPNlVRiFSbfhcKNcEUbsNUq8ofHGitYkQMFsMRtOvRQtZX5LJPJagvpVMrU+",
+	"Bw6vkG3Jmd086xhnzohQnoPsrdp0xPKzFhKKzMBELcS2386GBXSrOUxzb68rWVZgOsWVZFNre5WkAG7t",
+	"deOXobGgO/8L9bMxwBCaE6qPVCo8ux/4q/HjyzG4dBrX2PTPam7F7Zywk8ECV7n0eJihXxk1tgoFKC73",
+	"Ss4kS1l+mSDG0WWivVxToFn4xYH26/2v9/dkWipQojPD7UQwOKE9O98Ia3N7jt3liShv7etlgD3xRGC+",
+	"Rlqf9t42J+IFl19t0SpZqr/bVsqoYDn8Q8r1+f7k4ODLw/39rbGlNGTlLLiQg0bgs9PXG28vmsOC1VRK",
+	"iYh2GjFDFytYIw4FJjQgKv1nMA77TlvbWA/sNbJBtSv3VW3FrnyCmPU+5GtzdWqzrDsFWVTvHRaqf0+f",
+	"zA7xlsdwOyibP2uagPrYTtAskNHNdaq/hNK57PObBRagmA5DAxcPq2RZBQMV+P0roEu5So4Ov/xykhSE",
+	"ur8PIlp6bUOKTSTxcsQ8B4dft+cpsZTA1TD/56fLy5t36v9m03e/7U8ODv/t9q89fiBvuNq06RrNaoOL",
+	"+qTEF2VE1b50sWoDWtlNkg0gacnjwaomwbEEgBvW62quOIQ5rlGAN9jLENqehptse93HNJp4VLCKylMs",
+	"V93ZFUjxXLC8koBKrLlSPRRSrvgVSVfeva6JpwV/vTIv28yQc/9YKcg58tk1cBuQEQ8E6V2dXpRkrRkJ",
+	"bWHA7CGR6Vsio+Mbj9EZXJO4K0vNwe1X7cwVUJv4Btc+hH+tWS3wJsExDyPjc+W/zyDTvwwhZKNhiJTU",
+	"8M3U0DJ3qUxgACK0JVFPEBC5Aq6YbG31Zfpv68NGRPvRnr/H2n9pY4wsDtIMBR70M1igohLa5ClAdrG9",
+	"2fZOdseTxhDOkkji8FKHrCKOwr0FZqUJIjTNK22hMdZbY+z3nEtZ55Up1ns49nBZHl0f9HmIxyI13kgf",
+	"b1ZMAEo5ZEAlwblRMbQbSpPOPA+oCtJu0tT8JRAFyBBlG7F4AA+1qDqAfup7gxQqkrKN6NzCC9O4h8rk",
+	"WJEUeC/Ro7cXL6ZfP9YIigV89XQKNGUZZH46e7xqOdETsu2eq27WwLY1CrrOF9ZmVrCsD/dIDl8IVAIv",
+	"iNCURrWdof/NKu1eMBBc6yUXjANa4ILkBHPEUolzJ1DlgDWO/grcyrYTtP/V06caEFhzIkhJYTuwSvb0",
+	"eXq4/3iGXi60n9udXqZV4/YanYKhZT01VwBNQiUsgQ/zhibnCo6lKcei75m0gWWYrmsfvsWnMRyqRY8t",
+	"xXUoNUxvX5GYFbr53XiWcmKsz3FD3r357pw43FzQqw2Tb6kK7Dxrn7JnTR228att5+cySDCM79/DjR3i",
+	"zMBzSxOe7YXmLFuHcT8+IqiaeyxwpxmxhtXa1ste7ebNNXBOMhPApojVLOg2c8K9JmesIFJ6QqYj6r4Q",
+	"WjUiJnTjQdQh2h/cF0Amdmc7WuCTjVqg6bgNtOxUHx1QLSTWUBtG0q6lYoBCdxo3heGGMWakTqZEi4rn",
+	"cb6mPqK3Z698JNewmrMsl+kK0qvuYD+uQEvekiHdQo8myJJiRXVEHSBqbS7d+dCzgEe7KItu2PeyXF5B",
+	"j0AabOTb02/RFaw3TKrFULVKG6o2G38z1Hwvn3WHnBixFhtLjBORFc2fqUZ9slwMryb+7IYxTGkOPpin",
+	"D7N8o4aga4mb0X8058K0745HTEZu5M1Kb2RMp6digepJNhCOzQHe9VDOeVSvdGSQ96S1uWHobwZ9B+76",
+	"bjQg33ah39k8Fw77kOa5gXk+PfOcjf5z4l1n7R/QPnduQ4vu5GhUnZH5OHdoZJAqrY29LtKzD5lCaVBs",
+	"Dh7V5FgPFVjrFoxrw37tuzBWTzU/4HTVGEHbVWw4C9ZsrBV3OkPHjQmZ4SQrbFSr4B3YDeNXwDXVNhPK",
+	"FWfVcoWgqHIjSjXYiI5h3U6laIcgFYS+NJ0PWmrGJKko+aUC+1mxrNtJGNk5Tn0JoljSjj96ZIhHs183",
+	"inSkRzXopNyboVEu5iRqW+Y0yEsOUwHOOodp+ETPnLmQjPsTrU13gvmAeDeskuLspS30+wPzto1R4wXX",
+	"jjRvJEgZpZBKck2kfZcAhHsj2B30yqb1MqJkMjpnmMfDqH7U3j1ewcT5lsx99ba3ad1bi3XqkhgPsYg2",
+	"mgqQVTkTwK9JajiGBoKOzkUZ6F+bgVnqXSBGJyy9KomcKlkiQ8GsN+RXzLMZUmEiWJK5M3nNmVwhHael",
+	"F5VjCf7ZVON2aWoVl9Lq4OKRsZCmvQIyJ8sl8C3O6cL1uJ0klQB+EgJh/DBvO13btN9uqnm76hu/gfBv",
+	"DMSMB1+mNsyxDsJsSGibgy43vw2IuAc77HgE1+gJE+3wgztcxUhEa+Q+3i1atAnL+wwP1dA8c7b6AXu+",
+	"bti16gdn4myezoTP+HJPf6C4gCOJl3ELfo6FPAfocRipr0iSAoIDu1HSOABFj1aAuZwDlnpo98A7ybCE",
+	"qeoUm2/7gNhwj653dCt3pwtBnPXA/bSt+6IV1crtEuxzOA46PgNrs4izGMWup9NDzmujx4mODEsmyXm6",
+	"gqzKYevYtsZyYyP3NfUTxnefMp4NEalGQ8T1f0QLCA408zXCDmhDCuQ4zcaN2gRu7Fmp2IbsN8+9aZsa",
+	"j8YNbU6pSRpH7N4h8+YzC6I4hpM+MPg7GkJZ3VQLk7EXtK3smkYWYpP2ibwbc1/uHjXpBvBpQ3RAVLDD",
+	"CIU2kYnu5lWSFVi6HAnfhz1TVq69fhQZxwmNOjTPLkSHm6kPbTNwv+lXKQdUAr/G1r6mhaPkKDlYJZPI",
+	"QRaEkqIqzIHOQd5Ae8vB3WmsCyOtd5JrQNZhhRYsz9mNv2dqSOUGQI/EBBWIcbR6PEMeyguiPR6AeU6s",
+	"CpXBArjCzopKYj2wdjc600WJhTKiNmS+A20jC/T5R/9+9NPB9O/vLi+zvz3+98vL7CdRrN5FVXrhaM+d",
+	"POWc0efv9btS0rmoluqFRxDFvhMN3Ra58oF4G660C+clNPweWv5EpUQdYQN7MRKQVlypIxxESajneVhA",
+	"R9TywvMoNiU2i5PNlp5Uuwc9Ri1fEeEifmTN4caImbZnD93eiNIFE1KtSWfVIFxI+xjP+I7rb0ClD2q+",
+	"glLeQUZr8raInKalJIubF71EuCktOVzWZDirYCwJNrPZJY2ZDPcR/Q57HTf9SPamF6AFweFbsXUsxtuY",
+	"htaHw53GgRFVqXqbXiI1UVanVEl7vQfms/YauIG6c0Q5t2rW7yVwX7cbtcWr/RSTYBu9HLrOibMta/ZP",
+	"aOsH1dSlAHBPqiXzmbmcaS8wAGVkofUYl/NJ/N7H1nYz0dfWxXoK2RKmZoVT5cHoPrb2F8NlHGg9fa69",
+	"GEOvtz2sSRB5cH/vnHcRC5/5W2CDpnd4DGw7PsBrYDPyJ/cssbWs+36X2M4CMOuDxzYvE+2go54mnhji",
+	"2H6c+CBvEaNbaj1GjLZpLHJgqOaDxJ6hWu8Mo62aDw3jA7VfGg60Cp8axhBq+K2hPc77eWwYmb712jBM",
+	"6nK6wiK2PCWBqE8motbmoTKsy7Jb0VroLxVUGqJpeJalP7HUnouSDQ3wt95UsOT/dLPFP/fhU9AkikuN",
+	"Ifx64w0sCrXAOhD1WDeIhD126cSHjHuMzb7LKbKLfNwY+WjFhaGok7DJmLATIz1vSt0QyL0bde46JOn3",
+	"JmeIjvkuBoyLkbFBrVyY9ebHBAxtIpj1Ul4Go7SWe8cYkLr3+CCQenf3nJqlzvg71LWTdG4733AD13vd",
+	"sXYpm27MkMUsbDLeA/u8B7i/wxFZD7ktMxh0RW7jCLTa/of2BJppfcobN0AnF+8WBiefUfUut813RpLj",
+	"9ErUQplNzYuwlFCUMhY3KZmKvAgyNyafkZ3gU9Go65Tk2yvV7XTm96pX+8E/OdW6u7L71q7raxFRsLvT",
+	"b6Nj10MPqNk/YqKw+QXjLre80Fq2vpIDirZ56Nf4yyuSk+TYJVd/sbWi1Lfn+EIHuzR2Mdiyo3L3NWxq",
+	"3X2tmsDZ3DSA3GDjDlj7EWWE0u473ZPeHl9ES3X3jQbUzEabiKYZvTMfUtnsWcB4EcMP8JFUzs9FOavZ",
+	"jhdyt5E79PNor6u52gKPxOOgWkMXcxrVMQYSqjucjBTN8GULJLOvyP3L1v4c2DoCvPSodYOJ1N7xCOWz",
+	"IQZ9VSBCi4h+NGuqe8A18LWzGEAWSICjMDemfERj9PqfsXy/6QmLQtcaBESgnNAr/azdgMcnHt4zWX88",
+	"wEvOsiq1bvGVjaA3Fya/wWvhjiHbfA5bPmEY+bilKX1th8jPQKjZbKBBoDF1CVEXnbfQD9vXzWfKSI62",
+	"L7jQr2DaMUeAqkfHfDNXcdt3BYjDGTN6LPz2FPg0KFeDM0JBCCSqosDqor6hYHAllm7xUbeayWPt2GW+",
+	"Vwk8uIYhvZmhtzZRFa4r5gg0h5QVUJdwsU/7VShDWO0mUr9m9O3uK1y0bWCw/+aMKo4xhXp/XeHCn1dO",
+	"FpCu0xzuyEk36utaeILsOKY9kwKExEXplhWGzAR01dcMQo/IDGaTOtZJTRAyAqv0m8PRuv/GqkaPfVkc",
+	"0obNSp/1NXBTLQmbnYy3HvjiN6M274oqBRv3S1HGasVu0rq8Uf3RPiPhgNMVCOSFanPkOpuDz8M2XysT",
+	"tlCXgMoasGKL2MsNxKMuGtNKJOgNuDcrsM+pVuwmEAna0oJhPWo6tCBguIoJcAusu5vMvbHyGr2Mtq8C",
+	"ziT5Hm5GjNBs5ejx7zQd9wy6SWjo28vtu54j+ybq0DthRaFRDPJMILHC3GARznMUGwVdY06wxaj7K+tj",
+	"osXqjIIjHtneR7mfO73jHVX+5SPWd4m8FA5hNekcVL24dyOv/8UWgKnpWE+FnNbdm4wrmTNCYa7X2pmi",
+	"r2F06ttJEubT7iqxwduzTnUw++ZNfRYIy+BN4D0VBosXQxtdFyxKfj6rkmCGdny4kmAZEWWO1/FBvRq7",
+	"qlTeXA4405Kl7YRoO1VJy/y+fQEyIqGIVR/rDr9F+bF7KHzVunOfX80rg1fu2dCoAlcNb0r9SmNJroGi",
+	"Fo4jnHNtQDamBG2ePrN4913UVnXm9fl1CUILw8IqJk1lCTg6Pn0ZHkajHlAzXrVlOYudU5/CaH435jMO",
+	"suLUms/USaX2NTGWKGP0C+lamHfz5iTu0cCYRhPCnVfLpVEH/3lxceqWoNrWHjvjr5mgfXWCOksbyIa8",
+	"TKh8chhNxbYLY7nXMBYhohkljzvEtP7sI/6DZ70KjiUMRMLzHnfPMSpwuiIUeqe6Wa1bE5iU2HoNl9qP",
+	"U3G4TOx6dAIo3d6gABEIilKqMYDrPynTEOeFGayuJouOkfU+pTnmPreSRmO7WY3G80rWdfSYy0jVk6BV",
+	"DF9kC8saeNokwxZH6DI5N2qryyntd/rgaCNKSKeYZlML0s31ZSK2cbtxSyaC14QO6WKi0dhcqNtZHY/D",
+	"Ul8M4UbO1wnCOaNLm9VTiu4DjKjcFBca3cBa5jBPPVegp1GYV2IuEVvE5aYVphQi2biOqc89bqkIsm1t",
+	"YmSXPtJnTeXsWiGka1y5J5QBpr/IAaR91IgpsrUGTA3PkuUkXdtXhIbE2fkkU3y5lhJE66llbF9EK/cb",
+	"3/AGB6IOAfLFdirdsbruJAvqs2LpDHW5Y/iOaoSzbUZud+B2L8Oq2wgvfmft6tfac6k4WKWvpj0Cxbu+",
+	"q+bAKUgQSsxA4X1Fb4XNz6H1B5f7DfvgL6PJKLGki8zmzZkr/T32jVu91rpsOGSGP2oHjqFtaiVUyx5b",
+	"hAf1caR/Kn6EPJOw7ZBSzlJd9AZlIDHJBcJzVkm7Yr+8KDYxa313lXP63vfPnJ9vtvQt69tdQ8NERklk",
+	"MqpUJaONjRMqv3paryMQavq546M5J7B4jHgzLMLP+YUYtdNxATLDyNsTMOPpfASXfhfVb827gYN6iExc",
+	"XqgLnWTnhWYI6C29ouymERagvutAkFyo/9oWI80erdXZsVq/uqFbP/uZerfefNLctSBypt/e2QbOOK+Q",
+	"wz4/1fIuKUDMLqnOJuKL5//8N2T/9/MRmqLXhFYSxBH6+W8/owJLbe/en3759xmaon+yinc+HT5Rn56Z",
+	"6pqvGZWrZouD6ZMD1SL66eAw6PwjwFV79K9ml/Tc6clWLGJqEVPV8Ai9ti0xXVsBzyZAUcMQilZqyX48",
+	"4ydWvz1W8/48/fkI6WLbvtf+9OufNeAODtHxayQZ+hodvzatJz8fIR0t4RofTA4ObWthnjgeHMoVKjQM",
+	"TZ+9n4/QuYSyXtae62MW0+5xbuuKN/bydQ0ShdtfB10u6XPzZFJBDu1Pv54cfDU9fGKPtCcZ+Kbs3gOu",
+	"BaPvmLaGk2g5Q21IUcRab8LtsBud0NzmqhxdKNgt69R2dn9/Ywfp244P24jGu6gvQWi0I+eGhos1lSuQ",
+	"JA3qw+r0/it83cidn2sZQh27ssizSngVyGrW6NgPoXVHNQBitC4n8Fsd8DhBbmG38UTxhFaRg3mN17bs",
+	"gHOu6UfD6m+MclIQnQVN/U6rYg4c6RyjUAiriLviX5ZvulK4qoPmhVy76ArGwRxvIDNerByaamUM/1IZ",
+	"l1NhluTETyJEBY7xh0+DW5ozlmbGzGhrOTGtOEhO4NqlNXkv9d7YInQZO3CfGDCps9GVnAUREqg0Y6ll",
+	"WeW7ZELoNGYWZHanTbux2rfJ5KDLLGkQ6FSDGC3gxt4/Yc7UJcAw1TXMiVsFyDrWHLSN5O2y1RGtXeij",
+	"taB0wjvRET4pzh2kzGcrsDp3pCgZVdysojkIgdasMuvhkALxoJTsCqgPPgbO1XYM++3RzQuTBFNpWCes",
+	"6qtQUGNU4PA0yGXXqQFvtBLMQYO/Ga3iDtptxWrr4H41yOLiDjKU4znkiHELVQE5pIof2Dp3TTz3+3CL",
+	"UtFFmtVqPDWAhDzzQM9hIVFF9eWhmUsbjbJKQUZhCcG5TZ7YXKg+R+MTRo9caRFIcSUAEWmMDBKlq4pe",
+	"qZFY/VWDwHpdteCmGz2u98PBgs5gYHtPZiNE/J6dOPMW02ZLjePXB7ODL1HGnDEumMNgOaFSu+rVNa9z",
+	"G7fxRu3sbyAkKbRI/jdz28ivuou6ork6P72IE202U/5PVuWZnpeDppR9Y0vmKB/j9g9Q1VpGydi3Y8XO",
+	"mkLHonXcN0TaXEQXSQSuSVAW5yTmYtgLIXQPS8o0Ebdta99Ky0pLKZObClJGCoy1rO6+sdFU1p4gkobv",
+	"JYCSWo8V6HV0xYZUVKbnXbJQqbXmcJe57C3Q3beZbzmg+B0jQ+JST2Ia1uRAv65HcTcjC8PcZujUF5J3",
+	"8F4L69TA2VQJCCP1RE0OB48/SLf81ZPJJmx4jXWsjPmsEpw48SavnGSQYhpyd8aXmKoLbWsiwZJx9ecj",
+	"kbLS/GqI9OPQ39BBKjqupJxuHysj0NkXu6HAY4cYGPuxROyGCkPb3O9KwEOX2mi5p+a+TPrfE00S1+uH",
+	"XjsUdaKRBaqeljTjd4388YXQVJUrw14zTWC97xGxQlEqdqq0h6D2hQ9D28JBzHpuX22HlwyVwBW4Qrkf",
+	"Z5lW/Mscp8YEULBr9Q+pFvNudOm1Y/Qf52++R6dMQ0kX2InbBBW2xpeqPzmLGOPILmrWMWKw0hUxi9kn",
+	"OjUaXf4ulRyqsNUUAHPgx5Vc9VpZmp3i1pZgmL6zbc5k/nrhaMd//HiRmIRq6oLZrzXUlLegd2DGly+z",
+	"ngIKb+uSBpYEBFYwe6tqWXiG0GtcWgtgo0PNNmfqtqkDJWqSXyrQmdMNYUgYX/6LBKl1cEm+A5Nb2S3y",
+	"ziA2I+gMPcqF4vQtnOqbAgUmeXKUSMDF/wqzENWLUwB5ob9oDYSzHF0AVpZhXU4jcS6ZRu/b6FtC5JzH",
+	"Yfrm5tjagkOEb1FgqjMqBbncA3FD9Z/beuI+CZMNkrGxvLqjMgwpmx1JgRqbtN3ccYnTFaDD2X5nPzc3",
+	"NzOsP89UmlnbV+y9enny/Pvz59PD2f5sJYtcXxkiczVcC07NTR+fvgzM6UeJz/OkztmcVnKUPJntzw7s",
+	"9dRXTbms9q4PTJ5bvVn9czRGUttv+upJeUr2MrNN65ZCz8hxAVIns/wpJh8YbcSorYogpbLWEdiiVgKd",
+	"mGfYP+FGrxF92K+/ntvR3XXGEenudnKvqzIhiX2r0l/vtip1Ywr8Xqe4DPU1obNH+gWFWqTXEPtgRAoi",
+	"G6voBA/YGZOjg/39fR15aP7cj6kHQ243jwcKpnodTjkzG/CBAYax9y3ZW3W2gp3SO226eKs4cMjrhLft",
+	"dCEKiDqzdoD0xOlUwyBVTiQznGgssZ06s5OY8t0kcWPrm3i4v98qdxjk7t/7L+vqqCcYl+ZB3U9Dtlta",
+	"2XeKXjy9xzm9p6Mz1zc4Q06s0pMefIBJ31JcyZWWszMz65MPMOsLxucky0CH+jw9/PsHmPKCMfRaWfYt",
+	"iPWbjS8/yG7PLXd9S72d0YjbeCn826i5T9pRstibT5dFtrdSUpPhmOaNODFrAfuGZesHuEFm47XcayuT",
+	"tO7uwYPNHINWpqUQeqUnN8+Hg25Hv7VglrZbNLm0F2P+6omdquH3lz0ndWodT59sAP06K2Zrsk6Tzgnd",
+	"a9LKDYu+U+LK4TF7clfeTpJn2pYydBRZu8Wdj+JbkEMTLUHe+yyv2HLDRK/Y8q5z3e740UPzo/0PwY9U",
+	"huGcpHLHAbsc8P3UMbbkKPimFxxR0PZ+U3fj1vDMHGQs1F3/Ppp7PhsmPz/dofCgF4xtcWV73S2lbPLN",
+	"IRn+IeXh/gP8SHLw7E9GeJ5+gCnV+9IXrKLZjvJ0KU/UzvOt9nyOoxzfgvwkycZ9qP5/AD1/R9t2tG0n",
+	"VW0jVe0ZrVitsscwob8jjHhFdTBJUMDFlFcx4ykKYnOET4yVXv+LcWRTNNucF9YpbKaFrEtiT4bV9J/u",
+	"WBXaTPg5iGmfJDnbUbMHpmYfVCtFU3NFbZidvRw6QlLf0h19HU9fHQUdJrP22VevAJqzpXBZlmNyInqh",
+	"vqW6WJsZeYJMLRlh+ubk2rZKfcoZ19B4yexzjy/391FOKIgN0m3XiPVpCrgGCgYI1k3GKpGv0aOcXAG6",
+	"quaQytx8ny4eRyF5BVAKX+2ZUcRKoJugiXM7qo5nypmAfv+nfo21ncQc27EAOTFR4W4R2umpFsEWZoHu",
+	"uXKYjZ2IbrHcyCrDNslk5P2KJPIewR0lvJd7oB6i2YpIzQvdcsSzJWKVLCuJsLAhqNNzoBI9vzaBoAYF",
+	"HumAaQPrfyjkQIv2UT+OB0ap1Zg3B6OXoZsj1bM5rz5OZBMutJEnNv1OYdkpLDuGGrJMxSuHuWldSHVA",
+	"cQnrcgacKQOu3zv4Rw42iRBiFCa6yCvRIfPC1HnVz2N9OIfES/9sX2d7kAI1XHcUbqZ2aVM7g4u9NlE1",
+	"usax4r/2ScWwX/d7uKlfx2/Fi22OnIc2VT+kz7nevFdCPkUn9E4d+2PRajSNXB6ftEOTi4+isbVKTds8",
+	"RbSbnGjHbLZgNgErafMcsIbuzQGiscJbPRGitfV8FyK6CxH90CGiD263DMrn7YyXu3jLj0b5De0eH3DZ",
+	"ouCDknlfQN9936KPIu6GU28TdNkbCNlpcucovCBsp2+2rNPk7rOxG5oznA3PF2n0u6MM+yZbgnyAeQbD",
+	"Gesmu3jGXTzjLp4xzmG6yoVTHXpUiu1DGjfyp2cbKN84501kml1U486QvjOkf9L0Z2NY40bq8S3IPyHp",
+	"2CDw7ujHjn7s5JcB+eWOwYO2HrWJHrQjNsIHTxi9Bi5/fwDh/ZGzzzCE8JMjbDu69geLIbR3ZBdEeA+k",
+	"ti+MsEVxncGp1ynlzFZt2a+uyurcCRyWRNgaLC1dcqNV6zMSCVkqIR5l5l06c0KxdqWMiM1CU+SqRKJ5",
+	"zubITXs7SZ7sH3YPxPmUzyAjHFKbqdSA3ozw9uxVMklWgDNrW3vFUp9Zrh8Mt3rGf+vOeAFFyTjm63rO",
+	"B5p+x0J2ovH90esPgUsvXdo8E0aKnnPO+OfILjwj2MAwtg48bxPtMF7ajj0i9Ny33Db2vM/j8FE5zsNE",
+	"n3sYjQo/70D0gePPP8UgbguDjxbFPTD/znq0Y5E7labJo2KB3L7A7Ji4um4l+6HQutN66F103S667g8Y",
+	"XecxfBdgtwuw+7gMoKxr0I6NsetSc3UnS1vMFwdyVpig2BY1EWwhbzD3FfgGI/T8TA8ZpFdP8jHi9Fqz",
+	"756m/AmCr9A0cpdadatpT7HqHdXqUq2u5BpIp/2C6/bRW13KNxjAFZKv7W0g8cl2YVw7RfrP7K7c0cA4",
+	"DdwYOzaGdjnj7Z+RcG2WxnYEbGcJ/NMoglimkYJMuqjUkCJoylyevThBX/19/9CWb1KdbJiYpzcCScyX",
+	"oDM27IkS0j0zwp4xOppqRgI9YtxU5F+RPONAH2svSf08xRjxEOaAcJpCKSGbuXiWhR2jwGuTbGcOCGcZ",
+	"ZOgRLkugpu7aY1Pd0G/flOCzZUMJRS8wyU090FOj4foQNlM/p0lB9V4/fRo6VpWeajz4H9sh4uZyZKMU",
+	"7T8Bad9R9p1o+ifgJVVEND0zdfiGxFPDMRRvmNlfWrwhoOLo///f/2fsi9XcVr41JaAVMVd0H4mqBG4L",
+	"SauGacW5qxRtuIovS2eZiq1qbStCz9CxqtaqC/2qNVlPjZ+hU75ZSMbBV9JkHGH0dH8fkdrZcq+cxwL0",
+	"j8N7HtaM++G5y850vGNrn2mAeMqoI5dVmenXG/bjziZ9J5u0LiDLrx1RNkU295Lbd37MTuHxWnFitLec",
+	"pSXMQdKk28mIkWJ5j8KhDN8dN1ZPrEc4XA2p23e3/z0AvorQhlwKAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
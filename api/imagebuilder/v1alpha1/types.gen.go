@@ -30,12 +30,22 @@ const (
 
 // Defines values for ExportFormatType.
 const (
+	ExportFormatTypeAMI                ExportFormatType = "ami"
+	ExportFormatTypeAnacondaISO        ExportFormatType = "anaconda-iso"
 	ExportFormatTypeISO                ExportFormatType = "iso"
 	ExportFormatTypeQCOW2              ExportFormatType = "qcow2"
 	ExportFormatTypeQCOW2DiskContainer ExportFormatType = "qcow2-disk-container"
+	ExportFormatTypeRaw                ExportFormatType = "raw"
+	ExportFormatTypeVHD                ExportFormatType = "vhd"
 	ExportFormatTypeVMDK               ExportFormatType = "vmdk"
 )
 
+// Defines values for ImageArchitecture.
+const (
+	ImageArchitectureAmd64 ImageArchitecture = "amd64"
+	ImageArchitectureArm64 ImageArchitecture = "arm64"
+)
+
 // Defines values for ImageBuildConditionReason.
 const (
 	ImageBuildConditionReasonBuilding       ImageBuildConditionReason = "Building"
@@ -58,6 +68,12 @@ const (
 	ImageBuildRefSourceTypeImageBuild ImageBuildRefSourceType = "imageBuild"
 )
 
+// Defines values for ImageBuildTriggerReason.
+const (
+	ImageBuildTriggerReasonSchedule          ImageBuildTriggerReason = "Schedule"
+	ImageBuildTriggerReasonSourceImageChange ImageBuildTriggerReason = "SourceImageChange"
+)
+
 // Defines values for ImageExportConditionReason.
 const (
 	ImageExportConditionReasonCanceled   ImageExportConditionReason = "Canceled"
@@ -187,9 +203,12 @@ type ExistingCatalogItemTarget struct {
 // ExistingCatalogItemTargetType Discriminator for the target type.
 type ExistingCatalogItemTargetType string
 
-// ExportFormatType The type of format to export the image to.
+// ExportFormatType The type of format to export the image to. Images of the raw format are compressed with gzip.
 type ExportFormatType string
 
+// ImageArchitecture The architecture of an image, as used in OCI image indexes.
+type ImageArchitecture string
+
 // ImageBuild ImageBuild represents a build request for a container image.
 type ImageBuild struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -211,6 +230,18 @@ type ImageBuild struct {
 	Status *ImageBuildStatus `json:"status,omitempty"`
 }
 
+// ImageBuildArchitectureStatus ImageBuildArchitectureStatus represents the status of the build of one architecture.
+type ImageBuildArchitectureStatus struct {
+	// Architecture The architecture of an image, as used in OCI image indexes.
+	Architecture ImageArchitecture `json:"architecture"`
+
+	// Conditions Current conditions of the build of the architecture.
+	Conditions *[]ImageBuildCondition `json:"conditions,omitempty"`
+
+	// ManifestDigest The digest of the image manifest of the architecture in the pushed image index.
+	ManifestDigest *string `json:"manifestDigest,omitempty"`
+}
+
 // ImageBuildBinding ImageBuildBinding specifies binding configuration for the build.
 type ImageBuildBinding struct {
 	union json.RawMessage
@@ -243,6 +274,33 @@ type ImageBuildConditionReason string
 // ImageBuildConditionType Type of ImageBuild condition.
 type ImageBuildConditionType string
 
+// ImageBuildCustomizations ImageBuildCustomizations declares changes applied to the OS image after the agent is installed. Packages are installed first, then files and directories are added, and finally units, kernel arguments and firewall ports are configured.
+type ImageBuildCustomizations struct {
+	// Directories Directories from Git repositories to add to the image.
+	Directories *[]ImageBuildDirectory `json:"directories,omitempty"`
+
+	// DisabledUnits Systemd units to disable.
+	DisabledUnits *[]string `json:"disabledUnits,omitempty"`
+
+	// EnabledUnits Systemd units to enable (e.g. "podman-auto-update.timer").
+	EnabledUnits *[]string `json:"enabledUnits,omitempty"`
+
+	// Files Files with inline content to add to the image.
+	Files *[]ImageBuildFile `json:"files,omitempty"`
+
+	// FirewallPorts Ports to open in the default firewalld zone, as "port/protocol" or "start-end/protocol" (e.g. "8080/tcp"). Requires firewalld in the image.
+	FirewallPorts *[]string `json:"firewallPorts,omitempty"`
+
+	// KernelArguments Kernel arguments to add, applied by bootc when the image is installed or updated to (e.g. "console=ttyS0,115200").
+	KernelArguments *[]string `json:"kernelArguments,omitempty"`
+
+	// PackageRepositories Additional RPM repositories to add to the image before installing packages. They remain configured in the image.
+	PackageRepositories *[]ImageBuildPackageRepository `json:"packageRepositories,omitempty"`
+
+	// Packages RPM packages to install, optionally with a version (e.g. "tmux" or "tmux-3.2a").
+	Packages *[]string `json:"packages,omitempty"`
+}
+
 // ImageBuildDestination ImageBuildDestination specifies the destination for the built image.
 type ImageBuildDestination struct {
 	// ImageName The name of the output image.
@@ -255,6 +313,48 @@ type ImageBuildDestination struct {
 	Repository string `json:"repository"`
 }
 
+// ImageBuildDirectory ImageBuildDirectory specifies a directory of a Git repository to add to the image.
+type ImageBuildDirectory struct {
+	// MountPath The absolute path in the image at which the contents of the directory are added. Existing files will be overwritten.
+	MountPath string `json:"mountPath"`
+
+	// Path The path to the directory in the Repository.
+	Path string `json:"path"`
+
+	// Repository The name of the Repository resource of type Git.
+	Repository string `json:"repository"`
+
+	// TargetRevision The revision to use from the Repository.
+	TargetRevision string `json:"targetRevision"`
+}
+
+// ImageBuildEmbeddedImage ImageBuildEmbeddedImage specifies an application image to embed into the OS image, either by reference or by catalog item. Exactly one of image and catalogItemRef must be set.
+type ImageBuildEmbeddedImage struct {
+	// CatalogItemRef A reference to a catalog item, along with its configuration.
+	CatalogItemRef *externalRef0.CatalogItemRefSpec `json:"catalogItemRef,omitempty"`
+
+	// Image The full reference of the image, including its registry (e.g. "quay.io/flightctl/app:v1").
+	Image *string `json:"image,omitempty"`
+
+	// Repository The name of a Repository resource of type OCI whose credentials are used to pull the image. Public images need no Repository.
+	Repository *string `json:"repository,omitempty"`
+}
+
+// ImageBuildFile ImageBuildFile specifies a file with inline content to add to the image.
+type ImageBuildFile struct {
+	// Content The plain text (UTF-8) or base64-encoded content of the file.
+	Content string `json:"content"`
+
+	// ContentEncoding Specifies the encoding type used for data representation.
+	ContentEncoding *externalRef0.EncodingType `json:"contentEncoding,omitempty"`
+
+	// Mode The file's permission mode. You may specify the more familiar octal with a leading zero (e.g., 0644) or as a decimal without a leading zero (e.g., 420). If not specified, the permission mode defaults to 0644.
+	Mode *int `json:"mode,omitempty"`
+
+	// Path The absolute path of the file in the image. Note that any existing file will be overwritten.
+	Path string `json:"path"`
+}
+
 // ImageBuildList ImageBuildList is a list of ImageBuild resources.
 type ImageBuildList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -282,6 +382,21 @@ type ImageBuildNewVersionRequest struct {
 	SourceImageTag *string `json:"sourceImageTag,omitempty"`
 }
 
+// ImageBuildPackageRepository ImageBuildPackageRepository specifies an RPM repository to add to the image.
+type ImageBuildPackageRepository struct {
+	// Baseurl The base URL of the repository.
+	Baseurl string `json:"baseurl"`
+
+	// Gpgcheck Whether to check the signatures of the packages of the repository. Defaults to true.
+	Gpgcheck *bool `json:"gpgcheck,omitempty"`
+
+	// Gpgkey The URL of the GPG key the packages of the repository are signed with.
+	Gpgkey *string `json:"gpgkey,omitempty"`
+
+	// Name The ID of the repository, used as the name of its .repo file.
+	Name string `json:"name"`
+}
+
 // ImageBuildRefSource ImageBuildRefSource specifies a source image from an ImageBuild resource.
 type ImageBuildRefSource struct {
 	// ImageBuildRef The name of the ImageBuild resource to use as source.
@@ -308,18 +423,30 @@ type ImageBuildSource struct {
 
 // ImageBuildSpec ImageBuildSpec describes the specification for an image build.
 type ImageBuildSpec struct {
+	// Architectures The architectures to build the image for. The image is built for each architecture and pushed as an OCI image index. Architectures other than the one of the worker are built through emulation. Defaults to amd64.
+	Architectures *[]ImageArchitecture `json:"architectures,omitempty"`
+
 	// Binding ImageBuildBinding specifies binding configuration for the build.
 	Binding ImageBuildBinding `json:"binding"`
 
+	// Customizations ImageBuildCustomizations declares changes applied to the OS image after the agent is installed. Packages are installed first, then files and directories are added, and finally units, kernel arguments and firewall ports are configured.
+	Customizations *ImageBuildCustomizations `json:"customizations,omitempty"`
+
 	// Destination ImageBuildDestination specifies the destination for the built image.
 	Destination ImageBuildDestination `json:"destination"`
 
+	// EmbeddedImages Application images to pre-seed into an additional image store of the OS image, so that applications using them can start on first boot without connectivity to their registry.
+	EmbeddedImages *[]ImageBuildEmbeddedImage `json:"embeddedImages,omitempty"`
+
 	// Onboarding When true, installs the flightctl-onboarding RPM and enables flightctl-onboarding-setup.service for first-boot device configuration via a Cockpit-based onboarding wizard. Compatible with both early and late binding. Defaults to false.
 	Onboarding *bool `json:"onboarding,omitempty"`
 
 	// Source ImageBuildSource specifies the source image for the build.
 	Source ImageBuildSource `json:"source"`
 
+	// Triggers ImageBuildTriggers defines when new versions of the ImageBuild are created automatically. New versions copy the spec of the ImageBuild without its triggers, as with the newversion endpoint.
+	Triggers *ImageBuildTriggers `json:"triggers,omitempty"`
+
 	// UserConfiguration ImageBuildUserConfiguration specifies user configuration for the build.
 	UserConfiguration *ImageBuildUserConfiguration `json:"userConfiguration,omitempty"`
 }
@@ -329,6 +456,9 @@ type ImageBuildStatus struct {
 	// Architecture The architecture of the built image.
 	Architecture *string `json:"architecture,omitempty"`
 
+	// Architectures The status of the build of each architecture.
+	Architectures *[]ImageBuildArchitectureStatus `json:"architectures,omitempty"`
+
 	// Conditions Current conditions of the ImageBuild.
 	Conditions *[]ImageBuildCondition `json:"conditions,omitempty"`
 
@@ -340,6 +470,57 @@ type ImageBuildStatus struct {
 
 	// ManifestDigest The digest of the built image manifest.
 	ManifestDigest *string `json:"manifestDigest,omitempty"`
+
+	// Triggers ImageBuildTriggersStatus records the state and history of the triggers of an ImageBuild.
+	Triggers *ImageBuildTriggersStatus `json:"triggers,omitempty"`
+}
+
+// ImageBuildTriggerReason The trigger that created a new version of an ImageBuild.
+type ImageBuildTriggerReason string
+
+// ImageBuildTriggerRecord ImageBuildTriggerRecord records a new version created by a trigger.
+type ImageBuildTriggerRecord struct {
+	// ImageBuildName The name of the created ImageBuild.
+	ImageBuildName string `json:"imageBuildName"`
+
+	// Reason The trigger that created a new version of an ImageBuild.
+	Reason ImageBuildTriggerReason `json:"reason"`
+
+	// SourceImageDigest The digest of the source image tag that triggered the new version.
+	SourceImageDigest *string `json:"sourceImageDigest,omitempty"`
+
+	// Time The time the new version was created.
+	Time time.Time `json:"time"`
+}
+
+// ImageBuildTriggers ImageBuildTriggers defines when new versions of the ImageBuild are created automatically. New versions copy the spec of the ImageBuild without its triggers, as with the newversion endpoint.
+type ImageBuildTriggers struct {
+	// MinInterval The minimum time between new versions created by triggers, as a positive integer followed by a time unit (s, m or h). Triggers firing earlier are deferred until the interval has passed. Defaults to 1h.
+	MinInterval *string `json:"minInterval,omitempty"`
+
+	// Schedule Cron expression format for scheduling times.
+	// The format is `* * * * *`: - Minutes: `*` matches 0-59. - Hours: `*` matches 0-23. - Day of Month: `*` matches 1-31. - Month: `*` matches 1-12. - Day of Week: `*` matches 0-6.
+	// Supported operators: - `*`: Matches any value (e.g., `*` in hours matches every hour). - `-`: Range (e.g., `0-8` for 12 AM to 8 AM). - `,`: List (e.g., `1,12` for 1st and 12th minute). - `/`: Step (e.g., `*/12` for every 12th minute). - Single value (e.g., `8` matches the 8th minute).
+	// Example: `* 0-8,16-23 * * *`.
+	Schedule *externalRef0.CronExpression `json:"schedule,omitempty"`
+
+	// SourceImageChange Create a new version when the digest of the source image tag changes in the source repository, such as after a security respin of the base image.
+	SourceImageChange *bool `json:"sourceImageChange,omitempty"`
+}
+
+// ImageBuildTriggersStatus ImageBuildTriggersStatus records the state and history of the triggers of an ImageBuild.
+type ImageBuildTriggersStatus struct {
+	// History The new versions created by triggers, most recent first. Only the most recent entries are kept.
+	History *[]ImageBuildTriggerRecord `json:"history,omitempty"`
+
+	// LastScheduleTime The last time the schedule was due.
+	LastScheduleTime *time.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastTriggerTime The last time a new version was created by a trigger.
+	LastTriggerTime *time.Time `json:"lastTriggerTime,omitempty"`
+
+	// SourceImageDigest The last seen digest of the source image tag.
+	SourceImageDigest *string `json:"sourceImageDigest,omitempty"`
 }
 
 // ImageBuildUserConfiguration ImageBuildUserConfiguration specifies user configuration for the build.
@@ -424,7 +605,10 @@ type ImageExportSourceType string
 
 // ImageExportSpec ImageExportSpec describes the specification for an image export.
 type ImageExportSpec struct {
-	// Format The type of format to export the image to.
+	// Architecture The architecture of an image, as used in OCI image indexes.
+	Architecture *ImageArchitecture `json:"architecture,omitempty"`
+
+	// Format The type of format to export the image to. Images of the raw format are compressed with gzip.
 	Format ExportFormatType `json:"format"`
 
 	// Source ImageExportSource specifies the source image for the export.
@@ -644,6 +828,9 @@ type GetImageBuildParams struct {
 type GetImageBuildLogParams struct {
 	// Follow If true, stream logs continuously (like kubectl logs -f). For active builds, keeps connection open. For completed builds, returns all logs and closes.
 	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// Architecture If set, only returns the logs of building the image for this architecture.
+	Architecture *ImageArchitecture `form:"architecture,omitempty" json:"architecture,omitempty"`
 }
 
 // ListImageExportsParams defines parameters for ListImageExports.
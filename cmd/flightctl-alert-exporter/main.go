@@ -12,6 +12,7 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/profiling"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/migration"
 	"github.com/flightctl/flightctl/internal/org/cache"
 	canaryservice "github.com/flightctl/flightctl/internal/service/canary"
 	checkpointservice "github.com/flightctl/flightctl/internal/service/checkpoint"
@@ -61,6 +62,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("initializing data store: %v", err)
 	}
+	if err := migration.CheckSchemaVersion(ctx, db); err != nil {
+		log.Fatalf("checking database schema version: %v", err)
+	}
 
 	defer func() {
 		if sqlDB, err := db.DB(); err == nil {
@@ -33,6 +33,7 @@ import (
 	instpprof "github.com/flightctl/flightctl/internal/instrumentation/pprof"
 	"github.com/flightctl/flightctl/internal/instrumentation/profiling"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/migration"
 	"github.com/flightctl/flightctl/internal/org/cache"
 	"github.com/flightctl/flightctl/internal/service"
 	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
@@ -212,6 +213,9 @@ func main() {
 	if err != nil {
 		logger.Fatalf("Initializing data store: %v", err)
 	}
+	if err := migration.CheckSchemaVersion(ctx, db); err != nil {
+		logger.Fatalf("Checking database schema version: %v", err)
+	}
 
 	defer func() {
 		if sqlDB, err := db.DB(); err == nil {
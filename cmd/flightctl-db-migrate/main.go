@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
@@ -11,9 +15,75 @@ import (
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
+const usage = `Usage: flightctl-db-migrate [--dry-run] [command]
+
+Commands:
+  up [--to VERSION] [--dry-run]   Migrate the database up to VERSION (default: latest). This is the default command.
+  down --to VERSION [--dry-run]   Revert the versioned migrations above VERSION.
+  status                          Show the current schema version and the available migrations.
+`
+
+type command struct {
+	name   string
+	target int64
+	dryRun bool
+}
+
+func parseCommand(args []string) (*command, error) {
+	global := flag.NewFlagSet("flightctl-db-migrate", flag.ContinueOnError)
+	global.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	dryRun := global.Bool("dry-run", false, "Validate migrations without committing any changes")
+	if err := global.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cmd := &command{name: "up", target: migration.LatestVersion(), dryRun: *dryRun}
+	if global.NArg() == 0 {
+		return cmd, nil
+	}
+	cmd.name = global.Arg(0)
+
+	sub := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	sub.Usage = global.Usage
+	switch cmd.name {
+	case "up", "down":
+		to := sub.Int64("to", -1, "Target schema version")
+		sub.BoolVar(&cmd.dryRun, "dry-run", cmd.dryRun, "Validate migrations without committing any changes")
+		if err := sub.Parse(global.Args()[1:]); err != nil {
+			return nil, err
+		}
+		switch {
+		case *to >= 0:
+			cmd.target = *to
+		case cmd.name == "down":
+			return nil, errors.New("down requires --to")
+		}
+	case "status":
+		if err := sub.Parse(global.Args()[1:]); err != nil {
+			return nil, err
+		}
+	default:
+		global.Usage()
+		return nil, fmt.Errorf("unknown command %q", cmd.name)
+	}
+	if sub.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", sub.Args())
+	}
+	return cmd, nil
+}
+
 func main() {
+	cmd, err := parseCommand(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.InitLogs().WithError(err).Fatal("parsing arguments")
+	}
+
 	cfg, err := config.LoadOrGenerate(config.ConfigFile())
 	if err != nil {
 		log.InitLogs().WithError(err).Fatal("reading configuration")
@@ -21,19 +91,18 @@ func main() {
 
 	log := log.InitLogs(cfg.Service.LogLevel)
 
-	dryRun := flag.Bool("dry-run", false, "Validate migrations without committing any changes")
-	flag.Parse()
-
 	ctx := context.Background()
 
-	startMsg := "Starting Flight Control database migration"
-	if *dryRun {
-		startMsg += " in dry-run mode"
-	}
-	log.Info(startMsg)
-	defer log.Info("Flight Control database migration completed")
+	if cmd.name != "status" {
+		startMsg := "Starting Flight Control database migration"
+		if cmd.dryRun {
+			startMsg += " in dry-run mode"
+		}
+		log.Info(startMsg)
+		defer log.Info("Flight Control database migration completed")
 
-	log.Infof("Using config: %s", cfg)
+		log.Infof("Using config: %s", cfg)
+	}
 
 	tracerShutdown := tracing.InitTracer(log, cfg, "flightctl-db-migrate")
 	defer func() {
@@ -60,13 +129,27 @@ func main() {
 		}
 	}()
 
-	if *dryRun {
+	if cmd.name == "status" {
+		if err = printStatus(ctx, migrationDB); err != nil {
+			log.WithError(err).Fatal("reading database schema status")
+		}
+		return
+	}
+
+	if cmd.dryRun {
 		log.Info("Dry-run mode enabled: changes will be rolled back after validation")
 	} else {
 		log.Info("Running database migrations with migration user")
 	}
 
-	if err = migration.Run(ctx, migrationDB, log, *dryRun); err != nil {
+	if cmd.name == "down" {
+		log.Infof("Reverting database schema to version %d", cmd.target)
+		err = migration.Down(ctx, migrationDB, log, cmd.target, cmd.dryRun)
+	} else {
+		log.Infof("Migrating database schema to version %d", cmd.target)
+		err = migration.Up(ctx, migrationDB, log, cmd.target, cmd.dryRun)
+	}
+	if err != nil {
 		if errors.Is(err, migration.ErrDryRunComplete) {
 			log.Info("Dry-run completed successfully; no changes were committed.")
 			return
@@ -76,3 +159,40 @@ func main() {
 
 	log.Info("Database migration completed successfully")
 }
+
+func printStatus(ctx context.Context, db *gorm.DB) error {
+	status, err := migration.GetStatus(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Current version: %d\n", status.CurrentVersion)
+	fmt.Printf("Latest version:  %d\n\n", status.LatestVersion)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED AT\tREVERSIBLE")
+	for _, m := range status.Migrations {
+		appliedAt := "pending"
+		if m.AppliedAt != nil {
+			appliedAt = m.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		reversible := "no"
+		if m.Reversible {
+			reversible = "yes"
+		}
+		description := m.Description
+		if m.Unknown {
+			description += " (unknown to this release)"
+			reversible = "unknown"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", m.Version, description, appliedAt, reversible)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if status.CurrentVersion > status.LatestVersion {
+		return fmt.Errorf("%w: the database was migrated by a newer release", migration.ErrUnknownSchemaVersion)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/migration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	latest := migration.LatestVersion()
+	tests := []struct {
+		name    string
+		args    []string
+		want    command
+		wantErr bool
+	}{
+		{name: "no command migrates up to latest", args: nil, want: command{name: "up", target: latest}},
+		{name: "global dry run", args: []string{"--dry-run"}, want: command{name: "up", target: latest, dryRun: true}},
+		{name: "up to version", args: []string{"up", "--to", "2", "--dry-run"}, want: command{name: "up", target: 2, dryRun: true}},
+		{name: "down to version", args: []string{"down", "--to", "0"}, want: command{name: "down", target: 0}},
+		{name: "global dry run before down", args: []string{"--dry-run", "down", "--to", "1"}, want: command{name: "down", target: 1, dryRun: true}},
+		{name: "status", args: []string{"status"}, want: command{name: "status", target: latest}},
+		{name: "down requires target", args: []string{"down"}, wantErr: true},
+		{name: "unknown command", args: []string{"sideways"}, wantErr: true},
+		{name: "extra arguments", args: []string{"status", "now"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := parseCommand(tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, *cmd)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/profiling"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/migration"
 	canaryservice "github.com/flightctl/flightctl/internal/service/canary"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
@@ -55,6 +56,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("initializing data store: %v", err)
 	}
+	if err := migration.CheckSchemaVersion(ctx, db); err != nil {
+		log.Fatalf("checking database schema version: %v", err)
+	}
 
 	// ImageBuilder-specific store
 	imageBuilderStore := imagebuilderstore.NewStore(db, log.WithField("pkg", "imagebuilder-store"))
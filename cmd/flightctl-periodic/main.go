@@ -8,6 +8,7 @@ import (
 	instpprof "github.com/flightctl/flightctl/internal/instrumentation/pprof"
 	"github.com/flightctl/flightctl/internal/instrumentation/profiling"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/migration"
 	periodic "github.com/flightctl/flightctl/internal/periodic_checker"
 	canaryservice "github.com/flightctl/flightctl/internal/service/canary"
 	"github.com/flightctl/flightctl/internal/store"
@@ -43,6 +44,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("initializing data store: %v", err)
 	}
+	if err := migration.CheckSchemaVersion(ctx, db); err != nil {
+		log.Fatalf("checking database schema version: %v", err)
+	}
 
 	defer func() {
 		if sqlDB, err := db.DB(); err == nil {
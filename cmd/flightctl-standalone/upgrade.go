@@ -0,0 +1,68 @@
+package main
+
+import (
+	"time"
+
+	"github.com/flightctl/flightctl/internal/quadlet/renderer"
+	"github.com/flightctl/flightctl/internal/quadlet/upgrade"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewUpgradeCommand() *cobra.Command {
+	var cfgFile string
+	var backupDir string
+	var minFreeMB uint64
+	var healthTimeout time.Duration
+	config := renderer.NewRendererConfig()
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade a standalone Flight Control deployment",
+		Long: `Upgrade a standalone Flight Control deployment to the release in the current directory.
+
+The upgrade performs the following steps:
+
+  1. Runs preflight checks (required commands, disk space, configuration
+     compatibility and database reachability)
+  2. Creates a flightctl-backup archive
+  3. Validates the database migration of the new release with a dry-run
+  4. Stops the services using the database and migrates it
+  5. Renders and installs the quadlets and configuration of the new release
+  6. Restarts the services in dependency order and waits for them to become active
+
+If a step after the services were stopped fails, the previous quadlets are
+reinstalled and the backup is restored with flightctl-restore.
+
+Like "render quadlets", the command must be run from the directory containing
+the release's deploy/ tree.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Bind at run time: the render command binds the same keys when it is created.
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			return initConfig(cfgFile, config)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.InitLogs()
+			return upgrade.NewUpgrader(logger, config,
+				upgrade.WithBackupDir(backupDir),
+				upgrade.WithMinFreeBytes(minFreeMB<<20),
+				upgrade.WithHealthTimeout(healthTimeout),
+			).Run(cmd.Context())
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (required)")
+
+	cmd.Flags().StringVar(&backupDir, "backup-dir", "/var/lib/flightctl/backups", "Directory the pre-upgrade backup archive is written to")
+	cmd.Flags().Uint64Var(&minFreeMB, "min-free-space", 2048, "Free space in MiB required in the backup directory")
+	cmd.Flags().DurationVar(&healthTimeout, "health-timeout", 5*time.Minute, "How long to wait for the services to become active after the upgrade")
+	cmd.Flags().StringSliceVar(&config.BinSourceDirs, "bin-source-dirs", []string{"bin"}, "Directories to search for binaries (searched in order)")
+	cmd.Flags().StringVar(&config.FlightctlServicesTagOverride, "flightctl-services-tag-override", "", "Override image tags for all FlightCtl services")
+	cmd.Flags().BoolVar(&config.FlightctlUiTagOverride, "flightctl-ui-tag-override", false, "Apply tag override to UI service")
+
+	return cmd
+}
@@ -10,7 +10,11 @@ import (
 	instpprof "github.com/flightctl/flightctl/internal/instrumentation/pprof"
 	"github.com/flightctl/flightctl/internal/instrumentation/profiling"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/migration"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/device"
 	tg "github.com/flightctl/flightctl/internal/telemetry_gateway"
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceattrs"
 	"github.com/flightctl/flightctl/pkg/log"
 )
 
@@ -46,7 +50,26 @@ func main() {
 		cancel()
 	}()
 
-	if err := tg.Run(ctx, cfg); err != nil {
+	log.Println("Initializing data store")
+	db, err := store.InitDB(cfg, log)
+	if err != nil {
+		log.Fatalf("initializing data store: %v", err)
+	}
+	if err := migration.CheckSchemaVersion(ctx, db); err != nil {
+		log.Fatalf("checking database schema version: %v", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	fleets := deviceattrs.NewCachedFleetResolver(
+		device.NewDeviceStore(db, log.WithField("pkg", "device-store")), deviceattrs.DefaultFleetCacheTTL)
+	fleets.Start()
+	defer fleets.Stop()
+
+	if err := tg.Run(ctx, cfg, tg.WithFleetResolver(fleets)); err != nil {
 		log.Fatalf("failed to create telemetry gateway: %v", err)
 	}
 }
@@ -36,6 +36,9 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdDeny())
 	cmd.AddCommand(cli.NewCmdLogin())
 	cmd.AddCommand(cli.NewCmdResume())
+	cmd.AddCommand(cli.NewCmdLabel())
+	cmd.AddCommand(cli.NewCmdAnnotate())
+	cmd.AddCommand(cli.NewCmdRollback())
 	cmd.AddCommand(cli.NewCmdVersion())
 	cmd.AddCommand(cli.NewConsoleCmd())
 	cmd.AddCommand(cli.NewCmdCompletion())
@@ -44,6 +47,8 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdDownload())
 	cmd.AddCommand(cli.NewCmdLogs())
 	cmd.AddCommand(cli.NewCmdApp())
+	cmd.AddCommand(cli.NewCmdPortForward())
+	cmd.AddCommand(cli.NewCmdCopy())
 
 	return cmd
 }
@@ -18,11 +18,16 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/filetransfer"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
 	imagepruning "github.com/flightctl/flightctl/internal/agent/device/image_pruning"
 	"github.com/flightctl/flightctl/internal/agent/device/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/localapi"
+	"github.com/flightctl/flightctl/internal/agent/device/logforward"
 	"github.com/flightctl/flightctl/internal/agent/device/os"
 	"github.com/flightctl/flightctl/internal/agent/device/policy"
+	"github.com/flightctl/flightctl/internal/agent/device/portforward"
+	"github.com/flightctl/flightctl/internal/agent/device/registries"
 	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/spec/audit"
@@ -236,7 +241,9 @@ func (a *Agent) Run(ctx context.Context) error {
 
 	reloadManager := reload.NewManager(a.configFile, a.log)
 
-	policyManager := policy.NewManager(a.log)
+	policyManager := policy.NewManager(rootReadWriter, exec, a.log)
+
+	registriesManager := registries.NewManager(rootReadWriter, a.log)
 
 	deviceNotFoundHandler := func() error {
 		return wipeCertificateAndRestart(ctx, identityProvider, exec, a.log)
@@ -319,6 +326,8 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config.PullTimeout,
 		resourceManager,
 		pollBackoff,
+		dependency.WithPullBandwidth(a.config.PullBandwidth),
+		dependency.WithRegistryMirrors(registriesManager),
 	)
 
 	// create status manager
@@ -354,6 +363,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	statusManager.RegisterStatusExporter(osManager)
 	statusManager.RegisterStatusExporter(specManager)
 	statusManager.RegisterStatusExporter(systemInfoManager)
+	statusManager.RegisterStatusExporter(registriesManager)
 	if len(a.config.Warnings) > 0 {
 		statusManager.RegisterStatusExporter(newConfigWarningExporter(a.config.Warnings))
 	}
@@ -425,6 +435,25 @@ func (a *Agent) Run(ctx context.Context) error {
 
 	applicationsManager.WithConsole(deviceName, remoteAccessGrpcClient)
 
+	// create port-forward manager
+	portForwardManager := portforward.NewManager(
+		remoteAccessGrpcClient,
+		deviceName,
+		a.config,
+		specManager.Watch(),
+		a.log,
+	)
+
+	// create file transfer manager
+	fileTransferManager := filetransfer.NewManager(
+		remoteAccessGrpcClient,
+		deviceName,
+		rootReadWriter,
+		a.config,
+		specManager.Watch(),
+		a.log,
+	)
+
 	applicationsController := applications.NewController(
 		podmanClientFactory,
 		cliClients,
@@ -471,17 +500,35 @@ func (a *Agent) Run(ctx context.Context) error {
 		prefetchManager,
 		pullConfigResolver,
 		pruningManager,
+		registriesManager,
 		osMode,
 		backoff,
 		a.log,
 	)
 
+	localAPIServer := localapi.NewServer(
+		a.config,
+		specManager,
+		statusManager,
+		policyManager,
+		a.log,
+	)
+
+	logForwarder := logforward.NewForwarder(
+		a.config,
+		client.NewJournalctl(exec, v1beta1.RootUsername),
+		rootReadWriter,
+		a.log,
+	)
+
 	// register reloader with reload manager
 	reloadManager.Register(agent.ReloadConfig)
 	reloadManager.Register(systemInfoManager.ReloadConfig)
 	reloadManager.Register(statusManager.ReloadCollect)
 	reloadManager.Register(certManager.Sync)
 	reloadManager.Register(pruningManager.ReloadConfig)
+	reloadManager.Register(portForwardManager.ReloadConfig)
+	reloadManager.Register(fileTransferManager.ReloadConfig)
 
 	// When the server returns ConflictPaused (e.g. post-restore), clear lastStatus so the next status sync pushes device details.
 	specManager.Publisher().SetOnConflictPausedInvalidator(statusManager)
@@ -493,11 +540,16 @@ func (a *Agent) Run(ctx context.Context) error {
 	startAsync(reloadManager.Run)
 	startAsync(resourceManager.Run)
 	startAsync(prefetchManager.Run)
+	startAsync(registriesManager.Run)
 	appConsoleWatcher := specManager.Watch()
 	startAsync(consoleManager.Run)
 	startAsync(func(ctx context.Context) { applicationsManager.RunConsole(ctx, appConsoleWatcher) })
+	startAsync(portForwardManager.Run)
+	startAsync(fileTransferManager.Run)
 	startAsync(specManager.Publisher().Run)
 	startAsync(certManager.Run)
+	startAsync(localAPIServer.Run)
+	startAsync(logForwarder.Run)
 
 	// main agent loop: all critical work happens here serially
 	err = agent.Run(ctx)
@@ -96,6 +96,7 @@ type clientOptions struct {
 	pullSecretPath       string
 	repositoryConfigPath string
 	criConfigPath        string
+	proxyURL             string
 	timeout              time.Duration
 }
 
@@ -123,6 +124,13 @@ func WithCRIConfig(path string) ClientOption {
 	}
 }
 
+// WithProxy routes the registry traffic of a pull through the HTTP proxy at the URL.
+func WithProxy(proxyURL string) ClientOption {
+	return func(opts *clientOptions) {
+		opts.proxyURL = proxyURL
+	}
+}
+
 // Timeout sets a custom timeout for the client operation.
 // When defined, this value overrides the default client timeout.
 func Timeout(timeout time.Duration) ClientOption {
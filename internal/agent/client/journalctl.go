@@ -1,8 +1,11 @@
 package client
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -81,3 +84,99 @@ func (j *Journalctl) Logs(ctx context.Context, options ...LogOptions) ([]string,
 
 	return result, nil
 }
+
+// JournalEntry is an entry of the systemd journal.
+type JournalEntry struct {
+	// Cursor identifies the entry so that reading can resume after it.
+	Cursor string
+	// Timestamp is the time the entry was received by the journal.
+	Timestamp time.Time
+	// Priority is the syslog priority of the entry, from 0 (emerg) to 7 (debug).
+	Priority int
+	Message  string
+	// Unit is the systemd unit that wrote the entry, if any.
+	Unit             string
+	SyslogIdentifier string
+	// ContainerName is set for entries written by the journald log driver of podman.
+	ContainerName string
+}
+
+// Entries returns up to limit journal entries following the entry identified by afterCursor or,
+// if afterCursor is empty, the oldest entries since the given time.
+func (j *Journalctl) Entries(ctx context.Context, afterCursor string, since time.Time, limit int) ([]JournalEntry, error) {
+	// journalctl reads forward from the cursor or time and stops after limit entries.
+	args := []string{"-o", "json", "--no-pager", "-n", strconv.Itoa(limit)}
+	if afterCursor != "" {
+		args = append(args, "--after-cursor", afterCursor)
+	} else {
+		args = append(args, "--since", since.Format("2006-01-02 15:04:05"))
+	}
+
+	stdout, stderr, exitCode := j.exec.ExecuteWithContext(ctx, journalctlCommand, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("journalctl entries: %w", errors.FromStderr(stderr, exitCode))
+	}
+	return parseJournalEntries(stdout)
+}
+
+func parseJournalEntries(output string) ([]JournalEntry, error) {
+	var entries []JournalEntry
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			return nil, fmt.Errorf("parsing journal entry: %w", err)
+		}
+		entry := JournalEntry{
+			Cursor:           journalField(fields, "__CURSOR"),
+			Priority:         6,
+			Message:          journalField(fields, "MESSAGE"),
+			Unit:             journalField(fields, "_SYSTEMD_UNIT"),
+			SyslogIdentifier: journalField(fields, "SYSLOG_IDENTIFIER"),
+			ContainerName:    journalField(fields, "CONTAINER_NAME"),
+		}
+		if usec, err := strconv.ParseInt(journalField(fields, "__REALTIME_TIMESTAMP"), 10, 64); err == nil {
+			entry.Timestamp = time.UnixMicro(usec)
+		}
+		if priority, err := strconv.Atoi(journalField(fields, "PRIORITY")); err == nil {
+			entry.Priority = priority
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading journal entries: %w", err)
+	}
+	return entries, nil
+}
+
+// journalField returns the value of a field of a journal entry. journalctl prints fields that
+// are not valid UTF-8 as arrays of bytes and fields with several values as arrays, of which
+// the first value is returned.
+func journalField(fields map[string]json.RawMessage, name string) string {
+	raw, ok := fields[name]
+	if !ok {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var ints []int
+	if err := json.Unmarshal(raw, &ints); err == nil {
+		b := make([]byte, len(ints))
+		for i, v := range ints {
+			b[i] = byte(v)
+		}
+		return string(b)
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err == nil && len(values) > 0 {
+		return journalField(map[string]json.RawMessage{name: values[0]}, name)
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseJournalEntries(t *testing.T) {
+	require := require.New(t)
+	output := `{"__CURSOR":"s=1;i=1","__REALTIME_TIMESTAMP":"1700000000000000","PRIORITY":"3","MESSAGE":"failed","_SYSTEMD_UNIT":"app.service","SYSLOG_IDENTIFIER":"app"}
+{"__CURSOR":"s=1;i=2","__REALTIME_TIMESTAMP":"1700000001000000","MESSAGE":[104,105],"CONTAINER_NAME":["web","web-old"]}
+`
+	entries, err := parseJournalEntries(output)
+	require.NoError(err)
+	require.Equal([]JournalEntry{
+		{
+			Cursor:           "s=1;i=1",
+			Timestamp:        time.UnixMicro(1700000000000000),
+			Priority:         3,
+			Message:          "failed",
+			Unit:             "app.service",
+			SyslogIdentifier: "app",
+		},
+		{
+			Cursor:        "s=1;i=2",
+			Timestamp:     time.UnixMicro(1700000001000000),
+			Priority:      6,
+			Message:       "hi",
+			ContainerName: "web",
+		},
+	}, entries)
+
+	_, err = parseJournalEntries("not json\n")
+	require.Error(err)
+}
+
+func TestJournalctlEntriesLimit(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockExec := executer.NewMockExecuter(ctrl)
+	j := NewJournalctl(mockExec, "")
+
+	output := `{"__CURSOR":"s=1;i=2","MESSAGE":"next"}` + "\n"
+	mockExec.EXPECT().
+		ExecuteWithContext(gomock.Any(), journalctlCommand, "-o", "json", "--no-pager", "-n", "500", "--after-cursor", "s=1;i=1").
+		Return(output, "", 0)
+	entries, err := j.Entries(context.Background(), "s=1;i=1", time.Time{}, 500)
+	require.NoError(err)
+	require.Len(entries, 1)
+	require.Equal("s=1;i=2", entries[0].Cursor)
+
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local)
+	mockExec.EXPECT().
+		ExecuteWithContext(gomock.Any(), journalctlCommand, "-o", "json", "--no-pager", "-n", "500", "--since", "2026-01-02 03:04:05").
+		Return("", "", 0)
+	entries, err = j.Entries(context.Background(), "", since, 500)
+	require.NoError(err)
+	require.Empty(entries)
+}
@@ -174,7 +174,7 @@ func (p *Podman) pullImage(ctx context.Context, image string, options *clientOpt
 			args = append(args, "--authfile", pullSecretPath)
 		}
 	}
-	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	stdout, stderr, exitCode := p.executeWithProxy(ctx, options.proxyURL, args)
 	if exitCode != 0 {
 		return "", fmt.Errorf("pull image: %w", deviceerrors.FromStderr(stderr, exitCode))
 	}
@@ -182,6 +182,31 @@ func (p *Podman) pullImage(ctx context.Context, image string, options *clientOpt
 	return out, nil
 }
 
+// executeWithProxy runs podman with the proxy in its environment, if one is set.
+func (p *Podman) executeWithProxy(ctx context.Context, proxyURL string, args []string) (string, string, int) {
+	if proxyURL == "" {
+		return p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	}
+	return p.exec.ExecuteWithContextFromDir(ctx, "", podmanCmd, args, proxyEnv(ctx, p.exec, proxyURL)...)
+}
+
+// proxyEnv returns the environment of the command run by the executer with the proxy set.
+// The proxy is set in the environment rather than through env(1), as its URL carries the
+// credentials of the pull, which must not be visible in the process list.
+func proxyEnv(ctx context.Context, e executer.Executer, proxyURL string) []string {
+	// the executer sets the environment of the user it runs as, if any.
+	env := e.CommandContext(ctx, podmanCmd).Env
+	if env == nil {
+		env = os.Environ()
+	}
+	return append(env,
+		"HTTPS_PROXY="+proxyURL,
+		"HTTP_PROXY="+proxyURL,
+		"https_proxy="+proxyURL,
+		"http_proxy="+proxyURL,
+	)
+}
+
 // PullArtifact pulls an artifact from the registry with optional retry and authentication via a pull secret.
 // Logs progress periodically while the operation is in progress.
 func (p *Podman) PullArtifact(ctx context.Context, artifact string, opts ...ClientOption) (string, error) {
@@ -224,7 +249,7 @@ func (p *Podman) pullArtifact(ctx context.Context, artifact string, options *cli
 		}
 	}
 
-	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	stdout, stderr, exitCode := p.executeWithProxy(ctx, options.proxyURL, args)
 	if exitCode != 0 {
 		return "", fmt.Errorf("pull artifact: %w", deviceerrors.FromStderr(stderr, exitCode))
 	}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"math"
 	"net"
 	"net/url"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -97,6 +99,16 @@ const (
 	DefaultMetricsEnabled = false
 	// DefaultProfilingEnabled controls whether runtime profiling (pprof) is enabled by default.
 	DefaultProfilingEnabled = false
+	// DefaultFileTransferMaxFileSize is the default size limit of a file copied with `flightctl cp`.
+	DefaultFileTransferMaxFileSize = 1 << 30
+	// DefaultLocalAPISocketPath is the default path of the Unix socket of the local API.
+	DefaultLocalAPISocketPath = "/run/flightctl/agent.sock"
+	// LogForwardingCertFile is the name of the client certificate used to forward logs
+	LogForwardingCertFile = "log-forwarding.crt"
+	// LogForwardingKeyFile is the name of the client key used to forward logs
+	LogForwardingKeyFile = "log-forwarding.key"
+	// DefaultLogForwardingInterval is the default interval between two reads of new log entries
+	DefaultLogForwardingInterval = util.Duration(10 * time.Second)
 )
 
 var testRootDirWarningOnce sync.Once
@@ -191,6 +203,9 @@ type Config struct {
 	// PullRetrySteps defines how many retry attempts are allowed for pulling an OCI target.
 	PullRetrySteps int `json:"pull-retry-steps,omitempty"`
 
+	// PullBandwidth limits the network bandwidth used to prefetch images and artifacts.
+	PullBandwidth PullBandwidth `json:"pull-bandwidth,omitempty"`
+
 	// MetricsEnabled enables the loopback-only Prometheus /metrics endpoint for local observability.
 	MetricsEnabled bool `json:"metrics-enabled,omitempty"`
 
@@ -200,6 +215,18 @@ type Config struct {
 	// ImagePruning holds all image/artifact pruning-related configuration
 	ImagePruning ImagePruning `json:"image-pruning,omitempty"`
 
+	// PortForward holds the configuration for TCP port forwarding over the remote-access tunnel
+	PortForward PortForward `json:"port-forward,omitempty"`
+
+	// FileTransfer holds the configuration for copying files with `flightctl cp`
+	FileTransfer FileTransfer `json:"file-transfer,omitempty"`
+
+	// LocalAPI holds the configuration for the API served to applications on the device
+	LocalAPI LocalAPI `json:"local-api,omitempty"`
+
+	// LogForwarding holds the configuration for forwarding device logs to the telemetry gateway
+	LogForwarding LogForwarding `json:"log-forwarding,omitempty"`
+
 	// Warnings collects non-fatal issues encountered during config loading
 	// (e.g., skipped drop-ins) so they can be surfaced in device status.
 	Warnings []string `json:"-"`
@@ -224,6 +251,203 @@ type ImagePruning struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type PortForward struct {
+	// AllowedPorts lists the device-local TCP ports that may be forwarded to through
+	// `flightctl port-forward`. Connections are only ever made to the loopback interface.
+	// Default: empty, which disables port forwarding.
+	AllowedPorts []int32 `json:"allowed-ports,omitempty"`
+}
+
+type FileTransfer struct {
+	// AllowedPaths lists the absolute device paths that `flightctl cp` may read from or write
+	// to. A file is allowed if it is one of the listed paths or lies below a listed directory,
+	// after resolving symbolic links.
+	// Default: empty, which disables file transfer.
+	AllowedPaths []string `json:"allowed-paths,omitempty"`
+	// MaxFileSize is the largest file in bytes that may be copied in either direction.
+	// Default: 1 GiB
+	MaxFileSize int64 `json:"max-file-size,omitempty"`
+}
+
+type LocalAPI struct {
+	// Enabled controls whether the agent serves its local API on a Unix socket.
+	// Default: false
+	Enabled bool `json:"enabled,omitempty"`
+	// SocketPath is the path of the Unix socket.
+	// Default: /run/flightctl/agent.sock
+	SocketPath string `json:"socket-path,omitempty"`
+	// SocketGroup is the group whose members may connect to the socket. If empty, only root
+	// may connect.
+	// Default: empty
+	SocketGroup string `json:"socket-group,omitempty"`
+}
+
+type LogForwarding struct {
+	// Enabled controls whether the agent forwards device logs to the telemetry gateway over
+	// OTLP/gRPC.
+	// Default: false
+	Enabled bool `json:"enabled,omitempty"`
+	// Endpoint is the host:port of the telemetry gateway. If empty, it is derived from the
+	// enrollment service by replacing an agent-api. hostname prefix with telemetry. and the
+	// port with 4317.
+	// Default: empty
+	Endpoint string `json:"endpoint,omitempty"`
+	// CertFile is the client certificate presented to the telemetry gateway. It must be issued
+	// by the flightctl.io/device-svc-client signer, e.g. through a certs.d drop-in.
+	// Default: /var/lib/flightctl/certs/log-forwarding.crt
+	CertFile string `json:"cert-file,omitempty"`
+	// KeyFile is the key of the client certificate.
+	// Default: /var/lib/flightctl/certs/log-forwarding.key
+	KeyFile string `json:"key-file,omitempty"`
+	// Journald controls whether entries of the system journal are forwarded.
+	// Default: false
+	Journald bool `json:"journald,omitempty"`
+	// Units restricts the forwarded journal entries to those of the listed systemd units.
+	// Default: empty, which forwards the entries of all units.
+	Units []string `json:"units,omitempty"`
+	// Applications controls whether the output of application containers is forwarded.
+	// Default: false
+	Applications bool `json:"applications,omitempty"`
+	// Interval is the interval between two reads of new log entries.
+	// Default: 10s
+	Interval util.Duration `json:"interval,omitempty"`
+}
+
+type PullBandwidth struct {
+	// Limit is the bandwidth available to prefetching images and artifacts, in bits per
+	// second with an optional k, M or G prefix, e.g. "2Mbps".
+	// Default: empty, which does not limit the bandwidth.
+	Limit string `json:"limit,omitempty"`
+	// Windows override Limit during times of day, e.g. to allow more bandwidth at night.
+	// The first window containing the current local time applies.
+	// Default: empty
+	Windows []PullBandwidthWindow `json:"windows,omitempty"`
+	// AllowedHosts are hosts, with an optional port, that limited pulls may connect to besides
+	// the registry of the image and its mirrors, such as the CDN a registry redirects layer
+	// downloads to. A host starting with "*." matches its subdomains.
+	// Default: empty
+	AllowedHosts []string `json:"allowed-hosts,omitempty"`
+}
+
+type PullBandwidthWindow struct {
+	// Start is the local time of day the window starts at, as HH:MM.
+	Start string `json:"start"`
+	// End is the local time of day the window ends at, as HH:MM. A window that ends before
+	// it starts spans midnight.
+	End string `json:"end"`
+	// Limit is the bandwidth available during the window. If empty, the bandwidth is not
+	// limited during the window.
+	Limit string `json:"limit,omitempty"`
+}
+
+func (f LogForwarding) Validate() error {
+	if !f.Enabled {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(f.Endpoint); err != nil {
+		return fmt.Errorf("log-forwarding.endpoint: invalid endpoint %q: must be host:port", f.Endpoint)
+	}
+	if !filepath.IsAbs(f.CertFile) {
+		return fmt.Errorf("log-forwarding.cert-file: invalid path %q: must be absolute", f.CertFile)
+	}
+	if !filepath.IsAbs(f.KeyFile) {
+		return fmt.Errorf("log-forwarding.key-file: invalid path %q: must be absolute", f.KeyFile)
+	}
+	if !f.Journald && !f.Applications {
+		return fmt.Errorf("log-forwarding: at least one of journald or applications must be enabled")
+	}
+	for _, unit := range f.Units {
+		if unit == "" {
+			return fmt.Errorf("log-forwarding.units: unit name cannot be empty")
+		}
+	}
+	if f.Interval < MinSyncInterval {
+		return fmt.Errorf("log-forwarding.interval must be at least %s, got %s", MinSyncInterval, f.Interval)
+	}
+	return nil
+}
+
+// IsLimited returns true if the bandwidth is limited at any time of day.
+func (b PullBandwidth) IsLimited() bool {
+	if b.Limit != "" {
+		return true
+	}
+	for _, w := range b.Windows {
+		if w.Limit != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (b PullBandwidth) Validate() error {
+	if _, err := ParseBandwidth(b.Limit); err != nil {
+		return fmt.Errorf("pull-bandwidth.limit: %w", err)
+	}
+	for i, w := range b.Windows {
+		if _, err := ParseTimeOfDay(w.Start); err != nil {
+			return fmt.Errorf("pull-bandwidth.windows[%d].start: %w", i, err)
+		}
+		if _, err := ParseTimeOfDay(w.End); err != nil {
+			return fmt.Errorf("pull-bandwidth.windows[%d].end: %w", i, err)
+		}
+		if w.Start == w.End {
+			return fmt.Errorf("pull-bandwidth.windows[%d]: start and end must differ", i)
+		}
+		if _, err := ParseBandwidth(w.Limit); err != nil {
+			return fmt.Errorf("pull-bandwidth.windows[%d].limit: %w", i, err)
+		}
+	}
+	for i, host := range b.AllowedHosts {
+		if host == "" || strings.ContainsAny(host, "/@ ") {
+			return fmt.Errorf("pull-bandwidth.allowed-hosts[%d]: invalid host %q: must be a host with an optional port", i, host)
+		}
+	}
+	return nil
+}
+
+// ParseBandwidth parses a bandwidth such as "512kbps" or "2Mbps" into bits per second. An
+// empty string parses as 0, which means unlimited.
+func ParseBandwidth(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	value, ok := strings.CutSuffix(s, "bps")
+	if !ok {
+		return 0, fmt.Errorf("invalid bandwidth %q: must be in bps, kbps, Mbps or Gbps", s)
+	}
+	multiplier := 1.0
+	switch {
+	case strings.HasSuffix(value, "k"):
+		multiplier = 1e3
+	case strings.HasSuffix(value, "M"):
+		multiplier = 1e6
+	case strings.HasSuffix(value, "G"):
+		multiplier = 1e9
+	}
+	if multiplier != 1 {
+		value = value[:len(value)-1]
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f <= 0 || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid bandwidth %q: must be a positive number of bps, kbps, Mbps or Gbps", s)
+	}
+	bps := f * multiplier
+	if bps < 8 {
+		return 0, fmt.Errorf("invalid bandwidth %q: must be at least 8bps", s)
+	}
+	return int64(bps), nil
+}
+
+// ParseTimeOfDay parses a time of day as HH:MM into the duration since midnight.
+func ParseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: must be HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
 // DefaultSystemInfo defines the list of system information keys that are included
 // in the default system info status report generated by the agent.
 var DefaultSystemInfo = append([]string{
@@ -272,6 +496,17 @@ func NewDefault() *Config {
 		ImagePruning: ImagePruning{
 			Enabled: lo.ToPtr(false),
 		},
+		FileTransfer: FileTransfer{
+			MaxFileSize: DefaultFileTransferMaxFileSize,
+		},
+		LogForwarding: LogForwarding{
+			CertFile: filepath.Join(DefaultDataDir, DefaultCertsDirName, LogForwardingCertFile),
+			KeyFile:  filepath.Join(DefaultDataDir, DefaultCertsDirName, LogForwardingKeyFile),
+			Interval: DefaultLogForwardingInterval,
+		},
+		LocalAPI: LocalAPI{
+			SocketPath: DefaultLocalAPISocketPath,
+		},
 	}
 
 	if value := os.Getenv(TestRootDirEnvKey); value != "" {
@@ -363,6 +598,13 @@ func (cfg *Config) Complete() error {
 		cfg.RemoteAccessService.Config.AuthInfo = baseclient.AuthInfo{}
 		cfg.RemoteAccessService.Config.Service.Server = remoteAccessServer
 	}
+	if cfg.LogForwarding.Enabled && cfg.LogForwarding.Endpoint == "" {
+		endpoint, err := deriveTelemetryGatewayEndpoint(cfg.EnrollmentService.Config.Service.Server)
+		if err != nil {
+			return fmt.Errorf("deriving telemetry gateway endpoint: %w", err)
+		}
+		cfg.LogForwarding.Endpoint = endpoint
+	}
 	if cfg.StatusUpdateJitter == nil {
 		jitter := cfg.StatusUpdateInterval
 		cfg.StatusUpdateJitter = &jitter
@@ -411,6 +653,23 @@ func deriveRemoteAccessServer(enrollmentServer string) (string, error) {
 	return u.String(), nil
 }
 
+// deriveTelemetryGatewayEndpoint derives the host:port of the telemetry gateway's OTLP/gRPC
+// listener from the enrollment service URL, the same way the device image instructions do for
+// an OpenTelemetry Collector on the device.
+func deriveTelemetryGatewayEndpoint(enrollmentServer string) (string, error) {
+	const telemetryGatewayPort = "4317"
+
+	u, err := url.Parse(enrollmentServer)
+	if err != nil {
+		return "", fmt.Errorf("parsing enrollment server URL %q: %w", enrollmentServer, err)
+	}
+	hostname := u.Hostname()
+	if rest, ok := strings.CutPrefix(hostname, "agent-api."); ok {
+		hostname = "telemetry." + rest
+	}
+	return net.JoinHostPort(hostname, telemetryGatewayPort), nil
+}
+
 // Validate checks that the required fields are set and ensures that the paths exist.
 func (cfg *Config) Validate() error {
 	if err := cfg.EnrollmentService.Validate(); err != nil {
@@ -427,6 +686,33 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("system-info-timeout cannot exceed %s, got %s", MaxSystemInfoTimeout, cfg.SystemInfoTimeout)
 	}
 
+	for _, port := range cfg.PortForward.AllowedPorts {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("port-forward.allowed-ports: invalid port %d: must be between 1 and 65535", port)
+		}
+	}
+
+	for _, p := range cfg.FileTransfer.AllowedPaths {
+		if !filepath.IsAbs(p) {
+			return fmt.Errorf("file-transfer.allowed-paths: invalid path %q: must be absolute", p)
+		}
+	}
+	if cfg.FileTransfer.MaxFileSize < 0 {
+		return fmt.Errorf("file-transfer.max-file-size cannot be negative, got %d", cfg.FileTransfer.MaxFileSize)
+	}
+
+	if err := cfg.PullBandwidth.Validate(); err != nil {
+		return err
+	}
+
+	if !filepath.IsAbs(cfg.LocalAPI.SocketPath) {
+		return fmt.Errorf("local-api.socket-path: invalid path %q: must be absolute", cfg.LocalAPI.SocketPath)
+	}
+
+	if err := cfg.LogForwarding.Validate(); err != nil {
+		return err
+	}
+
 	if cfg.TPM.AuthEnabled && !cfg.TPM.Enabled {
 		return fmt.Errorf("cannot enable TPM password authentication when TPM device identity is disabled")
 	}
@@ -632,6 +918,33 @@ func mergeConfigs(base, override *Config) {
 	// but a dropin with image-pruning.enabled: false will override to false.
 	overrideIfNotEmpty(&base.ImagePruning.Enabled, override.ImagePruning.Enabled)
 
+	// port forwarding
+	overrideSliceIfNotNil(&base.PortForward.AllowedPorts, override.PortForward.AllowedPorts)
+
+	// file transfer
+	overrideSliceIfNotNil(&base.FileTransfer.AllowedPaths, override.FileTransfer.AllowedPaths)
+	overrideIfNotEmpty(&base.FileTransfer.MaxFileSize, override.FileTransfer.MaxFileSize)
+
+	// pull bandwidth
+	overrideIfNotEmpty(&base.PullBandwidth.Limit, override.PullBandwidth.Limit)
+	overrideSliceIfNotNil(&base.PullBandwidth.Windows, override.PullBandwidth.Windows)
+	overrideSliceIfNotNil(&base.PullBandwidth.AllowedHosts, override.PullBandwidth.AllowedHosts)
+
+	// local api
+	overrideIfNotEmpty(&base.LocalAPI.Enabled, override.LocalAPI.Enabled)
+	overrideIfNotEmpty(&base.LocalAPI.SocketPath, override.LocalAPI.SocketPath)
+	overrideIfNotEmpty(&base.LocalAPI.SocketGroup, override.LocalAPI.SocketGroup)
+
+	// log forwarding
+	overrideIfNotEmpty(&base.LogForwarding.Enabled, override.LogForwarding.Enabled)
+	overrideIfNotEmpty(&base.LogForwarding.Endpoint, override.LogForwarding.Endpoint)
+	overrideIfNotEmpty(&base.LogForwarding.CertFile, override.LogForwarding.CertFile)
+	overrideIfNotEmpty(&base.LogForwarding.KeyFile, override.LogForwarding.KeyFile)
+	overrideIfNotEmpty(&base.LogForwarding.Journald, override.LogForwarding.Journald)
+	overrideSliceIfNotNil(&base.LogForwarding.Units, override.LogForwarding.Units)
+	overrideIfNotEmpty(&base.LogForwarding.Applications, override.LogForwarding.Applications)
+	overrideIfNotEmpty(&base.LogForwarding.Interval, override.LogForwarding.Interval)
+
 	maps.Copy(base.DefaultLabels, override.DefaultLabels)
 	maps.Copy(base.LabelFromSystemInfo, override.LabelFromSystemInfo)
 }
@@ -4,8 +4,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
@@ -438,3 +440,85 @@ func TestLoadWithOverrides_DropinErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBandwidth(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{input: "", expected: 0},
+		{input: "64bps", expected: 64},
+		{input: "512kbps", expected: 512_000},
+		{input: "1.5Mbps", expected: 1_500_000},
+		{input: "1Gbps", expected: 1_000_000_000},
+		{input: "2MB", wantErr: true},
+		{input: "Mbps", wantErr: true},
+		{input: "-1Mbps", wantErr: true},
+		{input: "4bps", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			bps, err := ParseBandwidth(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, bps)
+		})
+	}
+}
+
+func TestPullBandwidthValidate(t *testing.T) {
+	require := require.New(t)
+	valid := PullBandwidth{
+		Limit:        "2Mbps",
+		Windows:      []PullBandwidthWindow{{Start: "22:00", End: "06:00", Limit: "20Mbps"}, {Start: "12:00", End: "13:00"}},
+		AllowedHosts: []string{"cdn.example.com", "*.cloudfront.net", "10.0.0.5:5000"},
+	}
+	require.NoError(valid.Validate())
+	require.True(valid.IsLimited())
+	require.False(PullBandwidth{Windows: []PullBandwidthWindow{{Start: "22:00", End: "06:00"}}}.IsLimited())
+
+	require.ErrorContains(PullBandwidth{Limit: "fast"}.Validate(), "pull-bandwidth.limit")
+	require.ErrorContains(PullBandwidth{Windows: []PullBandwidthWindow{{Start: "25:00", End: "06:00"}}}.Validate(), "pull-bandwidth.windows[0].start")
+	require.ErrorContains(PullBandwidth{Windows: []PullBandwidthWindow{{Start: "06:00", End: "06:00"}}}.Validate(), "start and end must differ")
+	require.ErrorContains(PullBandwidth{AllowedHosts: []string{"https://cdn.example.com"}}.Validate(), "pull-bandwidth.allowed-hosts[0]")
+}
+
+func TestDeriveTelemetryGatewayEndpoint(t *testing.T) {
+	require := require.New(t)
+	got, err := deriveTelemetryGatewayEndpoint("https://agent-api.example.com:7443")
+	require.NoError(err)
+	require.Equal("telemetry.example.com:4317", got)
+
+	got, err = deriveTelemetryGatewayEndpoint("https://192.168.1.10:7443")
+	require.NoError(err)
+	require.Equal("192.168.1.10:4317", got)
+
+	_, err = deriveTelemetryGatewayEndpoint("://bad-url")
+	require.Error(err)
+}
+
+func TestLogForwardingValidate(t *testing.T) {
+	require := require.New(t)
+	valid := NewDefault().LogForwarding
+	valid.Enabled = true
+	valid.Endpoint = "telemetry.example.com:4317"
+	valid.Applications = true
+	require.NoError(valid.Validate())
+	require.NoError(LogForwarding{Endpoint: "no-port"}.Validate(), "disabled log forwarding is not validated")
+
+	noSource := valid
+	noSource.Applications = false
+	require.ErrorContains(noSource.Validate(), "at least one of journald or applications")
+
+	badEndpoint := valid
+	badEndpoint.Endpoint = "telemetry.example.com"
+	require.ErrorContains(badEndpoint.Validate(), "log-forwarding.endpoint")
+
+	badInterval := valid
+	badInterval.Interval = util.Duration(time.Second)
+	require.ErrorContains(badInterval.Validate(), "log-forwarding.interval")
+}
@@ -0,0 +1,535 @@
+package dependency
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	// bandwidthUpdateInterval is how often the bandwidth limit is updated to the current time of day.
+	bandwidthUpdateInterval = time.Minute
+	// proxyDialTimeout bounds connecting to a registry through the proxy.
+	proxyDialTimeout = 30 * time.Second
+	// proxyCopyBufferSize is the size of the chunks paced by the rate limiter.
+	proxyCopyBufferSize = 16 * 1024
+	// proxyUsername is the user name of the proxy URL of a pull, whose password is the pull's
+	// token.
+	proxyUsername = "pull"
+)
+
+// bandwidthSchedule is the bandwidth limit for prefetching by time of day.
+type bandwidthSchedule struct {
+	// limit is the limit in bits per second outside of windows, 0 if unlimited.
+	limit   int64
+	windows []bandwidthWindow
+}
+
+type bandwidthWindow struct {
+	start time.Duration
+	end   time.Duration
+	limit int64
+}
+
+func newBandwidthSchedule(cfg config.PullBandwidth) (*bandwidthSchedule, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	// errors are already checked by Validate.
+	s := &bandwidthSchedule{}
+	s.limit, _ = config.ParseBandwidth(cfg.Limit)
+	for _, w := range cfg.Windows {
+		start, _ := config.ParseTimeOfDay(w.Start)
+		end, _ := config.ParseTimeOfDay(w.End)
+		limit, _ := config.ParseBandwidth(w.Limit)
+		s.windows = append(s.windows, bandwidthWindow{start: start, end: end, limit: limit})
+	}
+	return s, nil
+}
+
+// limitAt returns the limit in bits per second at the local time t, 0 if unlimited.
+func (s *bandwidthSchedule) limitAt(t time.Time) int64 {
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	for _, w := range s.windows {
+		if w.contains(sinceMidnight) {
+			return w.limit
+		}
+	}
+	return s.limit
+}
+
+func (w bandwidthWindow) contains(sinceMidnight time.Duration) bool {
+	if w.start < w.end {
+		return sinceMidnight >= w.start && sinceMidnight < w.end
+	}
+	// the window spans midnight
+	return sinceMidnight >= w.start || sinceMidnight < w.end
+}
+
+// rateLimiter paces reads of all connections to a shared rate. It holds no burst: time
+// not used to transfer data is not saved up for later.
+type rateLimiter struct {
+	mu sync.Mutex
+	// bytesPerSecond is the rate, 0 if unlimited.
+	bytesPerSecond int64
+	next           time.Time
+
+	// this is used for testing to override time.Now
+	nowFn func() time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{nowFn: time.Now}
+}
+
+func (l *rateLimiter) setLimit(bitsPerSecond int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.bytesPerSecond = bitsPerSecond / 8
+}
+
+// reserve accounts for n bytes and returns how long the caller must wait before using them.
+func (l *rateLimiter) reserve(n int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.bytesPerSecond <= 0 {
+		return 0
+	}
+	now := l.nowFn()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.bytesPerSecond))
+	return delay
+}
+
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	delay := l.reserve(n)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttlingProxy is an HTTP proxy on the loopback interface that limits the bandwidth of the
+// image and artifact pulls routed through it. Registry traffic is tunneled with CONNECT, so TLS
+// is terminated by the pulling client as without the proxy. The proxy also counts the bytes it
+// receives, which is used to report the progress of pulls.
+//
+// Only pulls authorized by the agent may use the proxy: each pull authenticates with its own
+// secret token and may only connect to the hosts it was authorized for, so that other local
+// users cannot use the proxy, and with it the agent's own proxy and credentials, to reach
+// arbitrary hosts.
+//
+// If the agent itself is configured to use a proxy through the environment, connections are
+// made through that proxy.
+type throttlingProxy struct {
+	schedule *bandwidthSchedule
+	limiter  *rateLimiter
+	received atomic.Int64
+	limit    atomic.Int64
+	listener net.Listener
+	upstream func(*http.Request) (*url.URL, error)
+	log      *log.PrefixLogger
+	// allowedHosts are the hosts of the configuration that all pulls may connect to.
+	allowedHosts []string
+
+	mu sync.Mutex
+	// pulls are the hosts each authorized pull may connect to, by the pull's token.
+	pulls map[string][]string
+}
+
+func newThrottlingProxy(cfg config.PullBandwidth, log *log.PrefixLogger) (*throttlingProxy, error) {
+	schedule, err := newBandwidthSchedule(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &throttlingProxy{
+		schedule:     schedule,
+		limiter:      newRateLimiter(),
+		upstream:     http.ProxyFromEnvironment,
+		log:          log,
+		allowedHosts: cfg.AllowedHosts,
+		pulls:        make(map[string][]string),
+	}, nil
+}
+
+// Listen starts listening on an ephemeral loopback port.
+func (p *throttlingProxy) Listen() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("listening for bandwidth limiting proxy: %w", err)
+	}
+	p.listener = listener
+	p.updateLimit(time.Now())
+	return nil
+}
+
+// authorize allows a pull to connect to the hosts through the proxy until release is called.
+// It returns the proxy URL of the pull, which carries the pull's token. A host may have a port
+// and otherwise matches the HTTPS and HTTP ports, and a host starting with "*." matches its
+// subdomains.
+func (p *throttlingProxy) authorize(hosts []string) (proxyURL string, release func()) {
+	token := rand.Text()
+	p.mu.Lock()
+	p.pulls[token] = append(slices.Clone(hosts), p.allowedHosts...)
+	p.mu.Unlock()
+
+	u := &url.URL{
+		Scheme: "http",
+		User:   url.UserPassword(proxyUsername, token),
+		Host:   p.listener.Addr().String(),
+	}
+	return u.String(), func() {
+		p.mu.Lock()
+		delete(p.pulls, token)
+		p.mu.Unlock()
+	}
+}
+
+// authorizedHosts returns the hosts the pull authenticated by the request may connect to.
+func (p *throttlingProxy) authorizedHosts(r *http.Request) ([]string, bool) {
+	auth := &http.Request{Header: http.Header{"Authorization": r.Header.Values("Proxy-Authorization")}}
+	username, token, ok := auth.BasicAuth()
+	if !ok || username != proxyUsername {
+		return nil, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for pullToken, hosts := range p.pulls {
+		if subtle.ConstantTimeCompare([]byte(pullToken), []byte(token)) == 1 {
+			return hosts, true
+		}
+	}
+	return nil, false
+}
+
+// Run serves the proxy until ctx is canceled. Listen must have been called.
+func (p *throttlingProxy) Run(ctx context.Context) {
+	srv := &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: proxyDialTimeout,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		ticker := time.NewTicker(bandwidthUpdateInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = srv.Close()
+				return
+			case now := <-ticker.C:
+				p.updateLimit(now)
+			}
+		}
+	}()
+
+	if err := srv.Serve(p.listener); err != nil && err != http.ErrServerClosed {
+		p.log.Errorf("Bandwidth limiting proxy exited: %v", err)
+	}
+}
+
+func (p *throttlingProxy) updateLimit(now time.Time) {
+	limit := p.schedule.limitAt(now)
+	if p.limit.Swap(limit) != limit {
+		if limit > 0 {
+			p.log.Infof("Limiting prefetch bandwidth to %s", formatBitRate(limit))
+		} else {
+			p.log.Info("Not limiting prefetch bandwidth")
+		}
+	}
+	p.limiter.setLimit(limit)
+}
+
+// Limit returns the current bandwidth limit in bits per second, 0 if unlimited.
+func (p *throttlingProxy) Limit() int64 {
+	return p.limit.Load()
+}
+
+// BytesReceived returns the number of bytes received from registries.
+func (p *throttlingProxy) BytesReceived() int64 {
+	return p.received.Load()
+}
+
+func (p *throttlingProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hosts, ok := p.authorizedHosts(r)
+	if !ok {
+		w.Header().Set("Proxy-Authenticate", `Basic realm="flightctl"`)
+		http.Error(w, "proxy authentication required", http.StatusProxyAuthRequired)
+		return
+	}
+
+	address := r.Host
+	if r.Method != http.MethodConnect {
+		if !r.URL.IsAbs() {
+			http.Error(w, "only proxy requests are supported", http.StatusBadRequest)
+			return
+		}
+		address = r.URL.Host
+		if r.URL.Port() == "" {
+			port := "80"
+			if r.URL.Scheme == "https" {
+				port = "443"
+			}
+			address = net.JoinHostPort(r.URL.Hostname(), port)
+		}
+	}
+	if !hostAllowed(hosts, address) {
+		p.log.Warnf("Bandwidth limiting proxy denied connecting to %s, which the pull is not authorized for", address)
+		http.Error(w, fmt.Sprintf("connecting to %s is not allowed", address), http.StatusForbidden)
+		return
+	}
+
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r)
+		return
+	}
+	p.forward(w, r)
+}
+
+// wellKnownRegistryHosts are the hosts well-known registries serve pulls from besides their
+// own name. Podman pulls docker.io images from registry-1.docker.io, which authenticates with
+// auth.docker.io and redirects layer downloads to its CDN, and quay.io redirects layer
+// downloads to its CDN hosts.
+var wellKnownRegistryHosts = map[string][]string{
+	"docker.io": {"registry-1.docker.io", "auth.docker.io", "production.cloudflare.docker.com"},
+	"ghcr.io":   {"ghcr.io", "pkg-containers.githubusercontent.com"},
+	"quay.io":   {"quay.io", "*.quay.io"},
+}
+
+// registryHosts returns the hosts of the registry of the image.
+func registryHosts(image string) []string {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil
+	}
+	registry := reference.Domain(named)
+	if hosts, ok := wellKnownRegistryHosts[registry]; ok {
+		return hosts
+	}
+	return []string{registry}
+}
+
+// hostAllowed returns true if the address, as host:port, matches one of the hosts.
+func hostAllowed(hosts []string, address string) bool {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range hosts {
+		allowedHost, allowedPort, err := net.SplitHostPort(allowed)
+		if err != nil {
+			// no port matches the HTTPS and HTTP ports
+			allowedHost = allowed
+			if port != "443" && port != "80" {
+				continue
+			}
+		} else if port != allowedPort {
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(allowedHost, "*"); ok && strings.HasPrefix(suffix, ".") {
+			if len(host) > len(suffix) && strings.HasSuffix(strings.ToLower(host), strings.ToLower(suffix)) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(host, allowedHost) {
+			return true
+		}
+	}
+	return false
+}
+
+// tunnel connects the client to the requested host, limiting the traffic towards the client.
+func (p *throttlingProxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	upstream, err := p.dial(r.Context(), r.Host)
+	if err != nil {
+		p.log.Debugf("Bandwidth limiting proxy failed to connect to %s: %v", r.Host, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer upstream.Close()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		p.log.Debugf("Bandwidth limiting proxy failed to hijack connection: %v", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		// requests towards the registry are small and not limited.
+		_, _ = io.Copy(upstream, buffered)
+		cancel()
+	}()
+	_ = p.copyLimited(ctx, client, upstream)
+}
+
+// forward proxies a plain HTTP request, e.g. to an insecure registry.
+func (p *throttlingProxy) forward(w http.ResponseWriter, r *http.Request) {
+	outReq := r.Clone(r.Context())
+	outReq.RequestURI = ""
+	outReq.Header.Del("Proxy-Connection")
+	outReq.Header.Del("Proxy-Authorization")
+	transport := &http.Transport{Proxy: p.upstream, DialContext: (&net.Dialer{Timeout: proxyDialTimeout}).DialContext}
+	defer transport.CloseIdleConnections()
+
+	resp, err := transport.RoundTrip(outReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_ = p.copyLimited(r.Context(), w, resp.Body)
+}
+
+// dial connects to the address, through the agent's own proxy if one is configured.
+func (p *throttlingProxy) dial(ctx context.Context, address string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: proxyDialTimeout}
+	proxyURL, err := p.upstream(&http.Request{URL: &url.URL{Scheme: "https", Host: address}})
+	if err != nil {
+		return nil, fmt.Errorf("resolving upstream proxy: %w", err)
+	}
+	if proxyURL == nil {
+		return dialer.DialContext(ctx, "tcp", address)
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", proxyURL.Host)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to upstream proxy: %w", err)
+	}
+	connectReq := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		connectReq.SetBasicAuth(proxyURL.User.Username(), password)
+		connectReq.Header.Set("Proxy-Authorization", connectReq.Header.Get("Authorization"))
+		connectReq.Header.Del("Authorization")
+	}
+	_ = conn.SetDeadline(time.Now().Add(proxyDialTimeout))
+	if err := connectReq.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("connecting through upstream proxy: %w", err)
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(&singleByteReader{r: conn}), connectReq)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("connecting through upstream proxy: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("connecting through upstream proxy: %s", resp.Status)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// copyLimited copies from src to dst at the rate of the limiter, counting the bytes copied.
+func (p *throttlingProxy) copyLimited(ctx context.Context, dst io.Writer, src io.Reader) error {
+	buf := make([]byte, proxyCopyBufferSize)
+	for {
+		n, readErr := src.Read(buf)
+		if n > 0 {
+			if err := p.limiter.wait(ctx, n); err != nil {
+				return err
+			}
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return err
+			}
+			p.received.Add(int64(n))
+			if f, ok := dst.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+// singleByteReader reads one byte at a time, so that reading the response to CONNECT does not
+// consume data of the tunnel that follows it.
+type singleByteReader struct {
+	r io.Reader
+}
+
+func (s *singleByteReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	return s.r.Read(p[:1])
+}
+
+// formatBitRate formats bits per second for display.
+func formatBitRate(bps int64) string {
+	switch {
+	case bps >= 1e9:
+		return fmt.Sprintf("%.1f Gbps", float64(bps)/1e9)
+	case bps >= 1e6:
+		return fmt.Sprintf("%.1f Mbps", float64(bps)/1e6)
+	case bps >= 1e3:
+		return fmt.Sprintf("%.1f kbps", float64(bps)/1e3)
+	default:
+		return fmt.Sprintf("%d bps", bps)
+	}
+}
+
+// formatBytes formats a number of bytes for display.
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
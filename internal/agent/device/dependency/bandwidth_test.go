@@ -0,0 +1,197 @@
+package dependency
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthScheduleLimitAt(t *testing.T) {
+	require := require.New(t)
+	schedule, err := newBandwidthSchedule(config.PullBandwidth{
+		Limit: "2Mbps",
+		Windows: []config.PullBandwidthWindow{
+			{Start: "22:00", End: "06:00"},
+			{Start: "09:00", End: "17:00", Limit: "256kbps"},
+		},
+	})
+	require.NoError(err)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+	require.Equal(int64(0), schedule.limitAt(at(23, 30)))
+	require.Equal(int64(0), schedule.limitAt(at(5, 59)))
+	require.Equal(int64(2_000_000), schedule.limitAt(at(6, 0)))
+	require.Equal(int64(256_000), schedule.limitAt(at(9, 0)))
+	require.Equal(int64(2_000_000), schedule.limitAt(at(17, 0)))
+
+	_, err = newBandwidthSchedule(config.PullBandwidth{Limit: "fast"})
+	require.Error(err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	require := require.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter()
+	limiter.nowFn = func() time.Time { return now }
+
+	// unlimited
+	require.Zero(limiter.reserve(1 << 20))
+
+	limiter.setLimit(8000) // 1000 bytes per second
+	require.Zero(limiter.reserve(500))
+	require.Equal(500*time.Millisecond, limiter.reserve(1000))
+	require.Equal(1500*time.Millisecond, limiter.reserve(1000))
+
+	// unused time is not saved up
+	now = now.Add(10 * time.Second)
+	require.Zero(limiter.reserve(1000))
+	require.Equal(time.Second, limiter.reserve(1000))
+}
+
+func TestThrottlingProxy(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	body := strings.Repeat("layer", 1000)
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, body)
+	}))
+	defer registry.Close()
+
+	proxy, err := newThrottlingProxy(config.PullBandwidth{Limit: "100Mbps"}, log.NewPrefixLogger("test"))
+	require.NoError(err)
+	proxy.upstream = func(*http.Request) (*url.URL, error) { return nil, nil }
+	require.NoError(proxy.Listen())
+	go proxy.Run(ctx)
+	require.Equal(int64(100_000_000), proxy.Limit())
+
+	registryURL, err := url.Parse(registry.URL)
+	require.NoError(err)
+	get := func(proxyURL string) (*http.Response, error) {
+		u, err := url.Parse(proxyURL)
+		require.NoError(err)
+		transport := registry.Client().Transport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyURL(u)
+		httpClient := &http.Client{Transport: transport, Timeout: 10 * time.Second}
+		return httpClient.Get(registry.URL + "/v2/")
+	}
+
+	proxyURL, release := proxy.authorize([]string{registryURL.Host})
+	resp, err := get(proxyURL)
+	require.NoError(err)
+	defer resp.Body.Close()
+	received, err := io.ReadAll(resp.Body)
+	require.NoError(err)
+	require.Equal(body, string(received))
+	// the TLS handshake and headers are counted along with the body
+	require.Greater(proxy.BytesReceived(), int64(len(body)))
+
+	// a pull may only connect to the hosts it is authorized for
+	otherURL, releaseOther := proxy.authorize([]string{"quay.io"})
+	defer releaseOther()
+	_, err = get(otherURL)
+	require.ErrorContains(err, "Forbidden")
+
+	// the proxy requires the token of a current pull
+	release()
+	_, err = get(proxyURL)
+	require.ErrorContains(err, "Proxy Authentication Required")
+	unauthenticated, err := url.Parse(proxyURL)
+	require.NoError(err)
+	unauthenticated.User = nil
+	_, err = get(unauthenticated.String())
+	require.ErrorContains(err, "Proxy Authentication Required")
+}
+
+func TestHostAllowed(t *testing.T) {
+	require := require.New(t)
+	hosts := []string{"quay.io", "10.0.0.5:5000", "*.cloudfront.net"}
+	require.True(hostAllowed(hosts, "quay.io:443"))
+	require.True(hostAllowed(hosts, "QUAY.io:80"))
+	require.False(hostAllowed(hosts, "quay.io:22"))
+	require.False(hostAllowed(hosts, "cdn01.quay.io:443"))
+	require.True(hostAllowed(hosts, "10.0.0.5:5000"))
+	require.False(hostAllowed(hosts, "10.0.0.5:443"))
+	require.True(hostAllowed(hosts, "d1234.cloudfront.net:443"))
+	require.False(hostAllowed(hosts, "cloudfront.net:443"))
+	require.False(hostAllowed(hosts, "evilcloudfront.net:443"))
+	require.False(hostAllowed(hosts, "quay.io"))
+}
+
+func TestRegistryHosts(t *testing.T) {
+	require := require.New(t)
+	require.Equal([]string{"registry.example.com:5000"}, registryHosts("registry.example.com:5000/app:v1"))
+	require.Equal([]string{"registry-1.docker.io", "auth.docker.io", "production.cloudflare.docker.com"}, registryHosts("nginx"))
+	require.Equal([]string{"quay.io", "*.quay.io"}, registryHosts("quay.io/example/app@sha256:"+strings.Repeat("a", 64)))
+	require.Empty(registryHosts("not a reference"))
+}
+
+func TestLimitedPullStalled(t *testing.T) {
+	require := require.New(t)
+	logger := log.NewPrefixLogger("test")
+	m := &prefetchManager{log: logger, pullTimeout: 200 * time.Millisecond}
+	proxy, err := newThrottlingProxy(config.PullBandwidth{Limit: "1Mbps"}, logger)
+	require.NoError(err)
+	require.NoError(proxy.Listen())
+	m.proxy = proxy
+
+	target := imageRef{image: "quay.io/example/app:v1"}
+	var status PrefetchStatus
+	err = m.limitedPull(context.Background(), target, func(ctx context.Context, opts ...client.ClientOption) error {
+		status = m.status(ctx)
+		<-ctx.Done()
+		return ctx.Err()
+	}, nil)
+	require.ErrorIs(err, errors.ErrNetwork)
+	require.True(errors.IsRetryable(err))
+	require.Equal("quay.io/example/app:v1", status.Downloading)
+	require.Equal(int64(1_000_000), status.BandwidthLimit)
+	require.Empty(m.status(context.Background()).Downloading)
+}
+
+func TestLimitedPullReportsProgressWithoutLimit(t *testing.T) {
+	require := require.New(t)
+	logger := log.NewPrefixLogger("test")
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(t.TempDir())),
+		fileio.NewWriter(),
+	)
+	m := NewPrefetchManager(logger, nil, nil, nil, rw, util.Duration(time.Minute), nil, poll.Config{})
+	require.NotNil(m.proxy)
+	require.NoError(m.proxy.Listen())
+
+	target := imageRef{image: "quay.io/example/app:v1"}
+	var status PrefetchStatus
+	err := m.limitedPull(context.Background(), target, func(ctx context.Context, opts ...client.ClientOption) error {
+		status = m.status(ctx)
+		return nil
+	}, nil)
+	require.NoError(err)
+	require.Equal("quay.io/example/app:v1", status.Downloading)
+	require.Zero(status.BandwidthLimit)
+}
+
+func TestFormatBitRate(t *testing.T) {
+	require := require.New(t)
+	require.Equal("800 bps", formatBitRate(800))
+	require.Equal("256.0 kbps", formatBitRate(256_000))
+	require.Equal("1.5 Mbps", formatBitRate(1_500_000))
+	require.Equal("1.5 MiB", formatBytes(1536*1024))
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/resource"
@@ -23,6 +24,9 @@ import (
 
 const (
 	maxQueueSize = 10
+	// maxLimitedPullTimeout bounds pulls through the bandwidth limiting proxy, which are
+	// otherwise only canceled when they stall.
+	maxLimitedPullTimeout = 24 * time.Hour
 )
 
 const (
@@ -131,6 +135,12 @@ type PrefetchStatus struct {
 	TotalImages    int
 	PendingImages  []string
 	RetryingImages []string
+	// Downloading is the image currently pulled through the bandwidth limiting proxy, if any.
+	Downloading string
+	// DownloadedBytes is the number of bytes received for the image currently downloading.
+	DownloadedBytes int64
+	// BandwidthLimit is the current bandwidth limit in bits per second, 0 if unlimited.
+	BandwidthLimit int64
 }
 
 var _ PrefetchManager = (*prefetchManager)(nil)
@@ -204,11 +214,51 @@ type prefetchManager struct {
 	// encounters an error
 	pullTimeout time.Duration
 	pollConfig  *poll.Config
+	// proxy limits the bandwidth of podman pulls and counts their progress, nil if
+	// it is not available.
+	proxy *throttlingProxy
+	// mirrors are the registry mirrors limited pulls may connect to, nil if none.
+	mirrors RegistryMirrors
+	// embeddedImages are the images embedded into the OS image, which are
+	// always present.
+	embeddedImages map[string]struct{}
 
 	mu         sync.Mutex
 	tasks      map[imageRef]*prefetchTask
 	queue      chan imageRef
 	collectors []OCICollector
+	// downloading is the target currently pulled through the proxy and
+	// downloadStart the bytes received by the proxy when it started.
+	downloading   *imageRef
+	downloadStart int64
+}
+
+type prefetchManagerOpts struct {
+	pullBandwidth config.PullBandwidth
+	mirrors       RegistryMirrors
+}
+
+// RegistryMirrors returns the hosts of the mirrors of an image's registry.
+type RegistryMirrors interface {
+	MirrorHosts(image string) []string
+}
+
+// PrefetchManagerOpt is a functional option for the prefetch manager.
+type PrefetchManagerOpt func(*prefetchManagerOpts)
+
+// WithRegistryMirrors allows pulls through the bandwidth limiting proxy to connect to the
+// mirrors of the registries of the images they pull.
+func WithRegistryMirrors(mirrors RegistryMirrors) PrefetchManagerOpt {
+	return func(o *prefetchManagerOpts) {
+		o.mirrors = mirrors
+	}
+}
+
+// WithPullBandwidth limits the bandwidth used to prefetch podman images and artifacts.
+func WithPullBandwidth(pullBandwidth config.PullBandwidth) PrefetchManagerOpt {
+	return func(o *prefetchManagerOpts) {
+		o.pullBandwidth = pullBandwidth
+	}
 }
 
 type prefetchTask struct {
@@ -230,8 +280,14 @@ func NewPrefetchManager(
 	pullTimeout util.Duration,
 	resourceManager resource.Manager,
 	pollConfig poll.Config,
+	opts ...PrefetchManagerOpt,
 ) *prefetchManager {
-	return &prefetchManager{
+	var options prefetchManagerOpts
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	m := &prefetchManager{
 		log:             log,
 		podmanFactory:   podmanFactory,
 		skopeoFactory:   skopeoFactory,
@@ -240,9 +296,29 @@ func NewPrefetchManager(
 		pullTimeout:     time.Duration(pullTimeout),
 		pollConfig:      &pollConfig,
 		resourceManager: resourceManager,
+		mirrors:         options.mirrors,
 		tasks:           make(map[imageRef]*prefetchTask),
 		queue:           make(chan imageRef, maxQueueSize),
 	}
+
+	embeddedImages, err := ReadEmbeddedImages(readWriter)
+	if err != nil {
+		log.Errorf("Failed to read embedded images: %v", err)
+	} else if len(embeddedImages) > 0 {
+		log.Infof("OS image has %d embedded images", len(embeddedImages))
+		m.embeddedImages = embeddedImages
+	}
+
+	// podman pulls go through the proxy even when the bandwidth is not limited, as the proxy
+	// counts the bytes received to report the progress of pulls.
+	proxy, err := newThrottlingProxy(options.pullBandwidth, log)
+	if err != nil {
+		// the config is validated on load, so this is not expected.
+		log.Errorf("Not limiting prefetch bandwidth: %v", err)
+	} else {
+		m.proxy = proxy
+	}
+	return m
 }
 
 func (m *prefetchManager) Run(ctx context.Context) {
@@ -250,6 +326,19 @@ func (m *prefetchManager) Run(ctx context.Context) {
 	defer m.log.Debug("Prefetch manager stopped")
 
 	var wg sync.WaitGroup
+	if m.proxy != nil {
+		if err := m.proxy.Listen(); err != nil {
+			m.log.Errorf("Not limiting prefetch bandwidth: %v", err)
+			m.proxy = nil
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m.proxy.Run(ctx)
+			}()
+		}
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -430,13 +519,8 @@ func (m *prefetchManager) processTarget(ctx context.Context, target imageRef) {
 				m.log.Warnf("Retrying prefetch for %s (attempt %d): %v", target, retries+1, err)
 				m.setError(target, fmt.Errorf("%w: %w", errors.WithElement(target.image), err))
 
-				// cleanup file system from partial layer pulls
-				if err := m.cleanupPartialLayers(ctx, target.owner); err != nil {
-					m.log.Warnf("cleanup failed: %v", err)
-				} else {
-					m.log.Debug("cleanup completed successfully")
-				}
-
+				// partial layer pulls are kept so that the retry does not download
+				// them again.
 				select {
 				case <-time.After(poll.CalculateBackoffDelay(m.pollConfig, retries)):
 					continue
@@ -445,6 +529,13 @@ func (m *prefetchManager) processTarget(ctx context.Context, target imageRef) {
 					return
 				}
 			}
+
+			// cleanup file system from partial layer pulls
+			if err := m.cleanupPartialLayers(ctx, target.owner); err != nil {
+				m.log.Warnf("cleanup failed: %v", err)
+			} else {
+				m.log.Debug("cleanup completed successfully")
+			}
 			m.setResult(target, fmt.Errorf("pulling oci target %w: %w", errors.WithElement(target.image), err))
 			return
 		}
@@ -473,13 +564,22 @@ func (m *prefetchManager) pull(ctx context.Context, target imageRef, task *prefe
 		opts = append(opts, task.clientOptsFn()...)
 	}
 
+	pullImage := func(ctx context.Context, opts ...client.ClientOption) error {
+		_, err := podman.Pull(ctx, target.image, opts...)
+		return err
+	}
+	pullArtifact := func(ctx context.Context, opts ...client.ClientOption) error {
+		_, err := podman.PullArtifact(ctx, target.image, opts...)
+		return err
+	}
+
 	switch ociType {
 	case OCITypePodmanImage:
-		_, err = podman.Pull(ctx, target.image, opts...)
+		err = m.limitedPull(ctx, target, pullImage, opts)
 	case OCITypeCRIImage:
 		_, err = m.cliClients.CRI().Pull(ctx, target.image, opts...)
 	case OCITypePodmanArtifact:
-		_, err = podman.PullArtifact(ctx, target.image, opts...)
+		err = m.limitedPull(ctx, target, pullArtifact, opts)
 	case OCITypeHelmChart:
 		err = m.cliClients.Helm().Resolve(ctx, target.image, opts...)
 	case OCITypeAuto:
@@ -498,9 +598,9 @@ func (m *prefetchManager) pull(ctx context.Context, target imageRef, task *prefe
 
 		switch detectedType {
 		case OCITypePodmanImage:
-			_, err = podman.Pull(ctx, target.image, opts...)
+			err = m.limitedPull(ctx, target, pullImage, opts)
 		case OCITypePodmanArtifact:
-			_, err = podman.PullArtifact(ctx, target.image, opts...)
+			err = m.limitedPull(ctx, target, pullArtifact, opts)
 		default:
 			return fmt.Errorf("unexpected detected OCI type: %s", detectedType)
 		}
@@ -510,6 +610,74 @@ func (m *prefetchManager) pull(ctx context.Context, target imageRef, task *prefe
 	return err
 }
 
+// limitedPull runs the pull through the bandwidth limiting proxy if available, which limits
+// its bandwidth and reports its progress. The pull may only connect to the registry of the
+// image and its mirrors through the proxy. As a limited pull of a large image can take much
+// longer than the pull timeout, it is then only canceled when no data is received for the
+// pull timeout.
+func (m *prefetchManager) limitedPull(ctx context.Context, target imageRef, pullFn func(context.Context, ...client.ClientOption) error, opts []client.ClientOption) error {
+	if m.proxy == nil {
+		return pullFn(ctx, opts...)
+	}
+
+	m.mu.Lock()
+	m.downloading = &target
+	m.downloadStart = m.proxy.BytesReceived()
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.downloading = nil
+		m.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	go m.watchStall(ctx, cancel)
+
+	hosts := registryHosts(target.image)
+	if m.mirrors != nil {
+		hosts = append(hosts, m.mirrors.MirrorHosts(target.image)...)
+	}
+	proxyURL, release := m.proxy.authorize(hosts)
+	defer release()
+
+	opts = append(opts, client.WithProxy(proxyURL), client.Timeout(maxLimitedPullTimeout))
+	err := pullFn(ctx, opts...)
+	if err != nil && errors.Is(context.Cause(ctx), errPullStalled) {
+		return fmt.Errorf("%w: no data received for %s", errors.ErrNetwork, m.pullTimeout)
+	}
+	return err
+}
+
+var errPullStalled = errors.New("pull stalled")
+
+// watchStall cancels the pull when the proxy receives no data for the pull timeout.
+func (m *prefetchManager) watchStall(ctx context.Context, cancel context.CancelCauseFunc) {
+	interval := min(max(m.pullTimeout/10, 100*time.Millisecond), 10*time.Second)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	received := m.proxy.BytesReceived()
+	lastProgress := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if current := m.proxy.BytesReceived(); current != received {
+				received = current
+				lastProgress = now
+				continue
+			}
+			if now.Sub(lastProgress) >= m.pullTimeout {
+				m.log.Warnf("No data received for %s, canceling pull", m.pullTimeout)
+				cancel(errPullStalled)
+				return
+			}
+		}
+	}
+}
+
 func (m *prefetchManager) setResult(target imageRef, err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -577,6 +745,16 @@ func (m *prefetchManager) prepareTask(ctx context.Context, target imageRef, ociT
 		return false, nil
 	}
 
+	// images embedded into the OS image are pre-seeded and cannot be pulled again
+	if _, embedded := m.embeddedImages[target.image]; embedded && (ociType == OCITypePodmanImage || ociType == OCITypeAuto) {
+		m.log.Debugf("Scheduled prefetch target is embedded in the OS image: %s", target)
+		m.tasks[target] = &prefetchTask{
+			ociType: ociType,
+			done:    true,
+		}
+		return false, nil
+	}
+
 	podman, err := m.podmanFactory(target.owner)
 	if err != nil {
 		return false, fmt.Errorf("creating podman client: %w", err)
@@ -753,6 +931,17 @@ func (m *prefetchManager) cleanupPartialLayers(ctx context.Context, user v1beta1
 
 func (m *prefetchManager) StatusMessage(ctx context.Context) string {
 	status := m.status(ctx)
+	msg := statusMessage(status)
+	if status.Downloading != "" {
+		msg = fmt.Sprintf("%s, downloading %s: %s received", msg, status.Downloading, formatBytes(status.DownloadedBytes))
+		if status.BandwidthLimit > 0 {
+			msg = fmt.Sprintf("%s at up to %s", msg, formatBitRate(status.BandwidthLimit))
+		}
+	}
+	return msg
+}
+
+func statusMessage(status PrefetchStatus) string {
 	pending := status.PendingImages
 	retrying := status.RetryingImages
 	total := status.TotalImages
@@ -804,9 +993,15 @@ func (m *prefetchManager) status(ctx context.Context) PrefetchStatus {
 	slices.Sort(pendingImages)
 	slices.Sort(retryingImages)
 
-	return PrefetchStatus{
+	status := PrefetchStatus{
 		TotalImages:    len(m.tasks),
 		PendingImages:  pendingImages,
 		RetryingImages: retryingImages,
 	}
+	if m.downloading != nil {
+		status.Downloading = m.downloading.image
+		status.DownloadedBytes = m.proxy.BytesReceived() - m.downloadStart
+		status.BandwidthLimit = m.proxy.Limit()
+	}
+	return status
 }
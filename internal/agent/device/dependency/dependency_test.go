@@ -878,6 +878,21 @@ func TestStatusMessage(t *testing.T) {
 			},
 			expected: "1/5 images complete, pending: image2, image3, image4 and 1 more",
 		},
+		{
+			name: "image downloading with bandwidth limit",
+			setupManager: func(m *prefetchManager) {
+				m.tasks = map[imageRef]*prefetchTask{
+					{image: "image1"}: {done: true, err: nil},
+					{image: "image2"}: {done: false, err: nil},
+				}
+				m.proxy = &throttlingProxy{}
+				m.proxy.received.Store(3 * 1024 * 1024)
+				m.proxy.limit.Store(512_000)
+				m.downloading = &imageRef{image: "image2"}
+				m.downloadStart = 1024 * 1024
+			},
+			expected: "1/2 images complete, pending: image2, downloading image2: 2.0 MiB received at up to 512.0 kbps",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1613,3 +1628,96 @@ func TestDetectOCIType(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessTargetPartialLayers(t *testing.T) {
+	const partialDir = "container_images_storage123"
+	tests := []struct {
+		name        string
+		pullResults [][]any
+		expectError bool
+		expectKept  bool
+	}{
+		{
+			name: "interrupted pull keeps partial layers for the retry",
+			pullResults: [][]any{
+				{"", "Error: copying blob: unexpected EOF", 125},
+				{"sha256:1234", "", 0},
+			},
+			expectKept: true,
+		},
+		{
+			name: "failed pull removes partial layers",
+			pullResults: [][]any{
+				{"", "Error: invalid reference format", 125},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			rootDir := t.TempDir()
+			tmpDir := filepath.Join(rootDir, "var", "tmp")
+			require.NoError(os.MkdirAll(filepath.Join(tmpDir, partialDir), 0755))
+			rw := fileio.NewReadWriter(
+				fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+				fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+			)
+
+			logger := log.NewPrefixLogger("test")
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockExec := executer.NewMockExecuter(ctrl)
+			var calls []any
+			for _, result := range tt.pullResults {
+				calls = append(calls, mockExec.EXPECT().
+					ExecuteWithContext(gomock.Any(), "podman", "pull", testImageV1).
+					Return(result...))
+			}
+			gomock.InOrder(calls...)
+			mockExec.EXPECT().
+				ExecuteWithContext(gomock.Any(), "podman", "info", "--format", "{{.Store.ImageCopyTmpDir}}").
+				Return("/var/tmp", "", 0).
+				AnyTimes()
+
+			backoff := poll.Config{BaseDelay: time.Millisecond, Factor: 1, MaxSteps: 1}
+			var podmanFactory client.PodmanFactory = func(user v1beta1.Username) (*client.Podman, error) {
+				return client.NewPodman(logger, mockExec, rw, backoff), nil
+			}
+			var skopeoFactory client.SkopeoFactory = func(u v1beta1.Username) (*client.Skopeo, error) {
+				return client.NewSkopeo(logger, mockExec, rw), nil
+			}
+
+			target := imageRef{image: testImageV1}
+			pm := &prefetchManager{
+				log:           logger,
+				podmanFactory: podmanFactory,
+				skopeoFactory: skopeoFactory,
+				cliClients:    client.NewCLIClients(),
+				readWriter:    rw,
+				pullTimeout:   5 * time.Minute,
+				pollConfig:    &poll.Config{BaseDelay: time.Millisecond, Factor: 1},
+				tasks:         map[imageRef]*prefetchTask{target: {ociType: OCITypePodmanImage}},
+			}
+
+			pm.processTarget(context.Background(), target)
+
+			task := pm.tasks[target]
+			require.True(task.done)
+			if tt.expectError {
+				require.Error(task.err)
+			} else {
+				require.NoError(task.err)
+			}
+			_, err := os.Stat(filepath.Join(tmpDir, partialDir))
+			if tt.expectKept {
+				require.NoError(err)
+			} else {
+				require.True(os.IsNotExist(err))
+			}
+		})
+	}
+}
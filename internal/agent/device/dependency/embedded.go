@@ -0,0 +1,36 @@
+package dependency
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+)
+
+// EmbeddedImagesFile lists the images embedded into the OS image at build time, one per
+// line. The images are stored in a read-only additional image store of podman, so they
+// are present without being pulled and cannot be removed.
+const EmbeddedImagesFile = "/usr/lib/flightctl/embedded-images"
+
+// ReadEmbeddedImages returns the set of images embedded into the OS image. It returns an
+// empty set if the OS image has no embedded images.
+func ReadEmbeddedImages(reader fileio.Reader) (map[string]struct{}, error) {
+	images := make(map[string]struct{})
+	contents, err := reader.ReadFile(EmbeddedImagesFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return images, nil
+		}
+		return nil, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		if image := strings.TrimSpace(scanner.Text()); image != "" {
+			images[image] = struct{}{}
+		}
+	}
+	return images, scanner.Err()
+}
@@ -0,0 +1,71 @@
+package dependency
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newEmbeddedImagesReadWriter(t *testing.T, contents string) fileio.ReadWriter {
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	if contents != "" {
+		require.NoError(t, rw.MkdirAll(filepath.Dir(EmbeddedImagesFile), fileio.DefaultDirectoryPermissions))
+		require.NoError(t, rw.WriteFile(EmbeddedImagesFile, []byte(contents), fileio.DefaultFilePermissions))
+	}
+	return rw
+}
+
+func TestReadEmbeddedImages(t *testing.T) {
+	require := require.New(t)
+
+	images, err := ReadEmbeddedImages(newEmbeddedImagesReadWriter(t, ""))
+	require.NoError(err)
+	require.Empty(images)
+
+	images, err = ReadEmbeddedImages(newEmbeddedImagesReadWriter(t, testImageV1+"\n\n"+testImageV2+"\n"))
+	require.NoError(err)
+	require.Equal(map[string]struct{}{testImageV1: {}, testImageV2: {}}, images)
+}
+
+func TestPrepareTaskEmbeddedImage(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	logger := log.NewPrefixLogger("test")
+
+	rw := newEmbeddedImagesReadWriter(t, testImageV1+"\n")
+	mockExec := executer.NewMockExecuter(ctrl)
+	podman := client.NewPodman(logger, mockExec, rw, poll.Config{})
+	podmanFactory := func(v1beta1.Username) (*client.Podman, error) { return podman, nil }
+	manager := NewPrefetchManager(logger, podmanFactory, nil, client.NewCLIClients(), rw, util.Duration(time.Minute), nil, poll.Config{})
+
+	// embedded images are done without checking for or pulling them
+	needsQueue, err := manager.prepareTask(t.Context(), imageRef{image: testImageV1}, OCITypePodmanImage, nil)
+	require.NoError(err)
+	require.False(needsQueue)
+	require.True(manager.tasks[imageRef{image: testImageV1}].done)
+
+	// embedded images are only container images
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"artifact", "inspect", testImageV1}).Return("", "", 1)
+	needsQueue, err = manager.prepareTask(t.Context(), imageRef{image: testImageV1, owner: "user"}, OCITypePodmanArtifact, nil)
+	require.NoError(err)
+	require.True(needsQueue)
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"image", "exists", testImageV2}).Return("", "", 1)
+	needsQueue, err = manager.prepareTask(t.Context(), imageRef{image: testImageV2}, OCITypePodmanImage, nil)
+	require.NoError(err)
+	require.True(needsQueue)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/os"
 	"github.com/flightctl/flightctl/internal/agent/device/policy"
+	"github.com/flightctl/flightctl/internal/agent/device/registries"
 	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
@@ -51,6 +52,7 @@ type Agent struct {
 	prefetchManager        dependency.PrefetchManager
 	pullConfigResolver     dependency.PullConfigResolver
 	pruningManager         imagepruning.Manager
+	registriesManager      registries.Manager
 	osMode                 v1beta1.OsModeType
 
 	statusUpdateInterval util.Duration
@@ -84,6 +86,7 @@ func NewAgent(
 	prefetchManager dependency.PrefetchManager,
 	pullConfigResolver dependency.PullConfigResolver,
 	pruningManager imagepruning.Manager,
+	registriesManager registries.Manager,
 	osMode v1beta1.OsModeType,
 	backoff wait.Backoff,
 	log *log.PrefixLogger,
@@ -111,6 +114,7 @@ func NewAgent(
 		prefetchManager:        prefetchManager,
 		pullConfigResolver:     pullConfigResolver,
 		pruningManager:         pruningManager,
+		registriesManager:      registriesManager,
 		osMode:                 osMode,
 		backoff:                backoff,
 		log:                    log,
@@ -228,6 +232,7 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 
 		// Policy or critical resource alerts may defer update; in all cases, warn and roll back to the previous renderedVersion.
 		if errors.Is(syncErr, errors.ErrUpdatePolicyNotReady) || errors.Is(syncErr, errors.ErrDownloadPolicyNotReady) ||
+			errors.Is(syncErr, errors.ErrUpdateInhibited) || errors.Is(syncErr, errors.ErrUpdateConditionsNotMet) ||
 			errors.Is(syncErr, errors.ErrCriticalResourceAlert) {
 			a.log.Warnf("Requeuing version %s: %s", current.Version(), syncErr.Error())
 		} else {
@@ -463,6 +468,12 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 		return fmt.Errorf("%w: %w", errors.ErrComponentDownloadPolicy, err)
 	}
 
+	if a.specManager.IsUpgrading() {
+		if err := a.policyManager.CheckConditions(ctx, policy.Download, desired.Version()); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrComponentDownloadPolicy, err)
+		}
+	}
+
 	// the agent is validating the desired device spec and downloading
 	// dependencies. no changes have been made to the device's configuration
 	// yet.
@@ -480,6 +491,11 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 
 	a.pullConfigResolver.BeforeUpdate(desired.Spec)
 
+	// registry mirrors are configured before prefetching so that downloads use them.
+	if err := a.registriesManager.Sync(ctx, desired.Spec); err != nil {
+		return fmt.Errorf("%w: %w", errors.ErrComponentRegistries, err)
+	}
+
 	a.prefetchManager.RegisterOCICollector(a.appManager)
 	if a.specManager.ShouldApplyOSImageUpdate() {
 		a.prefetchManager.RegisterOCICollector(a.osManager)
@@ -519,6 +535,24 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 		return fmt.Errorf("%w: %w", errors.ErrComponentUpdatePolicy, err)
 	}
 
+	// conditions of the update policy and inhibitors held by applications on
+	// the device may defer an update, for example while on battery or while a
+	// production batch is running. both are only checked before the update
+	// starts: once started, it runs to completion.
+	if a.specManager.IsUpgrading() {
+		if err := a.policyManager.CheckConditions(ctx, policy.Update, desired.Version()); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrComponentUpdatePolicy, err)
+		}
+		if err := a.policyManager.CheckInhibitors(policy.InhibitUpdate); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrComponentUpdatePolicy, err)
+		}
+		if osUpdatePending {
+			if err := a.policyManager.CheckInhibitors(policy.InhibitReboot); err != nil {
+				return fmt.Errorf("%w: %w", errors.ErrComponentUpdatePolicy, err)
+			}
+		}
+	}
+
 	// the agent has validated the desired spec, downloaded all dependencies,
 	// and is ready to update. no changes have been made to the device's
 	// configuration yet.
@@ -566,6 +600,11 @@ func (a *Agent) syncDevice(ctx context.Context, current, desired *v1beta1.Device
 		return fmt.Errorf("%w: %w", errors.ErrComponentSystemd, err)
 	}
 
+	// a rollback skips beforeUpdate, so the registry mirrors are synced here as well.
+	if err := a.registriesManager.Sync(ctx, desired.Spec); err != nil {
+		return fmt.Errorf("%w: %w", errors.ErrComponentRegistries, err)
+	}
+
 	if err := a.lifecycleManager.Sync(ctx, current.Spec, desired.Spec); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrComponentLifecycle, err)
 	}
@@ -689,6 +728,28 @@ func (a *Agent) handleSyncError(ctx context.Context, desired *v1beta1.Device, sy
 	}
 
 	se := errors.FormatError(syncErr)
+	var inhibitedErr *policy.InhibitedError
+	var conditionsErr *policy.ConditionsNotMetError
+	var waitingState v1beta1.UpdateState
+	var waitingReason string
+	switch {
+	case errors.As(syncErr, &inhibitedErr):
+		waitingState, waitingReason = v1beta1.UpdateStateInhibited, inhibitedErr.Reason()
+	case errors.As(syncErr, &conditionsErr):
+		waitingState, waitingReason = v1beta1.UpdateStateWaitingForConditions, conditionsErr.Reason()
+	}
+	if waitingState != "" {
+		// the update has not started, report that it is waiting rather than retrying.
+		msg := fmt.Sprintf("Update to renderedVersion %s is waiting: %s", version, waitingReason)
+		conditionUpdate.Reason = string(waitingState)
+		conditionUpdate.Message = log.Truncate(msg, status.MaxMessageLength)
+		conditionUpdate.Status = v1beta1.ConditionStatusTrue
+		a.log.Info(msg)
+		if err := a.statusManager.UpdateCondition(ctx, conditionUpdate); err != nil {
+			a.log.Warnf("Failed to update device status condition: %v", err)
+		}
+		return
+	}
 	if !errors.IsRetryable(syncErr) {
 		msg := fmt.Sprintf("Failed to update to renderedVersion: %s: %v", version, syncErr.Error())
 		conditionUpdate.Reason = string(v1beta1.UpdateStateError)
@@ -3,6 +3,7 @@ package device
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/os"
 	"github.com/flightctl/flightctl/internal/agent/device/policy"
+	"github.com/flightctl/flightctl/internal/agent/device/registries"
 	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/spec/audit"
@@ -105,6 +107,8 @@ func TestSync(t *testing.T) {
 				mockSpecManager.EXPECT().ShouldApplyOSImageUpdate().Return(false).AnyTimes()
 				mockSpecManager.EXPECT().ShouldApplyOSImageUpdatePending(gomock.Any()).Return(false, nil).AnyTimes()
 				mockSpecManager.EXPECT().CheckPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockPolicyManager.EXPECT().CheckConditions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockPolicyManager.EXPECT().CheckInhibitors(gomock.Any()).Return(nil).AnyTimes()
 
 				// GetDesired, Read, and BeforeUpdate may be called multiple times if syncDeviceSpec is called again
 				mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil).AnyTimes()
@@ -167,6 +171,8 @@ func TestSync(t *testing.T) {
 				mockPrefetchManager.EXPECT().RegisterOCICollector(gomock.Any()).AnyTimes()
 				mockSpecManager.EXPECT().ShouldApplyOSImageUpdatePending(gomock.Any()).Return(false, nil).AnyTimes()
 				mockSpecManager.EXPECT().CheckPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockPolicyManager.EXPECT().CheckConditions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockPolicyManager.EXPECT().CheckInhibitors(gomock.Any()).Return(nil).AnyTimes()
 				mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil).AnyTimes()
 				mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil).AnyTimes()
 				mockResourceManager.EXPECT().BeforeUpdate(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -226,6 +232,8 @@ func TestSync(t *testing.T) {
 				mockPrefetchManager.EXPECT().RegisterOCICollector(gomock.Any()).AnyTimes()
 				mockSpecManager.EXPECT().ShouldApplyOSImageUpdatePending(gomock.Any()).Return(false, nil).AnyTimes()
 				mockSpecManager.EXPECT().CheckPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockPolicyManager.EXPECT().CheckConditions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockPolicyManager.EXPECT().CheckInhibitors(gomock.Any()).Return(nil).AnyTimes()
 				mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil).AnyTimes()
 				mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil).AnyTimes()
 				mockResourceManager.EXPECT().BeforeUpdate(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -363,6 +371,8 @@ func TestSync(t *testing.T) {
 			mockOSManager := os.NewMockManager(ctrl)
 			mockPruningManager := imagepruning.NewMockManager(ctrl)
 			mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
+			mockRegistriesManager := registries.NewMockManager(ctrl)
+			mockRegistriesManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			tc.setupMocks(
 				tc.current,
 				tc.desired,
@@ -427,6 +437,7 @@ func TestSync(t *testing.T) {
 				osManager:              mockOSManager,
 				pruningManager:         mockPruningManager,
 				pullConfigResolver:     mockPullConfigResolver,
+				registriesManager:      mockRegistriesManager,
 			}
 
 			// initial sync
@@ -525,7 +536,7 @@ func TestRollbackDevice(t *testing.T) {
 			)
 			dataDir := filepath.Join(tmpDir, "data")
 
-			policyManager := policy.NewManager(log)
+			policyManager := policy.NewManager(readWriter, executer.NewCommonExecuter(), log)
 			statusManager := status.NewManager(deviceName, log)
 			statusManager.SetClient(mockManagementClient)
 			mockAuditLogger := audit.NewMockLogger(ctrl)
@@ -713,6 +724,8 @@ func TestOSRollback(t *testing.T) {
 			mockOSManager := os.NewMockManager(ctrl)
 			mockPrefetchManager := dependency.NewMockPrefetchManager(ctrl)
 			mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
+			mockRegistriesManager := registries.NewMockManager(ctrl)
+			mockRegistriesManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			mockPrefetchManager.EXPECT().Cleanup().AnyTimes()
 			mockPullConfigResolver.EXPECT().Cleanup().AnyTimes()
@@ -732,6 +745,7 @@ func TestOSRollback(t *testing.T) {
 				osManager:          mockOSManager,
 				prefetchManager:    mockPrefetchManager,
 				pullConfigResolver: mockPullConfigResolver,
+				registriesManager:  mockRegistriesManager,
 			}
 
 			syncFnCalled := false
@@ -939,6 +953,8 @@ func TestSyncDeviceSpecPackageModeRejection(t *testing.T) {
 		mockOSManager := os.NewMockManager(ctrl)
 		mockPruningManager := imagepruning.NewMockManager(ctrl)
 		mockPullConfigResolver := dependency.NewMockPullConfigResolver(ctrl)
+		mockRegistriesManager := registries.NewMockManager(ctrl)
+		mockRegistriesManager.EXPECT().Sync(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		mockExec := executer.NewMockExecuter(ctrl)
 
 		logger := log.NewPrefixLogger("test")
@@ -1033,6 +1049,7 @@ func TestSyncDeviceSpecPackageModeRejection(t *testing.T) {
 			osManager:              mockOSManager,
 			pruningManager:         mockPruningManager,
 			pullConfigResolver:     mockPullConfigResolver,
+			registriesManager:      mockRegistriesManager,
 			osMode:                 v1beta1.OsModePackage,
 		}
 
@@ -1074,6 +1091,61 @@ func TestSyncDeviceSpecPackageModeRejection(t *testing.T) {
 	})
 }
 
+func TestHandleSyncErrorWaiting(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		expectedReason  v1beta1.UpdateState
+		expectedMessage string
+	}{
+		{
+			name: "inhibited",
+			err: &policy.InhibitedError{
+				Action:     policy.InhibitUpdate,
+				Inhibitors: []policy.Inhibitor{{ID: "batch-42", Reason: "production batch running"}},
+			},
+			expectedReason:  v1beta1.UpdateStateInhibited,
+			expectedMessage: `update inhibited by "batch-42" (production batch running)`,
+		},
+		{
+			name: "conditions not met",
+			err: &policy.ConditionsNotMetError{
+				PolicyType: policy.Update,
+				Unmet:      []string{"device is running on battery"},
+			},
+			expectedReason:  v1beta1.UpdateStateWaitingForConditions,
+			expectedMessage: "update conditions not met: device is running on battery",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockManagementClient := client.NewMockManagement(ctrl)
+			mockManagementClient.EXPECT().UpdateDeviceStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			log := log.NewPrefixLogger("test")
+			statusManager := status.NewManager("test-device", log)
+			statusManager.SetClient(mockManagementClient)
+			agent := Agent{log: log, statusManager: statusManager}
+
+			syncErr := fmt.Errorf("%w: %w", errors.ErrPhasePreparing, fmt.Errorf("%w: %w", errors.ErrComponentUpdatePolicy, tc.err))
+			require.True(errors.IsRetryable(syncErr))
+
+			agent.handleSyncError(ctx, newVersionedDevice("2"), syncErr)
+
+			condition := v1beta1.FindStatusCondition(statusManager.Get(ctx).Conditions, v1beta1.ConditionTypeDeviceUpdating)
+			require.NotNil(condition)
+			require.Equal(v1beta1.ConditionStatusTrue, condition.Status)
+			require.Equal(string(tc.expectedReason), condition.Reason)
+			require.Contains(condition.Message, tc.expectedMessage)
+		})
+	}
+}
+
 func newVersionedDevice(version string) *v1beta1.Device {
 	device := &v1beta1.Device{
 		Metadata: v1beta1.ObjectMeta{
@@ -33,6 +33,7 @@ var (
 	ErrComponentHooks          = errors.New("hooks")
 	ErrComponentConfig         = errors.New("config")
 	ErrComponentSystemd        = errors.New("systemd")
+	ErrComponentRegistries     = errors.New("registries")
 	ErrComponentLifecycle      = errors.New("lifecycle")
 	ErrComponentOS             = errors.New("os")
 	ErrComponentOSReconciled   = errors.New("os reconciliation")
@@ -109,8 +110,16 @@ var (
 	// policy
 	ErrDownloadPolicyNotReady = errors.New("download policy not ready")
 	ErrUpdatePolicyNotReady   = errors.New("update policy not ready")
+	ErrUpdateInhibited        = errors.New("update inhibited")
+	ErrUpdateConditionsNotMet = errors.New("update conditions not met")
 	ErrInvalidPolicyType      = errors.New("invalid policy type")
 
+	// inhibitors
+	ErrInhibitorNotFound = errors.New("inhibitor not found")
+	ErrInhibitorOwner    = errors.New("inhibitor is held by another user")
+	ErrInhibitorLimit    = errors.New("too many inhibitors")
+	ErrInvalidInhibitor  = errors.New("invalid inhibitor")
+
 	// prefetch
 	ErrPrefetchNotReady     = errors.New("oci prefetch not ready")
 	ErrOCICollectorNotReady = errors.New("oci target collector not ready")
@@ -293,6 +302,8 @@ var (
 		ErrAppLabel:               codes.FailedPrecondition,
 		ErrDownloadPolicyNotReady: codes.FailedPrecondition,
 		ErrUpdatePolicyNotReady:   codes.FailedPrecondition,
+		ErrUpdateInhibited:        codes.FailedPrecondition,
+		ErrUpdateConditionsNotMet: codes.FailedPrecondition,
 		ErrPrefetchNotReady:       codes.FailedPrecondition,
 		ErrOCICollectorNotReady:   codes.FailedPrecondition,
 
@@ -479,7 +490,8 @@ func IsRetryable(err error) bool {
 		return true
 	case errors.Is(err, ErrNetwork):
 		return true
-	case errors.Is(err, ErrDownloadPolicyNotReady), errors.Is(err, ErrUpdatePolicyNotReady):
+	case errors.Is(err, ErrDownloadPolicyNotReady), errors.Is(err, ErrUpdatePolicyNotReady), errors.Is(err, ErrUpdateInhibited),
+		errors.Is(err, ErrUpdateConditionsNotMet):
 		return true
 	case errors.Is(err, ErrCriticalResourceAlert):
 		return true
@@ -523,6 +535,10 @@ func Is(err, target error) bool {
 	return errors.Is(err, target)
 }
 
+func As(err error, target any) bool {
+	return errors.As(err, target)
+}
+
 func New(msg string) error {
 	return errors.New(msg)
 }
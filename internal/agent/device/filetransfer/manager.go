@@ -0,0 +1,218 @@
+package filetransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/remotesession"
+	"github.com/flightctl/flightctl/internal/agent/device/spec"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// drainTimeout bounds how long the agent waits for the server to end the stream after the
+	// transfer has completed.
+	drainTimeout = 30 * time.Second
+)
+
+// transferConfig is the part of the agent config that governs file transfers.
+type transferConfig struct {
+	allowedPaths []string
+	maxFileSize  int64
+}
+
+// Manager serves every file transfer entry in the device's remote-session annotation by
+// streaming the requested file to, or receiving it from, the remote-access service over a
+// gRPC stream. Only paths listed in the agent config's file-transfer.allowed-paths, or lying
+// below a listed directory, can be accessed; requests for any other path are rejected before
+// any data is exchanged.
+type Manager struct {
+	grpcClient grpc_v1.RouterServiceClient
+	deviceName string
+	readWriter fileio.ReadWriter
+	watcher    spec.Watcher
+	log        *log.PrefixLogger
+
+	cfg atomic.Pointer[transferConfig]
+
+	sessions *remotesession.Tracker
+}
+
+// NewManager returns a file transfer Manager. grpcClient may be nil if the remote-access
+// service is not configured, in which case sessions are logged and ignored.
+func NewManager(
+	grpcClient grpc_v1.RouterServiceClient,
+	deviceName string,
+	readWriter fileio.ReadWriter,
+	cfg *config.Config,
+	watcher spec.Watcher,
+	log *log.PrefixLogger,
+) *Manager {
+	m := &Manager{
+		grpcClient: grpcClient,
+		deviceName: deviceName,
+		readWriter: readWriter,
+		watcher:    watcher,
+		log:        log,
+	}
+	m.sessions = remotesession.NewTracker(log, isFileTransfer, m.start)
+	m.cfg.Store(newTransferConfig(cfg))
+	return m
+}
+
+func newTransferConfig(cfg *config.Config) *transferConfig {
+	maxFileSize := cfg.FileTransfer.MaxFileSize
+	if maxFileSize <= 0 {
+		maxFileSize = config.DefaultFileTransferMaxFileSize
+	}
+	return &transferConfig{
+		allowedPaths: slices.Clone(cfg.FileTransfer.AllowedPaths),
+		maxFileSize:  maxFileSize,
+	}
+}
+
+// ReloadConfig updates the allowed paths and size limit. Transfers that are already running
+// are not affected.
+func (m *Manager) ReloadConfig(ctx context.Context, cfg *config.Config) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	c := newTransferConfig(cfg)
+	m.cfg.Store(c)
+	m.log.Infof("File transfer config reloaded: allowed-paths=%v max-file-size=%d", c.allowedPaths, c.maxFileSize)
+	return nil
+}
+
+// Run syncs file transfer sessions each time a new desired spec is published, until the
+// watcher is closed.
+func (m *Manager) Run(ctx context.Context) {
+	m.log.Debug("Starting file transfer controller")
+	defer m.log.Debug("Stopping file transfer controller")
+
+	for {
+		desired, err := m.watcher.Pop()
+		if err != nil {
+			m.log.Warnf("failed to pop from spec watcher: %v", err)
+			m.sessions.Wait()
+			return
+		}
+		m.Sync(ctx, desired)
+	}
+}
+
+// Sync starts a goroutine for each file transfer entry in the remote-session annotation that
+// is not already tracked. Entries without a file path belong to other session types.
+func (m *Manager) Sync(ctx context.Context, device *v1beta1.Device) {
+	m.sessions.Sync(ctx, device)
+}
+
+// isFileTransfer returns true for the file transfer entries of the remote-session annotation.
+func isFileTransfer(entry v1beta1.DeviceRemoteSession) bool {
+	return entry.FilePath != ""
+}
+
+// Wait blocks until all active sessions have finished.
+func (m *Manager) Wait() {
+	m.sessions.Wait()
+}
+
+// start validates the requested path and opens the gRPC stream. A refused path is reported
+// via stream metadata so the server can fail the client's request before upgrading it.
+func (m *Manager) start(ctx context.Context, entry v1beta1.DeviceRemoteSession) {
+	if m.grpcClient == nil {
+		m.log.Errorf("gRPC client not available for file transfer session %s", entry.SessionID)
+		return
+	}
+
+	cfg := m.cfg.Load()
+	var t *transfer
+	var sessionErr error
+	switch entry.FileTransfer {
+	case filetransfer.DirectionDownload:
+		t, sessionErr = m.prepareDownload(cfg, entry.FilePath)
+	case filetransfer.DirectionUpload:
+		t, sessionErr = m.prepareUpload(cfg, entry.FilePath)
+	default:
+		sessionErr = fmt.Errorf("unsupported file transfer direction %q", entry.FileTransfer)
+	}
+
+	metadataPairs := []string{
+		consts.GrpcSessionIDKey, entry.SessionID,
+		consts.GrpcClientNameKey, m.deviceName,
+	}
+	if sessionErr != nil {
+		metadataPairs = append(metadataPairs, consts.GrpcSessionErrorKey, sanitizeGrpcMetadataValue(sessionErr.Error()))
+		var codedErr *transferError
+		if errors.As(sessionErr, &codedErr) {
+			metadataPairs = append(metadataPairs, consts.GrpcSessionErrorCodeKey, codedErr.code)
+		}
+	} else {
+		metadataPairs = append(metadataPairs, consts.GrpcSelectedProtocolKey, consts.FileTransferProtocol)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	streamCtx = metadata.AppendToOutgoingContext(streamCtx, metadataPairs...)
+	streamClient, err := m.grpcClient.Stream(streamCtx)
+	if err != nil {
+		m.log.Errorf("error creating file transfer stream for session %s: %v", entry.SessionID, err)
+		return
+	}
+
+	if sessionErr != nil {
+		m.log.Warnf("rejecting file transfer session %s: %v", entry.SessionID, sessionErr)
+		_ = streamClient.CloseSend()
+		return
+	}
+
+	m.log.Infof("file transfer session %s (%s %s) started", entry.SessionID, entry.FileTransfer, entry.FilePath)
+	if entry.FileTransfer == filetransfer.DirectionDownload {
+		err = m.download(streamClient, t)
+	} else {
+		err = m.upload(streamClient, t)
+	}
+	if err != nil {
+		m.log.Warnf("file transfer session %s (%s %s) failed: %v", entry.SessionID, entry.FileTransfer, entry.FilePath, err)
+		_ = streamClient.Send(&grpc_v1.StreamRequest{Payload: filetransfer.EncodeError(err.Error())})
+	} else {
+		m.log.Infof("file transfer session %s (%s %s) completed", entry.SessionID, entry.FileTransfer, entry.FilePath)
+	}
+	_ = streamClient.CloseSend()
+	drain(streamClient, cancel)
+}
+
+// drain waits for the server to end the stream so that frames sent last are delivered
+// before the stream's context is canceled.
+func drain(streamClient grpc_v1.RouterService_StreamClient, cancel context.CancelFunc) {
+	timer := time.AfterFunc(drainTimeout, cancel)
+	defer timer.Stop()
+	for {
+		msg, err := streamClient.Recv()
+		if err != nil || msg.Closed {
+			return
+		}
+	}
+}
+
+// sanitizeGrpcMetadataValue replaces every byte outside the printable ASCII range so the
+// value is accepted as gRPC metadata.
+func sanitizeGrpcMetadataValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return '?'
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,249 @@
+package filetransfer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeStream is an in-memory RouterService_StreamClient. Frames queued on toAgent are
+// returned by Recv; frames passed to Send are collected on fromAgent.
+type fakeStream struct {
+	grpc.ClientStream
+	toAgent   chan []byte
+	fromAgent chan []byte
+}
+
+func newFakeStream() *fakeStream {
+	return &fakeStream{toAgent: make(chan []byte, 16), fromAgent: make(chan []byte, 1024)}
+}
+
+func (s *fakeStream) Send(req *grpc_v1.StreamRequest) error {
+	s.fromAgent <- req.Payload
+	return nil
+}
+
+func (s *fakeStream) Recv() (*grpc_v1.StreamResponse, error) {
+	msg, ok := <-s.toAgent
+	if !ok {
+		return nil, io.EOF
+	}
+	return &grpc_v1.StreamResponse{Payload: msg}, nil
+}
+
+func (s *fakeStream) CloseSend() error {
+	return nil
+}
+
+// fakeRouterClient hands out a single fakeStream and records the metadata it was opened with.
+type fakeRouterClient struct {
+	stream *fakeStream
+	md     chan metadata.MD
+}
+
+func (c *fakeRouterClient) Stream(ctx context.Context, _ ...grpc.CallOption) (grpc_v1.RouterService_StreamClient, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.md <- md
+	return c.stream, nil
+}
+
+func deviceWithSessions(t *testing.T, sessions ...v1beta1.DeviceRemoteSession) *v1beta1.Device {
+	t.Helper()
+	b, err := json.Marshal(sessions)
+	require.NoError(t, err)
+	return &v1beta1.Device{
+		Metadata: v1beta1.ObjectMeta{
+			Annotations: &map[string]string{v1beta1.DeviceAnnotationRemoteSession: string(b)},
+		},
+	}
+}
+
+func newTestManager(t *testing.T, allowedPaths ...string) (*Manager, *fakeRouterClient, string) {
+	t.Helper()
+	root := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(root)),
+		fileio.NewWriter(fileio.WithWriterRootDir(root)),
+	)
+	require.NoError(t, rw.MkdirAll("/var/log", 0o755))
+	require.NoError(t, rw.MkdirAll("/etc", 0o755))
+	cfg := config.NewDefault()
+	cfg.FileTransfer.AllowedPaths = allowedPaths
+	cfg.FileTransfer.MaxFileSize = 1024
+	client := &fakeRouterClient{stream: newFakeStream(), md: make(chan metadata.MD, 1)}
+	return NewManager(client, "test-device", rw, cfg, nil, log.NewPrefixLogger("test")), client, root
+}
+
+func mdValue(md metadata.MD, key string) string {
+	if vals := md.Get(key); len(vals) == 1 {
+		return vals[0]
+	}
+	return ""
+}
+
+func nextFrame(t *testing.T, s *fakeStream) (filetransfer.FrameType, []byte) {
+	t.Helper()
+	select {
+	case msg := <-s.fromAgent:
+		ft, payload, err := filetransfer.Decode(msg)
+		require.NoError(t, err)
+		return ft, payload
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a frame from the agent")
+		return 0, nil
+	}
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestSyncRejectsPathNotAllowed(t *testing.T) {
+	require := require.New(t)
+	m, client, root := newTestManager(t, "/var/log")
+	require.NoError(os.WriteFile(filepath.Join(root, "etc", "shadow"), []byte("secret"), 0o600))
+	// A symbolic link inside an allowed directory must not grant access to its target.
+	require.NoError(os.Symlink("../../etc/shadow", filepath.Join(root, "var", "log", "shadow")))
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", FilePath: "/var/log/shadow", FileTransfer: filetransfer.DirectionDownload}))
+	close(client.stream.toAgent)
+	m.Wait()
+
+	md := <-client.md
+	require.Equal("s1", mdValue(md, consts.GrpcSessionIDKey))
+	require.Contains(mdValue(md, consts.GrpcSessionErrorKey), "is not allowed")
+	require.Equal(consts.FileTransferErrorCodePathNotAllowed, mdValue(md, consts.GrpcSessionErrorCodeKey))
+	require.Empty(mdValue(md, consts.GrpcSelectedProtocolKey))
+}
+
+func TestSyncRejectsFileTooLarge(t *testing.T) {
+	require := require.New(t)
+	m, client, root := newTestManager(t, "/var/log")
+	require.NoError(os.WriteFile(filepath.Join(root, "var", "log", "big"), make([]byte, 2048), 0o600))
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", FilePath: "/var/log/big", FileTransfer: filetransfer.DirectionDownload}))
+	close(client.stream.toAgent)
+	m.Wait()
+
+	md := <-client.md
+	require.Equal(consts.FileTransferErrorCodeTooLarge, mdValue(md, consts.GrpcSessionErrorCodeKey))
+}
+
+func TestSyncIgnoresOtherSessions(t *testing.T) {
+	m, _, _ := newTestManager(t, "/var/log")
+	// The fake client's metadata channel holds a single entry, so a second Stream() call
+	// would block and Wait would never return.
+	m.Sync(context.Background(), deviceWithSessions(t,
+		v1beta1.DeviceRemoteSession{SessionID: "console", AppName: "app"},
+		v1beta1.DeviceRemoteSession{SessionID: "pf", Port: 8080},
+	))
+	m.Wait()
+}
+
+func TestDownloadResumesFromOffset(t *testing.T) {
+	require := require.New(t)
+	m, client, root := newTestManager(t, "/var/log")
+	content := []byte("hello, file transfer")
+	require.NoError(os.WriteFile(filepath.Join(root, "var", "log", "messages"), content, 0o600))
+
+	header, err := filetransfer.EncodeHeader(filetransfer.Header{Offset: 7})
+	require.NoError(err)
+	client.stream.toAgent <- header
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", FilePath: "/var/log/messages", FileTransfer: filetransfer.DirectionDownload}))
+	require.Equal(consts.FileTransferProtocol, mdValue(<-client.md, consts.GrpcSelectedProtocolKey))
+
+	ft, payload := nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameHeader, ft)
+	h, err := filetransfer.DecodeHeader(payload)
+	require.NoError(err)
+	require.Equal(filetransfer.Header{Size: int64(len(content)), SHA256: sha256Hex(content), Offset: 7}, h)
+
+	ft, payload = nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameData, ft)
+	require.Equal(content[7:], payload)
+	ft, _ = nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameDone, ft)
+
+	close(client.stream.toAgent)
+	m.Wait()
+}
+
+func TestUploadResumesAndMovesIntoPlace(t *testing.T) {
+	require := require.New(t)
+	m, client, root := newTestManager(t, "/var/log")
+	content := []byte("uploaded diagnostics")
+	sum := sha256Hex(content)
+	// An earlier attempt staged the first 8 bytes.
+	staging := filepath.Join(root, "var", "log", ".diag.txt."+sum[:16]+filetransfer.PartialSuffix)
+	require.NoError(os.WriteFile(staging, content[:8], 0o600))
+
+	header, err := filetransfer.EncodeHeader(filetransfer.Header{Size: int64(len(content)), SHA256: sum})
+	require.NoError(err)
+	client.stream.toAgent <- header
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", FilePath: "/var/log/diag.txt", FileTransfer: filetransfer.DirectionUpload}))
+	require.Equal(consts.FileTransferProtocol, mdValue(<-client.md, consts.GrpcSelectedProtocolKey))
+
+	ft, payload := nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameHeader, ft)
+	h, err := filetransfer.DecodeHeader(payload)
+	require.NoError(err)
+	require.Equal(int64(8), h.Offset)
+
+	client.stream.toAgent <- filetransfer.Encode(filetransfer.FrameData, content[8:])
+	client.stream.toAgent <- filetransfer.Encode(filetransfer.FrameDone, nil)
+	ft, _ = nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameDone, ft)
+
+	close(client.stream.toAgent)
+	m.Wait()
+
+	got, err := os.ReadFile(filepath.Join(root, "var", "log", "diag.txt"))
+	require.NoError(err)
+	require.Equal(content, got)
+	require.NoFileExists(staging)
+}
+
+func TestUploadChecksumMismatch(t *testing.T) {
+	require := require.New(t)
+	m, client, root := newTestManager(t, "/var/log")
+	content := []byte("uploaded diagnostics")
+
+	header, err := filetransfer.EncodeHeader(filetransfer.Header{Size: int64(len(content)), SHA256: sha256Hex([]byte("something else"))})
+	require.NoError(err)
+	client.stream.toAgent <- header
+	client.stream.toAgent <- filetransfer.Encode(filetransfer.FrameData, content)
+	client.stream.toAgent <- filetransfer.Encode(filetransfer.FrameDone, nil)
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", FilePath: "/var/log/diag.txt", FileTransfer: filetransfer.DirectionUpload}))
+	<-client.md
+
+	ft, _ := nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameHeader, ft)
+	ft, payload := nextFrame(t, client.stream)
+	require.Equal(filetransfer.FrameError, ft)
+	require.Contains(string(payload), "checksum mismatch")
+
+	close(client.stream.toAgent)
+	m.Wait()
+	require.NoFileExists(filepath.Join(root, "var", "log", "diag.txt"))
+}
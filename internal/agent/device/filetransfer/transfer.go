@@ -0,0 +1,309 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+)
+
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// transferError is a refusal that carries a machine-readable code for the client.
+type transferError struct {
+	code string
+	msg  string
+}
+
+func (e *transferError) Error() string {
+	return e.msg
+}
+
+// transfer is a validated file transfer request.
+type transfer struct {
+	// path is the device path of the file with symbolic links resolved.
+	path        string
+	maxFileSize int64
+}
+
+// prepareDownload checks that path is an allowed regular file within the size limit.
+func (m *Manager) prepareDownload(cfg *transferConfig, path string) (*transfer, error) {
+	resolved, err := m.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	if !m.isAllowed(cfg, resolved) {
+		return nil, &transferError{code: consts.FileTransferErrorCodePathNotAllowed, msg: fmt.Sprintf("path %s is not allowed for file transfer on this device", path)}
+	}
+	info, err := os.Stat(m.readWriter.PathFor(resolved))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("file %s does not exist", path)
+		}
+		return nil, fmt.Errorf("stat %s: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", path)
+	}
+	if info.Size() > cfg.maxFileSize {
+		return nil, &transferError{code: consts.FileTransferErrorCodeTooLarge, msg: fmt.Sprintf("file %s is %d bytes, which exceeds the limit of %d bytes", path, info.Size(), cfg.maxFileSize)}
+	}
+	return &transfer{path: resolved, maxFileSize: cfg.maxFileSize}, nil
+}
+
+// prepareUpload checks that path is allowed, that its directory exists and that it is not
+// itself a directory.
+func (m *Manager) prepareUpload(cfg *transferConfig, path string) (*transfer, error) {
+	resolved, err := m.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	if !m.isAllowed(cfg, resolved) {
+		return nil, &transferError{code: consts.FileTransferErrorCodePathNotAllowed, msg: fmt.Sprintf("path %s is not allowed for file transfer on this device", path)}
+	}
+	info, err := os.Stat(m.readWriter.PathFor(resolved))
+	if err == nil && !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s exists and is not a regular file", path)
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("stat %s: %w", path, err)
+	}
+	return &transfer{path: resolved, maxFileSize: cfg.maxFileSize}, nil
+}
+
+// resolve returns the device path of path with symbolic links resolved. If path does not
+// exist, its directory must, and only the directory is resolved.
+func (m *Manager) resolve(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path %s must be absolute", path)
+	}
+	root, err := filepath.EvalSymlinks(m.readWriter.PathFor("/"))
+	if err != nil {
+		return "", fmt.Errorf("resolving root directory: %w", err)
+	}
+	hostPath := m.readWriter.PathFor(path)
+	resolved, err := filepath.EvalSymlinks(hostPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		var dir string
+		dir, err = filepath.EvalSymlinks(filepath.Dir(hostPath))
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("directory %s does not exist", filepath.Dir(path))
+		}
+		resolved = filepath.Join(dir, filepath.Base(hostPath))
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", path, err)
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("path %s resolves outside the root directory", path)
+	}
+	return filepath.Join("/", rel), nil
+}
+
+// isAllowed reports whether the resolved device path is one of the allowed paths or lies
+// below one of them. Allowed paths are resolved too, so that an allow-list entry that is a
+// symbolic link matches the files it points to.
+func (m *Manager) isAllowed(cfg *transferConfig, resolved string) bool {
+	for _, allowed := range cfg.allowedPaths {
+		allowedResolved, err := m.resolve(filepath.Clean(allowed))
+		if err != nil {
+			allowedResolved = filepath.Clean(allowed)
+		}
+		if resolved == allowedResolved || strings.HasPrefix(resolved, strings.TrimSuffix(allowedResolved, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// download sends the file from the offset requested by the client.
+func (m *Manager) download(streamClient grpc_v1.RouterService_StreamClient, t *transfer) error {
+	req, err := recvHeader(streamClient)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(m.readWriter.PathFor(t.path))
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", t.path, err)
+	}
+	defer f.Close()
+
+	// Hash the open file rather than the path so the checksum matches the content sent even if
+	// the path is replaced during the transfer.
+	sum, size, err := hashFile(f)
+	if err != nil {
+		return fmt.Errorf("hashing %s: %w", t.path, err)
+	}
+	if size > t.maxFileSize {
+		return fmt.Errorf("file %s is %d bytes, which exceeds the limit of %d bytes", t.path, size, t.maxFileSize)
+	}
+	offset := req.Offset
+	if offset > size {
+		offset = 0
+	}
+	if err := sendHeader(streamClient, filetransfer.Header{Size: size, SHA256: sum, Offset: offset}); err != nil {
+		return err
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("seeking %s: %w", t.path, err)
+	}
+	buf := make([]byte, filetransfer.ChunkSize)
+	r := io.LimitReader(f, size-offset)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if sendErr := streamClient.Send(&grpc_v1.StreamRequest{Payload: filetransfer.Encode(filetransfer.FrameData, buf[:n])}); sendErr != nil {
+				return fmt.Errorf("sending data: %w", sendErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", t.path, err)
+		}
+	}
+	return streamClient.Send(&grpc_v1.StreamRequest{Payload: filetransfer.Encode(filetransfer.FrameDone, nil)})
+}
+
+// upload receives the file into a staging file next to the destination, resuming from any
+// content staged by an earlier attempt, and moves it into place once its size and checksum
+// have been verified. The staging file is kept if the transfer is interrupted.
+func (m *Manager) upload(streamClient grpc_v1.RouterService_StreamClient, t *transfer) error {
+	req, err := recvHeader(streamClient)
+	if err != nil {
+		return err
+	}
+	if req.Size > t.maxFileSize {
+		return fmt.Errorf("file is %d bytes, which exceeds the limit of %d bytes", req.Size, t.maxFileSize)
+	}
+	if !sha256Pattern.MatchString(req.SHA256) {
+		return fmt.Errorf("invalid sha256 %q", req.SHA256)
+	}
+
+	// The staging name includes the checksum so that a resumed upload only ever continues
+	// the same content.
+	staging := filepath.Join(filepath.Dir(t.path), "."+filepath.Base(t.path)+"."+req.SHA256[:16]+filetransfer.PartialSuffix)
+	var offset int64
+	if info, err := os.Stat(m.readWriter.PathFor(staging)); err == nil && info.Mode().IsRegular() && info.Size() <= req.Size {
+		offset = info.Size()
+	} else if err := m.readWriter.RemoveFile(staging); err != nil {
+		return err
+	}
+
+	f, err := m.readWriter.CreateFile(staging, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := sendHeader(streamClient, filetransfer.Header{Offset: offset}); err != nil {
+		return err
+	}
+
+	written := offset
+	for {
+		ft, payload, err := recvFrame(streamClient)
+		if err != nil {
+			return err
+		}
+		if ft == filetransfer.FrameDone {
+			break
+		}
+		if ft != filetransfer.FrameData {
+			return fmt.Errorf("unexpected %q frame", ft)
+		}
+		if written+int64(len(payload)) > req.Size {
+			_ = m.readWriter.RemoveFile(staging)
+			return fmt.Errorf("received more than the announced %d bytes", req.Size)
+		}
+		if _, err := f.Write(payload); err != nil {
+			return fmt.Errorf("writing %s: %w", staging, err)
+		}
+		written += int64(len(payload))
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing %s: %w", staging, err)
+	}
+
+	sum, size, err := filetransfer.FileSHA256(m.readWriter.PathFor(staging))
+	if err != nil {
+		return fmt.Errorf("hashing %s: %w", staging, err)
+	}
+	if size != req.Size || sum != req.SHA256 {
+		_ = m.readWriter.RemoveFile(staging)
+		return fmt.Errorf("checksum mismatch: received %d bytes with sha256 %s, expected %d bytes with sha256 %s", size, sum, req.Size, req.SHA256)
+	}
+
+	mode := fileio.DefaultFilePermissions
+	if info, err := os.Stat(m.readWriter.PathFor(t.path)); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(m.readWriter.PathFor(staging), mode); err != nil {
+		return fmt.Errorf("setting mode of %s: %w", staging, err)
+	}
+	if err := m.readWriter.Rename(staging, t.path); err != nil {
+		return fmt.Errorf("moving %s into place: %w", t.path, err)
+	}
+	return streamClient.Send(&grpc_v1.StreamRequest{Payload: filetransfer.Encode(filetransfer.FrameDone, nil)})
+}
+
+func hashFile(f *os.File) (string, int64, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+	return filetransfer.ReaderSHA256(f)
+}
+
+// recvFrame returns the next frame sent by the client. An Error frame is returned as an error.
+func recvFrame(streamClient grpc_v1.RouterService_StreamClient) (filetransfer.FrameType, []byte, error) {
+	msg, err := streamClient.Recv()
+	if err != nil {
+		return 0, nil, fmt.Errorf("receiving frame: %w", err)
+	}
+	if msg.Closed {
+		return 0, nil, fmt.Errorf("client closed the connection")
+	}
+	ft, payload, err := filetransfer.Decode(msg.Payload)
+	if err != nil {
+		return 0, nil, err
+	}
+	if ft == filetransfer.FrameError {
+		return 0, nil, fmt.Errorf("client aborted the transfer: %s", payload)
+	}
+	return ft, payload, nil
+}
+
+func recvHeader(streamClient grpc_v1.RouterService_StreamClient) (filetransfer.Header, error) {
+	ft, payload, err := recvFrame(streamClient)
+	if err != nil {
+		return filetransfer.Header{}, err
+	}
+	if ft != filetransfer.FrameHeader {
+		return filetransfer.Header{}, fmt.Errorf("expected a header frame, got %q", ft)
+	}
+	return filetransfer.DecodeHeader(payload)
+}
+
+func sendHeader(streamClient grpc_v1.RouterService_StreamClient, h filetransfer.Header) error {
+	msg, err := filetransfer.EncodeHeader(h)
+	if err != nil {
+		return err
+	}
+	if err := streamClient.Send(&grpc_v1.StreamRequest{Payload: msg}); err != nil {
+		return fmt.Errorf("sending header: %w", err)
+	}
+	return nil
+}
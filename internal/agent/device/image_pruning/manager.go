@@ -13,6 +13,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
@@ -549,6 +550,12 @@ func (m *manager) determineEligibleImages(ctx context.Context) (*EligibleItems,
 
 	eligibleRefs = lo.Uniq(eligibleRefs)
 
+	// Images embedded into the OS image are in a read-only image store and cannot be removed
+	embeddedImages, err := dependency.ReadEmbeddedImages(m.readWriter)
+	if err != nil {
+		m.log.Warnf("Failed to read embedded images: %v", err)
+	}
+
 	// Categorize eligible references by type and verify existence
 	var eligibleImages []ImageRef
 	var eligibleArtifacts []ImageRef
@@ -558,6 +565,10 @@ func (m *manager) determineEligibleImages(ctx context.Context) (*EligibleItems,
 	for _, ref := range eligibleRefs {
 		switch ref.Type {
 		case RefTypePodman:
+			if _, embedded := embeddedImages[ref.Image]; embedded {
+				m.log.Debugf("Skipping podman reference %s - embedded in the OS image", ref.Image)
+				continue
+			}
 			podmanClient, err := m.podmanClientFactory(ref.Owner)
 			if err != nil {
 				return nil, fmt.Errorf("constructing podman client: %w", err)
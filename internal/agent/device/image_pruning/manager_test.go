@@ -10,6 +10,7 @@ import (
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/pkg/executer"
@@ -722,6 +723,37 @@ func TestManager_determineEligibleImages(t *testing.T) {
 			},
 			want: &EligibleItems{Images: []ImageRef{}, Artifacts: []ImageRef{}, CRI: []ImageRef{}, Helm: []ImageRef{}}, // No previous file, so nothing to prune
 		},
+		{
+			name: "images embedded in the OS image are not eligible",
+			setupMocks: func(mockExec *executer.MockExecuter, mockSpec *spec.MockManager, readWriter fileio.ReadWriter, dataDir string) {
+				previousRefs := ImageArtifactReferences{
+					Timestamp: "2025-01-01T00:00:00Z",
+					References: []ImageRef{
+						{Image: "quay.io/example/embedded:v1.0", Type: RefTypePodman},
+						{Image: "quay.io/example/unused:v1.0", Type: RefTypePodman},
+					},
+				}
+				jsonData, err := json.Marshal(previousRefs)
+				require.NoError(err)
+				require.NoError(readWriter.MkdirAll(dataDir, fileio.DefaultDirectoryPermissions))
+				require.NoError(readWriter.WriteFile(filepath.Join(dataDir, ReferencesFileName), jsonData, fileio.DefaultFilePermissions))
+				require.NoError(readWriter.MkdirAll(filepath.Dir(dependency.EmbeddedImagesFile), fileio.DefaultDirectoryPermissions))
+				require.NoError(readWriter.WriteFile(dependency.EmbeddedImagesFile, []byte("quay.io/example/embedded:v1.0\n"), fileio.DefaultFilePermissions))
+
+				mockSpec.EXPECT().Read(spec.Current).Return(&v1beta1.Device{Spec: &v1beta1.DeviceSpec{}}, nil).Times(1)
+				mockSpec.EXPECT().Read(spec.Desired).Return(nil, errors.New("desired not found")).Times(1)
+
+				// the embedded image is not checked, as it cannot be removed
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"image", "exists", "quay.io/example/unused:v1.0"}).
+					Return("", "", 0)
+			},
+			want: &EligibleItems{
+				Images:    []ImageRef{{Image: "quay.io/example/unused:v1.0", Type: RefTypePodman}},
+				Artifacts: []ImageRef{},
+				CRI:       []ImageRef{},
+				Helm:      []ImageRef{},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
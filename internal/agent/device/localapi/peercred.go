@@ -0,0 +1,32 @@
+package localapi
+
+import (
+	"context"
+	"net"
+)
+
+type peerCredentialsKey struct{}
+
+// peerCredentials identifies the process on the other end of a connection.
+type peerCredentials struct {
+	uid uint32
+}
+
+// withPeerCredentials adds the peer credentials of the connection to its context. Requests on
+// connections whose credentials cannot be determined are not allowed to modify inhibitors.
+func withPeerCredentials(ctx context.Context, conn net.Conn) context.Context {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return ctx
+	}
+	creds, err := getPeerCredentials(unixConn)
+	if err != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, peerCredentialsKey{}, creds)
+}
+
+func peerCredentialsFrom(ctx context.Context) (peerCredentials, bool) {
+	creds, ok := ctx.Value(peerCredentialsKey{}).(peerCredentials)
+	return creds, ok
+}
@@ -0,0 +1,26 @@
+package localapi
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+func getPeerCredentials(conn *net.UnixConn) (peerCredentials, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return peerCredentials{}, err
+	}
+	var ucred *unix.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		ucred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return peerCredentials{}, err
+	}
+	if credErr != nil {
+		return peerCredentials{}, fmt.Errorf("getting peer credentials: %w", credErr)
+	}
+	return peerCredentials{uid: ucred.Uid}, nil
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package localapi
+
+import (
+	"fmt"
+	"net"
+)
+
+func getPeerCredentials(_ *net.UnixConn) (peerCredentials, error) {
+	return peerCredentials{}, fmt.Errorf("peer credentials are only supported on linux")
+}
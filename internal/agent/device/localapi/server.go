@@ -0,0 +1,289 @@
+package localapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/config"
+	agenterrors "github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/policy"
+	"github.com/flightctl/flightctl/internal/agent/device/spec"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	// readHeaderTimeout bounds how long a client may take to send its request headers.
+	readHeaderTimeout = 10 * time.Second
+	// shutdownTimeout bounds how long in-flight requests may take when the agent stops.
+	shutdownTimeout = 5 * time.Second
+	// maxRequestBodySize bounds the size of request bodies.
+	maxRequestBodySize = 64 * 1024
+	// maxReasonLength bounds the reason of an inhibitor, which is shown in the device status.
+	maxReasonLength = 256
+)
+
+// AcquireInhibitorRequest is the body of a request to acquire or renew an inhibitor.
+type AcquireInhibitorRequest struct {
+	// Reason is shown in the device status while the inhibitor blocks an update.
+	Reason string `json:"reason"`
+	// Actions are the actions the inhibitor blocks: "update" and/or "reboot".
+	Actions []policy.InhibitAction `json:"actions"`
+	// TTL is the lease of the inhibitor as a Go duration, e.g. "30m". Defaults to 5m.
+	TTL string `json:"ttl,omitempty"`
+}
+
+// ErrorResponse is the body of an unsuccessful response.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// Server serves the agent's local API to applications on the device over a Unix socket. It gives
+// read-only access to the device status and the current and desired rendered device, and lets
+// applications hold inhibitors that keep the agent from updating or rebooting the device.
+//
+// Access is restricted by the permissions of the socket: it is owned by root and, if a socket
+// group is configured, accessible to that group. The user ID of the caller is taken from the
+// socket's peer credentials, so that an inhibitor can only be renewed or released by the user
+// that acquired it, or by root.
+type Server struct {
+	socketPath    string
+	socketGroup   string
+	specManager   spec.Manager
+	statusManager status.Manager
+	policyManager policy.Manager
+	log           *log.PrefixLogger
+}
+
+// NewServer returns a local API server. It does nothing when run unless the local API is enabled
+// in the agent config.
+func NewServer(
+	cfg *config.Config,
+	specManager spec.Manager,
+	statusManager status.Manager,
+	policyManager policy.Manager,
+	log *log.PrefixLogger,
+) *Server {
+	s := &Server{
+		specManager:   specManager,
+		statusManager: statusManager,
+		policyManager: policyManager,
+		log:           log,
+	}
+	if cfg.LocalAPI.Enabled {
+		s.socketPath = cfg.PathFor(cfg.LocalAPI.SocketPath)
+		s.socketGroup = cfg.LocalAPI.SocketGroup
+	}
+	return s
+}
+
+// Run serves the local API until ctx is canceled.
+func (s *Server) Run(ctx context.Context) {
+	if s.socketPath == "" {
+		s.log.Debug("Local API disabled")
+		return
+	}
+
+	listener, err := s.listen()
+	if err != nil {
+		s.log.Errorf("Failed to start local API: %v", err)
+		return
+	}
+
+	srv := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+		ConnContext:       withPeerCredentials,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			s.log.Warnf("Failed to shut down local API: %v", err)
+		}
+	}()
+
+	s.log.Infof("Serving local API on %s", s.socketPath)
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.log.Errorf("Local API exited: %v", err)
+	}
+	if err := os.Remove(s.socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.log.Warnf("Failed to remove local API socket: %v", err)
+	}
+}
+
+// listen creates the socket, replacing one left behind by a previous run. The socket is created
+// in a private directory and only moved into place once its permissions are restricted, so that
+// no one can connect to it before.
+func (s *Server) listen() (net.Listener, error) {
+	dir := filepath.Dir(s.socketPath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating socket directory: %w", err)
+	}
+	tmpDir, err := os.MkdirTemp(dir, ".localapi-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary socket directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tmpPath := filepath.Join(tmpDir, filepath.Base(s.socketPath))
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, fmt.Errorf("listening on socket: %w", err)
+	}
+	// the socket is moved, so it is removed by Run rather than when the listener is closed.
+	listener.SetUnlinkOnClose(false)
+
+	if err := s.restrictPermissions(tmpPath); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, s.socketPath); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("moving socket into place: %w", err)
+	}
+	return listener, nil
+}
+
+// restrictPermissions makes the socket accessible to root and, if configured, the socket group.
+func (s *Server) restrictPermissions(path string) error {
+	mode := os.FileMode(0o600)
+	if s.socketGroup != "" {
+		group, err := user.LookupGroup(s.socketGroup)
+		if err != nil {
+			return fmt.Errorf("looking up socket group %q: %w", s.socketGroup, err)
+		}
+		gid, err := strconv.Atoi(group.Gid)
+		if err != nil {
+			return fmt.Errorf("parsing gid of socket group %q: %w", s.socketGroup, err)
+		}
+		if err := os.Chown(path, -1, gid); err != nil {
+			return fmt.Errorf("setting socket group: %w", err)
+		}
+		mode = 0o660
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		return fmt.Errorf("setting socket permissions: %w", err)
+	}
+	return nil
+}
+
+// Handler returns the HTTP handler of the local API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/status", s.getStatus)
+	mux.HandleFunc("GET /v1/spec/current", s.getSpec(spec.Current))
+	mux.HandleFunc("GET /v1/spec/desired", s.getSpec(spec.Desired))
+	mux.HandleFunc("GET /v1/inhibitors", s.listInhibitors)
+	mux.HandleFunc("PUT /v1/inhibitors/{id}", s.acquireInhibitor)
+	mux.HandleFunc("DELETE /v1/inhibitors/{id}", s.releaseInhibitor)
+	return mux
+}
+
+func (s *Server) getStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.statusManager.Get(r.Context()))
+}
+
+func (s *Server) getSpec(specType spec.Type) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		device, err := s.specManager.Read(specType)
+		if err != nil {
+			s.log.Errorf("Local API: reading %s spec: %v", specType, err)
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("reading %s spec", specType))
+			return
+		}
+		writeJSON(w, http.StatusOK, device)
+	}
+}
+
+func (s *Server) listInhibitors(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.policyManager.ListInhibitors())
+}
+
+func (s *Server) acquireInhibitor(w http.ResponseWriter, r *http.Request) {
+	creds, ok := peerCredentialsFrom(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "unable to determine the caller's user")
+		return
+	}
+
+	var req AcquireInhibitorRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if len(req.Reason) > maxReasonLength {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("reason cannot exceed %d characters", maxReasonLength))
+		return
+	}
+	var ttl time.Duration
+	if req.TTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(req.TTL); err != nil || ttl <= 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid ttl %q: must be a positive duration", req.TTL))
+			return
+		}
+	}
+
+	inhibitor, err := s.policyManager.AcquireInhibitor(policy.Inhibitor{
+		ID:      r.PathValue("id"),
+		Reason:  req.Reason,
+		Actions: req.Actions,
+		UID:     creds.uid,
+	}, ttl)
+	if err != nil {
+		writeError(w, inhibitorErrorStatus(err), err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, inhibitor)
+}
+
+func (s *Server) releaseInhibitor(w http.ResponseWriter, r *http.Request) {
+	creds, ok := peerCredentialsFrom(r.Context())
+	if !ok {
+		writeError(w, http.StatusForbidden, "unable to determine the caller's user")
+		return
+	}
+	if err := s.policyManager.ReleaseInhibitor(r.PathValue("id"), creds.uid); err != nil {
+		writeError(w, inhibitorErrorStatus(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func inhibitorErrorStatus(err error) int {
+	switch {
+	case agenterrors.Is(err, agenterrors.ErrInvalidInhibitor):
+		return http.StatusBadRequest
+	case agenterrors.Is(err, agenterrors.ErrInhibitorNotFound):
+		return http.StatusNotFound
+	case agenterrors.Is(err, agenterrors.ErrInhibitorOwner):
+		return http.StatusForbidden
+	case agenterrors.Is(err, agenterrors.ErrInhibitorLimit):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, ErrorResponse{Message: message})
+}
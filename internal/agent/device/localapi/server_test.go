@@ -0,0 +1,124 @@
+package localapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/policy"
+	"github.com/flightctl/flightctl/internal/agent/device/spec"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := log.NewPrefixLogger("test")
+	cfg := config.NewDefault()
+	cfg.LocalAPI.Enabled = true
+	cfg.LocalAPI.SocketPath = filepath.Join(t.TempDir(), "run", "agent.sock")
+
+	desired := &v1beta1.Device{Metadata: v1beta1.ObjectMeta{Name: lo.ToPtr("test-device")}}
+	mockSpecManager := spec.NewMockManager(ctrl)
+	mockSpecManager.EXPECT().Read(spec.Desired).Return(desired, nil)
+	policyManager := policy.NewManager(fileio.NewReader(), executer.NewCommonExecuter(), logger)
+
+	server := NewServer(cfg, mockSpecManager, status.NewManager("test-device", logger), policyManager, logger)
+	done := make(chan struct{})
+	go func() {
+		server.Run(ctx)
+		close(done)
+	}()
+
+	httpClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", cfg.LocalAPI.SocketPath)
+		},
+	}}
+	do := func(method, path string, body any) *http.Response {
+		var buf bytes.Buffer
+		if body != nil {
+			require.NoError(json.NewEncoder(&buf).Encode(body))
+		}
+		req, err := http.NewRequestWithContext(ctx, method, "http://localapi"+path, &buf)
+		require.NoError(err)
+		resp, err := httpClient.Do(req)
+		require.NoError(err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	require.Eventually(func() bool {
+		_, err := os.Stat(cfg.LocalAPI.SocketPath)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("When no socket group is configured it should only be accessible to its owner", func(t *testing.T) {
+		info, err := os.Stat(cfg.LocalAPI.SocketPath)
+		require.NoError(err)
+		require.Equal(os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("When reading the desired spec it should return the rendered device", func(t *testing.T) {
+		resp := do(http.MethodGet, "/v1/spec/desired", nil)
+		require.Equal(http.StatusOK, resp.StatusCode)
+		var device v1beta1.Device
+		require.NoError(json.NewDecoder(resp.Body).Decode(&device))
+		require.Equal("test-device", lo.FromPtr(device.Metadata.Name))
+	})
+
+	t.Run("When an application acquires an inhibitor it should block the action", func(t *testing.T) {
+		resp := do(http.MethodPut, "/v1/inhibitors/batch-42", AcquireInhibitorRequest{
+			Reason:  "production batch running",
+			Actions: []policy.InhibitAction{policy.InhibitReboot},
+			TTL:     "30m",
+		})
+		require.Equal(http.StatusOK, resp.StatusCode)
+		var inhibitor policy.Inhibitor
+		require.NoError(json.NewDecoder(resp.Body).Decode(&inhibitor))
+		require.Equal(uint32(os.Getuid()), inhibitor.UID)
+		require.Error(policyManager.CheckInhibitors(policy.InhibitReboot))
+
+		resp = do(http.MethodGet, "/v1/inhibitors", nil)
+		var inhibitors []policy.Inhibitor
+		require.NoError(json.NewDecoder(resp.Body).Decode(&inhibitors))
+		require.Len(inhibitors, 1)
+
+		resp = do(http.MethodDelete, "/v1/inhibitors/batch-42", nil)
+		require.Equal(http.StatusNoContent, resp.StatusCode)
+		require.NoError(policyManager.CheckInhibitors(policy.InhibitReboot))
+	})
+
+	t.Run("When the request is invalid it should be rejected", func(t *testing.T) {
+		resp := do(http.MethodPut, "/v1/inhibitors/batch-42", AcquireInhibitorRequest{Actions: []policy.InhibitAction{"shutdown"}})
+		require.Equal(http.StatusBadRequest, resp.StatusCode)
+		resp = do(http.MethodPut, "/v1/inhibitors/batch-42", AcquireInhibitorRequest{Actions: []policy.InhibitAction{policy.InhibitUpdate}, TTL: "forever"})
+		require.Equal(http.StatusBadRequest, resp.StatusCode)
+		resp = do(http.MethodDelete, "/v1/inhibitors/unknown", nil)
+		require.Equal(http.StatusNotFound, resp.StatusCode)
+	})
+
+	cancel()
+	<-done
+	_, err := os.Stat(cfg.LocalAPI.SocketPath)
+	require.ErrorIs(err, os.ErrNotExist)
+}
@@ -0,0 +1,312 @@
+package logforward
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	baseclient "github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/version"
+	"github.com/samber/lo"
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+const (
+	// cursorFileName is the file in the data dir that holds the cursor of the last forwarded
+	// journal entry, so that forwarding resumes where it stopped when the agent restarts.
+	cursorFileName = "log-forwarding-cursor"
+	// maxBatchSize bounds the number of log records sent in a single export request.
+	maxBatchSize = 1000
+	// maxEntriesPerInterval bounds the number of journal entries read at each interval, so that
+	// a large backlog, e.g. after the telemetry gateway was unreachable, is forwarded over
+	// several intervals instead of being read into memory at once.
+	maxEntriesPerInterval = 10 * maxBatchSize
+	// exportTimeout bounds how long a single export request may take.
+	exportTimeout = 30 * time.Second
+	// scopeName is the instrumentation scope of the forwarded log records.
+	scopeName = "github.com/flightctl/flightctl/internal/agent/device/logforward"
+
+	// SourceJournald marks log records read from the journal of a systemd unit.
+	SourceJournald = "journald"
+	// SourceApplication marks log records written by an application container.
+	SourceApplication = "application"
+)
+
+type journalReader interface {
+	Entries(ctx context.Context, afterCursor string, since time.Time, limit int) ([]client.JournalEntry, error)
+}
+
+// Forwarder reads new entries from the systemd journal at a fixed interval and forwards them to
+// the telemetry gateway over OTLP/gRPC, authenticating with a client certificate issued by the
+// device-svc-client signer. Entries of application containers, written by podman's journald
+// log driver, and entries of systemd units can be forwarded independently of each other.
+//
+// If an export fails, the entries are read and sent again at the next interval.
+type Forwarder struct {
+	cfg           config.LogForwarding
+	serviceConfig *baseclient.Config
+	cursorPath    string
+	journal       journalReader
+	readWriter    fileio.ReadWriter
+	log           *log.PrefixLogger
+
+	// exporter is set when Run connects to the telemetry gateway, or by tests.
+	exporter collectorlogs.LogsServiceClient
+	nowFn    func() time.Time
+	cursor   string
+	since    time.Time
+}
+
+// NewForwarder returns a log Forwarder. It does nothing when run unless log forwarding is
+// enabled in the agent config.
+func NewForwarder(
+	cfg *config.Config,
+	journal journalReader,
+	readWriter fileio.ReadWriter,
+	log *log.PrefixLogger,
+) *Forwarder {
+	return &Forwarder{
+		cfg:           cfg.LogForwarding,
+		serviceConfig: cfg.EnrollmentService.Config.DeepCopy(),
+		cursorPath:    filepath.Join(cfg.DataDir, cursorFileName),
+		journal:       journal,
+		readWriter:    readWriter,
+		log:           log,
+		nowFn:         time.Now,
+	}
+}
+
+// Run forwards new log entries at the configured interval until the context is canceled.
+func (f *Forwarder) Run(ctx context.Context) {
+	if !f.cfg.Enabled {
+		return
+	}
+	f.log.Infof("Starting log forwarding to %s", f.cfg.Endpoint)
+	defer f.log.Debug("Stopping log forwarding")
+
+	conn, err := f.dial()
+	if err != nil {
+		f.log.Errorf("Log forwarding disabled: %v", err)
+		return
+	}
+	defer conn.Close()
+	f.exporter = collectorlogs.NewLogsServiceClient(conn)
+
+	f.loadCursor()
+	ticker := time.NewTicker(time.Duration(f.cfg.Interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.forward(ctx); err != nil {
+				f.log.Warnf("Failed to forward logs: %v", err)
+			}
+		}
+	}
+}
+
+func (f *Forwarder) dial() (*grpc.ClientConn, error) {
+	tlsConfig, err := f.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(f.cfg.Endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("creating gRPC client for %s: %w", f.cfg.Endpoint, err)
+	}
+	return conn, nil
+}
+
+// tlsConfig trusts the CA of the enrollment service, which also issues the telemetry gateway's
+// server certificate. The client certificate is read on every handshake, so that it is picked
+// up once the certificate manager has provisioned or renewed it.
+func (f *Forwarder) tlsConfig() (*tls.Config, error) {
+	serviceConfig := f.serviceConfig.DeepCopy()
+	serviceConfig.AuthInfo = baseclient.AuthInfo{}
+	if err := serviceConfig.Flatten(); err != nil {
+		return nil, fmt.Errorf("reading enrollment service CA: %w", err)
+	}
+	host, _, err := net.SplitHostPort(f.cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", f.cfg.Endpoint, err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS13,
+		ServerName:         host,
+		InsecureSkipVerify: serviceConfig.Service.InsecureSkipVerify, //nolint:gosec
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certPEM, err := f.readWriter.ReadFile(f.cfg.CertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading log forwarding certificate: %w", err)
+			}
+			keyPEM, err := f.readWriter.ReadFile(f.cfg.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading log forwarding key: %w", err)
+			}
+			cert, err := tls.X509KeyPair(certPEM, keyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("parsing log forwarding certificate: %w", err)
+			}
+			return &cert, nil
+		},
+	}
+	if len(serviceConfig.Service.CertificateAuthorityData) > 0 {
+		caPool, err := certutil.NewPoolFromBytes(serviceConfig.Service.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("parsing enrollment service CA: %w", err)
+		}
+		tlsConfig.RootCAs = caPool
+	}
+	return tlsConfig, nil
+}
+
+func (f *Forwarder) loadCursor() {
+	f.since = f.nowFn()
+	contents, err := f.readWriter.ReadFile(f.cursorPath)
+	if err != nil {
+		// Without a cursor, only entries written from now on are forwarded.
+		return
+	}
+	f.cursor = strings.TrimSpace(string(contents))
+}
+
+func (f *Forwarder) saveCursor() {
+	if err := f.readWriter.WriteFile(f.cursorPath, []byte(f.cursor), fileio.DefaultFilePermissions); err != nil {
+		f.log.Warnf("Failed to save log forwarding cursor: %v", err)
+	}
+}
+
+// forward sends up to maxEntriesPerInterval of the journal entries written since the last
+// successful export in batches. The cursor advances after each batch, so a failed export is
+// retried from the failed batch on and the remaining entries are sent at the next interval.
+func (f *Forwarder) forward(ctx context.Context) error {
+	entries, err := f.journal.Entries(ctx, f.cursor, f.since, maxEntriesPerInterval)
+	if err != nil {
+		return fmt.Errorf("reading journal: %w", err)
+	}
+
+	for batch := range slices.Chunk(entries, maxBatchSize) {
+		if records := f.logRecords(batch); len(records) > 0 {
+			exportCtx, cancel := context.WithTimeout(ctx, exportTimeout)
+			resp, err := f.exporter.Export(exportCtx, newExportRequest(records))
+			cancel()
+			if err != nil {
+				return fmt.Errorf("exporting %d log records: %w", len(records), err)
+			}
+			if rejected := resp.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+				f.log.Warnf("Telemetry gateway rejected %d log records: %s", rejected, resp.GetPartialSuccess().GetErrorMessage())
+			}
+		}
+		if cursor := batch[len(batch)-1].Cursor; cursor != "" {
+			f.cursor = cursor
+			f.saveCursor()
+		}
+	}
+	return nil
+}
+
+// source returns whether an entry is forwarded and, if so, the source it is attributed to.
+func (f *Forwarder) source(entry client.JournalEntry) (string, bool) {
+	if entry.ContainerName != "" {
+		return SourceApplication, f.cfg.Applications
+	}
+	if !f.cfg.Journald {
+		return "", false
+	}
+	return SourceJournald, len(f.cfg.Units) == 0 || slices.Contains(f.cfg.Units, entry.Unit)
+}
+
+func (f *Forwarder) logRecords(entries []client.JournalEntry) []*logspb.LogRecord {
+	observed := uint64(f.nowFn().UnixNano()) //nolint:gosec
+	var records []*logspb.LogRecord
+	for _, entry := range entries {
+		source, ok := f.source(entry)
+		if !ok {
+			continue
+		}
+		attrs := []*commonpb.KeyValue{stringAttribute("log.source", source)}
+		if entry.Unit != "" {
+			attrs = append(attrs, stringAttribute("systemd.unit", entry.Unit))
+		}
+		if entry.SyslogIdentifier != "" {
+			attrs = append(attrs, stringAttribute("syslog.identifier", entry.SyslogIdentifier))
+		}
+		if entry.ContainerName != "" {
+			attrs = append(attrs, stringAttribute("container.name", entry.ContainerName))
+		}
+		severity := severityOf(entry.Priority)
+		records = append(records, &logspb.LogRecord{
+			TimeUnixNano:         uint64(entry.Timestamp.UnixNano()), //nolint:gosec
+			ObservedTimeUnixNano: observed,
+			SeverityNumber:       severity.number,
+			SeverityText:         severity.text,
+			Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: entry.Message}},
+			Attributes:           attrs,
+		})
+	}
+	return records
+}
+
+// newExportRequest wraps the records in a request. The telemetry gateway labels the resource
+// with the device, organization and fleet of the authenticated device.
+func newExportRequest(records []*logspb.LogRecord) *collectorlogs.ExportLogsServiceRequest {
+	resourceAttrs := []*commonpb.KeyValue{stringAttribute("service.name", "flightctl-agent")}
+	return &collectorlogs.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: &resourcepb.Resource{Attributes: resourceAttrs},
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope: &commonpb.InstrumentationScope{
+					Name:    scopeName,
+					Version: version.Get().String(),
+				},
+				LogRecords: records,
+			}},
+		}},
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+type severity struct {
+	number logspb.SeverityNumber
+	text   string
+}
+
+// severities maps syslog priorities to OpenTelemetry severities.
+var severities = []severity{
+	{logspb.SeverityNumber_SEVERITY_NUMBER_FATAL4, "emerg"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_FATAL3, "alert"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_FATAL, "crit"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, "err"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "warning"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_INFO2, "notice"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "info"},
+	{logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG, "debug"},
+}
+
+func severityOf(priority int) severity {
+	return severities[lo.Clamp(priority, 0, len(severities)-1)]
+}
@@ -0,0 +1,158 @@
+package logforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+)
+
+type fakeJournal struct {
+	entries     []client.JournalEntry
+	afterCursor string
+}
+
+func (j *fakeJournal) Entries(_ context.Context, afterCursor string, _ time.Time, limit int) ([]client.JournalEntry, error) {
+	j.afterCursor = afterCursor
+	entries := j.entries
+	if i := slices.IndexFunc(entries, func(e client.JournalEntry) bool { return e.Cursor == afterCursor }); i >= 0 {
+		entries = entries[i+1:]
+	}
+	return entries[:min(limit, len(entries))], nil
+}
+
+type fakeExporter struct {
+	requests []*collectorlogs.ExportLogsServiceRequest
+	err      error
+}
+
+func (e *fakeExporter) Export(_ context.Context, in *collectorlogs.ExportLogsServiceRequest, _ ...grpc.CallOption) (*collectorlogs.ExportLogsServiceResponse, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	e.requests = append(e.requests, in)
+	return &collectorlogs.ExportLogsServiceResponse{}, nil
+}
+
+func newTestForwarder(t *testing.T, logForwarding config.LogForwarding) (*Forwarder, *fakeJournal, *fakeExporter, fileio.ReadWriter) {
+	t.Helper()
+	tempDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tempDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tempDir)),
+	)
+	cfg := config.NewDefault()
+	cfg.DataDir = "/"
+	cfg.LogForwarding = logForwarding
+	journal := &fakeJournal{}
+	exporter := &fakeExporter{}
+	f := NewForwarder(cfg, journal, readWriter, log.NewPrefixLogger("test"))
+	f.exporter = exporter
+	return f, journal, exporter, readWriter
+}
+
+func sentRecords(requests []*collectorlogs.ExportLogsServiceRequest) []*logspb.LogRecord {
+	var records []*logspb.LogRecord
+	for _, req := range requests {
+		records = append(records, req.ResourceLogs[0].ScopeLogs[0].LogRecords...)
+	}
+	return records
+}
+
+func recordAttribute(record *logspb.LogRecord, key string) string {
+	for _, kv := range record.Attributes {
+		if kv.Key == key {
+			return kv.Value.GetStringValue()
+		}
+	}
+	return ""
+}
+
+func TestForwardFiltersEntries(t *testing.T) {
+	require := require.New(t)
+	f, journal, exporter, _ := newTestForwarder(t, config.LogForwarding{
+		Journald:     true,
+		Units:        []string{"flightctl-agent.service"},
+		Applications: true,
+	})
+	journal.entries = []client.JournalEntry{
+		{Cursor: "c1", Priority: 3, Message: "agent error", Unit: "flightctl-agent.service"},
+		{Cursor: "c2", Priority: 6, Message: "sshd noise", Unit: "sshd.service"},
+		{Cursor: "c3", Priority: 6, Message: "app output", Unit: "app.service", ContainerName: "app-web-1"},
+	}
+
+	require.NoError(f.forward(context.Background()))
+	require.Len(exporter.requests, 1)
+
+	resourceAttrs := exporter.requests[0].ResourceLogs[0].Resource.Attributes
+	require.Equal([]*commonpb.KeyValue{stringAttribute("service.name", "flightctl-agent")}, resourceAttrs)
+
+	records := sentRecords(exporter.requests)
+	require.Len(records, 2)
+	require.Equal("agent error", records[0].Body.GetStringValue())
+	require.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, records[0].SeverityNumber)
+	require.Equal(SourceJournald, recordAttribute(records[0], "log.source"))
+	require.Equal(SourceApplication, recordAttribute(records[1], "log.source"))
+	require.Equal("app-web-1", recordAttribute(records[1], "container.name"))
+}
+
+func TestForwardAdvancesCursor(t *testing.T) {
+	require := require.New(t)
+	f, journal, exporter, readWriter := newTestForwarder(t, config.LogForwarding{Applications: true})
+	journal.entries = []client.JournalEntry{
+		{Cursor: "c1", Message: "app output", ContainerName: "app-web-1"},
+		{Cursor: "c2", Message: "not forwarded", Unit: "sshd.service"},
+	}
+
+	exporter.err = errors.New("unavailable")
+	require.Error(f.forward(context.Background()))
+	require.Empty(f.cursor, "the cursor must not advance past entries that failed to export")
+
+	exporter.err = nil
+	require.NoError(f.forward(context.Background()))
+	require.Len(sentRecords(exporter.requests), 1)
+	require.Equal("c2", f.cursor)
+
+	saved, err := readWriter.ReadFile(f.cursorPath)
+	require.NoError(err)
+	require.Equal("c2", string(saved))
+
+	journal.entries = nil
+	require.NoError(f.forward(context.Background()))
+	require.Equal("c2", journal.afterCursor)
+}
+
+func TestForwardCapsEntriesPerInterval(t *testing.T) {
+	require := require.New(t)
+	f, journal, exporter, _ := newTestForwarder(t, config.LogForwarding{Applications: true})
+	total := maxEntriesPerInterval + maxBatchSize/2
+	for i := range total {
+		journal.entries = append(journal.entries, client.JournalEntry{
+			Cursor:        fmt.Sprintf("c%d", i),
+			Message:       "app output",
+			ContainerName: "app-web-1",
+		})
+	}
+
+	require.NoError(f.forward(context.Background()))
+	require.Len(sentRecords(exporter.requests), maxEntriesPerInterval)
+	require.Len(exporter.requests, maxEntriesPerInterval/maxBatchSize)
+	require.Equal(fmt.Sprintf("c%d", maxEntriesPerInterval-1), f.cursor)
+
+	require.NoError(f.forward(context.Background()))
+	require.Len(sentRecords(exporter.requests), total)
+	require.Equal(fmt.Sprintf("c%d", maxEntriesPerInterval-1), journal.afterCursor)
+	require.Equal(fmt.Sprintf("c%d", total-1), f.cursor)
+}
@@ -0,0 +1,357 @@
+package policy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	// DefaultConditionStoragePath is the path checked for free storage unless one is given.
+	DefaultConditionStoragePath = "/var"
+	// DefaultConditionCommandTimeout bounds a condition command that does not set a timeout.
+	DefaultConditionCommandTimeout = 10 * time.Second
+
+	powerSupplyDir = "/sys/class/power_supply"
+	netClassDir    = "/sys/class/net"
+	procLoadAvg    = "/proc/loadavg"
+	procNetRoute   = "/proc/net/route"
+)
+
+// ConditionsNotMetError is returned when conditions of the update policy are not met. It wraps
+// ErrUpdateConditionsNotMet.
+type ConditionsNotMetError struct {
+	PolicyType Type
+	// Unmet describes each condition that is not met.
+	Unmet []string
+}
+
+func (e *ConditionsNotMetError) Error() string {
+	return fmt.Sprintf("%s: %s", errors.ErrUpdateConditionsNotMet, e.Reason())
+}
+
+func (e *ConditionsNotMetError) Unwrap() error {
+	return errors.ErrUpdateConditionsNotMet
+}
+
+// Reason describes the unmet conditions, for display in the device status.
+func (e *ConditionsNotMetError) Reason() string {
+	return fmt.Sprintf("%s conditions not met: %s", e.PolicyType, strings.Join(e.Unmet, "; "))
+}
+
+// powerState is the state of the device's power supplies.
+type powerState struct {
+	// hasBattery is true if the device has a battery.
+	hasBattery bool
+	// external is true if the device is connected to external power.
+	external bool
+	// batteryLevel is the charge of the battery in percent.
+	batteryLevel int
+}
+
+// deviceState reads the local state of the device that conditions are evaluated against.
+type deviceState interface {
+	// FreeStorage returns the free space of the filesystem holding the path in percent.
+	FreeStorage(path string) (int, error)
+	// CPULoad returns the 1-minute load average relative to the number of CPUs in percent.
+	CPULoad() (int, error)
+	// Power returns the state of the device's power supplies.
+	Power() (powerState, error)
+	// Metered returns whether the link of the default route is cellular or marked as metered.
+	Metered(ctx context.Context) (bool, error)
+	// RunCommand runs the command and returns an error unless it exits with code 0.
+	RunCommand(ctx context.Context, run string, timeout time.Duration) error
+}
+
+// conditions gates a policy type on the local state of the device.
+type conditions struct {
+	policyType Type
+	spec       v1beta1.UpdateConditions
+
+	minFreeStorage  int
+	maxCPULoad      int
+	minBatteryLevel int
+	commandTimeouts []time.Duration
+}
+
+func newConditions(policyType Type, spec *v1beta1.UpdateConditions) (*conditions, error) {
+	c := &conditions{
+		policyType:      policyType,
+		spec:            *spec,
+		minFreeStorage:  -1,
+		maxCPULoad:      -1,
+		minBatteryLevel: -1,
+	}
+	var err error
+	if spec.MinFreeStorage != nil {
+		if c.minFreeStorage, err = util.PercentageAsInt(*spec.MinFreeStorage); err != nil {
+			return nil, fmt.Errorf("invalid minFreeStorage: %w", err)
+		}
+	}
+	if spec.MaxCpuLoad != nil {
+		if c.maxCPULoad, err = util.PercentageAsInt(*spec.MaxCpuLoad); err != nil {
+			return nil, fmt.Errorf("invalid maxCpuLoad: %w", err)
+		}
+	}
+	if spec.MinBatteryLevel != nil {
+		if c.minBatteryLevel, err = util.PercentageAsInt(*spec.MinBatteryLevel); err != nil {
+			return nil, fmt.Errorf("invalid minBatteryLevel: %w", err)
+		}
+	}
+	for i, command := range lo.FromPtr(spec.Commands) {
+		timeout := DefaultConditionCommandTimeout
+		if command.Timeout != nil {
+			if timeout, err = time.ParseDuration(*command.Timeout); err != nil {
+				return nil, fmt.Errorf("invalid timeout of command %d: %w", i, err)
+			}
+		}
+		c.commandTimeouts = append(c.commandTimeouts, timeout)
+	}
+	return c, nil
+}
+
+// Check evaluates every condition and returns a ConditionsNotMetError listing those not met. A
+// condition that cannot be evaluated is not met.
+func (c *conditions) Check(ctx context.Context, state deviceState) error {
+	var unmet []string
+
+	if c.minFreeStorage >= 0 {
+		path := lo.FromPtrOr(c.spec.StoragePath, DefaultConditionStoragePath)
+		free, err := state.FreeStorage(path)
+		switch {
+		case err != nil:
+			unmet = append(unmet, fmt.Sprintf("free storage on %s unknown: %v", path, err))
+		case free < c.minFreeStorage:
+			unmet = append(unmet, fmt.Sprintf("free storage on %s is %d%%, below %d%%", path, free, c.minFreeStorage))
+		}
+	}
+
+	if c.maxCPULoad >= 0 {
+		load, err := state.CPULoad()
+		switch {
+		case err != nil:
+			unmet = append(unmet, fmt.Sprintf("CPU load unknown: %v", err))
+		case load > c.maxCPULoad:
+			unmet = append(unmet, fmt.Sprintf("CPU load is %d%%, above %d%%", load, c.maxCPULoad))
+		}
+	}
+
+	if lo.FromPtr(c.spec.RequireExternalPower) || c.minBatteryLevel >= 0 {
+		power, err := state.Power()
+		switch {
+		case err != nil:
+			unmet = append(unmet, fmt.Sprintf("power state unknown: %v", err))
+		case !power.hasBattery || power.external:
+			// the device is not running on battery.
+		case lo.FromPtr(c.spec.RequireExternalPower):
+			unmet = append(unmet, "device is running on battery")
+		case power.batteryLevel < c.minBatteryLevel:
+			unmet = append(unmet, fmt.Sprintf("battery level is %d%%, below %d%%", power.batteryLevel, c.minBatteryLevel))
+		}
+	}
+
+	if lo.FromPtr(c.spec.RequireUnmeteredNetwork) {
+		metered, err := state.Metered(ctx)
+		switch {
+		case err != nil:
+			unmet = append(unmet, fmt.Sprintf("network link type unknown: %v", err))
+		case metered:
+			unmet = append(unmet, "network link is metered")
+		}
+	}
+
+	for i, command := range lo.FromPtr(c.spec.Commands) {
+		if err := state.RunCommand(ctx, command.Run, c.commandTimeouts[i]); err != nil {
+			unmet = append(unmet, fmt.Sprintf("command %q failed: %v", command.Run, err))
+		}
+	}
+
+	if len(unmet) == 0 {
+		return nil
+	}
+	return &ConditionsNotMetError{PolicyType: c.policyType, Unmet: unmet}
+}
+
+// localDeviceState reads the state of the device the agent runs on.
+type localDeviceState struct {
+	readWriter fileio.Reader
+	exec       executer.Executer
+	log        *log.PrefixLogger
+}
+
+func newLocalDeviceState(readWriter fileio.Reader, exec executer.Executer, log *log.PrefixLogger) *localDeviceState {
+	return &localDeviceState{
+		readWriter: readWriter,
+		exec:       exec,
+		log:        log,
+	}
+}
+
+func (s *localDeviceState) FreeStorage(path string) (int, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(s.readWriter.PathFor(path), &stat); err != nil {
+		return 0, err
+	}
+	if stat.Blocks == 0 {
+		return 0, fmt.Errorf("filesystem reports no blocks")
+	}
+	return int(stat.Bavail * 100 / stat.Blocks), nil
+}
+
+func (s *localDeviceState) CPULoad() (int, error) {
+	contents, err := s.readWriter.ReadFile(procLoadAvg)
+	if err != nil {
+		return 0, err
+	}
+	return parseCPULoad(contents, runtime.NumCPU())
+}
+
+func (s *localDeviceState) Power() (powerState, error) {
+	var state powerState
+	entries, err := s.readWriter.ReadDir(powerSupplyDir)
+	if err != nil {
+		// devices without power supply information are not running on battery.
+		if errors.Is(err, errors.ErrNotExist) {
+			return state, nil
+		}
+		return state, err
+	}
+	for _, entry := range entries {
+		dir := filepath.Join(powerSupplyDir, entry.Name())
+		supplyType, err := s.readAttribute(dir, "type")
+		if err != nil {
+			s.log.Debugf("Skipping power supply %s: %v", entry.Name(), err)
+			continue
+		}
+		switch supplyType {
+		case "Battery":
+			capacity, err := s.readAttribute(dir, "capacity")
+			if err != nil {
+				s.log.Debugf("Skipping battery %s: %v", entry.Name(), err)
+				continue
+			}
+			level, err := strconv.Atoi(capacity)
+			if err != nil {
+				return state, fmt.Errorf("parsing capacity of battery %s: %w", entry.Name(), err)
+			}
+			if !state.hasBattery || level < state.batteryLevel {
+				state.batteryLevel = level
+			}
+			state.hasBattery = true
+		case "Mains", "USB":
+			if online, err := s.readAttribute(dir, "online"); err == nil && online == "1" {
+				state.external = true
+			}
+		}
+	}
+	return state, nil
+}
+
+func (s *localDeviceState) Metered(ctx context.Context) (bool, error) {
+	contents, err := s.readWriter.ReadFile(procNetRoute)
+	if err != nil {
+		return false, err
+	}
+	iface, err := parseDefaultRouteInterface(contents)
+	if err != nil {
+		return false, err
+	}
+
+	uevent, err := s.readWriter.ReadFile(filepath.Join(netClassDir, iface, "uevent"))
+	if err == nil && bytes.Contains(uevent, []byte("DEVTYPE=wwan")) {
+		return true, nil
+	}
+
+	// NetworkManager knows whether a link is metered, either configured or guessed, e.g. for
+	// links shared from a phone. devices without NetworkManager are assumed to be unmetered.
+	stdout, stderr, exitCode := s.exec.ExecuteWithContext(ctx, "nmcli", "--terse", "--get-values", "GENERAL.METERED", "device", "show", iface)
+	if exitCode != 0 {
+		s.log.Debugf("Unable to determine whether %s is metered: %s", iface, strings.TrimSpace(stderr))
+		return false, nil
+	}
+	return strings.HasPrefix(strings.TrimSpace(stdout), "yes"), nil
+}
+
+func (s *localDeviceState) RunCommand(ctx context.Context, run string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, stderr, exitCode := s.exec.ExecuteWithContext(ctx, "/bin/sh", "-c", run)
+	if ctx.Err() != nil {
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("%w %d: %s", errors.ErrExitCode, exitCode, strings.TrimSpace(stderr))
+	}
+	return nil
+}
+
+func (s *localDeviceState) readAttribute(dir, name string) (string, error) {
+	contents, err := s.readWriter.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// parseCPULoad parses the contents of /proc/loadavg into the 1-minute load average relative to
+// the number of CPUs in percent.
+func parseCPULoad(contents []byte, numCPU int) (int, error) {
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty load average")
+	}
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing load average: %w", err)
+	}
+	if numCPU < 1 {
+		numCPU = 1
+	}
+	return int(load * 100 / float64(numCPU)), nil
+}
+
+// parseDefaultRouteInterface parses the contents of /proc/net/route into the interface of the
+// default route with the lowest metric.
+func parseDefaultRouteInterface(contents []byte) (string, error) {
+	var iface string
+	bestMetric := -1
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Scan() // header
+	for scanner.Scan() {
+		// Iface Destination Gateway Flags RefCnt Use Metric Mask ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 || fields[1] != "00000000" || fields[7] != "00000000" {
+			continue
+		}
+		metric, err := strconv.Atoi(fields[6])
+		if err != nil {
+			continue
+		}
+		if bestMetric < 0 || metric < bestMetric {
+			iface = fields[0]
+			bestMetric = metric
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if iface == "" {
+		return "", fmt.Errorf("no default route")
+	}
+	return iface, nil
+}
@@ -0,0 +1,216 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fakeDeviceState struct {
+	freeStorage int
+	cpuLoad     int
+	power       powerState
+	metered     bool
+	failing     map[string]bool
+	commands    []string
+}
+
+func (f *fakeDeviceState) FreeStorage(string) (int, error) { return f.freeStorage, nil }
+func (f *fakeDeviceState) CPULoad() (int, error)           { return f.cpuLoad, nil }
+func (f *fakeDeviceState) Power() (powerState, error)      { return f.power, nil }
+func (f *fakeDeviceState) Metered(context.Context) (bool, error) {
+	return f.metered, nil
+}
+func (f *fakeDeviceState) RunCommand(_ context.Context, run string, _ time.Duration) error {
+	f.commands = append(f.commands, run)
+	if f.failing[run] {
+		return fmt.Errorf("exit code 1")
+	}
+	return nil
+}
+
+func TestConditionsCheck(t *testing.T) {
+	spec := &v1beta1.UpdateConditions{
+		MinFreeStorage:          lo.ToPtr("20%"),
+		MaxCpuLoad:              lo.ToPtr("70%"),
+		MinBatteryLevel:         lo.ToPtr("50%"),
+		RequireUnmeteredNetwork: lo.ToPtr(true),
+		Commands:                &[]v1beta1.UpdateConditionCommand{{Run: "line-idle"}, {Run: "no-alarms"}},
+	}
+
+	testCases := []struct {
+		name          string
+		state         *fakeDeviceState
+		expectedUnmet []string
+	}{
+		{
+			name:  "all conditions met",
+			state: &fakeDeviceState{freeStorage: 40, cpuLoad: 10, power: powerState{hasBattery: true, batteryLevel: 80}},
+		},
+		{
+			name:  "no battery",
+			state: &fakeDeviceState{freeStorage: 40, cpuLoad: 10},
+		},
+		{
+			name:  "low battery on external power",
+			state: &fakeDeviceState{freeStorage: 40, cpuLoad: 10, power: powerState{hasBattery: true, external: true, batteryLevel: 10}},
+		},
+		{
+			name: "conditions not met",
+			state: &fakeDeviceState{
+				freeStorage: 12,
+				cpuLoad:     95,
+				power:       powerState{hasBattery: true, batteryLevel: 30},
+				metered:     true,
+				failing:     map[string]bool{"line-idle": true},
+			},
+			expectedUnmet: []string{
+				"free storage on /var is 12%, below 20%",
+				"CPU load is 95%, above 70%",
+				"battery level is 30%, below 50%",
+				"network link is metered",
+				`command "line-idle" failed: exit code 1`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			conditions, err := newConditions(Update, spec)
+			require.NoError(err)
+
+			err = conditions.Check(context.Background(), tc.state)
+			require.Equal([]string{"line-idle", "no-alarms"}, tc.state.commands)
+			if len(tc.expectedUnmet) == 0 {
+				require.NoError(err)
+				return
+			}
+			require.ErrorIs(err, errors.ErrUpdateConditionsNotMet)
+			var conditionsErr *ConditionsNotMetError
+			require.True(errors.As(err, &conditionsErr))
+			require.Equal(tc.expectedUnmet, conditionsErr.Unmet)
+		})
+	}
+}
+
+func TestConditionsRequireExternalPower(t *testing.T) {
+	require := require.New(t)
+	conditions, err := newConditions(Download, &v1beta1.UpdateConditions{RequireExternalPower: lo.ToPtr(true)})
+	require.NoError(err)
+
+	err = conditions.Check(context.Background(), &fakeDeviceState{power: powerState{hasBattery: true, batteryLevel: 100}})
+	require.EqualError(err, "update conditions not met: download conditions not met: device is running on battery")
+	require.NoError(conditions.Check(context.Background(), &fakeDeviceState{power: powerState{hasBattery: true, external: true}}))
+}
+
+func TestManagerCheckConditions(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := NewManager(fileio.NewReader(), executer.NewCommonExecuter(), log.NewPrefixLogger("test")).(*manager)
+	state := &fakeDeviceState{cpuLoad: 95}
+	m.deviceState = state
+
+	require.NoError(m.CheckConditions(ctx, Download, "1"))
+
+	err := m.Sync(ctx, &v1beta1.DeviceSpec{UpdatePolicy: &v1beta1.DeviceUpdatePolicySpec{
+		DownloadConditions: &v1beta1.UpdateConditions{MaxCpuLoad: lo.ToPtr("70%")},
+		UpdateConditions:   &v1beta1.UpdateConditions{MaxCpuLoad: lo.ToPtr("70%")},
+	}})
+	require.NoError(err)
+	require.ErrorIs(m.CheckConditions(ctx, Download, "1"), errors.ErrUpdateConditionsNotMet)
+
+	state.cpuLoad = 10
+	require.NoError(m.CheckConditions(ctx, Download, "1"))
+	require.NoError(m.CheckConditions(ctx, Update, "1"))
+
+	// once met, download conditions are not checked again for the version.
+	state.cpuLoad = 95
+	require.NoError(m.CheckConditions(ctx, Download, "1"))
+	require.ErrorIs(m.CheckConditions(ctx, Download, "2"), errors.ErrUpdateConditionsNotMet)
+	require.ErrorIs(m.CheckConditions(ctx, Update, "1"), errors.ErrUpdateConditionsNotMet)
+}
+
+func TestLocalDeviceState(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tmpDir := t.TempDir()
+	writeFile := func(path, contents string) {
+		fullPath := filepath.Join(tmpDir, path)
+		require.NoError(os.MkdirAll(filepath.Dir(fullPath), 0o755))
+		require.NoError(os.WriteFile(fullPath, []byte(contents), 0o600))
+	}
+	mockExec := executer.NewMockExecuter(ctrl)
+	state := newLocalDeviceState(fileio.NewReader(fileio.WithReaderRootDir(tmpDir)), mockExec, log.NewPrefixLogger("test"))
+
+	// power
+	power, err := state.Power()
+	require.NoError(err)
+	require.False(power.hasBattery)
+
+	writeFile("sys/class/power_supply/BAT0/type", "Battery\n")
+	writeFile("sys/class/power_supply/BAT0/capacity", "42\n")
+	writeFile("sys/class/power_supply/AC/type", "Mains\n")
+	writeFile("sys/class/power_supply/AC/online", "0\n")
+	power, err = state.Power()
+	require.NoError(err)
+	require.Equal(powerState{hasBattery: true, batteryLevel: 42}, power)
+
+	writeFile("sys/class/power_supply/AC/online", "1\n")
+	power, err = state.Power()
+	require.NoError(err)
+	require.True(power.external)
+
+	// network
+	_, err = state.Metered(ctx)
+	require.Error(err)
+
+	writeFile("proc/net/route", `Iface	Destination	Gateway 	Flags	RefCnt	Use	Metric	Mask		MTU	Window	IRTT
+wwan0	00000000	0100000A	0003	0	0	700	00000000	0	0	0
+eth0	00000000	0101A8C0	0003	0	0	100	00000000	0	0	0
+eth0	0001A8C0	00000000	0001	0	0	100	00FFFFFF	0	0	0
+`)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "nmcli", "--terse", "--get-values", "GENERAL.METERED", "device", "show", "eth0").Return("yes (guessed)\n", "", 0)
+	metered, err := state.Metered(ctx)
+	require.NoError(err)
+	require.True(metered)
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "nmcli", "--terse", "--get-values", "GENERAL.METERED", "device", "show", "eth0").Return("", "nmcli: command not found", 127)
+	metered, err = state.Metered(ctx)
+	require.NoError(err)
+	require.False(metered)
+
+	writeFile("sys/class/net/eth0/uevent", "INTERFACE=eth0\nDEVTYPE=wwan\n")
+	metered, err = state.Metered(ctx)
+	require.NoError(err)
+	require.True(metered)
+
+	// cpu load
+	writeFile("proc/loadavg", "1.50 0.80 0.40 2/345 6789\n")
+	_, err = state.CPULoad()
+	require.NoError(err)
+	load, err := parseCPULoad([]byte("1.50 0.80 0.40 2/345 6789\n"), 4)
+	require.NoError(err)
+	require.Equal(37, load)
+
+	// commands
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "/bin/sh", "-c", "line-idle --quiet").Return("", "line busy", 1)
+	err = state.RunCommand(ctx, "line-idle --quiet", time.Second)
+	require.ErrorIs(err, errors.ErrExitCode)
+	require.ErrorContains(err, "line busy")
+}
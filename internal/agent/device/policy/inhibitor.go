@@ -0,0 +1,194 @@
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+)
+
+// InhibitAction is a disruptive action of the agent that applications on the device can inhibit.
+type InhibitAction string
+
+const (
+	// InhibitUpdate blocks the agent from starting to apply a new device spec.
+	InhibitUpdate InhibitAction = "update"
+	// InhibitReboot blocks the agent from starting an update that requires a reboot.
+	InhibitReboot InhibitAction = "reboot"
+)
+
+const (
+	// DefaultInhibitorTTL is the lease of an inhibitor that does not request one.
+	DefaultInhibitorTTL = 5 * time.Minute
+	// MaxInhibitorTTL bounds the lease of an inhibitor, so that an application that crashed
+	// while holding one cannot block updates for longer than this.
+	MaxInhibitorTTL = 24 * time.Hour
+	// MaxInhibitors bounds the number of inhibitors held at the same time.
+	MaxInhibitors = 64
+)
+
+// Inhibitor is held by an application on the device to keep the agent from updating or rebooting
+// the device. Inhibitors are leases: they expire unless renewed before ExpiresAt, so that an
+// application that exits without releasing its inhibitor does not block updates forever.
+type Inhibitor struct {
+	// ID identifies the inhibitor. Acquiring an inhibitor with the ID of a held one renews it.
+	ID string `json:"id"`
+	// Reason is shown in the device status while the inhibitor blocks an update.
+	Reason string `json:"reason"`
+	// Actions are the actions the inhibitor blocks.
+	Actions []InhibitAction `json:"actions"`
+	// UID is the user ID of the process that acquired the inhibitor.
+	UID uint32 `json:"uid"`
+	// AcquiredAt is when the inhibitor was first acquired.
+	AcquiredAt time.Time `json:"acquiredAt"`
+	// ExpiresAt is when the inhibitor is released unless renewed.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Blocks returns true if the inhibitor blocks the action.
+func (i *Inhibitor) Blocks(action InhibitAction) bool {
+	return slices.Contains(i.Actions, action)
+}
+
+// InhibitedError is returned when inhibitors block an action. It wraps ErrUpdateInhibited.
+type InhibitedError struct {
+	Action     InhibitAction
+	Inhibitors []Inhibitor
+}
+
+func (e *InhibitedError) Error() string {
+	return fmt.Sprintf("%s: %s", errors.ErrUpdateInhibited, e.Reason())
+}
+
+func (e *InhibitedError) Unwrap() error {
+	return errors.ErrUpdateInhibited
+}
+
+// Reason describes which inhibitors block the action and why, for display in the device status.
+func (e *InhibitedError) Reason() string {
+	reasons := make([]string, 0, len(e.Inhibitors))
+	for _, inhibitor := range e.Inhibitors {
+		reasons = append(reasons, fmt.Sprintf("%q (%s)", inhibitor.ID, inhibitor.Reason))
+	}
+	return fmt.Sprintf("%s inhibited by %s", e.Action, strings.Join(reasons, ", "))
+}
+
+// inhibitors is the set of inhibitors held by applications on the device. It is safe for
+// concurrent use, as inhibitors are acquired and released by the local API server while the
+// agent reconciles.
+type inhibitors struct {
+	mu    sync.Mutex
+	items map[string]Inhibitor
+
+	// this is used for testing to override time.Now
+	nowFn func() time.Time
+}
+
+func newInhibitors() *inhibitors {
+	return &inhibitors{
+		items: make(map[string]Inhibitor),
+		nowFn: time.Now,
+	}
+}
+
+func (i *inhibitors) acquire(inhibitor Inhibitor, ttl time.Duration) (Inhibitor, error) {
+	if inhibitor.ID == "" {
+		return Inhibitor{}, fmt.Errorf("%w: id is required", errors.ErrInvalidInhibitor)
+	}
+	if len(inhibitor.Actions) == 0 {
+		return Inhibitor{}, fmt.Errorf("%w: at least one action is required", errors.ErrInvalidInhibitor)
+	}
+	for _, action := range inhibitor.Actions {
+		if action != InhibitUpdate && action != InhibitReboot {
+			return Inhibitor{}, fmt.Errorf("%w: unknown action %q", errors.ErrInvalidInhibitor, action)
+		}
+	}
+	if ttl <= 0 {
+		ttl = DefaultInhibitorTTL
+	}
+	if ttl > MaxInhibitorTTL {
+		return Inhibitor{}, fmt.Errorf("%w: ttl cannot exceed %s", errors.ErrInvalidInhibitor, MaxInhibitorTTL)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	now := i.nowFn()
+	i.pruneLocked(now)
+
+	existing, ok := i.items[inhibitor.ID]
+	switch {
+	case ok && !canModify(existing, inhibitor.UID):
+		return Inhibitor{}, errors.ErrInhibitorOwner
+	case ok:
+		inhibitor.AcquiredAt = existing.AcquiredAt
+		inhibitor.UID = existing.UID
+	case len(i.items) >= MaxInhibitors:
+		return Inhibitor{}, errors.ErrInhibitorLimit
+	default:
+		inhibitor.AcquiredAt = now
+	}
+	inhibitor.ExpiresAt = now.Add(ttl)
+	i.items[inhibitor.ID] = inhibitor
+	return inhibitor, nil
+}
+
+func (i *inhibitors) release(id string, uid uint32) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.pruneLocked(i.nowFn())
+
+	existing, ok := i.items[id]
+	if !ok {
+		return errors.ErrInhibitorNotFound
+	}
+	if !canModify(existing, uid) {
+		return errors.ErrInhibitorOwner
+	}
+	delete(i.items, id)
+	return nil
+}
+
+func (i *inhibitors) list() []Inhibitor {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.pruneLocked(i.nowFn())
+
+	items := make([]Inhibitor, 0, len(i.items))
+	for _, inhibitor := range i.items {
+		items = append(items, inhibitor)
+	}
+	slices.SortFunc(items, func(a, b Inhibitor) int { return strings.Compare(a.ID, b.ID) })
+	return items
+}
+
+// check returns an InhibitedError if any inhibitor blocks the action.
+func (i *inhibitors) check(action InhibitAction) error {
+	var blocking []Inhibitor
+	for _, inhibitor := range i.list() {
+		if inhibitor.Blocks(action) {
+			blocking = append(blocking, inhibitor)
+		}
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+	return &InhibitedError{Action: action, Inhibitors: blocking}
+}
+
+// pruneLocked removes expired inhibitors. It assumes the lock is held.
+func (i *inhibitors) pruneLocked(now time.Time) {
+	for id, inhibitor := range i.items {
+		if !now.Before(inhibitor.ExpiresAt) {
+			delete(i.items, id)
+		}
+	}
+}
+
+// canModify returns true if the user may renew or release the inhibitor. Root may modify any
+// inhibitor, for example to release one left behind by a misbehaving application.
+func canModify(inhibitor Inhibitor, uid uint32) bool {
+	return uid == 0 || uid == inhibitor.UID
+}
@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInhibitors(t *testing.T) {
+	require := require.New(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	i := newInhibitors()
+	i.nowFn = func() time.Time { return now }
+
+	batch := Inhibitor{ID: "batch-42", Reason: "production batch running", Actions: []InhibitAction{InhibitReboot}, UID: 1001}
+	acquired, err := i.acquire(batch, time.Hour)
+	require.NoError(err)
+	require.Equal(now, acquired.AcquiredAt)
+	require.Equal(now.Add(time.Hour), acquired.ExpiresAt)
+
+	// only the actions held are blocked
+	require.NoError(i.check(InhibitUpdate))
+	err = i.check(InhibitReboot)
+	require.ErrorIs(err, errors.ErrUpdateInhibited)
+	require.Contains(err.Error(), `reboot inhibited by "batch-42" (production batch running)`)
+
+	// another user can neither renew nor release the inhibitor
+	_, err = i.acquire(Inhibitor{ID: "batch-42", Actions: []InhibitAction{InhibitUpdate}, UID: 1002}, 0)
+	require.ErrorIs(err, errors.ErrInhibitorOwner)
+	require.ErrorIs(i.release("batch-42", 1002), errors.ErrInhibitorOwner)
+
+	// renewing extends the lease but keeps the acquisition time
+	now = now.Add(30 * time.Minute)
+	renewed, err := i.acquire(batch, 0)
+	require.NoError(err)
+	require.Equal(acquired.AcquiredAt, renewed.AcquiredAt)
+	require.Equal(now.Add(DefaultInhibitorTTL), renewed.ExpiresAt)
+
+	// expired inhibitors no longer block
+	now = now.Add(DefaultInhibitorTTL)
+	require.NoError(i.check(InhibitReboot))
+	require.Empty(i.list())
+	require.ErrorIs(i.release("batch-42", 1001), errors.ErrInhibitorNotFound)
+
+	// root can release any inhibitor
+	_, err = i.acquire(batch, time.Minute)
+	require.NoError(err)
+	require.NoError(i.release("batch-42", 0))
+	require.Empty(i.list())
+}
+
+func TestInhibitorsValidation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		inhibitor Inhibitor
+		ttl       time.Duration
+	}{
+		{
+			name:      "missing id",
+			inhibitor: Inhibitor{Actions: []InhibitAction{InhibitUpdate}},
+		},
+		{
+			name:      "missing actions",
+			inhibitor: Inhibitor{ID: "a"},
+		},
+		{
+			name:      "unknown action",
+			inhibitor: Inhibitor{ID: "a", Actions: []InhibitAction{"shutdown"}},
+		},
+		{
+			name:      "ttl too long",
+			inhibitor: Inhibitor{ID: "a", Actions: []InhibitAction{InhibitUpdate}},
+			ttl:       MaxInhibitorTTL + time.Second,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newInhibitors().acquire(tc.inhibitor, tc.ttl)
+			require.ErrorIs(t, err, errors.ErrInvalidInhibitor)
+		})
+	}
+}
+
+func TestInhibitorsLimit(t *testing.T) {
+	require := require.New(t)
+	i := newInhibitors()
+	for n := 0; n < MaxInhibitors; n++ {
+		_, err := i.acquire(Inhibitor{ID: fmt.Sprintf("inhibitor-%d", n), Actions: []InhibitAction{InhibitUpdate}}, 0)
+		require.NoError(err)
+	}
+	_, err := i.acquire(Inhibitor{ID: "one-too-many", Actions: []InhibitAction{InhibitUpdate}}, 0)
+	require.ErrorIs(err, errors.ErrInhibitorLimit)
+}
@@ -6,24 +6,37 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
 )
 
 type manager struct {
-	download *schedule
-	update   *schedule
+	download           *schedule
+	update             *schedule
+	downloadConditions *conditions
+	updateConditions   *conditions
+	// downloadConditionsMet is the version whose download conditions were
+	// met. once a download starts, it is not gated again.
+	downloadConditionsMet string
+	inhibitors            *inhibitors
+	deviceState           deviceState
 
 	log *log.PrefixLogger
 }
 
 // NewManager returns a new device policy manager.
 // Note: This manager is designed for sequential operations only and is not
-// thread-safe.
-func NewManager(log *log.PrefixLogger) Manager {
+// thread-safe, except for the inhibitor methods which may be called
+// concurrently.
+func NewManager(readWriter fileio.Reader, exec executer.Executer, log *log.PrefixLogger) Manager {
 	return &manager{
-		log: log,
+		inhibitors:  newInhibitors(),
+		deviceState: newLocalDeviceState(readWriter, exec, log),
+		log:         log,
 	}
 }
 
@@ -38,6 +51,8 @@ func (m *manager) Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error {
 		m.log.Debugf("No update policy defined")
 		m.update = nil
 		m.download = nil
+		m.downloadConditions = nil
+		m.updateConditions = nil
 		return nil
 	}
 	if desired.UpdatePolicy.DownloadSchedule != nil {
@@ -60,6 +75,26 @@ func (m *manager) Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error {
 		m.update = nil
 	}
 
+	if desired.UpdatePolicy.DownloadConditions != nil {
+		conditions, err := newConditions(Download, desired.UpdatePolicy.DownloadConditions)
+		if err != nil {
+			return fmt.Errorf("failed to parse download conditions: %w", err)
+		}
+		m.downloadConditions = conditions
+	} else {
+		m.downloadConditions = nil
+	}
+
+	if desired.UpdatePolicy.UpdateConditions != nil {
+		conditions, err := newConditions(Update, desired.UpdatePolicy.UpdateConditions)
+		if err != nil {
+			return fmt.Errorf("failed to parse update conditions: %w", err)
+		}
+		m.updateConditions = conditions
+	} else {
+		m.updateConditions = nil
+	}
+
 	return nil
 }
 
@@ -85,6 +120,61 @@ func (m *manager) IsReady(ctx context.Context, policyType Type) bool {
 	return false
 }
 
+func (m *manager) CheckConditions(ctx context.Context, policyType Type, version string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	switch policyType {
+	case Download:
+		if m.downloadConditions == nil || m.downloadConditionsMet == version {
+			return nil
+		}
+		if err := m.downloadConditions.Check(ctx, m.deviceState); err != nil {
+			return err
+		}
+		m.log.Infof("Policy %s conditions are met for version %s", policyType, version)
+		m.downloadConditionsMet = version
+		return nil
+	case Update:
+		if m.updateConditions == nil {
+			return nil
+		}
+		if err := m.updateConditions.Check(ctx, m.deviceState); err != nil {
+			return err
+		}
+		m.log.Infof("Policy %s conditions are met for version %s", policyType, version)
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errors.ErrInvalidPolicyType, policyType)
+	}
+}
+
+func (m *manager) AcquireInhibitor(inhibitor Inhibitor, ttl time.Duration) (Inhibitor, error) {
+	acquired, err := m.inhibitors.acquire(inhibitor, ttl)
+	if err != nil {
+		return Inhibitor{}, err
+	}
+	m.log.Debugf("Inhibitor %s acquired by uid %d until %s: %s", acquired.ID, acquired.UID, acquired.ExpiresAt.Format(time.RFC3339), acquired.Reason)
+	return acquired, nil
+}
+
+func (m *manager) ReleaseInhibitor(id string, uid uint32) error {
+	if err := m.inhibitors.release(id, uid); err != nil {
+		return err
+	}
+	m.log.Debugf("Inhibitor %s released by uid %d", id, uid)
+	return nil
+}
+
+func (m *manager) ListInhibitors() []Inhibitor {
+	return m.inhibitors.list()
+}
+
+func (m *manager) CheckInhibitors(action InhibitAction) error {
+	return m.inhibitors.check(action)
+}
+
 type schedule struct {
 	policyType         Type
 	location           *time.Location
@@ -12,6 +12,7 @@ package policy
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,49 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// AcquireInhibitor mocks base method.
+func (m *MockManager) AcquireInhibitor(inhibitor Inhibitor, ttl time.Duration) (Inhibitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireInhibitor", inhibitor, ttl)
+	ret0, _ := ret[0].(Inhibitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireInhibitor indicates an expected call of AcquireInhibitor.
+func (mr *MockManagerMockRecorder) AcquireInhibitor(inhibitor, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireInhibitor", reflect.TypeOf((*MockManager)(nil).AcquireInhibitor), inhibitor, ttl)
+}
+
+// CheckConditions mocks base method.
+func (m *MockManager) CheckConditions(ctx context.Context, policyType Type, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckConditions", ctx, policyType, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckConditions indicates an expected call of CheckConditions.
+func (mr *MockManagerMockRecorder) CheckConditions(ctx, policyType, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConditions", reflect.TypeOf((*MockManager)(nil).CheckConditions), ctx, policyType, version)
+}
+
+// CheckInhibitors mocks base method.
+func (m *MockManager) CheckInhibitors(action InhibitAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInhibitors", action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckInhibitors indicates an expected call of CheckInhibitors.
+func (mr *MockManagerMockRecorder) CheckInhibitors(action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInhibitors", reflect.TypeOf((*MockManager)(nil).CheckInhibitors), action)
+}
+
 // IsReady mocks base method.
 func (m *MockManager) IsReady(ctx context.Context, policyType Type) bool {
 	m.ctrl.T.Helper()
@@ -54,6 +98,34 @@ func (mr *MockManagerMockRecorder) IsReady(ctx, policyType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReady", reflect.TypeOf((*MockManager)(nil).IsReady), ctx, policyType)
 }
 
+// ListInhibitors mocks base method.
+func (m *MockManager) ListInhibitors() []Inhibitor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInhibitors")
+	ret0, _ := ret[0].([]Inhibitor)
+	return ret0
+}
+
+// ListInhibitors indicates an expected call of ListInhibitors.
+func (mr *MockManagerMockRecorder) ListInhibitors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInhibitors", reflect.TypeOf((*MockManager)(nil).ListInhibitors))
+}
+
+// ReleaseInhibitor mocks base method.
+func (m *MockManager) ReleaseInhibitor(id string, uid uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseInhibitor", id, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseInhibitor indicates an expected call of ReleaseInhibitor.
+func (mr *MockManagerMockRecorder) ReleaseInhibitor(id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseInhibitor", reflect.TypeOf((*MockManager)(nil).ReleaseInhibitor), id, uid)
+}
+
 // Sync mocks base method.
 func (m *MockManager) Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package policy
 
 import (
 	"context"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 )
@@ -16,4 +17,16 @@ const (
 type Manager interface {
 	Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error
 	IsReady(ctx context.Context, policyType Type) bool
+	// CheckConditions returns a ConditionsNotMetError if conditions of the update policy for the
+	// policy type are not met by the device. Download conditions are only checked until they are
+	// met for the version.
+	CheckConditions(ctx context.Context, policyType Type, version string) error
+	// AcquireInhibitor acquires the inhibitor for the ttl, or renews it if it is already held.
+	AcquireInhibitor(inhibitor Inhibitor, ttl time.Duration) (Inhibitor, error)
+	// ReleaseInhibitor releases the inhibitor with the given ID on behalf of the user.
+	ReleaseInhibitor(id string, uid uint32) error
+	// ListInhibitors returns the inhibitors currently held.
+	ListInhibitors() []Inhibitor
+	// CheckInhibitors returns an error wrapping ErrUpdateInhibited if an inhibitor blocks the action.
+	CheckInhibitors(action InhibitAction) error
 }
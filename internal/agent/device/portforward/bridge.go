@@ -0,0 +1,76 @@
+package portforward
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+// bridge copies data bidirectionally between conn and streamClient until either side
+// closes or ctx is canceled. label is used only for debug logging.
+func bridge(ctx context.Context, label string, conn net.Conn, streamClient grpc_v1.RouterService_StreamClient, logger *log.PrefixLogger) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// conn → gRPC stream
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		// Signal the server that no more data will be sent, which unblocks Recv() below.
+		defer func() {
+			if closeErr := streamClient.CloseSend(); closeErr != nil {
+				logger.Debugf("%s: failed to close send side of gRPC stream: %v", label, closeErr)
+			}
+		}()
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := conn.Read(buf)
+			if n > 0 {
+				if sendErr := streamClient.Send(&grpc_v1.StreamRequest{Payload: buf[:n]}); sendErr != nil {
+					logger.Debugf("%s: send to gRPC stream failed: %v", label, sendErr)
+					return
+				}
+			}
+			if err != nil {
+				logger.Debugf("%s: connection read error: %v", label, err)
+				return
+			}
+		}
+	}()
+
+	// gRPC stream → conn
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		for {
+			msg, err := streamClient.Recv()
+			if err == io.EOF || (msg != nil && msg.Closed) {
+				return
+			}
+			if err != nil {
+				logger.Debugf("%s: recv from gRPC stream failed: %v", label, err)
+				return
+			}
+			if len(msg.Payload) > 0 {
+				if _, writeErr := conn.Write(msg.Payload); writeErr != nil {
+					logger.Debugf("%s: write to connection failed: %v", label, writeErr)
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Wait()
+}
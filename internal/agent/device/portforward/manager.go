@@ -0,0 +1,181 @@
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/remotesession"
+	"github.com/flightctl/flightctl/internal/agent/device/spec"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/pkg/log"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// dialTimeout bounds how long the agent waits to connect to the local port.
+	dialTimeout = 10 * time.Second
+)
+
+// Manager starts a TCP connection to a loopback port for every port-forward entry in the
+// device's remote-session annotation and bridges it to the remote-access service over a
+// gRPC stream. Only ports listed in the agent config's port-forward.allowed-ports can be
+// reached; sessions for any other port are rejected before any data is exchanged.
+type Manager struct {
+	grpcClient grpc_v1.RouterServiceClient
+	deviceName string
+	watcher    spec.Watcher
+	log        *log.PrefixLogger
+
+	allowedPorts atomic.Pointer[[]int32]
+	// dial is overridable in tests.
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	sessions *remotesession.Tracker
+}
+
+// NewManager returns a port-forward Manager. grpcClient may be nil if the remote-access
+// service is not configured, in which case sessions are logged and ignored.
+func NewManager(
+	grpcClient grpc_v1.RouterServiceClient,
+	deviceName string,
+	cfg *config.Config,
+	watcher spec.Watcher,
+	log *log.PrefixLogger,
+) *Manager {
+	m := &Manager{
+		grpcClient: grpcClient,
+		deviceName: deviceName,
+		watcher:    watcher,
+		log:        log,
+		dial:       (&net.Dialer{Timeout: dialTimeout}).DialContext,
+	}
+	m.sessions = remotesession.NewTracker(log, isPortForward, m.start)
+	ports := slices.Clone(cfg.PortForward.AllowedPorts)
+	m.allowedPorts.Store(&ports)
+	return m
+}
+
+// ReloadConfig updates the set of ports that may be forwarded to. Sessions that are already
+// running are not affected.
+func (m *Manager) ReloadConfig(ctx context.Context, cfg *config.Config) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	ports := slices.Clone(cfg.PortForward.AllowedPorts)
+	m.allowedPorts.Store(&ports)
+	m.log.Infof("Port-forward config reloaded: allowed-ports=%v", ports)
+	return nil
+}
+
+func (m *Manager) isAllowed(port int32) bool {
+	return slices.Contains(*m.allowedPorts.Load(), port)
+}
+
+// Run syncs port-forward sessions each time a new desired spec is published, until the
+// watcher is closed.
+func (m *Manager) Run(ctx context.Context) {
+	m.log.Debug("Starting port-forward controller")
+	defer m.log.Debug("Stopping port-forward controller")
+
+	for {
+		desired, err := m.watcher.Pop()
+		if err != nil {
+			m.log.Warnf("failed to pop from spec watcher: %v", err)
+			m.sessions.Wait()
+			return
+		}
+		m.Sync(ctx, desired)
+	}
+}
+
+// Sync starts a goroutine for each port-forward entry in the remote-session annotation that
+// is not already tracked. Entries without a port belong to application consoles.
+func (m *Manager) Sync(ctx context.Context, device *v1beta1.Device) {
+	m.sessions.Sync(ctx, device)
+}
+
+// isPortForward returns true for the port-forward entries of the remote-session annotation.
+func isPortForward(entry v1beta1.DeviceRemoteSession) bool {
+	return entry.Port != 0
+}
+
+// Wait blocks until all active sessions have finished.
+func (m *Manager) Wait() {
+	m.sessions.Wait()
+}
+
+// start connects to the local port and opens the gRPC stream. A refused port or a failed
+// dial is reported via stream metadata so the server can fail the client's request before
+// upgrading it.
+func (m *Manager) start(ctx context.Context, entry v1beta1.DeviceRemoteSession) {
+	if m.grpcClient == nil {
+		m.log.Errorf("gRPC client not available for port-forward session %s", entry.SessionID)
+		return
+	}
+
+	var conn net.Conn
+	var sessionErr error
+	var sessionErrCode string
+	if !m.isAllowed(entry.Port) {
+		sessionErr = fmt.Errorf("port %d is not allowed for port forwarding on this device", entry.Port)
+		sessionErrCode = consts.PortForwardErrorCodeNotAllowed
+	} else {
+		var err error
+		conn, err = m.dial(ctx, "tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(entry.Port))))
+		if err != nil {
+			sessionErr = fmt.Errorf("connecting to port %d: %w", entry.Port, err)
+		}
+	}
+
+	metadataPairs := []string{
+		consts.GrpcSessionIDKey, entry.SessionID,
+		consts.GrpcClientNameKey, m.deviceName,
+	}
+	if sessionErr != nil {
+		metadataPairs = append(metadataPairs, consts.GrpcSessionErrorKey, sanitizeGrpcMetadataValue(sessionErr.Error()))
+		if sessionErrCode != "" {
+			metadataPairs = append(metadataPairs, consts.GrpcSessionErrorCodeKey, sessionErrCode)
+		}
+	} else {
+		metadataPairs = append(metadataPairs, consts.GrpcSelectedProtocolKey, consts.PortForwardProtocol)
+	}
+	streamCtx := metadata.AppendToOutgoingContext(ctx, metadataPairs...)
+	streamClient, err := m.grpcClient.Stream(streamCtx)
+	if err != nil {
+		m.log.Errorf("error creating port-forward stream for session %s: %v", entry.SessionID, err)
+		if conn != nil {
+			_ = conn.Close()
+		}
+		return
+	}
+
+	if sessionErr != nil {
+		m.log.Warnf("rejecting port-forward session %s: %v", entry.SessionID, sessionErr)
+		_ = streamClient.CloseSend()
+		return
+	}
+
+	m.log.Infof("port-forward session %s to port %d started", entry.SessionID, entry.Port)
+	bridge(ctx, fmt.Sprintf("port %d", entry.Port), conn, streamClient, m.log)
+	m.log.Infof("port-forward session %s to port %d ended", entry.SessionID, entry.Port)
+}
+
+// sanitizeGrpcMetadataValue replaces every byte outside the printable ASCII range so the
+// value is accepted as gRPC metadata.
+func sanitizeGrpcMetadataValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return '?'
+		}
+		return r
+	}, s)
+}
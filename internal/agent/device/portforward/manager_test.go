@@ -0,0 +1,170 @@
+package portforward
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/console"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func deviceWithSessions(t *testing.T, sessions ...v1beta1.DeviceRemoteSession) *v1beta1.Device {
+	t.Helper()
+	b, err := json.Marshal(sessions)
+	require.NoError(t, err)
+	return &v1beta1.Device{
+		Metadata: v1beta1.ObjectMeta{
+			Annotations: &map[string]string{v1beta1.DeviceAnnotationRemoteSession: string(b)},
+		},
+	}
+}
+
+func newTestManager(t *testing.T, allowedPorts ...int32) (*Manager, *console.MockRouterServiceClient, *console.MockRouterService_StreamClient) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	grpcClient := console.NewMockRouterServiceClient(ctrl)
+	streamClient := console.NewMockRouterService_StreamClient(ctrl)
+	cfg := config.NewDefault()
+	cfg.PortForward.AllowedPorts = allowedPorts
+	return NewManager(grpcClient, "test-device", cfg, nil, log.NewPrefixLogger("test")), grpcClient, streamClient
+}
+
+func outgoingValue(ctx context.Context, key string) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if vals := md.Get(key); len(vals) == 1 {
+		return vals[0]
+	}
+	return ""
+}
+
+func TestSyncRejectsPortNotAllowed(t *testing.T) {
+	require := require.New(t)
+	m, grpcClient, streamClient := newTestManager(t, 8080)
+	m.dial = func(context.Context, string, string) (net.Conn, error) {
+		t.Fatal("dial must not be called for a port that is not allowed")
+		return nil, nil
+	}
+
+	var streamCtx context.Context
+	grpcClient.EXPECT().Stream(gomock.Any()).DoAndReturn(func(ctx context.Context, _ ...grpc.CallOption) (grpc_v1.RouterService_StreamClient, error) {
+		streamCtx = ctx
+		return streamClient, nil
+	})
+	streamClient.EXPECT().CloseSend().Return(nil)
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", Port: 22}))
+	m.Wait()
+
+	require.Equal("s1", outgoingValue(streamCtx, consts.GrpcSessionIDKey))
+	require.Contains(outgoingValue(streamCtx, consts.GrpcSessionErrorKey), "port 22 is not allowed")
+	require.Equal(consts.PortForwardErrorCodeNotAllowed, outgoingValue(streamCtx, consts.GrpcSessionErrorCodeKey))
+	require.Empty(outgoingValue(streamCtx, consts.GrpcSelectedProtocolKey))
+}
+
+func TestSyncIgnoresAppConsoleAndUnnamedSessions(t *testing.T) {
+	m, _, _ := newTestManager(t, 8080)
+	// No Stream() expectation: neither entry may start a port-forward session.
+	m.Sync(context.Background(), deviceWithSessions(t,
+		v1beta1.DeviceRemoteSession{SessionID: "app", AppName: "vm", ConsoleType: "serial"},
+		v1beta1.DeviceRemoteSession{Port: 8080},
+	))
+	m.Wait()
+}
+
+func TestSyncBridgesAllowedPort(t *testing.T) {
+	require := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 4)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		_, _ = conn.Write([]byte("pong"))
+	}()
+
+	m, grpcClient, streamClient := newTestManager(t, int32(port))
+
+	var streamCtx context.Context
+	grpcClient.EXPECT().Stream(gomock.Any()).DoAndReturn(func(ctx context.Context, _ ...grpc.CallOption) (grpc_v1.RouterService_StreamClient, error) {
+		streamCtx = ctx
+		return streamClient, nil
+	})
+
+	serverDone := make(chan struct{})
+	recvCalls := 0
+	streamClient.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		recvCalls++
+		if recvCalls == 1 {
+			return &grpc_v1.StreamResponse{Payload: []byte("ping")}, nil
+		}
+		<-serverDone
+		return nil, io.EOF
+	}).AnyTimes()
+
+	var mu sync.Mutex
+	var received []byte
+	streamClient.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *grpc_v1.StreamRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, req.Payload...)
+		return nil
+	}).AnyTimes()
+	streamClient.EXPECT().CloseSend().DoAndReturn(func() error {
+		// The device-side connection closed; the server ends the stream in response.
+		close(serverDone)
+		return nil
+	})
+
+	m.Sync(context.Background(), deviceWithSessions(t, v1beta1.DeviceRemoteSession{SessionID: "s1", Port: int32(port)}))
+
+	done := make(chan struct{})
+	go func() {
+		m.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("port-forward session did not finish")
+	}
+
+	require.Equal(consts.PortForwardProtocol, outgoingValue(streamCtx, consts.GrpcSelectedProtocolKey))
+	require.Empty(outgoingValue(streamCtx, consts.GrpcSessionErrorKey))
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal("pong", string(received))
+}
+
+func TestReloadConfig(t *testing.T) {
+	require := require.New(t)
+	m, _, _ := newTestManager(t)
+	require.False(m.isAllowed(8080))
+
+	cfg := config.NewDefault()
+	cfg.PortForward.AllowedPorts = []int32{8080}
+	require.NoError(m.ReloadConfig(context.Background(), cfg))
+	require.True(m.isAllowed(8080))
+}
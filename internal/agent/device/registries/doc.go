@@ -0,0 +1,5 @@
+// Package registries configures the registry mirrors of the device's spec for podman, bootc
+// and CRI-O and reports whether the mirrors are reachable.
+package registries
+
+//go:generate go run -modfile=../../../../tools/go.mod go.uber.org/mock/mockgen -source=registries.go -destination=mock_registries.go -package=registries
@@ -0,0 +1,332 @@
+package registries
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	// MirrorsConfPath is the registries.conf drop-in the registry mirrors of the device's spec
+	// are written to. It is read by podman, bootc and CRI-O on each pull.
+	MirrorsConfPath = "/etc/containers/registries.conf.d/99-flightctl-mirrors.conf"
+	// certsDir holds the CA certificates of registries, see containers-certs.d(5).
+	certsDir = "/etc/containers/certs.d"
+
+	// probeInterval is how often the reachability of the mirrors is checked.
+	probeInterval = 5 * time.Minute
+	// probeTimeout bounds checking the reachability of a single mirror.
+	probeTimeout = 10 * time.Second
+)
+
+type Manager interface {
+	// Sync writes the registry mirrors of the desired spec to the registries.conf drop-in,
+	// removing it if the spec has none.
+	Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error
+	// Run periodically checks the reachability of the mirrors until ctx is canceled.
+	Run(ctx context.Context)
+	// MirrorHosts returns the hosts, with optional ports, of the mirrors of the image's
+	// registry.
+	MirrorHosts(image string) []string
+	status.Exporter
+}
+
+type mirrorKey struct {
+	registry string
+	location string
+}
+
+type manager struct {
+	readWriter fileio.ReadWriter
+	log        *log.PrefixLogger
+
+	mu       sync.Mutex
+	mirrors  []v1beta1.RegistryMirror
+	statuses map[mirrorKey]v1beta1.DeviceRegistryMirrorStatus
+	changed  chan struct{}
+
+	// this is used for testing to override probing mirrors
+	probeFn func(ctx context.Context, location string, insecure bool) error
+}
+
+// NewManager creates a new registry mirrors manager.
+func NewManager(readWriter fileio.ReadWriter, log *log.PrefixLogger) Manager {
+	m := &manager{
+		readWriter: readWriter,
+		log:        log,
+		statuses:   make(map[mirrorKey]v1beta1.DeviceRegistryMirrorStatus),
+		changed:    make(chan struct{}, 1),
+	}
+	m.probeFn = m.probe
+	return m
+}
+
+func (m *manager) Sync(ctx context.Context, desired *v1beta1.DeviceSpec) error {
+	var mirrors []v1beta1.RegistryMirror
+	if desired != nil && desired.RegistryMirrors != nil {
+		mirrors = *desired.RegistryMirrors
+	}
+
+	m.mu.Lock()
+	unchanged := reflect.DeepEqual(m.mirrors, mirrors)
+	m.mu.Unlock()
+
+	if err := m.writeConf(mirrors); err != nil {
+		return err
+	}
+	if unchanged {
+		return nil
+	}
+
+	m.mu.Lock()
+	m.mirrors = mirrors
+	for key := range m.statuses {
+		if !containsMirror(mirrors, key) {
+			delete(m.statuses, key)
+		}
+	}
+	m.mu.Unlock()
+
+	select {
+	case m.changed <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// writeConf writes the drop-in if its contents differ, so that a drop-in modified or
+// removed on the device is restored on the next sync.
+func (m *manager) writeConf(mirrors []v1beta1.RegistryMirror) error {
+	exists, err := m.readWriter.PathExists(MirrorsConfPath)
+	if err != nil {
+		return fmt.Errorf("checking registry mirrors config: %w", err)
+	}
+	if len(mirrors) == 0 {
+		if !exists {
+			return nil
+		}
+		m.log.Info("Removing registry mirrors config")
+		if err := m.readWriter.RemoveFile(MirrorsConfPath); err != nil {
+			return fmt.Errorf("removing registry mirrors config: %w", err)
+		}
+		return nil
+	}
+
+	contents := renderConf(mirrors)
+	if exists {
+		current, err := m.readWriter.ReadFile(MirrorsConfPath)
+		if err != nil {
+			return fmt.Errorf("reading registry mirrors config: %w", err)
+		}
+		if bytes.Equal(current, contents) {
+			return nil
+		}
+	}
+	m.log.Infof("Writing registry mirrors config for %d registries", len(mirrors))
+	if err := m.readWriter.WriteFile(MirrorsConfPath, contents, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing registry mirrors config: %w", err)
+	}
+	return nil
+}
+
+// renderConf renders the mirrors in the containers-registries.conf(5) v2 format. Mirrors are
+// tried in order, and the registry itself is tried last.
+func renderConf(mirrors []v1beta1.RegistryMirror) []byte {
+	var b strings.Builder
+	b.WriteString("# Managed by flightctl from the device's spec.registryMirrors. Do not edit.\n")
+	for _, registryMirror := range mirrors {
+		fmt.Fprintf(&b, "\n[[registry]]\nprefix = %s\nlocation = %s\n", strconv.Quote(registryMirror.Registry), strconv.Quote(registryMirror.Registry))
+		for _, mirror := range registryMirror.Mirrors {
+			fmt.Fprintf(&b, "\n[[registry.mirror]]\nlocation = %s\n", strconv.Quote(mirror.Location))
+			if lo.FromPtr(mirror.Insecure) {
+				b.WriteString("insecure = true\n")
+			}
+		}
+	}
+	return []byte(b.String())
+}
+
+func containsMirror(mirrors []v1beta1.RegistryMirror, key mirrorKey) bool {
+	return slices.ContainsFunc(mirrors, func(r v1beta1.RegistryMirror) bool {
+		return r.Registry == key.registry && slices.ContainsFunc(r.Mirrors, func(l v1beta1.RegistryMirrorLocation) bool {
+			return l.Location == key.location
+		})
+	})
+}
+
+func (m *manager) MirrorHosts(image string) []string {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil
+	}
+	name := named.Name()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var hosts []string
+	for _, registryMirror := range m.mirrors {
+		// like containers-registries.conf(5), the registry matches the image's repository
+		// or a namespace of it.
+		if name != registryMirror.Registry && !strings.HasPrefix(name, registryMirror.Registry+"/") {
+			continue
+		}
+		for _, mirror := range registryMirror.Mirrors {
+			host, _, _ := strings.Cut(mirror.Location, "/")
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+func (m *manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.changed:
+		}
+		m.probeAll(ctx)
+	}
+}
+
+func (m *manager) probeAll(ctx context.Context) {
+	m.mu.Lock()
+	mirrors := m.mirrors
+	m.mu.Unlock()
+
+	for _, registryMirror := range mirrors {
+		for _, mirror := range registryMirror.Mirrors {
+			if ctx.Err() != nil {
+				return
+			}
+			mirrorStatus := v1beta1.DeviceRegistryMirrorStatus{
+				Registry:    registryMirror.Registry,
+				Location:    mirror.Location,
+				Reachable:   true,
+				LastChecked: time.Now().UTC(),
+			}
+			if err := m.probeFn(ctx, mirror.Location, lo.FromPtr(mirror.Insecure)); err != nil {
+				m.log.Warnf("Registry mirror %s of %s is not reachable: %v", mirror.Location, registryMirror.Registry, err)
+				mirrorStatus.Reachable = false
+				mirrorStatus.Message = lo.ToPtr(log.Truncate(err.Error(), 256))
+			}
+
+			key := mirrorKey{registry: registryMirror.Registry, location: mirror.Location}
+			m.mu.Lock()
+			if containsMirror(m.mirrors, key) {
+				m.statuses[key] = mirrorStatus
+			}
+			m.mu.Unlock()
+		}
+	}
+}
+
+// probe checks that the mirror responds to the registry API. Any response, including
+// unauthorized, counts as reachable.
+func (m *manager) probe(ctx context.Context, location string, insecure bool) error {
+	host, _, _ := strings.Cut(location, "/")
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: insecure} //nolint:gosec
+	if !insecure {
+		pool, err := m.certPool(host)
+		if err != nil {
+			return err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	httpClient := &http.Client{
+		Timeout:   probeTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+	}
+	defer httpClient.CloseIdleConnections()
+
+	err := get(ctx, httpClient, "https://"+host+"/v2/")
+	if err != nil && insecure {
+		// insecure mirrors may only serve plain HTTP
+		err = get(ctx, httpClient, "http://"+host+"/v2/")
+	}
+	return err
+}
+
+// certPool returns the system CAs along with the CAs configured for the host in certs.d.
+func (m *manager) certPool(host string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	dir := filepath.Join(certsDir, host)
+	exists, err := m.readWriter.PathExists(dir)
+	if err != nil || !exists {
+		return pool, nil
+	}
+	entries, err := m.readWriter.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificates of %s: %w", host, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".crt" {
+			continue
+		}
+		pem, err := m.readWriter.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate of %s: %w", host, err)
+		}
+		pool.AppendCertsFromPEM(pem)
+	}
+	return pool, nil
+}
+
+func get(ctx context.Context, httpClient *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+func (m *manager) Status(_ context.Context, deviceStatus *v1beta1.DeviceStatus, _ ...status.CollectorOpt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.mirrors) == 0 {
+		deviceStatus.RegistryMirrors = nil
+		return nil
+	}
+	statuses := []v1beta1.DeviceRegistryMirrorStatus{}
+	for _, registryMirror := range m.mirrors {
+		for _, mirror := range registryMirror.Mirrors {
+			if s, ok := m.statuses[mirrorKey{registry: registryMirror.Registry, location: mirror.Location}]; ok {
+				statuses = append(statuses, s)
+			}
+		}
+	}
+	deviceStatus.RegistryMirrors = &statuses
+	return nil
+}
@@ -0,0 +1,162 @@
+package registries
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T) (*manager, fileio.ReadWriter) {
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	return NewManager(readWriter, log.NewPrefixLogger("test")).(*manager), readWriter
+}
+
+func TestSync(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m, readWriter := newTestManager(t)
+
+	spec := &v1beta1.DeviceSpec{RegistryMirrors: &[]v1beta1.RegistryMirror{
+		{
+			Registry: "quay.io",
+			Mirrors: []v1beta1.RegistryMirrorLocation{
+				{Location: "10.0.0.5:5000", Insecure: lo.ToPtr(true)},
+				{Location: "mirror.example.com/quay"},
+			},
+		},
+		{
+			Registry: "registry.redhat.io",
+			Mirrors:  []v1beta1.RegistryMirrorLocation{{Location: "10.0.0.5:5000"}},
+		},
+	}}
+	require.NoError(m.Sync(ctx, spec))
+
+	contents, err := readWriter.ReadFile(MirrorsConfPath)
+	require.NoError(err)
+	require.Equal(`# Managed by flightctl from the device's spec.registryMirrors. Do not edit.
+
+[[registry]]
+prefix = "quay.io"
+location = "quay.io"
+
+[[registry.mirror]]
+location = "10.0.0.5:5000"
+insecure = true
+
+[[registry.mirror]]
+location = "mirror.example.com/quay"
+
+[[registry]]
+prefix = "registry.redhat.io"
+location = "registry.redhat.io"
+
+[[registry.mirror]]
+location = "10.0.0.5:5000"
+`, string(contents))
+
+	// a modified drop-in is restored
+	require.NoError(readWriter.WriteFile(MirrorsConfPath, []byte("edited"), fileio.DefaultFilePermissions))
+	require.NoError(m.Sync(ctx, spec))
+	restored, err := readWriter.ReadFile(MirrorsConfPath)
+	require.NoError(err)
+	require.Equal(contents, restored)
+
+	// the drop-in is removed with the mirrors
+	require.NoError(m.Sync(ctx, &v1beta1.DeviceSpec{}))
+	exists, err := readWriter.PathExists(MirrorsConfPath)
+	require.NoError(err)
+	require.False(exists)
+}
+
+func TestMirrorHosts(t *testing.T) {
+	require := require.New(t)
+	m, _ := newTestManager(t)
+
+	spec := &v1beta1.DeviceSpec{RegistryMirrors: &[]v1beta1.RegistryMirror{
+		{
+			Registry: "quay.io/myorg",
+			Mirrors: []v1beta1.RegistryMirrorLocation{
+				{Location: "10.0.0.5:5000"},
+				{Location: "mirror.example.com/quay"},
+			},
+		},
+		{
+			Registry: "docker.io",
+			Mirrors:  []v1beta1.RegistryMirrorLocation{{Location: "mirror.example.com/hub"}},
+		},
+	}}
+	require.NoError(m.Sync(context.Background(), spec))
+
+	require.Equal([]string{"10.0.0.5:5000", "mirror.example.com"}, m.MirrorHosts("quay.io/myorg/app:v1"))
+	require.Empty(m.MirrorHosts("quay.io/myorganization/app:v1"))
+	require.Empty(m.MirrorHosts("quay.io/other/app:v1"))
+	require.Equal([]string{"mirror.example.com"}, m.MirrorHosts("nginx:latest"))
+	require.Empty(m.MirrorHosts("not a reference"))
+}
+
+func TestStatus(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m, _ := newTestManager(t)
+	m.probeFn = func(_ context.Context, location string, _ bool) error {
+		if strings.HasPrefix(location, "10.") {
+			return fmt.Errorf("connection refused")
+		}
+		return nil
+	}
+
+	spec := &v1beta1.DeviceSpec{RegistryMirrors: &[]v1beta1.RegistryMirror{{
+		Registry: "quay.io",
+		Mirrors:  []v1beta1.RegistryMirrorLocation{{Location: "10.0.0.5:5000"}, {Location: "mirror.example.com"}},
+	}}}
+	require.NoError(m.Sync(ctx, spec))
+
+	deviceStatus := v1beta1.NewDeviceStatus()
+	require.NoError(m.Status(ctx, &deviceStatus))
+	require.NotNil(deviceStatus.RegistryMirrors)
+	require.Empty(*deviceStatus.RegistryMirrors)
+
+	m.probeAll(ctx)
+	require.NoError(m.Status(ctx, &deviceStatus))
+	require.Len(*deviceStatus.RegistryMirrors, 2)
+	unreachable := (*deviceStatus.RegistryMirrors)[0]
+	require.Equal("10.0.0.5:5000", unreachable.Location)
+	require.False(unreachable.Reachable)
+	require.Equal("connection refused", lo.FromPtr(unreachable.Message))
+	require.True((*deviceStatus.RegistryMirrors)[1].Reachable)
+
+	require.NoError(m.Sync(ctx, &v1beta1.DeviceSpec{}))
+	require.NoError(m.Status(ctx, &deviceStatus))
+	require.Nil(deviceStatus.RegistryMirrors)
+	require.Empty(m.statuses)
+}
+
+func TestProbe(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m, _ := newTestManager(t)
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("/v2/", r.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer registry.Close()
+	location := strings.TrimPrefix(registry.URL, "http://")
+
+	// a plain HTTP mirror is only reachable if insecure
+	require.NoError(m.probe(ctx, location+"/quay", true))
+	require.Error(m.probe(ctx, location, false))
+}
@@ -0,0 +1,115 @@
+// Package remotesession runs the sessions of the device's remote-session annotation that the
+// agent serves itself, such as port forwards and file transfers.
+package remotesession
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+// cleanupDuration is how long finished sessions are remembered so that a session still listed
+// in a stale annotation is not started a second time.
+const cleanupDuration = 5 * time.Minute
+
+// Tracker serves each session of the remote-session annotation that a manager accepts once,
+// in a goroutine of its own.
+type Tracker struct {
+	log *log.PrefixLogger
+	// accepts returns true for the sessions the manager serves. Other entries belong to
+	// other session types.
+	accepts func(v1beta1.DeviceRemoteSession) bool
+	serve   func(context.Context, v1beta1.DeviceRemoteSession)
+
+	mu       sync.Mutex
+	active   map[string]struct{}
+	inactive map[string]time.Time
+	wg       sync.WaitGroup
+
+	// this is used for testing to override time.Now
+	nowFn func() time.Time
+}
+
+// NewTracker returns a Tracker that calls serve for each session accepted by accepts.
+func NewTracker(
+	log *log.PrefixLogger,
+	accepts func(v1beta1.DeviceRemoteSession) bool,
+	serve func(context.Context, v1beta1.DeviceRemoteSession),
+) *Tracker {
+	return &Tracker{
+		log:      log,
+		accepts:  accepts,
+		serve:    serve,
+		active:   make(map[string]struct{}),
+		inactive: make(map[string]time.Time),
+		nowFn:    time.Now,
+	}
+}
+
+// Sync starts serving each accepted session of the device's remote-session annotation that is
+// not already tracked.
+func (t *Tracker) Sync(ctx context.Context, device *v1beta1.Device) {
+	if device == nil || device.Metadata.Annotations == nil {
+		return
+	}
+	val, ok := (*device.Metadata.Annotations)[v1beta1.DeviceAnnotationRemoteSession]
+	if !ok || val == "" {
+		return
+	}
+
+	var sessions []v1beta1.DeviceRemoteSession
+	if err := json.Unmarshal([]byte(val), &sessions); err != nil {
+		t.log.Errorf("failed to parse remote session annotation: %v", err)
+		return
+	}
+
+	for _, entry := range sessions {
+		if entry.SessionID == "" || !t.accepts(entry) {
+			continue
+		}
+		if !t.add(entry.SessionID) {
+			continue
+		}
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+			defer t.inactivate(entry.SessionID)
+			t.serve(ctx, entry)
+		}()
+	}
+}
+
+// Wait blocks until all active sessions have finished.
+func (t *Tracker) Wait() {
+	t.wg.Wait()
+}
+
+func (t *Tracker) add(sessionID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.nowFn()
+	for id, ts := range t.inactive {
+		if ts.Add(cleanupDuration).Before(now) {
+			delete(t.inactive, id)
+		}
+	}
+	if _, exists := t.inactive[sessionID]; exists {
+		return false
+	}
+	if _, exists := t.active[sessionID]; exists {
+		return false
+	}
+	t.active[sessionID] = struct{}{}
+	return true
+}
+
+func (t *Tracker) inactivate(sessionID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.active, sessionID)
+	t.inactive[sessionID] = t.nowFn()
+}
@@ -0,0 +1,88 @@
+package remotesession
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func deviceWithSessions(t *testing.T, sessions ...v1beta1.DeviceRemoteSession) *v1beta1.Device {
+	t.Helper()
+	b, err := json.Marshal(sessions)
+	require.NoError(t, err)
+	return &v1beta1.Device{
+		Metadata: v1beta1.ObjectMeta{
+			Annotations: &map[string]string{v1beta1.DeviceAnnotationRemoteSession: string(b)},
+		},
+	}
+}
+
+func TestTrackerServesAcceptedSessionsOnce(t *testing.T) {
+	require := require.New(t)
+
+	var mu sync.Mutex
+	var served []string
+	tracker := NewTracker(log.NewPrefixLogger("test"),
+		func(s v1beta1.DeviceRemoteSession) bool { return s.Port != 0 },
+		func(_ context.Context, s v1beta1.DeviceRemoteSession) {
+			mu.Lock()
+			defer mu.Unlock()
+			served = append(served, s.SessionID)
+		},
+	)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.nowFn = func() time.Time { return now }
+
+	device := deviceWithSessions(t,
+		v1beta1.DeviceRemoteSession{SessionID: "pf", Port: 8080},
+		v1beta1.DeviceRemoteSession{SessionID: "console", AppName: "app"},
+		v1beta1.DeviceRemoteSession{Port: 8081},
+	)
+	tracker.Sync(context.Background(), device)
+	tracker.Wait()
+	require.Equal([]string{"pf"}, served)
+
+	// a finished session is not restarted from a stale annotation
+	tracker.Sync(context.Background(), device)
+	tracker.Wait()
+	require.Equal([]string{"pf"}, served)
+
+	// finished sessions are forgotten after the cleanup duration
+	now = now.Add(cleanupDuration + time.Second)
+	tracker.Sync(context.Background(), device)
+	tracker.Wait()
+	require.Equal([]string{"pf", "pf"}, served)
+}
+
+func TestTrackerSkipsActiveSessions(t *testing.T) {
+	require := require.New(t)
+	tracker := NewTracker(log.NewPrefixLogger("test"),
+		func(v1beta1.DeviceRemoteSession) bool { return true },
+		func(context.Context, v1beta1.DeviceRemoteSession) {},
+	)
+
+	require.True(tracker.add("s1"))
+	require.False(tracker.add("s1"))
+	tracker.inactivate("s1")
+	require.False(tracker.add("s1"))
+}
+
+func TestTrackerIgnoresInvalidAnnotation(t *testing.T) {
+	tracker := NewTracker(log.NewPrefixLogger("test"),
+		func(v1beta1.DeviceRemoteSession) bool { return true },
+		func(context.Context, v1beta1.DeviceRemoteSession) {
+			t.Fatal("no session may be served")
+		},
+	)
+	tracker.Sync(context.Background(), nil)
+	tracker.Sync(context.Background(), &v1beta1.Device{Metadata: v1beta1.ObjectMeta{
+		Annotations: &map[string]string{v1beta1.DeviceAnnotationRemoteSession: "not json"},
+	}})
+	tracker.Wait()
+}
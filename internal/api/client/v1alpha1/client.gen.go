@@ -180,6 +180,30 @@ type ClientInterface interface {
 
 	// GetVulnerabilitySummary request
 	GetVulnerabilitySummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVulnerabilityExceptions request
+	ListVulnerabilityExceptions(ctx context.Context, params *ListVulnerabilityExceptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVulnerabilityExceptionWithBody request with any body
+	CreateVulnerabilityExceptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVulnerabilityException(ctx context.Context, body CreateVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportVulnerabilityExceptionsWithBody request with any body
+	ImportVulnerabilityExceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportVulnerabilityExceptions(ctx context.Context, body ImportVulnerabilityExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteVulnerabilityException request
+	DeleteVulnerabilityException(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVulnerabilityException request
+	GetVulnerabilityException(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplaceVulnerabilityExceptionWithBody request with any body
+	ReplaceVulnerabilityExceptionWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplaceVulnerabilityException(ctx context.Context, name string, body ReplaceVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ListAllCatalogItems(ctx context.Context, params *ListAllCatalogItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -566,6 +590,114 @@ func (c *Client) GetVulnerabilitySummary(ctx context.Context, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListVulnerabilityExceptions(ctx context.Context, params *ListVulnerabilityExceptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVulnerabilityExceptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVulnerabilityExceptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVulnerabilityExceptionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVulnerabilityException(ctx context.Context, body CreateVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVulnerabilityExceptionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportVulnerabilityExceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportVulnerabilityExceptionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportVulnerabilityExceptions(ctx context.Context, body ImportVulnerabilityExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportVulnerabilityExceptionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteVulnerabilityException(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVulnerabilityExceptionRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVulnerabilityException(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVulnerabilityExceptionRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceVulnerabilityExceptionWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceVulnerabilityExceptionRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceVulnerabilityException(ctx context.Context, name string, body ReplaceVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceVulnerabilityExceptionRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewListAllCatalogItemsRequest generates requests for ListAllCatalogItems
 func NewListAllCatalogItemsRequest(server string, params *ListAllCatalogItemsParams) (*http.Request, error) {
 	var err error
@@ -1596,6 +1728,22 @@ func NewListVulnerabilitiesRequest(server string, params *ListVulnerabilitiesPar
 
 		}
 
+		if params.IncludeExcepted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeExcepted", runtime.ParamLocationQuery, *params.IncludeExcepted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -1716,6 +1864,22 @@ func NewGetVulnerabilityImpactRequest(server string, cveId string, params *GetVu
 
 		}
 
+		if params.IncludeExcepted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeExcepted", runtime.ParamLocationQuery, *params.IncludeExcepted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -1836,6 +2000,22 @@ func NewGetDeviceVulnerabilitiesRequest(server string, name string, params *GetD
 
 		}
 
+		if params.IncludeExcepted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeExcepted", runtime.ParamLocationQuery, *params.IncludeExcepted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -2012,6 +2192,22 @@ func NewGetFleetVulnerabilitiesRequest(server string, name string, params *GetFl
 
 		}
 
+		if params.IncludeExcepted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeExcepted", runtime.ParamLocationQuery, *params.IncludeExcepted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -2106,289 +2302,467 @@ func NewGetVulnerabilitySummaryRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewListVulnerabilityExceptionsRequest generates requests for ListVulnerabilityExceptions
+func NewListVulnerabilityExceptionsRequest(server string, params *ListVulnerabilityExceptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/vulnerabilityexceptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListAllCatalogItemsWithResponse request
-	ListAllCatalogItemsWithResponse(ctx context.Context, params *ListAllCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListAllCatalogItemsResponse, error)
+		if params.Continue != nil {
 
-	// ListCatalogsWithResponse request
-	ListCatalogsWithResponse(ctx context.Context, params *ListCatalogsParams, reqEditors ...RequestEditorFn) (*ListCatalogsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateCatalogWithBodyWithResponse request with any body
-	CreateCatalogWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error)
+		}
 
-	CreateCatalogWithResponse(ctx context.Context, body CreateCatalogJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error)
+		if params.LabelSelector != nil {
 
-	// ListCatalogItemsWithResponse request
-	ListCatalogItemsWithResponse(ctx context.Context, catalog string, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// CreateCatalogItemWithBodyWithResponse request with any body
-	CreateCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+		}
 
-	CreateCatalogItemWithResponse(ctx context.Context, catalog string, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+		if params.FieldSelector != nil {
 
-	// DeleteCatalogItemWithResponse request
-	DeleteCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fieldSelector", runtime.ParamLocationQuery, *params.FieldSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetCatalogItemWithResponse request
-	GetCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error)
+		}
 
-	// PatchCatalogItemWithBodyWithResponse request with any body
-	PatchCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error)
-
-	PatchCatalogItemWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, catalog string, name string, body PatchCatalogItemApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error)
+		if params.Limit != nil {
 
-	// ReplaceCatalogItemWithBodyWithResponse request with any body
-	ReplaceCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCatalogItemResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	ReplaceCatalogItemWithResponse(ctx context.Context, catalog string, name string, body ReplaceCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCatalogItemResponse, error)
+		}
 
-	// GetCatalogItemDeploymentsWithResponse request
-	GetCatalogItemDeploymentsWithResponse(ctx context.Context, catalog string, name string, params *GetCatalogItemDeploymentsParams, reqEditors ...RequestEditorFn) (*GetCatalogItemDeploymentsResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteCatalogWithResponse request
-	DeleteCatalogWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteCatalogResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCatalogWithResponse request
-	GetCatalogWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCatalogResponse, error)
+	return req, nil
+}
 
-	// PatchCatalogWithBodyWithResponse request with any body
-	PatchCatalogWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogResponse, error)
+// NewCreateVulnerabilityExceptionRequest calls the generic CreateVulnerabilityException builder with application/json body
+func NewCreateVulnerabilityExceptionRequest(server string, body CreateVulnerabilityExceptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVulnerabilityExceptionRequestWithBody(server, "application/json", bodyReader)
+}
 
-	PatchCatalogWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, body PatchCatalogApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogResponse, error)
+// NewCreateVulnerabilityExceptionRequestWithBody generates requests for CreateVulnerabilityException with any type of body
+func NewCreateVulnerabilityExceptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ReplaceCatalogWithBodyWithResponse request with any body
-	ReplaceCatalogWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCatalogResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ReplaceCatalogWithResponse(ctx context.Context, name string, body ReplaceCatalogJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCatalogResponse, error)
+	operationPath := fmt.Sprintf("/vulnerabilityexceptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetCatalogStatusWithResponse request
-	GetCatalogStatusWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCatalogStatusResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchCatalogStatusWithBodyWithResponse request with any body
-	PatchCatalogStatusWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogStatusResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	PatchCatalogStatusWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, body PatchCatalogStatusApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogStatusResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ReplaceCatalogStatusWithBodyWithResponse request with any body
-	ReplaceCatalogStatusWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCatalogStatusResponse, error)
+	return req, nil
+}
 
-	ReplaceCatalogStatusWithResponse(ctx context.Context, name string, body ReplaceCatalogStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCatalogStatusResponse, error)
+// NewImportVulnerabilityExceptionsRequest calls the generic ImportVulnerabilityExceptions builder with application/json body
+func NewImportVulnerabilityExceptionsRequest(server string, body ImportVulnerabilityExceptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportVulnerabilityExceptionsRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// ListVulnerabilitiesWithResponse request
-	ListVulnerabilitiesWithResponse(ctx context.Context, params *ListVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*ListVulnerabilitiesResponse, error)
+// NewImportVulnerabilityExceptionsRequestWithBody generates requests for ImportVulnerabilityExceptions with any type of body
+func NewImportVulnerabilityExceptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetVulnerabilityImpactWithResponse request
-	GetVulnerabilityImpactWithResponse(ctx context.Context, cveId string, params *GetVulnerabilityImpactParams, reqEditors ...RequestEditorFn) (*GetVulnerabilityImpactResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDeviceVulnerabilitiesWithResponse request
-	GetDeviceVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitiesResponse, error)
+	operationPath := fmt.Sprintf("/vulnerabilityexceptions/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetDeviceVulnerabilitySummaryWithResponse request
-	GetDeviceVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitySummaryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetFleetVulnerabilitiesWithResponse request
-	GetFleetVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitiesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetFleetVulnerabilitySummaryWithResponse request
-	GetFleetVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitySummaryResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetVulnerabilitySummaryWithResponse request
-	GetVulnerabilitySummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVulnerabilitySummaryResponse, error)
+	return req, nil
 }
 
-type ListAllCatalogItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItemList
-	JSON400      *Status
-	JSON401      *Status
-	JSON403      *Status
-	JSON429      *Status
-	JSON503      *Status
-}
+// NewDeleteVulnerabilityExceptionRequest generates requests for DeleteVulnerabilityException
+func NewDeleteVulnerabilityExceptionRequest(server string, name string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListAllCatalogItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListAllCatalogItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListCatalogsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogList
-	JSON400      *Status
-	JSON401      *Status
-	JSON403      *Status
-	JSON429      *Status
-	JSON503      *Status
-}
+	operationPath := fmt.Sprintf("/vulnerabilityexceptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListCatalogsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCatalogsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateCatalogResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Catalog
-	JSON400      *Status
-	JSON401      *Status
-	JSON403      *Status
-	JSON409      *Status
-	JSON429      *Status
-	JSON503      *Status
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCatalogResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetVulnerabilityExceptionRequest generates requests for GetVulnerabilityException
+func NewGetVulnerabilityExceptionRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCatalogResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListCatalogItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItemList
-	JSON400      *Status
-	JSON401      *Status
-	JSON403      *Status
-	JSON404      *Status
-	JSON429      *Status
-	JSON503      *Status
-}
+	operationPath := fmt.Sprintf("/vulnerabilityexceptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListCatalogItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCatalogItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateCatalogItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CatalogItem
-	JSON400      *Status
-	JSON401      *Status
-	JSON403      *Status
-	JSON404      *Status
-	JSON409      *Status
-	JSON503      *Status
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCatalogItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// NewReplaceVulnerabilityExceptionRequest calls the generic ReplaceVulnerabilityException builder with application/json body
+func NewReplaceVulnerabilityExceptionRequest(server string, name string, body ReplaceVulnerabilityExceptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceVulnerabilityExceptionRequestWithBody(server, name, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCatalogItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewReplaceVulnerabilityExceptionRequestWithBody generates requests for ReplaceVulnerabilityException with any type of body
+func NewReplaceVulnerabilityExceptionRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vulnerabilityexceptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type DeleteCatalogItemResponse struct {
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAllCatalogItemsWithResponse request
+	ListAllCatalogItemsWithResponse(ctx context.Context, params *ListAllCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListAllCatalogItemsResponse, error)
+
+	// ListCatalogsWithResponse request
+	ListCatalogsWithResponse(ctx context.Context, params *ListCatalogsParams, reqEditors ...RequestEditorFn) (*ListCatalogsResponse, error)
+
+	// CreateCatalogWithBodyWithResponse request with any body
+	CreateCatalogWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error)
+
+	CreateCatalogWithResponse(ctx context.Context, body CreateCatalogJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error)
+
+	// ListCatalogItemsWithResponse request
+	ListCatalogItemsWithResponse(ctx context.Context, catalog string, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error)
+
+	// CreateCatalogItemWithBodyWithResponse request with any body
+	CreateCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+
+	CreateCatalogItemWithResponse(ctx context.Context, catalog string, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+
+	// DeleteCatalogItemWithResponse request
+	DeleteCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error)
+
+	// GetCatalogItemWithResponse request
+	GetCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error)
+
+	// PatchCatalogItemWithBodyWithResponse request with any body
+	PatchCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error)
+
+	PatchCatalogItemWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, catalog string, name string, body PatchCatalogItemApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error)
+
+	// ReplaceCatalogItemWithBodyWithResponse request with any body
+	ReplaceCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCatalogItemResponse, error)
+
+	ReplaceCatalogItemWithResponse(ctx context.Context, catalog string, name string, body ReplaceCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCatalogItemResponse, error)
+
+	// GetCatalogItemDeploymentsWithResponse request
+	GetCatalogItemDeploymentsWithResponse(ctx context.Context, catalog string, name string, params *GetCatalogItemDeploymentsParams, reqEditors ...RequestEditorFn) (*GetCatalogItemDeploymentsResponse, error)
+
+	// DeleteCatalogWithResponse request
+	DeleteCatalogWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteCatalogResponse, error)
+
+	// GetCatalogWithResponse request
+	GetCatalogWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCatalogResponse, error)
+
+	// PatchCatalogWithBodyWithResponse request with any body
+	PatchCatalogWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogResponse, error)
+
+	PatchCatalogWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, body PatchCatalogApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogResponse, error)
+
+	// ReplaceCatalogWithBodyWithResponse request with any body
+	ReplaceCatalogWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCatalogResponse, error)
+
+	ReplaceCatalogWithResponse(ctx context.Context, name string, body ReplaceCatalogJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCatalogResponse, error)
+
+	// GetCatalogStatusWithResponse request
+	GetCatalogStatusWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCatalogStatusResponse, error)
+
+	// PatchCatalogStatusWithBodyWithResponse request with any body
+	PatchCatalogStatusWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogStatusResponse, error)
+
+	PatchCatalogStatusWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, name string, body PatchCatalogStatusApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogStatusResponse, error)
+
+	// ReplaceCatalogStatusWithBodyWithResponse request with any body
+	ReplaceCatalogStatusWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCatalogStatusResponse, error)
+
+	ReplaceCatalogStatusWithResponse(ctx context.Context, name string, body ReplaceCatalogStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCatalogStatusResponse, error)
+
+	// ListVulnerabilitiesWithResponse request
+	ListVulnerabilitiesWithResponse(ctx context.Context, params *ListVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*ListVulnerabilitiesResponse, error)
+
+	// GetVulnerabilityImpactWithResponse request
+	GetVulnerabilityImpactWithResponse(ctx context.Context, cveId string, params *GetVulnerabilityImpactParams, reqEditors ...RequestEditorFn) (*GetVulnerabilityImpactResponse, error)
+
+	// GetDeviceVulnerabilitiesWithResponse request
+	GetDeviceVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitiesResponse, error)
+
+	// GetDeviceVulnerabilitySummaryWithResponse request
+	GetDeviceVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitySummaryResponse, error)
+
+	// GetFleetVulnerabilitiesWithResponse request
+	GetFleetVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitiesResponse, error)
+
+	// GetFleetVulnerabilitySummaryWithResponse request
+	GetFleetVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitySummaryResponse, error)
+
+	// GetVulnerabilitySummaryWithResponse request
+	GetVulnerabilitySummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVulnerabilitySummaryResponse, error)
+
+	// ListVulnerabilityExceptionsWithResponse request
+	ListVulnerabilityExceptionsWithResponse(ctx context.Context, params *ListVulnerabilityExceptionsParams, reqEditors ...RequestEditorFn) (*ListVulnerabilityExceptionsResponse, error)
+
+	// CreateVulnerabilityExceptionWithBodyWithResponse request with any body
+	CreateVulnerabilityExceptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVulnerabilityExceptionResponse, error)
+
+	CreateVulnerabilityExceptionWithResponse(ctx context.Context, body CreateVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVulnerabilityExceptionResponse, error)
+
+	// ImportVulnerabilityExceptionsWithBodyWithResponse request with any body
+	ImportVulnerabilityExceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportVulnerabilityExceptionsResponse, error)
+
+	ImportVulnerabilityExceptionsWithResponse(ctx context.Context, body ImportVulnerabilityExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportVulnerabilityExceptionsResponse, error)
+
+	// DeleteVulnerabilityExceptionWithResponse request
+	DeleteVulnerabilityExceptionWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteVulnerabilityExceptionResponse, error)
+
+	// GetVulnerabilityExceptionWithResponse request
+	GetVulnerabilityExceptionWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetVulnerabilityExceptionResponse, error)
+
+	// ReplaceVulnerabilityExceptionWithBodyWithResponse request with any body
+	ReplaceVulnerabilityExceptionWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceVulnerabilityExceptionResponse, error)
+
+	ReplaceVulnerabilityExceptionWithResponse(ctx context.Context, name string, body ReplaceVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceVulnerabilityExceptionResponse, error)
+}
+
+type ListAllCatalogItemsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Status
+	JSON200      *CatalogItemList
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
-	JSON404      *Status
+	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteCatalogItemResponse) Status() string {
+func (r ListAllCatalogItemsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2396,26 +2770,26 @@ func (r DeleteCatalogItemResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCatalogItemResponse) StatusCode() int {
+func (r ListAllCatalogItemsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetCatalogItemResponse struct {
+type ListCatalogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CatalogItem
+	JSON200      *CatalogList
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
-	JSON404      *Status
+	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r GetCatalogItemResponse) Status() string {
+func (r ListCatalogsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2423,27 +2797,27 @@ func (r GetCatalogItemResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogItemResponse) StatusCode() int {
+func (r ListCatalogsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchCatalogItemResponse struct {
+type CreateCatalogResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CatalogItem
+	JSON201      *Catalog
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
-	JSON404      *Status
 	JSON409      *Status
+	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchCatalogItemResponse) Status() string {
+func (r CreateCatalogResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2451,28 +2825,27 @@ func (r PatchCatalogItemResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchCatalogItemResponse) StatusCode() int {
+func (r CreateCatalogResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ReplaceCatalogItemResponse struct {
+type ListCatalogItemsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CatalogItem
-	JSON201      *CatalogItem
+	JSON200      *CatalogItemList
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
 	JSON404      *Status
-	JSON409      *Status
+	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r ReplaceCatalogItemResponse) Status() string {
+func (r ListCatalogItemsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2480,26 +2853,27 @@ func (r ReplaceCatalogItemResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ReplaceCatalogItemResponse) StatusCode() int {
+func (r ListCatalogItemsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetCatalogItemDeploymentsResponse struct {
+type CreateCatalogItemResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CatalogItemDeploymentList
+	JSON201      *CatalogItem
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
 	JSON404      *Status
+	JSON409      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r GetCatalogItemDeploymentsResponse) Status() string {
+func (r CreateCatalogItemResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2507,14 +2881,14 @@ func (r GetCatalogItemDeploymentsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogItemDeploymentsResponse) StatusCode() int {
+func (r CreateCatalogItemResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteCatalogResponse struct {
+type DeleteCatalogItemResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *Status
@@ -2522,13 +2896,11 @@ type DeleteCatalogResponse struct {
 	JSON401      *Status
 	JSON403      *Status
 	JSON404      *Status
-	JSON409      *Status
-	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteCatalogResponse) Status() string {
+func (r DeleteCatalogItemResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2536,27 +2908,26 @@ func (r DeleteCatalogResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCatalogResponse) StatusCode() int {
+func (r DeleteCatalogItemResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetCatalogResponse struct {
+type GetCatalogItemResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Catalog
+	JSON200      *CatalogItem
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
 	JSON404      *Status
-	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r GetCatalogResponse) Status() string {
+func (r GetCatalogItemResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2564,28 +2935,169 @@ func (r GetCatalogResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogResponse) StatusCode() int {
+func (r GetCatalogItemResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchCatalogResponse struct {
+type PatchCatalogItemResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Catalog
+	JSON200      *CatalogItem
 	JSON400      *Status
 	JSON401      *Status
 	JSON403      *Status
 	JSON404      *Status
 	JSON409      *Status
-	JSON429      *Status
 	JSON503      *Status
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchCatalogResponse) Status() string {
+func (r PatchCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON201      *CatalogItem
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON409      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogItemDeploymentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemDeploymentList
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogItemDeploymentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogItemDeploymentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Status
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON409      *Status
+	JSON429      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCatalogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCatalogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Catalog
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON429      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Catalog
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON409      *Status
+	JSON429      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchCatalogResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2909,92 +3421,264 @@ func (r GetVulnerabilitySummaryResponse) StatusCode() int {
 	return 0
 }
 
-// ListAllCatalogItemsWithResponse request returning *ListAllCatalogItemsResponse
-func (c *ClientWithResponses) ListAllCatalogItemsWithResponse(ctx context.Context, params *ListAllCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListAllCatalogItemsResponse, error) {
-	rsp, err := c.ListAllCatalogItems(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListAllCatalogItemsResponse(rsp)
-}
-
-// ListCatalogsWithResponse request returning *ListCatalogsResponse
-func (c *ClientWithResponses) ListCatalogsWithResponse(ctx context.Context, params *ListCatalogsParams, reqEditors ...RequestEditorFn) (*ListCatalogsResponse, error) {
-	rsp, err := c.ListCatalogs(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListCatalogsResponse(rsp)
+type ListVulnerabilityExceptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VulnerabilityExceptionList
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON429      *Status
+	JSON501      *Status
+	JSON503      *Status
 }
 
-// CreateCatalogWithBodyWithResponse request with arbitrary body returning *CreateCatalogResponse
-func (c *ClientWithResponses) CreateCatalogWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error) {
-	rsp, err := c.CreateCatalogWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListVulnerabilityExceptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseCreateCatalogResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) CreateCatalogWithResponse(ctx context.Context, body CreateCatalogJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error) {
-	rsp, err := c.CreateCatalog(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVulnerabilityExceptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseCreateCatalogResponse(rsp)
+	return 0
 }
 
-// ListCatalogItemsWithResponse request returning *ListCatalogItemsResponse
-func (c *ClientWithResponses) ListCatalogItemsWithResponse(ctx context.Context, catalog string, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error) {
-	rsp, err := c.ListCatalogItems(ctx, catalog, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListCatalogItemsResponse(rsp)
+type CreateVulnerabilityExceptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VulnerabilityException
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON409      *Status
+	JSON429      *Status
+	JSON501      *Status
+	JSON503      *Status
 }
 
-// CreateCatalogItemWithBodyWithResponse request with arbitrary body returning *CreateCatalogItemResponse
-func (c *ClientWithResponses) CreateCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
-	rsp, err := c.CreateCatalogItemWithBody(ctx, catalog, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r CreateVulnerabilityExceptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseCreateCatalogItemResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) CreateCatalogItemWithResponse(ctx context.Context, catalog string, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
-	rsp, err := c.CreateCatalogItem(ctx, catalog, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVulnerabilityExceptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseCreateCatalogItemResponse(rsp)
+	return 0
 }
 
-// DeleteCatalogItemWithResponse request returning *DeleteCatalogItemResponse
-func (c *ClientWithResponses) DeleteCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error) {
-	rsp, err := c.DeleteCatalogItem(ctx, catalog, name, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteCatalogItemResponse(rsp)
+type ImportVulnerabilityExceptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VulnerabilityExceptionList
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON429      *Status
+	JSON501      *Status
+	JSON503      *Status
 }
 
-// GetCatalogItemWithResponse request returning *GetCatalogItemResponse
-func (c *ClientWithResponses) GetCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error) {
-	rsp, err := c.GetCatalogItem(ctx, catalog, name, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ImportVulnerabilityExceptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetCatalogItemResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PatchCatalogItemWithBodyWithResponse request with arbitrary body returning *PatchCatalogItemResponse
-func (c *ClientWithResponses) PatchCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error) {
-	rsp, err := c.PatchCatalogItemWithBody(ctx, catalog, name, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportVulnerabilityExceptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePatchCatalogItemResponse(rsp)
+	return 0
+}
+
+type DeleteVulnerabilityExceptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON429      *Status
+	JSON501      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVulnerabilityExceptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVulnerabilityExceptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVulnerabilityExceptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VulnerabilityException
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON404      *Status
+	JSON429      *Status
+	JSON501      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVulnerabilityExceptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVulnerabilityExceptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceVulnerabilityExceptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VulnerabilityException
+	JSON201      *VulnerabilityException
+	JSON400      *Status
+	JSON401      *Status
+	JSON403      *Status
+	JSON409      *Status
+	JSON429      *Status
+	JSON501      *Status
+	JSON503      *Status
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceVulnerabilityExceptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceVulnerabilityExceptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAllCatalogItemsWithResponse request returning *ListAllCatalogItemsResponse
+func (c *ClientWithResponses) ListAllCatalogItemsWithResponse(ctx context.Context, params *ListAllCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListAllCatalogItemsResponse, error) {
+	rsp, err := c.ListAllCatalogItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAllCatalogItemsResponse(rsp)
+}
+
+// ListCatalogsWithResponse request returning *ListCatalogsResponse
+func (c *ClientWithResponses) ListCatalogsWithResponse(ctx context.Context, params *ListCatalogsParams, reqEditors ...RequestEditorFn) (*ListCatalogsResponse, error) {
+	rsp, err := c.ListCatalogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogsResponse(rsp)
+}
+
+// CreateCatalogWithBodyWithResponse request with arbitrary body returning *CreateCatalogResponse
+func (c *ClientWithResponses) CreateCatalogWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error) {
+	rsp, err := c.CreateCatalogWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCatalogWithResponse(ctx context.Context, body CreateCatalogJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogResponse, error) {
+	rsp, err := c.CreateCatalog(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogResponse(rsp)
+}
+
+// ListCatalogItemsWithResponse request returning *ListCatalogItemsResponse
+func (c *ClientWithResponses) ListCatalogItemsWithResponse(ctx context.Context, catalog string, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error) {
+	rsp, err := c.ListCatalogItems(ctx, catalog, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogItemsResponse(rsp)
+}
+
+// CreateCatalogItemWithBodyWithResponse request with arbitrary body returning *CreateCatalogItemResponse
+func (c *ClientWithResponses) CreateCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
+	rsp, err := c.CreateCatalogItemWithBody(ctx, catalog, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCatalogItemWithResponse(ctx context.Context, catalog string, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
+	rsp, err := c.CreateCatalogItem(ctx, catalog, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogItemResponse(rsp)
+}
+
+// DeleteCatalogItemWithResponse request returning *DeleteCatalogItemResponse
+func (c *ClientWithResponses) DeleteCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error) {
+	rsp, err := c.DeleteCatalogItem(ctx, catalog, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCatalogItemResponse(rsp)
+}
+
+// GetCatalogItemWithResponse request returning *GetCatalogItemResponse
+func (c *ClientWithResponses) GetCatalogItemWithResponse(ctx context.Context, catalog string, name string, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error) {
+	rsp, err := c.GetCatalogItem(ctx, catalog, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogItemResponse(rsp)
+}
+
+// PatchCatalogItemWithBodyWithResponse request with arbitrary body returning *PatchCatalogItemResponse
+func (c *ClientWithResponses) PatchCatalogItemWithBodyWithResponse(ctx context.Context, catalog string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error) {
+	rsp, err := c.PatchCatalogItemWithBody(ctx, catalog, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCatalogItemResponse(rsp)
 }
 
 func (c *ClientWithResponses) PatchCatalogItemWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, catalog string, name string, body PatchCatalogItemApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCatalogItemResponse, error) {
@@ -3135,76 +3819,541 @@ func (c *ClientWithResponses) ListVulnerabilitiesWithResponse(ctx context.Contex
 	return ParseListVulnerabilitiesResponse(rsp)
 }
 
-// GetVulnerabilityImpactWithResponse request returning *GetVulnerabilityImpactResponse
-func (c *ClientWithResponses) GetVulnerabilityImpactWithResponse(ctx context.Context, cveId string, params *GetVulnerabilityImpactParams, reqEditors ...RequestEditorFn) (*GetVulnerabilityImpactResponse, error) {
-	rsp, err := c.GetVulnerabilityImpact(ctx, cveId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetVulnerabilityImpactWithResponse request returning *GetVulnerabilityImpactResponse
+func (c *ClientWithResponses) GetVulnerabilityImpactWithResponse(ctx context.Context, cveId string, params *GetVulnerabilityImpactParams, reqEditors ...RequestEditorFn) (*GetVulnerabilityImpactResponse, error) {
+	rsp, err := c.GetVulnerabilityImpact(ctx, cveId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVulnerabilityImpactResponse(rsp)
+}
+
+// GetDeviceVulnerabilitiesWithResponse request returning *GetDeviceVulnerabilitiesResponse
+func (c *ClientWithResponses) GetDeviceVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitiesResponse, error) {
+	rsp, err := c.GetDeviceVulnerabilities(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceVulnerabilitiesResponse(rsp)
+}
+
+// GetDeviceVulnerabilitySummaryWithResponse request returning *GetDeviceVulnerabilitySummaryResponse
+func (c *ClientWithResponses) GetDeviceVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitySummaryResponse, error) {
+	rsp, err := c.GetDeviceVulnerabilitySummary(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceVulnerabilitySummaryResponse(rsp)
+}
+
+// GetFleetVulnerabilitiesWithResponse request returning *GetFleetVulnerabilitiesResponse
+func (c *ClientWithResponses) GetFleetVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitiesResponse, error) {
+	rsp, err := c.GetFleetVulnerabilities(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFleetVulnerabilitiesResponse(rsp)
+}
+
+// GetFleetVulnerabilitySummaryWithResponse request returning *GetFleetVulnerabilitySummaryResponse
+func (c *ClientWithResponses) GetFleetVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitySummaryResponse, error) {
+	rsp, err := c.GetFleetVulnerabilitySummary(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFleetVulnerabilitySummaryResponse(rsp)
+}
+
+// GetVulnerabilitySummaryWithResponse request returning *GetVulnerabilitySummaryResponse
+func (c *ClientWithResponses) GetVulnerabilitySummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVulnerabilitySummaryResponse, error) {
+	rsp, err := c.GetVulnerabilitySummary(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVulnerabilitySummaryResponse(rsp)
+}
+
+// ListVulnerabilityExceptionsWithResponse request returning *ListVulnerabilityExceptionsResponse
+func (c *ClientWithResponses) ListVulnerabilityExceptionsWithResponse(ctx context.Context, params *ListVulnerabilityExceptionsParams, reqEditors ...RequestEditorFn) (*ListVulnerabilityExceptionsResponse, error) {
+	rsp, err := c.ListVulnerabilityExceptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVulnerabilityExceptionsResponse(rsp)
+}
+
+// CreateVulnerabilityExceptionWithBodyWithResponse request with arbitrary body returning *CreateVulnerabilityExceptionResponse
+func (c *ClientWithResponses) CreateVulnerabilityExceptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVulnerabilityExceptionResponse, error) {
+	rsp, err := c.CreateVulnerabilityExceptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVulnerabilityExceptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVulnerabilityExceptionWithResponse(ctx context.Context, body CreateVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVulnerabilityExceptionResponse, error) {
+	rsp, err := c.CreateVulnerabilityException(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVulnerabilityExceptionResponse(rsp)
+}
+
+// ImportVulnerabilityExceptionsWithBodyWithResponse request with arbitrary body returning *ImportVulnerabilityExceptionsResponse
+func (c *ClientWithResponses) ImportVulnerabilityExceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportVulnerabilityExceptionsResponse, error) {
+	rsp, err := c.ImportVulnerabilityExceptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportVulnerabilityExceptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportVulnerabilityExceptionsWithResponse(ctx context.Context, body ImportVulnerabilityExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportVulnerabilityExceptionsResponse, error) {
+	rsp, err := c.ImportVulnerabilityExceptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportVulnerabilityExceptionsResponse(rsp)
+}
+
+// DeleteVulnerabilityExceptionWithResponse request returning *DeleteVulnerabilityExceptionResponse
+func (c *ClientWithResponses) DeleteVulnerabilityExceptionWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteVulnerabilityExceptionResponse, error) {
+	rsp, err := c.DeleteVulnerabilityException(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVulnerabilityExceptionResponse(rsp)
+}
+
+// GetVulnerabilityExceptionWithResponse request returning *GetVulnerabilityExceptionResponse
+func (c *ClientWithResponses) GetVulnerabilityExceptionWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetVulnerabilityExceptionResponse, error) {
+	rsp, err := c.GetVulnerabilityException(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVulnerabilityExceptionResponse(rsp)
+}
+
+// ReplaceVulnerabilityExceptionWithBodyWithResponse request with arbitrary body returning *ReplaceVulnerabilityExceptionResponse
+func (c *ClientWithResponses) ReplaceVulnerabilityExceptionWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceVulnerabilityExceptionResponse, error) {
+	rsp, err := c.ReplaceVulnerabilityExceptionWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceVulnerabilityExceptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplaceVulnerabilityExceptionWithResponse(ctx context.Context, name string, body ReplaceVulnerabilityExceptionJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceVulnerabilityExceptionResponse, error) {
+	rsp, err := c.ReplaceVulnerabilityException(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceVulnerabilityExceptionResponse(rsp)
+}
+
+// ParseListAllCatalogItemsResponse parses an HTTP response from a ListAllCatalogItemsWithResponse call
+func ParseListAllCatalogItemsResponse(rsp *http.Response) (*ListAllCatalogItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAllCatalogItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCatalogsResponse parses an HTTP response from a ListCatalogsWithResponse call
+func ParseListCatalogsResponse(rsp *http.Response) (*ListCatalogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCatalogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCatalogResponse parses an HTTP response from a CreateCatalogWithResponse call
+func ParseCreateCatalogResponse(rsp *http.Response) (*CreateCatalogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCatalogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Catalog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCatalogItemsResponse parses an HTTP response from a ListCatalogItemsWithResponse call
+func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCatalogItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCatalogItemResponse parses an HTTP response from a CreateCatalogItemWithResponse call
+func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCatalogItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CatalogItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return ParseGetVulnerabilityImpactResponse(rsp)
-}
 
-// GetDeviceVulnerabilitiesWithResponse request returning *GetDeviceVulnerabilitiesResponse
-func (c *ClientWithResponses) GetDeviceVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitiesResponse, error) {
-	rsp, err := c.GetDeviceVulnerabilities(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetDeviceVulnerabilitiesResponse(rsp)
+	return response, nil
 }
 
-// GetDeviceVulnerabilitySummaryWithResponse request returning *GetDeviceVulnerabilitySummaryResponse
-func (c *ClientWithResponses) GetDeviceVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetDeviceVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetDeviceVulnerabilitySummaryResponse, error) {
-	rsp, err := c.GetDeviceVulnerabilitySummary(ctx, name, params, reqEditors...)
+// ParseDeleteCatalogItemResponse parses an HTTP response from a DeleteCatalogItemWithResponse call
+func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDeviceVulnerabilitySummaryResponse(rsp)
-}
 
-// GetFleetVulnerabilitiesWithResponse request returning *GetFleetVulnerabilitiesResponse
-func (c *ClientWithResponses) GetFleetVulnerabilitiesWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitiesParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitiesResponse, error) {
-	rsp, err := c.GetFleetVulnerabilities(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteCatalogItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFleetVulnerabilitiesResponse(rsp)
-}
 
-// GetFleetVulnerabilitySummaryWithResponse request returning *GetFleetVulnerabilitySummaryResponse
-func (c *ClientWithResponses) GetFleetVulnerabilitySummaryWithResponse(ctx context.Context, name string, params *GetFleetVulnerabilitySummaryParams, reqEditors ...RequestEditorFn) (*GetFleetVulnerabilitySummaryResponse, error) {
-	rsp, err := c.GetFleetVulnerabilitySummary(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetFleetVulnerabilitySummaryResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-// GetVulnerabilitySummaryWithResponse request returning *GetVulnerabilitySummaryResponse
-func (c *ClientWithResponses) GetVulnerabilitySummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVulnerabilitySummaryResponse, error) {
-	rsp, err := c.GetVulnerabilitySummary(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetVulnerabilitySummaryResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListAllCatalogItemsResponse parses an HTTP response from a ListAllCatalogItemsWithResponse call
-func ParseListAllCatalogItemsResponse(rsp *http.Response) (*ListAllCatalogItemsResponse, error) {
+// ParseGetCatalogItemResponse parses an HTTP response from a GetCatalogItemWithResponse call
+func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListAllCatalogItemsResponse{
+	response := &GetCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItemList
+		var dest CatalogItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3231,12 +4380,12 @@ func ParseListAllCatalogItemsResponse(rsp *http.Response) (*ListAllCatalogItemsR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
@@ -3250,22 +4399,22 @@ func ParseListAllCatalogItemsResponse(rsp *http.Response) (*ListAllCatalogItemsR
 	return response, nil
 }
 
-// ParseListCatalogsResponse parses an HTTP response from a ListCatalogsWithResponse call
-func ParseListCatalogsResponse(rsp *http.Response) (*ListCatalogsResponse, error) {
+// ParsePatchCatalogItemResponse parses an HTTP response from a PatchCatalogItemWithResponse call
+func ParsePatchCatalogItemResponse(rsp *http.Response) (*PatchCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCatalogsResponse{
+	response := &PatchCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogList
+		var dest CatalogItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3292,12 +4441,19 @@ func ParseListCatalogsResponse(rsp *http.Response) (*ListCatalogsResponse, error
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
@@ -3311,22 +4467,29 @@ func ParseListCatalogsResponse(rsp *http.Response) (*ListCatalogsResponse, error
 	return response, nil
 }
 
-// ParseCreateCatalogResponse parses an HTTP response from a CreateCatalogWithResponse call
-func ParseCreateCatalogResponse(rsp *http.Response) (*CreateCatalogResponse, error) {
+// ParseReplaceCatalogItemResponse parses an HTTP response from a ReplaceCatalogItemWithResponse call
+func ParseReplaceCatalogItemResponse(rsp *http.Response) (*ReplaceCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCatalogResponse{
+	response := &ReplaceCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Catalog
+		var dest CatalogItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3353,19 +4516,19 @@ func ParseCreateCatalogResponse(rsp *http.Response) (*CreateCatalogResponse, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
@@ -3379,22 +4542,22 @@ func ParseCreateCatalogResponse(rsp *http.Response) (*CreateCatalogResponse, err
 	return response, nil
 }
 
-// ParseListCatalogItemsResponse parses an HTTP response from a ListCatalogItemsWithResponse call
-func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsResponse, error) {
+// ParseGetCatalogItemDeploymentsResponse parses an HTTP response from a GetCatalogItemDeploymentsWithResponse call
+func ParseGetCatalogItemDeploymentsResponse(rsp *http.Response) (*GetCatalogItemDeploymentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCatalogItemsResponse{
+	response := &GetCatalogItemDeploymentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItemList
+		var dest CatalogItemDeploymentList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3428,13 +4591,6 @@ func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsRespons
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3447,26 +4603,26 @@ func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsRespons
 	return response, nil
 }
 
-// ParseCreateCatalogItemResponse parses an HTTP response from a CreateCatalogItemWithResponse call
-func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemResponse, error) {
+// ParseDeleteCatalogResponse parses an HTTP response from a DeleteCatalogWithResponse call
+func ParseDeleteCatalogResponse(rsp *http.Response) (*DeleteCatalogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCatalogItemResponse{
+	response := &DeleteCatalogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CatalogItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
@@ -3503,6 +4659,13 @@ func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemRespo
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3515,22 +4678,22 @@ func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemRespo
 	return response, nil
 }
 
-// ParseDeleteCatalogItemResponse parses an HTTP response from a DeleteCatalogItemWithResponse call
-func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemResponse, error) {
+// ParseGetCatalogResponse parses an HTTP response from a GetCatalogWithResponse call
+func ParseGetCatalogResponse(rsp *http.Response) (*GetCatalogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCatalogItemResponse{
+	response := &GetCatalogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Status
+		var dest Catalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3564,6 +4727,13 @@ func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemRespo
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3576,22 +4746,22 @@ func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemRespo
 	return response, nil
 }
 
-// ParseGetCatalogItemResponse parses an HTTP response from a GetCatalogItemWithResponse call
-func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, error) {
+// ParsePatchCatalogResponse parses an HTTP response from a PatchCatalogWithResponse call
+func ParsePatchCatalogResponse(rsp *http.Response) (*PatchCatalogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogItemResponse{
+	response := &PatchCatalogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItem
+		var dest Catalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3625,6 +4795,20 @@ func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, e
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3637,27 +4821,34 @@ func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, e
 	return response, nil
 }
 
-// ParsePatchCatalogItemResponse parses an HTTP response from a PatchCatalogItemWithResponse call
-func ParsePatchCatalogItemResponse(rsp *http.Response) (*PatchCatalogItemResponse, error) {
+// ParseReplaceCatalogResponse parses an HTTP response from a ReplaceCatalogWithResponse call
+func ParseReplaceCatalogResponse(rsp *http.Response) (*ReplaceCatalogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchCatalogItemResponse{
+	response := &ReplaceCatalogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItem
+		var dest Catalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Catalog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3693,6 +4884,13 @@ func ParsePatchCatalogItemResponse(rsp *http.Response) (*PatchCatalogItemRespons
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3705,33 +4903,26 @@ func ParsePatchCatalogItemResponse(rsp *http.Response) (*PatchCatalogItemRespons
 	return response, nil
 }
 
-// ParseReplaceCatalogItemResponse parses an HTTP response from a ReplaceCatalogItemWithResponse call
-func ParseReplaceCatalogItemResponse(rsp *http.Response) (*ReplaceCatalogItemResponse, error) {
+// ParseGetCatalogStatusResponse parses an HTTP response from a GetCatalogStatusWithResponse call
+func ParseGetCatalogStatusResponse(rsp *http.Response) (*GetCatalogStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplaceCatalogItemResponse{
+	response := &GetCatalogStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItem
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CatalogItem
+		var dest Catalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
@@ -3761,12 +4952,12 @@ func ParseReplaceCatalogItemResponse(rsp *http.Response) (*ReplaceCatalogItemRes
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
@@ -3780,22 +4971,22 @@ func ParseReplaceCatalogItemResponse(rsp *http.Response) (*ReplaceCatalogItemRes
 	return response, nil
 }
 
-// ParseGetCatalogItemDeploymentsResponse parses an HTTP response from a GetCatalogItemDeploymentsWithResponse call
-func ParseGetCatalogItemDeploymentsResponse(rsp *http.Response) (*GetCatalogItemDeploymentsResponse, error) {
+// ParsePatchCatalogStatusResponse parses an HTTP response from a PatchCatalogStatusWithResponse call
+func ParsePatchCatalogStatusResponse(rsp *http.Response) (*PatchCatalogStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogItemDeploymentsResponse{
+	response := &PatchCatalogStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItemDeploymentList
+		var dest Catalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3829,6 +5020,13 @@ func ParseGetCatalogItemDeploymentsResponse(rsp *http.Response) (*GetCatalogItem
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3841,22 +5039,22 @@ func ParseGetCatalogItemDeploymentsResponse(rsp *http.Response) (*GetCatalogItem
 	return response, nil
 }
 
-// ParseDeleteCatalogResponse parses an HTTP response from a DeleteCatalogWithResponse call
-func ParseDeleteCatalogResponse(rsp *http.Response) (*DeleteCatalogResponse, error) {
+// ParseReplaceCatalogStatusResponse parses an HTTP response from a ReplaceCatalogStatusWithResponse call
+func ParseReplaceCatalogStatusResponse(rsp *http.Response) (*ReplaceCatalogStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCatalogResponse{
+	response := &ReplaceCatalogStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Status
+		var dest Catalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3916,22 +5114,22 @@ func ParseDeleteCatalogResponse(rsp *http.Response) (*DeleteCatalogResponse, err
 	return response, nil
 }
 
-// ParseGetCatalogResponse parses an HTTP response from a GetCatalogWithResponse call
-func ParseGetCatalogResponse(rsp *http.Response) (*GetCatalogResponse, error) {
+// ParseListVulnerabilitiesResponse parses an HTTP response from a ListVulnerabilitiesWithResponse call
+func ParseListVulnerabilitiesResponse(rsp *http.Response) (*ListVulnerabilitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogResponse{
+	response := &ListVulnerabilitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+		var dest VulnerabilityGroupList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3958,19 +5156,19 @@ func ParseGetCatalogResponse(rsp *http.Response) (*GetCatalogResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON501 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
@@ -3984,22 +5182,22 @@ func ParseGetCatalogResponse(rsp *http.Response) (*GetCatalogResponse, error) {
 	return response, nil
 }
 
-// ParsePatchCatalogResponse parses an HTTP response from a PatchCatalogWithResponse call
-func ParsePatchCatalogResponse(rsp *http.Response) (*PatchCatalogResponse, error) {
+// ParseGetVulnerabilityImpactResponse parses an HTTP response from a GetVulnerabilityImpactWithResponse call
+func ParseGetVulnerabilityImpactResponse(rsp *http.Response) (*GetVulnerabilityImpactResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchCatalogResponse{
+	response := &GetVulnerabilityImpactResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+		var dest VulnerabilityImpact
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4026,20 +5224,6 @@ func ParsePatchCatalogResponse(rsp *http.Response) (*PatchCatalogResponse, error
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4047,46 +5231,39 @@ func ParsePatchCatalogResponse(rsp *http.Response) (*PatchCatalogResponse, error
 		}
 		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReplaceCatalogResponse parses an HTTP response from a ReplaceCatalogWithResponse call
-func ParseReplaceCatalogResponse(rsp *http.Response) (*ReplaceCatalogResponse, error) {
+// ParseGetDeviceVulnerabilitiesResponse parses an HTTP response from a GetDeviceVulnerabilitiesWithResponse call
+func ParseGetDeviceVulnerabilitiesResponse(rsp *http.Response) (*GetDeviceVulnerabilitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplaceCatalogResponse{
+	response := &GetDeviceVulnerabilitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+		var dest VulnerabilityList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Catalog
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4115,13 +5292,6 @@ func ParseReplaceCatalogResponse(rsp *http.Response) (*ReplaceCatalogResponse, e
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4129,34 +5299,34 @@ func ParseReplaceCatalogResponse(rsp *http.Response) (*ReplaceCatalogResponse, e
 		}
 		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetCatalogStatusResponse parses an HTTP response from a GetCatalogStatusWithResponse call
-func ParseGetCatalogStatusResponse(rsp *http.Response) (*GetCatalogStatusResponse, error) {
+// ParseGetDeviceVulnerabilitySummaryResponse parses an HTTP response from a GetDeviceVulnerabilitySummaryWithResponse call
+func ParseGetDeviceVulnerabilitySummaryResponse(rsp *http.Response) (*GetDeviceVulnerabilitySummaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogStatusResponse{
+	response := &GetDeviceVulnerabilitySummaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+		var dest DeviceVulnerabilitySummaryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4197,34 +5367,34 @@ func ParseGetCatalogStatusResponse(rsp *http.Response) (*GetCatalogStatusRespons
 		}
 		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePatchCatalogStatusResponse parses an HTTP response from a PatchCatalogStatusWithResponse call
-func ParsePatchCatalogStatusResponse(rsp *http.Response) (*PatchCatalogStatusResponse, error) {
+// ParseGetFleetVulnerabilitiesResponse parses an HTTP response from a GetFleetVulnerabilitiesWithResponse call
+func ParseGetFleetVulnerabilitiesResponse(rsp *http.Response) (*GetFleetVulnerabilitiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchCatalogStatusResponse{
+	response := &GetFleetVulnerabilitiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+		var dest VulnerabilityGroupList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4265,34 +5435,34 @@ func ParsePatchCatalogStatusResponse(rsp *http.Response) (*PatchCatalogStatusRes
 		}
 		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReplaceCatalogStatusResponse parses an HTTP response from a ReplaceCatalogStatusWithResponse call
-func ParseReplaceCatalogStatusResponse(rsp *http.Response) (*ReplaceCatalogStatusResponse, error) {
+// ParseGetFleetVulnerabilitySummaryResponse parses an HTTP response from a GetFleetVulnerabilitySummaryWithResponse call
+func ParseGetFleetVulnerabilitySummaryResponse(rsp *http.Response) (*GetFleetVulnerabilitySummaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplaceCatalogStatusResponse{
+	response := &GetFleetVulnerabilitySummaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Catalog
+		var dest FleetVulnerabilitySummaryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4326,13 +5496,6 @@ func ParseReplaceCatalogStatusResponse(rsp *http.Response) (*ReplaceCatalogStatu
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4340,34 +5503,34 @@ func ParseReplaceCatalogStatusResponse(rsp *http.Response) (*ReplaceCatalogStatu
 		}
 		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListVulnerabilitiesResponse parses an HTTP response from a ListVulnerabilitiesWithResponse call
-func ParseListVulnerabilitiesResponse(rsp *http.Response) (*ListVulnerabilitiesResponse, error) {
+// ParseGetVulnerabilitySummaryResponse parses an HTTP response from a GetVulnerabilitySummaryWithResponse call
+func ParseGetVulnerabilitySummaryResponse(rsp *http.Response) (*GetVulnerabilitySummaryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListVulnerabilitiesResponse{
+	response := &GetVulnerabilitySummaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VulnerabilityGroupList
+		var dest VulnerabilitySummaryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4408,34 +5571,27 @@ func ParseListVulnerabilitiesResponse(rsp *http.Response) (*ListVulnerabilitiesR
 		}
 		response.JSON501 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
-		var dest Status
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON503 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetVulnerabilityImpactResponse parses an HTTP response from a GetVulnerabilityImpactWithResponse call
-func ParseGetVulnerabilityImpactResponse(rsp *http.Response) (*GetVulnerabilityImpactResponse, error) {
+// ParseListVulnerabilityExceptionsResponse parses an HTTP response from a ListVulnerabilityExceptionsWithResponse call
+func ParseListVulnerabilityExceptionsResponse(rsp *http.Response) (*ListVulnerabilityExceptionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVulnerabilityImpactResponse{
+	response := &ListVulnerabilityExceptionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VulnerabilityImpact
+		var dest VulnerabilityExceptionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4476,31 +5632,38 @@ func ParseGetVulnerabilityImpactResponse(rsp *http.Response) (*GetVulnerabilityI
 		}
 		response.JSON501 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetDeviceVulnerabilitiesResponse parses an HTTP response from a GetDeviceVulnerabilitiesWithResponse call
-func ParseGetDeviceVulnerabilitiesResponse(rsp *http.Response) (*GetDeviceVulnerabilitiesResponse, error) {
+// ParseCreateVulnerabilityExceptionResponse parses an HTTP response from a CreateVulnerabilityExceptionWithResponse call
+func ParseCreateVulnerabilityExceptionResponse(rsp *http.Response) (*CreateVulnerabilityExceptionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeviceVulnerabilitiesResponse{
+	response := &CreateVulnerabilityExceptionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VulnerabilityList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VulnerabilityException
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
@@ -4523,12 +5686,12 @@ func ParseGetDeviceVulnerabilitiesResponse(rsp *http.Response) (*GetDeviceVulner
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
@@ -4544,27 +5707,34 @@ func ParseGetDeviceVulnerabilitiesResponse(rsp *http.Response) (*GetDeviceVulner
 		}
 		response.JSON501 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetDeviceVulnerabilitySummaryResponse parses an HTTP response from a GetDeviceVulnerabilitySummaryWithResponse call
-func ParseGetDeviceVulnerabilitySummaryResponse(rsp *http.Response) (*GetDeviceVulnerabilitySummaryResponse, error) {
+// ParseImportVulnerabilityExceptionsResponse parses an HTTP response from a ImportVulnerabilityExceptionsWithResponse call
+func ParseImportVulnerabilityExceptionsResponse(rsp *http.Response) (*ImportVulnerabilityExceptionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeviceVulnerabilitySummaryResponse{
+	response := &ImportVulnerabilityExceptionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeviceVulnerabilitySummaryResponse
+		var dest VulnerabilityExceptionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4591,59 +5761,52 @@ func ParseGetDeviceVulnerabilitySummaryResponse(rsp *http.Response) (*GetDeviceV
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON501 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON501 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetFleetVulnerabilitiesResponse parses an HTTP response from a GetFleetVulnerabilitiesWithResponse call
-func ParseGetFleetVulnerabilitiesResponse(rsp *http.Response) (*GetFleetVulnerabilitiesResponse, error) {
+// ParseDeleteVulnerabilityExceptionResponse parses an HTTP response from a DeleteVulnerabilityExceptionWithResponse call
+func ParseDeleteVulnerabilityExceptionResponse(rsp *http.Response) (*DeleteVulnerabilityExceptionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFleetVulnerabilitiesResponse{
+	response := &DeleteVulnerabilityExceptionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VulnerabilityGroupList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Status
@@ -4680,27 +5843,34 @@ func ParseGetFleetVulnerabilitiesResponse(rsp *http.Response) (*GetFleetVulnerab
 		}
 		response.JSON501 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetFleetVulnerabilitySummaryResponse parses an HTTP response from a GetFleetVulnerabilitySummaryWithResponse call
-func ParseGetFleetVulnerabilitySummaryResponse(rsp *http.Response) (*GetFleetVulnerabilitySummaryResponse, error) {
+// ParseGetVulnerabilityExceptionResponse parses an HTTP response from a GetVulnerabilityExceptionWithResponse call
+func ParseGetVulnerabilityExceptionResponse(rsp *http.Response) (*GetVulnerabilityExceptionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFleetVulnerabilitySummaryResponse{
+	response := &GetVulnerabilityExceptionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FleetVulnerabilitySummaryResponse
+		var dest VulnerabilityException
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4748,32 +5918,46 @@ func ParseGetFleetVulnerabilitySummaryResponse(rsp *http.Response) (*GetFleetVul
 		}
 		response.JSON501 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetVulnerabilitySummaryResponse parses an HTTP response from a GetVulnerabilitySummaryWithResponse call
-func ParseGetVulnerabilitySummaryResponse(rsp *http.Response) (*GetVulnerabilitySummaryResponse, error) {
+// ParseReplaceVulnerabilityExceptionResponse parses an HTTP response from a ReplaceVulnerabilityExceptionWithResponse call
+func ParseReplaceVulnerabilityExceptionResponse(rsp *http.Response) (*ReplaceVulnerabilityExceptionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVulnerabilitySummaryResponse{
+	response := &ReplaceVulnerabilityExceptionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VulnerabilitySummaryResponse
+		var dest VulnerabilityException
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VulnerabilityException
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4795,6 +5979,13 @@ func ParseGetVulnerabilitySummaryResponse(rsp *http.Response) (*GetVulnerability
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
 		var dest Status
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4809,6 +6000,13 @@ func ParseGetVulnerabilitySummaryResponse(rsp *http.Response) (*GetVulnerability
 		}
 		response.JSON501 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
@@ -18,6 +18,12 @@ type VulnerabilityConverter interface {
 	FleetParamsToDomain(apiv1alpha1.GetFleetVulnerabilitiesParams) domain.GetFleetVulnerabilitiesParams
 	FleetSummaryParamsToDomain(apiv1alpha1.GetFleetVulnerabilitySummaryParams) domain.GetFleetVulnerabilitySummaryParams
 	ListParamsToDomain(apiv1alpha1.ListVulnerabilitiesParams) domain.ListVulnerabilitiesParams
+
+	ExceptionToDomain(apiv1alpha1.VulnerabilityException) domain.VulnerabilityException
+	ExceptionFromDomain(*domain.VulnerabilityException) *apiv1alpha1.VulnerabilityException
+	ExceptionListFromDomain(*domain.VulnerabilityExceptionList) *apiv1alpha1.VulnerabilityExceptionList
+	ExceptionListParamsToDomain(apiv1alpha1.ListVulnerabilityExceptionsParams) domain.ListVulnerabilityExceptionsParams
+	OpenVEXDocumentToDomain(apiv1alpha1.OpenVEXDocument) domain.OpenVEXDocument
 }
 
 type vulnerabilityConverter struct{}
@@ -70,3 +76,23 @@ func (c *vulnerabilityConverter) FleetSummaryParamsToDomain(p apiv1alpha1.GetFle
 func (c *vulnerabilityConverter) ListParamsToDomain(p apiv1alpha1.ListVulnerabilitiesParams) domain.ListVulnerabilitiesParams {
 	return p
 }
+
+func (c *vulnerabilityConverter) ExceptionToDomain(e apiv1alpha1.VulnerabilityException) domain.VulnerabilityException {
+	return e
+}
+
+func (c *vulnerabilityConverter) ExceptionFromDomain(e *domain.VulnerabilityException) *apiv1alpha1.VulnerabilityException {
+	return e
+}
+
+func (c *vulnerabilityConverter) ExceptionListFromDomain(l *domain.VulnerabilityExceptionList) *apiv1alpha1.VulnerabilityExceptionList {
+	return l
+}
+
+func (c *vulnerabilityConverter) ExceptionListParamsToDomain(p apiv1alpha1.ListVulnerabilityExceptionsParams) domain.ListVulnerabilityExceptionsParams {
+	return p
+}
+
+func (c *vulnerabilityConverter) OpenVEXDocumentToDomain(d apiv1alpha1.OpenVEXDocument) domain.OpenVEXDocument {
+	return d
+}
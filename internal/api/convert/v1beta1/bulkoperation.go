@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// BulkOperationConverter converts between v1beta1 API types and domain types for BulkOperation resources.
+type BulkOperationConverter interface {
+	ToDomain(apiv1beta1.BulkOperation) domain.BulkOperation
+	FromDomain(*domain.BulkOperation) *apiv1beta1.BulkOperation
+	ListFromDomain(*domain.BulkOperationList) *apiv1beta1.BulkOperationList
+
+	// Params conversions
+	ListParamsToDomain(apiv1beta1.ListBulkOperationsParams) domain.ListBulkOperationsParams
+}
+
+type bulkOperationConverter struct{}
+
+// NewBulkOperationConverter creates a new BulkOperationConverter.
+func NewBulkOperationConverter() BulkOperationConverter {
+	return &bulkOperationConverter{}
+}
+
+func (c *bulkOperationConverter) ToDomain(b apiv1beta1.BulkOperation) domain.BulkOperation {
+	return b
+}
+
+func (c *bulkOperationConverter) FromDomain(b *domain.BulkOperation) *apiv1beta1.BulkOperation {
+	return b
+}
+
+func (c *bulkOperationConverter) ListFromDomain(l *domain.BulkOperationList) *apiv1beta1.BulkOperationList {
+	return l
+}
+
+func (c *bulkOperationConverter) ListParamsToDomain(p apiv1beta1.ListBulkOperationsParams) domain.ListBulkOperationsParams {
+	return p
+}
@@ -9,6 +9,8 @@ type Converter interface {
 	CertificateSigningRequest() CertificateSigningRequestConverter
 	AuthProvider() AuthProviderConverter
 	ResourceSync() ResourceSyncConverter
+	Secret() SecretConverter
+	BulkOperation() BulkOperationConverter
 	TemplateVersion() TemplateVersionConverter
 	Event() EventConverter
 	Organization() OrganizationConverter
@@ -24,6 +26,8 @@ type converterImpl struct {
 	certificateSigningRequest CertificateSigningRequestConverter
 	authProvider              AuthProviderConverter
 	resourceSync              ResourceSyncConverter
+	secret                    SecretConverter
+	bulkOperation             BulkOperationConverter
 	templateVersion           TemplateVersionConverter
 	event                     EventConverter
 	organization              OrganizationConverter
@@ -41,6 +45,8 @@ func NewConverter() Converter {
 		certificateSigningRequest: NewCertificateSigningRequestConverter(),
 		authProvider:              NewAuthProviderConverter(),
 		resourceSync:              NewResourceSyncConverter(),
+		secret:                    NewSecretConverter(),
+		bulkOperation:             NewBulkOperationConverter(),
 		templateVersion:           NewTemplateVersionConverter(),
 		event:                     NewEventConverter(),
 		organization:              NewOrganizationConverter(),
@@ -77,6 +83,14 @@ func (c *converterImpl) ResourceSync() ResourceSyncConverter {
 	return c.resourceSync
 }
 
+func (c *converterImpl) Secret() SecretConverter {
+	return c.secret
+}
+
+func (c *converterImpl) BulkOperation() BulkOperationConverter {
+	return c.bulkOperation
+}
+
 func (c *converterImpl) TemplateVersion() TemplateVersionConverter {
 	return c.templateVersion
 }
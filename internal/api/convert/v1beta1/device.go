@@ -17,10 +17,18 @@ type DeviceConverter interface {
 	ResumeRequestToDomain(apiv1beta1.DeviceResumeRequest) domain.DeviceResumeRequest
 	ResumeResponseFromDomain(domain.DeviceResumeResponse) apiv1beta1.DeviceResumeResponse
 	LastSeenFromDomain(*domain.DeviceLastSeen) *apiv1beta1.DeviceLastSeen
+	StatusHistoryFromDomain(*domain.DeviceStatusHistoryList) *apiv1beta1.DeviceStatusHistoryList
+	RollbackToDomain(apiv1beta1.DeviceRollback) domain.DeviceRollback
+	RenderedVersionFromDomain(*domain.DeviceRenderedVersion) *apiv1beta1.DeviceRenderedVersion
+	RenderedVersionListFromDomain(*domain.DeviceRenderedVersionList) *apiv1beta1.DeviceRenderedVersionList
+	RenderedVersionDiffFromDomain(*domain.DeviceRenderedVersionDiff) *apiv1beta1.DeviceRenderedVersionDiff
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListDevicesParams) domain.ListDevicesParams
 	GetRenderedParamsToDomain(apiv1beta1.GetRenderedDeviceParams) domain.GetRenderedDeviceParams
+	StatusHistoryParamsToDomain(apiv1beta1.GetDeviceStatusHistoryParams) domain.GetDeviceStatusHistoryParams
+	ListRenderedVersionsParamsToDomain(apiv1beta1.ListDeviceRenderedVersionsParams) domain.ListDeviceRenderedVersionsParams
+	RenderedVersionDiffParamsToDomain(apiv1beta1.GetDeviceRenderedVersionDiffParams) domain.GetDeviceRenderedVersionDiffParams
 }
 
 type deviceConverter struct{}
@@ -58,6 +66,26 @@ func (c *deviceConverter) LastSeenFromDomain(l *domain.DeviceLastSeen) *apiv1bet
 	return l
 }
 
+func (c *deviceConverter) StatusHistoryFromDomain(l *domain.DeviceStatusHistoryList) *apiv1beta1.DeviceStatusHistoryList {
+	return l
+}
+
+func (c *deviceConverter) RollbackToDomain(r apiv1beta1.DeviceRollback) domain.DeviceRollback {
+	return r
+}
+
+func (c *deviceConverter) RenderedVersionFromDomain(v *domain.DeviceRenderedVersion) *apiv1beta1.DeviceRenderedVersion {
+	return v
+}
+
+func (c *deviceConverter) RenderedVersionListFromDomain(l *domain.DeviceRenderedVersionList) *apiv1beta1.DeviceRenderedVersionList {
+	return l
+}
+
+func (c *deviceConverter) RenderedVersionDiffFromDomain(d *domain.DeviceRenderedVersionDiff) *apiv1beta1.DeviceRenderedVersionDiff {
+	return d
+}
+
 func (c *deviceConverter) ListParamsToDomain(p apiv1beta1.ListDevicesParams) domain.ListDevicesParams {
 	return p
 }
@@ -65,3 +93,15 @@ func (c *deviceConverter) ListParamsToDomain(p apiv1beta1.ListDevicesParams) dom
 func (c *deviceConverter) GetRenderedParamsToDomain(p apiv1beta1.GetRenderedDeviceParams) domain.GetRenderedDeviceParams {
 	return p
 }
+
+func (c *deviceConverter) StatusHistoryParamsToDomain(p apiv1beta1.GetDeviceStatusHistoryParams) domain.GetDeviceStatusHistoryParams {
+	return p
+}
+
+func (c *deviceConverter) ListRenderedVersionsParamsToDomain(p apiv1beta1.ListDeviceRenderedVersionsParams) domain.ListDeviceRenderedVersionsParams {
+	return p
+}
+
+func (c *deviceConverter) RenderedVersionDiffParamsToDomain(p apiv1beta1.GetDeviceRenderedVersionDiffParams) domain.GetDeviceRenderedVersionDiffParams {
+	return p
+}
@@ -9,6 +9,7 @@ import (
 )
 const (
 	API_RESOURCE_AUTHPROVIDERS = "authproviders"
+	API_RESOURCE_BULKOPERATIONS = "bulkoperations"
 	API_RESOURCE_CATALOGITEMS = "catalogitems"
 	API_RESOURCE_CATALOGS = "catalogs"
 	API_RESOURCE_CATALOGS_DEPLOYMENTS = "catalogs/deployments"
@@ -20,9 +21,14 @@ const (
 	API_RESOURCE_DEVICES_APPLICATIONS_LIFECYCLE = "devices/applications/lifecycle"
 	API_RESOURCE_DEVICES_CONSOLE = "devices/console"
 	API_RESOURCE_DEVICES_DECOMMISSION = "devices/decommission"
+	API_RESOURCE_DEVICES_FILES = "devices/files"
+	API_RESOURCE_DEVICES_HISTORY = "devices/history"
 	API_RESOURCE_DEVICES_LASTSEEN = "devices/lastseen"
+	API_RESOURCE_DEVICES_PORTFORWARD = "devices/portforward"
 	API_RESOURCE_DEVICES_RENDERED = "devices/rendered"
+	API_RESOURCE_DEVICES_RENDEREDVERSIONS = "devices/renderedversions"
 	API_RESOURCE_DEVICES_RESUME = "devices/resume"
+	API_RESOURCE_DEVICES_ROLLBACK = "devices/rollback"
 	API_RESOURCE_DEVICES_STATUS = "devices/status"
 	API_RESOURCE_ENROLLMENTCONFIG = "enrollmentconfig"
 	API_RESOURCE_ENROLLMENTREQUESTS = "enrollmentrequests"
@@ -39,8 +45,10 @@ const (
 	API_RESOURCE_REPOSITORIES_CHECK_OCI_IMAGE = "repositories/check-oci-image"
 	API_RESOURCE_REPOSITORIES_CHECK_OCI_TAG = "repositories/check-oci-tag"
 	API_RESOURCE_RESOURCESYNCS = "resourcesyncs"
+	API_RESOURCE_SECRETS = "secrets"
 	API_RESOURCE_VERSION = "version"
 	API_RESOURCE_VULNERABILITIES = "vulnerabilities"
+	API_RESOURCE_VULNERABILITYEXCEPTIONS = "vulnerabilityexceptions"
 )
 const (
 	API_ACTION_CREATE = "create"
@@ -152,6 +160,38 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/bulkoperations": {
+		OperationID: "listBulkOperations",
+		Resource:    "bulkoperations",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/bulkoperations": {
+		OperationID: "createBulkOperation",
+		Resource:    "bulkoperations",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/bulkoperations/{name}": {
+		OperationID: "deleteBulkOperation",
+		Resource:    "bulkoperations",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/bulkoperations/{name}": {
+		OperationID: "getBulkOperation",
+		Resource:    "bulkoperations",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/catalogitems": {
 		OperationID: "listAllCatalogItems",
 		Resource:    "catalogitems",
@@ -432,6 +472,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/devices/{name}/history": {
+		OperationID: "getDeviceStatusHistory",
+		Resource:    "devices/history",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/devices/{name}/lastseen": {
 		OperationID: "getDeviceLastSeen",
 		Resource:    "devices/lastseen",
@@ -448,6 +496,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/devices/{name}/renderedversions": {
+		OperationID: "listDeviceRenderedVersions",
+		Resource:    "devices/renderedversions",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/devices/{name}/renderedversions/{version}": {
+		OperationID: "getDeviceRenderedVersion",
+		Resource:    "devices/renderedversions",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/devices/{name}/renderedversions/{version}/diff": {
+		OperationID: "getDeviceRenderedVersionDiff",
+		Resource:    "devices/renderedversions",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/devices/{name}/rollback": {
+		OperationID: "clearDeviceRollback",
+		Resource:    "devices/rollback",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/devices/{name}/rollback": {
+		OperationID: "rollbackDevice",
+		Resource:    "devices/rollback",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/devices/{name}/status": {
 		OperationID: "getDeviceStatus",
 		Resource:    "devices/status",
@@ -808,6 +896,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/secrets": {
+		OperationID: "listSecrets",
+		Resource:    "secrets",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/secrets": {
+		OperationID: "createSecret",
+		Resource:    "secrets",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/secrets/{name}": {
+		OperationID: "deleteSecret",
+		Resource:    "secrets",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/secrets/{name}": {
+		OperationID: "getSecret",
+		Resource:    "secrets",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/secrets/{name}": {
+		OperationID: "replaceSecret",
+		Resource:    "secrets",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/version": {
 		OperationID: "getVersion",
 		Resource:    "version",
@@ -872,6 +1000,54 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1alpha1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/vulnerabilityexceptions": {
+		OperationID: "listVulnerabilityExceptions",
+		Resource:    "vulnerabilityexceptions",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/vulnerabilityexceptions": {
+		OperationID: "createVulnerabilityException",
+		Resource:    "vulnerabilityexceptions",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/vulnerabilityexceptions/import": {
+		OperationID: "importVulnerabilityExceptions",
+		Resource:    "vulnerabilityexceptions",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/vulnerabilityexceptions/{name}": {
+		OperationID: "deleteVulnerabilityException",
+		Resource:    "vulnerabilityexceptions",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/vulnerabilityexceptions/{name}": {
+		OperationID: "getVulnerabilityException",
+		Resource:    "vulnerabilityexceptions",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/vulnerabilityexceptions/{name}": {
+		OperationID: "replaceVulnerabilityException",
+		Resource:    "vulnerabilityexceptions",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/ws/v1/devices/{name}/applications/{appname}/console": {
 		OperationID: "getDeviceApplicationConsole",
 		Resource:    "devices/applications/console",
@@ -888,6 +1064,22 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/ws/v1/devices/{name}/files": {
+		OperationID: "getDeviceFileTransfer",
+		Resource:    "devices/files",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/ws/v1/devices/{name}/portforward": {
+		OperationID: "getDevicePortForward",
+		Resource:    "devices/portforward",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 }
 
 // MetadataResolver provides lookup for endpoint metadata.
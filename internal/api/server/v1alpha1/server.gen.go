@@ -89,6 +89,24 @@ type ServerInterface interface {
 
 	// (GET /vulnerabilities/summary)
 	GetVulnerabilitySummary(w http.ResponseWriter, r *http.Request)
+
+	// (GET /vulnerabilityexceptions)
+	ListVulnerabilityExceptions(w http.ResponseWriter, r *http.Request, params ListVulnerabilityExceptionsParams)
+
+	// (POST /vulnerabilityexceptions)
+	CreateVulnerabilityException(w http.ResponseWriter, r *http.Request)
+
+	// (POST /vulnerabilityexceptions/import)
+	ImportVulnerabilityExceptions(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /vulnerabilityexceptions/{name})
+	DeleteVulnerabilityException(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /vulnerabilityexceptions/{name})
+	GetVulnerabilityException(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /vulnerabilityexceptions/{name})
+	ReplaceVulnerabilityException(w http.ResponseWriter, r *http.Request, name string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -215,6 +233,36 @@ func (_ Unimplemented) GetVulnerabilitySummary(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /vulnerabilityexceptions)
+func (_ Unimplemented) ListVulnerabilityExceptions(w http.ResponseWriter, r *http.Request, params ListVulnerabilityExceptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /vulnerabilityexceptions)
+func (_ Unimplemented) CreateVulnerabilityException(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /vulnerabilityexceptions/import)
+func (_ Unimplemented) ImportVulnerabilityExceptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /vulnerabilityexceptions/{name})
+func (_ Unimplemented) DeleteVulnerabilityException(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /vulnerabilityexceptions/{name})
+func (_ Unimplemented) GetVulnerabilityException(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /vulnerabilityexceptions/{name})
+func (_ Unimplemented) ReplaceVulnerabilityException(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -829,6 +877,14 @@ func (siw *ServerInterfaceWrapper) ListVulnerabilities(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Optional query parameter "includeExcepted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeExcepted", r.URL.Query(), &params.IncludeExcepted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeExcepted", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListVulnerabilities(w, r, params)
 	}))
@@ -897,6 +953,14 @@ func (siw *ServerInterfaceWrapper) GetVulnerabilityImpact(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Optional query parameter "includeExcepted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeExcepted", r.URL.Query(), &params.IncludeExcepted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeExcepted", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetVulnerabilityImpact(w, r, cveId, params)
 	}))
@@ -965,6 +1029,14 @@ func (siw *ServerInterfaceWrapper) GetDeviceVulnerabilities(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Optional query parameter "includeExcepted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeExcepted", r.URL.Query(), &params.IncludeExcepted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeExcepted", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetDeviceVulnerabilities(w, r, name, params)
 	}))
@@ -1069,6 +1141,14 @@ func (siw *ServerInterfaceWrapper) GetFleetVulnerabilities(w http.ResponseWriter
 		return
 	}
 
+	// ------------- Optional query parameter "includeExcepted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeExcepted", r.URL.Query(), &params.IncludeExcepted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeExcepted", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetFleetVulnerabilities(w, r, name, params)
 	}))
@@ -1130,6 +1210,160 @@ func (siw *ServerInterfaceWrapper) GetVulnerabilitySummary(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
+// ListVulnerabilityExceptions operation middleware
+func (siw *ServerInterfaceWrapper) ListVulnerabilityExceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVulnerabilityExceptionsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVulnerabilityExceptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateVulnerabilityException operation middleware
+func (siw *ServerInterfaceWrapper) CreateVulnerabilityException(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVulnerabilityException(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportVulnerabilityExceptions operation middleware
+func (siw *ServerInterfaceWrapper) ImportVulnerabilityExceptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportVulnerabilityExceptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVulnerabilityException operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVulnerabilityException(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVulnerabilityException(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVulnerabilityException operation middleware
+func (siw *ServerInterfaceWrapper) GetVulnerabilityException(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVulnerabilityException(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceVulnerabilityException operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceVulnerabilityException(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceVulnerabilityException(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1315,6 +1549,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/vulnerabilities/summary", wrapper.GetVulnerabilitySummary)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vulnerabilityexceptions", wrapper.ListVulnerabilityExceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vulnerabilityexceptions", wrapper.CreateVulnerabilityException)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vulnerabilityexceptions/import", wrapper.ImportVulnerabilityExceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/vulnerabilityexceptions/{name}", wrapper.DeleteVulnerabilityException)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vulnerabilityexceptions/{name}", wrapper.GetVulnerabilityException)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/vulnerabilityexceptions/{name}", wrapper.ReplaceVulnerabilityException)
+	})
 
 	return r
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/flightctl/flightctl/internal/rendered"
 	"github.com/flightctl/flightctl/internal/service"
 	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
+	bulkoperationservice "github.com/flightctl/flightctl/internal/service/bulkoperation"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
 	certificatesigningrequestservice "github.com/flightctl/flightctl/internal/service/certificatesigningrequest"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
@@ -37,10 +38,12 @@ import (
 	organizationservice "github.com/flightctl/flightctl/internal/service/organization"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
 	resourcesyncservice "github.com/flightctl/flightctl/internal/service/resourcesync"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
 	templateversionservice "github.com/flightctl/flightctl/internal/service/templateversion"
 	vulnerabilityfindingservice "github.com/flightctl/flightctl/internal/service/vulnerabilityfinding"
 	"github.com/flightctl/flightctl/internal/store"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
+	bulkoperationstore "github.com/flightctl/flightctl/internal/store/bulkoperation"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
 	certificatesigningrequeststore "github.com/flightctl/flightctl/internal/store/certificatesigningrequest"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
@@ -50,7 +53,9 @@ import (
 	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
 	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
 	resourcesyncstore "github.com/flightctl/flightctl/internal/store/resourcesync"
+	secretstore "github.com/flightctl/flightctl/internal/store/secret"
 	templateversionstore "github.com/flightctl/flightctl/internal/store/templateversion"
+	vulnerabilityexceptionstore "github.com/flightctl/flightctl/internal/store/vulnerabilityexception"
 	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
 	transportv1alpha1 "github.com/flightctl/flightctl/internal/transport/v1alpha1"
 	transportv1beta1 "github.com/flightctl/flightctl/internal/transport/v1beta1"
@@ -197,6 +202,9 @@ func (s *Server) Run(ctx context.Context) error {
 	organizationStore := organizationstore.NewOrganizationStore(s.db)
 	authProviderStore := authproviderstore.NewAuthProviderStore(s.db, s.log.WithField("pkg", "authprovider-store"))
 	vulnerabilityFindingStore := vulnerabilityfindingstore.NewVulnerabilityFindingStore(s.db, s.log.WithField("pkg", "vulnerabilityfinding-store"))
+	vulnerabilityExceptionStore := vulnerabilityexceptionstore.NewVulnerabilityExceptionStore(s.db, s.log.WithField("pkg", "vulnerabilityexception-store"))
+	secretStore := secretstore.NewSecretStore(s.db, s.log.WithField("pkg", "secret-store"))
+	bulkOperationStore := bulkoperationstore.NewBulkOperationStore(s.db, s.log.WithField("pkg", "bulkoperation-store"))
 
 	eventsSvc := events.NewServiceHandler(eventStore, workerClient, s.log)
 
@@ -214,6 +222,10 @@ func (s *Server) Run(ctx context.Context) error {
 		repositoryservice.NewServiceHandler(repositoryStore, eventsSvc, s.log))
 	resourceSyncSvc := resourcesyncservice.WrapWithTracing(
 		resourcesyncservice.NewServiceHandler(resourceSyncStore, catalogStore, fleetStore, eventsSvc, s.log))
+	secretSvc := secretservice.WrapWithTracing(
+		secretservice.NewServiceHandler(secretStore, eventsSvc, s.log))
+	bulkOperationSvc := bulkoperationservice.WrapWithTracing(
+		bulkoperationservice.NewServiceHandler(bulkOperationStore, eventsSvc, s.log))
 	catalogSvc := catalogservice.WrapWithTracing(
 		catalogservice.NewServiceHandler(catalogStore, deviceStore, fleetStore, eventsSvc, s.log))
 	eventSvc := eventservice.WrapWithTracing(
@@ -223,7 +235,7 @@ func (s *Server) Run(ctx context.Context) error {
 	authProviderSvc := authproviderservice.WrapWithTracing(
 		authproviderservice.NewServiceHandler(authProviderStore, eventsSvc, s.log))
 	vulnerabilityFindingSvc := vulnerabilityfindingservice.WrapWithTracing(
-		vulnerabilityfindingservice.NewServiceHandler(vulnerabilityFindingStore, deviceStore, fleetStore, eventsSvc, vulnerabilityEnabled, s.log))
+		vulnerabilityfindingservice.NewServiceHandler(vulnerabilityFindingStore, vulnerabilityExceptionStore, deviceStore, fleetStore, catalogStore, eventsSvc, vulnerabilityEnabled, s.log))
 
 	// Initialize auth with the authprovider service for OIDC provider access
 	authN, err := auth.InitMultiAuth(s.cfg, s.log, authProviderSvc)
@@ -294,8 +306,8 @@ func (s *Server) Run(ctx context.Context) error {
 	negotiator := versioning.NewNegotiator(versioning.V1Beta1, server.MetadataResolver)
 
 	handlerV1Beta1 := transportv1beta1.NewTransportHandler(
-		authProviderSvc, csrSvc, deviceSvc, enrollmentRequestSvc, eventSvc,
-		fleetSvc, organizationSvc, repositorySvc, resourceSyncSvc, templateVersionSvc,
+		authProviderSvc, bulkOperationSvc, csrSvc, deviceSvc, enrollmentRequestSvc, eventSvc,
+		fleetSvc, organizationSvc, repositorySvc, resourceSyncSvc, secretSvc, templateVersionSvc,
 		convertv1beta1.NewConverter(),
 		s.authN, authTokenProxy, authUserInfoProxy, s.authZ,
 	)
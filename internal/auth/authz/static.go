@@ -36,6 +36,8 @@ var resourcePermissions = map[string]map[string][]string{
 		"fleets":                         {"get", "list", "create", "update", "patch", "delete"},
 		"resourcesyncs":                  {"get", "list", "create", "update", "patch", "delete"},
 		"repositories":                   {"get", "list", "create", "update", "patch", "delete"},
+		"secrets":                        {"get", "list", "create", "update", "delete"},
+		"bulkoperations":                 {"get", "list", "create", "delete"},
 		"catalogs":                       {"get", "list"},
 		"catalogitems":                   {"get", "list"},
 		"imagebuilds":                    {"get", "list", "create", "update", "patch", "delete"},
@@ -55,6 +57,8 @@ var resourcePermissions = map[string]map[string][]string{
 		"*":                            {"get", "list"}, // Default read access to all resources
 		"devices/console":              {},              // Explicitly denied - console access requires operator or admin role
 		"devices/applications/console": {},              // Explicitly denied - console access requires operator or admin role
+		"devices/portforward":          {},              // Explicitly denied - port forwarding requires operator or admin role
+		"devices/files":                {},              // Explicitly denied - file copy requires operator or admin role
 		"imageexports/download":        {},              // Explicitly denied - empty list overrides wildcard
 	},
 	v1beta1.RoleInstaller: {
@@ -209,6 +209,34 @@ func TestStaticAuthZ_CheckPermission(t *testing.T) {
 			op:       "get",
 			expected: true,
 		},
+		{
+			name:     "viewer cannot access devices/portforward",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/portforward",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "operator can access devices/portforward",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "devices/portforward",
+			op:       "get",
+			expected: true,
+		},
+		{
+			name:     "viewer cannot access devices/files",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/files",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "operator can access devices/files",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "devices/files",
+			op:       "get",
+			expected: true,
+		},
 		{
 			name:     "viewer can list catalog",
 			roles:    []string{v1beta1.RoleViewer},
@@ -450,6 +478,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "repositories/check-oci-image",
 					Operations: []string{"create"},
 				},
+				{
+					Resource:   "secrets",
+					Operations: []string{"create", "delete", "get", "list", "update"},
+				},
+				{
+					Resource:   "bulkoperations",
+					Operations: []string{"create", "delete", "get", "list"},
+				},
 				{
 					Resource:   "resourcesyncs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -472,6 +508,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "devices/console",
 					Operations: []string{}, // Explicitly denied
 				},
+				{
+					Resource:   "devices/files",
+					Operations: []string{}, // Explicitly denied
+				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{}, // Explicitly denied
+				},
 				{
 					Resource:   "imageexports/download",
 					Operations: []string{}, // Explicitly denied
@@ -532,6 +576,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "devices/console",
 					Operations: []string{}, // Explicitly denied by viewer, installer does not grant it
 				},
+				{
+					Resource:   "devices/files",
+					Operations: []string{}, // Explicitly denied by viewer, installer does not grant it
+				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{}, // Explicitly denied by viewer, installer does not grant it
+				},
 				{
 					Resource:   "enrollmentrequests",
 					Operations: []string{"get", "list"},
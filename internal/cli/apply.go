@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/api/versioning"
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
@@ -34,8 +35,9 @@ var (
 
 	// alphaResources defines resource kinds that require v1alpha1 apiVersion.
 	alphaResources = map[ResourceKind]struct{}{
-		CatalogKind:     {},
-		CatalogItemKind: {},
+		CatalogKind:                {},
+		CatalogItemKind:            {},
+		VulnerabilityExceptionKind: {},
 	}
 )
 
@@ -238,6 +240,11 @@ func applyFromReader(ctx context.Context, c *client.Client, ibClient *client.Ima
 }
 
 func applySingleResource(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, filename string, resource genericResource, dryRun bool) []error {
+	// OpenVEX documents have no kind or metadata; they are imported as VulnerabilityExceptions.
+	if isOpenVEXDocument(resource) {
+		return applyOpenVEXDocument(ctx, c, filename, resource, dryRun)
+	}
+
 	kindLike, ok := resource["kind"].(string)
 	if !ok {
 		return []error{fmt.Errorf("%s: skipping resource of unspecified kind: %v", filename, resource)}
@@ -271,8 +278,10 @@ func applySingleResource(ctx context.Context, c *client.Client, ibClient *client
 	if err != nil {
 		return []error{fmt.Errorf("%s: skipping resource of kind %q: %w", filename, kindLike, err)}
 	}
-	result := applyResourceByKind(ctx, c, ibClient, kind, resourceName, buf)
+	return reportApplyResult(applyResourceByKind(ctx, c, ibClient, kind, resourceName, buf))
+}
 
+func reportApplyResult(result applyResult) []error {
 	var errs []error
 	if result.err != nil {
 		errs = append(errs, result.err)
@@ -290,6 +299,31 @@ func applySingleResource(ctx context.Context, c *client.Client, ibClient *client
 	return errs
 }
 
+func isOpenVEXDocument(resource genericResource) bool {
+	vexContext, ok := resource["@context"].(string)
+	return ok && strings.HasPrefix(vexContext, apiv1alpha1.OpenVEXContextPrefix)
+}
+
+func applyOpenVEXDocument(ctx context.Context, c *client.Client, filename string, resource genericResource, dryRun bool) []error {
+	documentID, _ := resource["@id"].(string)
+	if documentID == "" {
+		documentID = NoneString
+	}
+
+	if dryRun {
+		fmt.Printf("%s: importing OpenVEX document %s (dry run only)\n", filename, documentID)
+		return nil
+	}
+
+	fmt.Printf("%s: importing OpenVEX document %s: ", filename, documentID)
+	buf, err := json.Marshal(resource)
+	if err != nil {
+		return []error{fmt.Errorf("%s: skipping OpenVEX document: %w", filename, err)}
+	}
+	response, err := c.V1Alpha1().ImportVulnerabilityExceptionsWithBodyWithResponse(ctx, "application/json", bytes.NewReader(buf))
+	return reportApplyResult(extractApplyResult(response, err))
+}
+
 func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, kind ResourceKind, resourceName string, buf []byte) applyResult {
 	switch kind {
 	case DeviceKind:
@@ -307,6 +341,13 @@ func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client
 	case ResourceSyncKind:
 		response, err := c.ReplaceResourceSyncWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
+	case SecretKind:
+		response, err := c.ReplaceSecretWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case BulkOperationKind:
+		// Bulk operations run once and cannot be replaced, so applying one always creates it.
+		response, err := c.CreateBulkOperationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
 	case CertificateSigningRequestKind:
 		response, err := c.ReplaceCertificateSigningRequestWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
@@ -350,6 +391,9 @@ func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client
 		}
 		response, err := c.V1Alpha1().ReplaceCatalogItemWithBodyWithResponse(ctx, catalogName, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
+	case VulnerabilityExceptionKind:
+		response, err := c.V1Alpha1().ReplaceVulnerabilityExceptionWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
 	default:
 		return applyResult{err: fmt.Errorf("skipping resource of unknown kind %q", kind)}
 	}
@@ -376,6 +420,8 @@ func extractApplyResult(response interface{}, err error) applyResult {
 		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclient.ReplaceResourceSyncResponse:
 		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclient.ReplaceSecretResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclient.ReplaceCertificateSigningRequestResponse:
 		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclient.ReplaceAuthProviderResponse:
@@ -384,6 +430,10 @@ func extractApplyResult(response interface{}, err error) applyResult {
 		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *apiclientv1alpha1.ReplaceCatalogItemResponse:
 		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclientv1alpha1.ReplaceVulnerabilityExceptionResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
+	case *apiclientv1alpha1.ImportVulnerabilityExceptionsResponse:
+		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *imagebuilderclient.CreateImageBuildResponse:
 		return buildApplyResult(r.HTTPResponse, r.Body)
 	case *imagebuilderclient.CreateImageExportResponse:
@@ -55,6 +55,27 @@ func TestApplySingleResourceEmptyName(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "OpenVEX document is imported without kind or metadata (dry run)",
+			resource: genericResource{
+				"@context":   "https://openvex.dev/ns/v0.2.0",
+				"@id":        "https://example.com/vex/os-1.0",
+				"statements": []interface{}{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "vulnerabilityexception requires alpha apiVersion",
+			resource: genericResource{
+				"apiVersion": "flightctl.io/v1beta1",
+				"kind":       "VulnerabilityException",
+				"metadata": map[string]interface{}{
+					"name": "accept-cve",
+				},
+			},
+			wantErr:     true,
+			errContains: `requires apiVersion "flightctl.io/v1alpha1"`,
+		},
 	}
 
 	for _, tt := range tests {
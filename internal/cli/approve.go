@@ -10,6 +10,7 @@ import (
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -32,12 +33,12 @@ func NewCmdApprove() *cobra.Command {
 	o := DefaultApproveOptions()
 	cmd := &cobra.Command{
 		Use:   "approve TYPE/NAME or TYPE NAME",
-		Short: "Approve a certificate signing or enrollment request.",
+		Short: "Approve a certificate signing or enrollment request, or a fleet's pending auto-upgrade.",
 		Args:  cobra.RangeArgs(1, 2),
 		ValidArgsFunction: KindNameAutocomplete{
 			Options:            o,
 			AllowMultipleNames: false,
-			AllowedKinds:       []ResourceKind{EnrollmentRequestKind, CertificateSigningRequestKind},
+			AllowedKinds:       []ResourceKind{EnrollmentRequestKind, CertificateSigningRequestKind, FleetKind},
 		}.ValidArgsFunction,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.Complete(cmd, args); err != nil {
@@ -81,12 +82,12 @@ func (o *ApproveOptions) Validate(args []string) error {
 		return err
 	}
 
-	if kind != EnrollmentRequestKind && kind != CertificateSigningRequestKind {
-		return fmt.Errorf("kind must be either %s or %s", EnrollmentRequestKind, CertificateSigningRequestKind)
+	if kind != EnrollmentRequestKind && kind != CertificateSigningRequestKind && kind != FleetKind {
+		return fmt.Errorf("kind must be one of %s, %s or %s", EnrollmentRequestKind, CertificateSigningRequestKind, FleetKind)
 	}
 
 	if len(name) == 0 {
-		return fmt.Errorf("specify a specific request resource to approve")
+		return fmt.Errorf("specify a specific resource to approve")
 	}
 
 	if len(o.ApproveLabels) > 0 && kind != EnrollmentRequestKind {
@@ -150,6 +151,19 @@ func (o *ApproveOptions) Run(ctx context.Context, args []string) error {
 		api.RemoveStatusCondition(&csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestDenied)
 		api.RemoveStatusCondition(&csr.Status.Conditions, api.ConditionTypeCertificateSigningRequestFailed)
 		response, err = c.UpdateCertificateSigningRequestApproval(ctx, name, *csr)
+	case kind == FleetKind:
+		var fleet *api.Fleet
+		fleet, err = getFleetWithPendingAutoUpgrade(ctx, c.ClientWithResponses, name)
+		if err != nil {
+			return err
+		}
+		// Approve exactly the upgrades that are pending, so newer upgrades need a new approval
+		pending, _ := fleet.GetAnnotation(api.FleetAnnotationAutoUpgradePending)
+		annotations := lo.FromPtr(fleet.Metadata.Annotations)
+		annotations[api.FleetAnnotationAutoUpgradeApproved] = pending
+		fleet.Metadata.Annotations = &annotations
+		fleet.Status = nil
+		response, err = c.ReplaceFleet(ctx, name, *fleet)
 	default:
 		return fmt.Errorf("unsupported resource kind: %s", kind)
 	}
@@ -157,6 +171,28 @@ func (o *ApproveOptions) Run(ctx context.Context, args []string) error {
 	return processApprovalReponse(response, err, kind, name)
 }
 
+// getFleetWithPendingAutoUpgrade returns the fleet if its auto-upgrade policy holds back an upgrade.
+func getFleetWithPendingAutoUpgrade(ctx context.Context, c *apiclient.ClientWithResponses, name string) (*api.Fleet, error) {
+	getResponse, err := c.GetFleetWithResponse(ctx, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting fleet: %w", err)
+	}
+	if getResponse.HTTPResponse != nil {
+		defer getResponse.HTTPResponse.Body.Close()
+	}
+	if getResponse.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("getting fleet: %d", getResponse.StatusCode())
+	}
+	if getResponse.JSON200 == nil {
+		return nil, fmt.Errorf("getting fleet: empty response")
+	}
+	fleet := getResponse.JSON200
+	if pending, _ := fleet.GetAnnotation(api.FleetAnnotationAutoUpgradePending); pending == "" {
+		return nil, fmt.Errorf("fleet %s has no pending auto-upgrade to approve", name)
+	}
+	return fleet, nil
+}
+
 func processApprovalReponse(response *http.Response, err error, kind ResourceKind, name string) error {
 	errorPrefix := fmt.Sprintf("approving %s/%s", kind, name)
 	if err != nil {
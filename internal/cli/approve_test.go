@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -158,3 +160,39 @@ func TestParseAndValidateKindNameFromArgsApprove(t *testing.T) {
 		})
 	}
 }
+
+func TestApproveOptionsValidateKinds(t *testing.T) {
+	// Create a minimal config file so GlobalOptions.Validate doesn't fail on missing login
+	configFile := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("{}"), 0600))
+
+	tests := []struct {
+		name        string
+		args        []string
+		labels      []string
+		wantErr     bool
+		errContains string
+	}{
+		{name: "enrollment request", args: []string{"er/test1"}, labels: []string{"region=eu"}},
+		{name: "certificate signing request", args: []string{"csr/test1"}},
+		{name: "fleet auto-upgrade", args: []string{"fleet/test1"}},
+		{name: "fleet with labels", args: []string{"fleet/test1"}, labels: []string{"region=eu"}, wantErr: true, errContains: "labels only apply to"},
+		{name: "device", args: []string{"device/test1"}, wantErr: true, errContains: "kind must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			o := DefaultApproveOptions()
+			o.ConfigFilePath = configFile
+			o.ApproveLabels = append(o.ApproveLabels, tt.labels...)
+			err := o.Validate(tt.args)
+			if tt.wantErr {
+				require.Error(err)
+				require.Contains(err.Error(), tt.errContains)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
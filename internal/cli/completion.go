@@ -330,6 +330,24 @@ func (kna *KindNameAutocomplete) getAutocompleteNames(cmd *cobra.Command, o Clie
 				}
 			}
 		}
+	case SecretKind:
+		resp, err := c.ListSecretsWithResponse(ctx, &api.ListSecretsParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, er := range resp.JSON200.Items {
+				if er.Metadata.Name != nil {
+					names = append(names, *er.Metadata.Name)
+				}
+			}
+		}
+	case BulkOperationKind:
+		resp, err := c.ListBulkOperationsWithResponse(ctx, &api.ListBulkOperationsParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, er := range resp.JSON200.Items {
+				if er.Metadata.Name != nil {
+					names = append(names, *er.Metadata.Name)
+				}
+			}
+		}
 	case TemplateVersionKind:
 		if kna.FleetName != nil {
 			resp, err := c.ListTemplateVersionsWithResponse(ctx, *kna.FleetName, &api.ListTemplateVersionsParams{})
@@ -370,6 +388,18 @@ func (kna *KindNameAutocomplete) getAutocompleteNames(cmd *cobra.Command, o Clie
 				}
 			}
 		}
+	case VulnerabilityExceptionKind:
+		if c.V1Alpha1() == nil {
+			break
+		}
+		resp, err := c.V1Alpha1().ListVulnerabilityExceptionsWithResponse(ctx, &apiv1alpha1.ListVulnerabilityExceptionsParams{})
+		if err == nil && resp.JSON200 != nil {
+			for _, er := range resp.JSON200.Items {
+				if er.Metadata.Name != nil {
+					names = append(names, *er.Metadata.Name)
+				}
+			}
+		}
 	}
 
 	return names
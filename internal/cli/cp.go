@@ -0,0 +1,475 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// fileTransferWSWriteTimeout bounds each write to a file transfer WebSocket so a stalled
+// remote peer cannot block the copy forever.
+const fileTransferWSWriteTimeout = 30 * time.Second
+
+// copyTarget is one side of a "cp" command: a device path if device is set, a local path
+// otherwise.
+type copyTarget struct {
+	device string
+	path   string
+}
+
+// CopyOptions holds the options for the "cp" command, which copies a file to or from a
+// device over the remote-access tunnel.
+type CopyOptions struct {
+	GlobalOptions
+}
+
+func DefaultCopyOptions() *CopyOptions {
+	return &CopyOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdCopy() *cobra.Command {
+	o := DefaultCopyOptions()
+
+	cmd := &cobra.Command{
+		Use:   "cp SRC DST",
+		Short: "Copy a file to or from a device.",
+		Long: `Copy a single file between the local machine and a device through the
+flightctl-remote-access service. Exactly one of SRC and DST must be a device path of the form
+device/NAME:/absolute/path.
+
+The file's size and SHA-256 checksum are verified before it is moved into place. An
+interrupted copy leaves a partial file behind, and running the same command again resumes
+from where it stopped. The device's agent only allows paths listed in its
+file-transfer.allowed-paths configuration and files up to file-transfer.max-file-size.`,
+		Example: `  # Copy a core dump from a device to the current directory
+  flightctl cp device/my-device:/var/lib/systemd/coredump/core.app.1000 .
+
+  # Copy a local file into a directory on a device
+  flightctl cp ./diagnostics.sh device/my-device:/var/tmp/`,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+	}
+
+	o.Bind(cmd.Flags())
+
+	return cmd
+}
+
+func (o *CopyOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+func (o *CopyOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *CopyOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	_, _, err := parseCopyTargets(args[0], args[1])
+	return err
+}
+
+// parseCopyTarget parses a "cp" argument. An argument is a device path if the part before
+// the first colon is a device/NAME reference; anything else is a local path.
+func parseCopyTarget(arg string) (copyTarget, error) {
+	ref, remotePath, found := strings.Cut(arg, ":")
+	if !found || !strings.Contains(ref, "/") {
+		return copyTarget{path: arg}, nil
+	}
+	kind, name, err := parseAndValidateKindName(ref)
+	if err != nil {
+		// Not a resource reference, e.g. "./dir/file:1".
+		return copyTarget{path: arg}, nil
+	}
+	if kind != DeviceKind {
+		return copyTarget{}, fmt.Errorf("only devices support copying files, got %q", ref)
+	}
+	if name == "" {
+		return copyTarget{}, fmt.Errorf("device name is required in %q", arg)
+	}
+	if !path.IsAbs(remotePath) {
+		return copyTarget{}, fmt.Errorf("device path in %q must be absolute", arg)
+	}
+	return copyTarget{device: name, path: remotePath}, nil
+}
+
+// parseCopyTargets parses SRC and DST and checks that exactly one of them is on a device.
+func parseCopyTargets(srcArg, dstArg string) (copyTarget, copyTarget, error) {
+	src, err := parseCopyTarget(srcArg)
+	if err != nil {
+		return copyTarget{}, copyTarget{}, err
+	}
+	dst, err := parseCopyTarget(dstArg)
+	if err != nil {
+		return copyTarget{}, copyTarget{}, err
+	}
+	if (src.device == "") == (dst.device == "") {
+		return copyTarget{}, copyTarget{}, fmt.Errorf("exactly one of SRC and DST must be a device path of the form device/NAME:/path")
+	}
+	if src.path == "" || dst.path == "" {
+		return copyTarget{}, copyTarget{}, fmt.Errorf("SRC and DST must not be empty")
+	}
+	return src, dst, nil
+}
+
+// resolveCopyPaths completes a destination that names a directory with the source's base
+// name: a local destination that is an existing directory, or a device path ending in "/".
+func resolveCopyPaths(src, dst copyTarget) (copyTarget, copyTarget) {
+	if dst.device != "" {
+		if strings.HasSuffix(dst.path, "/") {
+			dst.path += filepath.Base(src.path)
+		}
+		dst.path = path.Clean(dst.path)
+		return src, dst
+	}
+	src.path = path.Clean(src.path)
+	if info, err := os.Stat(dst.path); err == nil && info.IsDir() {
+		dst.path = filepath.Join(dst.path, path.Base(src.path))
+	}
+	return src, dst
+}
+
+func (o *CopyOptions) Run(ctx context.Context, args []string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+
+	src, dst, err := parseCopyTargets(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	src, dst = resolveCopyPaths(src, dst)
+
+	consoleServer := config.GetRemoteAccessServer()
+	if consoleServer == "" {
+		return fmt.Errorf("remote access service is not configured; run 'flightctl login' to update your client config or set 'remoteAccessService.server' manually")
+	}
+	tlsCfg, err := buildTLSConfigForConsole(config.RemoteAccessService, config.AuthInfo)
+	if err != nil {
+		return err
+	}
+	dialer := &websocket.Dialer{TLSClientConfig: tlsCfg}
+
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+
+	var n int64
+	if src.device != "" {
+		connURL, err := o.buildFileTransferURL(consoleServer, src.device, src.path, filetransfer.DirectionDownload)
+		if err != nil {
+			return err
+		}
+		n, err = downloadFile(ctx, dialer, connURL, refresher.GetAccessToken(), dst.path)
+		if err != nil {
+			return copyError(err)
+		}
+	} else {
+		connURL, err := o.buildFileTransferURL(consoleServer, dst.device, dst.path, filetransfer.DirectionUpload)
+		if err != nil {
+			return err
+		}
+		n, err = uploadFile(ctx, dialer, connURL, refresher.GetAccessToken(), src.path)
+		if err != nil {
+			return copyError(err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Copied %d bytes\n", n)
+	return nil
+}
+
+// copyError quotes agent-controlled error text so control characters are not written to the
+// terminal.
+func copyError(err error) error {
+	var sessionErr *ConsoleSessionError
+	if errors.As(err, &sessionErr) {
+		return fmt.Errorf("copy failed: %q", sessionErr.Message)
+	}
+	return fmt.Errorf("copy failed: %w", err)
+}
+
+// buildFileTransferURL constructs the WebSocket URL for a file transfer session. The base
+// URL's scheme is converted from https/http to wss/ws as required by gorilla/websocket.
+func (o *CopyOptions) buildFileTransferURL(consoleServer, deviceName, devicePath, direction string) (string, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/ws/v1/devices/%s/files", consoleServer, url.PathEscape(deviceName)))
+	if err != nil {
+		return "", fmt.Errorf("parsing file transfer URL: %w", err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	q := url.Values{}
+	q.Set("path", devicePath)
+	q.Set("direction", direction)
+	q.Set(api.OrganizationIDQueryKey, o.GetEffectiveOrganization())
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// dialFileTransfer opens a file transfer WebSocket, turning a refused handshake into the
+// server's error.
+func dialFileTransfer(ctx context.Context, dialer *websocket.Dialer, connURL, token string) (*websocket.Conn, error) {
+	headers := http.Header{}
+	if token != "" {
+		headers.Set("Authorization", "Bearer "+token)
+	}
+	conn, resp, err := dialer.DialContext(ctx, connURL, headers)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxAppConsoleWSMessageSize))
+			return nil, consoleErrorFromHandshake(resp, strings.TrimSpace(string(body)))
+		}
+		return nil, err
+	}
+	conn.SetReadLimit(maxAppConsoleWSMessageSize)
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+	return conn, nil
+}
+
+// closeFileTransfer ends the session normally.
+func closeFileTransfer(conn *websocket.Conn) {
+	_ = conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(fileTransferWSWriteTimeout))
+	_ = conn.Close()
+}
+
+func writeFrame(conn *websocket.Conn, msg []byte) error {
+	_ = conn.SetWriteDeadline(time.Now().Add(fileTransferWSWriteTimeout))
+	return conn.WriteMessage(websocket.BinaryMessage, msg)
+}
+
+func writeHeader(conn *websocket.Conn, h filetransfer.Header) error {
+	msg, err := filetransfer.EncodeHeader(h)
+	if err != nil {
+		return err
+	}
+	return writeFrame(conn, msg)
+}
+
+// readFrame returns the next frame sent by the device. An Error frame and an abnormal close
+// are returned as errors.
+func readFrame(conn *websocket.Conn) (filetransfer.FrameType, []byte, error) {
+	for {
+		msgType, msg, err := conn.ReadMessage()
+		if err != nil {
+			var closeErr *websocket.CloseError
+			if errors.As(err, &closeErr) && closeErr.Code != websocket.CloseNormalClosure && closeErr.Code != websocket.CloseGoingAway {
+				return 0, nil, consoleSessionErrorFromWSClose(closeErr)
+			}
+			return 0, nil, fmt.Errorf("connection closed before the transfer completed: %w", err)
+		}
+		if msgType != websocket.BinaryMessage {
+			continue
+		}
+		ft, payload, err := filetransfer.Decode(msg)
+		if err != nil {
+			return 0, nil, err
+		}
+		if ft == filetransfer.FrameError {
+			return 0, nil, &ConsoleSessionError{Message: string(payload)}
+		}
+		return ft, payload, nil
+	}
+}
+
+func readHeader(conn *websocket.Conn) (filetransfer.Header, error) {
+	ft, payload, err := readFrame(conn)
+	if err != nil {
+		return filetransfer.Header{}, err
+	}
+	if ft != filetransfer.FrameHeader {
+		return filetransfer.Header{}, fmt.Errorf("expected a header frame, got %q", ft)
+	}
+	return filetransfer.DecodeHeader(payload)
+}
+
+// downloadFile copies the device file into dest via dest+filetransfer.PartialSuffix,
+// resuming from the partial file of an earlier attempt, and returns the number of bytes
+// received.
+func downloadFile(ctx context.Context, dialer *websocket.Dialer, connURL, token, dest string) (int64, error) {
+	partial := dest + filetransfer.PartialSuffix
+	var offset int64
+	if info, err := os.Stat(partial); err == nil && info.Mode().IsRegular() {
+		offset = info.Size()
+	}
+
+	conn, err := dialFileTransfer(ctx, dialer, connURL, token)
+	if err != nil {
+		return 0, err
+	}
+	defer closeFileTransfer(conn)
+
+	if err := writeHeader(conn, filetransfer.Header{Offset: offset}); err != nil {
+		return 0, err
+	}
+	h, err := readHeader(conn)
+	if err != nil {
+		return 0, err
+	}
+	// The device restarts from 0 if the partial file does not fit the current file.
+	if h.Offset != 0 && h.Offset != offset {
+		return 0, fmt.Errorf("device resumed from offset %d, expected %d", h.Offset, offset)
+	}
+
+	f, err := os.OpenFile(partial, os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if err := f.Truncate(h.Offset); err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(h.Offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	written := h.Offset
+	for {
+		ft, payload, err := readFrame(conn)
+		if err != nil {
+			return written - h.Offset, err
+		}
+		if ft == filetransfer.FrameDone {
+			break
+		}
+		if ft != filetransfer.FrameData {
+			return written - h.Offset, fmt.Errorf("unexpected %q frame", ft)
+		}
+		if written+int64(len(payload)) > h.Size {
+			_ = os.Remove(partial)
+			return written - h.Offset, fmt.Errorf("received more than the announced %d bytes", h.Size)
+		}
+		if _, err := f.Write(payload); err != nil {
+			return written - h.Offset, err
+		}
+		written += int64(len(payload))
+	}
+	if err := f.Close(); err != nil {
+		return written - h.Offset, err
+	}
+
+	sum, size, err := filetransfer.FileSHA256(partial)
+	if err != nil {
+		return written - h.Offset, err
+	}
+	if size != h.Size || sum != h.SHA256 {
+		_ = os.Remove(partial)
+		return written - h.Offset, fmt.Errorf("checksum mismatch: received %d bytes with sha256 %s, expected %d bytes with sha256 %s", size, sum, h.Size, h.SHA256)
+	}
+	if err := os.Chmod(partial, 0o644); err != nil {
+		return written - h.Offset, err
+	}
+	if err := os.Rename(partial, dest); err != nil {
+		return written - h.Offset, err
+	}
+	return written - h.Offset, nil
+}
+
+// uploadFile copies src to the device, resuming from whatever the device already staged
+// from an earlier attempt, and returns the number of bytes sent.
+func uploadFile(ctx context.Context, dialer *websocket.Dialer, connURL, token, src string) (int64, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if !info.Mode().IsRegular() {
+		return 0, fmt.Errorf("%s is not a regular file", src)
+	}
+	sum, size, err := filetransfer.ReaderSHA256(f)
+	if err != nil {
+		return 0, fmt.Errorf("hashing %s: %w", src, err)
+	}
+
+	conn, err := dialFileTransfer(ctx, dialer, connURL, token)
+	if err != nil {
+		return 0, err
+	}
+	defer closeFileTransfer(conn)
+
+	if err := writeHeader(conn, filetransfer.Header{Size: size, SHA256: sum}); err != nil {
+		return 0, err
+	}
+	h, err := readHeader(conn)
+	if err != nil {
+		return 0, err
+	}
+	if h.Offset > size {
+		return 0, fmt.Errorf("device resumed from offset %d beyond the file size %d", h.Offset, size)
+	}
+	if _, err := f.Seek(h.Offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	var sent int64
+	buf := make([]byte, filetransfer.ChunkSize)
+	r := io.LimitReader(f, size-h.Offset)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if werr := writeFrame(conn, filetransfer.Encode(filetransfer.FrameData, buf[:n])); werr != nil {
+				return sent, werr
+			}
+			sent += int64(n)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return sent, err
+		}
+	}
+	if err := writeFrame(conn, filetransfer.Encode(filetransfer.FrameDone, nil)); err != nil {
+		return sent, err
+	}
+
+	// The device answers Done once the file is verified and in place.
+	ft, _, err := readFrame(conn)
+	if err != nil {
+		return sent, err
+	}
+	if ft != filetransfer.FrameDone {
+		return sent, fmt.Errorf("unexpected %q frame", ft)
+	}
+	return sent, nil
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCopyTargets(t *testing.T) {
+	tests := []struct {
+		name        string
+		src, dst    string
+		wantSrc     copyTarget
+		wantDst     copyTarget
+		errContains string
+	}{
+		{
+			name:    "When copying from a device it should parse the device path",
+			src:     "device/dev1:/var/log/messages",
+			dst:     "./messages",
+			wantSrc: copyTarget{device: "dev1", path: "/var/log/messages"},
+			wantDst: copyTarget{path: "./messages"},
+		},
+		{
+			name:    "When copying to a device it should parse the device path",
+			src:     "./a:b",
+			dst:     "devices/dev1:/var/tmp/",
+			wantSrc: copyTarget{path: "./a:b"},
+			wantDst: copyTarget{device: "dev1", path: "/var/tmp/"},
+		},
+		{
+			name:        "When both sides are local it should return an error",
+			src:         "a",
+			dst:         "b",
+			errContains: "exactly one of SRC and DST",
+		},
+		{
+			name:        "When both sides are devices it should return an error",
+			src:         "device/a:/x",
+			dst:         "device/b:/y",
+			errContains: "exactly one of SRC and DST",
+		},
+		{
+			name:        "When the device path is relative it should return an error",
+			src:         "device/dev1:var/log",
+			dst:         ".",
+			errContains: "must be absolute",
+		},
+		{
+			name:        "When the resource is not a device it should return an error",
+			src:         "fleet/f1:/x",
+			dst:         ".",
+			errContains: "only devices support copying files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, dst, err := parseCopyTargets(tt.src, tt.dst)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSrc, src)
+			assert.Equal(t, tt.wantDst, dst)
+		})
+	}
+}
+
+func TestResolveCopyPaths(t *testing.T) {
+	dir := t.TempDir()
+
+	_, dst := resolveCopyPaths(copyTarget{device: "dev1", path: "/var/log/messages"}, copyTarget{path: dir})
+	assert.Equal(t, filepath.Join(dir, "messages"), dst.path)
+
+	_, dst = resolveCopyPaths(copyTarget{path: "./local/diag.sh"}, copyTarget{device: "dev1", path: "/var/tmp/"})
+	assert.Equal(t, "/var/tmp/diag.sh", dst.path)
+
+	_, dst = resolveCopyPaths(copyTarget{path: "./diag.sh"}, copyTarget{device: "dev1", path: "/var/tmp/renamed.sh"})
+	assert.Equal(t, "/var/tmp/renamed.sh", dst.path)
+}
+
+func TestBuildFileTransferURL(t *testing.T) {
+	o := DefaultCopyOptions()
+	got, err := o.buildFileTransferURL("https://remote.example.com", "dev1", "/var/log/messages", filetransfer.DirectionDownload)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(got, "wss://remote.example.com/ws/v1/devices/dev1/files?"), got)
+	assert.Contains(t, got, "path=%2Fvar%2Flog%2Fmessages")
+	assert.Contains(t, got, "direction=download")
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// newFakeAgentServer returns a WebSocket server that runs serve for each session, playing
+// the agent's side of the protocol.
+func newFakeAgentServer(t *testing.T, serve func(conn *websocket.Conn)) string {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		serve(conn)
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func TestDownloadFile_ResumesPartialFile(t *testing.T) {
+	content := []byte("core dump contents")
+	url := newFakeAgentServer(t, func(conn *websocket.Conn) {
+		h, err := readHeader(conn)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, int64(5), h.Offset)
+		_ = writeHeader(conn, filetransfer.Header{Size: int64(len(content)), SHA256: sha256Hex(content), Offset: h.Offset})
+		_ = writeFrame(conn, filetransfer.Encode(filetransfer.FrameData, content[h.Offset:]))
+		_ = writeFrame(conn, filetransfer.Encode(filetransfer.FrameDone, nil))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	dest := filepath.Join(t.TempDir(), "core")
+	require.NoError(t, os.WriteFile(dest+filetransfer.PartialSuffix, content[:5], 0o600))
+
+	n, err := downloadFile(context.Background(), &websocket.Dialer{}, url, "", dest)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)-5), n)
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+	assert.NoFileExists(t, dest+filetransfer.PartialSuffix)
+}
+
+func TestDownloadFile_ChecksumMismatch(t *testing.T) {
+	url := newFakeAgentServer(t, func(conn *websocket.Conn) {
+		if _, err := readHeader(conn); err != nil {
+			return
+		}
+		_ = writeHeader(conn, filetransfer.Header{Size: 4, SHA256: sha256Hex([]byte("good"))})
+		_ = writeFrame(conn, filetransfer.Encode(filetransfer.FrameData, []byte("evil")))
+		_ = writeFrame(conn, filetransfer.Encode(filetransfer.FrameDone, nil))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	dest := filepath.Join(t.TempDir(), "file")
+	_, err := downloadFile(context.Background(), &websocket.Dialer{}, url, "", dest)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+	assert.NoFileExists(t, dest)
+	assert.NoFileExists(t, dest+filetransfer.PartialSuffix)
+}
+
+func TestUploadFile_SendsFromStagedOffset(t *testing.T) {
+	content := []byte("diagnostics script")
+	received := make(chan []byte, 1)
+	url := newFakeAgentServer(t, func(conn *websocket.Conn) {
+		h, err := readHeader(conn)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, int64(len(content)), h.Size)
+		assert.Equal(t, sha256Hex(content), h.SHA256)
+		_ = writeHeader(conn, filetransfer.Header{Offset: 6})
+		var data []byte
+		for {
+			ft, payload, err := readFrame(conn)
+			if err != nil {
+				return
+			}
+			if ft == filetransfer.FrameDone {
+				break
+			}
+			data = append(data, payload...)
+		}
+		received <- data
+		_ = writeFrame(conn, filetransfer.Encode(filetransfer.FrameDone, nil))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	src := filepath.Join(t.TempDir(), "diag.sh")
+	require.NoError(t, os.WriteFile(src, content, 0o600))
+
+	n, err := uploadFile(context.Background(), &websocket.Dialer{}, url, "", src)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)-6), n)
+	assert.Equal(t, content[6:], <-received)
+}
+
+func TestUploadFile_DeviceError(t *testing.T) {
+	url := newFakeAgentServer(t, func(conn *websocket.Conn) {
+		if _, err := readHeader(conn); err != nil {
+			return
+		}
+		_ = writeFrame(conn, filetransfer.EncodeError("file is 20 bytes, which exceeds the limit of 10 bytes"))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	src := filepath.Join(t.TempDir(), "diag.sh")
+	require.NoError(t, os.WriteFile(src, []byte("twenty bytes of data"), 0o600))
+
+	_, err := uploadFile(context.Background(), &websocket.Dialer{}, url, "", src)
+	require.Error(t, err)
+	var sessionErr *ConsoleSessionError
+	require.ErrorAs(t, err, &sessionErr)
+	assert.Contains(t, sessionErr.Message, "exceeds the limit")
+}
+
+func TestDownloadFile_PathNotAllowed_ReturnsConsoleSessionError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(consts.AppConsoleErrorCodeHeader, consts.FileTransferErrorCodePathNotAllowed)
+		http.Error(w, "path /etc/shadow is not allowed for file transfer on this device", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	_, err := downloadFile(context.Background(), &websocket.Dialer{}, "ws"+strings.TrimPrefix(srv.URL, "http"), "", filepath.Join(t.TempDir(), "shadow"))
+	require.Error(t, err)
+	var sessionErr *ConsoleSessionError
+	require.ErrorAs(t, err, &sessionErr)
+	assert.Equal(t, consts.FileTransferErrorCodePathNotAllowed, sessionErr.Code)
+}
@@ -318,6 +318,10 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *client.Client, kind Re
 		response, err = c.DeleteRepositoryWithResponse(ctx, name)
 	case ResourceSyncKind:
 		response, err = c.DeleteResourceSyncWithResponse(ctx, name)
+	case SecretKind:
+		response, err = c.DeleteSecretWithResponse(ctx, name)
+	case BulkOperationKind:
+		response, err = c.DeleteBulkOperationWithResponse(ctx, name)
 	case CertificateSigningRequestKind:
 		response, err = c.DeleteCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
@@ -326,6 +330,8 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *client.Client, kind Re
 		response, err = c.V1Alpha1().DeleteCatalogWithResponse(ctx, name)
 	case CatalogItemKind:
 		response, err = c.V1Alpha1().DeleteCatalogItemWithResponse(ctx, o.CatalogName, name)
+	case VulnerabilityExceptionKind:
+		response, err = c.V1Alpha1().DeleteVulnerabilityExceptionWithResponse(ctx, name)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
@@ -76,6 +76,10 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printRepositoriesTable(w, data.(*apiclient.ListRepositoriesResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.ResourceSyncKind):
 		return f.printResourceSyncsTable(w, data.(*apiclient.ListResourceSyncsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.SecretKind):
+		return f.printSecretsTable(w, data.(*apiclient.ListSecretsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.BulkOperationKind):
+		return f.printBulkOperationsTable(w, data.(*apiclient.ListBulkOperationsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.CertificateSigningRequestKind):
 		return f.printCSRTable(w, data.(*apiclient.ListCertificateSigningRequestsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.EventKind):
@@ -99,6 +103,8 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printCatalogsTable(w, data.(*apiclientv1alpha1.ListCatalogsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, apiv1alpha1.CatalogItemKind):
 		return f.printCatalogItemsTable(w, options.CatalogName == "", data.(*apiclientv1alpha1.ListAllCatalogItemsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, apiv1alpha1.VulnerabilityExceptionKind):
+		return f.printVulnerabilityExceptionsTable(w, data.(*apiclientv1alpha1.ListVulnerabilityExceptionsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, apiv1alpha1.VulnerabilityGroupKind):
 		if resp, ok := data.(*apiclientv1alpha1.ListVulnerabilitiesResponse); ok {
 			return f.printVulnerabilityGroupsTable(w, false, resp.JSON200.Items...)
@@ -143,6 +149,18 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 				return fmt.Errorf("failed to get device last seen: HTTP %d", statusCode)
 			}
 		}
+		if historyResponse, ok := data.(*apiclient.GetDeviceStatusHistoryResponse); ok {
+			if historyResponse.JSON200 == nil {
+				return fmt.Errorf("failed to get device status history: HTTP %d", historyResponse.StatusCode())
+			}
+			return f.printDeviceStatusHistoryTable(w, historyResponse.JSON200.Items...)
+		}
+		if versionsResponse, ok := data.(*apiclient.ListDeviceRenderedVersionsResponse); ok {
+			if versionsResponse.JSON200 == nil {
+				return fmt.Errorf("failed to get device rendered versions: HTTP %d", versionsResponse.StatusCode())
+			}
+			return f.printDeviceRenderedVersionsTable(w, versionsResponse.JSON200.Items...)
+		}
 		var device api.Device
 		if getRenderedResponse, ok := data.(*apiclient.GetRenderedDeviceResponse); ok {
 			device = *getRenderedResponse.JSON200
@@ -160,6 +178,10 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printRepositoriesTable(w, *data.(*apiclient.GetRepositoryResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.ResourceSyncKind):
 		return f.printResourceSyncsTable(w, *data.(*apiclient.GetResourceSyncResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.SecretKind):
+		return f.printSecretsTable(w, *data.(*apiclient.GetSecretResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.BulkOperationKind):
+		return f.printBulkOperationsTable(w, *data.(*apiclient.GetBulkOperationResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.CertificateSigningRequestKind):
 		return f.printCSRTable(w, *data.(*apiclient.GetCertificateSigningRequestResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.AuthProviderKind):
@@ -174,6 +196,8 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printCatalogsTable(w, *data.(*apiclientv1alpha1.GetCatalogResponse).JSON200)
 	case strings.EqualFold(options.Kind, apiv1alpha1.CatalogItemKind):
 		return f.printCatalogItemsTable(w, options.CatalogName == "", *data.(*apiclientv1alpha1.GetCatalogItemResponse).JSON200)
+	case strings.EqualFold(options.Kind, apiv1alpha1.VulnerabilityExceptionKind):
+		return f.printVulnerabilityExceptionsTable(w, *data.(*apiclientv1alpha1.GetVulnerabilityExceptionResponse).JSON200)
 	default:
 		return fmt.Errorf("unknown resource type %s", options.Kind)
 	}
@@ -241,6 +265,55 @@ func (f *TableFormatter) printDevicesLastSeenTable(w *tabwriter.Writer, lastSeen
 	return nil
 }
 
+func (f *TableFormatter) printDeviceStatusHistoryTable(w *tabwriter.Writer, entries ...api.DeviceStatusHistoryEntry) error {
+	f.printHeaderRowLn(w, "TIME", "CHANGED", "STATUS", "UPDATED", "APPLICATIONS", "RESOURCES", "OS IMAGE")
+	for _, e := range entries {
+		changed := make([]string, 0, len(e.ChangedFields))
+		for _, field := range e.ChangedFields {
+			changed = append(changed, string(field))
+		}
+		f.printTableRowLn(w,
+			e.Timestamp.Format(time.RFC3339),
+			strings.Join(changed, ","),
+			string(e.Summary.Status),
+			string(e.Updated.Status),
+			formatDeviceStatusHistoryApplications(e),
+			fmt.Sprintf("cpu=%s,memory=%s,disk=%s", e.Resources.Cpu, e.Resources.Memory, e.Resources.Disk),
+			util.DefaultString(e.Os.Image, NoneString),
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printDeviceRenderedVersionsTable(w *tabwriter.Writer, versions ...api.DeviceRenderedVersion) error {
+	f.printHeaderRowLn(w, "VERSION", "TEMPLATE VERSION", "ROLLBACK OF", "CREATED")
+	for _, v := range versions {
+		f.printTableRowLn(w,
+			v.RenderedVersion,
+			util.DefaultIfNil(v.TemplateVersion, NoneString),
+			util.DefaultIfNil(v.RollbackOf, NoneString),
+			v.CreatedAt.Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+// formatDeviceStatusHistoryApplications shows the applications summary followed by any application
+// that is not running, which is usually what explains a degraded transition.
+func formatDeviceStatusHistoryApplications(e api.DeviceStatusHistoryEntry) string {
+	result := string(e.ApplicationsSummary.Status)
+	var failing []string
+	for _, app := range e.Applications {
+		if app.Status != api.ApplicationStatusRunning && app.Status != api.ApplicationStatusCompleted {
+			failing = append(failing, fmt.Sprintf("%s=%s", app.Name, app.Status))
+		}
+	}
+	if len(failing) > 0 {
+		result += " (" + strings.Join(failing, ",") + ")"
+	}
+	return result
+}
+
 func (f *TableFormatter) printDevicesTable(w *tabwriter.Writer, wide bool, devices ...api.Device) error {
 	if wide {
 		f.printHeaderRowLn(w, "NAME", "ALIAS", "OWNER", "SYSTEM", "UPDATED", "APPLICATIONS", "LABELS")
@@ -386,6 +459,42 @@ func (f *TableFormatter) printRepositoriesTable(w *tabwriter.Writer, repos ...ap
 	return nil
 }
 
+func (f *TableFormatter) printSecretsTable(w *tabwriter.Writer, secrets ...api.Secret) error {
+	f.printHeaderRowLn(w, "NAME", "KEYS", "AGE")
+	for _, s := range secrets {
+		age := NoneString
+		if s.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*s.Metadata.CreationTimestamp)
+		}
+		f.printTableRowLn(w, *s.Metadata.Name, fmt.Sprintf("%d", len(s.Spec.Data)), age)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printBulkOperationsTable(w *tabwriter.Writer, ops ...api.BulkOperation) error {
+	f.printHeaderRowLn(w, "NAME", "OPERATION", "PHASE", "MATCHED", "SUCCEEDED", "FAILED", "AGE")
+	for _, op := range ops {
+		status := api.BulkOperationStatus{Phase: api.BulkOperationPhasePending}
+		if op.Status != nil {
+			status = *op.Status
+		}
+		age := NoneString
+		if op.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*op.Metadata.CreationTimestamp)
+		}
+		f.printTableRowLn(w,
+			*op.Metadata.Name,
+			string(op.Spec.Operation),
+			string(status.Phase),
+			fmt.Sprintf("%d", status.MatchedDevices),
+			fmt.Sprintf("%d", status.SucceededDevices),
+			fmt.Sprintf("%d", status.FailedDevices),
+			age,
+		)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printResourceSyncsTable(w *tabwriter.Writer, resourcesyncs ...api.ResourceSync) error {
 	f.printHeaderRowLn(w, "NAME", "REPOSITORY", "PATH", "REVISION", "ACCESSIBLE", "SYNCED", "LAST SYNC")
 
@@ -798,7 +907,11 @@ func (f *TableFormatter) printImagePromotionsTable(w *tabwriter.Writer, promotio
 }
 
 func (f *TableFormatter) printCatalogsTable(w *tabwriter.Writer, catalogs ...apiv1alpha1.Catalog) error {
-	f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "AGE")
+	if f.wide {
+		f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "SOURCE", "SYNCED", "AGE")
+	} else {
+		f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "AGE")
+	}
 
 	for _, cat := range catalogs {
 		name := NoneString
@@ -816,7 +929,26 @@ func (f *TableFormatter) printCatalogsTable(w *tabwriter.Writer, catalogs ...api
 			age = humanize.Time(*cat.Metadata.CreationTimestamp)
 		}
 
-		f.printTableRowLn(w, name, displayName, age)
+		if !f.wide {
+			f.printTableRowLn(w, name, displayName, age)
+			continue
+		}
+
+		source, synced := NoneString, NoneString
+		if cat.Spec.Source != nil {
+			separator := ":"
+			if strings.Contains(cat.Spec.Source.TargetRevision, ":") {
+				separator = "@"
+			}
+			source = cat.Spec.Source.Artifact + separator + cat.Spec.Source.TargetRevision
+			synced = "Unknown"
+			if cat.Status != nil {
+				if condition := api.FindStatusCondition(cat.Status.Conditions, api.ConditionTypeCatalogSynced); condition != nil {
+					synced = string(condition.Status)
+				}
+			}
+		}
+		f.printTableRowLn(w, name, displayName, source, synced, age)
 	}
 	return nil
 }
@@ -874,6 +1006,52 @@ func (f *TableFormatter) printCatalogItemsTable(w *tabwriter.Writer, showCatalog
 	return nil
 }
 
+func (f *TableFormatter) printVulnerabilityExceptionsTable(w *tabwriter.Writer, exceptions ...apiv1alpha1.VulnerabilityException) error {
+	if f.wide {
+		f.printHeaderRowLn(w, "NAME", "CVE ID", "STATE", "SCOPE", "EXPIRES", "AGE", "JUSTIFICATION")
+	} else {
+		f.printHeaderRowLn(w, "NAME", "CVE ID", "STATE", "SCOPE", "EXPIRES", "AGE")
+	}
+
+	for _, e := range exceptions {
+		name := NoneString
+		if e.Metadata.Name != nil {
+			name = *e.Metadata.Name
+		}
+
+		scope := NoneString
+		switch {
+		case e.Spec.Scope.ImageDigest != nil:
+			scope = "ImageDigest/" + *e.Spec.Scope.ImageDigest
+		case e.Spec.Scope.Fleet != nil:
+			scope = "Fleet/" + *e.Spec.Scope.Fleet
+		case e.Spec.Scope.CatalogItem != nil:
+			scope = "CatalogItem/" + e.Spec.Scope.CatalogItem.Catalog + "/" + e.Spec.Scope.CatalogItem.Item
+		}
+
+		expires := NoneString
+		if e.Spec.ExpiresAt != nil {
+			expires = humanize.Time(*e.Spec.ExpiresAt)
+		}
+
+		age := NoneString
+		if e.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*e.Metadata.CreationTimestamp)
+		}
+
+		if f.wide {
+			justification := e.Spec.Justification
+			if len(justification) > 60 {
+				justification = justification[:57] + "..."
+			}
+			f.printTableRowLn(w, name, e.Spec.CveId, string(e.Spec.State), scope, expires, age, justification)
+		} else {
+			f.printTableRowLn(w, name, e.Spec.CveId, string(e.Spec.State), scope, expires, age)
+		}
+	}
+	return nil
+}
+
 func (f *TableFormatter) printVulnerabilitiesTable(w *tabwriter.Writer, vulns ...apiv1alpha1.Vulnerability) error {
 	if f.wide {
 		f.printHeaderRowLn(w, "CVE ID", "SEVERITY", "CVSS", "ADVISORY", "PUBLISHED", "DESCRIPTION")
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"time"
 
 	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
@@ -34,16 +35,20 @@ const (
 	FlagContinue      = "continue"
 
 	// Resource specific flags
-	FlagFleetName   = "fleetname"    // for templateversions
-	FlagCatalogName = "catalog"      // for catalogitems
-	FlagRendered    = "rendered"     // for a single device
-	FlagSummary     = "summary"      // for listing devices and fleets
-	FlagSummaryOnly = "summary-only" // for listing devices and vulnerabilities
-	FlagLastSeen    = "last-seen"    // for a single device
-	FlagWithExports = "with-exports" // for imagebuilds
-	FlagSortBy      = "sort-by"      // for vulnerabilities
-	FlagOrder       = "order"        // for vulnerabilities
-	FlagCveId       = "cve-id"       // for filtering devices by CVE
+	FlagFleetName       = "fleetname"        // for templateversions
+	FlagCatalogName     = "catalog"          // for catalogitems
+	FlagRendered        = "rendered"         // for a single device
+	FlagSummary         = "summary"          // for listing devices and fleets
+	FlagSummaryOnly     = "summary-only"     // for listing devices and vulnerabilities
+	FlagLastSeen        = "last-seen"        // for a single device
+	FlagHistory         = "history"          // for a single device
+	FlagSince           = "since"            // for a single device's history
+	FlagVersions        = "versions"         // for a single device
+	FlagWithExports     = "with-exports"     // for imagebuilds
+	FlagSortBy          = "sort-by"          // for vulnerabilities
+	FlagOrder           = "order"            // for vulnerabilities
+	FlagCveId           = "cve-id"           // for filtering devices by CVE
+	FlagIncludeExcepted = "include-excepted" // for vulnerabilities
 )
 
 type FlagContextualRule struct {
@@ -55,21 +60,25 @@ type FlagContextualRule struct {
 type GetOptions struct {
 	GlobalOptions
 
-	LabelSelector string
-	FieldSelector string
-	Output        string
-	Limit         int32
-	Continue      string
-	FleetName     string
-	CatalogName   string
-	Rendered      bool
-	Summary       bool
-	SummaryOnly   bool
-	LastSeen      bool
-	WithExports   bool
-	SortBy        string
-	Order         string
-	CveId         string
+	LabelSelector   string
+	FieldSelector   string
+	Output          string
+	Limit           int32
+	Continue        string
+	FleetName       string
+	CatalogName     string
+	Rendered        bool
+	Summary         bool
+	SummaryOnly     bool
+	LastSeen        bool
+	History         bool
+	Since           time.Duration
+	Versions        bool
+	WithExports     bool
+	SortBy          string
+	Order           string
+	CveId           string
+	IncludeExcepted bool
 }
 
 func DefaultGetOptions() *GetOptions {
@@ -138,10 +147,14 @@ func (o *GetOptions) Bind(fs *pflag.FlagSet) {
 	fs.BoolVarP(&o.Summary, FlagSummary, "s", false, "Display summary information.")
 	fs.BoolVar(&o.SummaryOnly, FlagSummaryOnly, false, "Display summary information only.")
 	fs.BoolVar(&o.LastSeen, FlagLastSeen, false, "Display the last seen timestamp of the device.")
+	fs.BoolVar(&o.History, FlagHistory, false, "Display the timeline of significant status transitions of the device, newest first.")
+	fs.DurationVar(&o.Since, FlagSince, 0, "Only show status transitions recorded within this duration (e.g. 24h), when used with '--history'.")
+	fs.BoolVar(&o.Versions, FlagVersions, false, "Display the retained rendered versions of the device, newest first.")
 	fs.BoolVar(&o.WithExports, FlagWithExports, false, "Include related ImageExport resources when getting imagebuilds.")
 	fs.StringVar(&o.SortBy, FlagSortBy, o.SortBy, "Field to sort results by (for vulnerabilities).")
 	fs.StringVar(&o.Order, FlagOrder, o.Order, "Sort order: 'asc' or 'desc' (for vulnerabilities).")
 	fs.StringVar(&o.CveId, FlagCveId, o.CveId, "Filter devices by CVE ID (e.g., CVE-2023-44487).")
+	fs.BoolVar(&o.IncludeExcepted, FlagIncludeExcepted, false, "Include findings excepted by a vulnerabilityexception (for vulnerabilities).")
 	o.hideHelpContextualFlags(fs)
 }
 
@@ -150,10 +163,14 @@ var flagContextualRules = []FlagContextualRule{
 	{FlagSummary, []ResourceKind{DeviceKind, FleetKind, VulnerabilityKind}, []string{"list", "any"}},
 	{FlagRendered, []ResourceKind{DeviceKind}, []string{"single"}},
 	{FlagLastSeen, []ResourceKind{DeviceKind}, []string{"single"}},
+	{FlagHistory, []ResourceKind{DeviceKind}, []string{"single"}},
+	{FlagSince, []ResourceKind{DeviceKind}, []string{"single"}},
+	{FlagVersions, []ResourceKind{DeviceKind}, []string{"single"}},
 	{FlagFleetName, []ResourceKind{TemplateVersionKind}, []string{"any"}},
 	{FlagCatalogName, []ResourceKind{CatalogItemKind}, []string{"any"}},
 	{FlagSortBy, []ResourceKind{VulnerabilityKind}, []string{"any"}},
 	{FlagOrder, []ResourceKind{VulnerabilityKind}, []string{"any"}},
+	{FlagIncludeExcepted, []ResourceKind{VulnerabilityKind}, []string{"any"}},
 	{FlagCveId, []ResourceKind{DeviceKind}, []string{"list"}},
 }
 
@@ -267,6 +284,8 @@ func (o *GetOptions) Validate(args []string) error {
 		func() error { return o.validateSingleResourceRestrictions(kind, names) },
 		func() error { return o.validateLimit() },
 		func() error { return o.validateLastSeen(kind, names) },
+		func() error { return o.validateHistory(kind, names) },
+		func() error { return o.validateVersions(kind, names) },
 		func() error { return o.validateWithExports(kind) },
 		func() error { return o.validateVulnerabilityFlags(kind) },
 		func() error { return o.validateCveId(kind, names) },
@@ -396,6 +415,48 @@ func (o *GetOptions) validateLastSeen(kind ResourceKind, names []string) error {
 	return nil
 }
 
+// validateHistory checks the usage of the --history and --since flags.
+func (o *GetOptions) validateHistory(kind ResourceKind, names []string) error {
+	if o.Since != 0 && !o.History {
+		return fmt.Errorf("'--since' can only be used with '--history'")
+	}
+	if !o.History {
+		return nil
+	}
+	if kind != DeviceKind || len(names) != 1 {
+		return fmt.Errorf("'--history' can only be used when getting a single device")
+	}
+	if o.LastSeen || o.Rendered || o.Summary || o.SummaryOnly {
+		return fmt.Errorf("'--history' cannot be combined with '--last-seen', '--rendered', '--summary', or '--summary-only'")
+	}
+	if o.Since < 0 {
+		return fmt.Errorf("'--since' must be a positive duration")
+	}
+	// Name output requires metadata.name which DeviceStatusHistoryList does not provide.
+	if o.Output == string(display.NameFormat) {
+		return fmt.Errorf("'--history' does not support '-o name'")
+	}
+	return nil
+}
+
+// validateVersions checks the usage of the --versions flag.
+func (o *GetOptions) validateVersions(kind ResourceKind, names []string) error {
+	if !o.Versions {
+		return nil
+	}
+	if kind != DeviceKind || len(names) != 1 {
+		return fmt.Errorf("'--versions' can only be used when getting a single device")
+	}
+	if o.LastSeen || o.Rendered || o.History || o.Summary || o.SummaryOnly {
+		return fmt.Errorf("'--versions' cannot be combined with '--last-seen', '--rendered', '--history', '--summary', or '--summary-only'")
+	}
+	// Name output requires metadata.name which DeviceRenderedVersionList does not provide.
+	if o.Output == string(display.NameFormat) {
+		return fmt.Errorf("'--versions' does not support '-o name'")
+	}
+	return nil
+}
+
 // validateWithExports checks the usage of the --with-exports flag.
 func (o *GetOptions) validateWithExports(kind ResourceKind) error {
 	if o.WithExports && kind != ImageBuildKind {
@@ -404,7 +465,7 @@ func (o *GetOptions) validateWithExports(kind ResourceKind) error {
 	return nil
 }
 
-// validateVulnerabilityFlags checks that --sort-by and --order are only used with vulnerabilities.
+// validateVulnerabilityFlags checks that --sort-by, --order and --include-excepted are only used with vulnerabilities.
 func (o *GetOptions) validateVulnerabilityFlags(kind ResourceKind) error {
 	if o.SortBy != "" && kind != VulnerabilityKind {
 		return fmt.Errorf("'--sort-by' can only be specified when getting vulnerabilities")
@@ -412,6 +473,9 @@ func (o *GetOptions) validateVulnerabilityFlags(kind ResourceKind) error {
 	if o.Order != "" && kind != VulnerabilityKind {
 		return fmt.Errorf("'--order' can only be specified when getting vulnerabilities")
 	}
+	if o.IncludeExcepted && kind != VulnerabilityKind {
+		return fmt.Errorf("'--include-excepted' can only be specified when getting vulnerabilities")
+	}
 	return nil
 }
 
@@ -660,6 +724,12 @@ func (o *GetOptions) getSingleResource(ctx context.Context, c *client.Client, ki
 		if o.LastSeen {
 			return GetLastSeenDevice(ctx, c, name)
 		}
+		if o.History {
+			return GetDeviceStatusHistory(ctx, c, name, o.Since, o.Limit)
+		}
+		if o.Versions {
+			return GetDeviceRenderedVersions(ctx, c, name, o.Limit)
+		}
 		if o.Rendered {
 			return GetRenderedDevice(ctx, c, name)
 		}
@@ -676,6 +746,8 @@ func (o *GetOptions) getSingleResource(ctx context.Context, c *client.Client, ki
 		return c.V1Alpha1().GetCatalogWithResponse(ctx, name)
 	case CatalogItemKind:
 		return c.V1Alpha1().GetCatalogItemWithResponse(ctx, o.CatalogName, name)
+	case VulnerabilityExceptionKind:
+		return c.V1Alpha1().GetVulnerabilityExceptionWithResponse(ctx, name)
 	default:
 		return GetSingleResource(ctx, c, kind, name)
 	}
@@ -834,6 +906,22 @@ func (o *GetOptions) getResourceList(ctx context.Context, c *client.Client, kind
 			Continue:      util.ToPtrWithNilDefault(o.Continue),
 		}
 		return c.ListResourceSyncsWithResponse(ctx, &params)
+	case SecretKind:
+		params := api.ListSecretsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListSecretsWithResponse(ctx, &params)
+	case BulkOperationKind:
+		params := api.ListBulkOperationsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListBulkOperationsWithResponse(ctx, &params)
 	case CertificateSigningRequestKind:
 		params := api.ListCertificateSigningRequestsParams{
 			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
@@ -882,6 +970,14 @@ func (o *GetOptions) getResourceList(ctx context.Context, c *client.Client, kind
 			Continue:      util.ToPtrWithNilDefault(o.Continue),
 		}
 		return c.V1Alpha1().ListAllCatalogItemsWithResponse(ctx, &params)
+	case VulnerabilityExceptionKind:
+		params := apiv1alpha1.ListVulnerabilityExceptionsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.V1Alpha1().ListVulnerabilityExceptionsWithResponse(ctx, &params)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
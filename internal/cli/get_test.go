@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
@@ -483,6 +484,57 @@ func TestGetOptionsValidation(t *testing.T) {
 			errorContains: "'--last-seen' can only be used when getting a single device",
 		},
 
+		// History validation tests
+		{
+			name:        "history_with_single_device_ok",
+			args:        []string{"device", "test1"},
+			options:     &GetOptions{History: true, Since: time.Hour},
+			expectError: false,
+		},
+		{
+			name:          "history_with_device_list",
+			args:          []string{"devices"},
+			options:       &GetOptions{History: true},
+			expectError:   true,
+			errorContains: "'--history' can only be used when getting a single device",
+		},
+		{
+			name:          "history_with_last_seen",
+			args:          []string{"device", "test1"},
+			options:       &GetOptions{History: true, LastSeen: true},
+			expectError:   true,
+			errorContains: "'--history' cannot be combined with",
+		},
+		{
+			name:          "since_without_history",
+			args:          []string{"device", "test1"},
+			options:       &GetOptions{Since: time.Hour},
+			expectError:   true,
+			errorContains: "'--since' can only be used with '--history'",
+		},
+
+		// Versions validation tests
+		{
+			name:        "versions_with_single_device_ok",
+			args:        []string{"device", "test1"},
+			options:     &GetOptions{Versions: true},
+			expectError: false,
+		},
+		{
+			name:          "versions_with_device_list",
+			args:          []string{"devices"},
+			options:       &GetOptions{Versions: true},
+			expectError:   true,
+			errorContains: "'--versions' can only be used when getting a single device",
+		},
+		{
+			name:          "versions_with_rendered",
+			args:          []string{"device", "test1"},
+			options:       &GetOptions{Versions: true, Rendered: true},
+			expectError:   true,
+			errorContains: "'--versions' cannot be combined with",
+		},
+
 		// Single resource restriction tests
 		{
 			name:          "get_individual_event",
@@ -593,6 +645,15 @@ func TestGetOptionsValidation(t *testing.T) {
 				if tc.options.LastSeen {
 					opts.LastSeen = tc.options.LastSeen
 				}
+				if tc.options.History {
+					opts.History = tc.options.History
+				}
+				if tc.options.Since != 0 {
+					opts.Since = tc.options.Since
+				}
+				if tc.options.Versions {
+					opts.Versions = tc.options.Versions
+				}
 				if tc.options.CatalogName != "" {
 					opts.CatalogName = tc.options.CatalogName
 				}
@@ -173,6 +173,9 @@ func (o *VulnerabilityGetOptions) ValidateVulnerability(vulnArgs *VulnerabilityA
 		if o.Limit > 0 || o.Continue != "" {
 			return fmt.Errorf("--limit and --continue are not supported for summary commands")
 		}
+		if o.IncludeExcepted {
+			return fmt.Errorf("--include-excepted is not supported for summary commands; summaries always omit excepted findings")
+		}
 	}
 
 	if o.Summary && vulnArgs.Command != VulnCmdDevice && vulnArgs.Command != VulnCmdFleet {
@@ -225,9 +228,10 @@ func (o *VulnerabilityGetOptions) getVulnerabilitySummary(ctx context.Context, c
 
 func (o *VulnerabilityGetOptions) listVulnerabilities(ctx context.Context, c *client.Client, formatter display.OutputFormatter) error {
 	params := &apiv1alpha1.ListVulnerabilitiesParams{
-		FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
-		Limit:         util.ToPtrWithNilDefault(o.Limit),
-		Continue:      util.ToPtrWithNilDefault(o.Continue),
+		FieldSelector:   util.ToPtrWithNilDefault(o.FieldSelector),
+		Limit:           util.ToPtrWithNilDefault(o.Limit),
+		Continue:        util.ToPtrWithNilDefault(o.Continue),
+		IncludeExcepted: util.ToPtrWithNilDefault(o.IncludeExcepted),
 	}
 	if o.SortBy != "" {
 		sortBy := apiv1alpha1.ListVulnerabilitiesParamsSortBy(o.SortBy)
@@ -250,9 +254,10 @@ func (o *VulnerabilityGetOptions) listVulnerabilities(ctx context.Context, c *cl
 
 func (o *VulnerabilityGetOptions) getDeviceVulnerabilities(ctx context.Context, c *client.Client, formatter display.OutputFormatter, name string) error {
 	params := &apiv1alpha1.GetDeviceVulnerabilitiesParams{
-		FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
-		Limit:         util.ToPtrWithNilDefault(o.Limit),
-		Continue:      util.ToPtrWithNilDefault(o.Continue),
+		FieldSelector:   util.ToPtrWithNilDefault(o.FieldSelector),
+		Limit:           util.ToPtrWithNilDefault(o.Limit),
+		Continue:        util.ToPtrWithNilDefault(o.Continue),
+		IncludeExcepted: util.ToPtrWithNilDefault(o.IncludeExcepted),
 	}
 	if o.SortBy != "" {
 		sortBy := apiv1alpha1.GetDeviceVulnerabilitiesParamsSortBy(o.SortBy)
@@ -306,9 +311,10 @@ func (o *VulnerabilityGetOptions) getDeviceVulnerabilitySummary(ctx context.Cont
 
 func (o *VulnerabilityGetOptions) getFleetVulnerabilities(ctx context.Context, c *client.Client, formatter display.OutputFormatter, name string) error {
 	params := &apiv1alpha1.GetFleetVulnerabilitiesParams{
-		FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
-		Limit:         util.ToPtrWithNilDefault(o.Limit),
-		Continue:      util.ToPtrWithNilDefault(o.Continue),
+		FieldSelector:   util.ToPtrWithNilDefault(o.FieldSelector),
+		Limit:           util.ToPtrWithNilDefault(o.Limit),
+		Continue:        util.ToPtrWithNilDefault(o.Continue),
+		IncludeExcepted: util.ToPtrWithNilDefault(o.IncludeExcepted),
 	}
 	if o.SortBy != "" {
 		sortBy := apiv1alpha1.GetFleetVulnerabilitiesParamsSortBy(o.SortBy)
@@ -362,9 +368,10 @@ func (o *VulnerabilityGetOptions) getFleetVulnerabilitySummary(ctx context.Conte
 
 func (o *VulnerabilityGetOptions) getVulnerabilityImpact(ctx context.Context, c *client.Client, formatter display.OutputFormatter, cveId string) error {
 	params := &apiv1alpha1.GetVulnerabilityImpactParams{
-		FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
-		Limit:         util.ToPtrWithNilDefault(o.Limit),
-		Continue:      util.ToPtrWithNilDefault(o.Continue),
+		FieldSelector:   util.ToPtrWithNilDefault(o.FieldSelector),
+		Limit:           util.ToPtrWithNilDefault(o.Limit),
+		Continue:        util.ToPtrWithNilDefault(o.Continue),
+		IncludeExcepted: util.ToPtrWithNilDefault(o.IncludeExcepted),
 	}
 	if o.SortBy != "" {
 		sortBy := apiv1alpha1.GetVulnerabilityImpactParamsSortBy(o.SortBy)
@@ -148,6 +148,7 @@ func TestValidateVulnerability(t *testing.T) {
 		limit         int32
 		continueToken string
 		summary       bool
+		excepted      bool
 		expectError   bool
 		errorContains string
 	}{
@@ -281,15 +282,33 @@ func TestValidateVulnerability(t *testing.T) {
 			expectError:   true,
 			errorContains: "--summary can only be used with",
 		},
+		{
+			name:     "include-excepted valid for fleet command",
+			vulnArgs: &VulnerabilityArgs{Command: VulnCmdFleet, Name: "myfleet"},
+			excepted: true,
+		},
+		{
+			name:     "include-excepted valid for impact command",
+			vulnArgs: &VulnerabilityArgs{Command: VulnCmdImpact, CveId: "CVE-2024-1234"},
+			excepted: true,
+		},
+		{
+			name:          "include-excepted not valid for summary command",
+			vulnArgs:      &VulnerabilityArgs{Command: VulnCmdDeviceSummary, Name: "mydevice"},
+			excepted:      true,
+			expectError:   true,
+			errorContains: "--include-excepted is not supported for summary commands",
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			opts := &VulnerabilityGetOptions{
 				GetOptions: &GetOptions{
-					Limit:    tc.limit,
-					Continue: tc.continueToken,
-					Summary:  tc.summary,
+					Limit:           tc.limit,
+					Continue:        tc.continueToken,
+					Summary:         tc.summary,
+					IncludeExcepted: tc.excepted,
 				},
 				SortBy: tc.sortBy,
 				Order:  tc.order,
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/client"
@@ -24,6 +25,7 @@ type ResourceKind string
 
 const (
 	InvalidKind                   ResourceKind = ""
+	BulkOperationKind             ResourceKind = "bulkoperation"
 	CatalogKind                   ResourceKind = "catalog"
 	CatalogItemKind               ResourceKind = "catalogitem"
 	CertificateSigningRequestKind ResourceKind = "certificatesigningrequest"
@@ -38,8 +40,10 @@ const (
 	OrganizationKind              ResourceKind = "organization"
 	RepositoryKind                ResourceKind = "repository"
 	ResourceSyncKind              ResourceKind = "resourcesync"
+	SecretKind                    ResourceKind = "secret"
 	TemplateVersionKind           ResourceKind = "templateversion"
 	VulnerabilityKind             ResourceKind = "vulnerability"
+	VulnerabilityExceptionKind    ResourceKind = "vulnerabilityexception"
 )
 
 func (r ResourceKind) String() string {
@@ -66,6 +70,7 @@ func ResourceKindFromString(kindLike string) (ResourceKind, error) {
 
 var (
 	resourceKindSet = map[ResourceKind]struct{}{
+		BulkOperationKind:             {},
 		CatalogKind:                   {},
 		CatalogItemKind:               {},
 		CertificateSigningRequestKind: {},
@@ -80,13 +85,16 @@ var (
 		OrganizationKind:              {},
 		RepositoryKind:                {},
 		ResourceSyncKind:              {},
+		SecretKind:                    {},
 		TemplateVersionKind:           {},
 		VulnerabilityKind:             {},
+		VulnerabilityExceptionKind:    {},
 	}
 
 	validResourceKinds = slices.Collect(maps.Keys(resourceKindSet))
 
 	pluralToKind = map[string]ResourceKind{
+		"bulkoperations":             BulkOperationKind,
 		"catalogs":                   CatalogKind,
 		"catalogitems":               CatalogItemKind,
 		"certificatesigningrequests": CertificateSigningRequestKind,
@@ -101,11 +109,14 @@ var (
 		"organizations":              OrganizationKind,
 		"repositories":               RepositoryKind,
 		"resourcesyncs":              ResourceSyncKind,
+		"secrets":                    SecretKind,
 		"templateversions":           TemplateVersionKind,
 		"vulnerabilities":            VulnerabilityKind,
+		"vulnerabilityexceptions":    VulnerabilityExceptionKind,
 	}
 
 	kindToPlural = map[ResourceKind]string{
+		BulkOperationKind:             "bulkoperations",
 		CatalogKind:                   "catalogs",
 		CatalogItemKind:               "catalogitems",
 		CertificateSigningRequestKind: "certificatesigningrequests",
@@ -120,11 +131,14 @@ var (
 		OrganizationKind:              "organizations",
 		RepositoryKind:                "repositories",
 		ResourceSyncKind:              "resourcesyncs",
+		SecretKind:                    "secrets",
 		TemplateVersionKind:           "templateversions",
 		VulnerabilityKind:             "vulnerabilities",
+		VulnerabilityExceptionKind:    "vulnerabilityexceptions",
 	}
 
 	shortnameToKind = map[string]ResourceKind{
+		"bo":   BulkOperationKind,
 		"cat":  CatalogKind,
 		"ci":   CatalogItemKind,
 		"csr":  CertificateSigningRequestKind,
@@ -141,6 +155,7 @@ var (
 		"rs":   ResourceSyncKind,
 		"tv":   TemplateVersionKind,
 		"vuln": VulnerabilityKind,
+		"vex":  VulnerabilityExceptionKind,
 	}
 )
 
@@ -394,6 +409,10 @@ func GetSingleResource(ctx context.Context, c *client.Client, kind ResourceKind,
 		return c.GetRepositoryWithResponse(ctx, name)
 	case ResourceSyncKind:
 		return c.GetResourceSyncWithResponse(ctx, name)
+	case SecretKind:
+		return c.GetSecretWithResponse(ctx, name)
+	case BulkOperationKind:
+		return c.GetBulkOperationWithResponse(ctx, name)
 	case CertificateSigningRequestKind:
 		return c.GetCertificateSigningRequestWithResponse(ctx, name)
 	case AuthProviderKind:
@@ -413,6 +432,29 @@ func GetLastSeenDevice(ctx context.Context, c *client.Client, name string) (inte
 	return c.GetDeviceLastSeenWithResponse(ctx, name)
 }
 
+// GetDeviceStatusHistory fetches the status transitions of a device, optionally restricted to
+// those recorded within the given duration and to at most limit entries.
+func GetDeviceStatusHistory(ctx context.Context, c *client.Client, name string, since time.Duration, limit int32) (interface{}, error) {
+	params := &api.GetDeviceStatusHistoryParams{}
+	if since > 0 {
+		from := time.Now().Add(-since)
+		params.From = &from
+	}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+	return c.GetDeviceStatusHistoryWithResponse(ctx, name, params)
+}
+
+// GetDeviceRenderedVersions fetches the retained rendered versions of the specified device.
+func GetDeviceRenderedVersions(ctx context.Context, c *client.Client, name string, limit int32) (interface{}, error) {
+	params := &api.ListDeviceRenderedVersionsParams{}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+	return c.ListDeviceRenderedVersionsWithResponse(ctx, name, params)
+}
+
 // GetTemplateVersion fetches a template version with the specified fleet name.
 func GetTemplateVersion(ctx context.Context, c *client.Client, fleetName, name string) (interface{}, error) {
 	return c.GetTemplateVersionWithResponse(ctx, fleetName, name)
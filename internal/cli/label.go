@@ -0,0 +1,316 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+const bulkOperationPollInterval = time.Second
+
+// MetadataPatchOptions backs the label and annotate commands, which change the labels or
+// annotations of one device, or of every device matching a selector, through a BulkOperation.
+type MetadataPatchOptions struct {
+	GlobalOptions
+	LabelSelector string
+	FieldSelector string
+	All           bool
+	Wait          bool
+
+	operation  api.BulkOperationType
+	deviceName string
+	changes    api.BulkOperationMetadataPatch
+}
+
+func DefaultMetadataPatchOptions(operation api.BulkOperationType) *MetadataPatchOptions {
+	return &MetadataPatchOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		LabelSelector: "",
+		FieldSelector: "",
+		All:           false,
+		Wait:          false,
+		operation:     operation,
+	}
+}
+
+func NewCmdLabel() *cobra.Command {
+	return newCmdMetadataPatch(api.BulkOperationTypePatchLabels, "label", "labels", `
+  # Add or overwrite the env label of a specific device
+  flightctl label device/my-device env=production
+
+  # Remove the env label from a specific device
+  flightctl label device/my-device env-
+
+  # Label all devices with a specific label and wait for the operation to finish
+  flightctl label devices --selector site=factory-1 region=emea --wait
+
+  # Label devices with a field selector
+  flightctl label devices --field-selector metadata.owner=Fleet/edge tier=edge`)
+}
+
+func NewCmdAnnotate() *cobra.Command {
+	return newCmdMetadataPatch(api.BulkOperationTypePatchAnnotations, "annotate", "annotations", `
+  # Add or overwrite an annotation of a specific device
+  flightctl annotate device/my-device example.com/owner-team=platform
+
+  # Remove an annotation from all devices
+  flightctl annotate devices --all example.com/owner-team-`)
+}
+
+func newCmdMetadataPatch(operation api.BulkOperationType, use string, noun string, examples string) *cobra.Command {
+	o := DefaultMetadataPatchOptions(operation)
+	cmd := &cobra.Command{
+		Use:   use + " (device/NAME | devices) KEY=VALUE... KEY-...",
+		Short: fmt.Sprintf("Update the %s of a device or of devices based on selectors.", noun),
+		Long: fmt.Sprintf(`Update the %s of a device or of devices based on selectors.
+
+KEY=VALUE adds or overwrites a key and KEY- removes it. The change is applied asynchronously by a
+BulkOperation, whose progress can be followed with 'flightctl get bulkoperation/NAME' or by
+passing --wait.
+
+Examples:%s`, noun, examples),
+		Args: cobra.MinimumNArgs(2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *MetadataPatchOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to filter on, supporting operators like '=', '!=', and 'in' (e.g., -l='key1=value1,key2!=value2,key3 in (value3, value4)').")
+	fs.StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, "Selector (field query) to filter on, supporting operators like '=', '==', and '!=' (e.g., --field-selector='key1=value1,key2!=value2').")
+	fs.BoolVar(&o.All, "all", o.All, "Update all devices.")
+	fs.BoolVar(&o.Wait, "wait", o.Wait, "Wait for the operation to finish and report its outcome.")
+}
+
+func (o *MetadataPatchOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *MetadataPatchOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	return o.validateArgs(args)
+}
+
+func (o *MetadataPatchOptions) validateArgs(args []string) error {
+	kind, name, changeArgs, err := parseMetadataPatchArgs(args)
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("kind must be Device")
+	}
+
+	changes, err := parseMetadataChanges(changeArgs)
+	if err != nil {
+		return err
+	}
+	o.changes = changes
+	o.deviceName = name
+
+	if name == "" {
+		if o.All && (o.LabelSelector != "" || o.FieldSelector != "") {
+			return fmt.Errorf("--all flag cannot be used with selectors")
+		}
+		if !o.All && o.LabelSelector == "" && o.FieldSelector == "" {
+			return fmt.Errorf("at least one selector or --all flag is required when updating multiple devices. Use --selector/-l, --field-selector, or --all flag")
+		}
+		return nil
+	}
+
+	if o.All {
+		return fmt.Errorf("--all flag cannot be used when updating a specific device")
+	}
+	if o.LabelSelector != "" || o.FieldSelector != "" {
+		return fmt.Errorf("selectors cannot be used when updating a specific device")
+	}
+	return nil
+}
+
+// parseMetadataPatchArgs splits the arguments into the resource and the requested changes. The
+// resource is either TYPE/NAME, TYPE NAME, or just TYPE when devices are selected by selectors.
+func parseMetadataPatchArgs(args []string) (ResourceKind, string, []string, error) {
+	if len(args) == 0 {
+		return InvalidKind, "", nil, fmt.Errorf("no arguments provided")
+	}
+	if strings.Contains(args[0], "/") {
+		kind, name, err := parseAndValidateKindName(args[0])
+		if err != nil {
+			return InvalidKind, "", nil, err
+		}
+		if name == "" {
+			return InvalidKind, "", nil, fmt.Errorf("resource name cannot be empty when using TYPE/NAME format")
+		}
+		return kind, name, args[1:], nil
+	}
+
+	kind, err := ResourceKindFromString(args[0])
+	if err != nil {
+		return InvalidKind, "", nil, err
+	}
+	rest := args[1:]
+	if len(rest) > 0 && !isMetadataChange(rest[0]) {
+		return kind, rest[0], rest[1:], nil
+	}
+	return kind, "", rest, nil
+}
+
+func isMetadataChange(arg string) bool {
+	return strings.Contains(arg, "=") || strings.HasSuffix(arg, "-")
+}
+
+// parseMetadataChanges parses KEY=VALUE and KEY- arguments. Keys and values are validated by the
+// service.
+func parseMetadataChanges(args []string) (api.BulkOperationMetadataPatch, error) {
+	set := map[string]string{}
+	var remove []string
+	for _, arg := range args {
+		switch {
+		case strings.Contains(arg, "="):
+			key, value, _ := strings.Cut(arg, "=")
+			if key == "" {
+				return api.BulkOperationMetadataPatch{}, fmt.Errorf("invalid change %q: key cannot be empty", arg)
+			}
+			set[key] = value
+		case strings.HasSuffix(arg, "-") && len(arg) > 1:
+			remove = append(remove, strings.TrimSuffix(arg, "-"))
+		default:
+			return api.BulkOperationMetadataPatch{}, fmt.Errorf("invalid change %q: must be KEY=VALUE or KEY-", arg)
+		}
+	}
+	if len(set) == 0 && len(remove) == 0 {
+		return api.BulkOperationMetadataPatch{}, fmt.Errorf("at least one KEY=VALUE or KEY- change is required")
+	}
+	changes := api.BulkOperationMetadataPatch{}
+	if len(set) > 0 {
+		changes.Set = &set
+	}
+	if len(remove) > 0 {
+		changes.Remove = &remove
+	}
+	return changes, nil
+}
+
+func (o *MetadataPatchOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	op := o.bulkOperation()
+	response, err := c.CreateBulkOperationWithResponse(ctx, op)
+	if err != nil {
+		return fmt.Errorf("creating bulk operation: %w", err)
+	}
+	if response.HTTPResponse != nil && response.HTTPResponse.StatusCode != http.StatusCreated {
+		return &CLIError{
+			Context: "creating bulk operation: failed",
+			Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+		}
+	}
+
+	name := lo.FromPtr(op.Metadata.Name)
+	fmt.Printf("%s/%s created\n", BulkOperationKind, name)
+	if !o.Wait {
+		return nil
+	}
+	return waitForBulkOperation(ctx, c.ClientWithResponses, name)
+}
+
+func (o *MetadataPatchOptions) bulkOperation() api.BulkOperation {
+	prefix := "label"
+	spec := api.BulkOperationSpec{Operation: o.operation}
+	if o.operation == api.BulkOperationTypePatchAnnotations {
+		prefix = "annotate"
+		spec.Annotations = &o.changes
+	} else {
+		spec.Labels = &o.changes
+	}
+
+	switch {
+	case o.deviceName != "":
+		spec.FieldSelector = lo.ToPtr(fmt.Sprintf("metadata.name=%s", o.deviceName))
+	case o.All:
+		// Matches all devices, as for 'flightctl resume devices --all'.
+		spec.FieldSelector = lo.ToPtr("metadata.name!=''")
+	default:
+		spec.LabelSelector = lo.EmptyableToPtr(o.LabelSelector)
+		spec.FieldSelector = lo.EmptyableToPtr(o.FieldSelector)
+	}
+
+	return api.BulkOperation{
+		ApiVersion: api.BulkOperationAPIVersion,
+		Kind:       api.BulkOperationKind,
+		Metadata:   api.ObjectMeta{Name: lo.ToPtr(fmt.Sprintf("%s-%s", prefix, utilrand.String(8)))},
+		Spec:       spec,
+	}
+}
+
+// waitForBulkOperation polls a bulk operation until it finishes, and returns an error if it failed.
+func waitForBulkOperation(ctx context.Context, c *apiclient.ClientWithResponses, name string) error {
+	ticker := time.NewTicker(bulkOperationPollInterval)
+	defer ticker.Stop()
+	for {
+		response, err := c.GetBulkOperationWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("getting bulk operation %s: %w", name, err)
+		}
+		if response.JSON200 == nil {
+			return &CLIError{
+				Context: fmt.Sprintf("getting bulk operation %s: failed", name),
+				Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+			}
+		}
+		if status := response.JSON200.Status; status != nil {
+			switch status.Phase {
+			case api.BulkOperationPhaseSucceeded:
+				fmt.Println(lo.FromPtr(status.Message))
+				return nil
+			case api.BulkOperationPhaseFailed:
+				for _, failure := range lo.FromPtr(status.Failures) {
+					fmt.Printf("  %s: %s\n", failure.DeviceName, failure.Message)
+				}
+				return fmt.Errorf("bulk operation %s failed: %s", name, lo.FromPtr(status.Message))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for bulk operation %s: %w", name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataPatchOptions_ValidateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		labelSelector string
+		all           bool
+		errorContains string
+		wantDevice    string
+		wantSet       map[string]string
+		wantRemove    []string
+	}{
+		{
+			name:       "single device with TYPE/NAME",
+			args:       []string{"device/test-device", "env=prod", "site-"},
+			wantDevice: "test-device",
+			wantSet:    map[string]string{"env": "prod"},
+			wantRemove: []string{"site"},
+		},
+		{
+			name:       "single device with TYPE NAME",
+			args:       []string{"device", "test-device", "env="},
+			wantDevice: "test-device",
+			wantSet:    map[string]string{"env": ""},
+		},
+		{
+			name:          "devices with selector",
+			args:          []string{"devices", "example.com/tier=edge"},
+			labelSelector: "site=a",
+			wantSet:       map[string]string{"example.com/tier": "edge"},
+		},
+		{
+			name:       "devices with --all",
+			args:       []string{"devices", "env-"},
+			all:        true,
+			wantRemove: []string{"env"},
+		},
+		{
+			name:          "devices without selector",
+			args:          []string{"devices", "env=prod"},
+			errorContains: "at least one selector or --all flag is required",
+		},
+		{
+			name:          "--all with selector",
+			args:          []string{"devices", "env=prod"},
+			labelSelector: "site=a",
+			all:           true,
+			errorContains: "--all flag cannot be used with selectors",
+		},
+		{
+			name:          "single device with selector",
+			args:          []string{"device/test-device", "env=prod"},
+			labelSelector: "site=a",
+			errorContains: "selectors cannot be used when updating a specific device",
+		},
+		{
+			name:          "no changes",
+			args:          []string{"device", "test-device"},
+			errorContains: "at least one KEY=VALUE or KEY- change is required",
+		},
+		{
+			name:          "empty key",
+			args:          []string{"device/test-device", "=prod"},
+			errorContains: "key cannot be empty",
+		},
+		{
+			name:          "invalid kind",
+			args:          []string{"fleet/test-fleet", "env=prod"},
+			errorContains: "kind must be Device",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultMetadataPatchOptions(api.BulkOperationTypePatchLabels)
+			opts.LabelSelector = tt.labelSelector
+			opts.All = tt.all
+
+			err := opts.validateArgs(tt.args)
+			if tt.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDevice, opts.deviceName)
+			assert.Equal(t, tt.wantSet, lo.FromPtr(opts.changes.Set))
+			assert.Equal(t, tt.wantRemove, lo.FromPtr(opts.changes.Remove))
+		})
+	}
+}
+
+func TestMetadataPatchOptions_BulkOperation(t *testing.T) {
+	opts := DefaultMetadataPatchOptions(api.BulkOperationTypePatchAnnotations)
+	require.NoError(t, opts.validateArgs([]string{"device/test-device", "example.com/team=platform"}))
+
+	op := opts.bulkOperation()
+	assert.Equal(t, api.BulkOperationTypePatchAnnotations, op.Spec.Operation)
+	assert.Equal(t, "metadata.name=test-device", lo.FromPtr(op.Spec.FieldSelector))
+	assert.Nil(t, op.Spec.LabelSelector)
+	assert.Nil(t, op.Spec.Labels)
+	require.NotNil(t, op.Spec.Annotations)
+	assert.Regexp(t, "^annotate-", lo.FromPtr(op.Metadata.Name))
+	assert.Empty(t, op.Validate())
+}
@@ -28,7 +28,8 @@ const (
 
 type LogsOptions struct {
 	GlobalOptions
-	Follow bool
+	Follow       bool
+	Architecture string
 }
 
 func DefaultLogsOptions() *LogsOptions {
@@ -50,6 +51,9 @@ func NewCmdLogs() *cobra.Command {
   # Follow logs for an active imagebuild
   flightctl logs imagebuild/my-build -f
 
+  # Get the logs of the arm64 build of a multi-architecture imagebuild
+  flightctl logs imagebuild/my-build --architecture arm64
+
   # Get logs for an imageexport
   flightctl logs imageexport/my-export
 
@@ -76,6 +80,7 @@ func NewCmdLogs() *cobra.Command {
 func (o *LogsOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 	fs.BoolVarP(&o.Follow, "follow", "f", o.Follow, "Specify if the logs should be streamed. Follows the logs until the build completes or the command is interrupted.")
+	fs.StringVar(&o.Architecture, "architecture", o.Architecture, "Only print the logs of the build for this architecture (imagebuild only).")
 }
 
 func (o *LogsOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -102,6 +107,10 @@ func (o *LogsOptions) Validate(args []string) error {
 		return fmt.Errorf("resource name is required")
 	}
 
+	if o.Architecture != "" && kind != ImageBuildKind {
+		return fmt.Errorf("--architecture is only supported for imagebuild resources")
+	}
+
 	return nil
 }
 
@@ -158,6 +167,9 @@ func (o *LogsOptions) streamLogs(ctx context.Context, ibClient *client.ImageBuil
 	switch kind {
 	case ImageBuildKind:
 		params := &imagebuilderapi.GetImageBuildLogParams{Follow: follow}
+		if o.Architecture != "" {
+			params.Architecture = (*imagebuilderapi.ImageArchitecture)(&o.Architecture)
+		}
 		resp, err = ibClient.GetImageBuildLog(ctx, name, params)
 	case ImageExportKind:
 		params := &imagebuilderapi.GetImageExportLogParams{Follow: follow}
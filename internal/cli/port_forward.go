@@ -0,0 +1,356 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// portForwardWSWriteTimeout bounds each write to a port-forward WebSocket so a stalled remote
+// peer cannot block the bridging goroutines forever.
+const portForwardWSWriteTimeout = 10 * time.Second
+
+// portMapping is a single LOCAL:REMOTE pair given on the command line. A local port of 0
+// means an ephemeral port is chosen when listening.
+type portMapping struct {
+	local  int
+	remote int
+}
+
+// PortForwardOptions holds the options for the "port-forward" command, which tunnels local
+// TCP connections to a TCP port on a device's loopback interface.
+type PortForwardOptions struct {
+	GlobalOptions
+	address string
+}
+
+func DefaultPortForwardOptions() *PortForwardOptions {
+	return &PortForwardOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		address:       "127.0.0.1",
+	}
+}
+
+func NewCmdPortForward() *cobra.Command {
+	o := DefaultPortForwardOptions()
+
+	cmd := &cobra.Command{
+		Use:   "port-forward device/NAME [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		Short: "Forward one or more local ports to ports on a device through the server.",
+		Long: `Forward one or more local ports to TCP ports on a device's loopback interface through the
+flightctl-remote-access service. Each accepted local connection is tunneled separately. The
+device's agent only connects to ports listed in its port-forward.allowed-ports configuration.`,
+		Example: `  # Listen on local port 8080 and forward to port 80 on the device
+  flightctl port-forward device/my-device 8080:80
+
+  # Listen on local port 5432 and forward to the same port on the device
+  flightctl port-forward device/my-device 5432
+
+  # Listen on a random local port and forward to port 443 on the device
+  flightctl port-forward device/my-device :443`,
+		Args: cobra.MinimumNArgs(2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+
+	o.Bind(cmd.Flags())
+
+	return cmd
+}
+
+func (o *PortForwardOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.address, "address", o.address, "Local address to listen on")
+}
+
+func (o *PortForwardOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *PortForwardOptions) Validate(args []string) error {
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args[:1])
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("only devices support port forwarding")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("device name is required")
+	}
+	if net.ParseIP(o.address) == nil && o.address != "localhost" {
+		return fmt.Errorf("--address must be an IP address or \"localhost\", got %q", o.address)
+	}
+	_, err = parsePortMappings(args[1:])
+	return err
+}
+
+// parsePortMappings parses [LOCAL:]REMOTE arguments. "80" forwards local port 80 to device
+// port 80, and ":80" forwards an ephemeral local port to device port 80.
+func parsePortMappings(specs []string) ([]portMapping, error) {
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("at least one port mapping is required")
+	}
+	mappings := make([]portMapping, 0, len(specs))
+	for _, spec := range specs {
+		localStr, remoteStr, found := strings.Cut(spec, ":")
+		if !found {
+			remoteStr = spec
+			localStr = spec
+		}
+		remote, err := parsePort(remoteStr, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port mapping %q: remote %w", spec, err)
+		}
+		local, err := parsePort(localStr, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port mapping %q: local %w", spec, err)
+		}
+		mappings = append(mappings, portMapping{local: local, remote: remote})
+	}
+	return mappings, nil
+}
+
+func parsePort(s string, allowEmpty bool) (int, error) {
+	if s == "" && allowEmpty {
+		return 0, nil
+	}
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("port must be between 1 and 65535, got %q", s)
+	}
+	return port, nil
+}
+
+func (o *PortForwardOptions) Run(ctx context.Context, args []string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args[:1])
+	if err != nil {
+		return err
+	}
+	mappings, err := parsePortMappings(args[1:])
+	if err != nil {
+		return err
+	}
+
+	consoleServer := config.GetRemoteAccessServer()
+	if consoleServer == "" {
+		return fmt.Errorf("remote access service is not configured; run 'flightctl login' to update your client config or set 'remoteAccessService.server' manually")
+	}
+	tlsCfg, err := buildTLSConfigForConsole(config.RemoteAccessService, config.AuthInfo)
+	if err != nil {
+		return err
+	}
+	dialer := &websocket.Dialer{TLSClientConfig: tlsCfg}
+
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listeners := make([]net.Listener, 0, len(mappings))
+	defer func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+	for _, m := range mappings {
+		l, err := net.Listen("tcp", net.JoinHostPort(o.address, strconv.Itoa(m.local)))
+		if err != nil {
+			return fmt.Errorf("listening on %s port %d: %w", o.address, m.local, err)
+		}
+		listeners = append(listeners, l)
+		fmt.Fprintf(os.Stderr, "Forwarding from %s -> %d\n", l.Addr().String(), m.remote)
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(mappings))
+	for i, m := range mappings {
+		connURL, err := o.buildPortForwardURL(consoleServer, name, m.remote)
+		if err != nil {
+			return err
+		}
+		l := listeners[i]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errCh <- o.serve(ctx, l, dialer, connURL, refresher.GetAccessToken)
+		}()
+	}
+
+	go func() {
+		<-ctx.Done()
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+
+	// Any listener failing ends the whole command, like kubectl port-forward.
+	err = <-errCh
+	cancel()
+	wg.Wait()
+	if ctx.Err() != nil && errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// buildPortForwardURL constructs the WebSocket URL for a port-forward session. The base URL's
+// scheme is converted from https/http to wss/ws as required by gorilla/websocket.
+func (o *PortForwardOptions) buildPortForwardURL(consoleServer, deviceName string, port int) (string, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/ws/v1/devices/%s/portforward", consoleServer, url.PathEscape(deviceName)))
+	if err != nil {
+		return "", fmt.Errorf("parsing port-forward URL: %w", err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	q := url.Values{}
+	q.Set("port", strconv.Itoa(port))
+	q.Set(api.OrganizationIDQueryKey, o.GetEffectiveOrganization())
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// serve accepts local connections on l until it is closed and tunnels each one over its own
+// WebSocket. A failed tunnel only drops the affected local connection.
+func (o *PortForwardOptions) serve(ctx context.Context, l net.Listener, dialer *websocket.Dialer, connURL string, token func() string) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		local, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer local.Close()
+			fmt.Fprintf(os.Stderr, "Handling connection for %s\n", l.Addr().String())
+			if err := forwardConnection(ctx, local, dialer, connURL, token()); err != nil {
+				var sessionErr *ConsoleSessionError
+				if errors.As(err, &sessionErr) {
+					// %q escapes control characters in agent-controlled error text.
+					fmt.Fprintf(os.Stderr, "Error forwarding %s: %q\n", l.Addr().String(), sessionErr.Message)
+				} else {
+					fmt.Fprintf(os.Stderr, "Error forwarding %s: %v\n", l.Addr().String(), err)
+				}
+			}
+		}()
+	}
+}
+
+// forwardConnection opens a WebSocket port-forward session and copies data between it and
+// local until either side closes or ctx is canceled.
+func forwardConnection(ctx context.Context, local net.Conn, dialer *websocket.Dialer, connURL, token string) error {
+	headers := http.Header{}
+	if token != "" {
+		headers.Set("Authorization", "Bearer "+token)
+	}
+	conn, resp, err := dialer.DialContext(ctx, connURL, headers)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxAppConsoleWSMessageSize))
+			return consoleErrorFromHandshake(resp, strings.TrimSpace(string(body)))
+		}
+		return err
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxAppConsoleWSMessageSize)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = local.Close()
+		_ = conn.Close()
+	}()
+
+	var sessionErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// local → WebSocket
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := local.Read(buf)
+			if n > 0 {
+				_ = conn.SetWriteDeadline(time.Now().Add(portForwardWSWriteTimeout))
+				if werr := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+					time.Now().Add(portForwardWSWriteTimeout))
+				return
+			}
+		}
+	}()
+
+	// WebSocket → local
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		for {
+			msgType, msg, err := conn.ReadMessage()
+			if err != nil {
+				var closeErr *websocket.CloseError
+				if errors.As(err, &closeErr) && closeErr.Code != websocket.CloseNormalClosure && closeErr.Code != websocket.CloseGoingAway {
+					sessionErr = consoleSessionErrorFromWSClose(closeErr)
+				}
+				return
+			}
+			if msgType != websocket.BinaryMessage {
+				continue
+			}
+			if _, werr := local.Write(msg); werr != nil {
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	return sessionErr
+}
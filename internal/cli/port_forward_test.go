@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePortMappings(t *testing.T) {
+	tests := []struct {
+		name        string
+		specs       []string
+		want        []portMapping
+		errContains string
+	}{
+		{
+			name:  "When LOCAL:REMOTE is given it should use both ports",
+			specs: []string{"8080:80"},
+			want:  []portMapping{{local: 8080, remote: 80}},
+		},
+		{
+			name:  "When only a port is given it should use it for both ends",
+			specs: []string{"5432"},
+			want:  []portMapping{{local: 5432, remote: 5432}},
+		},
+		{
+			name:  "When the local port is empty it should pick an ephemeral port",
+			specs: []string{":443", "9000:9000"},
+			want:  []portMapping{{local: 0, remote: 443}, {local: 9000, remote: 9000}},
+		},
+		{
+			name:        "When the remote port is missing it should return an error",
+			specs:       []string{"8080:"},
+			errContains: "remote port must be between 1 and 65535",
+		},
+		{
+			name:        "When a port is out of range it should return an error",
+			specs:       []string{"70000:80"},
+			errContains: "local port must be between 1 and 65535",
+		},
+		{
+			name:        "When a port is not a number it should return an error",
+			specs:       []string{"http"},
+			errContains: "invalid port mapping \"http\"",
+		},
+		{
+			name:        "When no mapping is given it should return an error",
+			errContains: "at least one port mapping is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePortMappings(tt.specs)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPortForwardOptions_Validate(t *testing.T) {
+	o := DefaultPortForwardOptions()
+	require.NoError(t, o.Validate([]string{"device/mydevice", "8080:80"}))
+
+	err := o.Validate([]string{"fleet/myfleet", "8080:80"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only devices support port forwarding")
+
+	o.address = "not an address"
+	err = o.Validate([]string{"device/mydevice", "8080:80"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--address must be an IP address")
+}
+
+func TestBuildPortForwardURL(t *testing.T) {
+	o := DefaultPortForwardOptions()
+	got, err := o.buildPortForwardURL("https://remote.example.com", "dev1", 8080)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(got, "wss://remote.example.com/ws/v1/devices/dev1/portforward?"), got)
+	assert.Contains(t, got, "port=8080")
+}
+
+// pipeConns returns both ends of a loopback TCP connection.
+func pipeConns(t *testing.T) (net.Conn, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		c, _ := l.Accept()
+		accepted <- c
+	}()
+	client, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	server := <-accepted
+	require.NotNil(t, server)
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	return client, server
+}
+
+func TestForwardConnection_RelaysData(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.WriteMessage(websocket.BinaryMessage, []byte(strings.ToUpper(string(msg))))
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+	defer srv.Close()
+
+	user, local := pipeConns(t)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwardConnection(context.Background(), local, &websocket.Dialer{}, "ws"+strings.TrimPrefix(srv.URL, "http"), "token")
+	}()
+
+	_, err := user.Write([]byte("ping"))
+	require.NoError(t, err)
+	require.NoError(t, user.SetReadDeadline(time.Now().Add(2*time.Second)))
+	got, err := io.ReadAll(user)
+	require.NoError(t, err)
+	assert.Equal(t, "PING", string(got))
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("forwardConnection did not return")
+	}
+}
+
+func TestForwardConnection_PortNotAllowed_ReturnsConsoleSessionError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(consts.AppConsoleErrorCodeHeader, consts.PortForwardErrorCodeNotAllowed)
+		http.Error(w, "port 22 is not allowed for port forwarding on this device", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	_, local := pipeConns(t)
+	err := forwardConnection(context.Background(), local, &websocket.Dialer{}, "ws"+strings.TrimPrefix(srv.URL, "http"), "")
+	require.Error(t, err)
+	sessionErr, ok := err.(*ConsoleSessionError)
+	require.True(t, ok, "expected *ConsoleSessionError, got %T", err)
+	assert.Equal(t, consts.PortForwardErrorCodeNotAllowed, sessionErr.Code)
+	assert.Contains(t, sessionErr.Message, "port 22 is not allowed")
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type RollbackOptions struct {
+	GlobalOptions
+	ToVersion string
+	Clear     bool
+	DryRun    bool
+}
+
+func DefaultRollbackOptions() *RollbackOptions {
+	return &RollbackOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdRollback() *cobra.Command {
+	o := DefaultRollbackOptions()
+	cmd := &cobra.Command{
+		Use:   "rollback device/NAME (--to VERSION | --clear)",
+		Short: "Roll a device back to a previously rendered version.",
+		Long: `Roll a device back to a previously rendered version of its specification.
+
+While a rollback is active the device is held at the restored version and reports
+an Updated status of Diverged. Clearing the rollback re-renders the device from its
+current specification or fleet template.
+
+Examples:
+  # List the rendered versions retained for a device
+  flightctl get device/my-device --versions
+
+  # Show what rolling back to rendered version 3 would change, without applying it
+  flightctl rollback device/my-device --to 3 --dry-run
+
+  # Roll the device back to rendered version 3
+  flightctl rollback device/my-device --to 3
+
+  # Clear the rollback and resume normal rendering
+  flightctl rollback device/my-device --clear`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RollbackOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.ToVersion, "to", o.ToVersion, "The rendered version to roll the device back to.")
+	fs.BoolVar(&o.Clear, "clear", o.Clear, "Clear an active rollback and resume rendering the device's current specification.")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Show the changes a rollback would make to the device's rendered specification without applying it.")
+}
+
+func (o *RollbackOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *RollbackOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("kind must be Device")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific device to roll back")
+	}
+
+	if o.Clear == (len(o.ToVersion) > 0) {
+		return fmt.Errorf("exactly one of '--to' or '--clear' must be specified")
+	}
+	if o.Clear && o.DryRun {
+		return fmt.Errorf("'--dry-run' can only be used with '--to'")
+	}
+	if len(o.ToVersion) > 0 {
+		if v, err := strconv.ParseInt(o.ToVersion, 10, 64); err != nil || v <= 0 {
+			return fmt.Errorf("'--to' must be a positive rendered version number")
+		}
+	}
+	return nil
+}
+
+func (o *RollbackOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	if o.Clear {
+		response, err := c.ClearDeviceRollbackWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("clearing rollback of device %s: %w", name, err)
+		}
+		if response.StatusCode() != http.StatusOK {
+			return &CLIError{
+				Context: fmt.Sprintf("clearing rollback of device %s: failed", name),
+				Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+			}
+		}
+		fmt.Printf("Rollback of device %s cleared\n", name)
+		return nil
+	}
+
+	if o.DryRun {
+		return o.printRollbackDiff(ctx, c, name)
+	}
+
+	response, err := c.RollbackDeviceWithResponse(ctx, name, api.DeviceRollback{RenderedVersion: o.ToVersion})
+	if err != nil {
+		return fmt.Errorf("rolling back device %s: %w", name, err)
+	}
+	if response.StatusCode() != http.StatusOK {
+		return &CLIError{
+			Context: fmt.Sprintf("rolling back device %s: failed", name),
+			Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+		}
+	}
+	renderedVersion := lo.FromPtr(response.JSON200.Metadata.Annotations)[api.DeviceAnnotationRenderedVersion]
+	fmt.Printf("Device %s rolled back to rendered version %s (delivered as rendered version %s)\n", name, o.ToVersion, renderedVersion)
+	return nil
+}
+
+// printRollbackDiff prints the patch that turns the device's currently rendered specification
+// into the specification of the version it would be rolled back to.
+func (o *RollbackOptions) printRollbackDiff(ctx context.Context, c *client.Client, name string) error {
+	deviceResponse, err := c.GetDeviceWithResponse(ctx, name)
+	if err != nil {
+		return fmt.Errorf("getting device %s: %w", name, err)
+	}
+	if deviceResponse.StatusCode() != http.StatusOK {
+		return &CLIError{
+			Context: fmt.Sprintf("getting device %s: failed", name),
+			Err:     &APIError{Status: ParseStatusFromBody(deviceResponse.Body)},
+		}
+	}
+	current, ok := lo.FromPtr(deviceResponse.JSON200.Metadata.Annotations)[api.DeviceAnnotationRenderedVersion]
+	if !ok {
+		return fmt.Errorf("device %s has not been rendered yet", name)
+	}
+
+	response, err := c.GetDeviceRenderedVersionDiffWithResponse(ctx, name, o.ToVersion, &api.GetDeviceRenderedVersionDiffParams{Base: &current})
+	if err != nil {
+		return fmt.Errorf("comparing rendered versions of device %s: %w", name, err)
+	}
+	if response.StatusCode() != http.StatusOK {
+		return &CLIError{
+			Context: fmt.Sprintf("comparing rendered versions of device %s: failed", name),
+			Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+		}
+	}
+
+	fmt.Printf("Rolling back device %s from rendered version %s to %s would apply:\n", name, current, o.ToVersion)
+	if len(response.JSON200.Patch) == 0 {
+		fmt.Println("  no changes")
+		return nil
+	}
+	for _, op := range response.JSON200.Patch {
+		if op.Op == api.Remove {
+			fmt.Printf("  %s %s\n", op.Op, op.Path)
+			continue
+		}
+		value, err := json.Marshal(op.Value)
+		if err != nil {
+			return fmt.Errorf("marshalling patch value: %w", err)
+		}
+		fmt.Printf("  %s %s: %s\n", op.Op, op.Path, value)
+	}
+	return nil
+}
@@ -117,33 +117,35 @@ type dbConfig struct {
 }
 
 type svcConfig struct {
-	Address                string           `json:"address,omitempty"`
-	AgentEndpointAddress   string           `json:"agentEndpointAddress,omitempty"`
-	CertStore              string           `json:"cert,omitempty"`
-	BaseUrl                string           `json:"baseUrl,omitempty"`
-	BaseAgentEndpointUrl   string           `json:"baseAgentEndpointUrl,omitempty"`
-	BaseUIUrl              string           `json:"baseUIUrl,omitempty"`
-	DisableTLS             bool             `json:"disableTLS,omitempty"`
-	SrvCertFile            string           `json:"srvCertificateFile,omitempty"`
-	SrvKeyFile             string           `json:"srvKeyFile,omitempty"`
-	ServerCertName         string           `json:"serverCertName,omitempty"`
-	ServerCertValidityDays int              `json:"serverCertValidityDays,omitempty"`
-	AltNames               []string         `json:"altNames,omitempty"`
-	LogLevel               string           `json:"logLevel,omitempty"`
-	HttpReadTimeout        util.Duration    `json:"httpReadTimeout,omitempty"`
-	HttpReadHeaderTimeout  util.Duration    `json:"httpReadHeaderTimeout,omitempty"`
-	HttpWriteTimeout       util.Duration    `json:"httpWriteTimeout,omitempty"`
-	HttpIdleTimeout        util.Duration    `json:"httpIdleTimeout,omitempty"`
-	HttpMaxNumHeaders      int              `json:"httpMaxNumHeaders,omitempty"`
-	HttpMaxHeaderBytes     int              `json:"httpMaxHeaderBytes,omitempty"`
-	HttpMaxUrlLength       int              `json:"httpMaxUrlLength,omitempty"`
-	HttpMaxRequestSize     int              `json:"httpMaxRequestSize,omitempty"`
-	EventRetentionPeriod   util.Duration    `json:"eventRetentionPeriod,omitempty"`
-	AlertPollingInterval   util.Duration    `json:"alertPollingInterval,omitempty"`
-	RenderedWaitTimeout    util.Duration    `json:"renderedWaitTimeout,omitempty"`
-	RateLimit              *RateLimitConfig `json:"rateLimit,omitempty"`
-	TPMCAPaths             []string         `json:"tpmCAPaths,omitempty"`
-	HealthChecks           *HealthChecks    `json:"healthChecks,omitempty"`
+	Address                string        `json:"address,omitempty"`
+	AgentEndpointAddress   string        `json:"agentEndpointAddress,omitempty"`
+	CertStore              string        `json:"cert,omitempty"`
+	BaseUrl                string        `json:"baseUrl,omitempty"`
+	BaseAgentEndpointUrl   string        `json:"baseAgentEndpointUrl,omitempty"`
+	BaseUIUrl              string        `json:"baseUIUrl,omitempty"`
+	DisableTLS             bool          `json:"disableTLS,omitempty"`
+	SrvCertFile            string        `json:"srvCertificateFile,omitempty"`
+	SrvKeyFile             string        `json:"srvKeyFile,omitempty"`
+	ServerCertName         string        `json:"serverCertName,omitempty"`
+	ServerCertValidityDays int           `json:"serverCertValidityDays,omitempty"`
+	AltNames               []string      `json:"altNames,omitempty"`
+	LogLevel               string        `json:"logLevel,omitempty"`
+	HttpReadTimeout        util.Duration `json:"httpReadTimeout,omitempty"`
+	HttpReadHeaderTimeout  util.Duration `json:"httpReadHeaderTimeout,omitempty"`
+	HttpWriteTimeout       util.Duration `json:"httpWriteTimeout,omitempty"`
+	HttpIdleTimeout        util.Duration `json:"httpIdleTimeout,omitempty"`
+	HttpMaxNumHeaders      int           `json:"httpMaxNumHeaders,omitempty"`
+	HttpMaxHeaderBytes     int           `json:"httpMaxHeaderBytes,omitempty"`
+	HttpMaxUrlLength       int           `json:"httpMaxUrlLength,omitempty"`
+	HttpMaxRequestSize     int           `json:"httpMaxRequestSize,omitempty"`
+	EventRetentionPeriod   util.Duration `json:"eventRetentionPeriod,omitempty"`
+	// DeviceStatusHistoryRetentionPeriod is how long recorded device status transitions are kept.
+	DeviceStatusHistoryRetentionPeriod util.Duration    `json:"deviceStatusHistoryRetentionPeriod,omitempty"`
+	AlertPollingInterval               util.Duration    `json:"alertPollingInterval,omitempty"`
+	RenderedWaitTimeout                util.Duration    `json:"renderedWaitTimeout,omitempty"`
+	RateLimit                          *RateLimitConfig `json:"rateLimit,omitempty"`
+	TPMCAPaths                         []string         `json:"tpmCAPaths,omitempty"`
+	HealthChecks                       *HealthChecks    `json:"healthChecks,omitempty"`
 }
 
 // HealthChecks holds health check endpoint configuration.
@@ -241,6 +243,7 @@ type imageBuilderWorkerConfig struct {
 	LastSeenUpdateInterval   util.Duration        `json:"lastSeenUpdateInterval,omitempty"`
 	ImageBuilderTimeout      util.Duration        `json:"imageBuilderTimeout,omitempty"`
 	TimeoutCheckTaskInterval util.Duration        `json:"timeoutCheckTaskInterval,omitempty"`
+	TriggerCheckTaskInterval util.Duration        `json:"triggerCheckTaskInterval,omitempty"`
 	RPMRepoURL               string               `json:"rpmRepoUrl,omitempty"`
 	RPMRepoAdd               *bool                `json:"rpmRepoAdd,omitempty"`
 	RPMRepoEnable            string               `json:"rpmRepoEnable,omitempty"`
@@ -358,6 +361,7 @@ func NewDefaultImageBuilderWorkerConfig() *imageBuilderWorkerConfig {
 		LastSeenUpdateInterval:   util.Duration(30 * time.Second),
 		ImageBuilderTimeout:      util.Duration(3 * time.Minute),
 		TimeoutCheckTaskInterval: util.Duration(1 * time.Minute),
+		TriggerCheckTaskInterval: util.Duration(5 * time.Minute),
 		SBOM:                     NewDefaultSBOMConfig(),
 		RPMRepoURL:               "https://rpm.flightctl.io/flightctl-epel.repo",
 		DNFTimeout:               &dnfTimeout,
@@ -744,8 +748,9 @@ type periodicTaskConfig struct {
 
 type periodicTasksConfig struct {
 	ResourceSync periodicTaskConfig `json:"resourceSync,omitempty"`
-	// DependencySync overrides the interval for both the dependency-sync-git and
-	// dependency-sync-http periodic tasks (see DefaultDependencySyncTaskInterval).
+	// DependencySync overrides the interval for the dependency-sync-git,
+	// dependency-sync-http, dependency-sync-vault and dependency-sync-oci
+	// periodic tasks (see DefaultDependencySyncTaskInterval).
 	DependencySync periodicTaskConfig `json:"dependencySync,omitempty"`
 	// RepositoryTester overrides the interval for the repository-tester periodic task,
 	// which probes Repository resources and sets their Accessible condition.
@@ -793,8 +798,35 @@ type VulnerabilityConfig struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// SyncInterval is the interval between Trustify sync runs (e.g. "15m", "1h").
 	SyncInterval util.Duration `json:"syncInterval,omitempty"`
+	// Scanner selects the backend that produces findings. Allowed values: "trustify" (default), "osv".
+	Scanner string `json:"scanner,omitempty"`
 	// Trustify holds the Trustify connection details (periodic service only).
 	Trustify *TrustifyConfig `json:"trustify,omitempty"`
+	// OSV configures the offline scanner that matches stored SBOMs against imported OSV records.
+	OSV *OSVScannerConfig `json:"osv,omitempty"`
+}
+
+const (
+	// VulnerabilityScannerTrustify queries a Trustify server for findings.
+	VulnerabilityScannerTrustify = "trustify"
+	// VulnerabilityScannerOSV matches SBOMs stored by the image builder against a local OSV database.
+	VulnerabilityScannerOSV = "osv"
+)
+
+// ScannerType returns the configured scanner backend, defaulting to Trustify.
+func (v *VulnerabilityConfig) ScannerType() string {
+	if v == nil || v.Scanner == "" {
+		return VulnerabilityScannerTrustify
+	}
+	return v.Scanner
+}
+
+// OSVScannerConfig configures the offline OSV scanner.
+type OSVScannerConfig struct {
+	// DatabaseDir is the directory holding the imported OSV records: JSON files containing a
+	// single record or an array of records, and ZIP archives of such files as published by
+	// osv.dev. The directory is re-read whenever its contents change.
+	DatabaseDir string `json:"databaseDir,omitempty"`
 }
 
 // TrustifyConfig holds Trustify API connection and authentication details.
@@ -978,26 +1010,27 @@ func NewDefault(opts ...ConfigOption) *Config {
 			MigrationPassword: "adminpass",
 		},
 		Service: &svcConfig{
-			Address:                ":3443",
-			AgentEndpointAddress:   ":7443",
-			CertStore:              CertificateDir(),
-			BaseUrl:                "https://localhost:3443",
-			BaseAgentEndpointUrl:   "https://localhost:7443",
-			DisableTLS:             false,
-			ServerCertName:         "server",
-			ServerCertValidityDays: 730,
-			LogLevel:               "info",
-			HttpReadTimeout:        util.Duration(5 * time.Minute),
-			HttpReadHeaderTimeout:  util.Duration(5 * time.Minute),
-			HttpWriteTimeout:       util.Duration(5 * time.Minute),
-			HttpIdleTimeout:        util.Duration(5 * time.Minute),
-			HttpMaxNumHeaders:      32,
-			HttpMaxHeaderBytes:     32 * 1024, // 32KB
-			HttpMaxUrlLength:       2000,
-			HttpMaxRequestSize:     50 * 1024 * 1024,                  // 50MB
-			EventRetentionPeriod:   util.Duration(7 * 24 * time.Hour), // 1 week
-			AlertPollingInterval:   util.Duration(1 * time.Minute),
-			RenderedWaitTimeout:    util.Duration(2 * time.Minute),
+			Address:                            ":3443",
+			AgentEndpointAddress:               ":7443",
+			CertStore:                          CertificateDir(),
+			BaseUrl:                            "https://localhost:3443",
+			BaseAgentEndpointUrl:               "https://localhost:7443",
+			DisableTLS:                         false,
+			ServerCertName:                     "server",
+			ServerCertValidityDays:             730,
+			LogLevel:                           "info",
+			HttpReadTimeout:                    util.Duration(5 * time.Minute),
+			HttpReadHeaderTimeout:              util.Duration(5 * time.Minute),
+			HttpWriteTimeout:                   util.Duration(5 * time.Minute),
+			HttpIdleTimeout:                    util.Duration(5 * time.Minute),
+			HttpMaxNumHeaders:                  32,
+			HttpMaxHeaderBytes:                 32 * 1024, // 32KB
+			HttpMaxUrlLength:                   2000,
+			HttpMaxRequestSize:                 50 * 1024 * 1024,                   // 50MB
+			EventRetentionPeriod:               util.Duration(7 * 24 * time.Hour),  // 1 week
+			DeviceStatusHistoryRetentionPeriod: util.Duration(30 * 24 * time.Hour), // 30 days
+			AlertPollingInterval:               util.Duration(1 * time.Minute),
+			RenderedWaitTimeout:                util.Duration(2 * time.Minute),
 			HealthChecks: &HealthChecks{
 				Enabled:          true,
 				ReadinessPath:    "/readyz",
@@ -1207,9 +1240,12 @@ func applyVulnerabilityReportingEnvVarOverrides(c *Config) {
 	trustifyOIDCIssuerURL := os.Getenv("FLIGHTCTL_VULNERABILITY_REPORTING_TRUSTIFY_OIDC_ISSUER_URL")
 	trustifyClientID := os.Getenv("FLIGHTCTL_VULNERABILITY_REPORTING_TRUSTIFY_CLIENT_ID")
 	trustifyClientSecret := os.Getenv("FLIGHTCTL_VULNERABILITY_REPORTING_TRUSTIFY_CLIENT_SECRET")
+	scanner := os.Getenv("FLIGHTCTL_VULNERABILITY_REPORTING_SCANNER")
+	osvDatabaseDir := os.Getenv("FLIGHTCTL_VULNERABILITY_REPORTING_OSV_DATABASE_DIR")
 
 	if enabled == "" && syncInterval == "" && trustifyEndpoint == "" && trustifyAuthMode == "" &&
-		trustifyOIDCIssuerURL == "" && trustifyClientID == "" && trustifyClientSecret == "" {
+		trustifyOIDCIssuerURL == "" && trustifyClientID == "" && trustifyClientSecret == "" &&
+		scanner == "" && osvDatabaseDir == "" {
 		return
 	}
 
@@ -1236,6 +1272,16 @@ func applyVulnerabilityReportingEnvVarOverrides(c *Config) {
 		}
 	}
 
+	if scanner != "" {
+		c.VulnerabilityReporting.Scanner = scanner
+	}
+	if osvDatabaseDir != "" {
+		if c.VulnerabilityReporting.OSV == nil {
+			c.VulnerabilityReporting.OSV = &OSVScannerConfig{}
+		}
+		c.VulnerabilityReporting.OSV.DatabaseDir = osvDatabaseDir
+	}
+
 	applyVulnerabilityReportingTrustifyEnvVarOverrides(c.VulnerabilityReporting, trustifyEndpoint, trustifyAuthMode, trustifyOIDCIssuerURL, trustifyClientID, trustifyClientSecret)
 }
 
@@ -1494,6 +1540,17 @@ func Validate(cfg *Config) error {
 		if time.Duration(cfg.ImageBuilderWorker.TimeoutCheckTaskInterval) <= 0 {
 			return fmt.Errorf("imageBuilderWorker.timeoutCheckTaskInterval must be greater than 0")
 		}
+		if time.Duration(cfg.ImageBuilderWorker.TriggerCheckTaskInterval) <= 0 {
+			return fmt.Errorf("imageBuilderWorker.triggerCheckTaskInterval must be greater than 0")
+		}
+	}
+
+	if v := cfg.VulnerabilityReporting; v != nil && v.Enabled {
+		switch v.ScannerType() {
+		case VulnerabilityScannerTrustify, VulnerabilityScannerOSV:
+		default:
+			return fmt.Errorf("invalid vulnerabilityReporting.scanner value: %s", v.Scanner)
+		}
 	}
 
 	// Validate OIDC and OAuth2 provider role assignments
@@ -33,9 +33,15 @@ type AppConsoleSession struct {
 	OrgId      uuid.UUID
 	DeviceName string
 	AppName    string
-	SendCh     chan []byte
-	RecvCh     chan []byte
-	ProtocolCh chan string
+	// Port is the device-local TCP port of a port-forward session; zero for application consoles.
+	Port int32
+	// FilePath and FileTransfer are the device path and direction of a file transfer session;
+	// empty otherwise.
+	FilePath     string
+	FileTransfer string
+	SendCh       chan []byte
+	RecvCh       chan []byte
+	ProtocolCh   chan string
 	// ErrCh carries a session-level failure reported by the agent (e.g. the requested
 	// application does not exist). The WebSocket handler must close the client
 	// connection with a distinguishable close code/reason instead of relaying this as
@@ -125,6 +131,10 @@ func (m *AppConsoleSessionManager) modifyAnnotations(ctx context.Context, orgId
 			if errors.As(err, &dupErr) {
 				return domain.StatusConflict(dupErr.Error())
 			}
+			var limitErr *tooManySessionsError
+			if errors.As(err, &limitErr) {
+				return domain.StatusConflict(limitErr.Error())
+			}
 			return domain.StatusInternalServerError(err.Error())
 		}
 		if newValue == value {
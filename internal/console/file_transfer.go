@@ -0,0 +1,65 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxFileTransferSessionsPerDevice bounds the number of concurrent file transfers recorded
+// in a single device's remote-session annotation.
+const MaxFileTransferSessionsPerDevice = 4
+
+// addFileTransferSession returns an updater closure that appends a new file transfer session
+// entry, up to MaxFileTransferSessionsPerDevice.
+func addFileTransferSession(sessionID, filePath, direction string) func(string) (string, error) {
+	return func(existing string) (string, error) {
+		var sessions []domain.DeviceRemoteSession
+		if existing != "" {
+			if err := json.Unmarshal([]byte(existing), &sessions); err != nil {
+				return "", err
+			}
+		}
+		active := lo.CountBy(sessions, func(s domain.DeviceRemoteSession) bool { return s.FilePath != "" })
+		if active >= MaxFileTransferSessionsPerDevice {
+			return "", &tooManySessionsError{kind: "file transfer", max: MaxFileTransferSessionsPerDevice}
+		}
+		sessions = append(sessions, domain.DeviceRemoteSession{
+			SessionID:    sessionID,
+			FilePath:     filePath,
+			FileTransfer: direction,
+		})
+		b, err := json.Marshal(&sessions)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+// StartFileTransferSession validates the request, records the session in the device's
+// remote-session annotation and registers it so the agent's Stream() call can rendezvous
+// with it. Whether the path may be accessed is decided by the agent, which reports a refusal
+// before the client's connection is upgraded.
+func (m *AppConsoleSessionManager) StartFileTransferSession(ctx context.Context, orgId uuid.UUID, deviceName, filePath, direction string) (*AppConsoleSession, domain.Status) {
+	if direction != filetransfer.DirectionDownload && direction != filetransfer.DirectionUpload {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("invalid direction %q: must be %q or %q", direction, filetransfer.DirectionDownload, filetransfer.DirectionUpload))
+	}
+	if !path.IsAbs(filePath) || path.Clean(filePath) != filePath {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("invalid path %q: must be an absolute, clean path", filePath))
+	}
+	session := newTunnelSession(orgId, deviceName)
+	session.FilePath = filePath
+	session.FileTransfer = direction
+	if status := m.startTunnelSession(ctx, session, addFileTransferSession(session.UUID, filePath, direction)); status.Code != http.StatusOK {
+		return nil, status
+	}
+	return session, domain.StatusOK()
+}
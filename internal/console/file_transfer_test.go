@@ -0,0 +1,53 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppConsoleSessionManager_StartFileTransferSession_InvalidRequest(t *testing.T) {
+	svc := &mockAppDeviceService{}
+	mgr := newTestAppManager(svc, &mockAppSessionRegistration{}, &mockConsoleEventNotifier{})
+
+	for _, tt := range []struct{ path, direction string }{
+		{"/var/log/messages", "sideways"},
+		{"var/log/messages", filetransfer.DirectionDownload},
+		{"/var/log/../../etc/shadow", filetransfer.DirectionUpload},
+	} {
+		session, status := mgr.StartFileTransferSession(context.Background(), uuid.New(), "dev1", tt.path, tt.direction)
+		assert.Nil(t, session)
+		assert.Equal(t, int32(http.StatusBadRequest), status.Code, "path %q direction %q", tt.path, tt.direction)
+	}
+	svc.AssertNotCalled(t, "GetDevice")
+}
+
+func TestAddFileTransferSession_LimitIgnoresOtherSessionTypes(t *testing.T) {
+	entries := []string{`{"sessionID":"console","appName":"app1","consoleType":"serial"}`, `{"sessionID":"pf","port":8080}`}
+	for i := 0; i < MaxFileTransferSessionsPerDevice-1; i++ {
+		entries = append(entries, fmt.Sprintf(`{"sessionID":"ft-%d","filePath":"/var/tmp/f%d","fileTransfer":"download"}`, i, i))
+	}
+	existing := "[" + strings.Join(entries, ",") + "]"
+
+	result, err := addFileTransferSession("new-id", "/var/tmp/new", filetransfer.DirectionUpload)(existing)
+	require.NoError(t, err)
+	var sessions []domain.DeviceRemoteSession
+	require.NoError(t, json.Unmarshal([]byte(result), &sessions))
+	require.Len(t, sessions, len(entries)+1)
+	last := sessions[len(sessions)-1]
+	assert.Equal(t, domain.DeviceRemoteSession{SessionID: "new-id", FilePath: "/var/tmp/new", FileTransfer: filetransfer.DirectionUpload}, last)
+
+	_, err = addFileTransferSession("one-too-many", "/var/tmp/x", filetransfer.DirectionDownload)(result)
+	var limitErr *tooManySessionsError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Contains(t, err.Error(), "file transfer")
+}
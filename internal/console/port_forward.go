@@ -0,0 +1,60 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MaxPortForwardSessionsPerDevice bounds the number of concurrent port-forward sessions
+// recorded in a single device's remote-session annotation. Every forwarded TCP connection
+// is its own session, so without a bound a misbehaving client could grow the annotation
+// (and the rendered device the agent polls) without limit.
+const MaxPortForwardSessionsPerDevice = 32
+
+// addPortForwardSession returns an updater closure that appends a new port-forward session
+// entry. Unlike application consoles, any number of sessions may target the same port (one
+// per forwarded TCP connection), up to MaxPortForwardSessionsPerDevice.
+func addPortForwardSession(sessionID string, port int32) func(string) (string, error) {
+	return func(existing string) (string, error) {
+		var sessions []domain.DeviceRemoteSession
+		if existing != "" {
+			if err := json.Unmarshal([]byte(existing), &sessions); err != nil {
+				return "", err
+			}
+		}
+		active := lo.CountBy(sessions, func(s domain.DeviceRemoteSession) bool { return s.Port != 0 })
+		if active >= MaxPortForwardSessionsPerDevice {
+			return "", &tooManySessionsError{kind: "port-forward", max: MaxPortForwardSessionsPerDevice}
+		}
+		sessions = append(sessions, domain.DeviceRemoteSession{
+			SessionID: sessionID,
+			Port:      port,
+		})
+		b, err := json.Marshal(&sessions)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+// StartPortForwardSession validates the target port, records the session in the device's
+// remote-session annotation and registers it so the agent's Stream() call can rendezvous
+// with it. The returned session is closed with CloseSession like an application console.
+func (m *AppConsoleSessionManager) StartPortForwardSession(ctx context.Context, orgId uuid.UUID, deviceName string, port int32) (*AppConsoleSession, domain.Status) {
+	if port < 1 || port > 65535 {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("invalid port %d: must be between 1 and 65535", port))
+	}
+	session := newTunnelSession(orgId, deviceName)
+	session.Port = port
+	if status := m.startTunnelSession(ctx, session, addPortForwardSession(session.UUID, port)); status.Code != http.StatusOK {
+		return nil, status
+	}
+	return session, domain.StatusOK()
+}
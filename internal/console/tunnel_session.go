@@ -0,0 +1,87 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// tooManySessionsError signals a 409 conflict when a device already has the maximum number
+// of active sessions of one kind.
+type tooManySessionsError struct {
+	kind string
+	max  int
+}
+
+func (e *tooManySessionsError) Error() string {
+	return fmt.Sprintf("too many active %s sessions (maximum %d)", e.kind, e.max)
+}
+
+// newTunnelSession returns a session for a raw byte-stream tunnel (port forwarding or file
+// transfer). Unlike application consoles, these sessions are single-shot, so the protocol
+// and error channels only ever carry one value.
+func newTunnelSession(orgId uuid.UUID, deviceName string) *AppConsoleSession {
+	return &AppConsoleSession{
+		UUID:       uuid.New().String(),
+		OrgId:      orgId,
+		DeviceName: deviceName,
+		SendCh:     make(chan []byte, ChannelSize),
+		RecvCh:     make(chan []byte, ChannelSize),
+		ProtocolCh: make(chan string, 1),
+		ErrCh:      make(chan SessionFailure, 1),
+	}
+}
+
+// startTunnelSession applies the same device guards as application consoles, records the
+// session in the device's remote-session annotation via addSession and registers it so the
+// agent's Stream() call can rendezvous with it. The annotation is rolled back on failure.
+func (m *AppConsoleSessionManager) startTunnelSession(ctx context.Context, session *AppConsoleSession, addSession func(string) (string, error)) domain.Status {
+	orgId, deviceName := session.OrgId, session.DeviceName
+
+	device, status := m.svc.GetDevice(ctx, orgId, deviceName)
+	if status.Code != http.StatusOK {
+		return status
+	}
+	if device.Spec != nil && device.Spec.Decommissioning != nil {
+		return domain.StatusConflict("Device is decommissioned")
+	}
+	annotations := util.EnsureMap(lo.FromPtr(device.Metadata.Annotations))
+	if waitingValue, exists := annotations[domain.DeviceAnnotationAwaitingReconnect]; exists && waitingValue == "true" {
+		return domain.StatusConflict("Device is awaiting reconnection after restore")
+	}
+	if pausedValue, exists := annotations[domain.DeviceAnnotationConflictPaused]; exists && pausedValue == "true" {
+		return domain.StatusConflict("Device is paused due to conflicts")
+	}
+
+	if status := m.modifyAnnotations(ctx, orgId, deviceName, true, addSession); status.Code != http.StatusOK {
+		// Derive from ctx via WithoutCancel (not context.Background()) so the rollback keeps the
+		// request's tracing span and other values but isn't cancelled by a client disconnect.
+		rollbackCtx, rollbackCancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer rollbackCancel()
+		if annStatus := m.modifyAnnotations(rollbackCtx, orgId, deviceName, false, removeAppSession(session.UUID)); annStatus.Code != http.StatusOK {
+			m.log.Errorf("Failed to roll back annotation for device %s after failed session start: %v", deviceName, annStatus)
+		}
+		return status
+	}
+
+	if err := m.sessionRegistration.StartSession(session); err != nil {
+		m.log.Errorf("Failed to start session %s for device %s: %v, rolling back annotation", session.UUID, deviceName, err)
+		rollbackCtx, rollbackCancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer rollbackCancel()
+		if annStatus := m.modifyAnnotations(rollbackCtx, orgId, deviceName, false, removeAppSession(session.UUID)); annStatus.Code != http.StatusOK {
+			m.log.Errorf("Failed to remove annotation from device %s: %v", deviceName, annStatus)
+		}
+		return domain.StatusInternalServerError(err.Error())
+	}
+
+	if err := m.notifier.NotifyConsole(ctx, orgId, deviceName); err != nil {
+		m.log.Warnf("failed to notify device %s of session %s: %v", deviceName, session.UUID, err)
+	}
+	return domain.StatusOK()
+}
@@ -38,6 +38,14 @@ const (
 	// OS image and configuration have been rolled back to the pre-update
 	// version and have been activated.
 	UpdateStateRetrying UpdateState = "Retrying"
+	// The agent has a pending update but an application on the device holds
+	// an inhibitor that blocks it. No changes have been made to the device's
+	// configuration yet.
+	UpdateStateInhibited UpdateState = "Inhibited"
+	// The agent has a pending update but the conditions of the device's update
+	// policy are not met. No changes have been made to the device's
+	// configuration yet.
+	UpdateStateWaitingForConditions UpdateState = "WaitingForConditions"
 )
 
 const (
@@ -72,6 +80,23 @@ const (
 	// unavailable (AppConsoleErrorCodeNotReady). Clients may retry.
 	AppConsoleNotReadyCloseCode = 4002
 
+	// PortForwardProtocol is the protocol the agent selects (via GrpcSelectedProtocolKey)
+	// once it has connected to the requested device-local TCP port of a port-forward session.
+	PortForwardProtocol = "tcp.portforward.flightctl.io"
+	// PortForwardErrorCodeNotAllowed is the machine-readable code the agent reports when the
+	// requested port is not in its port-forward allow-list.
+	PortForwardErrorCodeNotAllowed = "port-not-allowed"
+
+	// FileTransferProtocol is the protocol the agent selects (via GrpcSelectedProtocolKey)
+	// once it has validated the path of a `flightctl cp` session and is ready to exchange frames.
+	FileTransferProtocol = "file.transfer.flightctl.io"
+	// FileTransferErrorCodePathNotAllowed is the machine-readable code the agent reports when the
+	// requested path is outside its file-transfer allow-list.
+	FileTransferErrorCodePathNotAllowed = "path-not-allowed"
+	// FileTransferErrorCodeTooLarge is the machine-readable code the agent reports when the
+	// requested file exceeds its file-transfer size limit.
+	FileTransferErrorCodeTooLarge = "file-too-large"
+
 	// Tasks
 	TaskQueue           = "task-queue"
 	ImageBuildTaskQueue = "imagebuild-queue"
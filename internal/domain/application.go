@@ -29,6 +29,7 @@ const (
 
 type ApplicationContent = v1beta1.ApplicationContent
 type ApplicationEnvVars = v1beta1.ApplicationEnvVars
+type ApplicationEnvFromSource = v1beta1.ApplicationEnvFromSource
 type ApplicationPort = v1beta1.ApplicationPort
 type ApplicationResources = v1beta1.ApplicationResources
 type ApplicationResourceLimits = v1beta1.ApplicationResourceLimits
@@ -0,0 +1,37 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type BulkOperation = v1beta1.BulkOperation
+type BulkOperationList = v1beta1.BulkOperationList
+type BulkOperationSpec = v1beta1.BulkOperationSpec
+type BulkOperationStatus = v1beta1.BulkOperationStatus
+type BulkOperationMetadataPatch = v1beta1.BulkOperationMetadataPatch
+type BulkOperationDeviceFailure = v1beta1.BulkOperationDeviceFailure
+
+// ========== Enum Types ==========
+
+type BulkOperationType = v1beta1.BulkOperationType
+type BulkOperationPhase = v1beta1.BulkOperationPhase
+
+// ========== Enum Constants ==========
+
+const (
+	BulkOperationTypePatchLabels        = v1beta1.BulkOperationTypePatchLabels
+	BulkOperationTypePatchAnnotations   = v1beta1.BulkOperationTypePatchAnnotations
+	BulkOperationTypeSetOwner           = v1beta1.BulkOperationTypeSetOwner
+	BulkOperationTypeDecommission       = v1beta1.BulkOperationTypeDecommission
+	BulkOperationTypeStartApplication   = v1beta1.BulkOperationTypeStartApplication
+	BulkOperationTypeStopApplication    = v1beta1.BulkOperationTypeStopApplication
+	BulkOperationTypeRestartApplication = v1beta1.BulkOperationTypeRestartApplication
+	BulkOperationTypeResume             = v1beta1.BulkOperationTypeResume
+)
+
+const (
+	BulkOperationPhasePending   = v1beta1.BulkOperationPhasePending
+	BulkOperationPhaseRunning   = v1beta1.BulkOperationPhaseRunning
+	BulkOperationPhaseSucceeded = v1beta1.BulkOperationPhaseSucceeded
+	BulkOperationPhaseFailed    = v1beta1.BulkOperationPhaseFailed
+)
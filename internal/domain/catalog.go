@@ -9,6 +9,13 @@ type Catalog = v1alpha1.Catalog
 type CatalogList = v1alpha1.CatalogList
 type CatalogSpec = v1alpha1.CatalogSpec
 type CatalogStatus = v1alpha1.CatalogStatus
+type CatalogSource = v1alpha1.CatalogSource
+type CatalogSyncConflict = v1alpha1.CatalogSyncConflict
+
+const (
+	CatalogIndexMediaType           = v1alpha1.CatalogIndexMediaType
+	CatalogIndexSignatureAnnotation = v1alpha1.CatalogIndexSignatureAnnotation
+)
 
 type CatalogItem = v1alpha1.CatalogItem
 type CatalogItemMeta = v1alpha1.CatalogItemMeta
@@ -23,6 +23,8 @@ const (
 
 // Condition type constants
 const (
+	ConditionTypeCatalogAccessible                    = v1beta1.ConditionTypeCatalogAccessible
+	ConditionTypeCatalogSynced                        = v1beta1.ConditionTypeCatalogSynced
 	ConditionTypeCertificateSigningRequestApproved    = v1beta1.ConditionTypeCertificateSigningRequestApproved
 	ConditionTypeCertificateSigningRequestDenied      = v1beta1.ConditionTypeCertificateSigningRequestDenied
 	ConditionTypeCertificateSigningRequestFailed      = v1beta1.ConditionTypeCertificateSigningRequestFailed
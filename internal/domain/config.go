@@ -9,6 +9,9 @@ type GitConfigProviderSpec = v1beta1.GitConfigProviderSpec
 type HttpConfigProviderSpec = v1beta1.HttpConfigProviderSpec
 type InlineConfigProviderSpec = v1beta1.InlineConfigProviderSpec
 type KubernetesSecretProviderSpec = v1beta1.KubernetesSecretProviderSpec
+type VaultConfigProviderSpec = v1beta1.VaultConfigProviderSpec
+type OciConfigProviderSpec = v1beta1.OciConfigProviderSpec
+type SecretConfigProviderSpec = v1beta1.SecretConfigProviderSpec
 
 // ConfigProviderType discriminator type
 type ConfigProviderType = v1beta1.ConfigProviderType
@@ -18,6 +21,9 @@ const (
 	HttpConfigProviderType       = v1beta1.HttpConfigProviderType
 	InlineConfigProviderType     = v1beta1.InlineConfigProviderType
 	KubernetesSecretProviderType = v1beta1.KubernetesSecretProviderType
+	VaultConfigProviderType      = v1beta1.VaultConfigProviderType
+	OciConfigProviderType        = v1beta1.OciConfigProviderType
+	SecretConfigProviderType     = v1beta1.SecretConfigProviderType
 )
 
 // ========== File Types ==========
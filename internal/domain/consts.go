@@ -31,6 +31,10 @@ const (
 	DeviceAPIVersion = v1beta1.DeviceAPIVersion
 	DeviceKind       = v1beta1.DeviceKind
 	DeviceListKind   = v1beta1.DeviceListKind
+
+	DeviceStatusHistoryListKind   = v1beta1.DeviceStatusHistoryListKind
+	DeviceRenderedVersionKind     = v1beta1.DeviceRenderedVersionKind
+	DeviceRenderedVersionListKind = v1beta1.DeviceRenderedVersionListKind
 )
 
 // Device annotation keys
@@ -40,6 +44,7 @@ const (
 	DeviceAnnotationRenderedVersion           = v1beta1.DeviceAnnotationRenderedVersion
 	DeviceAnnotationAwaitingReconnect         = v1beta1.DeviceAnnotationAwaitingReconnect
 	DeviceAnnotationConflictPaused            = v1beta1.DeviceAnnotationConflictPaused
+	DeviceAnnotationRollbackVersion           = v1beta1.DeviceAnnotationRollbackVersion
 	DeviceAnnotationTemplateVersion           = v1beta1.DeviceAnnotationTemplateVersion
 	DeviceAnnotationRenderedTemplateVersion   = v1beta1.DeviceAnnotationRenderedTemplateVersion
 	DeviceAnnotationRenderedSpecHash          = v1beta1.DeviceAnnotationRenderedSpecHash
@@ -78,6 +83,8 @@ const (
 	FleetAnnotationLastBatchCompletionReport   = v1beta1.FleetAnnotationLastBatchCompletionReport
 	FleetAnnotationDeviceSelectionConfigDigest = v1beta1.FleetAnnotationDeviceSelectionConfigDigest
 	FleetAnnotationApplicationLifecycle        = v1beta1.FleetAnnotationApplicationLifecycle
+	FleetAnnotationAutoUpgradePending          = v1beta1.FleetAnnotationAutoUpgradePending
+	FleetAnnotationAutoUpgradeApproved         = v1beta1.FleetAnnotationAutoUpgradeApproved
 )
 
 // ========== Event ==========
@@ -98,6 +105,22 @@ const (
 	RepositoryListKind   = v1beta1.RepositoryListKind
 )
 
+// ========== Secret ==========
+
+const (
+	SecretAPIVersion = v1beta1.SecretAPIVersion
+	SecretKind       = v1beta1.SecretKind
+	SecretListKind   = v1beta1.SecretListKind
+)
+
+// ========== BulkOperation ==========
+
+const (
+	BulkOperationAPIVersion = v1beta1.BulkOperationAPIVersion
+	BulkOperationKind       = v1beta1.BulkOperationKind
+	BulkOperationListKind   = v1beta1.BulkOperationListKind
+)
+
 // ========== AuthProvider ==========
 
 const (
@@ -191,15 +214,17 @@ const (
 type UpdateState = v1beta1.UpdateState
 
 const (
-	UpdateStatePreparing      = v1beta1.UpdateStatePreparing
-	UpdateStateReadyToUpdate  = v1beta1.UpdateStateReadyToUpdate
-	UpdateStateApplyingUpdate = v1beta1.UpdateStateApplyingUpdate
-	UpdateStateRebooting      = v1beta1.UpdateStateRebooting
-	UpdateStateUpdated        = v1beta1.UpdateStateUpdated
-	UpdateStateCanceled       = v1beta1.UpdateStateCanceled
-	UpdateStateError          = v1beta1.UpdateStateError
-	UpdateStateRollingBack    = v1beta1.UpdateStateRollingBack
-	UpdateStateRetrying       = v1beta1.UpdateStateRetrying
+	UpdateStatePreparing            = v1beta1.UpdateStatePreparing
+	UpdateStateReadyToUpdate        = v1beta1.UpdateStateReadyToUpdate
+	UpdateStateApplyingUpdate       = v1beta1.UpdateStateApplyingUpdate
+	UpdateStateRebooting            = v1beta1.UpdateStateRebooting
+	UpdateStateUpdated              = v1beta1.UpdateStateUpdated
+	UpdateStateCanceled             = v1beta1.UpdateStateCanceled
+	UpdateStateError                = v1beta1.UpdateStateError
+	UpdateStateRollingBack          = v1beta1.UpdateStateRollingBack
+	UpdateStateRetrying             = v1beta1.UpdateStateRetrying
+	UpdateStateInhibited            = v1beta1.UpdateStateInhibited
+	UpdateStateWaitingForConditions = v1beta1.UpdateStateWaitingForConditions
 )
 
 // ========== Decommission State ==========
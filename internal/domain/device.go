@@ -25,21 +25,31 @@ type DeviceLifecycleStatus = v1beta1.DeviceLifecycleStatus
 type DeviceUpdatedStatus = v1beta1.DeviceUpdatedStatus
 type DeviceResourceStatus = v1beta1.DeviceResourceStatus
 type DeviceLastSeen = v1beta1.DeviceLastSeen
+type DeviceStatusHistoryEntry = v1beta1.DeviceStatusHistoryEntry
+type DeviceStatusHistoryList = v1beta1.DeviceStatusHistoryList
+type DeviceStatusHistoryField = v1beta1.DeviceStatusHistoryField
+type DeviceRenderedVersion = v1beta1.DeviceRenderedVersion
+type DeviceRenderedVersionList = v1beta1.DeviceRenderedVersionList
+type DeviceRenderedVersionDiff = v1beta1.DeviceRenderedVersionDiff
 type DeviceOsStatus = v1beta1.DeviceOsStatus
 type DeviceSystemInfo = v1beta1.DeviceSystemInfo
 type CustomDeviceInfo = v1beta1.CustomDeviceInfo
 type DeviceCapabilities = v1beta1.DeviceCapabilities
+type DeviceRegistryMirrorStatus = v1beta1.DeviceRegistryMirrorStatus
 
 // ========== Spec Subtypes ==========
 
 type DeviceOsSpec = v1beta1.DeviceOsSpec
 type DeviceUpdatePolicySpec = v1beta1.DeviceUpdatePolicySpec
+type RegistryMirror = v1beta1.RegistryMirror
+type RegistryMirrorLocation = v1beta1.RegistryMirrorLocation
 
 // ========== Operations ==========
 
 type DeviceRemoteSession = v1beta1.DeviceRemoteSession
 type DeviceConsole = v1beta1.DeviceConsole
 type DeviceDecommission = v1beta1.DeviceDecommission
+type DeviceRollback = v1beta1.DeviceRollback
 type DeviceResumeRequest = v1beta1.DeviceResumeRequest
 type DeviceResumeResponse = v1beta1.DeviceResumeResponse
 
@@ -93,10 +103,12 @@ const (
 // ========== Device Updated Status Constants ==========
 
 const (
+	DeviceUpdatedStatusDiverged  = v1beta1.DeviceUpdatedStatusDiverged
 	DeviceUpdatedStatusOutOfDate = v1beta1.DeviceUpdatedStatusOutOfDate
 	DeviceUpdatedStatusUnknown   = v1beta1.DeviceUpdatedStatusUnknown
 	DeviceUpdatedStatusUpToDate  = v1beta1.DeviceUpdatedStatusUpToDate
 	DeviceUpdatedStatusUpdating  = v1beta1.DeviceUpdatedStatusUpdating
+	DeviceUpdatedStatusWaiting   = v1beta1.DeviceUpdatedStatusWaiting
 )
 
 // ========== Device Resource Status Constants ==========
@@ -141,6 +153,16 @@ const (
 	DeviceLifecycleHookBeforeUpdating  = v1beta1.DeviceLifecycleHookBeforeUpdating
 )
 
+// ========== Device Status History Field Constants ==========
+
+const (
+	DeviceStatusHistoryFieldApplications = v1beta1.DeviceStatusHistoryFieldApplications
+	DeviceStatusHistoryFieldOs           = v1beta1.DeviceStatusHistoryFieldOs
+	DeviceStatusHistoryFieldResources    = v1beta1.DeviceStatusHistoryFieldResources
+	DeviceStatusHistoryFieldSummary      = v1beta1.DeviceStatusHistoryFieldSummary
+	DeviceStatusHistoryFieldUpdated      = v1beta1.DeviceStatusHistoryFieldUpdated
+)
+
 // ========== Event Details Types ==========
 
 type DeviceMultipleOwnersDetectedDetails = v1beta1.DeviceMultipleOwnersDetectedDetails
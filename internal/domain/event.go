@@ -42,6 +42,8 @@ const (
 	EventReasonDeviceCPUWarning                = v1beta1.EventReasonDeviceCPUWarning
 	EventReasonDeviceConflictPaused            = v1beta1.EventReasonDeviceConflictPaused
 	EventReasonDeviceConflictResolved          = v1beta1.EventReasonDeviceConflictResolved
+	EventReasonDeviceRolledBack                = v1beta1.EventReasonDeviceRolledBack
+	EventReasonDeviceRollbackCleared           = v1beta1.EventReasonDeviceRollbackCleared
 	EventReasonDeviceConnected                 = v1beta1.EventReasonDeviceConnected
 	EventReasonDeviceContentOutOfDate          = v1beta1.EventReasonDeviceContentOutOfDate
 	EventReasonDeviceContentUpToDate           = v1beta1.EventReasonDeviceContentUpToDate
@@ -100,6 +102,10 @@ const (
 	EventReasonEncryptionMigrationStarted      = v1beta1.EventReasonEncryptionMigrationStarted
 	EventReasonEncryptionMigrationCompleted    = v1beta1.EventReasonEncryptionMigrationCompleted
 	EventReasonApplicationLifecycleChanged     = v1beta1.EventReasonApplicationLifecycleChanged
+	EventReasonDevicePortForwardStarted        = v1beta1.EventReasonDevicePortForwardStarted
+	EventReasonDevicePortForwardStopped        = v1beta1.EventReasonDevicePortForwardStopped
+	EventReasonFleetAutoUpgradePending         = v1beta1.EventReasonFleetAutoUpgradePending
+	EventReasonFleetAutoUpgraded               = v1beta1.EventReasonFleetAutoUpgraded
 )
 
 // ========== Event Details Types ==========
@@ -122,6 +128,8 @@ type DependencySyncProbeFailedDetailsDetailType = v1beta1.DependencySyncProbeFai
 type ApplicationLifecycleChangedDetails = v1beta1.ApplicationLifecycleChangedDetails
 type ApplicationLifecycleChangedDetailsDetailType = v1beta1.ApplicationLifecycleChangedDetailsDetailType
 type ApplicationLifecycleChangedDetailsAction = v1beta1.ApplicationLifecycleChangedDetailsAction
+type DevicePortForwardDetails = v1beta1.DevicePortForwardDetails
+type DevicePortForwardDetailsDetailType = v1beta1.DevicePortForwardDetailsDetailType
 
 const (
 	InternalTaskFailed            = v1beta1.InternalTaskFailed
@@ -131,6 +139,7 @@ const (
 	ReferencedRepositoryUpdated   = v1beta1.ReferencedRepositoryUpdated
 	ResourceUpdated               = v1beta1.ResourceUpdated
 	ApplicationLifecycleChangedDT = v1beta1.ApplicationLifecycleChangedDetailType
+	DevicePortForwardDT           = v1beta1.DevicePortForwardDetailType
 
 	// Application lifecycle action constants
 	ApplicationLifecycleActionStop    = v1beta1.ApplicationLifecycleActionStop
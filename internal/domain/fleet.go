@@ -21,6 +21,12 @@ type Batch_Limit = v1beta1.Batch_Limit
 type BatchLimit1 = v1beta1.BatchLimit1
 type DisruptionBudget = v1beta1.DisruptionBudget
 
+// ========== Auto-Upgrade Types ==========
+
+type FleetAutoUpgradePolicy = v1beta1.FleetAutoUpgradePolicy
+
+const FleetAutoUpgradeCheckInterval = v1beta1.FleetAutoUpgradeCheckInterval
+
 // ========== Rollout Strategy Constants ==========
 
 const (
@@ -42,6 +48,8 @@ type FleetRolloutFailedDetailsDetailType = v1beta1.FleetRolloutFailedDetailsDeta
 type FleetRolloutStartedDetails = v1beta1.FleetRolloutStartedDetails
 type FleetRolloutStartedDetailsDetailType = v1beta1.FleetRolloutStartedDetailsDetailType
 type FleetRolloutStartedDetailsRolloutStrategy = v1beta1.FleetRolloutStartedDetailsRolloutStrategy
+type FleetAutoUpgradeDetails = v1beta1.FleetAutoUpgradeDetails
+type FleetAutoUpgradeDetailsDetailType = v1beta1.FleetAutoUpgradeDetailsDetailType
 
 const (
 	FleetRolloutBatchCompleted  = v1beta1.FleetRolloutBatchCompleted
@@ -52,6 +60,7 @@ const (
 	FleetRolloutStarted         = v1beta1.FleetRolloutStarted
 	FleetRolloutStrategyBatched = v1beta1.Batched
 	FleetRolloutStrategyNone    = v1beta1.None
+	FleetAutoUpgradeDT          = v1beta1.FleetAutoUpgradeDetailType
 
 	// Direct aliases for compatibility
 	Batched = v1beta1.Batched
@@ -5,8 +5,10 @@ import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 // ========== List Params ==========
 
 type ListAuthProvidersParams = v1beta1.ListAuthProvidersParams
+type ListBulkOperationsParams = v1beta1.ListBulkOperationsParams
 type ListCertificateSigningRequestsParams = v1beta1.ListCertificateSigningRequestsParams
 type ListDevicesParams = v1beta1.ListDevicesParams
+type ListDeviceRenderedVersionsParams = v1beta1.ListDeviceRenderedVersionsParams
 type ListEnrollmentRequestsParams = v1beta1.ListEnrollmentRequestsParams
 type ListEventsParams = v1beta1.ListEventsParams
 type ListFleetsParams = v1beta1.ListFleetsParams
@@ -14,11 +16,14 @@ type ListLabelsParams = v1beta1.ListLabelsParams
 type ListOrganizationsParams = v1beta1.ListOrganizationsParams
 type ListRepositoriesParams = v1beta1.ListRepositoriesParams
 type ListResourceSyncsParams = v1beta1.ListResourceSyncsParams
+type ListSecretsParams = v1beta1.ListSecretsParams
 type ListTemplateVersionsParams = v1beta1.ListTemplateVersionsParams
 
 // ========== Get Params ==========
 
 type GetEnrollmentConfigParams = v1beta1.GetEnrollmentConfigParams
+type GetDeviceStatusHistoryParams = v1beta1.GetDeviceStatusHistoryParams
+type GetDeviceRenderedVersionDiffParams = v1beta1.GetDeviceRenderedVersionDiffParams
 type GetFleetParams = v1beta1.GetFleetParams
 type GetRenderedDeviceParams = v1beta1.GetRenderedDeviceParams
 
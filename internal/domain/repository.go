@@ -14,6 +14,7 @@ type RepositoryStatus = v1beta1.RepositoryStatus
 type GitRepoSpec = v1beta1.GitRepoSpec
 type OciRepoSpec = v1beta1.OciRepoSpec
 type HttpRepoSpec = v1beta1.HttpRepoSpec
+type VaultRepoSpec = v1beta1.VaultRepoSpec
 type SshConfig = v1beta1.SshConfig
 type HttpConfig = v1beta1.HttpConfig
 
@@ -30,6 +31,18 @@ const (
 	Docker = v1beta1.Docker
 )
 
+// ========== Vault Auth Types ==========
+
+type VaultAuth = v1beta1.VaultAuth
+type VaultAuthType = v1beta1.VaultAuthType
+type VaultTokenAuth = v1beta1.VaultTokenAuth
+type VaultAppRoleAuth = v1beta1.VaultAppRoleAuth
+
+const (
+	VaultAuthTypeToken   = v1beta1.VaultAuthTypeToken
+	VaultAuthTypeAppRole = v1beta1.VaultAuthTypeAppRole
+)
+
 // ========== OCI Repo Spec Types ==========
 
 type OciRepoSpecAccessMode = v1beta1.OciRepoSpecAccessMode
@@ -48,16 +61,19 @@ type RepoSpecType = v1beta1.RepoSpecType
 type GitRepoSpecType = v1beta1.GitRepoSpecType
 type HttpRepoSpecType = v1beta1.HttpRepoSpecType
 type OciRepoSpecType = v1beta1.OciRepoSpecType
+type VaultRepoSpecType = v1beta1.VaultRepoSpecType
 
 const (
-	RepoSpecTypeGit  = v1beta1.RepoSpecTypeGit
-	RepoSpecTypeHttp = v1beta1.RepoSpecTypeHttp
-	RepoSpecTypeOci  = v1beta1.RepoSpecTypeOci
+	RepoSpecTypeGit   = v1beta1.RepoSpecTypeGit
+	RepoSpecTypeHttp  = v1beta1.RepoSpecTypeHttp
+	RepoSpecTypeOci   = v1beta1.RepoSpecTypeOci
+	RepoSpecTypeVault = v1beta1.RepoSpecTypeVault
 
 	// Specific type constants for strict oneOf discrimination
-	GitRepoSpecTypeGit   = v1beta1.GitRepoSpecTypeGit
-	HttpRepoSpecTypeHttp = v1beta1.HttpRepoSpecTypeHttp
-	OciRepoSpecTypeOci   = v1beta1.OciRepoSpecTypeOci
+	GitRepoSpecTypeGit     = v1beta1.GitRepoSpecTypeGit
+	HttpRepoSpecTypeHttp   = v1beta1.HttpRepoSpecTypeHttp
+	OciRepoSpecTypeOci     = v1beta1.OciRepoSpecTypeOci
+	VaultRepoSpecTypeVault = v1beta1.VaultRepoSpecTypeVault
 )
 
 // OciRegistryCheckResult is the unified result of an OCI registry check (tag existence or image accessibility).
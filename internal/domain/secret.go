@@ -0,0 +1,10 @@
+package domain
+
+import v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+
+// ========== Resource Types ==========
+
+type Secret = v1beta1.Secret
+type SecretList = v1beta1.SecretList
+type SecretSpec = v1beta1.SecretSpec
+type SecretReference = v1beta1.SecretReference
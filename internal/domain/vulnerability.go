@@ -52,3 +52,32 @@ type ListVulnerabilitiesParams = v1alpha1.ListVulnerabilitiesParams
 type GetVulnerabilityImpactParams = v1alpha1.GetVulnerabilityImpactParams
 type GetVulnerabilityImpactParamsSortBy = v1alpha1.GetVulnerabilityImpactParamsSortBy
 type GetVulnerabilityImpactParamsOrder = v1alpha1.GetVulnerabilityImpactParamsOrder
+
+// ========== VulnerabilityException ==========
+
+const (
+	VulnerabilityExceptionKind     = v1alpha1.VulnerabilityExceptionKind
+	VulnerabilityExceptionListKind = v1alpha1.VulnerabilityExceptionListKind
+
+	VulnerabilityExceptionAnnotationOpenVEXDocument = v1alpha1.VulnerabilityExceptionAnnotationOpenVEXDocument
+	OpenVEXContextPrefix                            = v1alpha1.OpenVEXContextPrefix
+)
+
+type VulnerabilityException = v1alpha1.VulnerabilityException
+type VulnerabilityExceptionList = v1alpha1.VulnerabilityExceptionList
+type VulnerabilityExceptionSpec = v1alpha1.VulnerabilityExceptionSpec
+type VulnerabilityExceptionScope = v1alpha1.VulnerabilityExceptionScope
+type VulnerabilityExceptionCatalogItemRef = v1alpha1.VulnerabilityExceptionCatalogItemRef
+type VulnerabilityExceptionState = v1alpha1.VulnerabilityExceptionState
+type VexJustification = v1alpha1.VexJustification
+type ListVulnerabilityExceptionsParams = v1alpha1.ListVulnerabilityExceptionsParams
+
+const (
+	VulnerabilityExceptionStateNotAffected  = v1alpha1.VulnerabilityExceptionStateNotAffected
+	VulnerabilityExceptionStateRiskAccepted = v1alpha1.VulnerabilityExceptionStateRiskAccepted
+)
+
+type OpenVEXDocument = v1alpha1.OpenVEXDocument
+type OpenVEXStatement = v1alpha1.OpenVEXStatement
+type OpenVEXProduct = v1alpha1.OpenVEXProduct
+type OpenVEXVulnerability = v1alpha1.OpenVEXVulnerability
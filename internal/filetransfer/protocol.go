@@ -0,0 +1,127 @@
+// Package filetransfer defines the framing used by `flightctl cp` to copy a file between the
+// CLI and a device's agent over the remote-access tunnel. The tunnel preserves message
+// boundaries (each WebSocket message becomes one gRPC payload and vice versa), so every
+// message carries exactly one frame: a one-byte frame type followed by its payload.
+//
+// A download proceeds as:
+//
+//	CLI   -> agent  Header{Offset}                 resume offset (size of the local partial file)
+//	agent -> CLI    Header{Size, SHA256, Offset}   Offset is reset to 0 if the requested one is invalid
+//	agent -> CLI    Data...                        file content from Offset
+//	agent -> CLI    Done
+//
+// An upload proceeds as:
+//
+//	CLI   -> agent  Header{Size, SHA256}
+//	agent -> CLI    Header{Offset}                 bytes already staged on the device from an earlier attempt
+//	CLI   -> agent  Data...                        file content from Offset
+//	CLI   -> agent  Done
+//	agent -> CLI    Done                           the file was verified and moved into place
+//
+// Either side may send an Error frame instead of the next expected frame to abort.
+package filetransfer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// DirectionDownload copies a file from the device to the CLI.
+	DirectionDownload = "download"
+	// DirectionUpload copies a file from the CLI to the device.
+	DirectionUpload = "upload"
+
+	// ChunkSize is the maximum number of file bytes carried by a single Data frame.
+	ChunkSize = 32 * 1024
+	// PartialSuffix is appended to the destination path while a transfer is in progress.
+	PartialSuffix = ".flightctl-partial"
+)
+
+// FrameType identifies the kind of a frame.
+type FrameType byte
+
+const (
+	FrameHeader FrameType = 'H'
+	FrameData   FrameType = 'D'
+	FrameDone   FrameType = 'F'
+	FrameError  FrameType = 'E'
+)
+
+// Header describes the file being transferred and the offset the transfer resumes from.
+type Header struct {
+	Size   int64  `json:"size,omitempty"`
+	SHA256 string `json:"sha256,omitempty"`
+	Offset int64  `json:"offset,omitempty"`
+}
+
+// Encode returns a message holding a single frame of type t.
+func Encode(t FrameType, payload []byte) []byte {
+	msg := make([]byte, 0, len(payload)+1)
+	msg = append(msg, byte(t))
+	return append(msg, payload...)
+}
+
+// EncodeHeader returns a message holding a Header frame.
+func EncodeHeader(h Header) ([]byte, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	return Encode(FrameHeader, b), nil
+}
+
+// EncodeError returns a message holding an Error frame with the given message.
+func EncodeError(msg string) []byte {
+	return Encode(FrameError, []byte(msg))
+}
+
+// Decode splits a message into its frame type and payload.
+func Decode(msg []byte) (FrameType, []byte, error) {
+	if len(msg) == 0 {
+		return 0, nil, fmt.Errorf("empty file transfer frame")
+	}
+	t := FrameType(msg[0])
+	switch t {
+	case FrameHeader, FrameData, FrameDone, FrameError:
+		return t, msg[1:], nil
+	default:
+		return 0, nil, fmt.Errorf("unknown file transfer frame type %q", msg[0])
+	}
+}
+
+// DecodeHeader parses the payload of a Header frame.
+func DecodeHeader(payload []byte) (Header, error) {
+	var h Header
+	if err := json.Unmarshal(payload, &h); err != nil {
+		return Header{}, fmt.Errorf("invalid file transfer header: %w", err)
+	}
+	if h.Size < 0 || h.Offset < 0 {
+		return Header{}, fmt.Errorf("invalid file transfer header: negative size or offset")
+	}
+	return h, nil
+}
+
+// FileSHA256 returns the hex-encoded SHA-256 digest of the file at path and its size.
+func FileSHA256(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	return ReaderSHA256(f)
+}
+
+// ReaderSHA256 returns the hex-encoded SHA-256 digest of everything read from r and its size.
+func ReaderSHA256(r io.Reader) (string, int64, error) {
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
@@ -0,0 +1,56 @@
+package filetransfer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	msg, err := EncodeHeader(Header{Size: 10, SHA256: "abc", Offset: 4})
+	require.NoError(err)
+	ft, payload, err := Decode(msg)
+	require.NoError(err)
+	require.Equal(FrameHeader, ft)
+	h, err := DecodeHeader(payload)
+	require.NoError(err)
+	require.Equal(Header{Size: 10, SHA256: "abc", Offset: 4}, h)
+
+	ft, payload, err = Decode(Encode(FrameData, []byte("data")))
+	require.NoError(err)
+	require.Equal(FrameData, ft)
+	require.Equal("data", string(payload))
+
+	ft, payload, err = Decode(EncodeError("boom"))
+	require.NoError(err)
+	require.Equal(FrameError, ft)
+	require.Equal("boom", string(payload))
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	require := require.New(t)
+
+	_, _, err := Decode(nil)
+	require.Error(err)
+	_, _, err = Decode([]byte("Xpayload"))
+	require.Error(err)
+	_, err = DecodeHeader([]byte(`{"offset":-1}`))
+	require.Error(err)
+	_, err = DecodeHeader([]byte(`not json`))
+	require.Error(err)
+}
+
+func TestFileSHA256(t *testing.T) {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "f")
+	require.NoError(os.WriteFile(path, []byte("hello"), 0o600))
+	sum, size, err := FileSHA256(path)
+	require.NoError(err)
+	require.Equal(int64(5), size)
+	require.Equal("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", sum)
+}
@@ -31,6 +31,7 @@ var (
 	ErrInvalidTemplateVersion            = errors.New("device's templateVersion is not valid")
 	ErrNoRenderedVersion                 = errors.New("no rendered version for device")
 	ErrDecommission                      = errors.New("decommissioned device cannot be created or updated")
+	ErrNoActiveRollback                  = errors.New("device has no active rollback")
 	ErrDeviceAwaitingReconnect           = errors.New("device is awaiting reconnection after restore")
 	ErrDeviceConflictPaused              = errors.New("device is paused due to conflicts")
 	ErrOsTargetNotSupportedOnPackageMode = errors.New("OS target is not supported on package-mode devices")
@@ -39,6 +39,13 @@ type RepoSpecType = corev1beta1.RepoSpecType
 // Repository spec type constants
 const (
 	RepoSpecTypeOci = corev1beta1.RepoSpecTypeOci
+	RepoSpecTypeGit = corev1beta1.RepoSpecTypeGit
+)
+
+// File content encoding constants
+const (
+	EncodingPlain  = corev1beta1.EncodingPlain
+	EncodingBase64 = corev1beta1.EncodingBase64
 )
 
 // Access mode type
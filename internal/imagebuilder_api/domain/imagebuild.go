@@ -16,6 +16,13 @@ type ImageBuildSource = api.ImageBuildSource
 type ImageBuildDestination = api.ImageBuildDestination
 type ImageBuildBinding = api.ImageBuildBinding
 type ImageBuildUserConfiguration = api.ImageBuildUserConfiguration
+type ImageBuildCustomizations = api.ImageBuildCustomizations
+type ImageBuildPackageRepository = api.ImageBuildPackageRepository
+type ImageBuildFile = api.ImageBuildFile
+type ImageBuildDirectory = api.ImageBuildDirectory
+type ImageBuildEmbeddedImage = api.ImageBuildEmbeddedImage
+type ImageArchitecture = api.ImageArchitecture
+type ImageBuildTriggers = api.ImageBuildTriggers
 
 // ========== Status Types ==========
 
@@ -23,6 +30,10 @@ type ImageBuildStatus = api.ImageBuildStatus
 type ImageBuildCondition = api.ImageBuildCondition
 type ImageBuildConditionType = api.ImageBuildConditionType
 type ImageBuildConditionReason = api.ImageBuildConditionReason
+type ImageBuildArchitectureStatus = api.ImageBuildArchitectureStatus
+type ImageBuildTriggersStatus = api.ImageBuildTriggersStatus
+type ImageBuildTriggerRecord = api.ImageBuildTriggerRecord
+type ImageBuildTriggerReason = api.ImageBuildTriggerReason
 
 // ========== Binding Types ==========
 
@@ -41,6 +52,26 @@ const (
 	Late             = api.Late
 )
 
+// ========== Architecture Constants ==========
+
+const (
+	ImageArchitectureAmd64 = api.ImageArchitectureAmd64
+	ImageArchitectureArm64 = api.ImageArchitectureArm64
+
+	// DefaultImageArchitecture is the architecture images are built and exported for by default
+	DefaultImageArchitecture = ImageArchitectureAmd64
+)
+
+// ========== Trigger Constants ==========
+
+const (
+	ImageBuildTriggerReasonSourceImageChange = api.ImageBuildTriggerReasonSourceImageChange
+	ImageBuildTriggerReasonSchedule          = api.ImageBuildTriggerReasonSchedule
+
+	// DefaultImageBuildTriggerMinInterval is the default minimum time between new versions created by triggers
+	DefaultImageBuildTriggerMinInterval = "1h"
+)
+
 // ========== Condition Type Constants ==========
 
 const (
@@ -109,3 +140,29 @@ func FindImageBuildStatusCondition(conditions []ImageBuildCondition, conditionTy
 func SetImageBuildStatusCondition(conditions *[]ImageBuildCondition, newCondition ImageBuildCondition) bool {
 	return api.SetImageBuildStatusCondition(conditions, newCondition)
 }
+
+// ImageBuildArchitectures returns the architectures to build the image for, which default
+// to DefaultImageArchitecture
+func ImageBuildArchitectures(spec ImageBuildSpec) []ImageArchitecture {
+	if spec.Architectures == nil || len(*spec.Architectures) == 0 {
+		return []ImageArchitecture{DefaultImageArchitecture}
+	}
+	return *spec.Architectures
+}
+
+// SetImageBuildArchitectureStatus sets the status of the architecture of newStatus in statuses
+func SetImageBuildArchitectureStatus(statuses *[]ImageBuildArchitectureStatus, newStatus ImageBuildArchitectureStatus) {
+	for i := range *statuses {
+		if (*statuses)[i].Architecture == newStatus.Architecture {
+			(*statuses)[i] = newStatus
+			return
+		}
+	}
+	*statuses = append(*statuses, newStatus)
+}
+
+// ImageBuildArchitectureLogPrefix returns the prefix of the log lines of the build of an
+// architecture, by which the logs of an architecture are filtered
+func ImageBuildArchitectureLogPrefix(architecture ImageArchitecture) string {
+	return "[" + string(architecture) + "] "
+}
@@ -32,9 +32,13 @@ type ImageExportFormatPhase = api.ImageExportFormatPhase
 // ========== Export Format Constants ==========
 
 const (
+	ExportFormatTypeAMI                = api.ExportFormatTypeAMI
+	ExportFormatTypeAnacondaISO        = api.ExportFormatTypeAnacondaISO
 	ExportFormatTypeISO                = api.ExportFormatTypeISO
 	ExportFormatTypeQCOW2              = api.ExportFormatTypeQCOW2
 	ExportFormatTypeQCOW2DiskContainer = api.ExportFormatTypeQCOW2DiskContainer
+	ExportFormatTypeRaw                = api.ExportFormatTypeRaw
+	ExportFormatTypeVHD                = api.ExportFormatTypeVHD
 	ExportFormatTypeVMDK               = api.ExportFormatTypeVMDK
 )
 
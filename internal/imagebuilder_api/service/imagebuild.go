@@ -505,8 +505,10 @@ func (s *imageBuildService) NewVersion(ctx context.Context, orgId uuid.UUID, par
 		return nil, StatusBadRequest("name is required")
 	}
 
-	// Copy spec from parent, applying tag overrides.
+	// Copy spec from parent, applying tag overrides. Triggers are not copied, so that only
+	// one ImageBuild of a lineage creates new versions.
 	newSpec := parent.Spec
+	newSpec.Triggers = nil
 	if req.SourceImageTag != nil {
 		newSpec.Source.ImageTag = *req.SourceImageTag
 	}
@@ -660,6 +662,61 @@ func (s *imageBuildService) validate(ctx context.Context, orgId uuid.UUID, image
 		errs = append(errs, ValidatePublicKey(&imageBuild.Spec.UserConfiguration.Publickey, "spec.userConfiguration.publickey")...)
 	}
 
+	// Validate architectures if provided
+	if imageBuild.Spec.Architectures != nil {
+		errs = append(errs, ValidateArchitectures(*imageBuild.Spec.Architectures, "spec.architectures")...)
+	}
+
+	// Validate customizations if provided
+	if imageBuild.Spec.Customizations != nil {
+		errs = append(errs, ValidateCustomizations(imageBuild.Spec.Customizations, "spec.customizations")...)
+		for i, directory := range lo.FromPtr(imageBuild.Spec.Customizations.Directories) {
+			// Validate the repository of the directory exists and is Git type
+			repo, err := s.repositoryStore.Get(ctx, orgId, directory.Repository)
+			if errors.Is(err, flterrors.ErrResourceNotFound) {
+				errs = append(errs, fmt.Errorf("spec.customizations.directories[%d].repository: Repository %q not found", i, directory.Repository))
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to get repository %q: %w", directory.Repository, err)
+			} else {
+				specType, err := repo.Spec.Discriminator()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get repository spec type: %w", err)
+				}
+				if specType != string(domain.RepoSpecTypeGit) {
+					errs = append(errs, fmt.Errorf("spec.customizations.directories[%d].repository: Repository %q must be of type 'git', got %q", i, directory.Repository, specType))
+				}
+			}
+		}
+	}
+
+	// Validate embedded images if provided
+	if imageBuild.Spec.EmbeddedImages != nil {
+		errs = append(errs, ValidateEmbeddedImages(*imageBuild.Spec.EmbeddedImages, "spec.embeddedImages")...)
+		for i, embeddedImage := range *imageBuild.Spec.EmbeddedImages {
+			if embeddedImage.Repository == nil {
+				continue
+			}
+			// Validate the repository providing the credentials exists and is OCI type
+			repo, err := s.repositoryStore.Get(ctx, orgId, *embeddedImage.Repository)
+			if errors.Is(err, flterrors.ErrResourceNotFound) {
+				errs = append(errs, fmt.Errorf("spec.embeddedImages[%d].repository: Repository %q not found", i, *embeddedImage.Repository))
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to get repository %q: %w", *embeddedImage.Repository, err)
+			} else {
+				specType, err := repo.Spec.Discriminator()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get repository spec type: %w", err)
+				}
+				if specType != string(domain.RepoSpecTypeOci) {
+					errs = append(errs, fmt.Errorf("spec.embeddedImages[%d].repository: Repository %q must be of type 'oci', got %q", i, *embeddedImage.Repository, specType))
+				}
+			}
+		}
+	}
+
+	// Validate triggers if provided
+	errs = append(errs, ValidateTriggers(imageBuild.Spec.Triggers, "spec.triggers")...)
+
 	return errs, nil
 }
 
@@ -1359,6 +1359,26 @@ func TestNewVersionImageBuild_InheritsParentSpec(t *testing.T) {
 	require.Equal("output-registry", result.Spec.Destination.Repository)
 }
 
+func TestNewVersionImageBuild_DoesNotInheritTriggers(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	svc, _ := newTestImageBuildServiceWithRepos(ctx, orgId)
+
+	parent := newValidImageBuild("parent-triggers")
+	parent.Spec.Triggers = &api.ImageBuildTriggers{SourceImageChange: lo.ToPtr(true)}
+	_, status := svc.Create(ctx, orgId, parent)
+	require.Equal(int32(http.StatusCreated), statusCode(status))
+
+	req := api.ImageBuildNewVersionRequest{Name: "child-triggers"}
+	result, status := svc.NewVersion(ctx, orgId, "parent-triggers", req)
+
+	require.Equal(int32(http.StatusCreated), statusCode(status))
+	require.NotNil(result)
+	require.Nil(result.Spec.Triggers)
+}
+
 func TestNewVersionImageBuild_OverridesSourceTag(t *testing.T) {
 	require := require.New(t)
 	ctx := context.Background()
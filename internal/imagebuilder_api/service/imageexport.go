@@ -776,12 +776,19 @@ func (s *imageExportService) handleBlobResponse(ctx context.Context, resp *http.
 func getDownloadFilename(baseName string, format domain.ExportFormatType) string {
 	ext := ".bin"
 	switch format {
-	case domain.ExportFormatTypeISO:
+	case domain.ExportFormatTypeISO, domain.ExportFormatTypeAnacondaISO:
 		ext = ".iso"
 	case domain.ExportFormatTypeQCOW2, domain.ExportFormatTypeQCOW2DiskContainer:
 		ext = ".qcow2"
 	case domain.ExportFormatTypeVMDK:
 		ext = ".vmdk"
+	case domain.ExportFormatTypeRaw:
+		// Raw images are compressed by the worker
+		ext = ".raw.gz"
+	case domain.ExportFormatTypeAMI:
+		ext = ".raw"
+	case domain.ExportFormatTypeVHD:
+		ext = ".vhd"
 	}
 	return baseName + ext
 }
@@ -849,6 +856,10 @@ func (s *imageExportService) validate(ctx context.Context, orgId uuid.UUID, imag
 					if imageBuild.Spec.Destination.ImageTag == "" {
 						errs = append(errs, fmt.Errorf("spec.source.imageBuildRef: ImageBuild %q does not have a destination imageTag configured", source.ImageBuildRef))
 					}
+					// Validate that the ImageBuild builds the architecture to export
+					if architecture := imageExport.Spec.Architecture; architecture != nil && !lo.Contains(domain.ImageBuildArchitectures(imageBuild.Spec), *architecture) {
+						errs = append(errs, fmt.Errorf("spec.architecture: ImageBuild %q is not built for architecture %q", source.ImageBuildRef, *architecture))
+					}
 				}
 			}
 		default:
@@ -861,6 +872,10 @@ func (s *imageExportService) validate(ctx context.Context, orgId uuid.UUID, imag
 		errs = append(errs, errors.New("spec.format is required"))
 	}
 
+	if imageExport.Spec.Architecture != nil {
+		errs = append(errs, ValidateArchitecture(*imageExport.Spec.Architecture, "spec.architecture")...)
+	}
+
 	return errs, nil
 }
 
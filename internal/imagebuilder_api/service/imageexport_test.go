@@ -199,6 +199,43 @@ func TestCreateImageExportWithImageBuildRef(t *testing.T) {
 	require.NotNil(result)
 }
 
+func TestCreateImageExportWithArchitecture(t *testing.T) {
+	require := require.New(t)
+	_, _, imageBuildStore := newTestImageExportService()
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	repoStore := NewDummyRepositoryStore()
+	setupRepositoriesForImageExport(repoStore, ctx, orgId, false)
+	svc := NewImageExportService(NewDummyImageExportStore(), imageBuildStore, repoStore, nil, nil, nil, config.NewDefaultImageBuilderServiceConfig(), log.InitLogs())
+
+	imageBuild := newValidImageBuild("multi-arch-build")
+	imageBuild.Spec.Architectures = &[]api.ImageArchitecture{api.ImageArchitectureAmd64, api.ImageArchitectureArm64}
+	_, err := imageBuildStore.Create(ctx, orgId, &imageBuild)
+	require.NoError(err)
+	imageBuild = newValidImageBuild("amd64-build")
+	_, err = imageBuildStore.Create(ctx, orgId, &imageBuild)
+	require.NoError(err)
+
+	imageExport := newImageExportWithImageBuildSource("arm64-export", "multi-arch-build")
+	imageExport.Spec.Architecture = lo.ToPtr(api.ImageArchitectureArm64)
+	result, status := svc.Create(ctx, orgId, imageExport)
+	require.Equal(int32(http.StatusCreated), statusCode(status))
+	require.NotNil(result)
+
+	// The ImageBuild defaults to amd64 only
+	imageExport = newImageExportWithImageBuildSource("missing-arch-export", "amd64-build")
+	imageExport.Spec.Architecture = lo.ToPtr(api.ImageArchitectureArm64)
+	_, status = svc.Create(ctx, orgId, imageExport)
+	require.Equal(int32(http.StatusBadRequest), statusCode(status))
+	require.Contains(status.Message, "arm64")
+
+	imageExport = newImageExportWithImageBuildSource("unsupported-arch-export", "multi-arch-build")
+	imageExport.Spec.Architecture = lo.ToPtr(api.ImageArchitecture("s390x"))
+	_, status = svc.Create(ctx, orgId, imageExport)
+	require.Equal(int32(http.StatusBadRequest), statusCode(status))
+}
+
 func TestCreateImageExportWithNonexistentImageBuildRef(t *testing.T) {
 	require := require.New(t)
 	svc, _, _ := newTestImageExportService()
@@ -2020,3 +2057,25 @@ func TestDownloadImageExportWithBearerAuth(t *testing.T) {
 	require.NoError(err)
 	require.Equal(blobContent, readContent)
 }
+
+func TestGetDownloadFilename(t *testing.T) {
+	tests := []struct {
+		format   api.ExportFormatType
+		expected string
+	}{
+		{format: api.ExportFormatTypeQCOW2, expected: "my-build.qcow2"},
+		{format: api.ExportFormatTypeQCOW2DiskContainer, expected: "my-build.qcow2"},
+		{format: api.ExportFormatTypeVMDK, expected: "my-build.vmdk"},
+		{format: api.ExportFormatTypeISO, expected: "my-build.iso"},
+		{format: api.ExportFormatTypeAnacondaISO, expected: "my-build.iso"},
+		{format: api.ExportFormatTypeRaw, expected: "my-build.raw.gz"},
+		{format: api.ExportFormatTypeAMI, expected: "my-build.raw"},
+		{format: api.ExportFormatTypeVHD, expected: "my-build.vhd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			require.Equal(t, tt.expected, getDownloadFilename("my-build", tt.format))
+		})
+	}
+}
@@ -395,3 +395,23 @@ func TestImagePromotionDelete(t *testing.T) {
 	_, getStatus := svc.Get(ctx, orgId, "promo-delete")
 	require.Equal(int32(http.StatusNotFound), getStatus.Code)
 }
+
+func TestExportFormatToCatalogItemArtifactType(t *testing.T) {
+	for _, format := range []api.ExportFormatType{
+		api.ExportFormatTypeQCOW2,
+		api.ExportFormatTypeQCOW2DiskContainer,
+		api.ExportFormatTypeVMDK,
+		api.ExportFormatTypeISO,
+		api.ExportFormatTypeAnacondaISO,
+		api.ExportFormatTypeRaw,
+		api.ExportFormatTypeAMI,
+		api.ExportFormatTypeVHD,
+	} {
+		artifactType, err := exportFormatToCatalogItemArtifactType(format)
+		require.NoError(t, err, "format %s", format)
+		require.Equal(t, string(format), string(artifactType))
+	}
+
+	_, err := exportFormatToCatalogItemArtifactType("gce")
+	require.Error(t, err)
+}
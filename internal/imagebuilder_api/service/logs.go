@@ -159,3 +159,69 @@ func (r *redisLogStreamReader) Stream(ctx context.Context, w io.Writer) error {
 		}
 	}
 }
+
+// FilterArchitectureLogs returns the lines of the logs of an ImageBuild that belong to the
+// build of an architecture
+func FilterArchitectureLogs(logs string, architecture domain.ImageArchitecture) string {
+	prefix := domain.ImageBuildArchitectureLogPrefix(architecture)
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(logs, "\n") {
+		if strings.HasPrefix(line, prefix) {
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// architectureLogStreamReader filters the logs read by a LogStreamReader to the lines of the
+// build of an architecture
+type architectureLogStreamReader struct {
+	reader       LogStreamReader
+	architecture domain.ImageArchitecture
+}
+
+// NewArchitectureLogStreamReader returns a LogStreamReader that only reads the lines of the
+// logs of reader that belong to the build of an architecture
+func NewArchitectureLogStreamReader(reader LogStreamReader, architecture domain.ImageArchitecture) LogStreamReader {
+	return &architectureLogStreamReader{reader: reader, architecture: architecture}
+}
+
+// ReadAll reads all available logs of the architecture
+func (r *architectureLogStreamReader) ReadAll(ctx context.Context) (string, error) {
+	logs, err := r.reader.ReadAll(ctx)
+	if err != nil {
+		return "", err
+	}
+	return FilterArchitectureLogs(logs, r.architecture), nil
+}
+
+// Stream streams the logs of the architecture to the writer
+func (r *architectureLogStreamReader) Stream(ctx context.Context, w io.Writer) error {
+	return r.reader.Stream(ctx, &architectureLogWriter{w: w, architecture: r.architecture})
+}
+
+// architectureLogWriter writes the lines of the build of an architecture to w. The stream
+// writes whole log lines, and the completion marker is always written through.
+type architectureLogWriter struct {
+	w            io.Writer
+	architecture domain.ImageArchitecture
+}
+
+func (aw *architectureLogWriter) Write(p []byte) (int, error) {
+	if string(p) == domain.LogStreamCompleteMarker {
+		return aw.w.Write(p)
+	}
+	if filtered := FilterArchitectureLogs(string(p), aw.architecture); filtered != "" {
+		if _, err := aw.w.Write([]byte(filtered)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush flushes the underlying writer if possible
+func (aw *architectureLogWriter) Flush() {
+	if flusher, ok := aw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
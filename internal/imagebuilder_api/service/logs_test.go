@@ -0,0 +1,63 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/stretchr/testify/require"
+)
+
+const testMultiArchLogs = "Starting build\n[amd64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n[arm64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n[amd64] COMMIT\n"
+
+func TestFilterArchitectureLogs(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("[amd64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n[amd64] COMMIT\n",
+		FilterArchitectureLogs(testMultiArchLogs, domain.ImageArchitectureAmd64))
+	require.Equal("[arm64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n",
+		FilterArchitectureLogs(testMultiArchLogs, domain.ImageArchitectureArm64))
+	require.Empty(FilterArchitectureLogs("", domain.ImageArchitectureArm64))
+}
+
+// fakeLogStreamReader streams its entries one write at a time, followed by the completion marker
+type fakeLogStreamReader struct {
+	entries []string
+}
+
+func (r *fakeLogStreamReader) ReadAll(_ context.Context) (string, error) {
+	var b bytes.Buffer
+	for _, entry := range r.entries {
+		b.WriteString(entry)
+	}
+	return b.String(), nil
+}
+
+func (r *fakeLogStreamReader) Stream(_ context.Context, w io.Writer) error {
+	for _, entry := range r.entries {
+		if _, err := w.Write([]byte(entry)); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write([]byte(domain.LogStreamCompleteMarker))
+	return err
+}
+
+func TestArchitectureLogStreamReader(t *testing.T) {
+	require := require.New(t)
+	reader := NewArchitectureLogStreamReader(&fakeLogStreamReader{entries: []string{
+		"Starting build\n",
+		"[arm64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n[amd64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n",
+		"[arm64] COMMIT\n",
+	}}, domain.ImageArchitectureArm64)
+
+	logs, err := reader.ReadAll(context.Background())
+	require.NoError(err)
+	require.Equal("[arm64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n[arm64] COMMIT\n", logs)
+
+	var out bytes.Buffer
+	require.NoError(reader.Stream(context.Background(), &out))
+	require.Equal("[arm64] STEP 1/3: FROM quay.io/fedora/fedora-bootc:42\n[arm64] COMMIT\n"+domain.LogStreamCompleteMarker, out.String())
+}
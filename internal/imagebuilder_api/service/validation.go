@@ -1,9 +1,17 @@
 package service
 
 import (
+	"fmt"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -211,3 +219,307 @@ func ValidatePublicKey(publicKey *string, path string) []error {
 
 	return errs
 }
+
+const (
+	// Package spec format: a package name, optionally with version, release and architecture
+	// (e.g. "tmux", "tmux-3.2a-5.el9.x86_64"). Must not start with '-' to not be taken as a dnf option.
+	packageSpecFmt string = `[A-Za-z0-9_][A-Za-z0-9_.+~^:-]*`
+	// Repository ID format: letters, digits and -_.: as accepted by dnf
+	packageRepositoryNameFmt string = `[A-Za-z0-9][A-Za-z0-9_.:-]*`
+	// Systemd unit name format: name or template instance with a unit type suffix, without globs
+	systemdUnitFmt string = `[A-Za-z0-9:_.\\-]+(@[A-Za-z0-9:_.\\-]*)?\.(service|socket|timer|path|mount|automount|swap|target|slice)`
+	// Firewall port format: a port or a range of ports with a protocol
+	firewallPortFmt string = `([0-9]{1,5})(-([0-9]{1,5}))?/(tcp|udp|sctp|dccp)`
+
+	customizationItemMaxLength int = 256
+	// Maximum decoded size of an inline file
+	customizationFileMaxSize int = 1024 * 1024
+)
+
+var (
+	packageSpecRegexp           = regexp.MustCompile("^" + packageSpecFmt + "$")
+	packageRepositoryNameRegexp = regexp.MustCompile("^" + packageRepositoryNameFmt + "$")
+	systemdUnitRegexp           = regexp.MustCompile("^" + systemdUnitFmt + "$")
+	firewallPortRegexp          = regexp.MustCompile("^" + firewallPortFmt + "$")
+)
+
+// ValidateCustomizations validates the customizations of an image build. The values are
+// written to the build context and read by shell commands of the Containerfile, so they
+// are restricted to characters that cannot be interpreted by the shell.
+// References to Repository resources are not checked.
+func ValidateCustomizations(customizations *domain.ImageBuildCustomizations, path string) []error {
+	if customizations == nil {
+		return nil
+	}
+
+	var errs []error
+	for i, pkg := range lo.FromPtr(customizations.Packages) {
+		errs = append(errs, validateCustomizationItem(pkg, fmt.Sprintf("%s.packages[%d]", path, i), packageSpecRegexp, "must be a package name, optionally with version (e.g. 'tmux' or 'tmux-3.2a')")...)
+	}
+	errs = append(errs, validatePackageRepositories(lo.FromPtr(customizations.PackageRepositories), path+".packageRepositories")...)
+	errs = append(errs, validateCustomizationFiles(lo.FromPtr(customizations.Files), path+".files")...)
+	errs = append(errs, validateCustomizationDirectories(lo.FromPtr(customizations.Directories), path+".directories")...)
+
+	enabled := make(map[string]struct{})
+	for i, unit := range lo.FromPtr(customizations.EnabledUnits) {
+		errs = append(errs, validateCustomizationItem(unit, fmt.Sprintf("%s.enabledUnits[%d]", path, i), systemdUnitRegexp, "must be a systemd unit name with its type suffix (e.g. 'chronyd.service')")...)
+		enabled[unit] = struct{}{}
+	}
+	for i, unit := range lo.FromPtr(customizations.DisabledUnits) {
+		unitPath := fmt.Sprintf("%s.disabledUnits[%d]", path, i)
+		errs = append(errs, validateCustomizationItem(unit, unitPath, systemdUnitRegexp, "must be a systemd unit name with its type suffix (e.g. 'chronyd.service')")...)
+		if _, ok := enabled[unit]; ok {
+			errs = append(errs, field.Invalid(fieldPathFor(unitPath), unit, "cannot be both enabled and disabled"))
+		}
+	}
+
+	for i, karg := range lo.FromPtr(customizations.KernelArguments) {
+		kargPath := fmt.Sprintf("%s.kernelArguments[%d]", path, i)
+		if karg == "" {
+			errs = append(errs, field.Required(fieldPathFor(kargPath), ""))
+		} else if len(karg) > customizationItemMaxLength {
+			errs = append(errs, field.TooLong(fieldPathFor(kargPath), karg, customizationItemMaxLength))
+		} else if strings.ContainsFunc(karg, func(r rune) bool { return r <= ' ' || r >= 0x7f || strings.ContainsRune("\"'\\`$", r) }) {
+			errs = append(errs, field.Invalid(fieldPathFor(kargPath), karg, "must be a single argument without whitespace, quotes, backslashes or '$'"))
+		}
+	}
+
+	for i, port := range lo.FromPtr(customizations.FirewallPorts) {
+		errs = append(errs, validateFirewallPort(port, fmt.Sprintf("%s.firewallPorts[%d]", path, i))...)
+	}
+	return errs
+}
+
+func validateCustomizationItem(s string, path string, patternRegexp *regexp.Regexp, msg string) []error {
+	if s == "" {
+		return []error{field.Required(fieldPathFor(path), "")}
+	}
+	if len(s) > customizationItemMaxLength {
+		return []error{field.TooLong(fieldPathFor(path), s, customizationItemMaxLength)}
+	}
+	if !patternRegexp.MatchString(s) {
+		return []error{field.Invalid(fieldPathFor(path), s, msg)}
+	}
+	return nil
+}
+
+func validatePackageRepositories(repositories []domain.ImageBuildPackageRepository, path string) []error {
+	var errs []error
+	names := make(map[string]struct{})
+	for i, repository := range repositories {
+		repositoryPath := fmt.Sprintf("%s[%d]", path, i)
+		errs = append(errs, validateCustomizationItem(repository.Name, repositoryPath+".name", packageRepositoryNameRegexp, "must start with a letter or digit and contain only letters, digits, '-', '_', '.' or ':'")...)
+		if _, ok := names[repository.Name]; ok {
+			errs = append(errs, field.Duplicate(fieldPathFor(repositoryPath+".name"), repository.Name))
+		}
+		names[repository.Name] = struct{}{}
+
+		errs = append(errs, validateRepositoryURL(repository.Baseurl, repositoryPath+".baseurl", "http", "https")...)
+		if repository.Gpgkey != nil {
+			errs = append(errs, validateRepositoryURL(*repository.Gpgkey, repositoryPath+".gpgkey", "http", "https", "file")...)
+		}
+	}
+	return errs
+}
+
+func validateRepositoryURL(s string, path string, schemes ...string) []error {
+	if s == "" {
+		return []error{field.Required(fieldPathFor(path), "")}
+	}
+	// the URL is written to a .repo file, so it must not span lines
+	if strings.ContainsFunc(s, func(r rune) bool { return r <= ' ' || r == 0x7f }) {
+		return []error{field.Invalid(fieldPathFor(path), s, "must not contain whitespace or control characters")}
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return []error{field.Invalid(fieldPathFor(path), s, err.Error())}
+	}
+	if !lo.Contains(schemes, u.Scheme) || (u.Scheme != "file" && u.Host == "") {
+		return []error{field.Invalid(fieldPathFor(path), s, fmt.Sprintf("must be a URL with scheme %s", strings.Join(schemes, ", ")))}
+	}
+	return nil
+}
+
+func validateCustomizationFiles(files []domain.ImageBuildFile, path string) []error {
+	var errs []error
+	paths := make(map[string]struct{})
+	for i, file := range files {
+		filePath := fmt.Sprintf("%s[%d]", path, i)
+		errs = append(errs, validateImagePath(file.Path, filePath+".path")...)
+		if _, ok := paths[file.Path]; ok {
+			errs = append(errs, field.Duplicate(fieldPathFor(filePath+".path"), file.Path))
+		}
+		paths[file.Path] = struct{}{}
+		errs = append(errs, validation.ValidateLinuxFileMode(file.Mode, filePath+".mode")...)
+
+		switch lo.FromPtrOr(file.ContentEncoding, domain.EncodingPlain) {
+		case domain.EncodingPlain:
+			if len(file.Content) > customizationFileMaxSize {
+				errs = append(errs, field.TooLong(fieldPathFor(filePath+".content"), "", customizationFileMaxSize))
+			}
+		case domain.EncodingBase64:
+			errs = append(errs, validation.ValidateBase64Field(file.Content, filePath+".content", base64EncodedLen(customizationFileMaxSize))...)
+		default:
+			errs = append(errs, field.NotSupported(fieldPathFor(filePath+".contentEncoding"), *file.ContentEncoding, []string{string(domain.EncodingPlain), string(domain.EncodingBase64)}))
+		}
+	}
+	return errs
+}
+
+func validateCustomizationDirectories(directories []domain.ImageBuildDirectory, path string) []error {
+	var errs []error
+	for i, directory := range directories {
+		directoryPath := fmt.Sprintf("%s[%d]", path, i)
+		errs = append(errs, validation.ValidateResourceNameReference(&directory.Repository, directoryPath+".repository")...)
+		errs = append(errs, validation.ValidateGitRevision(&directory.TargetRevision, directoryPath+".targetRevision")...)
+		if directory.Path == "" {
+			errs = append(errs, field.Required(fieldPathFor(directoryPath+".path"), ""))
+		} else if strings.Contains("/"+directory.Path+"/", "/../") {
+			errs = append(errs, field.Invalid(fieldPathFor(directoryPath+".path"), directory.Path, "must not contain '..' (parent directory references)"))
+		}
+		errs = append(errs, validateImagePath(directory.MountPath, directoryPath+".mountPath")...)
+	}
+	return errs
+}
+
+// validateImagePath validates an absolute path in the image. Paths managed by the agent
+// are rejected, as are paths with characters that would not survive the build context.
+func validateImagePath(p string, path string) []error {
+	if p == "" {
+		return []error{field.Required(fieldPathFor(path), "")}
+	}
+	if errs := validation.ValidateFilePath(&p, path); len(errs) > 0 {
+		return errs
+	}
+	if strings.ContainsFunc(p, func(r rune) bool { return r < ' ' || r == 0x7f }) {
+		return []error{field.Invalid(fieldPathFor(path), p, "must not contain control characters")}
+	}
+	if p != "/" {
+		if err := validation.DenyForbiddenDevicePath(p); err != nil {
+			return []error{field.Invalid(fieldPathFor(path), p, err.Error())}
+		}
+	}
+	return nil
+}
+
+func validateFirewallPort(port string, path string) []error {
+	matches := firewallPortRegexp.FindStringSubmatch(port)
+	if matches == nil {
+		return []error{field.Invalid(fieldPathFor(path), port, "must be 'port/protocol' or 'start-end/protocol' with protocol tcp, udp, sctp or dccp (e.g. '8080/tcp')")}
+	}
+	start, _ := strconv.Atoi(matches[1])
+	end := start
+	if matches[3] != "" {
+		end, _ = strconv.Atoi(matches[3])
+	}
+	if start < 1 || end > 65535 || end < start {
+		return []error{field.Invalid(fieldPathFor(path), port, "must be a port or an ascending range of ports between 1 and 65535")}
+	}
+	return nil
+}
+
+func base64EncodedLen(n int) int {
+	return (n + 2) / 3 * 4
+}
+
+// ValidateEmbeddedImages validates the images to embed into an image build. The references
+// are written to the build context and read by shell commands of the Containerfile, so they
+// must be strict OCI image references. References to Repository resources are not checked.
+func ValidateEmbeddedImages(embeddedImages []domain.ImageBuildEmbeddedImage, path string) []error {
+	var errs []error
+	images := make(map[string]struct{})
+	for i, embeddedImage := range embeddedImages {
+		imagePath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case embeddedImage.Image != nil && embeddedImage.CatalogItemRef != nil:
+			errs = append(errs, field.Invalid(fieldPathFor(imagePath), *embeddedImage.Image, "only one of image and catalogItemRef may be set"))
+		case embeddedImage.Image != nil:
+			errs = append(errs, validation.ValidateOciImageReferenceStrict(embeddedImage.Image, imagePath+".image")...)
+			if _, ok := images[*embeddedImage.Image]; ok {
+				errs = append(errs, field.Duplicate(fieldPathFor(imagePath+".image"), *embeddedImage.Image))
+			}
+			images[*embeddedImage.Image] = struct{}{}
+		case embeddedImage.CatalogItemRef != nil:
+			ref := embeddedImage.CatalogItemRef
+			errs = append(errs, validation.ValidateResourceNameReference(&ref.Catalog, imagePath+".catalogItemRef.catalog")...)
+			errs = append(errs, validation.ValidateResourceNameReference(&ref.Item, imagePath+".catalogItemRef.item")...)
+			if ref.Version == "" {
+				errs = append(errs, field.Required(fieldPathFor(imagePath+".catalogItemRef.version"), ""))
+			}
+		default:
+			errs = append(errs, field.Required(fieldPathFor(imagePath), "one of image and catalogItemRef must be set"))
+		}
+		if embeddedImage.Repository != nil {
+			errs = append(errs, validation.ValidateResourceNameReference(embeddedImage.Repository, imagePath+".repository")...)
+		}
+	}
+	return errs
+}
+
+// ValidateArchitectures validates the architectures to build an image for
+func ValidateArchitectures(architectures []domain.ImageArchitecture, path string) []error {
+	if len(architectures) == 0 {
+		return []error{field.Required(fieldPathFor(path), "at least one architecture must be set")}
+	}
+	var errs []error
+	seen := make(map[domain.ImageArchitecture]struct{}, len(architectures))
+	for i, architecture := range architectures {
+		errs = append(errs, ValidateArchitecture(architecture, fmt.Sprintf("%s[%d]", path, i))...)
+		if _, ok := seen[architecture]; ok {
+			errs = append(errs, field.Duplicate(fieldPathFor(fmt.Sprintf("%s[%d]", path, i)), architecture))
+		}
+		seen[architecture] = struct{}{}
+	}
+	return errs
+}
+
+// ValidateArchitecture validates an image architecture
+func ValidateArchitecture(architecture domain.ImageArchitecture, path string) []error {
+	supported := []string{string(domain.ImageArchitectureAmd64), string(domain.ImageArchitectureArm64)}
+	if !lo.Contains(supported, string(architecture)) {
+		return []error{field.NotSupported(fieldPathFor(path), architecture, supported)}
+	}
+	return nil
+}
+
+// triggerMinIntervalRegexp matches the minimum interval of triggers, e.g. "30m" or "24h"
+var triggerMinIntervalRegexp = regexp.MustCompile(`^(?:[1-9]\d*)?\d[smh]$`)
+
+// ValidateTriggers validates the triggers creating new versions of an ImageBuild
+func ValidateTriggers(triggers *domain.ImageBuildTriggers, path string) []error {
+	if triggers == nil {
+		return nil
+	}
+	var errs []error
+	if !lo.FromPtr(triggers.SourceImageChange) && triggers.Schedule == nil {
+		errs = append(errs, field.Required(fieldPathFor(path), "sourceImageChange or schedule must be set"))
+	}
+	if triggers.Schedule != nil {
+		if _, err := ParseTriggerSchedule(*triggers.Schedule); err != nil {
+			errs = append(errs, field.Invalid(fieldPathFor(path+".schedule"), *triggers.Schedule, err.Error()))
+		}
+	}
+	if triggers.MinInterval != nil {
+		if !triggerMinIntervalRegexp.MatchString(*triggers.MinInterval) {
+			errs = append(errs, field.Invalid(fieldPathFor(path+".minInterval"), *triggers.MinInterval, "must be a positive integer followed by s, m or h"))
+		} else if interval, _ := TriggerMinInterval(triggers); interval <= 0 {
+			errs = append(errs, field.Invalid(fieldPathFor(path+".minInterval"), *triggers.MinInterval, "must be greater than 0"))
+		}
+	}
+	return errs
+}
+
+// ParseTriggerSchedule parses the cron schedule of triggers, which uses the standard 5 field syntax
+func ParseTriggerSchedule(schedule string) (cron.Schedule, error) {
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	return parser.Parse(schedule)
+}
+
+// TriggerMinInterval returns the minimum time between new versions created by triggers
+func TriggerMinInterval(triggers *domain.ImageBuildTriggers) (time.Duration, error) {
+	minInterval := domain.DefaultImageBuildTriggerMinInterval
+	if triggers != nil && triggers.MinInterval != nil {
+		minInterval = *triggers.MinInterval
+	}
+	return time.ParseDuration(minInterval)
+}
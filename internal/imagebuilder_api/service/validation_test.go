@@ -4,6 +4,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1068,3 +1071,358 @@ func TestValidatePublicKey(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCustomizations(t *testing.T) {
+	tests := []struct {
+		name           string
+		customizations domain.ImageBuildCustomizations
+		wantErr        bool
+	}{
+		// Valid cases
+		{
+			name: "all customizations",
+			customizations: domain.ImageBuildCustomizations{
+				Packages: lo.ToPtr([]string{"tmux", "vim-enhanced", "python3.11", "tmux-3.2a-5.el9.x86_64"}),
+				PackageRepositories: lo.ToPtr([]domain.ImageBuildPackageRepository{
+					{Name: "epel", Baseurl: "https://dl.fedoraproject.org/pub/epel/9/Everything/x86_64/", Gpgkey: lo.ToPtr("https://dl.fedoraproject.org/pub/epel/RPM-GPG-KEY-EPEL-9")},
+				}),
+				Files: lo.ToPtr([]domain.ImageBuildFile{
+					{Path: "/etc/motd", Content: "Welcome\n"},
+					{Path: "/usr/local/bin/hello", Content: "IyEvYmluL3NoCmVjaG8gaGVsbG8K", ContentEncoding: lo.ToPtr(domain.EncodingBase64), Mode: lo.ToPtr(0755)},
+				}),
+				Directories: lo.ToPtr([]domain.ImageBuildDirectory{
+					{Repository: "site-config", TargetRevision: "main", Path: "/rootfs", MountPath: "/"},
+				}),
+				EnabledUnits:    lo.ToPtr([]string{"podman-auto-update.timer", "getty@tty1.service"}),
+				DisabledUnits:   lo.ToPtr([]string{"cups.socket"}),
+				KernelArguments: lo.ToPtr([]string{"console=ttyS0,115200", "quiet"}),
+				FirewallPorts:   lo.ToPtr([]string{"8080/tcp", "60000-61000/udp"}),
+			},
+			wantErr: false,
+		},
+		{
+			name:           "empty customizations",
+			customizations: domain.ImageBuildCustomizations{},
+			wantErr:        false,
+		},
+		// Invalid cases
+		{
+			name:           "package with command injection",
+			customizations: domain.ImageBuildCustomizations{Packages: lo.ToPtr([]string{"tmux; rm -rf /"})},
+			wantErr:        true,
+		},
+		{
+			name:           "package taken as dnf option",
+			customizations: domain.ImageBuildCustomizations{Packages: lo.ToPtr([]string{"--nogpgcheck"})},
+			wantErr:        true,
+		},
+		{
+			name:           "package glob",
+			customizations: domain.ImageBuildCustomizations{Packages: lo.ToPtr([]string{"tmux*"})},
+			wantErr:        true,
+		},
+		{
+			name: "duplicate package repository",
+			customizations: domain.ImageBuildCustomizations{PackageRepositories: lo.ToPtr([]domain.ImageBuildPackageRepository{
+				{Name: "epel", Baseurl: "https://example.com/a"},
+				{Name: "epel", Baseurl: "https://example.com/b"},
+			})},
+			wantErr: true,
+		},
+		{
+			name: "package repository URL with newline",
+			customizations: domain.ImageBuildCustomizations{PackageRepositories: lo.ToPtr([]domain.ImageBuildPackageRepository{
+				{Name: "epel", Baseurl: "https://example.com/\ngpgcheck=0"},
+			})},
+			wantErr: true,
+		},
+		{
+			name: "package repository URL without scheme",
+			customizations: domain.ImageBuildCustomizations{PackageRepositories: lo.ToPtr([]domain.ImageBuildPackageRepository{
+				{Name: "epel", Baseurl: "example.com/epel"},
+			})},
+			wantErr: true,
+		},
+		{
+			name:           "relative file path",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{{Path: "etc/motd", Content: "hi"}})},
+			wantErr:        true,
+		},
+		{
+			name:           "file path with parent reference",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{{Path: "/etc/../root/.bashrc", Content: "hi"}})},
+			wantErr:        true,
+		},
+		{
+			name:           "file overwriting the agent config",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{{Path: "/etc/flightctl/config.yaml", Content: "hi"}})},
+			wantErr:        true,
+		},
+		{
+			name: "duplicate file path",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{
+				{Path: "/etc/motd", Content: "a"},
+				{Path: "/etc/motd", Content: "b"},
+			})},
+			wantErr: true,
+		},
+		{
+			name:           "invalid base64 file content",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{{Path: "/etc/motd", Content: "not base64!", ContentEncoding: lo.ToPtr(domain.EncodingBase64)}})},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid file mode",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{{Path: "/etc/motd", Content: "hi", Mode: lo.ToPtr(010000)}})},
+			wantErr:        true,
+		},
+		{
+			name:           "file too large",
+			customizations: domain.ImageBuildCustomizations{Files: lo.ToPtr([]domain.ImageBuildFile{{Path: "/etc/motd", Content: strings.Repeat("a", customizationFileMaxSize+1)}})},
+			wantErr:        true,
+		},
+		{
+			name: "directory path with parent reference",
+			customizations: domain.ImageBuildCustomizations{Directories: lo.ToPtr([]domain.ImageBuildDirectory{
+				{Repository: "site-config", TargetRevision: "main", Path: "../secrets", MountPath: "/etc"},
+			})},
+			wantErr: true,
+		},
+		{
+			name: "directory with relative mount path",
+			customizations: domain.ImageBuildCustomizations{Directories: lo.ToPtr([]domain.ImageBuildDirectory{
+				{Repository: "site-config", TargetRevision: "main", Path: "/etc", MountPath: "etc"},
+			})},
+			wantErr: true,
+		},
+		{
+			name:           "unit without type suffix",
+			customizations: domain.ImageBuildCustomizations{EnabledUnits: lo.ToPtr([]string{"chronyd"})},
+			wantErr:        true,
+		},
+		{
+			name:           "unit glob",
+			customizations: domain.ImageBuildCustomizations{EnabledUnits: lo.ToPtr([]string{"*.service"})},
+			wantErr:        true,
+		},
+		{
+			name: "unit both enabled and disabled",
+			customizations: domain.ImageBuildCustomizations{
+				EnabledUnits:  lo.ToPtr([]string{"chronyd.service"}),
+				DisabledUnits: lo.ToPtr([]string{"chronyd.service"}),
+			},
+			wantErr: true,
+		},
+		{
+			name:           "kernel argument with whitespace",
+			customizations: domain.ImageBuildCustomizations{KernelArguments: lo.ToPtr([]string{"quiet splash"})},
+			wantErr:        true,
+		},
+		{
+			name:           "kernel argument with quote",
+			customizations: domain.ImageBuildCustomizations{KernelArguments: lo.ToPtr([]string{`quiet"]`})},
+			wantErr:        true,
+		},
+		{
+			name:           "firewall port without protocol",
+			customizations: domain.ImageBuildCustomizations{FirewallPorts: lo.ToPtr([]string{"8080"})},
+			wantErr:        true,
+		},
+		{
+			name:           "firewall port out of range",
+			customizations: domain.ImageBuildCustomizations{FirewallPorts: lo.ToPtr([]string{"70000/tcp"})},
+			wantErr:        true,
+		},
+		{
+			name:           "firewall port range descending",
+			customizations: domain.ImageBuildCustomizations{FirewallPorts: lo.ToPtr([]string{"9000-8000/tcp"})},
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateCustomizations(&tt.customizations, "spec.customizations")
+			if tt.wantErr {
+				require.NotEmpty(t, errs, "expected validation errors")
+			} else {
+				assert.Empty(t, errs, "expected no validation errors\nGot errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateEmbeddedImages(t *testing.T) {
+	catalogItemRef := &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "nginx", Version: "1.0.0"}
+	tests := []struct {
+		name           string
+		embeddedImages []domain.ImageBuildEmbeddedImage
+		wantErr        bool
+	}{
+		// Valid cases
+		{
+			name: "image and catalog item",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{
+				{Image: lo.ToPtr("quay.io/flightctl/app:v1"), Repository: lo.ToPtr("quay-io")},
+				{Image: lo.ToPtr("registry.example.com:5000/app@sha256:4d3a2f1b2c8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a")},
+				{CatalogItemRef: catalogItemRef},
+			},
+			wantErr: false,
+		},
+		// Invalid cases
+		{
+			name:           "neither image nor catalog item",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{{Repository: lo.ToPtr("quay-io")}},
+			wantErr:        true,
+		},
+		{
+			name:           "both image and catalog item",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{{Image: lo.ToPtr("quay.io/flightctl/app:v1"), CatalogItemRef: catalogItemRef}},
+			wantErr:        true,
+		},
+		{
+			name:           "short image name",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{{Image: lo.ToPtr("nginx:latest")}},
+			wantErr:        true,
+		},
+		{
+			name:           "image with command injection",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{{Image: lo.ToPtr("quay.io/app:v1; rm -rf /")}},
+			wantErr:        true,
+		},
+		{
+			name: "duplicate image",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{
+				{Image: lo.ToPtr("quay.io/flightctl/app:v1")},
+				{Image: lo.ToPtr("quay.io/flightctl/app:v1")},
+			},
+			wantErr: true,
+		},
+		{
+			name:           "catalog item without version",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{{CatalogItemRef: &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "nginx"}}},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid repository name",
+			embeddedImages: []domain.ImageBuildEmbeddedImage{{Image: lo.ToPtr("quay.io/flightctl/app:v1"), Repository: lo.ToPtr("Quay IO")}},
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateEmbeddedImages(tt.embeddedImages, "spec.embeddedImages")
+			if tt.wantErr {
+				require.NotEmpty(t, errs, "expected validation errors")
+			} else {
+				assert.Empty(t, errs, "expected no validation errors\nGot errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateArchitectures(t *testing.T) {
+	tests := []struct {
+		name          string
+		architectures []domain.ImageArchitecture
+		wantErr       bool
+	}{
+		{
+			name:          "single architecture",
+			architectures: []domain.ImageArchitecture{domain.ImageArchitectureAmd64},
+		},
+		{
+			name:          "multiple architectures",
+			architectures: []domain.ImageArchitecture{domain.ImageArchitectureArm64, domain.ImageArchitectureAmd64},
+		},
+		{
+			name:          "no architectures",
+			architectures: []domain.ImageArchitecture{},
+			wantErr:       true,
+		},
+		{
+			name:          "unsupported architecture",
+			architectures: []domain.ImageArchitecture{"s390x"},
+			wantErr:       true,
+		},
+		{
+			name:          "platform instead of architecture",
+			architectures: []domain.ImageArchitecture{"linux/amd64"},
+			wantErr:       true,
+		},
+		{
+			name:          "duplicate architecture",
+			architectures: []domain.ImageArchitecture{domain.ImageArchitectureArm64, domain.ImageArchitectureArm64},
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateArchitectures(tt.architectures, "spec.architectures")
+			if tt.wantErr {
+				require.NotEmpty(t, errs, "expected validation errors")
+			} else {
+				assert.Empty(t, errs, "expected no validation errors\nGot errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateTriggers(t *testing.T) {
+	tests := []struct {
+		name     string
+		triggers *domain.ImageBuildTriggers
+		wantErr  bool
+	}{
+		{
+			name:     "no triggers",
+			triggers: nil,
+		},
+		{
+			name:     "source image change",
+			triggers: &domain.ImageBuildTriggers{SourceImageChange: lo.ToPtr(true)},
+		},
+		{
+			name:     "schedule with min interval",
+			triggers: &domain.ImageBuildTriggers{Schedule: lo.ToPtr("0 2 * * 1"), MinInterval: lo.ToPtr("30m")},
+		},
+		{
+			name:     "no trigger enabled",
+			triggers: &domain.ImageBuildTriggers{SourceImageChange: lo.ToPtr(false)},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid schedule",
+			triggers: &domain.ImageBuildTriggers{Schedule: lo.ToPtr("every day")},
+			wantErr:  true,
+		},
+		{
+			name:     "schedule with seconds",
+			triggers: &domain.ImageBuildTriggers{Schedule: lo.ToPtr("0 0 2 * * *")},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid min interval",
+			triggers: &domain.ImageBuildTriggers{SourceImageChange: lo.ToPtr(true), MinInterval: lo.ToPtr("1d")},
+			wantErr:  true,
+		},
+		{
+			name:     "zero min interval",
+			triggers: &domain.ImageBuildTriggers{SourceImageChange: lo.ToPtr(true), MinInterval: lo.ToPtr("0s")},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateTriggers(tt.triggers, "spec.triggers")
+			if tt.wantErr {
+				require.NotEmpty(t, errs, "expected validation errors")
+			} else {
+				assert.Empty(t, errs, "expected no validation errors\nGot errors: %v", errs)
+			}
+		})
+	}
+}
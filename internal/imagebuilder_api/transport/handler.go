@@ -129,12 +129,27 @@ func (h *TransportHandler) GetImageBuildLog(w http.ResponseWriter, r *http.Reque
 		follow = *params.Follow
 	}
 
+	if params.Architecture != nil {
+		if errs := service.ValidateArchitecture(*params.Architecture, "architecture"); len(errs) > 0 {
+			h.SetResponse(w, nil, service.StatusBadRequest(errors.Join(errs...).Error()))
+			return
+		}
+	}
+
 	reader, logs, status := h.service.ImageBuild().GetLogs(ctx, orgID, name, follow)
 	if !service.IsStatusOK(status) {
 		h.SetResponse(w, nil, status)
 		return
 	}
 
+	// The build output of each architecture is prefixed, so it can be filtered by architecture
+	if params.Architecture != nil {
+		if reader != nil {
+			reader = service.NewArchitectureLogStreamReader(reader, *params.Architecture)
+		}
+		logs = service.FilterArchitectureLogs(logs, *params.Architecture)
+	}
+
 	// If we have a reader (active build with follow=true), stream via SSE
 	if reader != nil {
 		// Set SSE headers
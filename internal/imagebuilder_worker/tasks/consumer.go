@@ -38,11 +38,15 @@ type Consumer struct {
 	repositoryStore     repositorystore.Store
 	catalogStore        catalogstore.Store
 	kvStore             kvstore.KVStore
+	sbomStore           SBOMStore
 	serviceHandler      *certificatesigningrequestservice.ServiceHandler
 	imageBuilderService imagebuilderapi.Service
 	queueProducer       queues.QueueProducer
 	cfg                 *config.Config
 	log                 logrus.FieldLogger
+
+	// sourceImageDigestResolver overrides how the digest of source images is resolved, for tests
+	sourceImageDigestResolver sourceImageDigestResolver
 }
 
 // NewConsumer creates a new Consumer instance with the provided dependencies
@@ -52,6 +56,7 @@ func NewConsumer(
 	repositoryStore repositorystore.Store,
 	catalogStore catalogstore.Store,
 	kvStore kvstore.KVStore,
+	sbomStore SBOMStore,
 	serviceHandler *certificatesigningrequestservice.ServiceHandler,
 	imageBuilderService imagebuilderapi.Service,
 	queueProducer queues.QueueProducer,
@@ -64,6 +69,7 @@ func NewConsumer(
 		repositoryStore:     repositoryStore,
 		catalogStore:        catalogStore,
 		kvStore:             kvStore,
+		sbomStore:           sbomStore,
 		serviceHandler:      serviceHandler,
 		imageBuilderService: imageBuilderService,
 		queueProducer:       queueProducer,
@@ -228,6 +234,7 @@ func LaunchConsumers(
 	repositoryStore repositorystore.Store,
 	catalogStore catalogstore.Store,
 	kvStore kvstore.KVStore,
+	sbomStore SBOMStore,
 	serviceHandler *certificatesigningrequestservice.ServiceHandler,
 	imageBuilderService imagebuilderapi.Service,
 	cfg *config.Config,
@@ -242,7 +249,7 @@ func LaunchConsumers(
 		return fmt.Errorf("failed to create queue producer for consumer: %w", err)
 	}
 
-	taskConsumer := NewConsumer(store, organizationStore, repositoryStore, catalogStore, kvStore, serviceHandler, imageBuilderService, consumerQueueProducer, cfg, log)
+	taskConsumer := NewConsumer(store, organizationStore, repositoryStore, catalogStore, kvStore, sbomStore, serviceHandler, imageBuilderService, consumerQueueProducer, cfg, log)
 
 	for i := 0; i < maxConcurrentBuilds; i++ {
 		consumer, err := queuesProvider.NewQueueConsumer(ctx, consts.ImageBuildTaskQueue)
@@ -260,6 +267,9 @@ func LaunchConsumers(
 	// Start periodic timeout check task
 	go taskConsumer.runPeriodicTimeoutCheck(ctx)
 
+	// Start periodic trigger check task
+	go taskConsumer.runPeriodicTriggerCheck(ctx)
+
 	log.Info("All imagebuild queue consumers started")
 	return nil
 }
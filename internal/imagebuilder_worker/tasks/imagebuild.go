@@ -24,6 +24,7 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/encryption"
 	"github.com/flightctl/flightctl/internal/service"
 	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
+	coretasks "github.com/flightctl/flightctl/internal/tasks"
 	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/google/uuid"
@@ -63,6 +64,11 @@ type ContainerfileResult struct {
 	AgentConfig []byte
 	// Publickey contains the SSH public key content (for user configuration)
 	Publickey []byte
+	// Customizations contains the files of the customizations directory, keyed by their
+	// path relative to it
+	Customizations map[string]buildContextFile
+	// EmbeddedImages contains the images to embed into the built image
+	EmbeddedImages []embeddedImage
 }
 
 // shouldProcessImageBuild checks if the ImageBuild is in a state that should be processed.
@@ -161,6 +167,14 @@ func (c *Consumer) processImageBuild(ctx context.Context, eventWithOrgId worker_
 	}
 	domain.SetImageBuildStatusCondition(imageBuild.Status.Conditions, buildingCondition)
 
+	// Reset the status of all architectures, which are built one after the other
+	architectureStatuses := []domain.ImageBuildArchitectureStatus{}
+	for _, architecture := range domain.ImageBuildArchitectures(imageBuild.Spec) {
+		architectureStatuses = append(architectureStatuses,
+			newImageBuildArchitectureStatus(architecture, domain.ImageBuildConditionReasonPending, "Waiting for the build to start", ""))
+	}
+	imageBuild.Status.Architectures = &architectureStatuses
+
 	// Synchronously update status to Building - this will fail if resource_version changed
 	_, err = c.imageBuilderService.ImageBuild().UpdateStatus(ctx, orgID, imageBuild)
 	if err != nil {
@@ -246,6 +260,9 @@ func (c *Consumer) processImageBuild(ctx context.Context, eventWithOrgId worker_
 		if c.handleBuildError(ctx, orgID, imageBuildName, err, statusUpdater, log) {
 			return nil // Cancellation handled
 		}
+		for _, architecture := range domain.ImageBuildArchitectures(imageBuild.Spec) {
+			statusUpdater.UpdateArchitecture(architecture, domain.ImageBuildConditionReasonFailed, "Push failed", "")
+		}
 		return fmt.Errorf("failed to push image with podman: %w", err)
 	}
 
@@ -257,6 +274,17 @@ func (c *Consumer) processImageBuild(ctx context.Context, eventWithOrgId worker_
 	// Update ImageBuild status with the pushed image reference and manifest digest
 	statusUpdater.UpdateImageReference(imageRef, manifestDigest)
 
+	// The pushed manifest is an index of the images of all architectures. Their digests are
+	// informational, so failing to get them does not fail the build.
+	architectureDigests, err := inspectManifestDigests(buildCtx, podmanWorker, imageRef)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get the manifest digests of the architectures")
+	}
+	for _, architecture := range domain.ImageBuildArchitectures(imageBuild.Spec) {
+		statusUpdater.UpdateArchitecture(architecture, domain.ImageBuildConditionReasonCompleted,
+			fmt.Sprintf("Built and pushed for %s", platformForArchitecture(architecture)), architectureDigests[architecture])
+	}
+
 	// Step 5: Generate and distribute SBOM when enabled and a destination is configured
 	if c.shouldRunSBOMPipeline() {
 		c.processSBOM(buildCtx, ctx, orgID, imageBuild, imageRef, manifestDigest, podmanWorker, statusUpdater, log)
@@ -335,6 +363,13 @@ func (c *Consumer) processSBOM(
 		}
 	}
 
+	// Store SBOM for the offline vulnerability scanner (if selected)
+	if c.shouldStoreSBOM() {
+		if err := c.storeSBOM(buildCtx, sbomResult, log); err != nil {
+			log.WithError(err).Warn("Storing SBOM for vulnerability scanning failed (non-fatal)")
+		}
+	}
+
 	log.Info("Phase: SBOM Generation Completed")
 }
 
@@ -588,6 +623,20 @@ func (c *Consumer) generateContainerfileWithGenerator(
 		result.Publickey = []byte(spec.UserConfiguration.Publickey)
 	}
 
+	// Render customizations, cloning directories from Git repositories
+	customizations, err := c.renderCustomizations(ctx, orgID, spec.Customizations, coretasks.CloneGitRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render customizations: %w", err)
+	}
+	result.Customizations = customizations
+
+	// Resolve the images to embed, including the images of catalog items
+	embeddedImages, err := c.resolveEmbeddedImages(ctx, orgID, lo.FromPtr(spec.EmbeddedImages))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve embedded images: %w", err)
+	}
+	result.EmbeddedImages = embeddedImages
+
 	// Handle early binding - generate enrollment credentials
 	if isEarlyBinding {
 		// Generate a unique name for this build's enrollment credentials
@@ -646,6 +695,10 @@ type statusWriter struct {
 	mu            sync.Mutex
 	buf           *bytes.Buffer
 	statusUpdater *statusUpdater
+	// prefix is prepended to every line of output, if set. Output is then only reported
+	// in whole lines, so that the lines can be filtered by their prefix.
+	prefix  string
+	partial []byte
 }
 
 // Write implements io.Writer to handle the stream safely
@@ -653,6 +706,13 @@ func (w *statusWriter) Write(p []byte) (n int, err error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
+	n = len(p)
+	if w.prefix != "" {
+		if p = w.prefixLines(p); len(p) == 0 {
+			return n, nil
+		}
+	}
+
 	// 1. Capture to memory buffer
 	w.buf.Write(p)
 
@@ -660,7 +720,34 @@ func (w *statusWriter) Write(p []byte) (n int, err error) {
 	if w.statusUpdater != nil {
 		w.statusUpdater.ReportOutput(p)
 	}
-	return len(p), nil
+	return n, nil
+}
+
+// prefixLines returns the whole lines completed by p with the prefix prepended to each,
+// keeping the last line until it is completed
+func (w *statusWriter) prefixLines(p []byte) []byte {
+	w.partial = append(w.partial, p...)
+	var prefixed []byte
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			return prefixed
+		}
+		prefixed = append(prefixed, w.prefix...)
+		prefixed = append(prefixed, w.partial[:i+1]...)
+		w.partial = w.partial[i+1:]
+	}
+}
+
+// Flush reports the last line of output if it is not terminated by a newline
+func (w *statusWriter) Flush() {
+	w.mu.Lock()
+	partial := w.partial
+	w.partial = nil
+	w.mu.Unlock()
+	if len(partial) > 0 {
+		_, _ = w.Write(append(partial, '\n'))
+	}
 }
 
 // validateImageRefComponents validates the components of an image reference
@@ -679,6 +766,11 @@ func validateImageRefComponents(imageName, imageTag string) error {
 // It streams output to the status updater to track progress
 // envVars is a map of environment variable names to values (e.g., {"REGISTRY_AUTH_FILE": "/build/auth.json"})
 func (w *podmanWorker) runInWorker(ctx context.Context, log logrus.FieldLogger, phaseName string, envVars map[string]string, args ...string) error {
+	return w.runInWorkerWithLogPrefix(ctx, log, phaseName, "", envVars, args...)
+}
+
+// runInWorkerWithLogPrefix is like runInWorker, prepending logPrefix to every line of output
+func (w *podmanWorker) runInWorkerWithLogPrefix(ctx context.Context, log logrus.FieldLogger, phaseName string, logPrefix string, envVars map[string]string, args ...string) error {
 	// We use "podman exec" to run inside the running container
 	execArgs := []string{"exec"}
 
@@ -699,6 +791,7 @@ func (w *podmanWorker) runInWorker(ctx context.Context, log logrus.FieldLogger,
 	writer := &statusWriter{
 		buf:           &outputBuffer,
 		statusUpdater: w.statusUpdater,
+		prefix:        logPrefix,
 	}
 
 	// Assign the SAME writer to both stdout and stderr.
@@ -706,7 +799,9 @@ func (w *podmanWorker) runInWorker(ctx context.Context, log logrus.FieldLogger,
 	cmd.Stderr = writer
 
 	// Run() handles the starting, streaming, and waiting automatically.
-	if err := cmd.Run(); err != nil {
+	err := cmd.Run()
+	writer.Flush()
+	if err != nil {
 		output := outputBuffer.String()
 		log.Debugf("%s output:\n%s", phaseName, output)
 		return fmt.Errorf("%s failed: %w. Output: %s", phaseName, err, output)
@@ -988,6 +1083,51 @@ func (c *Consumer) loginToRegistry(
 	return nil
 }
 
+// loginToOciRepo logs into the registry of an OCI repository if it has credentials,
+// returning whether it logged in. repoRole describes the repository in errors.
+func (c *Consumer) loginToOciRepo(
+	ctx context.Context,
+	podmanWorker *podmanWorker,
+	ociSpec *coredomain.OciRepoSpec,
+	repoRole string,
+	log logrus.FieldLogger,
+) (bool, error) {
+	if ociSpec.OciAuth == nil {
+		return false, nil
+	}
+	dockerAuth, err := ociSpec.OciAuth.AsDockerAuth()
+	if err != nil || dockerAuth.Username == "" || dockerAuth.Password == "" {
+		return false, nil
+	}
+	decryptedPassword, ok, err := encryption.Decrypt(ctx, encryption.Ciphertext(dockerAuth.Password))
+	if err != nil {
+		return false, fmt.Errorf("failed to decrypt OCI password: %w", err)
+	}
+	if !ok {
+		log.WithField("registry", ociSpec.Registry).Warnf("%s registry password is stored as plaintext; expected an encrypted value", repoRole)
+	}
+	// ociSpec.Registry is already the hostname (no scheme)
+	if err := c.loginToRegistry(ctx, podmanWorker, ociSpec.Registry, dockerAuth.Username, string(decryptedPassword), ociSpec, log); err != nil {
+		return false, fmt.Errorf("failed to login to %s registry: %w", repoRole, err)
+	}
+	return true, nil
+}
+
+// embeddedImagesBuildArgs returns the podman build arguments for pulling embedded images
+// inside the build. The registry credentials are mounted as a secret if there are any.
+// It is a pure function with no side-effects, extracted for unit-testability.
+func embeddedImagesBuildArgs(withAuth bool) []string {
+	args := []string{
+		"--cap-add=all",
+		"--security-opt=label=disable",
+		"--device=/dev/fuse",
+	}
+	if withAuth {
+		args = append(args, "--secret", fmt.Sprintf("id=%s,src=%s", registryAuthSecretID, containerAuthFile))
+	}
+	return args
+}
+
 // getOciRepoSpec retrieves and validates a repository as OCI type, returning its spec.
 func (c *Consumer) getOciRepoSpec(ctx context.Context, orgID uuid.UUID, repoName string, repoRole string) (*coredomain.OciRepoSpec, error) {
 	repo, err := c.repositoryStore.Get(ctx, orgID, repoName)
@@ -1039,7 +1179,13 @@ func writeBuildContextFiles(tmpDir string, containerfileResult *ContainerfileRes
 		return fmt.Errorf("failed to write user-publickey.txt: %w", err)
 	}
 
-	return nil
+	// Write embedded images list (required by COPY instruction, empty if no embedded images)
+	if err := writeEmbeddedImages(tmpDir, containerfileResult.EmbeddedImages); err != nil {
+		return err
+	}
+
+	// Write customizations directory (required by COPY instruction, empty if no customizations)
+	return writeCustomizations(tmpDir, containerfileResult.Customizations)
 }
 
 // buildImageWithPodman builds the image using podman in a container-in-container setup.
@@ -1074,18 +1220,25 @@ func (c *Consumer) buildImageWithPodman(
 	destRegistryHostname := destOciSpec.Registry
 	imageRef := fmt.Sprintf("%s/%s:%s", destRegistryHostname, spec.Destination.ImageName, spec.Destination.ImageTag)
 
-	// Determine platform from ImageBuild status architecture, default to linux/amd64
-	platform := "linux/amd64"
-	if imageBuild.Status != nil && imageBuild.Status.Architecture != nil && *imageBuild.Status.Architecture != "" {
-		platform = *imageBuild.Status.Architecture
-	}
+	architectures := domain.ImageBuildArchitectures(spec)
 
 	log.WithFields(logrus.Fields{
-		"imageRef": imageRef,
-		"platform": platform,
+		"imageRef":      imageRef,
+		"architectures": architectures,
 	}).Info("Starting podman build")
 
-	// Write build context files (Containerfile, agent-config.yaml, user-publickey.txt)
+	// Fail early if an architecture cannot be built on this worker, rather than after
+	// building the other architectures
+	for _, architecture := range architectures {
+		if err := checkArchitectureEmulation(architecture); err != nil {
+			if podmanWorker.statusUpdater != nil {
+				podmanWorker.statusUpdater.UpdateArchitecture(architecture, domain.ImageBuildConditionReasonFailed, err.Error(), "")
+			}
+			return err
+		}
+	}
+
+	// Write build context files (Containerfile, agent-config.yaml, user-publickey.txt, customizations)
 	if err := writeBuildContextFiles(podmanWorker.TmpDir, containerfileResult); err != nil {
 		return err
 	}
@@ -1099,25 +1252,24 @@ func (c *Consumer) buildImageWithPodman(
 	// Container paths
 	containerBuildDir := "/build"
 
-	// ociSpec.Registry is already the hostname (no scheme)
-	sourceRegistryHostname := ociSpec.Registry
-
 	// Login to source registry using podman login with stdin
 	// This is used to pull the base image during build
-	if ociSpec.OciAuth != nil {
-		dockerAuth, err := ociSpec.OciAuth.AsDockerAuth()
-		if err == nil && dockerAuth.Username != "" && dockerAuth.Password != "" {
-			decryptedPassword, ok, decErr := encryption.Decrypt(ctx, encryption.Ciphertext(dockerAuth.Password))
-			if decErr != nil {
-				return fmt.Errorf("failed to decrypt OCI password: %w", decErr)
-			}
-			if !ok {
-				log.WithField("registry", sourceRegistryHostname).Warn("source registry password is stored as plaintext; expected an encrypted value")
-			}
-			if err := c.loginToRegistry(ctx, podmanWorker, sourceRegistryHostname, dockerAuth.Username, string(decryptedPassword), ociSpec, log); err != nil {
-				return fmt.Errorf("failed to login to source registry: %w", err)
-			}
+	loggedIn, err := c.loginToOciRepo(ctx, podmanWorker, ociSpec, "source", log)
+	if err != nil {
+		return err
+	}
+
+	// Login to the registries of embedded images. The credentials are passed to the build
+	// as a secret, as the images are pulled by podman running inside the build.
+	for _, image := range containerfileResult.EmbeddedImages {
+		if image.Repository == nil {
+			continue
 		}
+		embeddedLoggedIn, err := c.loginToOciRepo(ctx, podmanWorker, image.Repository, "embedded image", log)
+		if err != nil {
+			return err
+		}
+		loggedIn = loggedIn || embeddedLoggedIn
 	}
 
 	containerContainerfilePath := filepath.Join(containerBuildDir, "Containerfile")
@@ -1140,11 +1292,7 @@ func (c *Consumer) buildImageWithPodman(
 	// Authentication is handled via podman login above
 	// Build arguments are passed via --build-arg for safer execution (no shell injection risk)
 	args := containerfileResult.BuildArgs
-	podmanBuildArgs := []string{
-		"build",
-		"--platform", platform,
-		"--manifest", imageRef,
-	}
+	var podmanBuildArgs []string
 
 	// Disable TLS verification for HTTP source registries or when explicitly requested
 	if ociSpec.Scheme != nil && *ociSpec.Scheme == coredomain.OciRepoSchemeHttp {
@@ -1172,6 +1320,11 @@ func (c *Consumer) buildImageWithPodman(
 		"--build-arg", fmt.Sprintf("DNF_SKIP_UNAVAILABLE=%t", args.DNFSkipUnavailable),
 	)
 
+	// Pulling embedded images runs podman inside the build, which needs extra privileges
+	if len(containerfileResult.EmbeddedImages) > 0 {
+		podmanBuildArgs = append(podmanBuildArgs, embeddedImagesBuildArgs(loggedIn)...)
+	}
+
 	// Mount entitlement certs into the build if available (for RHEL subscription repos)
 	if podmanWorker.HasEntitlementCerts {
 		podmanBuildArgs = append(podmanBuildArgs, "--volume", fmt.Sprintf("%s:%s:ro", entitlementCertsPath, entitlementCertsPath))
@@ -1182,9 +1335,13 @@ func (c *Consumer) buildImageWithPodman(
 		containerBuildDir,
 	)
 
+	// Build each architecture into the manifest list, one after the other. Architectures
+	// other than the one of the worker are built through emulation.
 	buildEnvVars := authFileEnv()
-	if err := podmanWorker.runInWorker(ctx, log, "build", buildEnvVars, podmanBuildArgs...); err != nil {
-		return err
+	for _, architecture := range architectures {
+		if err := c.buildArchitectureWithPodman(ctx, podmanWorker, architecture, imageRef, buildEnvVars, podmanBuildArgs, log); err != nil {
+			return err
+		}
 	}
 
 	log.Info("Phase: Build Completed")
@@ -1192,6 +1349,41 @@ func (c *Consumer) buildImageWithPodman(
 	return nil
 }
 
+// buildArchitectureWithPodman builds the image for an architecture into the manifest list
+// imageRef, reporting the status of the architecture and prefixing its build output.
+func (c *Consumer) buildArchitectureWithPodman(
+	ctx context.Context,
+	podmanWorker *podmanWorker,
+	architecture domain.ImageArchitecture,
+	imageRef string,
+	envVars map[string]string,
+	buildArgs []string,
+	log logrus.FieldLogger,
+) error {
+	platform := platformForArchitecture(architecture)
+	log = log.WithField("platform", platform)
+	statusUpdater := podmanWorker.statusUpdater
+
+	if statusUpdater != nil {
+		statusUpdater.UpdateArchitecture(architecture, domain.ImageBuildConditionReasonBuilding, fmt.Sprintf("Building for %s", platform), "")
+	}
+	log.Info("Building architecture")
+
+	podmanBuildArgs := append([]string{"build", "--platform", platform, "--manifest", imageRef}, buildArgs...)
+	logPrefix := domain.ImageBuildArchitectureLogPrefix(architecture)
+	if err := podmanWorker.runInWorkerWithLogPrefix(ctx, log, "build "+platform, logPrefix, envVars, podmanBuildArgs...); err != nil {
+		if statusUpdater != nil && ctx.Err() == nil {
+			statusUpdater.UpdateArchitecture(architecture, domain.ImageBuildConditionReasonFailed, fmt.Sprintf("Build for %s failed", platform), "")
+		}
+		return err
+	}
+
+	if statusUpdater != nil {
+		statusUpdater.UpdateArchitecture(architecture, domain.ImageBuildConditionReasonPushing, fmt.Sprintf("Built for %s, waiting for push", platform), "")
+	}
+	return nil
+}
+
 // pushImageWithPodman pushes the built image to the destination registry.
 // It returns the image reference and manifest digest of the pushed image.
 func (c *Consumer) pushImageWithPodman(
@@ -0,0 +1,75 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// binfmtMiscDir is where the kernel exposes the registered binfmt_misc interpreters.
+// It is a variable so tests can point it to a temporary directory.
+var binfmtMiscDir = "/proc/sys/fs/binfmt_misc"
+
+// qemuBinfmtNames maps architectures to the names qemu-user-static registers them with
+var qemuBinfmtNames = map[domain.ImageArchitecture]string{
+	domain.ImageArchitectureAmd64: "qemu-x86_64",
+	domain.ImageArchitectureArm64: "qemu-aarch64",
+}
+
+// platformForArchitecture returns the OCI platform to build or export an architecture for
+func platformForArchitecture(architecture domain.ImageArchitecture) string {
+	return "linux/" + string(architecture)
+}
+
+// checkArchitectureEmulation returns an error if an architecture can neither be built
+// natively nor through emulation on this worker. The worker container shares the kernel
+// of the host, so emulation must be registered with binfmt_misc on the host.
+func checkArchitectureEmulation(architecture domain.ImageArchitecture) error {
+	if string(architecture) == runtime.GOARCH {
+		return nil
+	}
+	name, ok := qemuBinfmtNames[architecture]
+	if !ok {
+		return fmt.Errorf("unsupported architecture %q", architecture)
+	}
+	if _, err := os.Stat(filepath.Join(binfmtMiscDir, name)); err != nil {
+		return fmt.Errorf("cannot build for architecture %q on a %s worker: emulation is not available (%s is not registered with binfmt_misc, install qemu-user-static on the worker host)",
+			architecture, runtime.GOARCH, name)
+	}
+	return nil
+}
+
+// inspectManifestDigests returns the digests of the per-architecture images of the
+// manifest list built in the worker.
+func inspectManifestDigests(ctx context.Context, podmanWorker *podmanWorker, imageRef string) (map[domain.ImageArchitecture]string, error) {
+	cmd := exec.CommandContext(ctx, "podman", "exec", podmanWorker.ContainerName, "podman", "manifest", "inspect", imageRef)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect manifest list %q: %w", imageRef, err)
+	}
+	return parseManifestDigests(out)
+}
+
+// parseManifestDigests returns the digests of the per-architecture images of an OCI image
+// index (or Docker manifest list) by architecture.
+func parseManifestDigests(data []byte) (map[domain.ImageArchitecture]string, error) {
+	var index ocispec.Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest list: %w", err)
+	}
+	digests := make(map[domain.ImageArchitecture]string, len(index.Manifests))
+	for _, manifest := range index.Manifests {
+		if manifest.Platform == nil || manifest.Platform.OS != "linux" {
+			continue
+		}
+		digests[domain.ImageArchitecture(manifest.Platform.Architecture)] = manifest.Digest.String()
+	}
+	return digests, nil
+}
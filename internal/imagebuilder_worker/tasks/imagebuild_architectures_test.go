@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifestDigests(t *testing.T) {
+	require := require.New(t)
+
+	digests, err := parseManifestDigests([]byte(`{
+		"schemaVersion": 2,
+		"mediaType": "application/vnd.oci.image.index.v1+json",
+		"manifests": [
+			{
+				"mediaType": "application/vnd.oci.image.manifest.v1+json",
+				"digest": "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+				"size": 1000,
+				"platform": {"architecture": "amd64", "os": "linux"}
+			},
+			{
+				"mediaType": "application/vnd.oci.image.manifest.v1+json",
+				"digest": "sha256:2222222222222222222222222222222222222222222222222222222222222222",
+				"size": 1000,
+				"platform": {"architecture": "arm64", "os": "linux", "variant": "v8"}
+			},
+			{
+				"mediaType": "application/vnd.oci.image.manifest.v1+json",
+				"digest": "sha256:3333333333333333333333333333333333333333333333333333333333333333",
+				"size": 1000
+			}
+		]
+	}`))
+	require.NoError(err)
+	require.Equal(map[domain.ImageArchitecture]string{
+		domain.ImageArchitectureAmd64: "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+		domain.ImageArchitectureArm64: "sha256:2222222222222222222222222222222222222222222222222222222222222222",
+	}, digests)
+
+	_, err = parseManifestDigests([]byte("not json"))
+	require.Error(err)
+}
+
+func TestCheckArchitectureEmulation(t *testing.T) {
+	require := require.New(t)
+	origDir := binfmtMiscDir
+	binfmtMiscDir = t.TempDir()
+	t.Cleanup(func() { binfmtMiscDir = origDir })
+
+	// The native architecture never needs emulation
+	require.NoError(checkArchitectureEmulation(domain.ImageArchitecture(runtime.GOARCH)))
+
+	foreign := domain.ImageArchitectureArm64
+	if runtime.GOARCH == string(domain.ImageArchitectureArm64) {
+		foreign = domain.ImageArchitectureAmd64
+	}
+	err := checkArchitectureEmulation(foreign)
+	require.ErrorContains(err, "emulation is not available")
+
+	require.NoError(os.WriteFile(filepath.Join(binfmtMiscDir, qemuBinfmtNames[foreign]), []byte("enabled\n"), 0600))
+	require.NoError(checkArchitectureEmulation(foreign))
+
+	require.ErrorContains(checkArchitectureEmulation("s390x"), "unsupported architecture")
+}
+
+func TestStatusWriter_Prefix(t *testing.T) {
+	require := require.New(t)
+	var buf bytes.Buffer
+	writer := &statusWriter{buf: &buf, prefix: domain.ImageBuildArchitectureLogPrefix(domain.ImageArchitectureArm64)}
+
+	// Lines are only written once complete, even when split across writes
+	_, err := writer.Write([]byte("STEP 1/3: FROM"))
+	require.NoError(err)
+	require.Empty(buf.String())
+	_, err = writer.Write([]byte(" fedora-bootc\nSTEP 2/3: RUN dnf"))
+	require.NoError(err)
+	require.Equal("[arm64] STEP 1/3: FROM fedora-bootc\n", buf.String())
+	_, err = writer.Write([]byte(" install\nSTEP 3/3"))
+	require.NoError(err)
+	writer.Flush()
+	require.Equal("[arm64] STEP 1/3: FROM fedora-bootc\n[arm64] STEP 2/3: RUN dnf install\n[arm64] STEP 3/3\n", buf.String())
+
+	// Output without a prefix is written as is
+	buf.Reset()
+	writer = &statusWriter{buf: &buf}
+	_, err = writer.Write([]byte("partial"))
+	require.NoError(err)
+	writer.Flush()
+	require.Equal("partial", buf.String())
+}
+
+func TestPlatformForArchitecture(t *testing.T) {
+	require.Equal(t, "linux/arm64", platformForArchitecture(domain.ImageArchitectureArm64))
+	require.Equal(t, "linux/amd64", platformForArchitecture(domain.ImageArchitectureAmd64))
+}
@@ -0,0 +1,254 @@
+package tasks
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/config"
+	coredomain "github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	imagebuilderservice "github.com/flightctl/flightctl/internal/imagebuilder_api/service"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// customizationsDir is the build context directory the customizations are rendered to.
+	// It must always exist (even if empty) because the COPY instruction is unconditional.
+	customizationsDir = "customizations"
+
+	// Files of the customizations directory, read by the Containerfile. Missing files mean
+	// there is nothing to apply.
+	customizationsPackagesFile      = "packages.txt"
+	customizationsReposDir          = "repos"
+	customizationsFilesDir          = "files"
+	customizationsEnabledUnitsFile  = "units-enabled.txt"
+	customizationsDisabledUnitsFile = "units-disabled.txt"
+	customizationsKargsFile         = "kargs.toml"
+	customizationsFirewallPortsFile = "firewall-ports.txt"
+
+	// defaultCustomizationFileMode is the mode of inline files without an explicit mode
+	defaultCustomizationFileMode = 0644
+)
+
+// a function to clone a git repo, for mockable unit testing
+type cloneGitRepoFunc func(ctx context.Context, repo *coredomain.Repository, revision *string, depth *int, cfg *config.Config) (billy.Filesystem, string, error)
+
+// buildContextFile is a file of the build context. For symlinks, Content is the link target.
+type buildContextFile struct {
+	Content []byte
+	Mode    os.FileMode
+}
+
+// renderCustomizations renders the customizations of an image build to the files of the
+// customizations directory, keyed by their path relative to it. Directories from Git
+// repositories are cloned, and inline files take precedence over them.
+func (c *Consumer) renderCustomizations(
+	ctx context.Context,
+	orgID uuid.UUID,
+	customizations *domain.ImageBuildCustomizations,
+	cloneGitRepo cloneGitRepoFunc,
+) (map[string]buildContextFile, error) {
+	files := make(map[string]buildContextFile)
+	if customizations == nil {
+		return files, nil
+	}
+
+	// Validate again before rendering (defense-in-depth), as the values end up in shell commands
+	if errs := imagebuilderservice.ValidateCustomizations(customizations, "spec.customizations"); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid customizations: %w", errors.Join(errs...))
+	}
+
+	if packages := lo.FromPtr(customizations.Packages); len(packages) > 0 {
+		files[customizationsPackagesFile] = lines(packages)
+	}
+	for _, repository := range lo.FromPtr(customizations.PackageRepositories) {
+		files[path.Join(customizationsReposDir, repository.Name+".repo")] = buildContextFile{Content: renderRepoFile(repository), Mode: 0644}
+	}
+
+	for i, directory := range lo.FromPtr(customizations.Directories) {
+		if err := c.renderDirectory(ctx, orgID, directory, cloneGitRepo, files); err != nil {
+			return nil, fmt.Errorf("spec.customizations.directories[%d]: %w", i, err)
+		}
+	}
+	for _, file := range lo.FromPtr(customizations.Files) {
+		content := []byte(file.Content)
+		if lo.FromPtr(file.ContentEncoding) == domain.EncodingBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(file.Content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode content of %s: %w", file.Path, err)
+			}
+			content = decoded
+		}
+		mode := os.FileMode(lo.FromPtrOr(file.Mode, defaultCustomizationFileMode))
+		files[path.Join(customizationsFilesDir, file.Path)] = buildContextFile{Content: content, Mode: toFileMode(mode)}
+	}
+
+	if units := lo.FromPtr(customizations.EnabledUnits); len(units) > 0 {
+		files[customizationsEnabledUnitsFile] = lines(units)
+	}
+	if units := lo.FromPtr(customizations.DisabledUnits); len(units) > 0 {
+		files[customizationsDisabledUnitsFile] = lines(units)
+	}
+	if kargs := lo.FromPtr(customizations.KernelArguments); len(kargs) > 0 {
+		quoted := lo.Map(kargs, func(karg string, _ int) string { return strconv.Quote(karg) })
+		files[customizationsKargsFile] = buildContextFile{Content: []byte(fmt.Sprintf("kargs = [%s]\n", strings.Join(quoted, ", "))), Mode: 0644}
+	}
+	if ports := lo.FromPtr(customizations.FirewallPorts); len(ports) > 0 {
+		files[customizationsFirewallPortsFile] = lines(ports)
+	}
+	return files, nil
+}
+
+// renderDirectory adds the files of a directory of a Git repository below its mount path.
+// Like Git config providers, a path to a single file adds that file to the mount path.
+func (c *Consumer) renderDirectory(
+	ctx context.Context,
+	orgID uuid.UUID,
+	directory domain.ImageBuildDirectory,
+	cloneGitRepo cloneGitRepoFunc,
+	files map[string]buildContextFile,
+) error {
+	repo, err := c.repositoryStore.Get(ctx, orgID, directory.Repository)
+	if err != nil {
+		return fmt.Errorf("failed to get repository %q: %w", directory.Repository, err)
+	}
+	mfs, _, err := cloneGitRepo(ctx, repo, &directory.TargetRevision, nil, c.cfg)
+	if err != nil {
+		return fmt.Errorf("failed to clone repository %q: %w", directory.Repository, err)
+	}
+
+	root := path.Join("/", directory.Path)
+	rootInfo, err := mfs.Lstat(root)
+	if err != nil {
+		return fmt.Errorf("failed accessing path %s of repository %q: %w", directory.Path, directory.Repository, err)
+	}
+	prefix := path.Join(customizationsFilesDir, directory.MountPath)
+	if !rootInfo.IsDir() {
+		prefix = path.Join(prefix, rootInfo.Name())
+	}
+
+	return util.Walk(mfs, root, func(name string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		target := path.Join(prefix, filepath.ToSlash(rel))
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := mfs.Readlink(name)
+			if err != nil {
+				return fmt.Errorf("failed reading symlink %s: %w", name, err)
+			}
+			files[target] = buildContextFile{Content: []byte(link), Mode: os.ModeSymlink | 0777}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := mfs.Open(name)
+		if err != nil {
+			return fmt.Errorf("failed opening %s: %w", name, err)
+		}
+		defer f.Close()
+		content, err := io.ReadAll(f)
+		if err != nil {
+			return fmt.Errorf("failed reading %s: %w", name, err)
+		}
+		files[target] = buildContextFile{Content: content, Mode: info.Mode().Perm()}
+		return nil
+	})
+}
+
+// writeCustomizations writes the rendered customizations to the customizations directory
+// of the build context. The directory is created even if there are no customizations.
+func writeCustomizations(tmpDir string, files map[string]buildContextFile) error {
+	dir := filepath.Join(tmpDir, customizationsDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s directory: %w", customizationsDir, err)
+	}
+	// Symlinks are created after all other files, so that no file is written through a
+	// symlink to outside of the build context
+	names := lo.Keys(files)
+	slices.SortFunc(names, func(a, b string) int {
+		aLink, bLink := files[a].Mode&os.ModeSymlink != 0, files[b].Mode&os.ModeSymlink != 0
+		if aLink != bLink {
+			return lo.Ternary(aLink, 1, -1)
+		}
+		return strings.Compare(a, b)
+	})
+	for _, name := range names {
+		file := files[name]
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if !strings.HasPrefix(target, dir+string(filepath.Separator)) {
+			return fmt.Errorf("customization file %q is outside of the %s directory", name, customizationsDir)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", name, err)
+		}
+		if file.Mode&os.ModeSymlink != 0 {
+			if err := os.Symlink(string(file.Content), target); err != nil {
+				return fmt.Errorf("failed to write %s: %w", name, err)
+			}
+			continue
+		}
+		if err := os.WriteFile(target, file.Content, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		// COPY preserves the mode, so set it explicitly rather than subject to the umask
+		if err := os.Chmod(target, file.Mode); err != nil {
+			return fmt.Errorf("failed to set mode of %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// renderRepoFile renders a dnf .repo file for the repository. The values are validated
+// to not contain whitespace, so they cannot add lines to the file.
+func renderRepoFile(repository domain.ImageBuildPackageRepository) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s]\nname=%s\nbaseurl=%s\nenabled=1\n", repository.Name, repository.Name, repository.Baseurl)
+	fmt.Fprintf(&b, "gpgcheck=%d\n", lo.Ternary(lo.FromPtrOr(repository.Gpgcheck, true), 1, 0))
+	if repository.Gpgkey != nil {
+		fmt.Fprintf(&b, "gpgkey=%s\n", *repository.Gpgkey)
+	}
+	return []byte(b.String())
+}
+
+func lines(values []string) buildContextFile {
+	return buildContextFile{Content: []byte(strings.Join(values, "\n") + "\n"), Mode: 0644}
+}
+
+// toFileMode converts a Unix permission mode, which may include the setuid, setgid and
+// sticky bits, to an os.FileMode.
+func toFileMode(mode os.FileMode) os.FileMode {
+	fileMode := mode.Perm()
+	if mode&04000 != 0 {
+		fileMode |= os.ModeSetuid
+	}
+	if mode&02000 != 0 {
+		fileMode |= os.ModeSetgid
+	}
+	if mode&01000 != 0 {
+		fileMode |= os.ModeSticky
+	}
+	return fileMode
+}
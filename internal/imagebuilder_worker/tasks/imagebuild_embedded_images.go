@@ -0,0 +1,138 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	coredomain "github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	imagebuilderservice "github.com/flightctl/flightctl/internal/imagebuilder_api/service"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// embeddedImagesFile is the build context file listing the images to embed, one per line
+	// with the value of --tls-verify to pull it with. It must always exist (even if empty)
+	// because the COPY instruction is unconditional.
+	embeddedImagesFile = "embedded-images.txt"
+
+	// registryAuthSecretID is the ID of the build secret holding the registry credentials
+	// for pulling embedded images
+	registryAuthSecretID = "registry-auth"
+)
+
+// embeddedImage is an image to embed into the built image, resolved to an image reference
+type embeddedImage struct {
+	Reference string
+	TLSVerify bool
+	// Repository is the spec of the OCI repository providing the pull credentials, if any
+	Repository *coredomain.OciRepoSpec
+}
+
+// resolveEmbeddedImages resolves the images to embed into the built image, looking up the
+// image references of catalog items and the OCI repositories providing pull credentials.
+func (c *Consumer) resolveEmbeddedImages(
+	ctx context.Context,
+	orgID uuid.UUID,
+	embeddedImages []domain.ImageBuildEmbeddedImage,
+) ([]embeddedImage, error) {
+	// Validate again before resolving (defense-in-depth), as the references end up in shell commands
+	if errs := imagebuilderservice.ValidateEmbeddedImages(embeddedImages, "spec.embeddedImages"); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid embedded images: %w", errors.Join(errs...))
+	}
+
+	resolved := make([]embeddedImage, 0, len(embeddedImages))
+	seen := make(map[string]struct{}, len(embeddedImages))
+	for i, image := range embeddedImages {
+		reference := lo.FromPtr(image.Image)
+		if image.CatalogItemRef != nil {
+			var err error
+			reference, err = c.resolveCatalogItemImage(ctx, orgID, *image.CatalogItemRef)
+			if err != nil {
+				return nil, fmt.Errorf("spec.embeddedImages[%d]: %w", i, err)
+			}
+			if errs := validation.ValidateOciImageReferenceStrict(&reference, fmt.Sprintf("spec.embeddedImages[%d]", i)); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid image reference of catalog item: %w", errors.Join(errs...))
+			}
+		}
+		// An image may be referenced both directly and by a catalog item
+		if _, ok := seen[reference]; ok {
+			continue
+		}
+		seen[reference] = struct{}{}
+
+		resolvedImage := embeddedImage{Reference: reference, TLSVerify: true}
+		if image.Repository != nil {
+			ociSpec, err := c.getOciRepoSpec(ctx, orgID, *image.Repository, "embedded image")
+			if err != nil {
+				return nil, fmt.Errorf("spec.embeddedImages[%d]: %w", i, err)
+			}
+			// Credentials are stored per registry, so they only apply to images of that registry
+			if !strings.HasPrefix(reference, ociSpec.Registry+"/") {
+				return nil, fmt.Errorf("spec.embeddedImages[%d]: image %q is not in registry %q of repository %q", i, reference, ociSpec.Registry, *image.Repository)
+			}
+			resolvedImage.Repository = ociSpec
+			resolvedImage.TLSVerify = !isInsecureOciRepo(ociSpec)
+		}
+		resolved = append(resolved, resolvedImage)
+	}
+	return resolved, nil
+}
+
+// resolveCatalogItemImage returns the image reference of the container artifact of a
+// catalog item version.
+func (c *Consumer) resolveCatalogItemImage(ctx context.Context, orgID uuid.UUID, ref coredomain.CatalogItemRefSpec) (string, error) {
+	item, err := c.catalogStore.GetItem(ctx, orgID, ref.Catalog, ref.Item)
+	if err != nil {
+		return "", fmt.Errorf("failed to get catalog item %s/%s: %w", ref.Catalog, ref.Item, err)
+	}
+	if item.Spec.Type == coredomain.CatalogItemTypeOS {
+		return "", fmt.Errorf("catalog item %s/%s of type %s cannot be embedded", ref.Catalog, ref.Item, item.Spec.Type)
+	}
+
+	version := item.Spec.FindVersion(ref.Version)
+	if version == nil {
+		return "", fmt.Errorf("unknown version %s for catalog item %s/%s", ref.Version, ref.Catalog, ref.Item)
+	}
+	artifact := item.Spec.FindArtifact(coredomain.CatalogItemArtifactTypeContainer)
+	if artifact == nil {
+		return "", fmt.Errorf("catalog item %s/%s must have a container artifact", ref.Catalog, ref.Item)
+	}
+	tag := version.References[coredomain.CatalogItemArtifactTypeContainer]
+	if tag == "" {
+		return "", fmt.Errorf("catalog item version %s for %s/%s lacks container version ref", ref.Version, ref.Catalog, ref.Item)
+	}
+
+	sep := ":"
+	if strings.Contains(tag, ":") {
+		sep = "@"
+	}
+	return artifact.Uri + sep + tag, nil
+}
+
+// writeEmbeddedImages writes the list of images to embed to the build context. The file
+// is written even if there are no images to embed.
+func writeEmbeddedImages(tmpDir string, images []embeddedImage) error {
+	var b strings.Builder
+	for _, image := range images {
+		fmt.Fprintf(&b, "%s %t\n", image.Reference, image.TLSVerify)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, embeddedImagesFile), []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", embeddedImagesFile, err)
+	}
+	return nil
+}
+
+// isInsecureOciRepo returns whether TLS verification is disabled for the OCI repository
+func isInsecureOciRepo(ociSpec *coredomain.OciRepoSpec) bool {
+	if ociSpec.Scheme != nil && *ociSpec.Scheme == coredomain.OciRepoSchemeHttp {
+		return true
+	}
+	return ociSpec.SkipServerVerification != nil && *ociSpec.SkipServerVerification
+}
@@ -0,0 +1,158 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	api "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
+	coredomain "github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEmbeddedImagesConsumer(itemType coredomain.CatalogItemType) *Consumer {
+	repoStore := newMockRepositoryStore()
+	repoStore.repositories["private"] = createTestRepository("private", "registry.example.com", nil)
+	repoStore.repositories["insecure"] = createTestRepository("insecure", "10.0.0.5:5000", lo.ToPtr(v1beta1.Http))
+
+	catalogWriter := newDummyCatalogItemWriter()
+	catalogWriter.items["apps/web"] = &coredomain.CatalogItem{
+		Metadata: coredomain.CatalogItemMeta{Name: lo.ToPtr("web")},
+		Spec: coredomain.CatalogItemSpec{
+			Type: itemType,
+			Artifacts: []coredomain.CatalogItemArtifact{
+				{Type: coredomain.CatalogItemArtifactTypeContainer, Uri: "registry.example.com/apps/web"},
+			},
+			Versions: []coredomain.CatalogItemVersion{
+				{Version: "1.0.0", Channels: []string{"stable"}, References: map[v1alpha1.CatalogItemArtifactType]string{coredomain.CatalogItemArtifactTypeContainer: "v1"}},
+				{Version: "1.0.1", Channels: []string{"stable"}, References: map[v1alpha1.CatalogItemArtifactType]string{coredomain.CatalogItemArtifactTypeContainer: "sha256:0f1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"}},
+			},
+		},
+	}
+	return &Consumer{
+		repositoryStore: repoStore,
+		catalogStore:    &dummyCatalogStoreAdapter{catalogWriter},
+		log:             log.InitLogs(),
+	}
+}
+
+func TestResolveEmbeddedImages(t *testing.T) {
+	require := require.New(t)
+	c := newTestEmbeddedImagesConsumer(coredomain.CatalogItemTypeContainer)
+
+	images, err := c.resolveEmbeddedImages(context.Background(), uuid.New(), []api.ImageBuildEmbeddedImage{
+		{Image: lo.ToPtr("quay.io/flightctl/nginx:1.27")},
+		{Image: lo.ToPtr("10.0.0.5:5000/apps/db:16"), Repository: lo.ToPtr("insecure")},
+		{CatalogItemRef: &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "web", Version: "1.0.0"}, Repository: lo.ToPtr("private")},
+		{CatalogItemRef: &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "web", Version: "1.0.1"}},
+		// resolves to an image that is already listed
+		{Image: lo.ToPtr("registry.example.com/apps/web:v1")},
+	})
+	require.NoError(err)
+	require.Len(images, 4)
+
+	require.Equal("quay.io/flightctl/nginx:1.27", images[0].Reference)
+	require.True(images[0].TLSVerify)
+	require.Nil(images[0].Repository)
+
+	require.Equal("10.0.0.5:5000/apps/db:16", images[1].Reference)
+	require.False(images[1].TLSVerify)
+	require.NotNil(images[1].Repository)
+
+	require.Equal("registry.example.com/apps/web:v1", images[2].Reference)
+	require.True(images[2].TLSVerify)
+	require.Equal("registry.example.com", images[2].Repository.Registry)
+
+	require.Equal("registry.example.com/apps/web@sha256:0f1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9", images[3].Reference)
+}
+
+func TestResolveEmbeddedImages_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		itemType      coredomain.CatalogItemType
+		image         api.ImageBuildEmbeddedImage
+		expectedError string
+	}{
+		{
+			name:          "invalid reference",
+			itemType:      coredomain.CatalogItemTypeContainer,
+			image:         api.ImageBuildEmbeddedImage{Image: lo.ToPtr("quay.io/app:v1;reboot")},
+			expectedError: "invalid embedded images",
+		},
+		{
+			name:          "unknown catalog item",
+			itemType:      coredomain.CatalogItemTypeContainer,
+			image:         api.ImageBuildEmbeddedImage{CatalogItemRef: &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "db", Version: "1.0.0"}},
+			expectedError: "failed to get catalog item apps/db",
+		},
+		{
+			name:          "unknown version",
+			itemType:      coredomain.CatalogItemTypeContainer,
+			image:         api.ImageBuildEmbeddedImage{CatalogItemRef: &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "web", Version: "2.0.0"}},
+			expectedError: "unknown version 2.0.0",
+		},
+		{
+			name:          "OS catalog item",
+			itemType:      coredomain.CatalogItemTypeOS,
+			image:         api.ImageBuildEmbeddedImage{CatalogItemRef: &v1beta1.CatalogItemRefSpec{Catalog: "apps", Item: "web", Version: "1.0.0"}},
+			expectedError: "cannot be embedded",
+		},
+		{
+			name:          "unknown repository",
+			itemType:      coredomain.CatalogItemTypeContainer,
+			image:         api.ImageBuildEmbeddedImage{Image: lo.ToPtr("quay.io/app:v1"), Repository: lo.ToPtr("missing")},
+			expectedError: "failed to get embedded image repository",
+		},
+		{
+			name:          "image of another registry",
+			itemType:      coredomain.CatalogItemTypeContainer,
+			image:         api.ImageBuildEmbeddedImage{Image: lo.ToPtr("quay.io/app:v1"), Repository: lo.ToPtr("private")},
+			expectedError: `is not in registry "registry.example.com"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestEmbeddedImagesConsumer(tt.itemType)
+			_, err := c.resolveEmbeddedImages(context.Background(), uuid.New(), []api.ImageBuildEmbeddedImage{tt.image})
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestWriteEmbeddedImages(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+
+	// the file is written even without embedded images
+	require.NoError(writeEmbeddedImages(tmpDir, nil))
+	content, err := os.ReadFile(filepath.Join(tmpDir, embeddedImagesFile))
+	require.NoError(err)
+	require.Empty(content)
+
+	require.NoError(writeEmbeddedImages(tmpDir, []embeddedImage{
+		{Reference: "quay.io/flightctl/nginx:1.27", TLSVerify: true},
+		{Reference: "10.0.0.5:5000/apps/db:16", TLSVerify: false},
+	}))
+	content, err = os.ReadFile(filepath.Join(tmpDir, embeddedImagesFile))
+	require.NoError(err)
+	require.Equal("quay.io/flightctl/nginx:1.27 true\n10.0.0.5:5000/apps/db:16 false\n", string(content))
+}
+
+func TestEmbeddedImagesBuildArgs(t *testing.T) {
+	require.NotContains(t, embeddedImagesBuildArgs(false), "--secret")
+	args := embeddedImagesBuildArgs(true)
+	require.Contains(t, args, "--device=/dev/fuse")
+	require.Contains(t, args, "id="+registryAuthSecretID+",src="+containerAuthFile)
+}
+
+func TestContainerfileTemplate_EmbeddedImages(t *testing.T) {
+	require.Contains(t, containerfileTemplate, "COPY "+embeddedImagesFile+" /tmp/"+embeddedImagesFile)
+	require.Contains(t, containerfileTemplate, "--mount=type=secret,id="+registryAuthSecretID)
+	require.Contains(t, containerfileTemplate, "/usr/lib/flightctl/embedded-images")
+}
@@ -21,6 +21,7 @@ type statusUpdateRequest struct {
 	LastSeen       *time.Time
 	ImageReference *string
 	ManifestDigest *string
+	Architecture   *domain.ImageBuildArchitectureStatus
 	// done is closed when the update has been processed (used for terminal conditions)
 	done chan struct{}
 }
@@ -113,6 +114,7 @@ func (u *statusUpdater) run(cfg *config.Config) {
 	var pendingCondition *domain.ImageBuildCondition
 	var pendingImageReference *string
 	var pendingManifestDigest *string
+	var pendingArchitectures []domain.ImageBuildArchitectureStatus
 	lastSeenUpdateTime := time.Now().UTC()
 
 	// Track the last time output was received - updated when new output arrives
@@ -140,12 +142,13 @@ func (u *statusUpdater) run(cfg *config.Config) {
 					// Store a copy of the time we're setting
 					lastSetLastSeenCopy := *lastOutputTime
 					lastSetLastSeen = &lastSetLastSeenCopy
-					u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference, pendingManifestDigest)
+					u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference, pendingManifestDigest, pendingArchitectures)
 					// Also persist logs to DB periodically
 					u.persistLogsToDB()
 					pendingCondition = nil      // Clear after update
 					pendingImageReference = nil // Clear after update
 					pendingManifestDigest = nil // Clear after update
+					pendingArchitectures = nil  // Clear after update
 				}
 			}
 		case output := <-u.outputChan:
@@ -173,12 +176,16 @@ func (u *statusUpdater) run(cfg *config.Config) {
 			if req.ManifestDigest != nil {
 				pendingManifestDigest = req.ManifestDigest
 			}
-			// Update immediately when condition, image reference, or manifest digest changes
-			if req.Condition != nil || req.ImageReference != nil || req.ManifestDigest != nil {
-				u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference, pendingManifestDigest)
+			if req.Architecture != nil {
+				pendingArchitectures = append(pendingArchitectures, *req.Architecture)
+			}
+			// Update immediately when condition, image reference, manifest digest, or architecture status changes
+			if req.Condition != nil || req.ImageReference != nil || req.ManifestDigest != nil || req.Architecture != nil {
+				u.updateStatus(pendingCondition, &lastSeenUpdateTime, pendingImageReference, pendingManifestDigest, pendingArchitectures)
 				pendingCondition = nil      // Clear after update
 				pendingImageReference = nil // Clear after update
 				pendingManifestDigest = nil // Clear after update
+				pendingArchitectures = nil  // Clear after update
 			}
 			// Signal completion if done channel exists (used for synchronous updates)
 			if req.done != nil {
@@ -188,12 +195,13 @@ func (u *statusUpdater) run(cfg *config.Config) {
 	}
 }
 
-// updateStatus performs the actual database update, merging conditions, LastSeen, ImageReference, and ManifestDigest
+// updateStatus performs the actual database update, merging conditions, LastSeen, ImageReference, ManifestDigest,
+// and the status of architectures
 // Note: Uses u.ctx (updaterCtx) which is derived from the consumer context, NOT the build context.
 // When cancelBuild() is called, only buildCtx is canceled - updaterCtx remains valid until
 // cleanupStatusUpdater() is called, which happens AFTER processImageBuild() returns.
 // This ensures we can still write the final status (e.g., Canceled) after the build is canceled.
-func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, lastSeen *time.Time, imageReference *string, manifestDigest *string) {
+func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, lastSeen *time.Time, imageReference *string, manifestDigest *string, architectures []domain.ImageBuildArchitectureStatus) {
 	// Load current status from database
 	imageBuild, status := u.imageBuildService.Get(u.ctx, u.orgID, u.imageBuildName, false)
 	if imageBuild == nil || !imagebuilderapi.IsStatusOK(status) {
@@ -259,6 +267,14 @@ func (u *statusUpdater) updateStatus(condition *domain.ImageBuildCondition, last
 		imageBuild.Status.ManifestDigest = manifestDigest
 	}
 
+	// Update the status of architectures if provided
+	for _, architecture := range architectures {
+		if imageBuild.Status.Architectures == nil {
+			imageBuild.Status.Architectures = &[]domain.ImageBuildArchitectureStatus{}
+		}
+		domain.SetImageBuildArchitectureStatus(imageBuild.Status.Architectures, architecture)
+	}
+
 	// Write updated status atomically
 	_, err := u.imageBuildService.UpdateStatus(u.ctx, u.orgID, imageBuild)
 	if err != nil {
@@ -303,6 +319,41 @@ func (u *statusUpdater) UpdateImageReference(imageReference, manifestDigest stri
 	}
 }
 
+// UpdateArchitecture sends an update of the status of an architecture to the updater goroutine.
+// The Ready condition of the architecture is set to the given reason and message, and the
+// manifest digest is set if not empty.
+func (u *statusUpdater) UpdateArchitecture(architecture domain.ImageArchitecture, reason domain.ImageBuildConditionReason, message string, manifestDigest string) {
+	status := newImageBuildArchitectureStatus(architecture, reason, message, manifestDigest)
+	select {
+	case u.updateChan <- statusUpdateRequest{Architecture: &status}:
+	case <-u.ctx.Done():
+		// Context canceled, ignore update
+	}
+}
+
+// newImageBuildArchitectureStatus returns the status of an architecture with its Ready condition
+// set to the given reason. The condition is only true once the architecture is completed.
+func newImageBuildArchitectureStatus(architecture domain.ImageArchitecture, reason domain.ImageBuildConditionReason, message string, manifestDigest string) domain.ImageBuildArchitectureStatus {
+	condition := domain.ImageBuildCondition{
+		Type:               domain.ImageBuildConditionTypeReady,
+		Status:             domain.ConditionStatusFalse,
+		Reason:             string(reason),
+		Message:            message,
+		LastTransitionTime: time.Now().UTC(),
+	}
+	if reason == domain.ImageBuildConditionReasonCompleted {
+		condition.Status = domain.ConditionStatusTrue
+	}
+	status := domain.ImageBuildArchitectureStatus{
+		Architecture: architecture,
+		Conditions:   &[]domain.ImageBuildCondition{condition},
+	}
+	if manifestDigest != "" {
+		status.ManifestDigest = &manifestDigest
+	}
+	return status
+}
+
 // ReportOutput sends task output to the central output handler
 // This marks that progress has been made and LastSeen should be updated
 // Exported for testing purposes.
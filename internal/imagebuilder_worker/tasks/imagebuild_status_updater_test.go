@@ -431,6 +431,75 @@ func TestStatusUpdater_updateImageReference(t *testing.T) {
 	assert.Equal(t, manifestDigest, *updatedBuild.Status.ManifestDigest)
 }
 
+func TestStatusUpdater_updateArchitecture(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgID := uuid.New()
+	name := "test-build"
+	imageBuild := &api.ImageBuild{
+		Metadata: v1beta1.ObjectMeta{Name: &name},
+		Status: &api.ImageBuildStatus{
+			Architectures: &[]api.ImageBuildArchitectureStatus{
+				newImageBuildArchitectureStatus(api.ImageArchitectureAmd64, api.ImageBuildConditionReasonPending, "Waiting for the build to start", ""),
+				newImageBuildArchitectureStatus(api.ImageArchitectureArm64, api.ImageBuildConditionReasonPending, "Waiting for the build to start", ""),
+			},
+		},
+	}
+
+	mockService := newMockImageBuildServiceForStatusUpdater(ctrl, imageBuild)
+	updater, cleanup := StartStatusUpdater(
+		context.Background(),
+		func() {}, // no-op cancel function
+		mockService,
+		orgID,
+		name,
+		nil,
+		&config.Config{
+			ImageBuilderWorker: config.NewDefaultImageBuilderWorkerConfig(),
+		},
+		logrus.NewEntry(logrus.New()),
+	)
+	defer cleanup()
+
+	manifestDigest := "sha256:abc123def456"
+	updater.UpdateArchitecture(api.ImageArchitectureArm64, api.ImageBuildConditionReasonCompleted, "Built and pushed for linux/arm64", manifestDigest)
+
+	// Give goroutine time to process
+	time.Sleep(100 * time.Millisecond)
+
+	updatedBuild := mockService.getImageBuild()
+	require.NotNil(t, updatedBuild.Status.Architectures)
+	architectures := *updatedBuild.Status.Architectures
+	require.Len(t, architectures, 2)
+
+	// The status of other architectures is kept
+	assert.Equal(t, api.ImageArchitectureAmd64, architectures[0].Architecture)
+	assert.Nil(t, architectures[0].ManifestDigest)
+	assert.Equal(t, string(api.ImageBuildConditionReasonPending), (*architectures[0].Conditions)[0].Reason)
+
+	assert.Equal(t, api.ImageArchitectureArm64, architectures[1].Architecture)
+	require.NotNil(t, architectures[1].ManifestDigest)
+	assert.Equal(t, manifestDigest, *architectures[1].ManifestDigest)
+	readyCondition := api.FindImageBuildStatusCondition(*architectures[1].Conditions, api.ImageBuildConditionTypeReady)
+	require.NotNil(t, readyCondition)
+	assert.Equal(t, v1beta1.ConditionStatusTrue, readyCondition.Status)
+	assert.Equal(t, string(api.ImageBuildConditionReasonCompleted), readyCondition.Reason)
+}
+
+func TestNewImageBuildArchitectureStatus(t *testing.T) {
+	status := newImageBuildArchitectureStatus(api.ImageArchitectureArm64, api.ImageBuildConditionReasonBuilding, "Building for linux/arm64", "")
+	assert.Equal(t, api.ImageArchitectureArm64, status.Architecture)
+	assert.Nil(t, status.ManifestDigest)
+	require.NotNil(t, status.Conditions)
+	require.Len(t, *status.Conditions, 1)
+	condition := (*status.Conditions)[0]
+	assert.Equal(t, api.ImageBuildConditionTypeReady, condition.Type)
+	assert.Equal(t, v1beta1.ConditionStatusFalse, condition.Status)
+	assert.Equal(t, string(api.ImageBuildConditionReasonBuilding), condition.Reason)
+	assert.Equal(t, "Building for linux/arm64", condition.Message)
+}
+
 func TestStatusUpdater_reportOutput(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -588,7 +657,7 @@ func TestStatusUpdater_updateStatus_withFailedCondition(t *testing.T) {
 		LastTransitionTime: time.Now().UTC(),
 	}
 
-	updater.updateStatus(&failedCondition, nil, nil, nil)
+	updater.updateStatus(&failedCondition, nil, nil, nil, nil)
 
 	// Should have persisted logs when condition is Failed
 	assert.Equal(t, 1, mockService.getUpdateLogsCallsCount())
@@ -0,0 +1,320 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	imagebuilderapi "github.com/flightctl/flightctl/internal/imagebuilder_api/service"
+	"github.com/flightctl/flightctl/internal/oci"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxTriggerHistory is the number of new versions created by triggers kept in the status
+	maxTriggerHistory = 10
+
+	// sourceImageResolveTimeout bounds resolving the digest of the source image tag
+	sourceImageResolveTimeout = 30 * time.Second
+
+	// triggerListPageSize is the number of ImageBuilds listed at a time when checking triggers
+	triggerListPageSize = int32(100)
+)
+
+// sourceImageDigestResolver returns the digest the source image tag of an ImageBuild points to
+type sourceImageDigestResolver func(ctx context.Context, orgID uuid.UUID, source domain.ImageBuildSource) (string, error)
+
+// runPeriodicTriggerCheck runs the periodic check of the triggers of ImageBuilds
+func (c *Consumer) runPeriodicTriggerCheck(ctx context.Context) {
+	interval := time.Duration(c.cfg.ImageBuilderWorker.TriggerCheckTaskInterval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Info("Periodic trigger check task stopped")
+			return
+		case <-ticker.C:
+			c.executeTriggerCheck(ctx)
+		}
+	}
+}
+
+// executeTriggerCheck checks the triggers of the ImageBuilds of all organizations
+func (c *Consumer) executeTriggerCheck(ctx context.Context) {
+	log := c.log.WithField("task", "trigger-check")
+	log.Debug("Starting periodic trigger check task")
+
+	orgs, err := c.organizationStore.List(ctx, store.ListParams{})
+	if err != nil {
+		log.WithError(err).Error("Failed to list organizations")
+		return
+	}
+
+	totalCreated := 0
+	for _, org := range orgs {
+		created, err := c.CheckTriggersForOrg(ctx, org.ID, time.Now().UTC(), log.WithField("orgId", org.ID))
+		if err != nil {
+			log.WithError(err).WithField("orgId", org.ID).Error("Failed to check triggers for organization")
+		}
+		totalCreated += created
+	}
+
+	if totalCreated > 0 {
+		log.WithField("created", totalCreated).Info("Periodic trigger check task completed")
+	} else {
+		log.Debug("Periodic trigger check task completed - no new versions created")
+	}
+}
+
+// CheckTriggersForOrg checks the triggers of the ImageBuilds of an organization, creating new
+// versions of the ImageBuilds whose triggers fired. It returns the number of created versions.
+func (c *Consumer) CheckTriggersForOrg(ctx context.Context, orgID uuid.UUID, now time.Time, log logrus.FieldLogger) (int, error) {
+	created := 0
+	params := domain.ListImageBuildsParams{Limit: lo.ToPtr(triggerListPageSize)}
+	for {
+		imageBuilds, status := c.imageBuilderService.ImageBuild().List(ctx, orgID, params)
+		if !imagebuilderapi.IsStatusOK(status) {
+			return created, fmt.Errorf("failed to list imagebuilds: %s", status.Message)
+		}
+		for i := range imageBuilds.Items {
+			imageBuild := &imageBuilds.Items[i]
+			if imageBuild.Spec.Triggers == nil {
+				continue
+			}
+			name := lo.FromPtr(imageBuild.Metadata.Name)
+			ok, err := c.checkImageBuildTriggers(ctx, orgID, imageBuild, now, log.WithField("imageBuild", name))
+			if err != nil {
+				log.WithError(err).WithField("imageBuild", name).Warn("Failed to check triggers of imageBuild")
+				continue
+			}
+			if ok {
+				created++
+			}
+		}
+		if imageBuilds.Metadata.Continue == nil || *imageBuilds.Metadata.Continue == "" {
+			return created, nil
+		}
+		params.Continue = imageBuilds.Metadata.Continue
+	}
+}
+
+// checkImageBuildTriggers checks the triggers of an ImageBuild and creates a new version if one
+// of them fired. It returns whether a new version was created.
+//
+// The first check only records the digest of the source image and the time of the schedule to
+// compare later checks with. New versions are named after what triggered them, so that checks
+// running concurrently on several workers create each version only once.
+func (c *Consumer) checkImageBuildTriggers(ctx context.Context, orgID uuid.UUID, imageBuild *domain.ImageBuild, now time.Time, log logrus.FieldLogger) (bool, error) {
+	// Only the status of finished builds is updated here, as the build owns the status otherwise
+	if !isImageBuildFinished(imageBuild) {
+		log.Debug("Skipping triggers of imageBuild that is not finished")
+		return false, nil
+	}
+
+	triggers := imageBuild.Spec.Triggers
+	triggersStatus := domain.ImageBuildTriggersStatus{}
+	if imageBuild.Status.Triggers != nil {
+		triggersStatus = *imageBuild.Status.Triggers
+	}
+	statusChanged := false
+
+	var reason domain.ImageBuildTriggerReason
+	var sourceImageDigest string
+	if lo.FromPtr(triggers.SourceImageChange) {
+		digest, err := c.resolveSourceImageDigest(ctx, orgID, imageBuild.Spec.Source)
+		if err != nil {
+			// The schedule is still checked if the source image cannot be resolved
+			log.WithError(err).Warn("Failed to resolve the digest of the source image")
+		} else {
+			sourceImageDigest = digest
+			switch {
+			case triggersStatus.SourceImageDigest == nil:
+				triggersStatus.SourceImageDigest = lo.ToPtr(digest)
+				statusChanged = true
+			case *triggersStatus.SourceImageDigest != digest:
+				reason = domain.ImageBuildTriggerReasonSourceImageChange
+			}
+		}
+	}
+
+	var scheduleTime time.Time
+	if reason == "" && triggers.Schedule != nil {
+		schedule, err := imagebuilderapi.ParseTriggerSchedule(*triggers.Schedule)
+		if err != nil {
+			return false, fmt.Errorf("invalid schedule: %w", err)
+		}
+		if triggersStatus.LastScheduleTime == nil {
+			triggersStatus.LastScheduleTime = lo.ToPtr(lo.FromPtr(imageBuild.Metadata.CreationTimestamp))
+			if triggersStatus.LastScheduleTime.IsZero() {
+				triggersStatus.LastScheduleTime = lo.ToPtr(now)
+			}
+			statusChanged = true
+		}
+		// Missed schedule times are not made up for, only the most recent one is
+		for next := schedule.Next(*triggersStatus.LastScheduleTime); !next.After(now); next = schedule.Next(next) {
+			scheduleTime = next
+		}
+		if !scheduleTime.IsZero() {
+			reason = domain.ImageBuildTriggerReasonSchedule
+		}
+	}
+
+	if reason != "" {
+		deferred, err := c.isTriggerDeferred(ctx, orgID, triggers, triggersStatus, now)
+		if err != nil {
+			return false, err
+		}
+		if deferred != "" {
+			log.WithField("reason", reason).Debugf("Deferring trigger: %s", deferred)
+			reason = ""
+		}
+	}
+
+	created := false
+	if reason != "" {
+		parentName := lo.FromPtr(imageBuild.Metadata.Name)
+		newName := triggeredVersionName(parentName, reason, sourceImageDigest, scheduleTime)
+		_, status := c.imageBuilderService.ImageBuild().NewVersion(ctx, orgID, parentName, domain.ImageBuildNewVersionRequest{Name: newName})
+		switch {
+		case imagebuilderapi.IsStatusOK(status):
+			created = true
+		case status.Code == http.StatusConflict:
+			// Already created by another worker, or by an earlier check whose status update failed
+			log.WithField("newImageBuild", newName).Debug("New version created by trigger already exists")
+		default:
+			return false, fmt.Errorf("failed to create new version %q: %s", newName, status.Message)
+		}
+		log.WithFields(logrus.Fields{
+			"reason":            reason,
+			"newImageBuild":     newName,
+			"sourceImageDigest": sourceImageDigest,
+		}).Info("Trigger created new version of imageBuild")
+
+		record := domain.ImageBuildTriggerRecord{
+			Time:           now,
+			Reason:         reason,
+			ImageBuildName: newName,
+		}
+		if sourceImageDigest != "" {
+			record.SourceImageDigest = lo.ToPtr(sourceImageDigest)
+			// The new version is built from the current source image whatever triggered it
+			triggersStatus.SourceImageDigest = lo.ToPtr(sourceImageDigest)
+		}
+		if !scheduleTime.IsZero() {
+			triggersStatus.LastScheduleTime = lo.ToPtr(scheduleTime)
+		}
+		triggersStatus.LastTriggerTime = lo.ToPtr(now)
+		history := append([]domain.ImageBuildTriggerRecord{record}, lo.FromPtr(triggersStatus.History)...)
+		if len(history) > maxTriggerHistory {
+			history = history[:maxTriggerHistory]
+		}
+		triggersStatus.History = &history
+		statusChanged = true
+	}
+
+	if statusChanged {
+		imageBuild.Status.Triggers = &triggersStatus
+		if _, err := c.imageBuilderService.ImageBuild().UpdateStatus(ctx, orgID, imageBuild); err != nil {
+			return created, fmt.Errorf("failed to update status of triggers: %w", err)
+		}
+	}
+	return created, nil
+}
+
+// isTriggerDeferred returns why a fired trigger must wait before creating a new version, or an
+// empty string if it does not have to wait. Triggers wait for the minimum interval since the last
+// new version created by a trigger, and for that version to finish building.
+func (c *Consumer) isTriggerDeferred(ctx context.Context, orgID uuid.UUID, triggers *domain.ImageBuildTriggers, triggersStatus domain.ImageBuildTriggersStatus, now time.Time) (string, error) {
+	minInterval, err := imagebuilderapi.TriggerMinInterval(triggers)
+	if err != nil {
+		return "", fmt.Errorf("invalid minInterval: %w", err)
+	}
+	if triggersStatus.LastTriggerTime != nil && now.Sub(*triggersStatus.LastTriggerTime) < minInterval {
+		return fmt.Sprintf("last new version was created less than %s ago", minInterval), nil
+	}
+
+	history := lo.FromPtr(triggersStatus.History)
+	if len(history) == 0 {
+		return "", nil
+	}
+	last, status := c.imageBuilderService.ImageBuild().Get(ctx, orgID, history[0].ImageBuildName, false)
+	if status.Code == http.StatusNotFound {
+		return "", nil
+	}
+	if last == nil || !imagebuilderapi.IsStatusOK(status) {
+		return "", fmt.Errorf("failed to get imageBuild %q: %s", history[0].ImageBuildName, status.Message)
+	}
+	if !isImageBuildFinished(last) {
+		return fmt.Sprintf("last new version %q is still building", history[0].ImageBuildName), nil
+	}
+	return "", nil
+}
+
+// resolveSourceImageDigest returns the digest the source image tag of an ImageBuild points to
+func (c *Consumer) resolveSourceImageDigest(ctx context.Context, orgID uuid.UUID, source domain.ImageBuildSource) (string, error) {
+	if c.sourceImageDigestResolver != nil {
+		return c.sourceImageDigestResolver(ctx, orgID, source)
+	}
+
+	ociSpec, err := c.getOciRepoSpec(ctx, orgID, source.Repository, "source")
+	if err != nil {
+		return "", err
+	}
+	ref := strings.TrimRight(ociSpec.Registry, "/") + "/" + strings.TrimLeft(source.ImageName, "/")
+	repoRef, err := oci.BuildOciRepoRef(ctx, ociSpec, ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid source image reference %q: %w", ref, err)
+	}
+
+	resolveCtx, cancel := context.WithTimeout(ctx, sourceImageResolveTimeout)
+	defer cancel()
+	desc, err := repoRef.Resolve(resolveCtx, source.ImageTag)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s:%s: %w", ref, source.ImageTag, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// triggeredVersionName returns the name of the new version of an ImageBuild created by a trigger.
+// The name is derived from the digest of the source image or the schedule time, so that it is
+// the same for every check that sees the trigger fire.
+func triggeredVersionName(parentName string, reason domain.ImageBuildTriggerReason, sourceImageDigest string, scheduleTime time.Time) string {
+	var suffix string
+	if reason == domain.ImageBuildTriggerReasonSourceImageChange {
+		encoded := sourceImageDigest[strings.Index(sourceImageDigest, ":")+1:]
+		suffix = "-" + encoded[:min(12, len(encoded))]
+	} else {
+		suffix = "-" + scheduleTime.UTC().Format("200601021504")
+	}
+	// Keep the name a valid DNS subdomain
+	const maxNameLength = 253
+	if len(parentName)+len(suffix) > maxNameLength {
+		parentName = strings.TrimRight(parentName[:maxNameLength-len(suffix)], "-.")
+	}
+	return parentName + suffix
+}
+
+// isImageBuildFinished returns whether the build of an ImageBuild has completed, failed or been canceled
+func isImageBuildFinished(imageBuild *domain.ImageBuild) bool {
+	if imageBuild.Status == nil || imageBuild.Status.Conditions == nil {
+		return false
+	}
+	ready := domain.FindImageBuildStatusCondition(*imageBuild.Status.Conditions, domain.ImageBuildConditionTypeReady)
+	if ready == nil {
+		return false
+	}
+	switch domain.ImageBuildConditionReason(ready.Reason) {
+	case domain.ImageBuildConditionReasonCompleted, domain.ImageBuildConditionReasonFailed, domain.ImageBuildConditionReasonCanceled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,352 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	apiimagebuilder "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	imagebuilderapi "github.com/flightctl/flightctl/internal/imagebuilder_api/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// triggerImageBuilderService is a mock imagebuilderapi.Service recording the new versions
+// created and the statuses updated by trigger checks
+type triggerImageBuilderService struct {
+	imageBuilds   map[string]*apiimagebuilder.ImageBuild
+	newVersions   []string
+	updatedBuilds map[string]*apiimagebuilder.ImageBuild
+}
+
+func newTriggerImageBuilderService(imageBuilds ...*apiimagebuilder.ImageBuild) *triggerImageBuilderService {
+	m := &triggerImageBuilderService{
+		imageBuilds:   make(map[string]*apiimagebuilder.ImageBuild),
+		updatedBuilds: make(map[string]*apiimagebuilder.ImageBuild),
+	}
+	for _, ib := range imageBuilds {
+		m.imageBuilds[lo.FromPtr(ib.Metadata.Name)] = ib
+	}
+	return m
+}
+
+func (m *triggerImageBuilderService) ImageBuild() imagebuilderapi.ImageBuildService {
+	return &triggerImageBuildService{parent: m}
+}
+
+func (m *triggerImageBuilderService) ImageExport() imagebuilderapi.ImageExportService {
+	return nil
+}
+
+func (m *triggerImageBuilderService) ImagePromotion() imagebuilderapi.ImagePromotionService {
+	return nil
+}
+
+type triggerImageBuildService struct {
+	imagebuilderapi.ImageBuildService
+	parent *triggerImageBuilderService
+}
+
+func (m *triggerImageBuildService) Get(ctx context.Context, orgId uuid.UUID, name string, withExports bool) (*apiimagebuilder.ImageBuild, v1beta1.Status) {
+	ib, ok := m.parent.imageBuilds[name]
+	if !ok {
+		return nil, v1beta1.StatusResourceNotFound("ImageBuild", name)
+	}
+	return ib, v1beta1.StatusOK()
+}
+
+func (m *triggerImageBuildService) List(ctx context.Context, orgId uuid.UUID, params apiimagebuilder.ListImageBuildsParams) (*apiimagebuilder.ImageBuildList, v1beta1.Status) {
+	items := make([]apiimagebuilder.ImageBuild, 0, len(m.parent.imageBuilds))
+	for _, ib := range m.parent.imageBuilds {
+		items = append(items, *ib)
+	}
+	return &apiimagebuilder.ImageBuildList{Items: items}, v1beta1.StatusOK()
+}
+
+func (m *triggerImageBuildService) NewVersion(ctx context.Context, orgId uuid.UUID, parentName string, req apiimagebuilder.ImageBuildNewVersionRequest) (*apiimagebuilder.ImageBuild, v1beta1.Status) {
+	if _, ok := m.parent.imageBuilds[req.Name]; ok {
+		return nil, v1beta1.StatusConflict("already exists")
+	}
+	m.parent.newVersions = append(m.parent.newVersions, req.Name)
+	ib := createTestImageBuild(req.Name, apiimagebuilder.ImageBuildConditionReasonPending, time.Now().UTC())
+	m.parent.imageBuilds[req.Name] = ib
+	return ib, v1beta1.StatusOK()
+}
+
+func (m *triggerImageBuildService) UpdateStatus(ctx context.Context, orgId uuid.UUID, imageBuild *apiimagebuilder.ImageBuild) (*apiimagebuilder.ImageBuild, error) {
+	m.parent.updatedBuilds[lo.FromPtr(imageBuild.Metadata.Name)] = imageBuild
+	return imageBuild, nil
+}
+
+func createTriggeredImageBuild(name string, triggers apiimagebuilder.ImageBuildTriggers, triggersStatus *apiimagebuilder.ImageBuildTriggersStatus, created time.Time) *apiimagebuilder.ImageBuild {
+	ib := createTestImageBuild(name, apiimagebuilder.ImageBuildConditionReasonCompleted, created)
+	ib.Metadata.CreationTimestamp = lo.ToPtr(created)
+	ib.Spec = apiimagebuilder.ImageBuildSpec{
+		Source: apiimagebuilder.ImageBuildSource{
+			Repository: "source-repo",
+			ImageName:  "centos-bootc/centos-bootc",
+			ImageTag:   "stream9",
+		},
+		Triggers: &triggers,
+	}
+	ib.Status.Triggers = triggersStatus
+	return ib
+}
+
+func newTriggerTestConsumer(service imagebuilderapi.Service, digest string, resolveErr error) *Consumer {
+	return &Consumer{
+		imageBuilderService: service,
+		cfg:                 &config.Config{},
+		log:                 logrus.NewEntry(logrus.New()),
+		sourceImageDigestResolver: func(ctx context.Context, orgID uuid.UUID, source domain.ImageBuildSource) (string, error) {
+			return digest, resolveErr
+		},
+	}
+}
+
+func TestCheckTriggersForOrg_SourceImageChange(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.New()
+	logger := logrus.NewEntry(logrus.New())
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	oldDigest := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	newDigest := "sha256:abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789"
+	triggers := apiimagebuilder.ImageBuildTriggers{SourceImageChange: lo.ToPtr(true)}
+
+	t.Run("records the digest of the source image on the first check", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers, nil, now.Add(-time.Hour)))
+		consumer := newTriggerTestConsumer(service, oldDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.newVersions)
+
+		updated := service.updatedBuilds["build"]
+		require.NotNil(t, updated)
+		require.NotNil(t, updated.Status.Triggers)
+		assert.Equal(t, oldDigest, lo.FromPtr(updated.Status.Triggers.SourceImageDigest))
+		assert.Nil(t, updated.Status.Triggers.History)
+	})
+
+	t.Run("creates a new version when the digest changes", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{SourceImageDigest: lo.ToPtr(oldDigest)}, now.Add(-time.Hour)))
+		consumer := newTriggerTestConsumer(service, newDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 1, created)
+		assert.Equal(t, []string{"build-abcdef012345"}, service.newVersions)
+
+		status := service.updatedBuilds["build"].Status.Triggers
+		assert.Equal(t, newDigest, lo.FromPtr(status.SourceImageDigest))
+		assert.Equal(t, now, lo.FromPtr(status.LastTriggerTime))
+		require.Len(t, lo.FromPtr(status.History), 1)
+		record := (*status.History)[0]
+		assert.Equal(t, apiimagebuilder.ImageBuildTriggerReasonSourceImageChange, record.Reason)
+		assert.Equal(t, "build-abcdef012345", record.ImageBuildName)
+		assert.Equal(t, newDigest, lo.FromPtr(record.SourceImageDigest))
+	})
+
+	t.Run("does nothing when the digest is unchanged", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{SourceImageDigest: lo.ToPtr(oldDigest)}, now.Add(-time.Hour)))
+		consumer := newTriggerTestConsumer(service, oldDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.newVersions)
+		assert.Empty(t, service.updatedBuilds)
+	})
+
+	t.Run("does nothing when the source image cannot be resolved", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{SourceImageDigest: lo.ToPtr(oldDigest)}, now.Add(-time.Hour)))
+		consumer := newTriggerTestConsumer(service, "", errors.New("registry unavailable"))
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.newVersions)
+		assert.Empty(t, service.updatedBuilds)
+	})
+
+	t.Run("defers the new version within the minimum interval", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{
+				SourceImageDigest: lo.ToPtr(oldDigest),
+				LastTriggerTime:   lo.ToPtr(now.Add(-30 * time.Minute)),
+			}, now.Add(-2*time.Hour)))
+		consumer := newTriggerTestConsumer(service, newDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.newVersions)
+		assert.Empty(t, service.updatedBuilds, "the old digest must be kept to trigger once the interval has passed")
+	})
+
+	t.Run("defers the new version while the last one is building", func(t *testing.T) {
+		parent := createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{
+				SourceImageDigest: lo.ToPtr(oldDigest),
+				LastTriggerTime:   lo.ToPtr(now.Add(-2 * time.Hour)),
+				History: &[]apiimagebuilder.ImageBuildTriggerRecord{
+					{Time: now.Add(-2 * time.Hour), Reason: apiimagebuilder.ImageBuildTriggerReasonSourceImageChange, ImageBuildName: "build-111111111111"},
+				},
+			}, now.Add(-3*time.Hour))
+		building := createTestImageBuild("build-111111111111", apiimagebuilder.ImageBuildConditionReasonBuilding, now)
+		service := newTriggerImageBuilderService(parent, building)
+		consumer := newTriggerTestConsumer(service, newDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.newVersions)
+	})
+
+	t.Run("records the history when the new version already exists", func(t *testing.T) {
+		parent := createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{SourceImageDigest: lo.ToPtr(oldDigest)}, now.Add(-time.Hour))
+		existing := createTestImageBuild("build-abcdef012345", apiimagebuilder.ImageBuildConditionReasonBuilding, now)
+		service := newTriggerImageBuilderService(parent, existing)
+		consumer := newTriggerTestConsumer(service, newDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		require.Len(t, lo.FromPtr(service.updatedBuilds["build"].Status.Triggers.History), 1)
+	})
+
+	t.Run("skips ImageBuilds that are not finished", func(t *testing.T) {
+		parent := createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{SourceImageDigest: lo.ToPtr(oldDigest)}, now.Add(-time.Hour))
+		parent.Status.Conditions = createTestImageBuild("build", apiimagebuilder.ImageBuildConditionReasonBuilding, now).Status.Conditions
+		service := newTriggerImageBuilderService(parent)
+		consumer := newTriggerTestConsumer(service, newDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.newVersions)
+		assert.Empty(t, service.updatedBuilds)
+	})
+
+	t.Run("skips ImageBuilds without triggers", func(t *testing.T) {
+		ib := createTestImageBuild("build", apiimagebuilder.ImageBuildConditionReasonCompleted, now)
+		service := newTriggerImageBuilderService(ib)
+		consumer := newTriggerTestConsumer(service, newDigest, nil)
+
+		created, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, service.updatedBuilds)
+	})
+}
+
+func TestCheckTriggersForOrg_Schedule(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.New()
+	logger := logrus.NewEntry(logrus.New())
+	now := time.Date(2026, 3, 10, 12, 30, 0, 0, time.UTC)
+	triggers := apiimagebuilder.ImageBuildTriggers{Schedule: lo.ToPtr("0 2 * * *")}
+
+	t.Run("records the creation time as the last schedule time", func(t *testing.T) {
+		created := now.Add(-time.Hour)
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers, nil, created))
+		consumer := newTriggerTestConsumer(service, "", nil)
+
+		count, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		assert.Equal(t, created, lo.FromPtr(service.updatedBuilds["build"].Status.Triggers.LastScheduleTime))
+	})
+
+	t.Run("creates a new version for the most recent missed schedule time", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{LastScheduleTime: lo.ToPtr(now.Add(-72 * time.Hour))}, now.Add(-96*time.Hour)))
+		consumer := newTriggerTestConsumer(service, "", nil)
+
+		count, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, []string{"build-202603100200"}, service.newVersions)
+
+		status := service.updatedBuilds["build"].Status.Triggers
+		assert.Equal(t, time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC), lo.FromPtr(status.LastScheduleTime))
+		require.Len(t, lo.FromPtr(status.History), 1)
+		assert.Equal(t, apiimagebuilder.ImageBuildTriggerReasonSchedule, (*status.History)[0].Reason)
+		assert.Nil(t, (*status.History)[0].SourceImageDigest)
+	})
+
+	t.Run("does nothing before the next schedule time", func(t *testing.T) {
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{LastScheduleTime: lo.ToPtr(time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC))}, now.Add(-96*time.Hour)))
+		consumer := newTriggerTestConsumer(service, "", nil)
+
+		count, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		assert.Empty(t, service.updatedBuilds)
+	})
+
+	t.Run("caps the history", func(t *testing.T) {
+		history := make([]apiimagebuilder.ImageBuildTriggerRecord, maxTriggerHistory)
+		for i := range history {
+			history[i] = apiimagebuilder.ImageBuildTriggerRecord{
+				Time:           now.Add(-time.Duration(i+2) * 24 * time.Hour),
+				Reason:         apiimagebuilder.ImageBuildTriggerReasonSchedule,
+				ImageBuildName: "missing",
+			}
+		}
+		service := newTriggerImageBuilderService(createTriggeredImageBuild("build", triggers,
+			&apiimagebuilder.ImageBuildTriggersStatus{
+				LastScheduleTime: lo.ToPtr(now.Add(-48 * time.Hour)),
+				History:          &history,
+			}, now.Add(-96*time.Hour)))
+		consumer := newTriggerTestConsumer(service, "", nil)
+
+		count, err := consumer.CheckTriggersForOrg(ctx, orgID, now, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		updatedHistory := lo.FromPtr(service.updatedBuilds["build"].Status.Triggers.History)
+		require.Len(t, updatedHistory, maxTriggerHistory)
+		assert.Equal(t, "build-202603100200", updatedHistory[0].ImageBuildName)
+	})
+}
+
+func TestTriggeredVersionName(t *testing.T) {
+	scheduleTime := time.Date(2026, 3, 10, 2, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	assert.Equal(t, "build-abcdef012345",
+		triggeredVersionName("build", apiimagebuilder.ImageBuildTriggerReasonSourceImageChange, "sha256:abcdef0123456789", time.Time{}))
+	assert.Equal(t, "build-202603100100",
+		triggeredVersionName("build", apiimagebuilder.ImageBuildTriggerReasonSchedule, "", scheduleTime))
+
+	long := triggeredVersionName(lo.RandomString(250, []rune("ab"))+".-", apiimagebuilder.ImageBuildTriggerReasonSchedule, "", scheduleTime)
+	assert.LessOrEqual(t, len(long), 253)
+	assert.NotContains(t, long, ".-")
+}
+
+func TestIsImageBuildFinished(t *testing.T) {
+	now := time.Now().UTC()
+	for reason, expected := range map[apiimagebuilder.ImageBuildConditionReason]bool{
+		apiimagebuilder.ImageBuildConditionReasonPending:   false,
+		apiimagebuilder.ImageBuildConditionReasonBuilding:  false,
+		apiimagebuilder.ImageBuildConditionReasonCompleted: true,
+		apiimagebuilder.ImageBuildConditionReasonFailed:    true,
+		apiimagebuilder.ImageBuildConditionReasonCanceled:  true,
+	} {
+		assert.Equal(t, expected, isImageBuildFinished(createTestImageBuild("build", reason, now)), string(reason))
+	}
+	assert.False(t, isImageBuildFinished(&apiimagebuilder.ImageBuild{}))
+}
@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	_ "embed"
 	"encoding/json"
@@ -53,6 +54,8 @@ type exportSource struct {
 	OciRepoSpec *coredomain.OciRepoSpec
 	ImageName   string
 	ImageTag    string
+	// Architecture is the architecture of the image to export
+	Architecture domain.ImageArchitecture
 }
 
 // processImageExport processes an imageExport event by loading the ImageExport resource
@@ -417,12 +420,12 @@ func (c *Consumer) executeExport(
 	}
 
 	// Step 4: Pull the source image
-	if err := c.pullSourceImage(ctx, worker, bootcImageRef, exportSource.OciRepoSpec, log); err != nil {
+	if err := c.pullSourceImage(ctx, worker, bootcImageRef, exportSource.OciRepoSpec, exportSource.Architecture, log); err != nil {
 		return "", cleanup, fmt.Errorf("failed to pull source image: %w", err)
 	}
 
 	// Step 5: Run bootc-image-builder conversion
-	if err := c.runBootcImageBuilder(ctx, worker, imageExport.Spec.Format, bootcImageRef, log); err != nil {
+	if err := c.runBootcImageBuilder(ctx, worker, imageExport.Spec.Format, bootcImageRef, exportSource.Architecture, log); err != nil {
 		return "", cleanup, fmt.Errorf("failed to run bootc-image-builder: %w", err)
 	}
 
@@ -447,7 +450,7 @@ func (c *Consumer) executeExport(
 
 	// Step 7: For qcow2-disk-container, build the container disk image and save to OCI directory
 	if imageExport.Spec.Format == domain.ExportFormatTypeQCOW2DiskContainer {
-		ociDirPath, err := c.buildContainerDiskImage(ctx, worker, outputFilePath, log)
+		ociDirPath, err := c.buildContainerDiskImage(ctx, worker, outputFilePath, exportSource.Architecture, log)
 		if err != nil {
 			return "", cleanup, fmt.Errorf("failed to build container disk image: %w", err)
 		}
@@ -455,6 +458,15 @@ func (c *Consumer) executeExport(
 		log.WithField("ociDir", ociDirPath).Info("Container disk image built and saved to OCI directory")
 	}
 
+	// Step 7 (raw): Compress the raw disk image, which is mostly empty, for download and flashing
+	if imageExport.Spec.Format == domain.ExportFormatTypeRaw {
+		compressedPath, err := compressDiskImage(ctx, outputFilePath, worker.statusUpdater, log)
+		if err != nil {
+			return "", cleanup, fmt.Errorf("failed to compress raw disk image: %w", err)
+		}
+		outputFilePath = compressedPath
+	}
+
 	log.WithField("outputFile", outputFilePath).Info("Export completed successfully")
 	return outputFilePath, cleanup, nil
 }
@@ -683,11 +695,12 @@ func (c *Consumer) loginToRegistryForExport(
 }
 
 // pullSourceImage pulls the source image into the worker container
-func (c *Consumer) pullSourceImage(ctx context.Context, worker *privilegedPodmanWorker, bootcImageRef string, ociRepoSpec *coredomain.OciRepoSpec, log logrus.FieldLogger) error {
-	log.WithField("image", bootcImageRef).Info("Pulling source image")
+func (c *Consumer) pullSourceImage(ctx context.Context, worker *privilegedPodmanWorker, bootcImageRef string, ociRepoSpec *coredomain.OciRepoSpec, architecture domain.ImageArchitecture, log logrus.FieldLogger) error {
+	platform := platformForArchitecture(architecture)
+	log.WithField("image", bootcImageRef).WithField("platform", platform).Info("Pulling source image")
 
-	// Build podman pull command
-	pullArgs := []string{"pull"}
+	// Build podman pull command, pulling the image of the architecture to export from the manifest list
+	pullArgs := []string{"pull", "--platform", platform}
 
 	if ociRepoSpec != nil && ociRepoSpec.Scheme != nil && *ociRepoSpec.Scheme == coredomain.OciRepoSchemeHttp {
 		pullArgs = append(pullArgs, "--tls-verify=false")
@@ -731,6 +744,7 @@ func (c *Consumer) runBootcImageBuilder(
 	worker *privilegedPodmanWorker,
 	format domain.ExportFormatType,
 	bootcImageRef string,
+	architecture domain.ImageArchitecture,
 	log logrus.FieldLogger,
 ) error {
 	// Map qcow2-disk-container to qcow2 for bootc-image-builder
@@ -744,6 +758,7 @@ func (c *Consumer) runBootcImageBuilder(
 		"format":      format,
 		"bootcFormat": bootcFormat,
 		"image":       bootcImageRef,
+		"arch":        architecture,
 	}).Info("Running bootc-image-builder")
 
 	// Run bootc-image-builder entrypoint inside the existing container
@@ -756,6 +771,8 @@ func (c *Consumer) runBootcImageBuilder(
 		"bootc-image-builder",
 		"--type", string(bootcFormat),
 		"--rootfs", "xfs",
+		// Images of architectures other than the one of the worker are built through emulation
+		"--target-arch", string(architecture),
 		bootcImageRef,
 	}
 
@@ -786,17 +803,24 @@ func (c *Consumer) runBootcImageBuilder(
 // bootc-image-builder creates files at {type}/disk.{type} relative to the working directory
 // Since we run with -w /output, files are at /output/{type}/disk.{type} in container
 // which maps to {outputDir}/{type}/disk.{type} on the host
-// Exception: ISO format uses bootiso/install.iso instead of iso/disk.iso
+// Exception: ISO formats use bootiso/install.iso instead of iso/disk.iso
 // Exception: qcow2-disk-container uses qcow2/disk.qcow2 (same as qcow2)
+// Exception: raw and ami use image/disk.raw
+// Exception: vhd uses vpc/disk.vhd
 func (c *Consumer) findOutputFile(outputDir string, format domain.ExportFormatType, log logrus.FieldLogger) (string, error) {
 	var outputFilePath string
 	switch format {
-	case domain.ExportFormatTypeISO:
-		// ISO format uses bootiso/install.iso instead of iso/disk.iso
+	case domain.ExportFormatTypeISO, domain.ExportFormatTypeAnacondaISO:
+		// ISO formats use bootiso/install.iso instead of iso/disk.iso
 		outputFilePath = filepath.Join(outputDir, "bootiso", "install.iso")
 	case domain.ExportFormatTypeQCOW2DiskContainer:
 		// qcow2-disk-container uses qcow2 output from bootc-image-builder
 		outputFilePath = filepath.Join(outputDir, "qcow2", "disk.qcow2")
+	case domain.ExportFormatTypeRaw, domain.ExportFormatTypeAMI:
+		// AMIs are raw disk images
+		outputFilePath = filepath.Join(outputDir, "image", "disk.raw")
+	case domain.ExportFormatTypeVHD:
+		outputFilePath = filepath.Join(outputDir, "vpc", "disk.vhd")
 	default:
 		// Other formats (vmdk, qcow2) use {format}/disk.{format}
 		outputFilePath = filepath.Join(outputDir, string(format), "disk."+string(format))
@@ -811,12 +835,83 @@ func (c *Consumer) findOutputFile(outputDir string, format domain.ExportFormatTy
 	return outputFilePath, nil
 }
 
+// compressDiskImage compresses a disk image with gzip next to it and removes the uncompressed
+// image. Returns the path to the compressed image.
+func compressDiskImage(ctx context.Context, diskPath string, statusUpdater *imageExportStatusUpdater, log logrus.FieldLogger) (string, error) {
+	compressedPath := diskPath + ".gz"
+	log.WithFields(logrus.Fields{"disk": diskPath, "compressed": compressedPath}).Info("Compressing disk image")
+
+	diskFile, err := os.Open(diskPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open disk image: %w", err)
+	}
+	defer diskFile.Close()
+
+	fileInfo, err := diskFile.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to stat disk image: %w", err)
+	}
+
+	compressedFile, err := os.Create(compressedPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create compressed disk image: %w", err)
+	}
+	defer compressedFile.Close()
+
+	// Report progress every 10% to avoid flooding the logs
+	lastReported := int64(-1)
+	reader := newProgressReader(diskFile, fileInfo.Size(), func(bytesRead int64, totalBytes int64) {
+		if totalBytes <= 0 || statusUpdater == nil {
+			return
+		}
+		if percent := bytesRead * 100 / totalBytes / 10 * 10; percent > lastReported {
+			lastReported = percent
+			statusUpdater.reportOutput([]byte(fmt.Sprintf("Compressing disk image: %d%%\n", percent)))
+		}
+	})
+
+	gzipWriter := gzip.NewWriter(compressedFile)
+	gzipWriter.Name = filepath.Base(diskPath)
+	if _, err := io.Copy(gzipWriter, &contextReader{ctx: ctx, reader: reader}); err != nil {
+		return "", fmt.Errorf("failed to compress disk image: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return "", fmt.Errorf("failed to finish compressed disk image: %w", err)
+	}
+	if err := compressedFile.Close(); err != nil {
+		return "", fmt.Errorf("failed to close compressed disk image: %w", err)
+	}
+
+	// The uncompressed image is not needed anymore and can be large
+	if err := os.Remove(diskPath); err != nil {
+		log.WithError(err).WithField("disk", diskPath).Warn("Failed to remove uncompressed disk image")
+	}
+
+	log.WithField("compressed", compressedPath).Info("Disk image compressed")
+	return compressedPath, nil
+}
+
+// contextReader stops reading once its context is done, so that long copies can be canceled
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+// Read implements io.Reader
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
 // buildContainerDiskImage builds a container image wrapping the qcow2 disk for OpenShift Virt
 // and saves it to OCI directory format. Returns the path to the OCI directory.
 func (c *Consumer) buildContainerDiskImage(
 	ctx context.Context,
 	worker *privilegedPodmanWorker,
 	qcow2Path string,
+	architecture domain.ImageArchitecture,
 	log logrus.FieldLogger,
 ) (string, error) {
 	log.Info("Building container disk image for OpenShift Virt")
@@ -845,6 +940,7 @@ func (c *Consumer) buildContainerDiskImage(
 		"exec",
 		worker.ContainerName,
 		"podman", "build",
+		"--platform", platformForArchitecture(architecture),
 		"-t", localImageName,
 		"--build-arg", fmt.Sprintf("DISK_IMAGE_FILE=%s", qcow2Filename),
 		"-f", filepath.Join(containerBuildDir, "Containerfile"),
@@ -938,6 +1034,7 @@ func (c *Consumer) validateAndNormalizeSource(ctx context.Context, orgID uuid.UU
 	var repoName string
 	var imageName string
 	var imageTag string
+	var architecture domain.ImageArchitecture
 
 	switch sourceType {
 	case string(domain.ImageExportSourceTypeImageBuild):
@@ -960,6 +1057,15 @@ func (c *Consumer) validateAndNormalizeSource(ctx context.Context, orgID uuid.UU
 		imageName = imageBuild.Spec.Destination.ImageName
 		imageTag = imageBuild.Spec.Destination.ImageTag
 
+		// Export the requested architecture, defaulting to the first one built
+		architecture = domain.ImageBuildArchitectures(imageBuild.Spec)[0]
+		if imageExport.Spec.Architecture != nil {
+			architecture = *imageExport.Spec.Architecture
+		}
+		if !lo.Contains(domain.ImageBuildArchitectures(imageBuild.Spec), architecture) {
+			return nil, fmt.Errorf("ImageBuild %q was not built for architecture %q", source.ImageBuildRef, architecture)
+		}
+
 		log.Infof("ImageBuild %q is ready, proceeding with export", source.ImageBuildRef)
 
 	default:
@@ -978,19 +1084,21 @@ func (c *Consumer) validateAndNormalizeSource(ctx context.Context, orgID uuid.UU
 	}
 
 	return &exportSource{
-		OciRepoSpec: &ociSpec,
-		ImageName:   imageName,
-		ImageTag:    imageTag,
+		OciRepoSpec:  &ociSpec,
+		ImageName:    imageName,
+		ImageTag:     imageTag,
+		Architecture: architecture,
 	}, nil
 }
 
 // getReferencedDigest resolves the destination image manifest and returns its descriptor.
-// If the manifest is a manifest list (multi-arch), it resolves to a platform-specific manifest (default: linux/amd64).
+// If the manifest is a manifest list (multi-arch), it resolves to the manifest of the exported architecture.
 func getReferencedDigest(
 	ctx context.Context,
 	repoRef *remote.Repository,
 	imageTag string,
 	destRef string,
+	architecture domain.ImageArchitecture,
 	statusUpdater *imageExportStatusUpdater,
 	log logrus.FieldLogger,
 ) (ocispec.Descriptor, error) {
@@ -1000,9 +1108,9 @@ func getReferencedDigest(
 		return ocispec.Descriptor{}, fmt.Errorf("failed to resolve destination image manifest: %w", err)
 	}
 
-	// If the resolved descriptor is a manifest list (multi-arch), resolve to a specific platform
-	// Default to linux/amd64, but this could be made configurable in the future
-	targetPlatform := "linux/amd64"
+	// If the resolved descriptor is a manifest list (multi-arch), resolve to the platform of the
+	// exported architecture so the artifact is attached to the image it was exported from
+	targetPlatform := platformForArchitecture(architecture)
 	if destManifestDesc.MediaType == ocispec.MediaTypeImageIndex {
 		log.WithField("mediaType", destManifestDesc.MediaType).Info("Resolved manifest list, finding platform-specific manifest")
 		statusUpdater.reportOutput([]byte(fmt.Sprintf("Resolved manifest list, finding platform-specific manifest for %s\n", targetPlatform)))
@@ -1148,7 +1256,7 @@ func (c *Consumer) pushArtifact(
 
 	// Resolve the destination image's manifest to get its digest for the referrer subject
 	destImageTag := imageBuild.Spec.Destination.ImageTag
-	destManifestDesc, err := getReferencedDigest(ctx, repoRef, destImageTag, destRef, statusUpdater, log)
+	destManifestDesc, err := getReferencedDigest(ctx, repoRef, destImageTag, destRef, exportSource.Architecture, statusUpdater, log)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOutputFile(t *testing.T) {
+	tests := []struct {
+		format   domain.ExportFormatType
+		expected string
+	}{
+		{format: domain.ExportFormatTypeQCOW2, expected: "qcow2/disk.qcow2"},
+		{format: domain.ExportFormatTypeQCOW2DiskContainer, expected: "qcow2/disk.qcow2"},
+		{format: domain.ExportFormatTypeVMDK, expected: "vmdk/disk.vmdk"},
+		{format: domain.ExportFormatTypeISO, expected: "bootiso/install.iso"},
+		{format: domain.ExportFormatTypeAnacondaISO, expected: "bootiso/install.iso"},
+		{format: domain.ExportFormatTypeRaw, expected: "image/disk.raw"},
+		{format: domain.ExportFormatTypeAMI, expected: "image/disk.raw"},
+		{format: domain.ExportFormatTypeVHD, expected: "vpc/disk.vhd"},
+	}
+
+	consumer := &Consumer{}
+	log := logrus.NewEntry(logrus.New())
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			outputDir := t.TempDir()
+
+			_, err := consumer.findOutputFile(outputDir, tt.format, log)
+			require.Error(t, err, "missing output file should be an error")
+
+			expectedPath := filepath.Join(outputDir, tt.expected)
+			require.NoError(t, os.MkdirAll(filepath.Dir(expectedPath), 0o755))
+			require.NoError(t, os.WriteFile(expectedPath, []byte("disk"), 0o600))
+
+			path, err := consumer.findOutputFile(outputDir, tt.format, log)
+			require.NoError(t, err)
+			assert.Equal(t, expectedPath, path)
+		})
+	}
+}
+
+func TestCompressDiskImage(t *testing.T) {
+	log := logrus.NewEntry(logrus.New())
+	diskPath := filepath.Join(t.TempDir(), "disk.raw")
+	content := append(bytes.Repeat([]byte{0}, 1<<20), []byte("bootloader")...)
+	require.NoError(t, os.WriteFile(diskPath, content, 0o600))
+
+	compressedPath, err := compressDiskImage(context.Background(), diskPath, nil, log)
+	require.NoError(t, err)
+	assert.Equal(t, diskPath+".gz", compressedPath)
+
+	_, err = os.Stat(diskPath)
+	assert.True(t, os.IsNotExist(err), "uncompressed disk image should be removed")
+
+	compressedFile, err := os.Open(compressedPath)
+	require.NoError(t, err)
+	defer compressedFile.Close()
+	info, err := compressedFile.Stat()
+	require.NoError(t, err)
+	assert.Less(t, info.Size(), int64(len(content)))
+
+	gzipReader, err := gzip.NewReader(compressedFile)
+	require.NoError(t, err)
+	assert.Equal(t, "disk.raw", gzipReader.Header.Name)
+	decompressed, err := io.ReadAll(gzipReader)
+	require.NoError(t, err)
+	assert.Equal(t, content, decompressed)
+}
+
+func TestCompressDiskImage_Canceled(t *testing.T) {
+	log := logrus.NewEntry(logrus.New())
+	diskPath := filepath.Join(t.TempDir(), "disk.raw")
+	require.NoError(t, os.WriteFile(diskPath, []byte("disk"), 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := compressDiskImage(ctx, diskPath, nil, log)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = os.Stat(diskPath)
+	assert.NoError(t, err, "uncompressed disk image should be kept on failure")
+}
@@ -733,6 +733,14 @@ func exportFormatToCatalogItemArtifactTypeWorker(format domain.ExportFormatType)
 		return coredomain.CatalogItemArtifactTypeVmdk, nil
 	case domain.ExportFormatTypeQCOW2DiskContainer:
 		return coredomain.CatalogItemArtifactTypeQcow2DiskContainer, nil
+	case domain.ExportFormatTypeRaw:
+		return coredomain.CatalogItemArtifactTypeRaw, nil
+	case domain.ExportFormatTypeAMI:
+		return coredomain.CatalogItemArtifactTypeAmi, nil
+	case domain.ExportFormatTypeVHD:
+		return coredomain.CatalogItemArtifactTypeVhd, nil
+	case domain.ExportFormatTypeAnacondaISO:
+		return coredomain.CatalogItemArtifactTypeAnacondaIso, nil
 	default:
 		return "", fmt.Errorf("unsupported export format: %s", format)
 	}
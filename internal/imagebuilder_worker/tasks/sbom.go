@@ -34,6 +34,11 @@ const (
 	syftWorkDir = "/work"
 )
 
+// SBOMStore stores the SBOMs of pushed images for the offline vulnerability scanner.
+type SBOMStore interface {
+	UpsertImageSBOM(ctx context.Context, imageDigest string, document []byte) error
+}
+
 // SBOMResult contains the result of SBOM generation
 type SBOMResult struct {
 	SBOMPath    string // Path to the transformed SBOM file
@@ -364,6 +369,28 @@ func (c *Consumer) uploadSBOMToTrustify(
 	return nil
 }
 
+// storeSBOM stores the SBOM in the database, where the offline vulnerability scanner matches
+// it against its vulnerability database.
+func (c *Consumer) storeSBOM(ctx context.Context, sbomResult *SBOMResult, log logrus.FieldLogger) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	sbomData, err := os.ReadFile(sbomResult.SBOMPath)
+	if err != nil {
+		return fmt.Errorf("reading SBOM file: %w", err)
+	}
+
+	log.WithField("imageDigest", sbomResult.ImageDigest).Info("Storing SBOM for vulnerability scanning")
+
+	if err := c.sbomStore.UpsertImageSBOM(ctx, sbomResult.ImageDigest, sbomData); err != nil {
+		return fmt.Errorf("storing SBOM: %w", err)
+	}
+
+	log.Info("Successfully stored SBOM")
+	return nil
+}
+
 // isSBOMEnabled returns whether SBOM generation is enabled.
 func (c *Consumer) isSBOMEnabled() bool {
 	return c.cfg.ImageBuilderWorker.IsSBOMEnabled()
@@ -379,13 +406,21 @@ func (c *Consumer) shouldUploadSBOMToTrustify() bool {
 	return c.cfg.ImageBuilderWorker.SBOMUploadToTrustify()
 }
 
+// shouldStoreSBOM returns whether to store the SBOM in the database for the offline
+// vulnerability scanner, which is the case whenever that scanner is selected.
+func (c *Consumer) shouldStoreSBOM() bool {
+	v := c.cfg.VulnerabilityReporting
+	return c.sbomStore != nil && v != nil && v.Enabled && v.ScannerType() == config.VulnerabilityScannerOSV
+}
+
 // shouldRunSBOMPipeline reports whether SBOM generation should run. Syft runs only when
-// at least one distribution path is in effect (OCI referrer push or Trustify upload).
+// at least one distribution path is in effect (OCI referrer push, Trustify upload or storage
+// for the offline scanner).
 func (c *Consumer) shouldRunSBOMPipeline() bool {
 	if !c.isSBOMEnabled() {
 		return false
 	}
-	if c.shouldPushSBOMToRegistry() {
+	if c.shouldPushSBOMToRegistry() || c.shouldStoreSBOM() {
 		return true
 	}
 	if !c.shouldUploadSBOMToTrustify() {
@@ -16,7 +16,7 @@ import (
 func testConsumer(t *testing.T, cfg *config.Config) *Consumer {
 	t.Helper()
 	log := flightlog.InitLogs()
-	return NewConsumer(nil, nil, nil, nil, nil, nil, nil, nil, cfg, log)
+	return NewConsumer(nil, nil, nil, nil, nil, nil, nil, nil, nil, cfg, log)
 }
 
 func TestConsumer_transformSBOM(t *testing.T) {
@@ -78,6 +78,42 @@ func TestConsumer_transformSBOM(t *testing.T) {
 	})
 }
 
+type fakeSBOMStore struct {
+	stored map[string][]byte
+}
+
+func (f *fakeSBOMStore) UpsertImageSBOM(_ context.Context, imageDigest string, document []byte) error {
+	f.stored[imageDigest] = document
+	return nil
+}
+
+func TestConsumer_storeSBOM(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.New()
+	log.SetOutput(testingWriter{t})
+
+	cfg := config.NewDefault()
+	cfg.ImageBuilderWorker.SBOM.PushToRegistry = false
+	cfg.ImageBuilderWorker.SBOM.UploadToTrustify = false
+	store := &fakeSBOMStore{stored: map[string][]byte{}}
+	c := testConsumer(t, cfg)
+	c.sbomStore = store
+
+	require.False(t, c.shouldRunSBOMPipeline(), "without a distribution path the SBOM should not be generated")
+
+	cfg.VulnerabilityReporting = &config.VulnerabilityConfig{Enabled: true, Scanner: config.VulnerabilityScannerOSV}
+	require.True(t, c.shouldStoreSBOM())
+	require.True(t, c.shouldRunSBOMPipeline())
+
+	sbomPath := filepath.Join(t.TempDir(), "sbom-transformed.json")
+	require.NoError(t, os.WriteFile(sbomPath, []byte(`{"bomFormat":"CycloneDX"}`), 0600))
+	require.NoError(t, c.storeSBOM(ctx, &SBOMResult{SBOMPath: sbomPath, ImageDigest: "sha256:abc123"}, log))
+	require.Equal(t, `{"bomFormat":"CycloneDX"}`, string(store.stored["sha256:abc123"]))
+
+	cfg.VulnerabilityReporting.Scanner = config.VulnerabilityScannerTrustify
+	require.False(t, c.shouldStoreSBOM())
+}
+
 // testingWriter sends log output to the test log (optional noise reduction).
 type testingWriter struct{ t *testing.T }
 
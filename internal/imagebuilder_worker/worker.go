@@ -21,6 +21,7 @@ import (
 	eventstore "github.com/flightctl/flightctl/internal/store/event"
 	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
 	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
+	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -36,6 +37,7 @@ type Worker struct {
 	catalogStore      catalogstore.Store
 	eventStore        eventstore.Store
 	kvStore           kvstore.KVStore
+	sbomStore         vulnerabilityfindingstore.Store
 	queuesProvider    queues.Provider
 	ca                *crypto.CAClient
 	serviceHandler    *certificatesigningrequestservice.ServiceHandler
@@ -55,6 +57,7 @@ func New(
 	repositoryStore := repositorystore.NewRepositoryStore(db, log.WithField("pkg", "repository-store"))
 	catalogStore := catalogstore.NewCatalogStore(db, log.WithField("pkg", "catalog-store"))
 	eventStore := eventstore.NewEventStore(db, log.WithField("pkg", "event-store"))
+	sbomStore := vulnerabilityfindingstore.NewVulnerabilityFindingStore(db, log.WithField("pkg", "vulnerabilityfinding-store"))
 
 	// Service handler for internal operations (enrollment credential generation).
 	// nil worker_client: matches Run()'s eventsSvc - events are stored in DB for audit/logging
@@ -73,6 +76,7 @@ func New(
 		catalogStore:      catalogStore,
 		eventStore:        eventStore,
 		kvStore:           kvStore,
+		sbomStore:         sbomStore,
 		queuesProvider:    queuesProvider,
 		ca:                ca,
 		serviceHandler:    serviceHandler,
@@ -96,7 +100,7 @@ func (w *Worker) Run(ctx context.Context) error {
 	imageBuilderService := imagebuilderapi.NewService(ctx, w.cfg, w.imageBuilderStore, w.catalogStore, w.repositoryStore, eventsSvc, queueProducer, w.kvStore, w.log)
 
 	// Launch queue consumers
-	if err := tasks.LaunchConsumers(ctx, w.queuesProvider, w.imageBuilderStore, w.organizationStore, w.repositoryStore, w.catalogStore, w.kvStore, w.serviceHandler, imageBuilderService, w.cfg, w.log); err != nil {
+	if err := tasks.LaunchConsumers(ctx, w.queuesProvider, w.imageBuilderStore, w.organizationStore, w.repositoryStore, w.catalogStore, w.kvStore, w.sbomStore, w.serviceHandler, imageBuilderService, w.cfg, w.log); err != nil {
 		w.log.WithError(err).Error("failed to launch consumers")
 		return err
 	}
@@ -32,6 +32,34 @@ func (m *MockDevice) GetLastSeen(ctx context.Context, orgId uuid.UUID, name stri
 	return nil, nil
 }
 
+func (m *MockDevice) ListStatusHistory(ctx context.Context, orgId uuid.UUID, name string, from, to *time.Time, limit int) ([]domain.DeviceStatusHistoryEntry, error) {
+	return nil, nil
+}
+
+func (m *MockDevice) AppendStatusHistory(ctx context.Context, orgId uuid.UUID, name string, entry domain.DeviceStatusHistoryEntry) error {
+	return nil
+}
+
+func (m *MockDevice) DeleteStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockDevice) ListRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, limit int) ([]domain.DeviceRenderedVersion, error) {
+	return nil, nil
+}
+
+func (m *MockDevice) GetRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error) {
+	return nil, nil
+}
+
+func (m *MockDevice) GetPrecedingRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error) {
+	return nil, nil
+}
+
+func (m *MockDevice) AppendRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version devicestore.DeviceRenderedVersion) error {
+	return nil
+}
+
 func (m *MockDevice) Healthcheck(ctx context.Context, orgId uuid.UUID, names []string) error {
 	return nil
 }
@@ -10,6 +10,8 @@ const (
 	RefTypeGit    = "git"
 	RefTypeHTTP   = "http"
 	RefTypeSecret = "secret"
+	RefTypeVault  = "vault"
+	RefTypeOci    = "oci"
 )
 
 // DependencySyncCollector implements prometheus.Collector for dependency sync metrics.
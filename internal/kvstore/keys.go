@@ -66,6 +66,42 @@ func (k *K8sSecretKey) ComposeKey() string {
 	return fmt.Sprintf("v1/%s/%s/%s/k8ssecret-data/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Namespace, k.Name)
 }
 
+type VaultSecretKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	Repository      string
+	Path            string
+}
+
+func (k *VaultSecretKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/vault-data/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Repository, k.Path)
+}
+
+type NativeSecretKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	Name            string
+}
+
+func (k *NativeSecretKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/secret-data/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Name)
+}
+
+type OciArtifactKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	Repository      string
+	Artifact        string
+	Reference       string
+}
+
+func (k *OciArtifactKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/oci-artifact/%s/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Repository, k.Artifact, k.Reference)
+}
+
 type HttpKey struct {
 	OrgID           uuid.UUID
 	Fleet           string
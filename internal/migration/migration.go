@@ -1,6 +1,11 @@
 // Package migration provides database migration functionality for Flight Control.
 // It runs schema migrations for both the main store and the imagebuilder store
 // within a single transaction to ensure atomicity.
+//
+// Each store declares its tables through its InitialMigration, which is idempotent and
+// reapplied on every upgrade. Tables and columns added since schema versions were introduced,
+// as well as changes that cannot be expressed that way, such as data rewrites, are versioned
+// migrations that are applied once, in order, and recorded in the schema_version table.
 package migration
 
 import (
@@ -10,7 +15,6 @@ import (
 
 	"github.com/flightctl/flightctl/internal/domain"
 	imagebuilderstore "github.com/flightctl/flightctl/internal/imagebuilder_api/store"
-	"github.com/flightctl/flightctl/internal/store"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	canarystore "github.com/flightctl/flightctl/internal/store/canary"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
@@ -36,38 +40,32 @@ import (
 // ErrDryRunComplete signals that migrations validated successfully in dry-run mode.
 var ErrDryRunComplete = errors.New("dry-run complete")
 
-// Run executes all database migrations within a single transaction.
+// Run executes all database migrations up to the latest version within a single transaction.
 // If dryRun is true, the transaction is rolled back after successful validation.
 // The provided db must be connected as a user with migration privileges.
 func Run(ctx context.Context, db *gorm.DB, log logrus.FieldLogger, dryRun bool) error {
-	ctx = store.WithBypassSpanCheck(ctx)
-
-	return db.Transaction(func(tx *gorm.DB) error {
-		txLog := log.WithFields(logrus.Fields{
-			"pkg":     "migration-store-tx",
-			"dry_run": dryRun,
-		})
-		if err := runMainStoreMigrations(ctx, tx, txLog); err != nil {
-			return err
-		}
+	return Up(ctx, db, log, LatestVersion(), dryRun)
+}
 
-		if err := imagebuilderstore.NewStore(tx, log.WithFields(logrus.Fields{
-			"pkg":     "imagebuild-migration-tx",
-			"dry_run": dryRun,
-		})).RunMigrations(ctx); err != nil {
-			return err
-		}
+// syncSchema applies the InitialMigration of every store, which creates missing tables,
+// columns and indexes.
+func syncSchema(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger, dryRun bool) error {
+	if err := runMainStoreMigrations(ctx, tx, log.WithFields(logrus.Fields{
+		"pkg":     "migration-store-tx",
+		"dry_run": dryRun,
+	})); err != nil {
+		return err
+	}
 
-		if dryRun {
-			return ErrDryRunComplete
-		}
-		return nil
-	})
+	return imagebuilderstore.NewStore(tx, log.WithFields(logrus.Fields{
+		"pkg":     "imagebuild-migration-tx",
+		"dry_run": dryRun,
+	})).RunMigrations(ctx)
 }
 
 // runMainStoreMigrations runs schema migrations for every resource's own store, in the same
 // order the former monolithic DataStore.RunMigrations used, then applies the same
-// post-migration customizations (FK-constraint drop, default-catalog backfill).
+// post-migration customizations (FK-constraint drop).
 func runMainStoreMigrations(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error {
 	if err := tx.WithContext(ctx).AutoMigrate(&model.SchemaMigration{}); err != nil {
 		return err
@@ -122,12 +120,14 @@ func runMainStoreMigrations(ctx context.Context, tx *gorm.DB, log logrus.FieldLo
 		return err
 	}
 
-	return customizeMigration(ctx, tx, log)
+	return customizeMigration(ctx, tx)
 }
 
-// customizeMigration applies one-off schema fixups that don't belong to any single resource's
-// own InitialMigration, ported verbatim from the former monolithic DataStore.customizeMigration.
-func customizeMigration(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error {
+// customizeMigration applies schema fixups that don't belong to any single resource's own
+// InitialMigration, ported verbatim from the former monolithic DataStore.customizeMigration.
+// AutoMigrate recreates the repository foreign keys of the join tables, so they are dropped
+// on every run.
+func customizeMigration(ctx context.Context, tx *gorm.DB) error {
 	db := tx.WithContext(ctx)
 
 	if db.Migrator().HasConstraint("fleet_repos", "fk_fleet_repos_repository") {
@@ -140,14 +140,7 @@ func customizeMigration(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger
 			return err
 		}
 	}
-
-	if err := backfillDefaultCatalogs(ctx, tx); err != nil {
-		return err
-	}
-	if err := normalizeAuthProviderURLs(ctx, tx); err != nil {
-		return err
-	}
-	return migrateCatalogItemLabels(ctx, tx, log)
+	return nil
 }
 
 // backfillDefaultCatalogs creates a default catalog for every organization that has no
@@ -0,0 +1,69 @@
+package migration
+
+import (
+	"context"
+
+	bulkoperationstore "github.com/flightctl/flightctl/internal/store/bulkoperation"
+	"github.com/flightctl/flightctl/internal/store/model"
+	secretstore "github.com/flightctl/flightctl/internal/store/secret"
+	vulnerabilityexceptionstore "github.com/flightctl/flightctl/internal/store/vulnerabilityexception"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// The versioned schema changes below add tables and columns that earlier releases don't know.
+// Their Down reverts them, discarding the data they hold, so that the database can be used by
+// the release before them again. Up is idempotent, so that databases whose tables were created
+// before the changes were versioned are migrated without errors.
+
+func createTable(m any) MigrationFunc {
+	return func(ctx context.Context, tx *gorm.DB, _ logrus.FieldLogger) error {
+		return tx.WithContext(ctx).AutoMigrate(m)
+	}
+}
+
+func dropTable(m any) MigrationFunc {
+	return func(ctx context.Context, tx *gorm.DB, _ logrus.FieldLogger) error {
+		return tx.WithContext(ctx).Migrator().DropTable(m)
+	}
+}
+
+func createVulnerabilityExceptions(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error {
+	return vulnerabilityexceptionstore.NewVulnerabilityExceptionStore(tx, log).InitialMigration(ctx)
+}
+
+func createSecrets(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error {
+	return secretstore.NewSecretStore(tx, log).InitialMigration(ctx)
+}
+
+// dropSecrets also removes the dependency references to Secret resources, which the previous
+// release doesn't know.
+func dropSecrets(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error {
+	if err := tx.WithContext(ctx).Where("ref_type = ?", "native-secret").Delete(&model.DependencyRef{}).Error; err != nil {
+		return err
+	}
+	return dropTable(&model.Secret{})(ctx, tx, log)
+}
+
+// addVaultAndOciDependencyColumns adds the columns of the Vault and OCI dependency references.
+// The columns are excluded from the DependencyRef AutoMigrate, so that they are only managed
+// here.
+func addVaultAndOciDependencyColumns(ctx context.Context, tx *gorm.DB, _ logrus.FieldLogger) error {
+	return tx.WithContext(ctx).Exec(`ALTER TABLE dependency_refs
+		ADD COLUMN IF NOT EXISTS vault_path text,
+		ADD COLUMN IF NOT EXISTS oci_artifact text`).Error
+}
+
+func dropVaultAndOciDependencyColumns(ctx context.Context, tx *gorm.DB, _ logrus.FieldLogger) error {
+	db := tx.WithContext(ctx)
+	if err := db.Where("ref_type IN ?", []string{"vault", "oci"}).Delete(&model.DependencyRef{}).Error; err != nil {
+		return err
+	}
+	return db.Exec(`ALTER TABLE dependency_refs
+		DROP COLUMN IF EXISTS vault_path,
+		DROP COLUMN IF EXISTS oci_artifact`).Error
+}
+
+func createBulkOperations(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error {
+	return bulkoperationstore.NewBulkOperationStore(tx, log).InitialMigration(ctx)
+}
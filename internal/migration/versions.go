@@ -0,0 +1,331 @@
+package migration
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// migrationLockID is the key of the transaction-level advisory lock that serializes concurrent
+// migration runs, e.g. from several replicas of a migration job.
+const migrationLockID = 0x666c6374 // "flct"
+
+var (
+	// ErrUnknownSchemaVersion signals that the database was migrated by a newer release.
+	ErrUnknownSchemaVersion = errors.New("unknown database schema version")
+	// ErrIrreversibleMigration signals that a migration cannot be reverted.
+	ErrIrreversibleMigration = errors.New("irreversible migration")
+)
+
+// MigrationFunc applies or reverts a versioned migration within the migration transaction.
+type MigrationFunc func(ctx context.Context, tx *gorm.DB, log logrus.FieldLogger) error
+
+// Migration is a versioned migration. Up moves the database from the previous version to
+// Version and Down moves it back. A migration without Down is irreversible: going back to an
+// earlier version requires restoring a backup taken before it was applied.
+type Migration struct {
+	Version     int64
+	Description string
+	Up          MigrationFunc
+	Down        MigrationFunc
+}
+
+// migrations lists the versioned migrations in the order they are applied. New migrations are
+// appended with the next version; released migrations are never renumbered or removed.
+//
+// The migrations up to version 3 pre-date the schema_version table and are additionally
+// guarded by their schema_migrations keys, so that they stay no-ops on databases that had
+// already applied them.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "backfill default catalogs",
+		Up: func(ctx context.Context, tx *gorm.DB, _ logrus.FieldLogger) error {
+			return backfillDefaultCatalogs(ctx, tx)
+		},
+	},
+	{
+		Version:     2,
+		Description: "normalize auth provider URLs",
+		Up: func(ctx context.Context, tx *gorm.DB, _ logrus.FieldLogger) error {
+			return normalizeAuthProviderURLs(ctx, tx)
+		},
+	},
+	{
+		Version:     3,
+		Description: "migrate catalog item labels to catalog item refs",
+		Up:          migrateCatalogItemLabels,
+	},
+	{
+		Version:     4,
+		Description: "add device status history",
+		Up:          createTable(&model.DeviceStatusHistory{}),
+		Down:        dropTable(&model.DeviceStatusHistory{}),
+	},
+	{
+		Version:     5,
+		Description: "add device rendered versions",
+		Up:          createTable(&model.DeviceRenderedVersion{}),
+		Down:        dropTable(&model.DeviceRenderedVersion{}),
+	},
+	{
+		Version:     6,
+		Description: "add vulnerability exceptions",
+		Up:          createVulnerabilityExceptions,
+		Down:        dropTable(&model.VulnerabilityException{}),
+	},
+	{
+		Version:     7,
+		Description: "add image SBOMs",
+		Up:          createTable(&model.ImageSBOM{}),
+		Down:        dropTable(&model.ImageSBOM{}),
+	},
+	{
+		Version:     8,
+		Description: "add secrets",
+		Up:          createSecrets,
+		Down:        dropSecrets,
+	},
+	{
+		Version:     9,
+		Description: "add Vault and OCI dependency references",
+		Up:          addVaultAndOciDependencyColumns,
+		Down:        dropVaultAndOciDependencyColumns,
+	},
+	{
+		Version:     10,
+		Description: "add bulk operations",
+		Up:          createBulkOperations,
+		Down:        dropTable(&model.BulkOperation{}),
+	},
+}
+
+// LatestVersion returns the schema version that this release migrates the database to.
+func LatestVersion() int64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// Up applies the InitialMigration of every store and then the versioned migrations up to and
+// including the target version, within a single transaction. If dryRun is true, the
+// transaction is rolled back after successful validation and ErrDryRunComplete is returned.
+func Up(ctx context.Context, db *gorm.DB, log logrus.FieldLogger, target int64, dryRun bool) error {
+	return inMigrationTx(ctx, db, dryRun, func(ctx context.Context, tx *gorm.DB, current int64) error {
+		steps, err := planUp(migrations, current, target)
+		if err != nil {
+			return err
+		}
+		if err := syncSchema(ctx, tx, log, dryRun); err != nil {
+			return err
+		}
+		for _, m := range steps {
+			log.Infof("Applying migration %d: %s", m.Version, m.Description)
+			if err := m.Up(ctx, tx, log); err != nil {
+				return fmt.Errorf("applying migration %d (%s): %w", m.Version, m.Description, err)
+			}
+			if err := tx.WithContext(ctx).Create(&model.SchemaVersion{
+				Version:     m.Version,
+				Description: m.Description,
+				AppliedAt:   time.Now(),
+			}).Error; err != nil {
+				return fmt.Errorf("recording migration %d: %w", m.Version, err)
+			}
+		}
+		if len(steps) == 0 {
+			log.Infof("Database schema is at version %d, no versioned migrations to apply", current)
+		}
+		return nil
+	})
+}
+
+// Down reverts the versioned migrations above the target version, newest first, within a
+// single transaction. It fails without changing anything if one of them is irreversible. The
+// tables and columns created by the stores' InitialMigration are left in place.
+func Down(ctx context.Context, db *gorm.DB, log logrus.FieldLogger, target int64, dryRun bool) error {
+	return inMigrationTx(ctx, db, dryRun, func(ctx context.Context, tx *gorm.DB, current int64) error {
+		steps, err := planDown(migrations, current, target)
+		if err != nil {
+			return err
+		}
+		for _, m := range steps {
+			log.Infof("Reverting migration %d: %s", m.Version, m.Description)
+			if err := m.Down(ctx, tx, log); err != nil {
+				return fmt.Errorf("reverting migration %d (%s): %w", m.Version, m.Description, err)
+			}
+			if err := tx.WithContext(ctx).Delete(&model.SchemaVersion{Version: m.Version}).Error; err != nil {
+				return fmt.Errorf("recording revert of migration %d: %w", m.Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// inMigrationTx runs fn in a transaction holding the migration lock, passing it the current
+// schema version. It refuses to touch a database with a schema version it doesn't know.
+func inMigrationTx(ctx context.Context, db *gorm.DB, dryRun bool, fn func(ctx context.Context, tx *gorm.DB, current int64) error) error {
+	ctx = store.WithBypassSpanCheck(ctx)
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+			return fmt.Errorf("acquiring migration lock: %w", err)
+		}
+		if err := tx.AutoMigrate(&model.SchemaVersion{}); err != nil {
+			return err
+		}
+		current, err := currentVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if err := checkKnownVersion(current); err != nil {
+			return err
+		}
+		if err := fn(ctx, tx, current); err != nil {
+			return err
+		}
+		if dryRun {
+			return ErrDryRunComplete
+		}
+		return nil
+	})
+}
+
+func currentVersion(ctx context.Context, db *gorm.DB) (int64, error) {
+	var version int64
+	if err := db.WithContext(ctx).Model(&model.SchemaVersion{}).
+		Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("reading schema version: %w", err)
+	}
+	return version, nil
+}
+
+func checkKnownVersion(current int64) error {
+	if latest := LatestVersion(); current > latest {
+		return fmt.Errorf("%w: the database schema is at version %d, but this release only knows versions up to %d",
+			ErrUnknownSchemaVersion, current, latest)
+	}
+	return nil
+}
+
+// CheckSchemaVersion returns ErrUnknownSchemaVersion if the database was migrated by a newer
+// release, whose schema this release may not be able to work with. Services call it at
+// startup, connected as the application user.
+func CheckSchemaVersion(ctx context.Context, db *gorm.DB) error {
+	ctx = store.WithBypassSpanCheck(ctx)
+	if !db.WithContext(ctx).Migrator().HasTable(&model.SchemaVersion{}) {
+		return nil
+	}
+	current, err := currentVersion(ctx, db)
+	if err != nil {
+		return err
+	}
+	return checkKnownVersion(current)
+}
+
+// planUp returns the migrations to apply to move from the current version to the target.
+func planUp(migrations []Migration, current, target int64) ([]Migration, error) {
+	if target < current {
+		return nil, fmt.Errorf("target version %d is below the current version %d", target, current)
+	}
+	if target > 0 && !slices.ContainsFunc(migrations, func(m Migration) bool { return m.Version == target }) {
+		return nil, fmt.Errorf("unknown target version %d", target)
+	}
+	var steps []Migration
+	for _, m := range migrations {
+		if m.Version > current && m.Version <= target {
+			steps = append(steps, m)
+		}
+	}
+	return steps, nil
+}
+
+// planDown returns the migrations to revert, newest first, to move from the current version to
+// the target.
+func planDown(migrations []Migration, current, target int64) ([]Migration, error) {
+	if target < 0 {
+		return nil, fmt.Errorf("invalid target version %d", target)
+	}
+	if target > current {
+		return nil, fmt.Errorf("target version %d is above the current version %d", target, current)
+	}
+	var steps []Migration
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.Version <= target || m.Version > current {
+			continue
+		}
+		if m.Down == nil {
+			return nil, fmt.Errorf("%w: migration %d (%s) cannot be reverted; restore a backup taken before it was applied instead",
+				ErrIrreversibleMigration, m.Version, m.Description)
+		}
+		steps = append(steps, m)
+	}
+	return steps, nil
+}
+
+// MigrationStatus describes a versioned migration and whether it has been applied.
+type MigrationStatus struct {
+	Version     int64
+	Description string
+	Reversible  bool
+	// AppliedAt is nil if the migration has not been applied.
+	AppliedAt *time.Time
+	// Unknown is true for an applied migration that this release doesn't know.
+	Unknown bool
+}
+
+// Status describes the schema version of the database.
+type Status struct {
+	CurrentVersion int64
+	LatestVersion  int64
+	Migrations     []MigrationStatus
+}
+
+// GetStatus returns the versioned migrations known to this release or applied to the database,
+// ordered by version.
+func GetStatus(ctx context.Context, db *gorm.DB) (*Status, error) {
+	ctx = store.WithBypassSpanCheck(ctx)
+	var applied []model.SchemaVersion
+	if db.WithContext(ctx).Migrator().HasTable(&model.SchemaVersion{}) {
+		if err := db.WithContext(ctx).Order("version").Find(&applied).Error; err != nil {
+			return nil, fmt.Errorf("reading schema versions: %w", err)
+		}
+	}
+	return buildStatus(migrations, applied), nil
+}
+
+func buildStatus(migrations []Migration, applied []model.SchemaVersion) *Status {
+	status := &Status{LatestVersion: LatestVersion()}
+	appliedByVersion := make(map[int64]model.SchemaVersion, len(applied))
+	for _, a := range applied {
+		appliedByVersion[a.Version] = a
+		status.CurrentVersion = max(status.CurrentVersion, a.Version)
+	}
+	for _, m := range migrations {
+		ms := MigrationStatus{Version: m.Version, Description: m.Description, Reversible: m.Down != nil}
+		if a, ok := appliedByVersion[m.Version]; ok {
+			ms.AppliedAt = &a.AppliedAt
+			delete(appliedByVersion, m.Version)
+		}
+		status.Migrations = append(status.Migrations, ms)
+	}
+	for _, a := range appliedByVersion {
+		status.Migrations = append(status.Migrations, MigrationStatus{
+			Version:     a.Version,
+			Description: a.Description,
+			AppliedAt:   &a.AppliedAt,
+			Unknown:     true,
+		})
+	}
+	slices.SortFunc(status.Migrations, func(a, b MigrationStatus) int { return cmp.Compare(a.Version, b.Version) })
+	return status
+}
@@ -0,0 +1,103 @@
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func noop(context.Context, *gorm.DB, logrus.FieldLogger) error { return nil }
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Description: "one", Up: noop},
+		{Version: 2, Description: "two", Up: noop, Down: noop},
+		{Version: 3, Description: "three", Up: noop, Down: noop},
+	}
+}
+
+func versions(steps []Migration) []int64 {
+	var v []int64
+	for _, m := range steps {
+		v = append(v, m.Version)
+	}
+	return v
+}
+
+func TestMigrationsAreOrdered(t *testing.T) {
+	for i, m := range migrations {
+		assert.Equal(t, int64(i+1), m.Version, "migration versions must be consecutive, starting at 1")
+		assert.NotEmpty(t, m.Description)
+		assert.NotNil(t, m.Up)
+	}
+}
+
+func TestMigrationsAfterSchemaVersioningAreReversible(t *testing.T) {
+	for _, m := range migrations {
+		if m.Version > 3 {
+			assert.NotNil(t, m.Down, "migration %d (%s) must be reversible", m.Version, m.Description)
+		}
+	}
+}
+
+func TestPlanUp(t *testing.T) {
+	steps, err := planUp(testMigrations(), 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, versions(steps))
+
+	steps, err = planUp(testMigrations(), 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, versions(steps))
+
+	steps, err = planUp(testMigrations(), 3, 3)
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	_, err = planUp(testMigrations(), 2, 1)
+	assert.ErrorContains(t, err, "below the current version")
+
+	_, err = planUp(testMigrations(), 0, 4)
+	assert.ErrorContains(t, err, "unknown target version")
+}
+
+func TestPlanDown(t *testing.T) {
+	steps, err := planDown(testMigrations(), 3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, versions(steps))
+
+	steps, err = planDown(testMigrations(), 2, 2)
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	_, err = planDown(testMigrations(), 3, 0)
+	assert.ErrorIs(t, err, ErrIrreversibleMigration)
+
+	_, err = planDown(testMigrations(), 1, 2)
+	assert.ErrorContains(t, err, "above the current version")
+}
+
+func TestCheckKnownVersion(t *testing.T) {
+	assert.NoError(t, checkKnownVersion(LatestVersion()))
+	assert.ErrorIs(t, checkKnownVersion(LatestVersion()+1), ErrUnknownSchemaVersion)
+}
+
+func TestBuildStatus(t *testing.T) {
+	appliedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	status := buildStatus(testMigrations(), []model.SchemaVersion{
+		{Version: 1, Description: "one", AppliedAt: appliedAt},
+		{Version: 99, Description: "from the future", AppliedAt: appliedAt},
+	})
+
+	assert.Equal(t, int64(99), status.CurrentVersion)
+	require.Len(t, status.Migrations, 4)
+	assert.Equal(t, MigrationStatus{Version: 1, Description: "one", AppliedAt: &appliedAt}, status.Migrations[0])
+	assert.Equal(t, MigrationStatus{Version: 2, Description: "two", Reversible: true}, status.Migrations[1])
+	assert.True(t, status.Migrations[3].Unknown)
+	assert.Equal(t, int64(99), status.Migrations[3].Version)
+}
@@ -25,8 +25,8 @@ import (
 	syncstateservice "github.com/flightctl/flightctl/internal/service/syncstate"
 	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
 	"github.com/flightctl/flightctl/internal/tasks"
-	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/vulnscan"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/flightctl/flightctl/pkg/reqid"
@@ -42,16 +42,21 @@ const QueueMaintenanceInterval = 2 * tasks.EventProcessingTimeout
 type PeriodicTaskType string
 
 const (
-	PeriodicTaskTypeRepositoryTester       PeriodicTaskType = "repository-tester"
-	PeriodicTaskTypeResourceSync           PeriodicTaskType = "resource-sync"
-	PeriodicTaskTypeDeviceConnection       PeriodicTaskType = "device-connection"
-	PeriodicTaskTypeRolloutDeviceSelection PeriodicTaskType = "rollout-device-selection"
-	PeriodicTaskTypeDisruptionBudget       PeriodicTaskType = "disruption-budget"
-	PeriodicTaskTypeEventCleanup           PeriodicTaskType = "event-cleanup"
-	PeriodicTaskTypeQueueMaintenance       PeriodicTaskType = "queue-maintenance"
-	PeriodicTaskTypeVulnerabilitySync      PeriodicTaskType = "vulnerability-sync"
-	PeriodicTaskTypeDependencySyncGit      PeriodicTaskType = "dependency-sync-git"
-	PeriodicTaskTypeDependencySyncHttp     PeriodicTaskType = "dependency-sync-http"
+	PeriodicTaskTypeRepositoryTester           PeriodicTaskType = "repository-tester"
+	PeriodicTaskTypeResourceSync               PeriodicTaskType = "resource-sync"
+	PeriodicTaskTypeDeviceConnection           PeriodicTaskType = "device-connection"
+	PeriodicTaskTypeRolloutDeviceSelection     PeriodicTaskType = "rollout-device-selection"
+	PeriodicTaskTypeDisruptionBudget           PeriodicTaskType = "disruption-budget"
+	PeriodicTaskTypeEventCleanup               PeriodicTaskType = "event-cleanup"
+	PeriodicTaskTypeDeviceStatusHistoryCleanup PeriodicTaskType = "device-status-history-cleanup"
+	PeriodicTaskTypeQueueMaintenance           PeriodicTaskType = "queue-maintenance"
+	PeriodicTaskTypeVulnerabilitySync          PeriodicTaskType = "vulnerability-sync"
+	PeriodicTaskTypeDependencySyncGit          PeriodicTaskType = "dependency-sync-git"
+	PeriodicTaskTypeDependencySyncHttp         PeriodicTaskType = "dependency-sync-http"
+	PeriodicTaskTypeDependencySyncVault        PeriodicTaskType = "dependency-sync-vault"
+	PeriodicTaskTypeDependencySyncOci          PeriodicTaskType = "dependency-sync-oci"
+	PeriodicTaskTypeFleetAutoUpgrade           PeriodicTaskType = "fleet-auto-upgrade"
+	PeriodicTaskTypeCatalogSourceSync          PeriodicTaskType = "catalog-source-sync"
 )
 
 type PeriodicTaskMetadata struct {
@@ -60,16 +65,21 @@ type PeriodicTaskMetadata struct {
 }
 
 var periodicTasks = map[PeriodicTaskType]PeriodicTaskMetadata{
-	PeriodicTaskTypeRepositoryTester:       {Interval: 2 * time.Minute, SystemWide: false},
-	PeriodicTaskTypeResourceSync:           {Interval: 2 * time.Minute, SystemWide: false},
-	PeriodicTaskTypeDeviceConnection:       {Interval: tasks.DeviceConnectionPollingInterval, SystemWide: false},
-	PeriodicTaskTypeRolloutDeviceSelection: {Interval: device_selection.RolloutDeviceSelectionInterval, SystemWide: false},
-	PeriodicTaskTypeDisruptionBudget:       {Interval: disruption_budget.DisruptionBudgetReconcilationInterval, SystemWide: false},
-	PeriodicTaskTypeEventCleanup:           {Interval: tasks.EventCleanupPollingInterval, SystemWide: true},
-	PeriodicTaskTypeQueueMaintenance:       {Interval: QueueMaintenanceInterval, SystemWide: true},
-	PeriodicTaskTypeVulnerabilitySync:      {Interval: tasks.VulnerabilitySyncInterval, SystemWide: true},
-	PeriodicTaskTypeDependencySyncGit:      {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
-	PeriodicTaskTypeDependencySyncHttp:     {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeRepositoryTester:           {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeResourceSync:               {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeDeviceConnection:           {Interval: tasks.DeviceConnectionPollingInterval, SystemWide: false},
+	PeriodicTaskTypeRolloutDeviceSelection:     {Interval: device_selection.RolloutDeviceSelectionInterval, SystemWide: false},
+	PeriodicTaskTypeDisruptionBudget:           {Interval: disruption_budget.DisruptionBudgetReconcilationInterval, SystemWide: false},
+	PeriodicTaskTypeEventCleanup:               {Interval: tasks.EventCleanupPollingInterval, SystemWide: true},
+	PeriodicTaskTypeDeviceStatusHistoryCleanup: {Interval: tasks.DeviceStatusHistoryCleanupPollingInterval, SystemWide: true},
+	PeriodicTaskTypeQueueMaintenance:           {Interval: QueueMaintenanceInterval, SystemWide: true},
+	PeriodicTaskTypeVulnerabilitySync:          {Interval: tasks.VulnerabilitySyncInterval, SystemWide: true},
+	PeriodicTaskTypeDependencySyncGit:          {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeDependencySyncHttp:         {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeDependencySyncVault:        {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeDependencySyncOci:          {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeFleetAutoUpgrade:           {Interval: tasks.FleetAutoUpgradeInterval, SystemWide: false},
+	PeriodicTaskTypeCatalogSourceSync:          {Interval: tasks.CatalogSourceSyncInterval, SystemWide: false},
 }
 
 // MergeTasksWithConfig merges configured task intervals with defaults.
@@ -98,7 +108,7 @@ func MergeTasksWithConfig(cfg *config.Config) map[PeriodicTaskType]PeriodicTaskM
 		}
 		if periodicTasks.DependencySync.Schedule.Interval > 0 {
 			interval := time.Duration(periodicTasks.DependencySync.Schedule.Interval)
-			for _, taskType := range []PeriodicTaskType{PeriodicTaskTypeDependencySyncGit, PeriodicTaskTypeDependencySyncHttp} {
+			for _, taskType := range []PeriodicTaskType{PeriodicTaskTypeDependencySyncGit, PeriodicTaskTypeDependencySyncHttp, PeriodicTaskTypeDependencySyncVault, PeriodicTaskTypeDependencySyncOci} {
 				meta := merged[taskType]
 				meta.Interval = interval
 				merged[taskType] = meta
@@ -206,6 +216,31 @@ func (e *DisruptionBudgetExecutor) Execute(ctx context.Context, log logrus.Field
 	disruptionBudget.Reconcile(taskCtx, orgId)
 }
 
+type FleetAutoUpgradeExecutor struct {
+	fleetSvc   fleetservice.Service
+	catalogSvc catalogservice.Service
+	eventSvc   eventservice.Service
+	log        logrus.FieldLogger
+}
+
+func (e *FleetAutoUpgradeExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeFleetAutoUpgrade)
+	fleetAutoUpgrade := tasks.NewFleetAutoUpgrade(e.log, e.fleetSvc, e.catalogSvc, e.eventSvc)
+	fleetAutoUpgrade.Poll(taskCtx, orgId)
+}
+
+type CatalogSourceSyncExecutor struct {
+	catalogSvc    catalogservice.Service
+	repositorySvc repositoryservice.Service
+	log           logrus.FieldLogger
+}
+
+func (e *CatalogSourceSyncExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeCatalogSourceSync)
+	catalogSourceSync := tasks.NewCatalogSourceSync(e.log, e.catalogSvc, e.repositorySvc)
+	catalogSourceSync.Poll(taskCtx, orgId)
+}
+
 type EventCleanupExecutor struct {
 	log                  logrus.FieldLogger
 	eventSvc             eventservice.Service
@@ -219,6 +254,19 @@ func (e *EventCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogg
 	eventCleanup.Poll(taskCtx)
 }
 
+type DeviceStatusHistoryCleanupExecutor struct {
+	log             logrus.FieldLogger
+	deviceSvc       deviceservice.Service
+	retentionPeriod util.Duration
+}
+
+func (e *DeviceStatusHistoryCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeDeviceStatusHistoryCleanup)
+	// Note: Device status history cleanup is system-wide, orgId is not used
+	cleanup := tasks.NewDeviceStatusHistoryCleanup(e.log, e.deviceSvc, e.retentionPeriod)
+	cleanup.Poll(taskCtx)
+}
+
 type QueueMaintenanceExecutor struct {
 	log             logrus.FieldLogger
 	checkpointSvc   checkpointservice.Service
@@ -243,7 +291,7 @@ func (e *QueueMaintenanceExecutor) Execute(ctx context.Context, log logrus.Field
 
 type VulnerabilitySyncExecutor struct {
 	log           logrus.FieldLogger
-	vulnClient    trustifyv2.VulnerabilityClient
+	scanner       vulnscan.Scanner
 	findingStore  vulnerabilityfindingstore.Store
 	checkpointSvc checkpointservice.Service
 	eventSvc      eventservice.Service
@@ -252,7 +300,7 @@ type VulnerabilitySyncExecutor struct {
 func (e *VulnerabilitySyncExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
 	taskCtx := createTaskContext(ctx, PeriodicTaskTypeVulnerabilitySync)
 	checkpoint := &serviceCheckpointAdapter{svc: e.checkpointSvc}
-	vulnSync := tasks.NewVulnerabilitySync(e.log, e.vulnClient, e.findingStore, checkpoint, e.eventSvc)
+	vulnSync := tasks.NewVulnerabilitySync(e.log, e.scanner, e.findingStore, checkpoint, e.eventSvc)
 	vulnSync.Poll(taskCtx)
 }
 
@@ -317,6 +365,36 @@ func (e *DependencySyncHttpExecutor) Execute(ctx context.Context, log logrus.Fie
 	depSync.Poll(taskCtx, orgId)
 }
 
+type DependencySyncVaultExecutor struct {
+	log              logrus.FieldLogger
+	dependencyrefSvc dependencyrefservice.Service
+	eventSvc         eventservice.Service
+	syncstateSvc     syncstateservice.Service
+	cfg              *config.Config
+	metrics          *periodicmetrics.DependencySyncCollector
+}
+
+func (e *DependencySyncVaultExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeDependencySyncVault)
+	depSync := tasks.NewDependencySyncVault(e.log, e.dependencyrefSvc, e.eventSvc, e.syncstateSvc, e.cfg, e.metrics)
+	depSync.Poll(taskCtx, orgId)
+}
+
+type DependencySyncOciExecutor struct {
+	log              logrus.FieldLogger
+	dependencyrefSvc dependencyrefservice.Service
+	eventSvc         eventservice.Service
+	syncstateSvc     syncstateservice.Service
+	cfg              *config.Config
+	metrics          *periodicmetrics.DependencySyncCollector
+}
+
+func (e *DependencySyncOciExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeDependencySyncOci)
+	depSync := tasks.NewDependencySyncOci(e.log, e.dependencyrefSvc, e.eventSvc, e.syncstateSvc, e.cfg, e.metrics)
+	depSync.Poll(taskCtx, orgId)
+}
+
 func InitializeTaskExecutors(
 	log logrus.FieldLogger,
 	repositorySvc repositoryservice.Service,
@@ -334,7 +412,7 @@ func InitializeTaskExecutors(
 	workerClient worker_client.WorkerClient,
 	workerMetrics *worker.WorkerCollector,
 	findingStore vulnerabilityfindingstore.Store,
-	vulnClient trustifyv2.VulnerabilityClient,
+	vulnScanner vulnscan.Scanner,
 	depSyncMetrics *periodicmetrics.DependencySyncCollector,
 ) map[PeriodicTaskType]PeriodicTaskExecutor {
 	executors := map[PeriodicTaskType]PeriodicTaskExecutor{
@@ -366,11 +444,27 @@ func InitializeTaskExecutors(
 			eventSvc:  eventSvc,
 			log:       log.WithField("pkg", "disruption-budget"),
 		},
+		PeriodicTaskTypeFleetAutoUpgrade: &FleetAutoUpgradeExecutor{
+			fleetSvc:   fleetSvc,
+			catalogSvc: catalogSvc,
+			eventSvc:   eventSvc,
+			log:        log.WithField("pkg", "fleet-auto-upgrade"),
+		},
+		PeriodicTaskTypeCatalogSourceSync: &CatalogSourceSyncExecutor{
+			catalogSvc:    catalogSvc,
+			repositorySvc: repositorySvc,
+			log:           log.WithField("pkg", "catalog-source-sync"),
+		},
 		PeriodicTaskTypeEventCleanup: &EventCleanupExecutor{
 			log:                  log.WithField("pkg", "event-cleanup"),
 			eventSvc:             eventSvc,
 			eventRetentionPeriod: cfg.Service.EventRetentionPeriod,
 		},
+		PeriodicTaskTypeDeviceStatusHistoryCleanup: &DeviceStatusHistoryCleanupExecutor{
+			log:             log.WithField("pkg", "device-status-history-cleanup"),
+			deviceSvc:       deviceSvc,
+			retentionPeriod: cfg.Service.DeviceStatusHistoryRetentionPeriod,
+		},
 		PeriodicTaskTypeQueueMaintenance: &QueueMaintenanceExecutor{
 			log:             log.WithField("pkg", "queue-maintenance"),
 			checkpointSvc:   checkpointSvc,
@@ -382,10 +476,10 @@ func InitializeTaskExecutors(
 		},
 	}
 
-	if cfg.VulnerabilityReporting != nil && cfg.VulnerabilityReporting.Enabled && vulnClient != nil && findingStore != nil {
+	if cfg.VulnerabilityReporting != nil && cfg.VulnerabilityReporting.Enabled && vulnScanner != nil && findingStore != nil {
 		executors[PeriodicTaskTypeVulnerabilitySync] = &VulnerabilitySyncExecutor{
 			log:           log.WithField("pkg", "vulnerability-sync"),
-			vulnClient:    vulnClient,
+			scanner:       vulnScanner,
 			findingStore:  findingStore,
 			checkpointSvc: checkpointSvc,
 			eventSvc:      eventSvc,
@@ -410,5 +504,23 @@ func InitializeTaskExecutors(
 		metrics:          depSyncMetrics,
 	}
 
+	executors[PeriodicTaskTypeDependencySyncVault] = &DependencySyncVaultExecutor{
+		log:              log.WithField("pkg", "dependency-sync-vault"),
+		dependencyrefSvc: dependencyrefSvc,
+		eventSvc:         eventSvc,
+		syncstateSvc:     syncstateSvc,
+		cfg:              cfg,
+		metrics:          depSyncMetrics,
+	}
+
+	executors[PeriodicTaskTypeDependencySyncOci] = &DependencySyncOciExecutor{
+		log:              log.WithField("pkg", "dependency-sync-oci"),
+		dependencyrefSvc: dependencyrefSvc,
+		eventSvc:         eventSvc,
+		syncstateSvc:     syncstateSvc,
+		cfg:              cfg,
+		metrics:          depSyncMetrics,
+	}
+
 	return executors
 }
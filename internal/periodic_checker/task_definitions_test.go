@@ -122,7 +122,7 @@ func TestMergeTasksWithConfig_DependencySync(t *testing.T) {
 			expectedInterval: defaultDependencySyncInterval,
 		},
 		{
-			name: "When a custom interval is configured it should apply to both dependency-sync-git and dependency-sync-http",
+			name: "When a custom interval is configured it should apply to all dependency-sync tasks",
 			configJSON: `{
 				"periodic": {
 					"tasks": {
@@ -149,6 +149,8 @@ func TestMergeTasksWithConfig_DependencySync(t *testing.T) {
 			require.Contains(t, result, PeriodicTaskTypeDependencySyncHttp)
 			require.Equal(t, tt.expectedInterval, result[PeriodicTaskTypeDependencySyncGit].Interval)
 			require.Equal(t, tt.expectedInterval, result[PeriodicTaskTypeDependencySyncHttp].Interval)
+			require.Equal(t, tt.expectedInterval, result[PeriodicTaskTypeDependencySyncVault].Interval)
+			require.Equal(t, tt.expectedInterval, result[PeriodicTaskTypeDependencySyncOci].Interval)
 		})
 	}
 }
@@ -0,0 +1,82 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/flightctl/flightctl/internal/quadlet/renderer"
+	"github.com/sirupsen/logrus"
+)
+
+const migrateConfigPath = "/etc/flightctl/flightctl-db-migrate/config.yaml"
+
+// systemctl implements ServiceManager using systemctl.
+type systemctl struct{}
+
+func (s *systemctl) run(ctx context.Context, args ...string) error {
+	if out, err := exec.CommandContext(ctx, "systemctl", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("systemctl %s failed: %w (output: %s)", args[0], err, out)
+	}
+	return nil
+}
+
+func (s *systemctl) DaemonReload(ctx context.Context) error {
+	return s.run(ctx, "daemon-reload")
+}
+
+func (s *systemctl) Stop(ctx context.Context, units ...string) error {
+	if len(units) == 0 {
+		return nil
+	}
+	return s.run(ctx, append([]string{"stop"}, units...)...)
+}
+
+func (s *systemctl) Restart(ctx context.Context, unit string) error {
+	return s.run(ctx, "restart", unit)
+}
+
+func (s *systemctl) Start(ctx context.Context, unit string) error {
+	return s.run(ctx, "start", unit)
+}
+
+func (s *systemctl) IsActive(ctx context.Context, unit string) bool {
+	return exec.CommandContext(ctx, "systemctl", "is-active", "--quiet", unit).Run() == nil
+}
+
+// podmanMigrator runs flightctl-db-migrate from the db-setup image of the new release, the
+// same way the flightctl-db-migrate quadlet does.
+type podmanMigrator struct {
+	renderConfig *renderer.RendererConfig
+	log          logrus.FieldLogger
+}
+
+func (m *podmanMigrator) Migrate(ctx context.Context, dryRun bool) error {
+	image := m.renderConfig.DbSetup.Image + ":" + m.renderConfig.DbSetup.Tag
+	args := []string{
+		"run", "--rm",
+		"--network", "flightctl",
+		"--env", "DB_MIGRATION_USER=flightctl_migrator",
+		"--secret", "flightctl-postgresql-migrator-password,type=env,target=DB_PASSWORD",
+		"--secret", "flightctl-postgresql-migrator-password,type=env,target=DB_MIGRATION_PASSWORD",
+		"--volume", migrateConfigPath + ":/root/.flightctl/config.yaml:ro,z",
+		"--volume", "/etc/flightctl/pki/db:/root/.flightctl/certs/db:ro,z",
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		args = append(args, "--add-host", hostname+":host-gateway")
+	}
+	args = append(args, image, "/usr/local/bin/flightctl-db-migrate")
+	if dryRun {
+		args = append(args, "--dry-run")
+	}
+
+	m.log.Infof("Running flightctl-db-migrate from %s", image)
+	cmd := exec.CommandContext(ctx, "podman", args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("flightctl-db-migrate failed: %w", err)
+	}
+	return nil
+}
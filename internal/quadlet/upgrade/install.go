@@ -0,0 +1,165 @@
+package upgrade
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+)
+
+// installation installs rendered release files and records the changes it makes, so that
+// they can be reverted if the upgrade fails.
+type installation struct {
+	log        logrus.FieldLogger
+	previous   string
+	stagingDir string
+	// replaced maps installed files that were overwritten to their saved previous copy.
+	replaced map[string]string
+	// created lists the files and directories that did not exist before, in creation order.
+	created []string
+}
+
+func newInstallation(previous, stagingDir string, log logrus.FieldLogger) *installation {
+	return &installation{
+		log:        log,
+		previous:   previous,
+		stagingDir: stagingDir,
+		replaced:   make(map[string]string),
+	}
+}
+
+// installTree copies the files below stagedRoot to destRoot. Existing files are replaced only
+// if overwrite is true; their previous content is saved first.
+func (i *installation) installTree(stagedRoot, destRoot string, overwrite bool) error {
+	if _, err := os.Stat(stagedRoot); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return filepath.WalkDir(stagedRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(stagedRoot, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(destRoot, rel)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if _, err := os.Stat(dest); err == nil {
+				return nil
+			}
+			if err := os.MkdirAll(dest, info.Mode().Perm()); err != nil {
+				return fmt.Errorf("creating directory %s: %w", dest, err)
+			}
+			i.created = append(i.created, dest)
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		current, err := os.ReadFile(dest)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			if err := writeFileAtomic(dest, content, info.Mode().Perm()); err != nil {
+				return fmt.Errorf("installing %s: %w", dest, err)
+			}
+			i.created = append(i.created, dest)
+			i.log.Infof("Installed %s", dest)
+		case err != nil:
+			return fmt.Errorf("reading %s: %w", dest, err)
+		case !overwrite || bytes.Equal(current, content):
+			return nil
+		default:
+			if err := i.save(dest, current); err != nil {
+				return err
+			}
+			if err := writeFileAtomic(dest, content, info.Mode().Perm()); err != nil {
+				return fmt.Errorf("installing %s: %w", dest, err)
+			}
+			i.log.Infof("Updated %s", dest)
+		}
+		return nil
+	})
+}
+
+func (i *installation) save(dest string, content []byte) error {
+	info, err := os.Stat(dest)
+	if err != nil {
+		return err
+	}
+	saved := filepath.Join(i.previous, dest)
+	if err := os.MkdirAll(filepath.Dir(saved), 0700); err != nil {
+		return fmt.Errorf("saving %s: %w", dest, err)
+	}
+	if err := os.WriteFile(saved, content, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("saving %s: %w", dest, err)
+	}
+	i.replaced[dest] = saved
+	return nil
+}
+
+// revert restores the replaced files and removes the created ones.
+func (i *installation) revert() error {
+	var errs []error
+	for dest, saved := range i.replaced {
+		info, err := os.Stat(saved)
+		if err == nil {
+			var content []byte
+			if content, err = os.ReadFile(saved); err == nil {
+				err = writeFileAtomic(dest, content, info.Mode().Perm())
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("restoring %s: %w", dest, err))
+		}
+	}
+	// Remove files before the directories containing them.
+	for _, path := range slices.Backward(i.created) {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, fmt.Errorf("removing %s: %w", path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (i *installation) cleanup() {
+	if err := os.RemoveAll(i.stagingDir); err != nil {
+		i.log.Warnf("Failed to clean up staging directory %s: %v", i.stagingDir, err)
+	}
+}
+
+// writeFileAtomic replaces path with a new file, so that running binaries and files read
+// concurrently are never seen half-written.
+func writeFileAtomic(path string, content []byte, mode fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
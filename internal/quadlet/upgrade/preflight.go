@@ -0,0 +1,211 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"sigs.k8s.io/yaml"
+)
+
+const dbDialTimeout = 5 * time.Second
+
+// check is a preflight check that must pass before anything is changed.
+type check struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+func (u *Upgrader) defaultChecks() []check {
+	return []check{
+		{name: "required commands", run: func(context.Context) error { return checkCommands("podman", "systemctl") }},
+		{name: "disk space", run: func(context.Context) error { return checkDiskSpace(u.backupDir, u.minFreeBytes) }},
+		{name: "configuration compatibility", run: func(context.Context) error { return u.checkConfig() }},
+		{name: "database reachability", run: u.checkDatabase},
+	}
+}
+
+// preflight runs all checks and reports every failure, so that they can be fixed at once.
+func (u *Upgrader) preflight(ctx context.Context) error {
+	var errs []error
+	for _, c := range u.checks {
+		if err := c.run(ctx); err != nil {
+			u.log.Errorf("Preflight check %q failed: %v", c.name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", c.name, err))
+			continue
+		}
+		u.log.Infof("Preflight check %q passed", c.name)
+	}
+	return errors.Join(errs...)
+}
+
+func checkCommands(names ...string) error {
+	for _, name := range names {
+		if _, err := exec.LookPath(name); err != nil {
+			return fmt.Errorf("%s is required but was not found in PATH", name)
+		}
+	}
+	return nil
+}
+
+// checkDiskSpace checks that the file system holding dir, or its closest existing parent, has
+// at least minFree bytes available.
+func checkDiskSpace(dir string, minFree uint64) error {
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return fmt.Errorf("reading free space of %s: %w", dir, err)
+	}
+	free := stat.Bavail * uint64(stat.Bsize) //nolint:gosec // block size is never negative
+	if free < minFree {
+		return fmt.Errorf("%s has %d MiB available, at least %d MiB are required", dir, free>>20, minFree>>20)
+	}
+	return nil
+}
+
+// serviceConfig holds the parts of service-config.yaml checked before an upgrade.
+type serviceConfig struct {
+	DB struct {
+		Type     string `json:"type"`
+		External struct {
+			Hostname string `json:"hostname"`
+			Port     any    `json:"port"`
+		} `json:"external"`
+	} `json:"db"`
+}
+
+// checkConfig checks that the installed service configuration can be used by the new release.
+// Settings that the release's default configuration doesn't know are reported, as they were
+// probably renamed or removed, but don't fail the check: they may be optional settings that
+// are commented out in the default configuration.
+func (u *Upgrader) checkConfig() error {
+	installed, err := os.ReadFile(u.serviceConfigPath)
+	if err != nil {
+		return fmt.Errorf("reading service configuration: %w", err)
+	}
+	release, err := os.ReadFile(u.releaseConfigPath)
+	if err != nil {
+		return fmt.Errorf("reading the release's service configuration: %w", err)
+	}
+	unknown, err := unknownSettings(installed, release)
+	if err != nil {
+		return err
+	}
+	for _, key := range unknown {
+		u.log.Warnf("Setting %q in %s is not part of the new release's default configuration", key, u.serviceConfigPath)
+	}
+
+	cfg, err := parseServiceConfig(installed)
+	if err != nil {
+		return err
+	}
+	switch cfg.DB.Type {
+	case "builtin":
+	case "external":
+		if cfg.DB.External.Hostname == "" {
+			return errors.New("db.external.hostname must be set for an external database")
+		}
+	default:
+		return fmt.Errorf("unsupported db.type %q", cfg.DB.Type)
+	}
+	return nil
+}
+
+func parseServiceConfig(data []byte) (*serviceConfig, error) {
+	var cfg serviceConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing service configuration: %w", err)
+	}
+	cfg.DB.Type = strings.ToLower(strings.TrimSpace(cfg.DB.Type))
+	if cfg.DB.Type == "" {
+		cfg.DB.Type = "builtin"
+	}
+	return &cfg, nil
+}
+
+// unknownSettings returns the dotted paths of the settings in installed that don't exist in
+// release, sorted.
+func unknownSettings(installed, release []byte) ([]string, error) {
+	var installedMap, releaseMap map[string]any
+	if err := yaml.Unmarshal(installed, &installedMap); err != nil {
+		return nil, fmt.Errorf("parsing service configuration: %w", err)
+	}
+	if err := yaml.Unmarshal(release, &releaseMap); err != nil {
+		return nil, fmt.Errorf("parsing the release's service configuration: %w", err)
+	}
+	var unknown []string
+	collectUnknown("", installedMap, releaseMap, &unknown)
+	slices.Sort(unknown)
+	return unknown, nil
+}
+
+func collectUnknown(prefix string, installed, release map[string]any, unknown *[]string) {
+	for key, value := range installed {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		releaseValue, ok := release[key]
+		if !ok {
+			*unknown = append(*unknown, path)
+			continue
+		}
+		installedChild, isMap := value.(map[string]any)
+		releaseChild, releaseIsMap := releaseValue.(map[string]any)
+		if isMap && releaseIsMap {
+			collectUnknown(path, installedChild, releaseChild, unknown)
+		}
+	}
+}
+
+// checkDatabase checks that the database accepts connections.
+func (u *Upgrader) checkDatabase(ctx context.Context) error {
+	data, err := os.ReadFile(u.serviceConfigPath)
+	if err != nil {
+		return fmt.Errorf("reading service configuration: %w", err)
+	}
+	cfg, err := parseServiceConfig(data)
+	if err != nil {
+		return err
+	}
+
+	if cfg.DB.Type == "builtin" {
+		out, err := exec.CommandContext(ctx, "podman", "exec", "flightctl-db", "pg_isready", "-U", "postgres").CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("database container is not ready: %w (output: %s)", err, strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+
+	port := "5432"
+	if cfg.DB.External.Port != nil {
+		port = fmt.Sprint(cfg.DB.External.Port)
+		if _, err := strconv.Atoi(port); err != nil {
+			return fmt.Errorf("invalid db.external.port %q", port)
+		}
+	}
+	address := net.JoinHostPort(cfg.DB.External.Hostname, port)
+	conn, err := (&net.Dialer{Timeout: dbDialTimeout}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("connecting to external database %s: %w", address, err)
+	}
+	return conn.Close()
+}
@@ -0,0 +1,80 @@
+package upgrade
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownSettings(t *testing.T) {
+	release := []byte(`
+global:
+  baseDomain:
+  auth:
+    type: oidc
+db:
+  name: flightctl
+  type: builtin
+`)
+	installed := []byte(`
+global:
+  baseDomain: example.com
+  auth:
+    type: aap
+    legacyOption: true
+db:
+  name: flightctl
+  type: builtin
+removedSection:
+  enabled: true
+`)
+	unknown, err := unknownSettings(installed, release)
+	require.NoError(t, err)
+	require.Equal(t, []string{"global.auth.legacyOption", "removedSection"}, unknown)
+
+	_, err = unknownSettings([]byte("global: [unterminated"), release)
+	require.Error(t, err)
+}
+
+func TestCheckConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		installed string
+		wantErr   string
+	}{
+		{name: "builtin database", installed: "db:\n  type: builtin\n"},
+		{name: "database type defaults to builtin", installed: "global: {}\n"},
+		{name: "external database", installed: "db:\n  type: external\n  external:\n    hostname: db.example.com\n    port: 5432\n"},
+		{name: "external database without hostname", installed: "db:\n  type: external\n", wantErr: "hostname must be set"},
+		{name: "unsupported database type", installed: "db:\n  type: sqlite\n", wantErr: "unsupported db.type"},
+		{name: "invalid yaml", installed: "db: [", wantErr: "parsing service configuration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			u := &Upgrader{
+				log:               logrus.New(),
+				serviceConfigPath: filepath.Join(dir, "installed.yaml"),
+				releaseConfigPath: filepath.Join(dir, "release.yaml"),
+			}
+			require.NoError(t, os.WriteFile(u.serviceConfigPath, []byte(tt.installed), 0600))
+			require.NoError(t, os.WriteFile(u.releaseConfigPath, []byte("db:\n  type: builtin\n"), 0600))
+
+			err := u.checkConfig()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckDiskSpace(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "does", "not", "exist")
+	require.NoError(t, checkDiskSpace(dir, 1))
+	require.ErrorContains(t, checkDiskSpace(dir, 1<<62), "MiB available")
+}
@@ -0,0 +1,407 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/backup"
+	"github.com/flightctl/flightctl/internal/quadlet/renderer"
+	"github.com/flightctl/flightctl/internal/restore"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultBackupDir     = "/var/lib/flightctl/backups"
+	defaultMinFreeBytes  = 2 << 30 // 2 GiB
+	defaultHealthTimeout = 5 * time.Minute
+	healthPollInterval   = 5 * time.Second
+	targetUnit           = "flightctl.target"
+)
+
+// serviceOrder lists the units of a standalone deployment in the order they are (re)started:
+// infrastructure first, then the database migration, the services using the database and
+// finally the services fronting them.
+var serviceOrder = []string{
+	"flightctl-network.service",
+	"flightctl-certs-init.service",
+	"flightctl-db.service",
+	"flightctl-db-wait.service",
+	"flightctl-db-users-init.service",
+	"flightctl-kv.service",
+	"flightctl-db-migrate.service",
+	"flightctl-pam-issuer.service",
+	"flightctl-api-init.service",
+	"flightctl-api.service",
+	"flightctl-worker.service",
+	"flightctl-periodic.service",
+	"flightctl-imagebuilder-api.service",
+	"flightctl-imagebuilder-worker.service",
+	"flightctl-remote-access.service",
+	"flightctl-telemetry-gateway.service",
+	"flightctl-alertmanager.service",
+	"flightctl-alert-exporter.service",
+	"flightctl-alertmanager-proxy.service",
+	"flightctl-userinfo-proxy.service",
+	"flightctl-ui-init.service",
+	"flightctl-ui.service",
+	"flightctl-cli-artifacts.service",
+	"flightctl-gateway.service",
+}
+
+// infrastructureServices are the units that must be running for the database to be restored
+// during a rollback.
+var infrastructureServices = []string{
+	"flightctl-network.service",
+	"flightctl-certs-init.service",
+	"flightctl-db.service",
+	"flightctl-db-wait.service",
+	"flightctl-kv.service",
+}
+
+// databaseClients are the units that connect to the database and are stopped while it is
+// migrated. They are stopped in reverse serviceOrder.
+var databaseClients = []string{
+	"flightctl-gateway.service",
+	"flightctl-alertmanager-proxy.service",
+	"flightctl-alert-exporter.service",
+	"flightctl-telemetry-gateway.service",
+	"flightctl-remote-access.service",
+	"flightctl-imagebuilder-worker.service",
+	"flightctl-imagebuilder-api.service",
+	"flightctl-periodic.service",
+	"flightctl-worker.service",
+	"flightctl-api.service",
+}
+
+// ServiceManager manages the systemd units of the deployment.
+type ServiceManager interface {
+	DaemonReload(ctx context.Context) error
+	Stop(ctx context.Context, units ...string) error
+	Restart(ctx context.Context, unit string) error
+	Start(ctx context.Context, unit string) error
+	IsActive(ctx context.Context, unit string) bool
+}
+
+// Migrator runs the database migration of the release being installed.
+type Migrator interface {
+	Migrate(ctx context.Context, dryRun bool) error
+}
+
+// BackupManager creates a backup archive of the deployment and restores it.
+type BackupManager interface {
+	Backup(ctx context.Context) (archivePath string, err error)
+	Restore(ctx context.Context, archivePath string) error
+}
+
+// Upgrader upgrades a standalone deployment to the release whose quadlets are rendered from
+// renderConfig.
+type Upgrader struct {
+	log               logrus.FieldLogger
+	renderConfig      *renderer.RendererConfig
+	serviceConfigPath string
+	releaseConfigPath string
+	backupDir         string
+	minFreeBytes      uint64
+	healthTimeout     time.Duration
+
+	checks   []check
+	services ServiceManager
+	migrator Migrator
+	backups  BackupManager
+	render   func(*renderer.RendererConfig, logrus.FieldLogger) error
+}
+
+type Option func(*Upgrader)
+
+// WithBackupDir sets the directory the pre-upgrade backup archive is written to.
+func WithBackupDir(dir string) Option {
+	return func(u *Upgrader) {
+		u.backupDir = dir
+	}
+}
+
+// WithMinFreeBytes sets the free space required in the backup directory.
+func WithMinFreeBytes(n uint64) Option {
+	return func(u *Upgrader) {
+		u.minFreeBytes = n
+	}
+}
+
+// WithHealthTimeout sets how long to wait for the services to become active after the upgrade.
+func WithHealthTimeout(d time.Duration) Option {
+	return func(u *Upgrader) {
+		u.healthTimeout = d
+	}
+}
+
+// WithServiceConfigPath overrides the path of the installed service configuration.
+func WithServiceConfigPath(path string) Option {
+	return func(u *Upgrader) {
+		u.serviceConfigPath = path
+	}
+}
+
+// NewUpgrader creates an Upgrader. The release files are rendered relative to the working
+// directory, like `flightctl-standalone render quadlets` does.
+func NewUpgrader(log *logrus.Logger, renderConfig *renderer.RendererConfig, opts ...Option) *Upgrader {
+	u := &Upgrader{
+		log:               log,
+		renderConfig:      renderConfig,
+		serviceConfigPath: renderer.DefaultServiceConfigPath,
+		releaseConfigPath: "deploy/podman/service-config.yaml",
+		backupDir:         defaultBackupDir,
+		minFreeBytes:      defaultMinFreeBytes,
+		healthTimeout:     defaultHealthTimeout,
+		render:            renderer.RenderQuadlets,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	u.services = &systemctl{}
+	u.migrator = &podmanMigrator{renderConfig: renderConfig, log: log}
+	u.backups = &archiveManager{
+		log:       log,
+		outputDir: u.backupDir,
+		backup:    backup.NewPodmanDeployer(log, backup.WithServiceConfigPath(u.serviceConfigPath)),
+		restore:   restore.NewPodmanRestoreDeployer(log, restore.WithServiceConfigPath(u.serviceConfigPath)),
+	}
+	u.checks = u.defaultChecks()
+	return u
+}
+
+// Run performs the upgrade:
+//
+//  1. Runs the preflight checks
+//  2. Creates a backup archive
+//  3. Validates the database migration with a dry-run
+//  4. Stops the services using the database and migrates it
+//  5. Renders and installs the quadlets and configuration of the new release
+//  6. Restarts the services in dependency order and waits for them to become active
+//
+// If a step after the services were stopped fails, the deployment is rolled back to the backup.
+func (u *Upgrader) Run(ctx context.Context) error {
+	u.renderConfig.ApplyFlightctlServicesTagOverride(u.log)
+
+	u.log.Info("Running preflight checks")
+	if err := u.preflight(ctx); err != nil {
+		return fmt.Errorf("preflight checks failed: %w", err)
+	}
+
+	active := u.activeUnits(ctx)
+	if len(active) == 0 {
+		return errors.New("no Flight Control services are active; install the deployment instead of upgrading it")
+	}
+
+	u.log.Info("Creating pre-upgrade backup")
+	archivePath, err := u.backups.Backup(ctx)
+	if err != nil {
+		return fmt.Errorf("creating backup: %w", err)
+	}
+	u.log.Infof("Backup created: %s", archivePath)
+
+	u.log.Info("Validating database migration (dry-run)")
+	if err := u.migrator.Migrate(ctx, true); err != nil {
+		return fmt.Errorf("database migration dry-run failed: %w", err)
+	}
+
+	u.log.Info("Stopping services using the database")
+	if err := u.services.Stop(ctx, databaseClients...); err != nil {
+		u.startUnits(ctx, active)
+		return fmt.Errorf("stopping services: %w", err)
+	}
+
+	u.log.Info("Migrating database")
+	if err := u.migrator.Migrate(ctx, false); err != nil {
+		// The migration runs in a single transaction, so the database is unchanged.
+		u.startUnits(ctx, active)
+		return fmt.Errorf("database migration failed: %w", err)
+	}
+
+	inst, err := u.install(ctx)
+	if err == nil {
+		err = u.restartAndWait(ctx, active)
+	}
+	if err != nil {
+		u.log.Errorf("Upgrade failed, rolling back to %s: %v", archivePath, err)
+		if rollbackErr := u.rollback(ctx, archivePath, inst, active); rollbackErr != nil {
+			return fmt.Errorf("upgrade failed: %w; rollback failed: %v (restore %s manually with flightctl-restore)", err, rollbackErr, archivePath)
+		}
+		return fmt.Errorf("upgrade failed and was rolled back: %w", err)
+	}
+
+	inst.cleanup()
+	u.log.Infof("Upgrade completed successfully; the pre-upgrade backup is kept at %s", archivePath)
+	return nil
+}
+
+// install renders the release into a staging directory and installs it. The returned
+// installation can revert the changes, even if install failed part way.
+func (u *Upgrader) install(ctx context.Context) (*installation, error) {
+	stagingDir, err := os.MkdirTemp("", "flightctl-upgrade-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating staging directory: %w", err)
+	}
+	inst := newInstallation(filepath.Join(stagingDir, "previous"), stagingDir, u.log)
+
+	staged := *u.renderConfig
+	staged.ReadOnlyConfigOutputDir = filepath.Join(stagingDir, "readonly-config")
+	staged.WriteableConfigOutputDir = filepath.Join(stagingDir, "writeable-config")
+	staged.QuadletFilesOutputDir = filepath.Join(stagingDir, "quadlets")
+	staged.SystemdUnitOutputDir = filepath.Join(stagingDir, "systemd")
+	staged.BinOutputDir = filepath.Join(stagingDir, "bin")
+	staged.VarTmpOutputDir = filepath.Join(stagingDir, "var-tmp")
+	staged.VarLibOutputDir = filepath.Join(stagingDir, "var-lib")
+
+	u.log.Info("Rendering release files")
+	if err := u.render(&staged, u.log); err != nil {
+		return inst, fmt.Errorf("rendering quadlets: %w", err)
+	}
+
+	u.log.Info("Installing release files")
+	trees := []struct {
+		staged, dest string
+		overwrite    bool
+	}{
+		{staged.ReadOnlyConfigOutputDir, u.renderConfig.ReadOnlyConfigOutputDir, true},
+		{staged.QuadletFilesOutputDir, u.renderConfig.QuadletFilesOutputDir, true},
+		{staged.SystemdUnitOutputDir, u.renderConfig.SystemdUnitOutputDir, true},
+		{staged.BinOutputDir, u.renderConfig.BinOutputDir, true},
+		// The writeable configuration belongs to the operator: only files that are new in
+		// this release are added.
+		{staged.WriteableConfigOutputDir, u.renderConfig.WriteableConfigOutputDir, false},
+		{staged.VarTmpOutputDir, u.renderConfig.VarTmpOutputDir, false},
+		{staged.VarLibOutputDir, u.renderConfig.VarLibOutputDir, false},
+	}
+	for _, t := range trees {
+		if err := inst.installTree(t.staged, t.dest, t.overwrite); err != nil {
+			return inst, err
+		}
+	}
+
+	if err := u.services.DaemonReload(ctx); err != nil {
+		return inst, fmt.Errorf("reloading systemd: %w", err)
+	}
+	return inst, nil
+}
+
+// restartAndWait restarts the previously active units in dependency order, starts the target
+// to bring up units added by the release and waits for all of them to become active.
+func (u *Upgrader) restartAndWait(ctx context.Context, active []string) error {
+	for _, unit := range active {
+		u.log.Infof("Restarting %s", unit)
+		if err := u.services.Restart(ctx, unit); err != nil {
+			return fmt.Errorf("restarting %s: %w", unit, err)
+		}
+	}
+	if err := u.services.Start(ctx, targetUnit); err != nil {
+		return fmt.Errorf("starting %s: %w", targetUnit, err)
+	}
+	return u.waitHealthy(ctx, append(slices.Clone(active), targetUnit))
+}
+
+func (u *Upgrader) waitHealthy(ctx context.Context, units []string) error {
+	u.log.Infof("Waiting up to %s for services to become active", u.healthTimeout)
+	ctx, cancel := context.WithTimeout(ctx, u.healthTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+	for {
+		var inactive []string
+		for _, unit := range units {
+			if !u.services.IsActive(ctx, unit) {
+				inactive = append(inactive, unit)
+			}
+		}
+		if len(inactive) == 0 {
+			u.log.Info("All services are active")
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("services did not become active within %s: %v", u.healthTimeout, inactive)
+		case <-ticker.C:
+			u.log.Infof("Waiting for %v", inactive)
+		}
+	}
+}
+
+// rollback reinstalls the previous release files and restores the backup archive.
+func (u *Upgrader) rollback(ctx context.Context, archivePath string, inst *installation, active []string) error {
+	// Use a fresh context so that a timed out or cancelled upgrade can still be rolled back.
+	ctx = context.WithoutCancel(ctx)
+
+	var errs []error
+	if inst != nil {
+		if err := inst.revert(); err != nil {
+			errs = append(errs, fmt.Errorf("reverting release files: %w", err))
+		}
+		inst.cleanup()
+		if err := u.services.DaemonReload(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("reloading systemd: %w", err))
+		}
+	}
+
+	// The database must be running for it to be restored; everything else is restarted
+	// only once the previous state is back.
+	if err := u.services.Stop(ctx, databaseClients...); err != nil {
+		u.log.Warnf("Failed to stop services before restore: %v", err)
+	}
+	for _, unit := range infrastructureServices {
+		if err := u.services.Restart(ctx, unit); err != nil {
+			u.log.Warnf("Failed to restart %s: %v", unit, err)
+		}
+	}
+
+	if err := u.backups.Restore(ctx, archivePath); err != nil {
+		return errors.Join(append(errs, fmt.Errorf("restoring backup: %w", err))...)
+	}
+
+	if err := u.restartAndWait(ctx, active); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// activeUnits returns the units of the deployment that are active, in serviceOrder.
+func (u *Upgrader) activeUnits(ctx context.Context) []string {
+	var active []string
+	for _, unit := range serviceOrder {
+		if u.services.IsActive(ctx, unit) {
+			active = append(active, unit)
+		}
+	}
+	return active
+}
+
+func (u *Upgrader) startUnits(ctx context.Context, units []string) {
+	for _, unit := range units {
+		if err := u.services.Start(ctx, unit); err != nil {
+			u.log.Warnf("Failed to start %s: %v", unit, err)
+		}
+	}
+}
+
+// archiveManager creates and restores flightctl-backup archives.
+type archiveManager struct {
+	log       *logrus.Logger
+	outputDir string
+	backup    backup.Deployer
+	restore   restore.Deployer
+}
+
+func (a *archiveManager) Backup(ctx context.Context) (string, error) {
+	if err := os.MkdirAll(a.outputDir, 0700); err != nil {
+		return "", fmt.Errorf("creating backup directory: %w", err)
+	}
+	return backup.PerformBackup(ctx, a.backup, a.outputDir, a.log)
+}
+
+func (a *archiveManager) Restore(ctx context.Context, archivePath string) error {
+	return restore.Restore(ctx, archivePath, a.restore, a.log)
+}
@@ -0,0 +1,218 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/quadlet/renderer"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeServices struct {
+	active  map[string]bool
+	calls   []string
+	failing map[string]bool
+}
+
+func (s *fakeServices) DaemonReload(context.Context) error {
+	s.calls = append(s.calls, "daemon-reload")
+	return nil
+}
+
+func (s *fakeServices) Stop(_ context.Context, units ...string) error {
+	for _, unit := range units {
+		s.calls = append(s.calls, "stop "+unit)
+		s.active[unit] = false
+	}
+	return nil
+}
+
+func (s *fakeServices) Restart(_ context.Context, unit string) error {
+	s.calls = append(s.calls, "restart "+unit)
+	s.active[unit] = !s.failing[unit]
+	return nil
+}
+
+func (s *fakeServices) Start(_ context.Context, unit string) error {
+	s.calls = append(s.calls, "start "+unit)
+	s.active[unit] = !s.failing[unit]
+	return nil
+}
+
+func (s *fakeServices) IsActive(_ context.Context, unit string) bool {
+	return s.active[unit]
+}
+
+type fakeMigrator struct {
+	runs []bool
+	err  error
+}
+
+func (m *fakeMigrator) Migrate(_ context.Context, dryRun bool) error {
+	m.runs = append(m.runs, dryRun)
+	if !dryRun {
+		return m.err
+	}
+	return nil
+}
+
+type fakeBackups struct {
+	restored  string
+	onRestore func()
+}
+
+func (b *fakeBackups) Backup(context.Context) (string, error) {
+	return "/backups/flightctl-backup.tar.gz", nil
+}
+
+func (b *fakeBackups) Restore(_ context.Context, archivePath string) error {
+	b.restored = archivePath
+	if b.onRestore != nil {
+		b.onRestore()
+	}
+	return nil
+}
+
+func newTestUpgrader(t *testing.T) (*Upgrader, *fakeServices, *fakeMigrator, *fakeBackups) {
+	t.Helper()
+	baseDir := t.TempDir()
+	cfg := &renderer.RendererConfig{
+		ReadOnlyConfigOutputDir:  filepath.Join(baseDir, "usr", "share", "flightctl"),
+		WriteableConfigOutputDir: filepath.Join(baseDir, "etc", "flightctl"),
+		QuadletFilesOutputDir:    filepath.Join(baseDir, "quadlets"),
+		SystemdUnitOutputDir:     filepath.Join(baseDir, "systemd"),
+		BinOutputDir:             filepath.Join(baseDir, "bin"),
+		VarTmpOutputDir:          filepath.Join(baseDir, "var", "tmp"),
+		VarLibOutputDir:          filepath.Join(baseDir, "var", "lib"),
+	}
+	require.NoError(t, os.MkdirAll(cfg.QuadletFilesOutputDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.QuadletFilesOutputDir, "flightctl-api.container"), []byte("old"), 0644))
+	require.NoError(t, os.MkdirAll(cfg.WriteableConfigOutputDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.WriteableConfigOutputDir, "service-config.yaml"), []byte("operator"), 0644))
+
+	services := &fakeServices{
+		active: map[string]bool{
+			"flightctl-db.service":  true,
+			"flightctl-api.service": true,
+		},
+		failing: map[string]bool{},
+	}
+	migrator := &fakeMigrator{}
+	backups := &fakeBackups{}
+	u := &Upgrader{
+		log:           logrus.New(),
+		renderConfig:  cfg,
+		healthTimeout: 50 * time.Millisecond,
+		services:      services,
+		migrator:      migrator,
+		backups:       backups,
+		render: func(staged *renderer.RendererConfig, _ logrus.FieldLogger) error {
+			if err := os.MkdirAll(staged.QuadletFilesOutputDir, 0755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(staged.QuadletFilesOutputDir, "flightctl-api.container"), []byte("new"), 0644); err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(staged.QuadletFilesOutputDir, "flightctl-new.container"), []byte("new"), 0644); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(staged.WriteableConfigOutputDir, 0755); err != nil {
+				return err
+			}
+			return os.WriteFile(filepath.Join(staged.WriteableConfigOutputDir, "service-config.yaml"), []byte("default"), 0644)
+		},
+	}
+	return u, services, migrator, backups
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRunUpgrades(t *testing.T) {
+	require := require.New(t)
+	u, services, migrator, backups := newTestUpgrader(t)
+	services.active[targetUnit] = true
+
+	require.NoError(u.Run(context.Background()))
+
+	require.Equal([]bool{true, false}, migrator.runs, "the dry-run must precede the migration")
+	require.Empty(backups.restored)
+	require.Equal("new", readFile(t, filepath.Join(u.renderConfig.QuadletFilesOutputDir, "flightctl-api.container")))
+	require.Equal("new", readFile(t, filepath.Join(u.renderConfig.QuadletFilesOutputDir, "flightctl-new.container")))
+	require.Equal("operator", readFile(t, filepath.Join(u.renderConfig.WriteableConfigOutputDir, "service-config.yaml")))
+
+	require.Contains(services.calls, "daemon-reload")
+	dbRestart := slices.Index(services.calls, "restart flightctl-db.service")
+	apiRestart := slices.Index(services.calls, "restart flightctl-api.service")
+	require.True(dbRestart >= 0 && apiRestart > dbRestart, "services must be restarted in dependency order: %v", services.calls)
+}
+
+func TestRunDoesNotChangeAnythingIfPreflightFails(t *testing.T) {
+	require := require.New(t)
+	u, services, migrator, _ := newTestUpgrader(t)
+	u.checks = []check{{name: "failing", run: func(context.Context) error { return errors.New("boom") }}}
+
+	err := u.Run(context.Background())
+	require.ErrorContains(err, "preflight checks failed")
+	require.Empty(migrator.runs)
+	require.Empty(services.calls)
+}
+
+func TestRunRestartsServicesIfMigrationFails(t *testing.T) {
+	require := require.New(t)
+	u, services, migrator, backups := newTestUpgrader(t)
+	migrator.err = errors.New("migration failed")
+
+	err := u.Run(context.Background())
+	require.ErrorContains(err, "database migration failed")
+	require.Empty(backups.restored, "a failed migration leaves the database unchanged")
+	require.True(services.active["flightctl-api.service"])
+	require.Equal("old", readFile(t, filepath.Join(u.renderConfig.QuadletFilesOutputDir, "flightctl-api.container")))
+}
+
+func TestRunRollsBackIfServicesAreUnhealthy(t *testing.T) {
+	require := require.New(t)
+	u, services, _, backups := newTestUpgrader(t)
+	services.failing[targetUnit] = true
+	backups.onRestore = func() { services.failing[targetUnit] = false }
+
+	err := u.Run(context.Background())
+	require.ErrorContains(err, "upgrade failed and was rolled back")
+	require.ErrorContains(err, "did not become active")
+	require.True(services.active[targetUnit])
+	require.Equal("/backups/flightctl-backup.tar.gz", backups.restored)
+	require.Equal("old", readFile(t, filepath.Join(u.renderConfig.QuadletFilesOutputDir, "flightctl-api.container")))
+	require.NoFileExists(filepath.Join(u.renderConfig.QuadletFilesOutputDir, "flightctl-new.container"))
+}
+
+func TestRunReportsFailedRollback(t *testing.T) {
+	require := require.New(t)
+	u, services, _, _ := newTestUpgrader(t)
+	services.failing[targetUnit] = true
+
+	err := u.Run(context.Background())
+	require.ErrorContains(err, "rollback failed")
+	require.ErrorContains(err, "flightctl-restore")
+}
+
+func TestRunRollsBackIfRenderFails(t *testing.T) {
+	require := require.New(t)
+	u, services, _, backups := newTestUpgrader(t)
+	services.active[targetUnit] = true
+	u.render = func(*renderer.RendererConfig, logrus.FieldLogger) error { return errors.New("render failed") }
+
+	err := u.Run(context.Background())
+	require.ErrorContains(err, "upgrade failed and was rolled back")
+	require.Equal("/backups/flightctl-backup.tar.gz", backups.restored)
+	require.True(services.active["flightctl-api.service"])
+}
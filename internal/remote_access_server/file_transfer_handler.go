@@ -0,0 +1,81 @@
+package remote_access_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// FileTransferHandler handles WebSocket connections used by `flightctl cp` to copy a single
+// file to or from a device. The handler only relays frames; the agent enforces its path
+// allow-list and size limit, and both ends verify the file's checksum.
+type FileTransferHandler struct {
+	log                      logrus.FieldLogger
+	appConsoleSessionManager *console.AppConsoleSessionManager
+}
+
+// NewFileTransferHandler returns a FileTransferHandler that upgrades HTTP connections to
+// WebSocket and bridges them to file transfer sessions.
+func NewFileTransferHandler(log logrus.FieldLogger, mgr *console.AppConsoleSessionManager) *FileTransferHandler {
+	return &FileTransferHandler{
+		log:                      log,
+		appConsoleSessionManager: mgr,
+	}
+}
+
+// RegisterRoutes mounts the file transfer WebSocket endpoint.
+func (h *FileTransferHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/ws/v1/devices/{name}/files", h.HandleFileTransfer)
+}
+
+// HandleFileTransfer upgrades the HTTP connection to WebSocket once the agent has accepted the
+// requested path, and relays binary messages in both directions until either side closes.
+func (h *FileTransferHandler) HandleFileTransfer(w http.ResponseWriter, r *http.Request) {
+	deviceName := chi.URLParam(r, "name")
+	filePath := r.URL.Query().Get("path")
+	direction := r.URL.Query().Get("direction")
+	if filePath == "" || direction == "" {
+		http.Error(w, "path and direction are required", http.StatusBadRequest)
+		return
+	}
+
+	h.log.Infof("websocket file transfer requested for device: %s path: %s direction: %s", deviceName, filePath, direction)
+
+	if !websocket.IsWebSocketUpgrade(r) {
+		http.Error(w, "expected a WebSocket upgrade request", http.StatusBadRequest)
+		return
+	}
+
+	orgId := transport.OrgIDFromContext(r.Context())
+	session, status := h.appConsoleSessionManager.StartFileTransferSession(r.Context(), orgId, deviceName, filePath, direction)
+	if status.Code != http.StatusOK {
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+
+	// Derive from r.Context() via WithoutCancel so annotation removal keeps the request's
+	// tracing span and other values but isn't cancelled by a client disconnect.
+	cleanupCtx := withRemoteAccessEventMetadata(context.WithoutCancel(r.Context()))
+	defer func() {
+		closeCtx, closeCancel := context.WithTimeout(cleanupCtx, 30*time.Second)
+		defer closeCancel()
+		if closeStatus := h.appConsoleSessionManager.CloseSession(closeCtx, session); closeStatus.Code != http.StatusOK {
+			h.log.Errorf("error closing file transfer session %s for device %s: %v", session.UUID, deviceName, closeStatus.Message)
+		}
+	}()
+
+	conn := acceptTunnel(w, r, h.log, session, fmt.Sprintf("file transfer session for device %s path %s", deviceName, filePath))
+	if conn == nil {
+		return
+	}
+
+	bridgeTunnel(r.Context(), h.log, conn, session)
+	h.log.Infof("ending file transfer session %s (%s %s) on device %s", session.UUID, direction, filePath, deviceName)
+}
@@ -0,0 +1,159 @@
+package remote_access_server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/filetransfer"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newFileTransferTestServer(t *testing.T) (*httptest.Server, chan *console.AppConsoleSession) {
+	t.Helper()
+	startedCh := make(chan *console.AppConsoleSession, 1)
+	mgr := console.NewAppConsoleSessionManager(
+		&fakeAppDeviceService{},
+		logrus.NewEntry(logrus.New()),
+		&fakeAppSessionRegistration{startedCh: startedCh},
+		&fakeConsoleEventNotifier{},
+	)
+	handler := NewFileTransferHandler(logrus.New(), mgr)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server, startedCh
+}
+
+func fileTransferURL(server *httptest.Server, path, direction string) string {
+	q := url.Values{"path": {path}, "direction": {direction}}
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/v1/devices/device1/files?" + q.Encode()
+}
+
+func TestHandleFileTransfer_InvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newFileTransferTestServer(t)
+	tests := []struct{ path, direction string }{
+		{"", filetransfer.DirectionDownload},
+		{"/var/log/messages", ""},
+		{"/var/log/messages", "sideways"},
+		{"relative/path", filetransfer.DirectionDownload},
+		{"/var/log/../../etc/shadow", filetransfer.DirectionDownload},
+	}
+	for _, tt := range tests {
+		_, resp, err := websocket.DefaultDialer.Dial(fileTransferURL(server, tt.path, tt.direction), nil)
+		require.Error(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "path %q direction %q", tt.path, tt.direction)
+	}
+}
+
+func TestHandleFileTransfer_AgentRefusal_MapsStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code   string
+		status int
+	}{
+		{consts.FileTransferErrorCodePathNotAllowed, http.StatusForbidden},
+		{consts.FileTransferErrorCodeTooLarge, http.StatusRequestEntityTooLarge},
+		{"", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		server, startedCh := newFileTransferTestServer(t)
+
+		type dialResult struct {
+			resp *http.Response
+			err  error
+		}
+		dialDone := make(chan dialResult, 1)
+		go func() {
+			_, resp, err := websocket.DefaultDialer.Dial(fileTransferURL(server, "/etc/shadow", filetransfer.DirectionDownload), nil)
+			dialDone <- dialResult{resp: resp, err: err}
+		}()
+
+		var session *console.AppConsoleSession
+		select {
+		case session = <-startedCh:
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected StartSession to be called")
+		}
+		require.Equal(t, "/etc/shadow", session.FilePath)
+		require.Equal(t, filetransfer.DirectionDownload, session.FileTransfer)
+
+		session.ErrCh <- console.SessionFailure{Message: "refused", Code: tt.code}
+
+		var res dialResult
+		select {
+		case res = <-dialDone:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for the dial to fail")
+		}
+		require.Error(t, res.err)
+		require.NotNil(t, res.resp)
+		require.Equal(t, tt.status, res.resp.StatusCode, "code %q", tt.code)
+		require.Equal(t, tt.code, res.resp.Header.Get(consts.AppConsoleErrorCodeHeader))
+	}
+}
+
+func TestHandleFileTransfer_BridgesFrames(t *testing.T) {
+	t.Parallel()
+
+	server, startedCh := newFileTransferTestServer(t)
+
+	type dialResult struct {
+		conn *websocket.Conn
+		err  error
+	}
+	dialDone := make(chan dialResult, 1)
+	go func() {
+		conn, _, err := websocket.DefaultDialer.Dial(fileTransferURL(server, "/var/tmp/diag.tar", filetransfer.DirectionUpload), nil)
+		dialDone <- dialResult{conn: conn, err: err}
+	}()
+
+	var session *console.AppConsoleSession
+	select {
+	case session = <-startedCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected StartSession to be called")
+	}
+	session.ProtocolCh <- consts.FileTransferProtocol
+
+	var res dialResult
+	select {
+	case res = <-dialDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for websocket handshake")
+	}
+	require.NoError(t, res.err)
+	conn := res.conn
+	defer conn.Close()
+
+	header, err := filetransfer.EncodeHeader(filetransfer.Header{Size: 4, SHA256: "abc"})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.BinaryMessage, header))
+	select {
+	case msg := <-session.SendCh:
+		require.Equal(t, header, msg)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for client frame to reach the session")
+	}
+
+	session.RecvCh <- filetransfer.Encode(filetransfer.FrameDone, nil)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	ft, _, err := filetransfer.Decode(msg)
+	require.NoError(t, err)
+	require.Equal(t, filetransfer.FrameDone, ft)
+}
@@ -0,0 +1,102 @@
+package remote_access_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/service/events"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// PortForwardHandler handles WebSocket connections that tunnel a single TCP connection to a
+// port on the device's loopback interface. Each WebSocket connection is its own session; the
+// CLI opens one per accepted local TCP connection.
+type PortForwardHandler struct {
+	log                      logrus.FieldLogger
+	appConsoleSessionManager *console.AppConsoleSessionManager
+	eventsSvc                events.Service
+}
+
+// NewPortForwardHandler returns a PortForwardHandler that upgrades HTTP connections to
+// WebSocket and bridges them to port-forward sessions. eventsSvc may be nil, in which case
+// session start/stop events are not recorded.
+func NewPortForwardHandler(log logrus.FieldLogger, mgr *console.AppConsoleSessionManager, eventsSvc events.Service) *PortForwardHandler {
+	return &PortForwardHandler{
+		log:                      log,
+		appConsoleSessionManager: mgr,
+		eventsSvc:                eventsSvc,
+	}
+}
+
+// RegisterRoutes mounts the port-forward WebSocket endpoint.
+func (h *PortForwardHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/ws/v1/devices/{name}/portforward", h.HandlePortForward)
+}
+
+// HandlePortForward upgrades the HTTP connection to WebSocket once the agent has connected to
+// the requested device port, and relays binary messages in both directions until either side
+// closes.
+func (h *PortForwardHandler) HandlePortForward(w http.ResponseWriter, r *http.Request) {
+	deviceName := chi.URLParam(r, "name")
+
+	portStr := r.URL.Query().Get("port")
+	if portStr == "" {
+		http.Error(w, "port is required", http.StatusBadRequest)
+		return
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		http.Error(w, fmt.Sprintf("invalid port %q: must be between 1 and 65535", portStr), http.StatusBadRequest)
+		return
+	}
+
+	h.log.Infof("websocket port-forward connection requested for device: %s port: %d", deviceName, port)
+
+	if !websocket.IsWebSocketUpgrade(r) {
+		http.Error(w, "expected a WebSocket upgrade request", http.StatusBadRequest)
+		return
+	}
+
+	orgId := transport.OrgIDFromContext(r.Context())
+	session, status := h.appConsoleSessionManager.StartPortForwardSession(r.Context(), orgId, deviceName, int32(port))
+	if status.Code != http.StatusOK {
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+
+	// Derive from r.Context() via WithoutCancel so event creation and annotation removal keep
+	// the request's tracing span and other values but aren't cancelled by a client disconnect.
+	cleanupCtx := withRemoteAccessEventMetadata(context.WithoutCancel(r.Context()))
+	connected := false
+	defer func() {
+		closeCtx, closeCancel := context.WithTimeout(cleanupCtx, 30*time.Second)
+		defer closeCancel()
+		if closeStatus := h.appConsoleSessionManager.CloseSession(closeCtx, session); closeStatus.Code != http.StatusOK {
+			h.log.Errorf("error closing port-forward session %s for device %s: %v", session.UUID, deviceName, closeStatus.Message)
+		}
+		if connected && h.eventsSvc != nil {
+			h.eventsSvc.CreateEvent(closeCtx, orgId, common.GetDevicePortForwardStoppedEvent(closeCtx, deviceName, session.UUID, session.Port))
+		}
+	}()
+
+	conn := acceptTunnel(w, r, h.log, session, fmt.Sprintf("port-forward session for device %s port %d", deviceName, port))
+	if conn == nil {
+		return
+	}
+
+	connected = true
+	if h.eventsSvc != nil {
+		h.eventsSvc.CreateEvent(cleanupCtx, orgId, common.GetDevicePortForwardStartedEvent(cleanupCtx, deviceName, session.UUID, session.Port))
+	}
+
+	bridgeTunnel(r.Context(), h.log, conn, session)
+	h.log.Infof("ending port-forward session %s to device %s port %d", session.UUID, deviceName, port)
+}
@@ -0,0 +1,142 @@
+package remote_access_server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newPortForwardTestServer(t *testing.T) (*httptest.Server, chan *console.AppConsoleSession) {
+	t.Helper()
+	startedCh := make(chan *console.AppConsoleSession, 1)
+	mgr := console.NewAppConsoleSessionManager(
+		&fakeAppDeviceService{},
+		logrus.NewEntry(logrus.New()),
+		&fakeAppSessionRegistration{startedCh: startedCh},
+		&fakeConsoleEventNotifier{},
+	)
+	handler := NewPortForwardHandler(logrus.New(), mgr, nil)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server, startedCh
+}
+
+func portForwardURL(server *httptest.Server, port string) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/v1/devices/device1/portforward?port=" + port
+}
+
+func TestHandlePortForward_InvalidPort(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newPortForwardTestServer(t)
+	for _, port := range []string{"", "0", "65536", "http"} {
+		_, resp, err := websocket.DefaultDialer.Dial(portForwardURL(server, port), nil)
+		require.Error(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "port %q", port)
+	}
+}
+
+func TestHandlePortForward_PortNotAllowed_ReturnsForbidden(t *testing.T) {
+	t.Parallel()
+
+	server, startedCh := newPortForwardTestServer(t)
+
+	type dialResult struct {
+		resp *http.Response
+		err  error
+	}
+	dialDone := make(chan dialResult, 1)
+	go func() {
+		_, resp, err := websocket.DefaultDialer.Dial(portForwardURL(server, "22"), nil)
+		dialDone <- dialResult{resp: resp, err: err}
+	}()
+
+	var session *console.AppConsoleSession
+	select {
+	case session = <-startedCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected StartSession to be called")
+	}
+	require.Equal(t, int32(22), session.Port)
+	require.Empty(t, session.AppName)
+
+	session.ErrCh <- console.SessionFailure{Message: "port 22 is not allowed", Code: consts.PortForwardErrorCodeNotAllowed}
+
+	var res dialResult
+	select {
+	case res = <-dialDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the dial to fail")
+	}
+	require.Error(t, res.err)
+	require.NotNil(t, res.resp)
+	require.Equal(t, http.StatusForbidden, res.resp.StatusCode)
+}
+
+func TestHandlePortForward_BridgesBinaryMessages(t *testing.T) {
+	t.Parallel()
+
+	server, startedCh := newPortForwardTestServer(t)
+
+	type dialResult struct {
+		conn *websocket.Conn
+		err  error
+	}
+	dialDone := make(chan dialResult, 1)
+	go func() {
+		conn, _, err := websocket.DefaultDialer.Dial(portForwardURL(server, "8080"), nil)
+		dialDone <- dialResult{conn: conn, err: err}
+	}()
+
+	var session *console.AppConsoleSession
+	select {
+	case session = <-startedCh:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected StartSession to be called")
+	}
+	session.ProtocolCh <- consts.PortForwardProtocol
+
+	var res dialResult
+	select {
+	case res = <-dialDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for websocket handshake")
+	}
+	require.NoError(t, res.err)
+	conn := res.conn
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteMessage(websocket.BinaryMessage, []byte("ping")))
+	select {
+	case msg := <-session.SendCh:
+		require.Equal(t, "ping", string(msg))
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for client data to reach the session")
+	}
+
+	session.RecvCh <- []byte("pong")
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	msgType, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, websocket.BinaryMessage, msgType)
+	require.Equal(t, "pong", string(msg))
+
+	// The agent closing its end of the connection ends the WebSocket normally.
+	close(session.RecvCh)
+	_, _, err = conn.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	require.True(t, ok, "expected a *websocket.CloseError, got %T: %v", err, err)
+	require.Equal(t, websocket.CloseNormalClosure, closeErr.Code)
+}
@@ -191,6 +191,8 @@ func (s *Server) Run(ctx context.Context) error {
 	svc := &storeAppConsoleService{deviceStore: deviceStore}
 	appConsoleMgr := console.NewAppConsoleSessionManager(svc, s.log, s, s.notifier)
 	appConsoleHandler := NewAppConsoleHandler(s.log, appConsoleMgr)
+	portForwardHandler := NewPortForwardHandler(s.log, appConsoleMgr, eventsSvc)
+	fileTransferHandler := NewFileTransferHandler(s.log, appConsoleMgr)
 
 	// HTTP router — mirrors flightctl-api: AuthN → IdentityMapping → OrgExtraction → AuthZ.
 	r := chi.NewRouter()
@@ -209,6 +211,8 @@ func (s *Server) Run(ctx context.Context) error {
 		r.Use(auth.CreateAuthZMiddleware(authZ, s.log))
 		apiserver.ConfigureRateLimiterFromConfig(r, s.cfg.RemoteAccessService.RateLimit, apiserver.RateLimitScopeGeneral)
 		appConsoleHandler.RegisterRoutes(r)
+		portForwardHandler.RegisterRoutes(r)
+		fileTransferHandler.RegisterRoutes(r)
 	})
 	httpHandler := otelhttp.NewHandler(r, "remote-access-http-server")
 
@@ -0,0 +1,154 @@
+package remote_access_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/contextutil"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// tunnelAcceptTimeout bounds how long a tunnel request waits for the agent to accept it.
+const tunnelAcceptTimeout = time.Minute
+
+// withRemoteAccessEventMetadata sets the event source and actor the same way the flightctl-api
+// AddEventMetadataToCtx middleware does, so events recorded here are attributed to the user.
+func withRemoteAccessEventMetadata(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, consts.EventSourceComponentCtxKey, "flightctl-remote-access")
+	userName := "none"
+	if identity, ok := contextutil.GetMappedIdentityFromContext(ctx); ok && identity != nil {
+		userName = identity.GetUsername()
+	}
+	return context.WithValue(ctx, consts.EventActorCtxKey, fmt.Sprintf("user:%s", userName))
+}
+
+// acceptTunnel waits for the agent to accept a raw byte-stream tunnel session (port forwarding
+// or file transfer) and upgrades the client's connection to WebSocket. If the agent refuses
+// the session, does not answer in time or the upgrade fails, the request is answered, the
+// session's SendCh is closed and nil is returned. label is used only for logging.
+func acceptTunnel(w http.ResponseWriter, r *http.Request, log logrus.FieldLogger, session *console.AppConsoleSession, label string) *websocket.Conn {
+	timer := time.NewTimer(tunnelAcceptTimeout)
+	defer timer.Stop()
+	select {
+	case _, ok := <-session.ProtocolCh:
+		if !ok {
+			close(session.SendCh)
+			log.Errorf("agent failed to open %s", label)
+			http.Error(w, fmt.Sprintf("failed opening %s", label), http.StatusInternalServerError)
+			return nil
+		}
+	case agentErr := <-session.ErrCh:
+		// The agent refused the session (e.g. the port or path is not allowed). Fail the
+		// request before upgrading so the client sees the reason.
+		close(session.SendCh)
+		log.Infof("%s failed before connecting with an agent-reported error", label)
+		log.Debugf("session %s failure detail: code=%q msg=%s", session.UUID, agentErr.Code, agentErr.Message)
+		writeSessionFailure(w, agentErr)
+		return nil
+	case <-timer.C:
+		close(session.SendCh)
+		log.Errorf("timed out waiting for agent to open %s", label)
+		http.Error(w, fmt.Sprintf("timed out waiting for %s", label), http.StatusGatewayTimeout)
+		return nil
+	case <-r.Context().Done():
+		close(session.SendCh)
+		log.Infof("client disconnected while waiting for %s", label)
+		return nil
+	}
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		close(session.SendCh)
+		log.Errorf("failed to upgrade %s to WebSocket: %v", label, err)
+		return nil
+	}
+	conn.SetReadLimit(1 << 20)
+	return conn
+}
+
+// bridgeTunnel relays binary WebSocket messages to session.SendCh and session.RecvCh back to
+// the WebSocket until either side closes. It closes conn and session.SendCh before returning.
+func bridgeTunnel(ctx context.Context, log logrus.FieldLogger, conn *websocket.Conn, session *console.AppConsoleSession) {
+	stopWriter := make(chan struct{})
+	writerDone := make(chan struct{})
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		defer func() {
+			close(stopWriter)
+			close(session.SendCh)
+			wg.Done()
+		}()
+		for {
+			msgType, message, err := conn.ReadMessage()
+			if err != nil {
+				log.Debugf("websocket tunnel session %s closed: %v", session.UUID, err)
+				return
+			}
+			if msgType != websocket.BinaryMessage {
+				log.Warningf("received unexpected message type %d from tunnel websocket session %s", msgType, session.UUID)
+				continue
+			}
+			select {
+			case session.SendCh <- message:
+			case <-writerDone:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		closeCode := websocket.CloseNormalClosure
+		closeReason := ""
+		defer func() {
+			close(writerDone)
+			if err := conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(closeCode, closeReason),
+				time.Now().Add(time.Second*5),
+			); err != nil {
+				log.Debugf("failed to write close message to tunnel websocket for session %s: %v", session.UUID, err)
+			}
+			conn.Close()
+			wg.Done()
+		}()
+		for {
+			select {
+			case <-stopWriter:
+				return
+			case agentErr, ok := <-session.ErrCh:
+				if !ok {
+					return
+				}
+				log.Debugf("tunnel session %s failure detail: code=%q msg=%s", session.UUID, agentErr.Code, agentErr.Message)
+				closeCode = wsCloseCodeForSessionFailure(agentErr)
+				closeReason = truncateWSCloseReason(agentErr.Message)
+				return
+			case message, ok := <-session.RecvCh:
+				if !ok {
+					return
+				}
+				if err := conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+					log.Debugf("failed to write message to tunnel websocket for session %s: %v", session.UUID, err)
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Wait()
+}
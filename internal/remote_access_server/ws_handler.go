@@ -32,8 +32,13 @@ func truncateWSCloseReason(msg string) string {
 }
 
 func httpStatusForSessionFailure(f console.SessionFailure) int {
-	if f.Code == consts.AppConsoleErrorCodeNotReady {
+	switch f.Code {
+	case consts.AppConsoleErrorCodeNotReady:
 		return http.StatusServiceUnavailable
+	case consts.PortForwardErrorCodeNotAllowed, consts.FileTransferErrorCodePathNotAllowed:
+		return http.StatusForbidden
+	case consts.FileTransferErrorCodeTooLarge:
+		return http.StatusRequestEntityTooLarge
 	}
 	return http.StatusNotFound
 }
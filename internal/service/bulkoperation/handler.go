@@ -0,0 +1,112 @@
+package bulkoperation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/service/events"
+	bulkoperationstore "github.com/flightctl/flightctl/internal/store/bulkoperation"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type ServiceHandler struct {
+	store  bulkoperationstore.Store
+	events events.Service
+	log    logrus.FieldLogger
+}
+
+// NewServiceHandler creates a new bulk operation ServiceHandler instance.
+func NewServiceHandler(store bulkoperationstore.Store, events events.Service, log logrus.FieldLogger) *ServiceHandler {
+	return &ServiceHandler{store: store, events: events, log: log}
+}
+
+var _ Service = (*ServiceHandler)(nil)
+
+// SanitizeBulkOperation clears managed metadata and the status from an untrusted bulk operation
+// document (HTTP body).
+func SanitizeBulkOperation(op *domain.BulkOperation) {
+	if op == nil {
+		return
+	}
+	common.NilOutManagedObjectMetaProperties(&op.Metadata)
+	op.Status = nil
+}
+
+// CreateBulkOperationFromUntrusted sanitizes an untrusted bulk operation document, then creates it.
+func CreateBulkOperationFromUntrusted(ctx context.Context, svc Service, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	SanitizeBulkOperation(&op)
+	return svc.CreateBulkOperation(ctx, orgId, op)
+}
+
+// CreateBulkOperation stores a new operation as Pending. Its creation event queues it for the
+// worker, which applies it to the matching devices.
+func (h *ServiceHandler) CreateBulkOperation(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	if errs := op.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	op.Status = &domain.BulkOperationStatus{Phase: domain.BulkOperationPhasePending}
+
+	result, err := h.store.Create(ctx, orgId, &op, h.callbackBulkOperationCreated)
+	return result, common.StoreErrorToApiStatus(err, true, domain.BulkOperationKind, op.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (*domain.BulkOperationList, domain.Status) {
+	listParams, status := common.PrepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	result, err := h.store.Get(ctx, orgId, name)
+	return result, common.StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+}
+
+// DeleteBulkOperation removes an operation. A running operation notices the deletion before its
+// next batch of devices and stops.
+func (h *ServiceHandler) DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.Delete(ctx, orgId, name, h.callbackBulkOperationDeleted)
+	return common.StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	if op.Status == nil {
+		return nil, domain.StatusBadRequest("status is required")
+	}
+
+	result, err := h.store.UpdateStatus(ctx, orgId, &op)
+	return result, common.StoreErrorToApiStatus(err, false, domain.BulkOperationKind, op.Metadata.Name)
+}
+
+// callbackBulkOperationCreated is the bulk operation-specific callback that handles creation events
+func (h *ServiceHandler) callbackBulkOperationCreated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := common.StoreErrorToApiStatus(err, created, domain.BulkOperationKind, &name)
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.BulkOperationKind, name, status, nil))
+		return
+	}
+	h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.BulkOperationKind, name, nil, h.log, nil))
+}
+
+// callbackBulkOperationDeleted is the bulk operation-specific callback that handles deletion events
+func (h *ServiceHandler) callbackBulkOperationDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.events.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
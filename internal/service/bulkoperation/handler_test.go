@@ -0,0 +1,195 @@
+package bulkoperation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/events"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	statusSuccessCode    = int32(200)
+	statusCreatedCode    = int32(201)
+	statusBadRequestCode = int32(400)
+	statusNotFoundCode   = int32(404)
+)
+
+// fakeBulkOperationStore is a small in-memory implementation of internal/store/bulkoperation.Store.
+type fakeBulkOperationStore struct {
+	items map[string]*domain.BulkOperation
+}
+
+func newFakeBulkOperationStore() *fakeBulkOperationStore {
+	return &fakeBulkOperationStore{items: map[string]*domain.BulkOperation{}}
+}
+
+func (f *fakeBulkOperationStore) InitialMigration(_ context.Context) error { return nil }
+
+func (f *fakeBulkOperationStore) Create(ctx context.Context, orgId uuid.UUID, op *domain.BulkOperation, eventCallback store.EventCallback) (*domain.BulkOperation, error) {
+	name := lo.FromPtr(op.Metadata.Name)
+	if _, exists := f.items[name]; exists {
+		return nil, flterrors.ErrDuplicateName
+	}
+	f.items[name] = op
+	if eventCallback != nil {
+		eventCallback(ctx, domain.BulkOperationKind, orgId, name, nil, op, true, nil)
+	}
+	return op, nil
+}
+
+func (f *fakeBulkOperationStore) Get(_ context.Context, _ uuid.UUID, name string) (*domain.BulkOperation, error) {
+	op, ok := f.items[name]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	copied := *op
+	return &copied, nil
+}
+
+func (f *fakeBulkOperationStore) List(_ context.Context, _ uuid.UUID, _ store.ListParams) (*domain.BulkOperationList, error) {
+	var items []domain.BulkOperation
+	for _, op := range f.items {
+		items = append(items, *op)
+	}
+	return &domain.BulkOperationList{Items: items}, nil
+}
+
+func (f *fakeBulkOperationStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error {
+	if _, exists := f.items[name]; !exists {
+		return nil
+	}
+	delete(f.items, name)
+	if eventCallback != nil {
+		eventCallback(ctx, domain.BulkOperationKind, orgId, name, nil, nil, false, nil)
+	}
+	return nil
+}
+
+func (f *fakeBulkOperationStore) UpdateStatus(_ context.Context, _ uuid.UUID, op *domain.BulkOperation) (*domain.BulkOperation, error) {
+	existing, exists := f.items[lo.FromPtr(op.Metadata.Name)]
+	if !exists {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	existing.Status = op.Status
+	return existing, nil
+}
+
+// fakeEventsService is a recording fake for events.Service.
+type fakeEventsService struct {
+	events.Service
+	created []*domain.Event
+	deleted []string
+}
+
+func (f *fakeEventsService) CreateEvent(_ context.Context, _ uuid.UUID, event *domain.Event) {
+	if event == nil {
+		return
+	}
+	f.created = append(f.created, event)
+}
+
+func (f *fakeEventsService) HandleGenericResourceDeletedEvents(_ context.Context, _ domain.ResourceKind, _ uuid.UUID, name string, _, _ interface{}, _ bool, _ error) {
+	f.deleted = append(f.deleted, name)
+}
+
+func newTestHandler() (*ServiceHandler, *fakeBulkOperationStore, *fakeEventsService) {
+	opStore := newFakeBulkOperationStore()
+	ev := &fakeEventsService{}
+	return NewServiceHandler(opStore, ev, logrus.New()), opStore, ev
+}
+
+func newBulkOperation(name string) domain.BulkOperation {
+	return domain.BulkOperation{
+		ApiVersion: "v1beta1",
+		Kind:       domain.BulkOperationKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.BulkOperationSpec{
+			LabelSelector: lo.ToPtr("site=factory-1"),
+			Operation:     domain.BulkOperationTypePatchLabels,
+			Labels:        &domain.BulkOperationMetadataPatch{Set: &map[string]string{"env": "prod"}},
+		},
+	}
+}
+
+func TestCreateBulkOperation(t *testing.T) {
+	t.Run("When the operation is valid it should store it as pending and emit a created event", func(t *testing.T) {
+		h, opStore, ev := newTestHandler()
+
+		resp, status := h.CreateBulkOperation(context.Background(), uuid.New(), newBulkOperation("label-prod"))
+
+		require.Equal(t, statusCreatedCode, status.Code)
+		require.NotNil(t, resp)
+		stored, err := opStore.Get(context.Background(), uuid.Nil, "label-prod")
+		require.NoError(t, err)
+		require.Equal(t, domain.BulkOperationPhasePending, stored.Status.Phase)
+		require.Len(t, ev.created, 1)
+		require.Equal(t, domain.EventReasonResourceCreated, ev.created[0].Reason)
+	})
+
+	t.Run("When the operation has no selector it should return 400", func(t *testing.T) {
+		h, _, ev := newTestHandler()
+		op := newBulkOperation("label-prod")
+		op.Spec.LabelSelector = nil
+
+		_, status := h.CreateBulkOperation(context.Background(), uuid.New(), op)
+
+		require.Equal(t, statusBadRequestCode, status.Code)
+		require.Empty(t, ev.created)
+	})
+
+	t.Run("When the caller sets a status CreateBulkOperationFromUntrusted should reset it", func(t *testing.T) {
+		h, opStore, _ := newTestHandler()
+		op := newBulkOperation("label-prod")
+		op.Status = &domain.BulkOperationStatus{Phase: domain.BulkOperationPhaseSucceeded, SucceededDevices: 10}
+
+		_, status := CreateBulkOperationFromUntrusted(context.Background(), h, uuid.New(), op)
+
+		require.Equal(t, statusCreatedCode, status.Code)
+		stored, err := opStore.Get(context.Background(), uuid.Nil, "label-prod")
+		require.NoError(t, err)
+		require.Equal(t, domain.BulkOperationStatus{Phase: domain.BulkOperationPhasePending}, *stored.Status)
+	})
+}
+
+func TestGetAndDeleteBulkOperation(t *testing.T) {
+	t.Run("When the operation does not exist it should return 404", func(t *testing.T) {
+		h, _, _ := newTestHandler()
+
+		_, status := h.GetBulkOperation(context.Background(), uuid.New(), "missing")
+
+		require.Equal(t, statusNotFoundCode, status.Code)
+	})
+
+	t.Run("When the operation is deleted it should emit a deleted event", func(t *testing.T) {
+		h, opStore, ev := newTestHandler()
+		orgId := uuid.New()
+		_, status := h.CreateBulkOperation(context.Background(), orgId, newBulkOperation("label-prod"))
+		require.Equal(t, statusCreatedCode, status.Code)
+
+		status = h.DeleteBulkOperation(context.Background(), orgId, "label-prod")
+
+		require.Equal(t, statusSuccessCode, status.Code)
+		_, err := opStore.Get(context.Background(), orgId, "label-prod")
+		require.ErrorIs(t, err, flterrors.ErrResourceNotFound)
+		require.Equal(t, []string{"label-prod"}, ev.deleted)
+	})
+}
+
+func TestReplaceBulkOperationStatus(t *testing.T) {
+	t.Run("When the operation was deleted it should return 404", func(t *testing.T) {
+		h, _, _ := newTestHandler()
+		op := newBulkOperation("label-prod")
+		op.Status = &domain.BulkOperationStatus{Phase: domain.BulkOperationPhaseRunning}
+
+		_, status := h.ReplaceBulkOperationStatus(context.Background(), uuid.New(), op)
+
+		require.Equal(t, statusNotFoundCode, status.Code)
+	})
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock.go -package=bulkoperation
+//
+
+// Package bulkoperation is a generated GoMock package.
+package bulkoperation
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/flightctl/flightctl/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateBulkOperation mocks base method.
+func (m *MockService) CreateBulkOperation(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBulkOperation", ctx, orgId, op)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateBulkOperation indicates an expected call of CreateBulkOperation.
+func (mr *MockServiceMockRecorder) CreateBulkOperation(ctx, orgId, op any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulkOperation", reflect.TypeOf((*MockService)(nil).CreateBulkOperation), ctx, orgId, op)
+}
+
+// DeleteBulkOperation mocks base method.
+func (m *MockService) DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteBulkOperation indicates an expected call of DeleteBulkOperation.
+func (mr *MockServiceMockRecorder) DeleteBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBulkOperation", reflect.TypeOf((*MockService)(nil).DeleteBulkOperation), ctx, orgId, name)
+}
+
+// GetBulkOperation mocks base method.
+func (m *MockService) GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetBulkOperation indicates an expected call of GetBulkOperation.
+func (mr *MockServiceMockRecorder) GetBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkOperation", reflect.TypeOf((*MockService)(nil).GetBulkOperation), ctx, orgId, name)
+}
+
+// ListBulkOperations mocks base method.
+func (m *MockService) ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (*domain.BulkOperationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBulkOperations", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.BulkOperationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListBulkOperations indicates an expected call of ListBulkOperations.
+func (mr *MockServiceMockRecorder) ListBulkOperations(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBulkOperations", reflect.TypeOf((*MockService)(nil).ListBulkOperations), ctx, orgId, params)
+}
+
+// ReplaceBulkOperationStatus mocks base method.
+func (m *MockService) ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBulkOperationStatus", ctx, orgId, op)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceBulkOperationStatus indicates an expected call of ReplaceBulkOperationStatus.
+func (mr *MockServiceMockRecorder) ReplaceBulkOperationStatus(ctx, orgId, op any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBulkOperationStatus", reflect.TypeOf((*MockService)(nil).ReplaceBulkOperationStatus), ctx, orgId, op)
+}
@@ -0,0 +1,18 @@
+package bulkoperation
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	CreateBulkOperation(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status)
+	ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (*domain.BulkOperationList, domain.Status)
+	GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status)
+	DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	// ReplaceBulkOperationStatus reports the progress of an operation; it is used by the worker
+	// running the operation and is not exposed through the API.
+	ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status)
+}
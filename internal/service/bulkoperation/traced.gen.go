@@ -0,0 +1,85 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/service-tracing
+// gowrap: http://github.com/hexdigest/gowrap
+
+package bulkoperation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedService wraps a Service implementation with OpenTelemetry tracing.
+type TracedService struct {
+	inner Service
+}
+
+// WrapWithTracing returns a Service that wraps inner with tracing spans, or nil if inner is nil.
+func WrapWithTracing(inner Service) Service {
+	if inner == nil {
+		return nil
+	}
+	return &TracedService{inner: inner}
+}
+
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "flightctl/service/bulkoperation", method)
+}
+
+func endSpan(span trace.Span, st domain.Status) {
+	span.SetAttributes(attribute.Int("status.code", int(st.Code)))
+
+	if st.Status != "Success" {
+		span.RecordError(errors.New(st.Message))
+		span.SetStatus(codes.Error, st.Message)
+	}
+
+	span.End()
+}
+
+func (_d *TracedService) CreateBulkOperation(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (bp1 *domain.BulkOperation, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "CreateBulkOperation")
+
+	bp1, s1 = _d.inner.CreateBulkOperation(ctx, orgId, op)
+	endSpan(span, s1)
+	return bp1, s1
+}
+
+func (_d *TracedService) DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (s1 domain.Status) {
+	ctx, span := startSpan(ctx, "DeleteBulkOperation")
+
+	s1 = _d.inner.DeleteBulkOperation(ctx, orgId, name)
+	endSpan(span, s1)
+	return s1
+}
+
+func (_d *TracedService) GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (bp1 *domain.BulkOperation, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetBulkOperation")
+
+	bp1, s1 = _d.inner.GetBulkOperation(ctx, orgId, name)
+	endSpan(span, s1)
+	return bp1, s1
+}
+
+func (_d *TracedService) ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (bp1 *domain.BulkOperationList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListBulkOperations")
+
+	bp1, s1 = _d.inner.ListBulkOperations(ctx, orgId, params)
+	endSpan(span, s1)
+	return bp1, s1
+}
+
+func (_d *TracedService) ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, op domain.BulkOperation) (bp1 *domain.BulkOperation, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceBulkOperationStatus")
+
+	bp1, s1 = _d.inner.ReplaceBulkOperationStatus(ctx, orgId, op)
+	endSpan(span, s1)
+	return bp1, s1
+}
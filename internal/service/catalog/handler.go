@@ -17,6 +17,7 @@ import (
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -137,22 +138,85 @@ func (h *ServiceHandler) ReplaceCatalog(ctx context.Context, orgId uuid.UUID, na
 		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
 
-	if enforceOwnership {
-		existing, getErr := h.store.Get(ctx, orgId, name)
-		if getErr != nil {
-			if !errors.Is(getErr, flterrors.ErrResourceNotFound) {
-				return nil, common.StoreErrorToApiStatus(getErr, false, domain.CatalogKind, &name)
-			}
-		} else if len(lo.FromPtr(existing.Metadata.Owner)) != 0 &&
-			!domain.CatalogSpecsAreEqual(existing.Spec, catalog.Spec) {
-			return nil, common.StoreErrorToApiStatus(flterrors.ErrUpdatingResourceWithOwnerNotAllowed, false, domain.CatalogKind, &name)
+	existing, getErr := h.store.Get(ctx, orgId, name)
+	if getErr != nil {
+		if !errors.Is(getErr, flterrors.ErrResourceNotFound) {
+			return nil, common.StoreErrorToApiStatus(getErr, false, domain.CatalogKind, &name)
 		}
+		existing = nil
+	}
+
+	if enforceOwnership && existing != nil && len(lo.FromPtr(existing.Metadata.Owner)) != 0 &&
+		!domain.CatalogSpecsAreEqual(existing.Spec, catalog.Spec) {
+		return nil, common.StoreErrorToApiStatus(flterrors.ErrUpdatingResourceWithOwnerNotAllowed, false, domain.CatalogKind, &name)
 	}
 
 	result, created, err := h.store.CreateOrUpdate(ctx, orgId, &catalog, h.callbackCatalogUpdated)
+	if err == nil && existing != nil {
+		err = h.releaseMirroredCatalogItems(ctx, orgId, name, existing.Spec, catalog.Spec)
+	}
 	return result, common.StoreErrorToApiStatus(err, created, domain.CatalogKind, &name)
 }
 
+// releaseMirroredCatalogItems hands the items mirrored from a catalog's source over to local
+// management once the source is removed from the catalog.
+func (h *ServiceHandler) releaseMirroredCatalogItems(ctx context.Context, orgId uuid.UUID, name string, oldSpec, newSpec domain.CatalogSpec) error {
+	if oldSpec.Source == nil || newSpec.Source != nil {
+		return nil
+	}
+	return h.store.UnsetItemOwner(ctx, nil, orgId, util.ResourceOwner(domain.CatalogKind, name))
+}
+
+// deleteMirroredCatalogItems deletes the items mirrored from a catalog's source, as only empty
+// catalogs can be deleted. Items with versions in use by devices or fleets block the deletion.
+func (h *ServiceHandler) deleteMirroredCatalogItems(ctx context.Context, orgId uuid.UUID, catalogName string) domain.Status {
+	owner := util.ResourceOwner(domain.CatalogKind, catalogName)
+	var itemNames []string
+	for lp := (store.ListParams{Limit: common.MaxRecordsPerListRequest}); ; {
+		items, err := h.store.ListItems(ctx, orgId, catalogName, lp)
+		if err != nil {
+			return common.StoreErrorToApiStatus(err, false, domain.CatalogKind, &catalogName)
+		}
+		for _, item := range items.Items {
+			if lo.FromPtr(item.Metadata.Owner) == owner {
+				itemNames = append(itemNames, lo.FromPtr(item.Metadata.Name))
+			}
+		}
+		if items.Metadata.Continue == nil {
+			break
+		}
+		cont, err := store.ParseContinueString(items.Metadata.Continue)
+		if err != nil {
+			return domain.StatusInternalServerError(err.Error())
+		}
+		lp.Continue = cont
+	}
+
+	for _, itemName := range itemNames {
+		if status := h.DeleteCatalogItem(ctx, orgId, catalogName, itemName, false); status.Code != 200 {
+			return status
+		}
+	}
+	return domain.StatusOK()
+}
+
+// checkCatalogAcceptsItem rejects new items in a catalog that is mirrored from a remote source,
+// unless the item is being mirrored from that source.
+func (h *ServiceHandler) checkCatalogAcceptsItem(ctx context.Context, orgId uuid.UUID, catalogName string, item domain.CatalogItem) domain.Status {
+	catalog, err := h.store.Get(ctx, orgId, catalogName)
+	if err != nil {
+		// A missing catalog is reported by the store call that follows.
+		if errors.Is(err, flterrors.ErrResourceNotFound) {
+			return domain.StatusOK()
+		}
+		return common.StoreErrorToApiStatus(err, false, domain.CatalogKind, &catalogName)
+	}
+	if catalog.Spec.Source != nil && lo.FromPtr(item.Metadata.Owner) != util.ResourceOwner(domain.CatalogKind, catalogName) {
+		return domain.StatusConflict(fmt.Sprintf("catalog %s is mirrored from a remote source and is read-only", catalogName))
+	}
+	return domain.StatusOK()
+}
+
 func (h *ServiceHandler) DeleteCatalog(ctx context.Context, orgId uuid.UUID, name string, enforceOwnership bool) domain.Status {
 	c, err := h.store.Get(ctx, orgId, name)
 	if err != nil {
@@ -166,6 +230,12 @@ func (h *ServiceHandler) DeleteCatalog(ctx context.Context, orgId uuid.UUID, nam
 		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
 	}
 
+	if c.Spec.Source != nil {
+		if status := h.deleteMirroredCatalogItems(ctx, orgId, name); status.Code != 200 {
+			return status
+		}
+	}
+
 	callback := func(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
 		// No owned resources for Catalog currently
 		return nil
@@ -206,6 +276,9 @@ func (h *ServiceHandler) PatchCatalog(ctx context.Context, orgId uuid.UUID, name
 	}
 
 	result, err := h.store.Update(ctx, orgId, newObj, h.callbackCatalogUpdated)
+	if err == nil {
+		err = h.releaseMirroredCatalogItems(ctx, orgId, name, currentObj.Spec, newObj.Spec)
+	}
 	return result, common.StoreErrorToApiStatus(err, false, domain.CatalogKind, &name)
 }
 
@@ -303,6 +376,9 @@ func (h *ServiceHandler) CreateCatalogItem(ctx context.Context, orgId uuid.UUID,
 	if errs := item.Validate(); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
+	if status := h.checkCatalogAcceptsItem(ctx, orgId, catalogName, item); status.Code != 200 {
+		return nil, status
+	}
 
 	result, err := h.store.CreateItem(ctx, orgId, catalogName, &item)
 	if errors.Is(err, flterrors.ErrParentResourceNotFound) {
@@ -327,6 +403,12 @@ func (h *ServiceHandler) ReplaceCatalogItem(ctx context.Context, orgId uuid.UUID
 		existing = nil
 	}
 
+	if existing == nil {
+		if status := h.checkCatalogAcceptsItem(ctx, orgId, catalogName, item); status.Code != 200 {
+			return nil, status
+		}
+	}
+
 	if existing != nil {
 		if enforceOwnership && len(lo.FromPtr(existing.Metadata.Owner)) != 0 &&
 			!domain.CatalogItemSpecsAreEqual(existing.Spec, item.Spec) {
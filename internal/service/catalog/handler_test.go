@@ -109,6 +109,27 @@ func (f *fakeDeviceStore) ProcessAwaitingReconnectAnnotation(context.Context, uu
 func (f *fakeDeviceStore) GetLastSeen(context.Context, uuid.UUID, string) (*time.Time, error) {
 	panic("not implemented")
 }
+func (f *fakeDeviceStore) ListStatusHistory(context.Context, uuid.UUID, string, *time.Time, *time.Time, int) ([]domain.DeviceStatusHistoryEntry, error) {
+	panic("not implemented")
+}
+func (f *fakeDeviceStore) AppendStatusHistory(context.Context, uuid.UUID, string, domain.DeviceStatusHistoryEntry) error {
+	panic("not implemented")
+}
+func (f *fakeDeviceStore) DeleteStatusHistoryOlderThan(context.Context, time.Time) (int64, error) {
+	panic("not implemented")
+}
+func (f *fakeDeviceStore) ListRenderedVersions(context.Context, uuid.UUID, string, int) ([]domain.DeviceRenderedVersion, error) {
+	panic("not implemented")
+}
+func (f *fakeDeviceStore) GetRenderedVersion(context.Context, uuid.UUID, string, int64) (*domain.DeviceRenderedVersion, error) {
+	panic("not implemented")
+}
+func (f *fakeDeviceStore) GetPrecedingRenderedVersion(context.Context, uuid.UUID, string, int64) (*domain.DeviceRenderedVersion, error) {
+	panic("not implemented")
+}
+func (f *fakeDeviceStore) AppendRenderedVersion(context.Context, uuid.UUID, string, devicestore.DeviceRenderedVersion) error {
+	panic("not implemented")
+}
 func (f *fakeDeviceStore) SetServiceConditions(context.Context, uuid.UUID, string, []domain.Condition, devicestore.ServiceConditionsCallback) error {
 	panic("not implemented")
 }
@@ -382,7 +403,15 @@ func (f *fakeCatalogStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uu
 }
 
 func (f *fakeCatalogStore) UnsetItemOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
-	return f.err
+	if f.err != nil {
+		return f.err
+	}
+	for _, it := range f.items {
+		if lo.FromPtr(it.Metadata.Owner) == owner {
+			it.Metadata.Owner = nil
+		}
+	}
+	return nil
 }
 
 func (f *fakeCatalogStore) ListAllItems(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.CatalogItemList, error) {
@@ -1188,6 +1217,64 @@ func TestReplaceCatalogItemOwnership(t *testing.T) {
 	})
 }
 
+func TestCatalogWithSource(t *testing.T) {
+	mirrorOwner := "Catalog/vendor"
+	newSourceCatalog := func() domain.Catalog {
+		catalog := createTestCatalog("vendor", nil)
+		catalog.Spec.Source = &domain.CatalogSource{Repository: "registry", Artifact: "vendor/catalog", TargetRevision: "stable"}
+		return catalog
+	}
+
+	t.Run("When creating an item through the API it should return conflict", func(t *testing.T) {
+		h, fakeStore, _ := newTestHandler()
+		catalog := newSourceCatalog()
+		fakeStore.catalogs["vendor"] = &catalog
+
+		_, status := h.CreateCatalogItem(context.Background(), uuid.New(), "vendor", createTestCatalogItem("vendor", "app", nil))
+		require.Equal(t, int32(http.StatusConflict), status.Code)
+		require.Contains(t, status.Message, "read-only")
+
+		_, status = h.ReplaceCatalogItem(context.Background(), uuid.New(), "vendor", "app", createTestCatalogItem("vendor", "app", nil), true)
+		require.Equal(t, int32(http.StatusConflict), status.Code)
+		require.Empty(t, fakeStore.items)
+	})
+
+	t.Run("When mirroring an item from the source it should create it", func(t *testing.T) {
+		h, fakeStore, _ := newTestHandler()
+		catalog := newSourceCatalog()
+		fakeStore.catalogs["vendor"] = &catalog
+
+		_, status := h.ReplaceCatalogItem(context.Background(), uuid.New(), "vendor", "app", createTestCatalogItem("vendor", "app", &mirrorOwner), false)
+		require.Equal(t, int32(http.StatusCreated), status.Code)
+		require.Equal(t, mirrorOwner, lo.FromPtr(fakeStore.items[itemKey("vendor", "app")].Metadata.Owner))
+	})
+
+	t.Run("When deleting the catalog it should delete the mirrored items", func(t *testing.T) {
+		h, fakeStore, _ := newTestHandler()
+		catalog := newSourceCatalog()
+		fakeStore.catalogs["vendor"] = &catalog
+		mirrored := createTestCatalogItem("vendor", "app", &mirrorOwner)
+		fakeStore.items[itemKey("vendor", "app")] = &mirrored
+
+		status := h.DeleteCatalog(context.Background(), uuid.New(), "vendor", true)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Empty(t, fakeStore.items)
+		require.NotContains(t, fakeStore.catalogs, "vendor")
+	})
+
+	t.Run("When the source is removed it should release the mirrored items", func(t *testing.T) {
+		h, fakeStore, _ := newTestHandler()
+		catalog := newSourceCatalog()
+		fakeStore.catalogs["vendor"] = &catalog
+		mirrored := createTestCatalogItem("vendor", "app", &mirrorOwner)
+		fakeStore.items[itemKey("vendor", "app")] = &mirrored
+
+		_, status := h.ReplaceCatalog(context.Background(), uuid.New(), "vendor", createTestCatalog("vendor", nil), true)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Nil(t, fakeStore.items[itemKey("vendor", "app")].Metadata.Owner)
+	})
+}
+
 func TestPatchCatalogItem(t *testing.T) {
 	t.Run("When the parent catalog does not exist it should return a not-found status", func(t *testing.T) {
 		h, _, _ := newTestHandler()
@@ -206,10 +206,24 @@ func updateServerSideDeviceUpdatedStatus(device *domain.Device, ctx context.Cont
 		if updateCondition := domain.FindStatusCondition(device.Status.Conditions, domain.ConditionTypeDeviceUpdating); updateCondition != nil {
 			agentInfoMessage = updateCondition.Message
 		}
+		if device.IsUpdateWaiting() {
+			device.Status.Updated.Status = domain.DeviceUpdatedStatusWaiting
+			device.Status.Updated.Info = lo.ToPtr(util.DefaultString(agentInfoMessage, "Device update is deferred by an application on the device or by its update policy."))
+			return device.Status.Updated.Status != lastUpdateStatus
+		}
 		device.Status.Updated.Status = domain.DeviceUpdatedStatusUpdating
 		device.Status.Updated.Info = lo.ToPtr(util.DefaultString(agentInfoMessage, "Device is updating to the latest device spec."))
 		return device.Status.Updated.Status != lastUpdateStatus
 	}
+	if rollbackVersion, ok := lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationRollbackVersion]; ok {
+		divergedFrom := "device spec"
+		if device.IsManaged() {
+			divergedFrom = "fleet's device template"
+		}
+		device.Status.Updated.Status = domain.DeviceUpdatedStatusDiverged
+		device.Status.Updated.Info = lo.ToPtr(fmt.Sprintf("Device was rolled back to rendered version %s and diverged from its %s.", rollbackVersion, divergedFrom))
+		return device.Status.Updated.Status != lastUpdateStatus
+	}
 	if !device.IsManaged() && !device.IsUpdatedToDeviceSpec() {
 		device.Status.Updated.Status = domain.DeviceUpdatedStatusOutOfDate
 		baseMessage := domain.DeviceOutOfDateText
@@ -645,3 +645,110 @@ func TestUpdateServerSideDeviceUpdatedStatus_ManagedDeviceErrorPriority(t *testi
 		})
 	}
 }
+
+func TestUpdateServerSideDeviceUpdatedStatus_Rollback(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	tests := []struct {
+		name               string
+		rollbackVersion    string
+		expectedStatus     domain.DeviceUpdatedStatusType
+		expectInfoContains string
+	}{
+		{
+			name:               "When the device is rolled back it should report Diverged",
+			rollbackVersion:    "2",
+			expectedStatus:     domain.DeviceUpdatedStatusDiverged,
+			expectInfoContains: "rolled back to rendered version 2",
+		},
+		{
+			name:           "When the device is not rolled back it should report UpToDate",
+			expectedStatus: domain.DeviceUpdatedStatusUpToDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotations := map[string]string{
+				domain.DeviceAnnotationRenderedVersion: "4",
+			}
+			if tt.rollbackVersion != "" {
+				annotations[domain.DeviceAnnotationRollbackVersion] = tt.rollbackVersion
+			}
+			device := &domain.Device{
+				Metadata: domain.ObjectMeta{
+					Name:        lo.ToPtr("test-device"),
+					Annotations: &annotations,
+				},
+				Spec: &domain.DeviceSpec{},
+				Status: &domain.DeviceStatus{
+					LastSeen: lo.ToPtr(time.Now()),
+					Config: domain.DeviceConfigStatus{
+						RenderedVersion: "4",
+					},
+				},
+			}
+
+			updateServerSideDeviceUpdatedStatus(device, ctx, nil, log, orgId)
+
+			assert.Equal(t, tt.expectedStatus, device.Status.Updated.Status)
+			if tt.expectInfoContains != "" {
+				assert.Contains(t, *device.Status.Updated.Info, tt.expectInfoContains)
+			}
+		})
+	}
+}
+
+func TestUpdateServerSideDeviceUpdatedStatus_Waiting(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	tests := []struct {
+		name           string
+		reason         domain.UpdateState
+		expectedStatus domain.DeviceUpdatedStatusType
+	}{
+		{
+			name:           "When an application inhibits the update it should report Waiting",
+			reason:         domain.UpdateStateInhibited,
+			expectedStatus: domain.DeviceUpdatedStatusWaiting,
+		},
+		{
+			name:           "When update conditions are not met it should report Waiting",
+			reason:         domain.UpdateStateWaitingForConditions,
+			expectedStatus: domain.DeviceUpdatedStatusWaiting,
+		},
+		{
+			name:           "When the update is in progress it should report Updating",
+			reason:         domain.UpdateStateApplyingUpdate,
+			expectedStatus: domain.DeviceUpdatedStatusUpdating,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := &domain.Device{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr("test-device")},
+				Spec:     &domain.DeviceSpec{},
+				Status: &domain.DeviceStatus{
+					LastSeen: lo.ToPtr(time.Now()),
+					Conditions: []domain.Condition{{
+						Type:    domain.ConditionTypeDeviceUpdating,
+						Status:  domain.ConditionStatusTrue,
+						Reason:  string(tt.reason),
+						Message: "agent message",
+					}},
+				},
+			}
+
+			changed := updateServerSideDeviceUpdatedStatus(device, ctx, nil, log, orgId)
+
+			assert.True(t, changed)
+			assert.Equal(t, tt.expectedStatus, device.Status.Updated.Status)
+			assert.Equal(t, "agent message", lo.FromPtr(device.Status.Updated.Info))
+		})
+	}
+}
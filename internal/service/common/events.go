@@ -297,6 +297,28 @@ func GetDeviceConflictResolvedEvent(ctx context.Context, deviceName string) *dom
 	})
 }
 
+// GetDeviceRolledBackEvent creates an event for a device being rolled back to a retained rendered version
+func GetDeviceRolledBackEvent(ctx context.Context, deviceName string, renderedVersion string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       domain.EventReasonDeviceRolledBack,
+		message:      fmt.Sprintf("Device was rolled back to rendered version %s.", renderedVersion),
+		details:      nil,
+	})
+}
+
+// GetDeviceRollbackClearedEvent creates an event for a device rollback being cleared
+func GetDeviceRollbackClearedEvent(ctx context.Context, deviceName string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       domain.EventReasonDeviceRollbackCleared,
+		message:      "Device rollback has been cleared and device will be rendered from its spec.",
+		details:      nil,
+	})
+}
+
 // GetDeviceConflictPausedEvent creates an event for device being paused due to version conflict
 func GetDeviceConflictPausedEvent(ctx context.Context, deviceName string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -536,6 +558,70 @@ func GetFleetApplicationLifecycleChangedEvent(ctx context.Context, fleetName str
 	})
 }
 
+// GetDevicePortForwardStartedEvent creates an event for a TCP port-forward session to a device port being opened.
+func GetDevicePortForwardStartedEvent(ctx context.Context, deviceName string, sessionID string, port int32) *domain.Event {
+	return getDevicePortForwardEvent(ctx, deviceName, sessionID, port, domain.EventReasonDevicePortForwardStarted,
+		fmt.Sprintf("Port-forward session %s to port %d on device %s started.", sessionID, port, deviceName))
+}
+
+// GetDevicePortForwardStoppedEvent creates an event for a TCP port-forward session to a device port being closed.
+func GetDevicePortForwardStoppedEvent(ctx context.Context, deviceName string, sessionID string, port int32) *domain.Event {
+	return getDevicePortForwardEvent(ctx, deviceName, sessionID, port, domain.EventReasonDevicePortForwardStopped,
+		fmt.Sprintf("Port-forward session %s to port %d on device %s stopped.", sessionID, port, deviceName))
+}
+
+func getDevicePortForwardEvent(ctx context.Context, deviceName string, sessionID string, port int32, reason domain.EventReason, message string) *domain.Event {
+	details := domain.DevicePortForwardDetails{
+		DetailType: domain.DevicePortForwardDT,
+		SessionId:  sessionID,
+		Port:       port,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromDevicePortForwardDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       reason,
+		message:      message,
+		details:      &eventDetails,
+	})
+}
+
+// GetFleetAutoUpgradePendingEvent creates an event for a catalog item upgrade that a fleet's auto-upgrade
+// policy holds back until it is approved or its schedule window opens.
+func GetFleetAutoUpgradePendingEvent(ctx context.Context, fleetName string, details domain.FleetAutoUpgradeDetails, reason string) *domain.Event {
+	return getFleetAutoUpgradeEvent(ctx, fleetName, details, domain.EventReasonFleetAutoUpgradePending,
+		fmt.Sprintf("Upgrade of catalog item %s/%s from version %s to %s on channel %s is pending: %s.",
+			details.Catalog, details.Item, details.FromVersion, details.ToVersion, details.Channel, reason))
+}
+
+// GetFleetAutoUpgradedEvent creates an event for a catalog item upgrade applied to a fleet's template by its
+// auto-upgrade policy.
+func GetFleetAutoUpgradedEvent(ctx context.Context, fleetName string, details domain.FleetAutoUpgradeDetails) *domain.Event {
+	return getFleetAutoUpgradeEvent(ctx, fleetName, details, domain.EventReasonFleetAutoUpgraded,
+		fmt.Sprintf("Catalog item %s/%s was upgraded from version %s to %s on channel %s.",
+			details.Catalog, details.Item, details.FromVersion, details.ToVersion, details.Channel))
+}
+
+func getFleetAutoUpgradeEvent(ctx context.Context, fleetName string, details domain.FleetAutoUpgradeDetails, reason domain.EventReason, message string) *domain.Event {
+	details.DetailType = domain.FleetAutoUpgradeDT
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetAutoUpgradeDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: fleetName,
+		reason:       reason,
+		message:      message,
+		details:      &eventDetails,
+	})
+}
+
 // GetFleetRolloutBatchDispatchedEvent creates an event for fleet rollout batch dispatch
 func GetFleetRolloutBatchDispatchedEvent(ctx context.Context, fleetName string, templateVersion string, batch string) *domain.Event {
 	details := domain.FleetRolloutBatchDispatchedDetails{
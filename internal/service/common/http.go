@@ -177,6 +177,7 @@ var conflictErrors = map[error]bool{
 	flterrors.ErrInvalidTemplateVersion:              true,
 	flterrors.ErrNoRenderedVersion:                   true,
 	flterrors.ErrDecommission:                        true,
+	flterrors.ErrNoActiveRollback:                    true,
 	flterrors.ErrResourceNotEmpty:                    true,
 	flterrors.ErrResourceInUse:                       true,
 }
@@ -79,7 +79,22 @@ func (h *ServiceHandler) ListDueHttpDependencies(ctx context.Context, orgId uuid
 	return probes, common.StoreErrorToApiStatus(err, false, "", nil)
 }
 
+func (h *ServiceHandler) ListDueVaultDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.VaultDependencyProbe, domain.Status) {
+	probes, err := h.store.ListDueVaultDependencies(ctx, orgId, pollInterval)
+	return probes, common.StoreErrorToApiStatus(err, false, "", nil)
+}
+
+func (h *ServiceHandler) ListDueOciDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.OciDependencyProbe, domain.Status) {
+	probes, err := h.store.ListDueOciDependencies(ctx, orgId, pollInterval)
+	return probes, common.StoreErrorToApiStatus(err, false, "", nil)
+}
+
 func (h *ServiceHandler) ListSecretDependencyTargets(ctx context.Context, secretNamespace, secretName, newFingerprint string) ([]model.SecretDependencyRef, domain.Status) {
 	refs, err := h.store.ListSecretDependencyTargets(ctx, secretNamespace, secretName, newFingerprint)
 	return refs, common.StoreErrorToApiStatus(err, false, "", nil)
 }
+
+func (h *ServiceHandler) ListNativeSecretDependencyTargets(ctx context.Context, orgId uuid.UUID, secretName string) ([]model.SecretDependencyRef, domain.Status) {
+	refs, err := h.store.ListNativeSecretDependencyTargets(ctx, orgId, secretName)
+	return refs, common.StoreErrorToApiStatus(err, false, "", nil)
+}
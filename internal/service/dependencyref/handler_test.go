@@ -37,6 +37,8 @@ type fakeDependencyRefStore struct {
 	refsByType    []model.DependencyRef
 	gitProbes     []model.GitDependencyProbe
 	httpProbes    []model.HttpDependencyProbe
+	vaultProbes   []model.VaultDependencyProbe
+	ociProbes     []model.OciDependencyProbe
 	secretTargets []model.SecretDependencyRef
 
 	err error
@@ -115,6 +117,22 @@ func (f *fakeDependencyRefStore) ListDueHttpDependencies(_ context.Context, _ uu
 	return f.httpProbes, nil
 }
 
+func (f *fakeDependencyRefStore) ListDueVaultDependencies(_ context.Context, _ uuid.UUID, pollInterval time.Duration) ([]model.VaultDependencyProbe, error) {
+	f.pollInterval = pollInterval
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.vaultProbes, nil
+}
+
+func (f *fakeDependencyRefStore) ListDueOciDependencies(_ context.Context, _ uuid.UUID, pollInterval time.Duration) ([]model.OciDependencyProbe, error) {
+	f.pollInterval = pollInterval
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.ociProbes, nil
+}
+
 func (f *fakeDependencyRefStore) ListSecretDependencyTargets(_ context.Context, secretNamespace, secretName, newFingerprint string) ([]model.SecretDependencyRef, error) {
 	f.secretNamespace = secretNamespace
 	f.secretName = secretName
@@ -125,6 +143,14 @@ func (f *fakeDependencyRefStore) ListSecretDependencyTargets(_ context.Context,
 	return f.secretTargets, nil
 }
 
+func (f *fakeDependencyRefStore) ListNativeSecretDependencyTargets(_ context.Context, _ uuid.UUID, secretName string) ([]model.SecretDependencyRef, error) {
+	f.secretName = secretName
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.secretTargets, nil
+}
+
 func newTestHandler() (*ServiceHandler, *fakeDependencyRefStore) {
 	store := &fakeDependencyRefStore{}
 	return NewServiceHandler(store, logrus.New()), store
@@ -237,6 +263,24 @@ func TestListDueHttpDependencies(t *testing.T) {
 	require.Equal(t, store.httpProbes, probes)
 }
 
+func TestListDueVaultDependencies(t *testing.T) {
+	h, store := newTestHandler()
+	store.vaultProbes = []model.VaultDependencyProbe{{RepositoryName: "vault", VaultPath: "edge/site-a"}}
+	probes, status := h.ListDueVaultDependencies(context.Background(), uuid.New(), 45*time.Second)
+	require.Equal(t, int32(200), status.Code)
+	require.Equal(t, 45*time.Second, store.pollInterval)
+	require.Equal(t, store.vaultProbes, probes)
+}
+
+func TestListDueOciDependencies(t *testing.T) {
+	h, store := newTestHandler()
+	store.ociProbes = []model.OciDependencyProbe{{RepositoryName: "registry", OciArtifact: "myorg/device-config", Revision: "v1"}}
+	probes, status := h.ListDueOciDependencies(context.Background(), uuid.New(), 45*time.Second)
+	require.Equal(t, int32(200), status.Code)
+	require.Equal(t, 45*time.Second, store.pollInterval)
+	require.Equal(t, store.ociProbes, probes)
+}
+
 func TestListSecretDependencyTargets(t *testing.T) {
 	h, store := newTestHandler()
 	store.secretTargets = []model.SecretDependencyRef{{FleetName: "fleet1", DeviceName: "dev1"}}
@@ -247,3 +291,12 @@ func TestListSecretDependencyTargets(t *testing.T) {
 	require.Equal(t, "fingerprint1", store.secretFingerprint)
 	require.Equal(t, store.secretTargets, targets)
 }
+
+func TestListNativeSecretDependencyTargets(t *testing.T) {
+	h, store := newTestHandler()
+	store.secretTargets = []model.SecretDependencyRef{{FleetName: "fleet1"}}
+	targets, status := h.ListNativeSecretDependencyTargets(context.Background(), uuid.New(), "secret1")
+	require.Equal(t, int32(200), status.Code)
+	require.Equal(t, "secret1", store.secretName)
+	require.Equal(t, store.secretTargets, targets)
+}
@@ -130,6 +130,51 @@ func (mr *MockServiceMockRecorder) ListDueHttpDependencies(ctx, orgId, pollInter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueHttpDependencies", reflect.TypeOf((*MockService)(nil).ListDueHttpDependencies), ctx, orgId, pollInterval)
 }
 
+// ListDueOciDependencies mocks base method.
+func (m *MockService) ListDueOciDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.OciDependencyProbe, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueOciDependencies", ctx, orgId, pollInterval)
+	ret0, _ := ret[0].([]model.OciDependencyProbe)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListDueOciDependencies indicates an expected call of ListDueOciDependencies.
+func (mr *MockServiceMockRecorder) ListDueOciDependencies(ctx, orgId, pollInterval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueOciDependencies", reflect.TypeOf((*MockService)(nil).ListDueOciDependencies), ctx, orgId, pollInterval)
+}
+
+// ListDueVaultDependencies mocks base method.
+func (m *MockService) ListDueVaultDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.VaultDependencyProbe, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueVaultDependencies", ctx, orgId, pollInterval)
+	ret0, _ := ret[0].([]model.VaultDependencyProbe)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListDueVaultDependencies indicates an expected call of ListDueVaultDependencies.
+func (mr *MockServiceMockRecorder) ListDueVaultDependencies(ctx, orgId, pollInterval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueVaultDependencies", reflect.TypeOf((*MockService)(nil).ListDueVaultDependencies), ctx, orgId, pollInterval)
+}
+
+// ListNativeSecretDependencyTargets mocks base method.
+func (m *MockService) ListNativeSecretDependencyTargets(ctx context.Context, orgId uuid.UUID, secretName string) ([]model.SecretDependencyRef, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNativeSecretDependencyTargets", ctx, orgId, secretName)
+	ret0, _ := ret[0].([]model.SecretDependencyRef)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListNativeSecretDependencyTargets indicates an expected call of ListNativeSecretDependencyTargets.
+func (mr *MockServiceMockRecorder) ListNativeSecretDependencyTargets(ctx, orgId, secretName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNativeSecretDependencyTargets", reflect.TypeOf((*MockService)(nil).ListNativeSecretDependencyTargets), ctx, orgId, secretName)
+}
+
 // ListSecretDependencyTargets mocks base method.
 func (m *MockService) ListSecretDependencyTargets(ctx context.Context, secretNamespace, secretName, newFingerprint string) ([]model.SecretDependencyRef, domain.Status) {
 	m.ctrl.T.Helper()
@@ -21,5 +21,8 @@ type Service interface {
 	ListDependencyRefsByRefType(ctx context.Context, orgId uuid.UUID, refType string) ([]model.DependencyRef, domain.Status)
 	ListDueGitDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.GitDependencyProbe, domain.Status)
 	ListDueHttpDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.HttpDependencyProbe, domain.Status)
+	ListDueVaultDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.VaultDependencyProbe, domain.Status)
+	ListDueOciDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) ([]model.OciDependencyProbe, domain.Status)
 	ListSecretDependencyTargets(ctx context.Context, secretNamespace, secretName, newFingerprint string) ([]model.SecretDependencyRef, domain.Status)
+	ListNativeSecretDependencyTargets(ctx context.Context, orgId uuid.UUID, secretName string) ([]model.SecretDependencyRef, domain.Status)
 }
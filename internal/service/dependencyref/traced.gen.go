@@ -94,6 +94,30 @@ func (_d *TracedService) ListDueHttpDependencies(ctx context.Context, orgId uuid
 	return ha1, s1
 }
 
+func (_d *TracedService) ListDueOciDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) (oa1 []model.OciDependencyProbe, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListDueOciDependencies")
+
+	oa1, s1 = _d.inner.ListDueOciDependencies(ctx, orgId, pollInterval)
+	endSpan(span, s1)
+	return oa1, s1
+}
+
+func (_d *TracedService) ListDueVaultDependencies(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration) (va1 []model.VaultDependencyProbe, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListDueVaultDependencies")
+
+	va1, s1 = _d.inner.ListDueVaultDependencies(ctx, orgId, pollInterval)
+	endSpan(span, s1)
+	return va1, s1
+}
+
+func (_d *TracedService) ListNativeSecretDependencyTargets(ctx context.Context, orgId uuid.UUID, secretName string) (sa1 []model.SecretDependencyRef, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListNativeSecretDependencyTargets")
+
+	sa1, s1 = _d.inner.ListNativeSecretDependencyTargets(ctx, orgId, secretName)
+	endSpan(span, s1)
+	return sa1, s1
+}
+
 func (_d *TracedService) ListSecretDependencyTargets(ctx context.Context, secretNamespace string, secretName string, newFingerprint string) (sa1 []model.SecretDependencyRef, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "ListSecretDependencyTargets")
 
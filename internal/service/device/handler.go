@@ -815,6 +815,10 @@ func (h *DeviceServiceHandler) UpdateRenderedDevice(ctx context.Context, orgId u
 		if m.Device.Status != nil {
 			oldConditions = append([]domain.Condition(nil), m.Device.Status.Conditions...)
 		}
+		// A render that started before a rollback must not overwrite the restored version.
+		if _, ok := lo.FromPtr(m.Device.Metadata.Annotations)[domain.DeviceAnnotationRollbackVersion]; ok {
+			return store.ErrMutateSkipWrite
+		}
 		version, err := applyRenderedUpdate(m, renderedConfig, renderedApplications, specHash, osImage, configFingerprints, forceUpdate)
 		if err != nil {
 			return err
@@ -852,6 +856,12 @@ func (h *DeviceServiceHandler) UpdateRenderedDevice(ctx context.Context, orgId u
 	}
 	if updated != nil {
 		h.callbackDeviceUpdated(ctx, domain.DeviceKind, orgId, name, previous, updated, false, nil)
+		templateVersion := lo.FromPtr(updated.Metadata.Annotations)[domain.DeviceAnnotationRenderedTemplateVersion]
+		h.recordRenderedVersion(ctx, orgId, name, renderedVersion, templateVersion, nil, devicestore.DeviceRendered{
+			Config:       renderedConfig,
+			Applications: renderedApplications,
+			OsImage:      osImage,
+		})
 	}
 	deviceForEvents := updated
 	if deviceForEvents == nil {
@@ -1046,6 +1056,12 @@ func (h *DeviceServiceHandler) ListLabels(ctx context.Context, orgId uuid.UUID,
 // callbackDeviceUpdated is the device-specific callback that handles device events
 func (h *DeviceServiceHandler) callbackDeviceUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	EmitDeviceUpdatedEvent(ctx, h.events, h.log, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err != nil || created {
+		return
+	}
+	if oldDevice, newDevice, ok := common.CastResources[domain.Device](oldResource, newResource); ok {
+		h.recordStatusHistory(ctx, orgId, name, oldDevice, newDevice)
+	}
 }
 
 // callbackDeviceDecommission is the device-specific callback that handles device decommission events
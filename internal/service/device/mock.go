@@ -44,6 +44,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ClearDeviceRollback mocks base method.
+func (m *MockService) ClearDeviceRollback(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDeviceRollback", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ClearDeviceRollback indicates an expected call of ClearDeviceRollback.
+func (mr *MockServiceMockRecorder) ClearDeviceRollback(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDeviceRollback", reflect.TypeOf((*MockService)(nil).ClearDeviceRollback), ctx, orgId, name)
+}
+
 // CountDevices mocks base method.
 func (m *MockService) CountDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (int64, domain.Status) {
 	m.ctrl.T.Helper()
@@ -118,6 +133,21 @@ func (mr *MockServiceMockRecorder) DeleteDevice(ctx, orgId, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockService)(nil).DeleteDevice), ctx, orgId, name)
 }
 
+// DeleteDeviceStatusHistoryOlderThan mocks base method.
+func (m *MockService) DeleteDeviceStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceStatusHistoryOlderThan", ctx, cutoffTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// DeleteDeviceStatusHistoryOlderThan indicates an expected call of DeleteDeviceStatusHistoryOlderThan.
+func (mr *MockServiceMockRecorder) DeleteDeviceStatusHistoryOlderThan(ctx, cutoffTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceStatusHistoryOlderThan", reflect.TypeOf((*MockService)(nil).DeleteDeviceStatusHistoryOlderThan), ctx, cutoffTime)
+}
+
 // ForceUpdateServerSideDeviceStatus mocks base method.
 func (m *MockService) ForceUpdateServerSideDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) error {
 	m.ctrl.T.Helper()
@@ -177,6 +207,36 @@ func (mr *MockServiceMockRecorder) GetDeviceLastSeen(ctx, orgId, name any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceLastSeen", reflect.TypeOf((*MockService)(nil).GetDeviceLastSeen), ctx, orgId, name)
 }
 
+// GetDeviceRenderedVersion mocks base method.
+func (m *MockService) GetDeviceRenderedVersion(ctx context.Context, orgId uuid.UUID, name, version string) (*domain.DeviceRenderedVersion, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceRenderedVersion", ctx, orgId, name, version)
+	ret0, _ := ret[0].(*domain.DeviceRenderedVersion)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetDeviceRenderedVersion indicates an expected call of GetDeviceRenderedVersion.
+func (mr *MockServiceMockRecorder) GetDeviceRenderedVersion(ctx, orgId, name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceRenderedVersion", reflect.TypeOf((*MockService)(nil).GetDeviceRenderedVersion), ctx, orgId, name, version)
+}
+
+// GetDeviceRenderedVersionDiff mocks base method.
+func (m *MockService) GetDeviceRenderedVersionDiff(ctx context.Context, orgId uuid.UUID, name, version string, params domain.GetDeviceRenderedVersionDiffParams) (*domain.DeviceRenderedVersionDiff, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceRenderedVersionDiff", ctx, orgId, name, version, params)
+	ret0, _ := ret[0].(*domain.DeviceRenderedVersionDiff)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetDeviceRenderedVersionDiff indicates an expected call of GetDeviceRenderedVersionDiff.
+func (mr *MockServiceMockRecorder) GetDeviceRenderedVersionDiff(ctx, orgId, name, version, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceRenderedVersionDiff", reflect.TypeOf((*MockService)(nil).GetDeviceRenderedVersionDiff), ctx, orgId, name, version, params)
+}
+
 // GetDeviceRepositoryRefs mocks base method.
 func (m *MockService) GetDeviceRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -207,6 +267,21 @@ func (mr *MockServiceMockRecorder) GetDeviceStatus(ctx, orgId, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceStatus", reflect.TypeOf((*MockService)(nil).GetDeviceStatus), ctx, orgId, name)
 }
 
+// GetDeviceStatusHistory mocks base method.
+func (m *MockService) GetDeviceStatusHistory(ctx context.Context, orgId uuid.UUID, name string, params domain.GetDeviceStatusHistoryParams) (*domain.DeviceStatusHistoryList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceStatusHistory", ctx, orgId, name, params)
+	ret0, _ := ret[0].(*domain.DeviceStatusHistoryList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetDeviceStatusHistory indicates an expected call of GetDeviceStatusHistory.
+func (mr *MockServiceMockRecorder) GetDeviceStatusHistory(ctx, orgId, name, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceStatusHistory", reflect.TypeOf((*MockService)(nil).GetDeviceStatusHistory), ctx, orgId, name, params)
+}
+
 // GetDevicesSummary mocks base method.
 func (m *MockService) GetDevicesSummary(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DevicesSummary, domain.Status) {
 	m.ctrl.T.Helper()
@@ -252,6 +327,21 @@ func (mr *MockServiceMockRecorder) ListConnectivityChangedDevices(ctx, orgId, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectivityChangedDevices", reflect.TypeOf((*MockService)(nil).ListConnectivityChangedDevices), ctx, orgId, params, cutoffTime)
 }
 
+// ListDeviceRenderedVersions mocks base method.
+func (m *MockService) ListDeviceRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, params domain.ListDeviceRenderedVersionsParams) (*domain.DeviceRenderedVersionList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeviceRenderedVersions", ctx, orgId, name, params)
+	ret0, _ := ret[0].(*domain.DeviceRenderedVersionList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListDeviceRenderedVersions indicates an expected call of ListDeviceRenderedVersions.
+func (mr *MockServiceMockRecorder) ListDeviceRenderedVersions(ctx, orgId, name, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeviceRenderedVersions", reflect.TypeOf((*MockService)(nil).ListDeviceRenderedVersions), ctx, orgId, name, params)
+}
+
 // ListDevices mocks base method.
 func (m *MockService) ListDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DeviceList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -435,6 +525,21 @@ func (mr *MockServiceMockRecorder) ResumeDevices(ctx, orgId, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDevices", reflect.TypeOf((*MockService)(nil).ResumeDevices), ctx, orgId, request)
 }
 
+// RollbackDevice mocks base method.
+func (m *MockService) RollbackDevice(ctx context.Context, orgId uuid.UUID, name string, rollback domain.DeviceRollback) (*domain.Device, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDevice", ctx, orgId, name, rollback)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RollbackDevice indicates an expected call of RollbackDevice.
+func (mr *MockServiceMockRecorder) RollbackDevice(ctx, orgId, name, rollback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDevice", reflect.TypeOf((*MockService)(nil).RollbackDevice), ctx, orgId, name, rollback)
+}
+
 // SetDeviceOwner mocks base method.
 func (m *MockService) SetDeviceOwner(ctx context.Context, orgId uuid.UUID, name string, expectedOwner, newOwner *string) (*domain.Device, domain.Status) {
 	m.ctrl.T.Helper()
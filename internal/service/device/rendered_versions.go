@@ -0,0 +1,292 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/rendered"
+	"github.com/flightctl/flightctl/internal/service/common"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	jsonpatchcreate "github.com/mattbaird/jsonpatch"
+	"github.com/samber/lo"
+)
+
+// ListDeviceRenderedVersions returns the retained rendered versions of a device, newest first.
+// Specs are omitted; use GetDeviceRenderedVersion to fetch a single version's spec.
+func (h *DeviceServiceHandler) ListDeviceRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, params domain.ListDeviceRenderedVersionsParams) (*domain.DeviceRenderedVersionList, domain.Status) {
+	limit := 0
+	if params.Limit != nil {
+		if *params.Limit < 0 || *params.Limit > common.MaxRecordsPerListRequest {
+			return nil, domain.StatusBadRequest("limit must be between 0 and 1000")
+		}
+		limit = int(*params.Limit)
+	}
+
+	if _, err := h.deviceStore.Get(ctx, orgId, name); err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	versions, err := h.deviceStore.ListRenderedVersions(ctx, orgId, name, limit)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	return &domain.DeviceRenderedVersionList{
+		ApiVersion: domain.DeviceAPIVersion,
+		Kind:       domain.DeviceRenderedVersionListKind,
+		Metadata:   domain.ListMeta{},
+		Items:      versions,
+	}, domain.StatusOK()
+}
+
+func (h *DeviceServiceHandler) GetDeviceRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version string) (*domain.DeviceRenderedVersion, domain.Status) {
+	result, _, status := h.getRenderedVersion(ctx, orgId, name, version)
+	return result, status
+}
+
+// GetDeviceRenderedVersionDiff returns the JSON Patch that transforms the base rendered spec into
+// the spec of version. The base defaults to the retained version preceding version.
+func (h *DeviceServiceHandler) GetDeviceRenderedVersionDiff(ctx context.Context, orgId uuid.UUID, name string, version string, params domain.GetDeviceRenderedVersionDiffParams) (*domain.DeviceRenderedVersionDiff, domain.Status) {
+	target, targetVersion, status := h.getRenderedVersion(ctx, orgId, name, version)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+
+	var base *domain.DeviceRenderedVersion
+	if params.Base != nil {
+		base, _, status = h.getRenderedVersion(ctx, orgId, name, *params.Base)
+		if status.Code != http.StatusOK {
+			return nil, status
+		}
+	} else {
+		var err error
+		base, err = h.deviceStore.GetPrecedingRenderedVersion(ctx, orgId, name, targetVersion)
+		if errors.Is(err, flterrors.ErrResourceNotFound) {
+			return nil, domain.StatusBadRequest(fmt.Sprintf("no retained rendered version precedes %s, specify a base version", version))
+		}
+		if err != nil {
+			return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceRenderedVersionKind, &version)
+		}
+	}
+
+	patch, err := diffRenderedSpecs(base.Spec, target.Spec)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to compare rendered versions: %v", err))
+	}
+	return &domain.DeviceRenderedVersionDiff{
+		Base:    base.RenderedVersion,
+		Version: target.RenderedVersion,
+		Patch:   patch,
+	}, domain.StatusOK()
+}
+
+// RollbackDevice re-delivers a retained rendered version to the device as a new rendered version.
+// Until ClearDeviceRollback is called, the device is not rendered from its spec and reports
+// that it diverged from it.
+func (h *DeviceServiceHandler) RollbackDevice(ctx context.Context, orgId uuid.UUID, name string, rollback domain.DeviceRollback) (*domain.Device, domain.Status) {
+	target, targetVersion, status := h.getRenderedVersion(ctx, orgId, name, rollback.RenderedVersion)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	content, err := renderedContentOf(target)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to read rendered version %s: %v", target.RenderedVersion, err))
+	}
+
+	var renderedVersion string
+	result, before, _, err := h.deviceStore.Mutate(ctx, orgId, name, nil, func(m *devicestore.DeviceMutation) error {
+		if err := m.RequireExisting(); err != nil {
+			return err
+		}
+		if err := ensureDeviceRollbackAllowed(m.Device); err != nil {
+			return err
+		}
+		ann := util.EnsureMap(lo.FromPtr(m.Device.Metadata.Annotations))
+		next, err := domain.GetNextDeviceRenderedVersion(ann, m.Device.Status)
+		if err != nil {
+			return err
+		}
+		// The rendered template version is left untouched so that fleet rollouts don't keep
+		// re-selecting a device whose rendering is paused by the rollback.
+		ann[domain.DeviceAnnotationRenderedVersion] = next
+		ann[domain.DeviceAnnotationRollbackVersion] = target.RenderedVersion
+		m.Device.Metadata.Annotations = &ann
+		m.Rendered = &content
+		m.PreserveGeneration = true
+		renderedVersion = next
+
+		if m.Device.Status == nil {
+			status := domain.NewDeviceStatus()
+			m.Device.Status = &status
+		}
+		common.UpdateServiceSideStatus(ctx, orgId, m.Device, h.fleetStore, h.log)
+		return nil
+	}, devicestore.WithTimestamp())
+	if err != nil {
+		return nil, rollbackStatus(err, name)
+	}
+
+	h.callbackDeviceUpdated(ctx, domain.DeviceKind, orgId, name, before, result, false, nil)
+	h.events.CreateEvent(ctx, orgId, common.GetDeviceRolledBackEvent(ctx, name, target.RenderedVersion))
+	h.recordRenderedVersion(ctx, orgId, name, renderedVersion, lo.FromPtr(target.TemplateVersion), &targetVersion, content)
+	if err := rendered.Bus.Instance().StoreAndNotify(ctx, orgId, name, renderedVersion); err != nil {
+		h.log.Errorf("Failed to publish rendered device %s/%s: %v", orgId, name, err)
+	}
+	return result, domain.StatusOK()
+}
+
+// ClearDeviceRollback ends an active rollback. The device is rendered from its spec again by
+// the device-render task, triggered by the DeviceRollbackCleared event.
+func (h *DeviceServiceHandler) ClearDeviceRollback(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status) {
+	result, before, _, err := h.deviceStore.Mutate(ctx, orgId, name, nil, func(m *devicestore.DeviceMutation) error {
+		if err := m.RequireExisting(); err != nil {
+			return err
+		}
+		ann := util.EnsureMap(lo.FromPtr(m.Device.Metadata.Annotations))
+		if _, ok := ann[domain.DeviceAnnotationRollbackVersion]; !ok {
+			return flterrors.ErrNoActiveRollback
+		}
+		delete(ann, domain.DeviceAnnotationRollbackVersion)
+		// Forget the spec hash so that the next render isn't skipped as unchanged.
+		delete(ann, domain.DeviceAnnotationRenderedSpecHash)
+		m.Device.Metadata.Annotations = &ann
+		m.PreserveGeneration = true
+
+		if m.Device.Status == nil {
+			status := domain.NewDeviceStatus()
+			m.Device.Status = &status
+		}
+		common.UpdateServiceSideStatus(ctx, orgId, m.Device, h.fleetStore, h.log)
+		return nil
+	}, devicestore.WithTimestamp())
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	h.callbackDeviceUpdated(ctx, domain.DeviceKind, orgId, name, before, result, false, nil)
+	h.events.CreateEvent(ctx, orgId, common.GetDeviceRollbackClearedEvent(ctx, name))
+	return result, domain.StatusOK()
+}
+
+// recordRenderedVersion retains a newly delivered rendered version. Failures are logged only,
+// the rendered version itself has already been persisted.
+func (h *DeviceServiceHandler) recordRenderedVersion(ctx context.Context, orgId uuid.UUID, name, renderedVersion, templateVersion string, rollbackOf *int64, content devicestore.DeviceRendered) {
+	version, err := strconv.ParseInt(renderedVersion, 10, 64)
+	if err != nil {
+		h.log.WithError(err).Warnf("failed recording rendered version %q of device %s/%s", renderedVersion, orgId, name)
+		return
+	}
+	err = h.deviceStore.AppendRenderedVersion(ctx, orgId, name, devicestore.DeviceRenderedVersion{
+		Version:         version,
+		TemplateVersion: templateVersion,
+		RollbackOf:      rollbackOf,
+		Rendered:        content,
+	})
+	if err != nil {
+		h.log.WithError(err).Warnf("failed recording rendered version %s of device %s/%s", renderedVersion, orgId, name)
+	}
+}
+
+// getRenderedVersion returns a retained rendered version along with its parsed version number.
+func (h *DeviceServiceHandler) getRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version string) (*domain.DeviceRenderedVersion, int64, domain.Status) {
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil || v <= 0 {
+		return nil, 0, domain.StatusBadRequest(fmt.Sprintf("invalid rendered version %q", version))
+	}
+	if _, err := h.deviceStore.Get(ctx, orgId, name); err != nil {
+		return nil, 0, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	result, err := h.deviceStore.GetRenderedVersion(ctx, orgId, name, v)
+	if err != nil {
+		return nil, 0, common.StoreErrorToApiStatus(err, false, domain.DeviceRenderedVersionKind, &version)
+	}
+	return result, v, domain.StatusOK()
+}
+
+// ensureDeviceRollbackAllowed rejects rollbacks of devices the service is not delivering specs to.
+func ensureDeviceRollbackAllowed(device *domain.Device) error {
+	if err := rejectDecommissionedDevice(device); err != nil {
+		return err
+	}
+	annotations := lo.FromPtr(device.Metadata.Annotations)
+	if annotations[domain.DeviceAnnotationAwaitingReconnect] == "true" {
+		return flterrors.ErrDeviceAwaitingReconnect
+	}
+	if annotations[domain.DeviceAnnotationConflictPaused] == "true" {
+		return flterrors.ErrDeviceConflictPaused
+	}
+	return nil
+}
+
+func rollbackStatus(err error, name string) domain.Status {
+	if errors.Is(err, flterrors.ErrDecommission) ||
+		errors.Is(err, flterrors.ErrDeviceAwaitingReconnect) ||
+		errors.Is(err, flterrors.ErrDeviceConflictPaused) {
+		return domain.StatusBadRequest(err.Error())
+	}
+	return common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+}
+
+// renderedContentOf converts a retained version back into the rendered column values.
+func renderedContentOf(version *domain.DeviceRenderedVersion) (devicestore.DeviceRendered, error) {
+	spec := lo.FromPtr(version.Spec)
+	config, err := json.Marshal(lo.FromPtr(spec.Config))
+	if err != nil {
+		return devicestore.DeviceRendered{}, err
+	}
+	applications, err := json.Marshal(lo.FromPtr(spec.Applications))
+	if err != nil {
+		return devicestore.DeviceRendered{}, err
+	}
+	content := devicestore.DeviceRendered{
+		Config:       string(config),
+		Applications: string(applications),
+	}
+	if spec.Os != nil {
+		content.OsImage = spec.Os.Image
+	}
+	// A nil slice marshals to null; the rendered columns expect an empty list instead.
+	if spec.Config == nil {
+		content.Config = "[]"
+	}
+	if spec.Applications == nil {
+		content.Applications = "[]"
+	}
+	return content, nil
+}
+
+// diffRenderedSpecs returns the JSON Patch transforming base into target, ordered by path.
+func diffRenderedSpecs(base, target *domain.DeviceSpec) (domain.PatchRequest, error) {
+	baseJSON, err := json.Marshal(lo.FromPtr(base))
+	if err != nil {
+		return nil, err
+	}
+	targetJSON, err := json.Marshal(lo.FromPtr(target))
+	if err != nil {
+		return nil, err
+	}
+	ops, err := jsonpatchcreate.CreatePatch(baseJSON, targetJSON)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].Path < ops[j].Path })
+
+	opsJSON, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+	patch := domain.PatchRequest{}
+	if err := json.Unmarshal(opsJSON, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
@@ -0,0 +1,147 @@
+package device
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceRenderedVersions(t *testing.T) {
+	setup := func(t *testing.T, annotations map[string]string) (*fakeStore, *fakeEvents, Service, uuid.UUID) {
+		st, ev, svc := newTestHandler()
+		ctx := context.Background()
+		orgId := uuid.New()
+		_, err := st.device.Create(ctx, orgId, &domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo"), Annotations: &annotations},
+			Spec:     &domain.DeviceSpec{},
+			Status:   lo.ToPtr(domain.NewDeviceStatus()),
+		}, nil)
+		require.NoError(t, err)
+		versions := []devicestore.DeviceRenderedVersion{
+			{Version: 1, TemplateVersion: "tv1", Rendered: devicestore.DeviceRendered{Config: "[]", Applications: "[]", OsImage: "quay.io/os:v1"}},
+			{Version: 2, TemplateVersion: "tv2", Rendered: devicestore.DeviceRendered{Config: "[]", Applications: "[]", OsImage: "quay.io/os:v2"}},
+			{Version: 3, TemplateVersion: "tv3", Rendered: devicestore.DeviceRendered{Config: "[]", Applications: "[]", OsImage: "quay.io/os:v3"}},
+		}
+		for _, v := range versions {
+			require.NoError(t, st.device.AppendRenderedVersion(ctx, orgId, "foo", v))
+		}
+		return st, ev, svc, orgId
+	}
+
+	t.Run("When listing rendered versions it should return them newest first without specs", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		list, status := svc.ListDeviceRenderedVersions(context.Background(), orgId, "foo", domain.ListDeviceRenderedVersionsParams{Limit: lo.ToPtr(int32(2))})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, domain.DeviceRenderedVersionListKind, list.Kind)
+		require.Len(t, list.Items, 2)
+		require.Equal(t, "3", list.Items[0].RenderedVersion)
+		require.Equal(t, "tv3", lo.FromPtr(list.Items[0].TemplateVersion))
+		require.Nil(t, list.Items[0].Spec)
+	})
+
+	t.Run("When getting a rendered version it should include its spec", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		version, status := svc.GetDeviceRenderedVersion(context.Background(), orgId, "foo", "2")
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, "quay.io/os:v2", version.Spec.Os.Image)
+	})
+
+	t.Run("When getting an unknown or invalid rendered version it should fail", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		_, status := svc.GetDeviceRenderedVersion(context.Background(), orgId, "foo", "7")
+		require.Equal(t, int32(http.StatusNotFound), status.Code)
+		_, status = svc.GetDeviceRenderedVersion(context.Background(), orgId, "foo", "latest")
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+		_, status = svc.GetDeviceRenderedVersion(context.Background(), orgId, "bar", "1")
+		require.Equal(t, int32(http.StatusNotFound), status.Code)
+	})
+
+	t.Run("When diffing without a base it should compare against the preceding version", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		diff, status := svc.GetDeviceRenderedVersionDiff(context.Background(), orgId, "foo", "3", domain.GetDeviceRenderedVersionDiffParams{})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, "2", diff.Base)
+		require.Equal(t, "3", diff.Version)
+		require.Len(t, diff.Patch, 1)
+		require.Equal(t, domain.PatchOpReplace, diff.Patch[0].Op)
+		require.Equal(t, "/os/image", diff.Patch[0].Path)
+		require.Equal(t, "quay.io/os:v3", diff.Patch[0].Value)
+	})
+
+	t.Run("When diffing against an explicit base it should compare against it", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		diff, status := svc.GetDeviceRenderedVersionDiff(context.Background(), orgId, "foo", "1", domain.GetDeviceRenderedVersionDiffParams{Base: lo.ToPtr("3")})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, "3", diff.Base)
+		require.Equal(t, "quay.io/os:v1", diff.Patch[0].Value)
+	})
+
+	t.Run("When diffing the oldest version without a base it should return bad request", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		_, status := svc.GetDeviceRenderedVersionDiff(context.Background(), orgId, "foo", "1", domain.GetDeviceRenderedVersionDiffParams{})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+	})
+
+	t.Run("When rolling back to an unknown version it should return not found", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		_, status := svc.RollbackDevice(context.Background(), orgId, "foo", domain.DeviceRollback{RenderedVersion: "9"})
+		require.Equal(t, int32(http.StatusNotFound), status.Code)
+	})
+
+	t.Run("When rolling back to an invalid version it should return bad request", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		for _, version := range []string{"latest", "0", "99999999999999999999"} {
+			_, status := svc.RollbackDevice(context.Background(), orgId, "foo", domain.DeviceRollback{RenderedVersion: version})
+			require.Equal(t, int32(http.StatusBadRequest), status.Code, version)
+		}
+	})
+
+	t.Run("When rolling back a device paused by a conflict it should return bad request", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{domain.DeviceAnnotationConflictPaused: "true"})
+		_, status := svc.RollbackDevice(context.Background(), orgId, "foo", domain.DeviceRollback{RenderedVersion: "1"})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+	})
+
+	t.Run("When clearing a device without an active rollback it should return conflict", func(t *testing.T) {
+		_, _, svc, orgId := setup(t, map[string]string{})
+		_, status := svc.ClearDeviceRollback(context.Background(), orgId, "foo")
+		require.Equal(t, int32(http.StatusConflict), status.Code)
+	})
+
+	t.Run("When clearing an active rollback it should remove it and request a render", func(t *testing.T) {
+		_, ev, svc, orgId := setup(t, map[string]string{
+			domain.DeviceAnnotationRollbackVersion:  "1",
+			domain.DeviceAnnotationRenderedSpecHash: "hash",
+			domain.DeviceAnnotationRenderedVersion:  "4",
+		})
+		device, status := svc.ClearDeviceRollback(context.Background(), orgId, "foo")
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		annotations := lo.FromPtr(device.Metadata.Annotations)
+		require.NotContains(t, annotations, domain.DeviceAnnotationRollbackVersion)
+		require.NotContains(t, annotations, domain.DeviceAnnotationRenderedSpecHash)
+		require.Equal(t, "4", annotations[domain.DeviceAnnotationRenderedVersion])
+		require.True(t, lo.ContainsBy(ev.created, func(e *domain.Event) bool {
+			return e.Reason == domain.EventReasonDeviceRollbackCleared
+		}))
+	})
+
+	t.Run("When a render completes during an active rollback it should not overwrite the rollback", func(t *testing.T) {
+		st, _, svc, orgId := setup(t, map[string]string{
+			domain.DeviceAnnotationRollbackVersion:  "1",
+			domain.DeviceAnnotationRenderedSpecHash: "old",
+			domain.DeviceAnnotationRenderedVersion:  "4",
+		})
+		status := svc.UpdateRenderedDevice(context.Background(), orgId, "foo", "[]", "[]", "new", "", nil, true)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		device, err := st.device.Get(context.Background(), orgId, "foo")
+		require.NoError(t, err)
+		require.Equal(t, "4", lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationRenderedVersion])
+		require.Len(t, st.device.versions["foo"], 3)
+	})
+}
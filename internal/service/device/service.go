@@ -33,11 +33,17 @@ type Service interface {
 	DeleteDevice(ctx context.Context, orgId uuid.UUID, name string) domain.Status
 	GetDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status)
 	GetDeviceLastSeen(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceLastSeen, domain.Status)
+	GetDeviceStatusHistory(ctx context.Context, orgId uuid.UUID, name string, params domain.GetDeviceStatusHistoryParams) (*domain.DeviceStatusHistoryList, domain.Status)
+	ListDeviceRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, params domain.ListDeviceRenderedVersionsParams) (*domain.DeviceRenderedVersionList, domain.Status)
+	GetDeviceRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version string) (*domain.DeviceRenderedVersion, domain.Status)
+	GetDeviceRenderedVersionDiff(ctx context.Context, orgId uuid.UUID, name string, version string, params domain.GetDeviceRenderedVersionDiffParams) (*domain.DeviceRenderedVersionDiff, domain.Status)
 	ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, device domain.Device, refreshLastSeen bool) (*domain.Device, domain.Status)
 	PatchDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Device, domain.Status)
 	GetRenderedDevice(ctx context.Context, orgId uuid.UUID, name string, params domain.GetRenderedDeviceParams) (*domain.Device, domain.Status)
 	PatchDevice(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest, enforceOwnership bool, enforceCapabilities bool) (*domain.Device, domain.Status)
 	DecommissionDevice(ctx context.Context, orgId uuid.UUID, name string, decom domain.DeviceDecommission) (*domain.Device, domain.Status)
+	RollbackDevice(ctx context.Context, orgId uuid.UUID, name string, rollback domain.DeviceRollback) (*domain.Device, domain.Status)
+	ClearDeviceRollback(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status)
 	ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status)
 	UpdateDeviceAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status
 	StopDeviceApplication(ctx context.Context, orgId uuid.UUID, name string, appName string) (*domain.Device, domain.Status)
@@ -58,5 +64,6 @@ type Service interface {
 	UpdateServerSideDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) error
 	ForceUpdateServerSideDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) error
 	ListConnectivityChangedDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, cutoffTime time.Time) (*domain.DeviceList, domain.Status)
+	DeleteDeviceStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status)
 	ListLabels(ctx context.Context, orgId uuid.UUID, params domain.ListLabelsParams) (*domain.LabelList, domain.Status)
 }
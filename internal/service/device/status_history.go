@@ -0,0 +1,131 @@
+package device
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+)
+
+// GetDeviceStatusHistory returns the recorded significant status transitions of a device,
+// newest first, optionally restricted to [from, to).
+func (h *DeviceServiceHandler) GetDeviceStatusHistory(ctx context.Context, orgId uuid.UUID, name string, params domain.GetDeviceStatusHistoryParams) (*domain.DeviceStatusHistoryList, domain.Status) {
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, domain.StatusBadRequest("'from' must be before 'to'")
+	}
+	limit := 0
+	if params.Limit != nil {
+		if *params.Limit < 0 || *params.Limit > common.MaxRecordsPerListRequest {
+			return nil, domain.StatusBadRequest("limit must be between 0 and 1000")
+		}
+		limit = int(*params.Limit)
+	}
+
+	if _, err := h.deviceStore.Get(ctx, orgId, name); err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	entries, err := h.deviceStore.ListStatusHistory(ctx, orgId, name, params.From, params.To, limit)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	return &domain.DeviceStatusHistoryList{
+		ApiVersion: domain.DeviceAPIVersion,
+		Kind:       domain.DeviceStatusHistoryListKind,
+		Metadata:   domain.ListMeta{},
+		Items:      entries,
+	}, domain.StatusOK()
+}
+
+// DeleteDeviceStatusHistoryOlderThan removes the status transitions of all devices recorded
+// before the cutoff. It is used by the retention task.
+func (h *DeviceServiceHandler) DeleteDeviceStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (int64, domain.Status) {
+	deleted, err := h.deviceStore.DeleteStatusHistoryOlderThan(ctx, cutoffTime)
+	if err != nil {
+		return 0, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, nil)
+	}
+	return deleted, domain.StatusOK()
+}
+
+// recordStatusHistory appends a history entry when the update changed a significant part of the
+// device status. Failures are logged only, the status update itself has already been persisted.
+func (h *DeviceServiceHandler) recordStatusHistory(ctx context.Context, orgId uuid.UUID, name string, oldDevice, newDevice *domain.Device) {
+	entry := computeStatusHistoryEntry(oldDevice, newDevice, time.Now().UTC())
+	if entry == nil {
+		return
+	}
+	if err := h.deviceStore.AppendStatusHistory(ctx, orgId, name, *entry); err != nil {
+		h.log.WithError(err).Warnf("failed recording status history of device %s/%s", orgId, name)
+	}
+}
+
+// computeStatusHistoryEntry returns the entry to record for a transition from oldDevice to
+// newDevice, or nil when nothing significant changed. Informational messages, heartbeats and
+// counters such as application restarts are deliberately not considered significant.
+func computeStatusHistoryEntry(oldDevice, newDevice *domain.Device, now time.Time) *domain.DeviceStatusHistoryEntry {
+	if newDevice == nil || newDevice.Status == nil {
+		return nil
+	}
+	oldStatus := domain.NewDeviceStatus()
+	if oldDevice != nil && oldDevice.Status != nil {
+		oldStatus = *oldDevice.Status
+	}
+	newStatus := newDevice.Status
+
+	var changed []domain.DeviceStatusHistoryField
+	if oldStatus.Summary.Status != newStatus.Summary.Status {
+		changed = append(changed, domain.DeviceStatusHistoryFieldSummary)
+	}
+	if oldStatus.Updated.Status != newStatus.Updated.Status {
+		changed = append(changed, domain.DeviceStatusHistoryFieldUpdated)
+	}
+	if oldStatus.ApplicationsSummary.Status != newStatus.ApplicationsSummary.Status ||
+		applicationStatusesChanged(oldStatus.Applications, newStatus.Applications) {
+		changed = append(changed, domain.DeviceStatusHistoryFieldApplications)
+	}
+	if oldStatus.Resources != newStatus.Resources {
+		changed = append(changed, domain.DeviceStatusHistoryFieldResources)
+	}
+	if oldStatus.Os.Image != newStatus.Os.Image || oldStatus.Os.ImageDigest != newStatus.Os.ImageDigest {
+		changed = append(changed, domain.DeviceStatusHistoryFieldOs)
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	applications := newStatus.Applications
+	if applications == nil {
+		applications = []domain.DeviceApplicationStatus{}
+	}
+	return &domain.DeviceStatusHistoryEntry{
+		Timestamp:           now,
+		ChangedFields:       changed,
+		Summary:             newStatus.Summary,
+		Updated:             newStatus.Updated,
+		ApplicationsSummary: newStatus.ApplicationsSummary,
+		Applications:        applications,
+		Resources:           newStatus.Resources,
+		Os:                  newStatus.Os,
+	}
+}
+
+// applicationStatusesChanged reports whether an application was added, removed or changed status.
+func applicationStatusesChanged(oldApps, newApps []domain.DeviceApplicationStatus) bool {
+	if len(oldApps) != len(newApps) {
+		return true
+	}
+	oldByName := make(map[string]domain.ApplicationStatusType, len(oldApps))
+	for _, app := range oldApps {
+		oldByName[app.Name] = app.Status
+	}
+	for _, app := range newApps {
+		status, ok := oldByName[app.Name]
+		if !ok || status != app.Status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+package device
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeStatusHistoryEntry(t *testing.T) {
+	now := time.Now().UTC()
+	base := func() *domain.Device {
+		status := domain.NewDeviceStatus()
+		status.Summary.Status = domain.DeviceSummaryStatusOnline
+		status.Updated.Status = domain.DeviceUpdatedStatusUpToDate
+		status.ApplicationsSummary.Status = domain.ApplicationsSummaryStatusHealthy
+		status.Applications = []domain.DeviceApplicationStatus{{Name: "app", Status: domain.ApplicationStatusRunning}}
+		status.Resources = domain.DeviceResourceStatus{
+			Cpu:    domain.DeviceResourceStatusHealthy,
+			Memory: domain.DeviceResourceStatusHealthy,
+			Disk:   domain.DeviceResourceStatusHealthy,
+		}
+		status.Os = domain.DeviceOsStatus{Image: "quay.io/os:v1", ImageDigest: "sha256:1"}
+		return &domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")}, Status: &status}
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(d *domain.Device)
+		expected []domain.DeviceStatusHistoryField
+	}{
+		{
+			name:   "When nothing significant changed it should record nothing",
+			mutate: func(d *domain.Device) { d.Status.Summary.Info = lo.ToPtr("heartbeat") },
+		},
+		{
+			name:   "When only an application's restart count changed it should record nothing",
+			mutate: func(d *domain.Device) { d.Status.Applications[0].Restarts = 3 },
+		},
+		{
+			name: "When the summary and application status changed it should record both",
+			mutate: func(d *domain.Device) {
+				d.Status.Summary.Status = domain.DeviceSummaryStatusDegraded
+				d.Status.ApplicationsSummary.Status = domain.ApplicationsSummaryStatusDegraded
+				d.Status.Applications[0].Status = domain.ApplicationStatusError
+			},
+			expected: []domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldSummary, domain.DeviceStatusHistoryFieldApplications},
+		},
+		{
+			name: "When an application was added it should record applications",
+			mutate: func(d *domain.Device) {
+				d.Status.Applications = append(d.Status.Applications, domain.DeviceApplicationStatus{Name: "other", Status: domain.ApplicationStatusStarting})
+			},
+			expected: []domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldApplications},
+		},
+		{
+			name:     "When a resource crossed a threshold it should record resources",
+			mutate:   func(d *domain.Device) { d.Status.Resources.Disk = domain.DeviceResourceStatusCritical },
+			expected: []domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldResources},
+		},
+		{
+			name: "When the OS image changed it should record os and updated",
+			mutate: func(d *domain.Device) {
+				d.Status.Updated.Status = domain.DeviceUpdatedStatusUpdating
+				d.Status.Os = domain.DeviceOsStatus{Image: "quay.io/os:v2", ImageDigest: "sha256:2"}
+			},
+			expected: []domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldUpdated, domain.DeviceStatusHistoryFieldOs},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldDevice := base()
+			newDevice := base()
+			tt.mutate(newDevice)
+
+			entry := computeStatusHistoryEntry(oldDevice, newDevice, now)
+			if tt.expected == nil {
+				require.Nil(t, entry)
+				return
+			}
+			require.NotNil(t, entry)
+			require.Equal(t, tt.expected, entry.ChangedFields)
+			require.Equal(t, now, entry.Timestamp)
+			require.Equal(t, newDevice.Status.Summary, entry.Summary)
+			require.Equal(t, newDevice.Status.Os, entry.Os)
+		})
+	}
+
+	t.Run("When the device had no status before it should compare against the initial status", func(t *testing.T) {
+		oldDevice := base()
+		oldDevice.Status = nil
+		entry := computeStatusHistoryEntry(oldDevice, base(), now)
+		require.NotNil(t, entry)
+		require.Contains(t, entry.ChangedFields, domain.DeviceStatusHistoryFieldSummary)
+		require.Contains(t, entry.ChangedFields, domain.DeviceStatusHistoryFieldOs)
+	})
+
+	t.Run("When the new device has no status it should record nothing", func(t *testing.T) {
+		newDevice := base()
+		newDevice.Status = nil
+		require.Nil(t, computeStatusHistoryEntry(base(), newDevice, now))
+	})
+}
+
+func TestGetDeviceStatusHistory(t *testing.T) {
+	setup := func(t *testing.T) (*fakeStore, Service, uuid.UUID) {
+		st, _, svc := newTestHandler()
+		orgId := uuid.New()
+		status := domain.NewDeviceStatus()
+		device := domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")},
+			Spec:     &domain.DeviceSpec{},
+			Status:   &status,
+		}
+		_, err := st.device.Create(context.Background(), orgId, &device, nil)
+		require.NoError(t, err)
+		return st, svc, orgId
+	}
+
+	t.Run("When a status update changes an application it should be returned in the history", func(t *testing.T) {
+		_, svc, orgId := setup(t)
+		ctx := context.Background()
+		status := domain.NewDeviceStatus()
+		status.Applications = []domain.DeviceApplicationStatus{{Name: "app", Status: domain.ApplicationStatusError}}
+		device := domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")}, Status: &status}
+		_, st := svc.ReplaceDeviceStatus(ctx, orgId, "foo", device, false)
+		require.Equal(t, int32(http.StatusOK), st.Code)
+
+		history, st := svc.GetDeviceStatusHistory(ctx, orgId, "foo", domain.GetDeviceStatusHistoryParams{})
+		require.Equal(t, int32(http.StatusOK), st.Code)
+		require.Equal(t, domain.DeviceStatusHistoryListKind, history.Kind)
+		require.Len(t, history.Items, 1)
+		require.Contains(t, history.Items[0].ChangedFields, domain.DeviceStatusHistoryFieldApplications)
+		require.Equal(t, "app", history.Items[0].Applications[0].Name)
+	})
+
+	t.Run("When filtering by time range it should return only matching entries, newest first", func(t *testing.T) {
+		st, svc, orgId := setup(t)
+		ctx := context.Background()
+		start := time.Now().UTC().Add(-3 * time.Hour)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, st.device.AppendStatusHistory(ctx, orgId, "foo", domain.DeviceStatusHistoryEntry{
+				Timestamp:     start.Add(time.Duration(i) * time.Hour),
+				ChangedFields: []domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldSummary},
+			}))
+		}
+
+		history, status := svc.GetDeviceStatusHistory(ctx, orgId, "foo", domain.GetDeviceStatusHistoryParams{
+			From: lo.ToPtr(start.Add(30 * time.Minute)),
+		})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Len(t, history.Items, 2)
+		require.Equal(t, start.Add(2*time.Hour), history.Items[0].Timestamp)
+
+		history, status = svc.GetDeviceStatusHistory(ctx, orgId, "foo", domain.GetDeviceStatusHistoryParams{Limit: lo.ToPtr(int32(1))})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Len(t, history.Items, 1)
+	})
+
+	t.Run("When from is not before to it should return bad request", func(t *testing.T) {
+		_, svc, orgId := setup(t)
+		now := time.Now()
+		_, status := svc.GetDeviceStatusHistory(context.Background(), orgId, "foo", domain.GetDeviceStatusHistoryParams{From: &now, To: &now})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+	})
+
+	t.Run("When the limit is out of range it should return bad request", func(t *testing.T) {
+		_, svc, orgId := setup(t)
+		_, status := svc.GetDeviceStatusHistory(context.Background(), orgId, "foo", domain.GetDeviceStatusHistoryParams{Limit: lo.ToPtr(int32(1001))})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+	})
+
+	t.Run("When the device does not exist it should return not found", func(t *testing.T) {
+		_, svc, orgId := setup(t)
+		_, status := svc.GetDeviceStatusHistory(context.Background(), orgId, "bar", domain.GetDeviceStatusHistoryParams{})
+		require.Equal(t, int32(http.StatusNotFound), status.Code)
+	})
+}
@@ -60,6 +60,8 @@ func newFakeStore() *fakeStore {
 			rendered: map[string]*devicestore.DeviceRendered{},
 			repoRefs: map[string][]string{},
 			lastSeen: map[string]*time.Time{},
+			history:  map[string][]domain.DeviceStatusHistoryEntry{},
+			versions: map[string][]devicestore.DeviceRenderedVersion{},
 		},
 		catalog: &fakeCatalogStore{items: map[string]*domain.CatalogItem{}},
 		fleet:   &fakeFleetStore{fleets: map[string]*domain.Fleet{}},
@@ -74,6 +76,8 @@ type fakeDeviceStore struct {
 	rendered map[string]*devicestore.DeviceRendered
 	repoRefs map[string][]string
 	lastSeen map[string]*time.Time
+	history  map[string][]domain.DeviceStatusHistoryEntry
+	versions map[string][]devicestore.DeviceRenderedVersion
 }
 
 func (s *fakeDeviceStore) rememberLastSeen(name string, device *domain.Device) {
@@ -289,6 +293,99 @@ func (s *fakeDeviceStore) GetLastSeen(ctx context.Context, orgId uuid.UUID, name
 	return nil, nil
 }
 
+func (s *fakeDeviceStore) AppendStatusHistory(ctx context.Context, orgId uuid.UUID, name string, entry domain.DeviceStatusHistoryEntry) error {
+	if s.history == nil {
+		s.history = map[string][]domain.DeviceStatusHistoryEntry{}
+	}
+	s.history[name] = append(s.history[name], entry)
+	return nil
+}
+
+func (s *fakeDeviceStore) ListStatusHistory(ctx context.Context, orgId uuid.UUID, name string, from, to *time.Time, limit int) ([]domain.DeviceStatusHistoryEntry, error) {
+	var result []domain.DeviceStatusHistoryEntry
+	for i := len(s.history[name]) - 1; i >= 0; i-- {
+		entry := s.history[name][i]
+		if (from != nil && entry.Timestamp.Before(*from)) || (to != nil && !entry.Timestamp.Before(*to)) {
+			continue
+		}
+		result = append(result, entry)
+		if limit > 0 && len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeDeviceStore) AppendRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version devicestore.DeviceRenderedVersion) error {
+	if s.versions == nil {
+		s.versions = map[string][]devicestore.DeviceRenderedVersion{}
+	}
+	s.versions[name] = append(s.versions[name], version)
+	return nil
+}
+
+func (s *fakeDeviceStore) ListRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, limit int) ([]domain.DeviceRenderedVersion, error) {
+	var result []domain.DeviceRenderedVersion
+	for i := len(s.versions[name]) - 1; i >= 0; i-- {
+		v, err := fakeRenderedVersionToDomain(s.versions[name][i], false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *v)
+		if limit > 0 && len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeDeviceStore) GetRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error) {
+	for _, v := range s.versions[name] {
+		if v.Version == version {
+			return fakeRenderedVersionToDomain(v, true)
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *fakeDeviceStore) GetPrecedingRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error) {
+	for i := len(s.versions[name]) - 1; i >= 0; i-- {
+		if v := s.versions[name][i]; v.Version < version {
+			return fakeRenderedVersionToDomain(v, true)
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func fakeRenderedVersionToDomain(v devicestore.DeviceRenderedVersion, withSpec bool) (*domain.DeviceRenderedVersion, error) {
+	result := &domain.DeviceRenderedVersion{RenderedVersion: fmt.Sprintf("%d", v.Version)}
+	if v.TemplateVersion != "" {
+		result.TemplateVersion = lo.ToPtr(v.TemplateVersion)
+	}
+	if v.RollbackOf != nil {
+		result.RollbackOf = lo.ToPtr(fmt.Sprintf("%d", *v.RollbackOf))
+	}
+	if !withSpec {
+		return result, nil
+	}
+	spec := domain.DeviceSpec{}
+	if v.Rendered.Config != "" {
+		if err := json.Unmarshal([]byte(v.Rendered.Config), &spec.Config); err != nil {
+			return nil, err
+		}
+	}
+	if v.Rendered.Applications != "" {
+		if err := json.Unmarshal([]byte(v.Rendered.Applications), &spec.Applications); err != nil {
+			return nil, err
+		}
+	}
+	if v.Rendered.OsImage != "" {
+		spec.Os = &domain.DeviceOsSpec{Image: v.Rendered.OsImage}
+	}
+	result.Spec = &spec
+	return result, nil
+}
+
 func (s *fakeDeviceStore) SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner string) error {
 	return nil
 }
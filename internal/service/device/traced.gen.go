@@ -47,6 +47,14 @@ func endSpan(span trace.Span, st domain.Status) {
 	span.End()
 }
 
+func (_d *TracedDeviceService) ClearDeviceRollback(ctx context.Context, orgId uuid.UUID, name string) (dp1 *domain.Device, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ClearDeviceRollback")
+
+	dp1, s1 = _d.inner.ClearDeviceRollback(ctx, orgId, name)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
 func (_d *TracedDeviceService) CountDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (i1 int64, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "CountDevices")
 
@@ -87,6 +95,14 @@ func (_d *TracedDeviceService) DeleteDevice(ctx context.Context, orgId uuid.UUID
 	return s1
 }
 
+func (_d *TracedDeviceService) DeleteDeviceStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (i1 int64, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "DeleteDeviceStatusHistoryOlderThan")
+
+	i1, s1 = _d.inner.DeleteDeviceStatusHistoryOlderThan(ctx, cutoffTime)
+	endSpan(span, s1)
+	return i1, s1
+}
+
 func (_d *TracedDeviceService) ForceUpdateServerSideDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) (err error) {
 	ctx, span := startSpan(ctx, "ForceUpdateServerSideDeviceStatus")
 
@@ -123,6 +139,22 @@ func (_d *TracedDeviceService) GetDeviceLastSeen(ctx context.Context, orgId uuid
 	return dp1, s1
 }
 
+func (_d *TracedDeviceService) GetDeviceRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version string) (dp1 *domain.DeviceRenderedVersion, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetDeviceRenderedVersion")
+
+	dp1, s1 = _d.inner.GetDeviceRenderedVersion(ctx, orgId, name, version)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
+func (_d *TracedDeviceService) GetDeviceRenderedVersionDiff(ctx context.Context, orgId uuid.UUID, name string, version string, params domain.GetDeviceRenderedVersionDiffParams) (dp1 *domain.DeviceRenderedVersionDiff, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetDeviceRenderedVersionDiff")
+
+	dp1, s1 = _d.inner.GetDeviceRenderedVersionDiff(ctx, orgId, name, version, params)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
 func (_d *TracedDeviceService) GetDeviceRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (rp1 *domain.RepositoryList, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "GetDeviceRepositoryRefs")
 
@@ -139,6 +171,14 @@ func (_d *TracedDeviceService) GetDeviceStatus(ctx context.Context, orgId uuid.U
 	return dp1, s1
 }
 
+func (_d *TracedDeviceService) GetDeviceStatusHistory(ctx context.Context, orgId uuid.UUID, name string, params domain.GetDeviceStatusHistoryParams) (dp1 *domain.DeviceStatusHistoryList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetDeviceStatusHistory")
+
+	dp1, s1 = _d.inner.GetDeviceStatusHistory(ctx, orgId, name, params)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
 func (_d *TracedDeviceService) GetDevicesSummary(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (dp1 *domain.DevicesSummary, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "GetDevicesSummary")
 
@@ -163,6 +203,14 @@ func (_d *TracedDeviceService) ListConnectivityChangedDevices(ctx context.Contex
 	return dp1, s1
 }
 
+func (_d *TracedDeviceService) ListDeviceRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, params domain.ListDeviceRenderedVersionsParams) (dp1 *domain.DeviceRenderedVersionList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListDeviceRenderedVersions")
+
+	dp1, s1 = _d.inner.ListDeviceRenderedVersions(ctx, orgId, name, params)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
 func (_d *TracedDeviceService) ListDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (dp1 *domain.DeviceList, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "ListDevices")
 
@@ -259,6 +307,14 @@ func (_d *TracedDeviceService) ResumeDevices(ctx context.Context, orgId uuid.UUI
 	return d1, s1
 }
 
+func (_d *TracedDeviceService) RollbackDevice(ctx context.Context, orgId uuid.UUID, name string, rollback domain.DeviceRollback) (dp1 *domain.Device, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "RollbackDevice")
+
+	dp1, s1 = _d.inner.RollbackDevice(ctx, orgId, name, rollback)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
 func (_d *TracedDeviceService) SetDeviceOwner(ctx context.Context, orgId uuid.UUID, name string, expectedOwner *string, newOwner *string) (dp1 *domain.Device, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "SetDeviceOwner")
 
@@ -0,0 +1,135 @@
+package secret
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/service/events"
+	secretstore "github.com/flightctl/flightctl/internal/store/secret"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type ServiceHandler struct {
+	store  secretstore.Store
+	events events.Service
+	log    logrus.FieldLogger
+}
+
+// NewServiceHandler creates a new secret ServiceHandler instance.
+func NewServiceHandler(store secretstore.Store, events events.Service, log logrus.FieldLogger) *ServiceHandler {
+	return &ServiceHandler{store: store, events: events, log: log}
+}
+
+var _ Service = (*ServiceHandler)(nil)
+
+// SanitizeSecret clears managed metadata from an untrusted secret document (HTTP body).
+func SanitizeSecret(secret *domain.Secret) {
+	if secret == nil {
+		return
+	}
+	common.NilOutManagedObjectMetaProperties(&secret.Metadata)
+}
+
+// CreateSecretFromUntrusted sanitizes an untrusted secret document, then creates it.
+func CreateSecretFromUntrusted(ctx context.Context, svc Service, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	SanitizeSecret(&secret)
+	return svc.CreateSecret(ctx, orgId, secret)
+}
+
+// ReplaceSecretFromUntrusted sanitizes an untrusted secret document, then replaces it.
+func ReplaceSecretFromUntrusted(ctx context.Context, svc Service, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	SanitizeSecret(&secret)
+	return svc.ReplaceSecret(ctx, orgId, name, secret)
+}
+
+func (h *ServiceHandler) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	if errs := secret.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.Create(ctx, orgId, &secret, h.callbackSecretUpdated)
+	return result, common.StoreErrorToApiStatus(err, true, domain.SecretKind, secret.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	listParams, status := common.PrepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	result, err := h.store.Get(ctx, orgId, name)
+	return result, common.StoreErrorToApiStatus(err, false, domain.SecretKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	if secret.Metadata.Name == nil || name != *secret.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	// Restore values that were masked when the secret was read, before they are validated as
+	// base64. This lets clients round-trip a secret while changing only some of its keys.
+	existingSecret, err := h.store.Get(ctx, orgId, name)
+	if err == nil {
+		if preserveErr := secret.PreserveSensitiveData(existingSecret); preserveErr != nil {
+			return nil, domain.StatusInternalServerError(preserveErr.Error())
+		}
+	}
+
+	if errs := secret.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, created, err := h.store.CreateOrUpdate(ctx, orgId, &secret, h.callbackSecretUpdated)
+	return result, common.StoreErrorToApiStatus(err, created, domain.SecretKind, &name)
+}
+
+func (h *ServiceHandler) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.Delete(ctx, orgId, name, h.callbackSecretDeleted)
+	return common.StoreErrorToApiStatus(err, false, domain.SecretKind, &name)
+}
+
+// callbackSecretUpdated is the secret-specific callback that handles secret create and update events
+func (h *ServiceHandler) callbackSecretUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := common.StoreErrorToApiStatus(err, created, domain.SecretKind, &name)
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.SecretKind, name, status, nil))
+		return
+	}
+
+	oldSecret, newSecret, ok := common.CastResources[domain.Secret](oldResource, newResource)
+	if !ok {
+		return
+	}
+
+	if created {
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.SecretKind, name, nil, h.log, nil))
+	} else if oldSecret != nil && newSecret != nil {
+		updateDetails := common.ComputeResourceUpdatedDetails(oldSecret.Metadata, newSecret.Metadata)
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.SecretKind, name, updateDetails, h.log, nil))
+	}
+}
+
+// callbackSecretDeleted is the secret-specific callback that handles secret deletion events
+func (h *ServiceHandler) callbackSecretDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.events.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
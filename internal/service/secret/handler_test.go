@@ -0,0 +1,254 @@
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/events"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	statusSuccessCode    = int32(200)
+	statusCreatedCode    = int32(201)
+	statusBadRequestCode = int32(400)
+	statusNotFoundCode   = int32(404)
+)
+
+// fakeSecretStore is a small in-memory implementation of internal/store/secret.Store.
+type fakeSecretStore struct {
+	items map[string]*domain.Secret
+}
+
+func newFakeSecretStore() *fakeSecretStore {
+	return &fakeSecretStore{items: map[string]*domain.Secret{}}
+}
+
+func (f *fakeSecretStore) InitialMigration(_ context.Context) error { return nil }
+
+func (f *fakeSecretStore) Create(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback store.EventCallback) (*domain.Secret, error) {
+	name := lo.FromPtr(secret.Metadata.Name)
+	if _, exists := f.items[name]; exists {
+		return nil, flterrors.ErrDuplicateName
+	}
+	secret.Metadata.Generation = lo.ToPtr(int64(1))
+	f.items[name] = secret
+	if eventCallback != nil {
+		eventCallback(ctx, domain.SecretKind, orgId, name, nil, secret, true, nil)
+	}
+	return secret, nil
+}
+
+func (f *fakeSecretStore) Update(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback store.EventCallback) (*domain.Secret, error) {
+	name := lo.FromPtr(secret.Metadata.Name)
+	old, exists := f.items[name]
+	if !exists {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	secret.Metadata.Generation = lo.ToPtr(lo.FromPtr(old.Metadata.Generation) + 1)
+	f.items[name] = secret
+	if eventCallback != nil {
+		eventCallback(ctx, domain.SecretKind, orgId, name, old, secret, false, nil)
+	}
+	return secret, nil
+}
+
+func (f *fakeSecretStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, eventCallback store.EventCallback) (*domain.Secret, bool, error) {
+	name := lo.FromPtr(secret.Metadata.Name)
+	if _, exists := f.items[name]; exists {
+		result, err := f.Update(ctx, orgId, secret, eventCallback)
+		return result, false, err
+	}
+	result, err := f.Create(ctx, orgId, secret, eventCallback)
+	return result, true, err
+}
+
+func (f *fakeSecretStore) Get(_ context.Context, _ uuid.UUID, name string) (*domain.Secret, error) {
+	s, ok := f.items[name]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	// Return a copy so callers can't modify the stored data through the map.
+	copied := *s
+	copied.Spec.Data = make(map[string]string, len(s.Spec.Data))
+	for k, v := range s.Spec.Data {
+		copied.Spec.Data[k] = v
+	}
+	return &copied, nil
+}
+
+func (f *fakeSecretStore) List(_ context.Context, _ uuid.UUID, _ store.ListParams) (*domain.SecretList, error) {
+	var items []domain.Secret
+	for _, s := range f.items {
+		items = append(items, *s)
+	}
+	return &domain.SecretList{Items: items}, nil
+}
+
+func (f *fakeSecretStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error {
+	if _, exists := f.items[name]; !exists {
+		return nil
+	}
+	delete(f.items, name)
+	if eventCallback != nil {
+		eventCallback(ctx, domain.SecretKind, orgId, name, nil, nil, false, nil)
+	}
+	return nil
+}
+
+// fakeEventsService is a recording fake for events.Service.
+type fakeEventsService struct {
+	events.Service
+	created []*domain.Event
+	deleted []string
+}
+
+func (f *fakeEventsService) CreateEvent(_ context.Context, _ uuid.UUID, event *domain.Event) {
+	if event == nil {
+		return
+	}
+	f.created = append(f.created, event)
+}
+
+func (f *fakeEventsService) HandleGenericResourceDeletedEvents(_ context.Context, _ domain.ResourceKind, _ uuid.UUID, name string, _, _ interface{}, _ bool, _ error) {
+	f.deleted = append(f.deleted, name)
+}
+
+func newTestHandler() (*ServiceHandler, *fakeSecretStore, *fakeEventsService) {
+	secretStore := newFakeSecretStore()
+	ev := &fakeEventsService{}
+	return NewServiceHandler(secretStore, ev, logrus.New()), secretStore, ev
+}
+
+func encode(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func newSecret(name string, data map[string]string) domain.Secret {
+	return domain.Secret{
+		ApiVersion: "v1beta1",
+		Kind:       domain.SecretKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:       domain.SecretSpec{Data: data},
+	}
+}
+
+func TestCreateSecret(t *testing.T) {
+	t.Run("When the secret is valid it should create it and emit a created event", func(t *testing.T) {
+		h, secretStore, ev := newTestHandler()
+
+		resp, status := h.CreateSecret(context.Background(), uuid.New(), newSecret("db", map[string]string{"password": encode("s3cr3t")}))
+
+		require.Equal(t, statusCreatedCode, status.Code)
+		require.NotNil(t, resp)
+		_, err := secretStore.Get(context.Background(), uuid.Nil, "db")
+		require.NoError(t, err)
+		require.Len(t, ev.created, 1)
+		require.Equal(t, domain.EventReasonResourceCreated, ev.created[0].Reason)
+	})
+
+	t.Run("When a value is not base64 encoded it should return 400", func(t *testing.T) {
+		h, _, _ := newTestHandler()
+
+		_, status := h.CreateSecret(context.Background(), uuid.New(), newSecret("db", map[string]string{"password": "s3cr3t!"}))
+
+		require.Equal(t, statusBadRequestCode, status.Code)
+		require.NotContains(t, status.Message, "s3cr3t")
+	})
+
+	t.Run("When managed metadata fields are set by the caller CreateSecretFromUntrusted should clear them", func(t *testing.T) {
+		h, secretStore, _ := newTestHandler()
+		secret := newSecret("db", map[string]string{"password": encode("s3cr3t")})
+		secret.Metadata.Owner = lo.ToPtr("Fleet/other")
+
+		_, status := CreateSecretFromUntrusted(context.Background(), h, uuid.New(), secret)
+
+		require.Equal(t, statusCreatedCode, status.Code)
+		stored, err := secretStore.Get(context.Background(), uuid.Nil, "db")
+		require.NoError(t, err)
+		require.Nil(t, stored.Metadata.Owner)
+	})
+}
+
+func TestReplaceSecret(t *testing.T) {
+	t.Run("When the name does not match the path it should return 400", func(t *testing.T) {
+		h, _, _ := newTestHandler()
+
+		_, status := h.ReplaceSecret(context.Background(), uuid.New(), "other", newSecret("db", map[string]string{}))
+
+		require.Equal(t, statusBadRequestCode, status.Code)
+	})
+
+	t.Run("When masked values are written back it should keep the existing values", func(t *testing.T) {
+		h, secretStore, _ := newTestHandler()
+		orgId := uuid.New()
+		_, status := h.CreateSecret(context.Background(), orgId, newSecret("db", map[string]string{"password": encode("s3cr3t"), "username": encode("admin")}))
+		require.Equal(t, statusCreatedCode, status.Code)
+
+		_, status = h.ReplaceSecret(context.Background(), orgId, "db", newSecret("db", map[string]string{
+			"password": "*****",
+			"username": encode("root"),
+		}))
+
+		require.Equal(t, statusSuccessCode, status.Code)
+		stored, err := secretStore.Get(context.Background(), orgId, "db")
+		require.NoError(t, err)
+		require.Equal(t, encode("s3cr3t"), stored.Spec.Data["password"])
+		require.Equal(t, encode("root"), stored.Spec.Data["username"])
+	})
+
+	t.Run("When the data changes it should emit an updated event with the spec field", func(t *testing.T) {
+		h, _, ev := newTestHandler()
+		orgId := uuid.New()
+		_, status := h.CreateSecret(context.Background(), orgId, newSecret("db", map[string]string{"password": encode("s3cr3t")}))
+		require.Equal(t, statusCreatedCode, status.Code)
+
+		_, status = h.ReplaceSecret(context.Background(), orgId, "db", newSecret("db", map[string]string{"password": encode("rotated")}))
+
+		require.Equal(t, statusSuccessCode, status.Code)
+		require.Len(t, ev.created, 2)
+		require.Equal(t, domain.EventReasonResourceUpdated, ev.created[1].Reason)
+		details, err := ev.created[1].Details.AsResourceUpdatedDetails()
+		require.NoError(t, err)
+		require.Contains(t, details.UpdatedFields, domain.Spec)
+	})
+
+	t.Run("When the secret does not exist it should create it", func(t *testing.T) {
+		h, _, _ := newTestHandler()
+
+		_, status := h.ReplaceSecret(context.Background(), uuid.New(), "db", newSecret("db", map[string]string{"password": encode("s3cr3t")}))
+
+		require.Equal(t, statusCreatedCode, status.Code)
+	})
+}
+
+func TestGetAndDeleteSecret(t *testing.T) {
+	t.Run("When the secret does not exist it should return 404", func(t *testing.T) {
+		h, _, _ := newTestHandler()
+
+		_, status := h.GetSecret(context.Background(), uuid.New(), "missing")
+
+		require.Equal(t, statusNotFoundCode, status.Code)
+	})
+
+	t.Run("When the secret is deleted it should emit a deleted event", func(t *testing.T) {
+		h, secretStore, ev := newTestHandler()
+		orgId := uuid.New()
+		_, status := h.CreateSecret(context.Background(), orgId, newSecret("db", map[string]string{}))
+		require.Equal(t, statusCreatedCode, status.Code)
+
+		status = h.DeleteSecret(context.Background(), orgId, "db")
+
+		require.Equal(t, statusSuccessCode, status.Code)
+		_, err := secretStore.Get(context.Background(), orgId, "db")
+		require.ErrorIs(t, err, flterrors.ErrResourceNotFound)
+		require.Equal(t, []string{"db"}, ev.deleted)
+	})
+}
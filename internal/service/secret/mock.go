@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock.go -package=secret
+//
+
+// Package secret is a generated GoMock package.
+package secret
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/flightctl/flightctl/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSecret mocks base method.
+func (m *MockService) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", ctx, orgId, secret)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockServiceMockRecorder) CreateSecret(ctx, orgId, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockService)(nil).CreateSecret), ctx, orgId, secret)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockService) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockServiceMockRecorder) DeleteSecret(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockService)(nil).DeleteSecret), ctx, orgId, name)
+}
+
+// GetSecret mocks base method.
+func (m *MockService) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockServiceMockRecorder) GetSecret(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockService)(nil).GetSecret), ctx, orgId, name)
+}
+
+// ListSecrets mocks base method.
+func (m *MockService) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.SecretList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockServiceMockRecorder) ListSecrets(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockService)(nil).ListSecrets), ctx, orgId, params)
+}
+
+// ReplaceSecret mocks base method.
+func (m *MockService) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSecret", ctx, orgId, name, secret)
+	ret0, _ := ret[0].(*domain.Secret)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceSecret indicates an expected call of ReplaceSecret.
+func (mr *MockServiceMockRecorder) ReplaceSecret(ctx, orgId, name, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSecret", reflect.TypeOf((*MockService)(nil).ReplaceSecret), ctx, orgId, name, secret)
+}
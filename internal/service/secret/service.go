@@ -0,0 +1,16 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (*domain.Secret, domain.Status)
+	ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (*domain.SecretList, domain.Status)
+	GetSecret(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, domain.Status)
+	ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (*domain.Secret, domain.Status)
+	DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+}
@@ -0,0 +1,85 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/service-tracing
+// gowrap: http://github.com/hexdigest/gowrap
+
+package secret
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedService wraps a Service implementation with OpenTelemetry tracing.
+type TracedService struct {
+	inner Service
+}
+
+// WrapWithTracing returns a Service that wraps inner with tracing spans, or nil if inner is nil.
+func WrapWithTracing(inner Service) Service {
+	if inner == nil {
+		return nil
+	}
+	return &TracedService{inner: inner}
+}
+
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "flightctl/service/secret", method)
+}
+
+func endSpan(span trace.Span, st domain.Status) {
+	span.SetAttributes(attribute.Int("status.code", int(st.Code)))
+
+	if st.Status != "Success" {
+		span.RecordError(errors.New(st.Message))
+		span.SetStatus(codes.Error, st.Message)
+	}
+
+	span.End()
+}
+
+func (_d *TracedService) CreateSecret(ctx context.Context, orgId uuid.UUID, secret domain.Secret) (sp1 *domain.Secret, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "CreateSecret")
+
+	sp1, s1 = _d.inner.CreateSecret(ctx, orgId, secret)
+	endSpan(span, s1)
+	return sp1, s1
+}
+
+func (_d *TracedService) DeleteSecret(ctx context.Context, orgId uuid.UUID, name string) (s1 domain.Status) {
+	ctx, span := startSpan(ctx, "DeleteSecret")
+
+	s1 = _d.inner.DeleteSecret(ctx, orgId, name)
+	endSpan(span, s1)
+	return s1
+}
+
+func (_d *TracedService) GetSecret(ctx context.Context, orgId uuid.UUID, name string) (sp1 *domain.Secret, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetSecret")
+
+	sp1, s1 = _d.inner.GetSecret(ctx, orgId, name)
+	endSpan(span, s1)
+	return sp1, s1
+}
+
+func (_d *TracedService) ListSecrets(ctx context.Context, orgId uuid.UUID, params domain.ListSecretsParams) (sp1 *domain.SecretList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListSecrets")
+
+	sp1, s1 = _d.inner.ListSecrets(ctx, orgId, params)
+	endSpan(span, s1)
+	return sp1, s1
+}
+
+func (_d *TracedService) ReplaceSecret(ctx context.Context, orgId uuid.UUID, name string, secret domain.Secret) (sp1 *domain.Secret, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceSecret")
+
+	sp1, s1 = _d.inner.ReplaceSecret(ctx, orgId, name, secret)
+	endSpan(span, s1)
+	return sp1, s1
+}
@@ -0,0 +1,328 @@
+package vulnerabilityfinding
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// SanitizeVulnerabilityException clears managed metadata from an untrusted exception document.
+func SanitizeVulnerabilityException(exception *domain.VulnerabilityException) {
+	if exception == nil {
+		return
+	}
+	common.NilOutManagedObjectMetaProperties(&exception.Metadata)
+}
+
+func (h *ServiceHandler) CreateVulnerabilityException(ctx context.Context, orgId uuid.UUID, exception domain.VulnerabilityException) (*domain.VulnerabilityException, domain.Status) {
+	if !h.vulnerabilityEnabled {
+		return nil, h.featureDisabledStatus()
+	}
+	SanitizeVulnerabilityException(&exception)
+	if errs := exception.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.exceptionStore.Create(ctx, orgId, &exception, h.callbackVulnerabilityExceptionUpdated)
+	return result, common.StoreErrorToApiStatus(err, true, domain.VulnerabilityExceptionKind, exception.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, params domain.ListVulnerabilityExceptionsParams) (*domain.VulnerabilityExceptionList, domain.Status) {
+	if !h.vulnerabilityEnabled {
+		return nil, h.featureDisabledStatus()
+	}
+	listParams, status := common.PrepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.exceptionStore.List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) (*domain.VulnerabilityException, domain.Status) {
+	if !h.vulnerabilityEnabled {
+		return nil, h.featureDisabledStatus()
+	}
+	result, err := h.exceptionStore.Get(ctx, orgId, name)
+	return result, common.StoreErrorToApiStatus(err, false, domain.VulnerabilityExceptionKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string, exception domain.VulnerabilityException) (*domain.VulnerabilityException, domain.Status) {
+	if !h.vulnerabilityEnabled {
+		return nil, h.featureDisabledStatus()
+	}
+	SanitizeVulnerabilityException(&exception)
+	if errs := exception.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *exception.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.exceptionStore.CreateOrUpdate(ctx, orgId, &exception, h.callbackVulnerabilityExceptionUpdated)
+	return result, common.StoreErrorToApiStatus(err, created, domain.VulnerabilityExceptionKind, &name)
+}
+
+func (h *ServiceHandler) DeleteVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if !h.vulnerabilityEnabled {
+		return h.featureDisabledStatus()
+	}
+	err := h.exceptionStore.Delete(ctx, orgId, name, h.callbackVulnerabilityExceptionDeleted)
+	return common.StoreErrorToApiStatus(err, false, domain.VulnerabilityExceptionKind, &name)
+}
+
+// callbackVulnerabilityExceptionUpdated emits the standard created/updated events for exceptions.
+func (h *ServiceHandler) callbackVulnerabilityExceptionUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := common.StoreErrorToApiStatus(err, created, domain.VulnerabilityExceptionKind, &name)
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, domain.VulnerabilityExceptionKind, name, status, nil))
+		return
+	}
+	if created {
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, domain.VulnerabilityExceptionKind, name, nil, h.log, nil))
+		return
+	}
+
+	oldException, newException, ok := common.CastResources[domain.VulnerabilityException](oldResource, newResource)
+	if !ok || oldException == nil || newException == nil {
+		return
+	}
+	if updateDetails := common.ComputeResourceUpdatedDetails(oldException.Metadata, newException.Metadata); updateDetails != nil {
+		h.events.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, false, domain.VulnerabilityExceptionKind, name, updateDetails, h.log, nil))
+	}
+}
+
+func (h *ServiceHandler) callbackVulnerabilityExceptionDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.events.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// exceptionIndex maps findings to the name of the active VulnerabilityException that excepts them.
+type exceptionIndex struct {
+	byDigest map[string]map[string]string // image digest -> CVE ID -> exception name
+	byOwner  map[string]map[string]string // device owner -> CVE ID -> exception name
+}
+
+func newExceptionIndex() *exceptionIndex {
+	return &exceptionIndex{
+		byDigest: map[string]map[string]string{},
+		byOwner:  map[string]map[string]string{},
+	}
+}
+
+func addException(m map[string]map[string]string, key, cveID, name string) {
+	if m[key] == nil {
+		m[key] = map[string]string{}
+	}
+	// Keep the first (name-ordered) exception when several cover the same finding.
+	if _, exists := m[key][cveID]; !exists {
+		m[key][cveID] = name
+	}
+}
+
+// lookup returns the exception covering the (digest, owner, CVE) finding, or nil.
+func (x *exceptionIndex) lookup(imageDigest string, owner *string, cveID string) *string {
+	if x == nil {
+		return nil
+	}
+	if name, ok := x.byDigest[imageDigest][cveID]; ok {
+		return &name
+	}
+	if owner != nil {
+		if name, ok := x.byOwner[*owner][cveID]; ok {
+			return &name
+		}
+	}
+	return nil
+}
+
+// exclusions converts the index to the store filter. It returns nil when nothing is excepted.
+func (x *exceptionIndex) exclusions() *vulnerabilityfindingstore.FindingExclusions {
+	if x == nil || (len(x.byDigest) == 0 && len(x.byOwner) == 0) {
+		return nil
+	}
+	flatten := func(m map[string]map[string]string) map[string][]string {
+		out := make(map[string][]string, len(m))
+		for key, cves := range m {
+			ids := lo.Keys(cves)
+			sort.Strings(ids)
+			out[key] = ids
+		}
+		return out
+	}
+	return &vulnerabilityfindingstore.FindingExclusions{
+		ByDigest: flatten(x.byDigest),
+		ByOwner:  flatten(x.byOwner),
+	}
+}
+
+// exceptionContext describes the findings a query covers, so that fleet and catalog item scoped
+// exceptions can be resolved to image digests.
+type exceptionContext struct {
+	// digests are the image digests in scope, with the image references devices report for them.
+	digests []vulnerabilityfindingstore.DeviceImageDigest
+	// loadDigests, when set, loads digests lazily, only once there are exceptions to resolve.
+	loadDigests func(ctx context.Context) ([]vulnerabilityfindingstore.DeviceImageDigest, error)
+	// fleet is the fleet the query is scoped to (fleet queries, or device queries for a fleet
+	// member). Empty for organization-wide queries and fleetless devices.
+	fleet string
+	// orgWide marks organization-wide queries. A fleet exception then only excepts a digest when
+	// every device running it belongs to an excepted fleet.
+	orgWide bool
+}
+
+// orgExceptionContext returns the organization-wide context. digests may be nil, in which case
+// the organization's digests are loaded on demand.
+func (h *ServiceHandler) orgExceptionContext(orgId uuid.UUID, digests []vulnerabilityfindingstore.DeviceImageDigest) exceptionContext {
+	qc := exceptionContext{digests: digests, orgWide: true}
+	if digests == nil {
+		qc.loadDigests = func(ctx context.Context) ([]vulnerabilityfindingstore.DeviceImageDigest, error) {
+			return h.store.ListOrgDeviceImageDigests(ctx, orgId)
+		}
+	}
+	return qc
+}
+
+// activeVulnerabilityExceptions returns the organization's exceptions that have not expired.
+func (h *ServiceHandler) activeVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID) ([]domain.VulnerabilityException, error) {
+	list, err := h.exceptionStore.List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return lo.Filter(list.Items, func(e domain.VulnerabilityException, _ int) bool {
+		return e.Spec.ExpiresAt == nil || e.Spec.ExpiresAt.After(now)
+	}), nil
+}
+
+// resolveExceptions builds the exception index for a query context.
+func (h *ServiceHandler) resolveExceptions(ctx context.Context, orgId uuid.UUID, qc exceptionContext) (*exceptionIndex, error) {
+	exceptions, err := h.activeVulnerabilityExceptions(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+	index := newExceptionIndex()
+	if len(exceptions) == 0 {
+		return index, nil
+	}
+	if qc.loadDigests != nil {
+		if qc.digests, err = qc.loadDigests(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	inScope := make(map[string]vulnerabilityfindingstore.DeviceImageDigest, len(qc.digests))
+	for _, d := range qc.digests {
+		inScope[d.ImageDigest] = d
+	}
+
+	// fleetCoverage counts, per excepted CVE and digest, the devices in excepted fleets. Each
+	// fleet is counted once per CVE, however many exceptions cover it.
+	fleetCoverage := map[string]map[string]int64{}
+	coveredFleets := map[string]map[string]struct{}{}
+	fleetDigests := map[string][]vulnerabilityfindingstore.DeviceImageDigest{}
+
+	for _, e := range exceptions {
+		name := lo.FromPtr(e.Metadata.Name)
+		cveID := e.Spec.CveId
+		scope := e.Spec.Scope
+		switch {
+		case scope.ImageDigest != nil:
+			addException(index.byDigest, *scope.ImageDigest, cveID, name)
+
+		case scope.CatalogItem != nil:
+			item, err := h.catalogStore.GetItem(ctx, orgId, scope.CatalogItem.Catalog, scope.CatalogItem.Item)
+			if err != nil {
+				if errors.Is(err, flterrors.ErrResourceNotFound) {
+					continue
+				}
+				return nil, err
+			}
+			for _, d := range qc.digests {
+				if catalogItemCoversDigest(item, d) {
+					addException(index.byDigest, d.ImageDigest, cveID, name)
+				}
+			}
+
+		case scope.Fleet != nil:
+			owner := domain.FleetKind + "/" + *scope.Fleet
+			addException(index.byOwner, owner, cveID, name)
+			if !qc.orgWide {
+				if qc.fleet == *scope.Fleet {
+					for _, d := range qc.digests {
+						addException(index.byDigest, d.ImageDigest, cveID, name)
+					}
+				}
+				continue
+			}
+			digests, ok := fleetDigests[*scope.Fleet]
+			if !ok {
+				if digests, err = h.store.ListFleetDeviceImageDigests(ctx, orgId, *scope.Fleet); err != nil {
+					return nil, err
+				}
+				fleetDigests[*scope.Fleet] = digests
+			}
+			if fleetCoverage[cveID] == nil {
+				fleetCoverage[cveID] = map[string]int64{}
+				coveredFleets[cveID] = map[string]struct{}{}
+			}
+			if _, counted := coveredFleets[cveID][*scope.Fleet]; !counted {
+				coveredFleets[cveID][*scope.Fleet] = struct{}{}
+				for _, d := range digests {
+					fleetCoverage[cveID][d.ImageDigest] += d.DeviceCount
+				}
+			}
+			for _, d := range digests {
+				if total, ok := inScope[d.ImageDigest]; ok && fleetCoverage[cveID][d.ImageDigest] >= total.DeviceCount {
+					addException(index.byDigest, d.ImageDigest, cveID, name)
+				}
+			}
+		}
+	}
+	return index, nil
+}
+
+// catalogItemCoversDigest reports whether any of the item's artifacts resolves to the digest, either
+// through an image reference devices report for it or through a version reference pinned by digest.
+func catalogItemCoversDigest(item *domain.CatalogItem, d vulnerabilityfindingstore.DeviceImageDigest) bool {
+	for _, version := range item.Spec.Versions {
+		for _, ref := range version.References {
+			if ref == d.ImageDigest {
+				return true
+			}
+		}
+	}
+	for _, artifact := range item.Spec.Artifacts {
+		uri := strings.TrimSuffix(artifact.Uri, "/")
+		if uri == "" {
+			continue
+		}
+		for _, ref := range d.ImageRefs {
+			if ref == uri || strings.HasPrefix(ref, uri+":") || strings.HasPrefix(ref, uri+"@") {
+				return true
+			}
+		}
+	}
+	return false
+}
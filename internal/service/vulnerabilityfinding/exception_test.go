@@ -0,0 +1,269 @@
+package vulnerabilityfinding
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	exceptionDigest1 = "sha256:" + strings.Repeat("a", 64)
+	exceptionDigest2 = "sha256:" + strings.Repeat("b", 64)
+)
+
+func newException(name, cveID string, scope domain.VulnerabilityExceptionScope) domain.VulnerabilityException {
+	return domain.VulnerabilityException{
+		ApiVersion: "flightctl.io/v1alpha1",
+		Kind:       domain.VulnerabilityExceptionKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.VulnerabilityExceptionSpec{
+			CveId:         cveID,
+			Scope:         scope,
+			State:         domain.VulnerabilityExceptionStateRiskAccepted,
+			Justification: "fix scheduled for the next release",
+		},
+	}
+}
+
+func seedException(h *ServiceHandler, e domain.VulnerabilityException) {
+	h.exceptionStore.(*fakeVulnerabilityExceptionStore).exceptions[*e.Metadata.Name] = e
+}
+
+func TestVulnerabilityExceptionCRUD(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	disabled, _, _, _, _ := newTestHandler(false)
+	_, status := disabled.CreateVulnerabilityException(ctx, orgId, newException("e1", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest1}))
+	require.Equal(int32(http.StatusNotImplemented), status.Code)
+
+	h, _, _, _, _ := newTestHandler(true)
+
+	invalid := newException("e1", "CVE-2024-0001", domain.VulnerabilityExceptionScope{})
+	_, status = h.CreateVulnerabilityException(ctx, orgId, invalid)
+	require.Equal(int32(http.StatusBadRequest), status.Code)
+
+	created, status := h.CreateVulnerabilityException(ctx, orgId, newException("e1", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest1}))
+	require.Equal(int32(http.StatusCreated), status.Code)
+	require.Equal("CVE-2024-0001", created.Spec.CveId)
+
+	_, status = h.ReplaceVulnerabilityException(ctx, orgId, "other", newException("e1", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest1}))
+	require.Equal(int32(http.StatusBadRequest), status.Code)
+
+	list, status := h.ListVulnerabilityExceptions(ctx, orgId, domain.ListVulnerabilityExceptionsParams{})
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Len(list.Items, 1)
+
+	status = h.DeleteVulnerabilityException(ctx, orgId, "e1")
+	require.Equal(int32(http.StatusOK), status.Code)
+	_, status = h.GetVulnerabilityException(ctx, orgId, "e1")
+	require.Equal(int32(http.StatusNotFound), status.Code)
+}
+
+func TestGetDeviceVulnerabilitiesWithExceptions(t *testing.T) {
+	imageRef := "quay.io/example/os:1.0"
+
+	cases := []struct {
+		name            string
+		exception       domain.VulnerabilityException
+		deviceOwner     *string
+		catalogItem     *domain.CatalogItem
+		includeExcepted bool
+		expectedCVEs    []string
+		expectedMarked  string
+	}{
+		{
+			name:         "When an image digest exception matches it should hide the finding",
+			exception:    newException("by-digest", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest1}),
+			expectedCVEs: []string{"CVE-2024-0002"},
+		},
+		{
+			name:         "When the exception targets another digest it should keep the finding",
+			exception:    newException("other-digest", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest2}),
+			expectedCVEs: []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+		{
+			name:            "When excepted findings are included it should annotate them",
+			exception:       newException("by-digest", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest1}),
+			includeExcepted: true,
+			expectedCVEs:    []string{"CVE-2024-0001", "CVE-2024-0002"},
+			expectedMarked:  "by-digest",
+		},
+		{
+			name: "When the exception has expired it should keep the finding",
+			exception: func() domain.VulnerabilityException {
+				e := newException("expired", "CVE-2024-0001", domain.VulnerabilityExceptionScope{ImageDigest: &exceptionDigest1})
+				e.Spec.ExpiresAt = lo.ToPtr(time.Now().Add(-time.Hour))
+				return e
+			}(),
+			expectedCVEs: []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+		{
+			name:         "When a fleet exception matches the device's fleet it should hide the finding",
+			exception:    newException("by-fleet", "CVE-2024-0001", domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}),
+			deviceOwner:  lo.ToPtr("Fleet/edge"),
+			expectedCVEs: []string{"CVE-2024-0002"},
+		},
+		{
+			name:         "When a fleet exception targets another fleet it should keep the finding",
+			exception:    newException("by-fleet", "CVE-2024-0001", domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("core")}),
+			deviceOwner:  lo.ToPtr("Fleet/edge"),
+			expectedCVEs: []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+		{
+			name: "When a catalog item artifact matches the device image it should hide the finding",
+			exception: newException("by-item", "CVE-2024-0001", domain.VulnerabilityExceptionScope{
+				CatalogItem: &domain.VulnerabilityExceptionCatalogItemRef{Catalog: "os", Item: "example"},
+			}),
+			catalogItem: &domain.CatalogItem{Spec: domain.CatalogItemSpec{
+				Artifacts: []domain.CatalogItemArtifact{{Uri: "quay.io/example/os"}},
+			}},
+			expectedCVEs: []string{"CVE-2024-0002"},
+		},
+		{
+			name: "When a catalog item artifact does not match the device image it should keep the finding",
+			exception: newException("by-item", "CVE-2024-0001", domain.VulnerabilityExceptionScope{
+				CatalogItem: &domain.VulnerabilityExceptionCatalogItemRef{Catalog: "os", Item: "example"},
+			}),
+			catalogItem: &domain.CatalogItem{Spec: domain.CatalogItemSpec{
+				Artifacts: []domain.CatalogItemArtifact{{Uri: "quay.io/example/os-extra"}},
+			}},
+			expectedCVEs: []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, vf, devStore, _, _ := newTestHandler(true)
+			orgId := uuid.New()
+			seedDevice(devStore, "dev1", exceptionDigest1, imageRef)
+			devStore.devices["dev1"].Metadata.Owner = tc.deviceOwner
+			vf.findings = []model.VulnerabilityFinding{
+				{ImageDigest: exceptionDigest1, CveID: "CVE-2024-0001", Severity: "Critical", Status: "affected", FirstSeenAt: time.Now()},
+				{ImageDigest: exceptionDigest1, CveID: "CVE-2024-0002", Severity: "Low", Status: "affected", FirstSeenAt: time.Now()},
+			}
+			seedException(h, tc.exception)
+			if tc.catalogItem != nil {
+				h.catalogStore.(*fakeCatalogStore).items["os/example"] = tc.catalogItem
+			}
+
+			resp, status := h.GetDeviceVulnerabilities(context.Background(), orgId, "dev1",
+				domain.GetDeviceVulnerabilitiesParams{IncludeExcepted: lo.ToPtr(tc.includeExcepted)})
+			require.Equal(t, int32(http.StatusOK), status.Code)
+			cves := lo.Map(resp.Items, func(v domain.Vulnerability, _ int) string { return v.CveId })
+			require.Equal(t, tc.expectedCVEs, cves)
+			if tc.expectedMarked != "" {
+				require.Equal(t, tc.expectedMarked, lo.FromPtr(resp.Items[0].Exception))
+				require.Nil(t, resp.Items[1].Exception)
+			}
+
+			summary, status := h.GetDeviceVulnerabilitySummary(context.Background(), orgId, "dev1", domain.GetDeviceVulnerabilitySummaryParams{})
+			require.Equal(t, int32(http.StatusOK), status.Code)
+			if tc.includeExcepted {
+				// Summaries always leave excepted findings out.
+				require.Equal(t, int64(1), summary.Summary.Total)
+			} else {
+				require.Equal(t, int64(len(tc.expectedCVEs)), summary.Summary.Total)
+			}
+		})
+	}
+}
+
+func TestListVulnerabilitiesWithFleetExceptions(t *testing.T) {
+	cases := []struct {
+		name                string
+		edgeDevices         int64
+		duplicateExceptions bool
+		expectedCVEs        []string
+	}{
+		{
+			name:         "When every device running the digest is in the excepted fleet it should hide the finding",
+			edgeDevices:  3,
+			expectedCVEs: []string{"CVE-2024-0002"},
+		},
+		{
+			name:         "When other devices run the digest it should keep the finding",
+			edgeDevices:  2,
+			expectedCVEs: []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+		{
+			name:                "When two exceptions cover the same fleet and another fleet runs the digest it should keep the finding",
+			edgeDevices:         2,
+			duplicateExceptions: true,
+			expectedCVEs:        []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+		{
+			name:                "When two exceptions cover the same fleet running every device it should hide the finding",
+			edgeDevices:         3,
+			duplicateExceptions: true,
+			expectedCVEs:        []string{"CVE-2024-0002"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, vf, _, _, _ := newTestHandler(true)
+			orgId := uuid.New()
+			vf.orgDigests[orgId] = []vulnerabilityfindingstore.DeviceImageDigest{
+				{ImageDigest: exceptionDigest1, DeviceCount: 3},
+			}
+			vf.fleetDigests[fleetDigestKey(orgId, "edge")] = []vulnerabilityfindingstore.DeviceImageDigest{
+				{ImageDigest: exceptionDigest1, DeviceCount: tc.edgeDevices},
+			}
+			vf.findings = []model.VulnerabilityFinding{
+				{ImageDigest: exceptionDigest1, CveID: "CVE-2024-0001", Severity: "Critical", Status: "affected", FirstSeenAt: time.Now()},
+				{ImageDigest: exceptionDigest1, CveID: "CVE-2024-0002", Severity: "Low", Status: "affected", FirstSeenAt: time.Now()},
+			}
+			seedException(h, newException("by-fleet", "CVE-2024-0001", domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}))
+			if tc.duplicateExceptions {
+				seedException(h, newException("openvex-edge", "CVE-2024-0001", domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}))
+			}
+
+			resp, status := h.ListVulnerabilities(context.Background(), orgId, domain.ListVulnerabilitiesParams{})
+			require.Equal(t, int32(http.StatusOK), status.Code)
+			cves := lo.Map(resp.Items, func(g domain.VulnerabilityGroup, _ int) string { return g.CveId })
+			require.Equal(t, tc.expectedCVEs, cves)
+
+			// The org summary joins devices, so the fleet exception is always passed by owner.
+			_, status = h.GetVulnerabilitySummary(context.Background(), orgId)
+			require.Equal(t, int32(http.StatusOK), status.Code)
+			require.NotNil(t, vf.lastExclusions)
+			require.Equal(t, []string{"CVE-2024-0001"}, vf.lastExclusions.ByOwner["Fleet/edge"])
+		})
+	}
+}
+
+func TestGetVulnerabilityImpactWithExceptions(t *testing.T) {
+	require := require.New(t)
+	h, vf, _, _, _ := newTestHandler(true)
+	orgId := uuid.New()
+	owner := "Fleet/edge"
+	vf.impactPage = &vulnerabilityfindingstore.ImpactPageResult{
+		Rows:        []vulnerabilityfindingstore.ImpactPageRow{{Owner: &owner, DeviceAffected: 1}},
+		TotalFleets: 1,
+	}
+	vf.impactDetails = []vulnerabilityfindingstore.ImpactDigestDetail{
+		{Owner: &owner, ImageDigest: exceptionDigest1, DeviceCount: 1, Severity: "High"},
+	}
+	seedException(h, newException("by-fleet", "CVE-2024-0001", domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}))
+
+	_, status := h.GetVulnerabilityImpact(context.Background(), orgId, "CVE-2024-0001", domain.GetVulnerabilityImpactParams{})
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.NotNil(vf.lastExclusions)
+
+	resp, status := h.GetVulnerabilityImpact(context.Background(), orgId, "CVE-2024-0001", domain.GetVulnerabilityImpactParams{IncludeExcepted: lo.ToPtr(true)})
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Nil(vf.lastExclusions)
+	require.Len(resp.Items, 1)
+	require.Equal("by-fleet", lo.FromPtr(resp.Items[0].Findings[0].Exception))
+}
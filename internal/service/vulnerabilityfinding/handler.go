@@ -11,10 +11,12 @@ import (
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
+	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
+	vulnerabilityexceptionstore "github.com/flightctl/flightctl/internal/store/vulnerabilityexception"
 	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -23,8 +25,10 @@ import (
 
 type ServiceHandler struct {
 	store                vulnerabilityfindingstore.Store
+	exceptionStore       vulnerabilityexceptionstore.Store
 	deviceStore          devicestore.Store
 	fleetStore           fleetstore.Store
+	catalogStore         catalogstore.Store
 	events               events.Service
 	vulnerabilityEnabled bool
 	log                  logrus.FieldLogger
@@ -33,16 +37,20 @@ type ServiceHandler struct {
 // NewServiceHandler creates a new vulnerabilityfinding ServiceHandler instance.
 func NewServiceHandler(
 	store vulnerabilityfindingstore.Store,
+	exceptionStore vulnerabilityexceptionstore.Store,
 	deviceStore devicestore.Store,
 	fleetStore fleetstore.Store,
+	catalogStore catalogstore.Store,
 	events events.Service,
 	vulnerabilityEnabled bool,
 	log logrus.FieldLogger,
 ) *ServiceHandler {
 	return &ServiceHandler{
 		store:                store,
+		exceptionStore:       exceptionStore,
 		deviceStore:          deviceStore,
 		fleetStore:           fleetStore,
+		catalogStore:         catalogStore,
 		events:               events,
 		vulnerabilityEnabled: vulnerabilityEnabled,
 		log:                  log,
@@ -98,7 +106,13 @@ func (h *ServiceHandler) GetVulnerabilitySummary(
 		return nil, h.featureDisabledStatus()
 	}
 
-	sum, err := h.store.GetOrgVulnerabilitySummary(ctx, orgId)
+	index, err := h.resolveExceptions(ctx, orgId, h.orgExceptionContext(orgId, nil))
+	if err != nil {
+		h.log.WithError(err).Error("failed to resolve vulnerability exceptions")
+		return nil, domain.StatusInternalServerError("failed to resolve vulnerability exceptions")
+	}
+
+	sum, err := h.store.GetOrgVulnerabilitySummary(ctx, orgId, index.exclusions())
 	if err != nil {
 		h.log.WithError(err).Error("failed to load org vulnerability summary")
 		return nil, domain.StatusInternalServerError("failed to load org vulnerability summary")
@@ -195,7 +209,15 @@ func (h *ServiceHandler) GetVulnerabilityImpact(
 		return nil, status
 	}
 
-	pageResult, err := h.store.GetVulnerabilityImpactPage(ctx, orgId, cveId, listParams)
+	index, err := h.resolveExceptions(ctx, orgId, h.orgExceptionContext(orgId, nil))
+	if err != nil {
+		h.log.WithError(err).Error("failed to resolve vulnerability exceptions")
+		return nil, domain.StatusInternalServerError("failed to resolve vulnerability exceptions")
+	}
+	includeExcepted := lo.FromPtr(params.IncludeExcepted)
+	exclusions := lo.Ternary(includeExcepted, nil, index.exclusions())
+
+	pageResult, err := h.store.GetVulnerabilityImpactPage(ctx, orgId, cveId, listParams, exclusions)
 	if err != nil {
 		var se *selector.SelectorError
 		switch {
@@ -211,14 +233,18 @@ func (h *ServiceHandler) GetVulnerabilityImpact(
 	if len(pageResult.Rows) > 0 {
 		owners, hasFleetless := extractOwnersFromPage(pageResult.Rows)
 		var derr error
-		details, derr = h.store.GetImpactDigestDetails(ctx, orgId, cveId, owners, hasFleetless)
+		details, derr = h.store.GetImpactDigestDetails(ctx, orgId, cveId, owners, hasFleetless, exclusions)
 		if derr != nil {
 			h.log.WithError(derr).Error("failed to load digest details for impact")
 			return nil, domain.StatusInternalServerError("failed to load digest details for impact")
 		}
 	}
 
-	fleets := buildAffectedFleetsFromPage(pageResult.Rows, details, cveId)
+	var annotate *exceptionIndex
+	if includeExcepted {
+		annotate = index
+	}
+	fleets := buildAffectedFleetsFromPage(pageResult.Rows, details, cveId, annotate)
 
 	sev := domain.VulnerabilityImpactSeverity(model.VulnerabilitySeverityUnknown)
 	if pageResult.WorstSeverity != "" {
@@ -293,7 +319,9 @@ func groupDetailsByOwner(details []vulnerabilityfindingstore.ImpactDigestDetail)
 	return m
 }
 
-func buildAffectedFleetsFromPage(rows []vulnerabilityfindingstore.ImpactPageRow, details []vulnerabilityfindingstore.ImpactDigestDetail, cveID string) []domain.AffectedFleet {
+// buildAffectedFleetsFromPage assembles the impact items. When exceptions is non-nil, findings
+// covered by an exception are annotated with its name.
+func buildAffectedFleetsFromPage(rows []vulnerabilityfindingstore.ImpactPageRow, details []vulnerabilityfindingstore.ImpactDigestDetail, cveID string, exceptions *exceptionIndex) []domain.AffectedFleet {
 	detailsByOwner := groupDetailsByOwner(details)
 	out := make([]domain.AffectedFleet, 0, len(rows))
 	for i := range rows {
@@ -323,6 +351,7 @@ func buildAffectedFleetsFromPage(rows []vulnerabilityfindingstore.ImpactPageRow,
 				Description:     d.Description,
 				PublishedAt:     d.PublishedAt,
 				FirstSeenAt:     d.FirstSeenAt,
+				Exception:       exceptions.lookup(d.ImageDigest, d.Owner, cveID),
 			}
 			if d.CvssScore != nil {
 				v := float32(*d.CvssScore)
@@ -380,9 +409,15 @@ func (h *ServiceHandler) GetDeviceVulnerabilities(
 		return nil, status
 	}
 
+	index, status := h.resolveDeviceExceptions(ctx, orgId, device)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	includeExcepted := lo.FromPtr(params.IncludeExcepted)
+
 	var result *vulnerabilityfindingstore.VulnerabilityListResult
 	if imageDigest != "" {
-		result, err = h.store.GetVulnerabilities(ctx, imageDigest, listParams)
+		result, err = h.store.GetVulnerabilities(ctx, imageDigest, listParams, lo.Ternary(includeExcepted, nil, index.exclusions()))
 		if err != nil {
 			h.log.WithError(err).Error("failed to query vulnerability findings")
 			return nil, common.StoreErrorToApiStatus(err, false, domain.VulnerabilityKind, nil)
@@ -395,11 +430,35 @@ func (h *ServiceHandler) GetDeviceVulnerabilities(
 	items := make([]domain.Vulnerability, len(result.Items))
 	for i, f := range result.Items {
 		items[i] = findingToVulnerability(f, imageRef)
+		if includeExcepted {
+			items[i].Exception = index.lookup(f.ImageDigest, device.Metadata.Owner, f.CveID)
+		}
 	}
 
 	return buildVulnerabilityList(items, result.Continue), domain.StatusOK()
 }
 
+// resolveDeviceExceptions resolves the exceptions that apply to the image a device runs.
+func (h *ServiceHandler) resolveDeviceExceptions(ctx context.Context, orgId uuid.UUID, device *domain.Device) (*exceptionIndex, domain.Status) {
+	qc := exceptionContext{}
+	if device.Status != nil && device.Status.Os.ImageDigest != "" {
+		d := vulnerabilityfindingstore.DeviceImageDigest{ImageDigest: device.Status.Os.ImageDigest, DeviceCount: 1}
+		if device.Status.Os.Image != "" {
+			d.ImageRefs = []string{device.Status.Os.Image}
+		}
+		qc.digests = []vulnerabilityfindingstore.DeviceImageDigest{d}
+	}
+	if fleetName, fleetless := extractFleetInfo(device.Metadata.Owner); !fleetless {
+		qc.fleet = fleetName
+	}
+	index, err := h.resolveExceptions(ctx, orgId, qc)
+	if err != nil {
+		h.log.WithError(err).Error("failed to resolve vulnerability exceptions")
+		return nil, domain.StatusInternalServerError("failed to resolve vulnerability exceptions")
+	}
+	return index, domain.StatusOK()
+}
+
 func (h *ServiceHandler) GetDeviceVulnerabilitySummary(
 	ctx context.Context,
 	orgId uuid.UUID,
@@ -429,9 +488,14 @@ func (h *ServiceHandler) GetDeviceVulnerabilitySummary(
 		}
 	}
 
+	index, status := h.resolveDeviceExceptions(ctx, orgId, device)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+
 	var counts vulnerabilityfindingstore.SeverityCounts
 	if imageDigest != "" {
-		counts, err = h.store.GetVulnerabilitySummary(ctx, imageDigest, fs)
+		counts, err = h.store.GetVulnerabilitySummary(ctx, imageDigest, fs, index.exclusions())
 		if err != nil {
 			h.log.WithError(err).Error("failed to compute device vulnerability summary")
 			return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceVulnerabilitySummaryKind, nil)
@@ -486,9 +550,16 @@ func (h *ServiceHandler) ListVulnerabilities(
 		return nil, status
 	}
 
+	index, err := h.resolveExceptions(ctx, orgId, h.orgExceptionContext(orgId, digests))
+	if err != nil {
+		h.log.WithError(err).Error("failed to resolve vulnerability exceptions")
+		return nil, domain.StatusInternalServerError("failed to resolve vulnerability exceptions")
+	}
+	includeExcepted := lo.FromPtr(params.IncludeExcepted)
+
 	var result *vulnerabilityfindingstore.VulnerabilityGroupResult
 	if len(digests) > 0 {
-		result, err = h.store.GetVulnerabilityGroups(ctx, digests, listParams)
+		result, err = h.store.GetVulnerabilityGroups(ctx, digests, listParams, lo.Ternary(includeExcepted, nil, index.exclusions()))
 		if err != nil {
 			h.log.WithError(err).Error("failed to query org vulnerability findings")
 			return nil, common.StoreErrorToApiStatus(err, false, domain.VulnerabilityGroupKind, nil)
@@ -505,7 +576,7 @@ func (h *ServiceHandler) ListVulnerabilities(
 
 	items := make([]domain.VulnerabilityGroup, len(result.Groups))
 	for i, g := range result.Groups {
-		items[i] = vulnerabilityGroupFromStore(g, digestInfoMap)
+		items[i] = vulnerabilityGroupFromStore(g, digestInfoMap, lo.Ternary(includeExcepted, index, nil))
 	}
 
 	return buildVulnerabilityGroupList(items, result.Continue), domain.StatusOK()
@@ -537,9 +608,16 @@ func (h *ServiceHandler) GetFleetVulnerabilities(
 		return nil, status
 	}
 
+	index, err := h.resolveExceptions(ctx, orgId, exceptionContext{digests: digests, fleet: name})
+	if err != nil {
+		h.log.WithError(err).Error("failed to resolve vulnerability exceptions")
+		return nil, domain.StatusInternalServerError("failed to resolve vulnerability exceptions")
+	}
+	includeExcepted := lo.FromPtr(params.IncludeExcepted)
+
 	var result *vulnerabilityfindingstore.VulnerabilityGroupResult
 	if len(digests) > 0 {
-		result, err = h.store.GetVulnerabilityGroups(ctx, digests, listParams)
+		result, err = h.store.GetVulnerabilityGroups(ctx, digests, listParams, lo.Ternary(includeExcepted, nil, index.exclusions()))
 		if err != nil {
 			h.log.WithError(err).Error("failed to query fleet vulnerability findings")
 			return nil, common.StoreErrorToApiStatus(err, false, domain.VulnerabilityGroupKind, nil)
@@ -556,7 +634,7 @@ func (h *ServiceHandler) GetFleetVulnerabilities(
 
 	items := make([]domain.VulnerabilityGroup, len(result.Groups))
 	for i, g := range result.Groups {
-		items[i] = vulnerabilityGroupFromStore(g, digestInfoMap)
+		items[i] = vulnerabilityGroupFromStore(g, digestInfoMap, lo.Ternary(includeExcepted, index, nil))
 	}
 
 	return buildVulnerabilityGroupList(items, result.Continue), domain.StatusOK()
@@ -590,9 +668,15 @@ func (h *ServiceHandler) GetFleetVulnerabilitySummary(
 		}
 	}
 
+	index, err := h.resolveExceptions(ctx, orgId, exceptionContext{digests: digests, fleet: name})
+	if err != nil {
+		h.log.WithError(err).Error("failed to resolve vulnerability exceptions")
+		return nil, domain.StatusInternalServerError("failed to resolve vulnerability exceptions")
+	}
+
 	var summary vulnerabilityfindingstore.FleetSeverityCounts
 	if len(digests) > 0 {
-		summary, err = h.store.GetFleetVulnerabilitySummary(ctx, digests, fs)
+		summary, err = h.store.GetFleetVulnerabilitySummary(ctx, digests, fs, index.exclusions())
 		if err != nil {
 			h.log.WithError(err).Error("failed to compute fleet vulnerability summary")
 			return nil, common.StoreErrorToApiStatus(err, false, domain.FleetVulnerabilitySummaryKind, nil)
@@ -750,8 +834,8 @@ func findingToVulnerability(f model.VulnerabilityFinding, imageRef string) domai
 
 // vulnerabilityGroupFromStore converts a store.VulnerabilityGroup to a domain.VulnerabilityGroup
 // for fleet-scoped and org-wide responses. digestInfoMap provides image name and device count
-// for each digest in scope.
-func vulnerabilityGroupFromStore(g vulnerabilityfindingstore.VulnerabilityGroup, digestInfoMap map[string]vulnerabilityfindingstore.DeviceImageDigest) domain.VulnerabilityGroup {
+// for each digest in scope. When exceptions is non-nil, excepted findings are annotated.
+func vulnerabilityGroupFromStore(g vulnerabilityfindingstore.VulnerabilityGroup, digestInfoMap map[string]vulnerabilityfindingstore.DeviceImageDigest, exceptions *exceptionIndex) domain.VulnerabilityGroup {
 	findings := make([]domain.VulnerabilityGroupItem, 0, len(g.Findings))
 	var affected int64
 
@@ -779,6 +863,7 @@ func vulnerabilityGroupFromStore(g vulnerabilityfindingstore.VulnerabilityGroup,
 				PublishedAt:     f.PublishedAt,
 				FirstSeenAt:     &firstSeen,
 				AffectedDevices: &info.DeviceCount,
+				Exception:       exceptions.lookup(f.ImageDigest, nil, f.CveID),
 			}
 			if f.CvssScore != nil {
 				score := float32(*f.CvssScore)
@@ -798,6 +883,7 @@ func vulnerabilityGroupFromStore(g vulnerabilityfindingstore.VulnerabilityGroup,
 			Description: f.Description,
 			PublishedAt: f.PublishedAt,
 			FirstSeenAt: &firstSeen,
+			Exception:   exceptions.lookup(f.ImageDigest, nil, f.CveID),
 		}
 		if f.CvssScore != nil {
 			score := float32(*f.CvssScore)
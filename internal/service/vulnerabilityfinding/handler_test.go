@@ -13,10 +13,12 @@ import (
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
+	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
+	vulnerabilityexceptionstore "github.com/flightctl/flightctl/internal/store/vulnerabilityexception"
 	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -60,6 +62,71 @@ func (f *fakeFleetStore) Get(_ context.Context, _ uuid.UUID, name string, _ ...f
 	return fl, nil
 }
 
+// fakeCatalogStore embeds catalogstore.Store (nil) and overrides only GetItem.
+type fakeCatalogStore struct {
+	catalogstore.Store
+	items map[string]*domain.CatalogItem // key: catalog+"/"+item
+}
+
+func (f *fakeCatalogStore) GetItem(_ context.Context, _ uuid.UUID, catalogName string, itemName string) (*domain.CatalogItem, error) {
+	item, ok := f.items[catalogName+"/"+itemName]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return item, nil
+}
+
+// fakeVulnerabilityExceptionStore is an in-memory vulnerabilityexceptionstore.Store.
+type fakeVulnerabilityExceptionStore struct {
+	vulnerabilityexceptionstore.Store
+	exceptions map[string]domain.VulnerabilityException
+}
+
+func (f *fakeVulnerabilityExceptionStore) Create(_ context.Context, _ uuid.UUID, e *domain.VulnerabilityException, _ store.EventCallback) (*domain.VulnerabilityException, error) {
+	if _, exists := f.exceptions[*e.Metadata.Name]; exists {
+		return nil, flterrors.ErrDuplicateName
+	}
+	f.exceptions[*e.Metadata.Name] = *e
+	return e, nil
+}
+
+func (f *fakeVulnerabilityExceptionStore) CreateOrUpdate(_ context.Context, _ uuid.UUID, e *domain.VulnerabilityException, _ store.EventCallback) (*domain.VulnerabilityException, bool, error) {
+	_, exists := f.exceptions[*e.Metadata.Name]
+	f.exceptions[*e.Metadata.Name] = *e
+	return e, !exists, nil
+}
+
+func (f *fakeVulnerabilityExceptionStore) Get(_ context.Context, _ uuid.UUID, name string) (*domain.VulnerabilityException, error) {
+	e, ok := f.exceptions[name]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return &e, nil
+}
+
+func (f *fakeVulnerabilityExceptionStore) List(_ context.Context, _ uuid.UUID, _ store.ListParams) (*domain.VulnerabilityExceptionList, error) {
+	names := lo.Keys(f.exceptions)
+	sort.Strings(names)
+	list := &domain.VulnerabilityExceptionList{Items: []domain.VulnerabilityException{}}
+	for _, name := range names {
+		list.Items = append(list.Items, f.exceptions[name])
+	}
+	return list, nil
+}
+
+func (f *fakeVulnerabilityExceptionStore) Delete(_ context.Context, _ uuid.UUID, name string, _ store.EventCallback) error {
+	delete(f.exceptions, name)
+	return nil
+}
+
+// isExcluded reports whether the fake store should drop the (digest, CVE) finding.
+func isExcluded(exclusions *vulnerabilityfindingstore.FindingExclusions, digest, cveID string) bool {
+	if exclusions == nil {
+		return false
+	}
+	return lo.Contains(exclusions.ByDigest[digest], cveID)
+}
+
 // fakeEventsService is a recording fake for events.Service.
 type fakeEventsService struct {
 	events.Service
@@ -107,6 +174,9 @@ type fakeVulnerabilityFindingStore struct {
 	criticalRows     []vulnerabilityfindingstore.CVEEventCandidate
 	warningRows      []vulnerabilityfindingstore.CVEEventCandidate
 	candidateErr     error
+
+	// lastExclusions records the exclusions passed to the most recent query.
+	lastExclusions *vulnerabilityfindingstore.FindingExclusions
 }
 
 func newFakeVulnerabilityFindingStore() *fakeVulnerabilityFindingStore {
@@ -121,10 +191,11 @@ func fleetDigestKey(orgId uuid.UUID, fleetName string) string {
 	return orgId.String() + "/" + fleetName
 }
 
-func (f *fakeVulnerabilityFindingStore) GetVulnerabilities(_ context.Context, digest string, listParams store.ListParams) (*vulnerabilityfindingstore.VulnerabilityListResult, error) {
+func (f *fakeVulnerabilityFindingStore) GetVulnerabilities(_ context.Context, digest string, listParams store.ListParams, exclusions *vulnerabilityfindingstore.FindingExclusions) (*vulnerabilityfindingstore.VulnerabilityListResult, error) {
+	f.lastExclusions = exclusions
 	var matched []model.VulnerabilityFinding
 	for _, fnd := range f.findings {
-		if fnd.ImageDigest == digest {
+		if fnd.ImageDigest == digest && !isExcluded(exclusions, fnd.ImageDigest, fnd.CveID) {
 			matched = append(matched, fnd)
 		}
 	}
@@ -141,10 +212,11 @@ func (f *fakeVulnerabilityFindingStore) GetVulnerabilities(_ context.Context, di
 	return &vulnerabilityfindingstore.VulnerabilityListResult{Items: items, Continue: cont}, nil
 }
 
-func (f *fakeVulnerabilityFindingStore) GetVulnerabilitySummary(_ context.Context, digest string, _ *selector.FieldSelector) (vulnerabilityfindingstore.SeverityCounts, error) {
+func (f *fakeVulnerabilityFindingStore) GetVulnerabilitySummary(_ context.Context, digest string, _ *selector.FieldSelector, exclusions *vulnerabilityfindingstore.FindingExclusions) (vulnerabilityfindingstore.SeverityCounts, error) {
+	f.lastExclusions = exclusions
 	var counts vulnerabilityfindingstore.SeverityCounts
 	for _, fnd := range f.findings {
-		if fnd.ImageDigest != digest {
+		if fnd.ImageDigest != digest || isExcluded(exclusions, fnd.ImageDigest, fnd.CveID) {
 			continue
 		}
 		addSeverityCount(&counts, fnd.Severity)
@@ -160,7 +232,8 @@ func (f *fakeVulnerabilityFindingStore) ListOrgDeviceImageDigests(_ context.Cont
 	return f.orgDigests[orgId], nil
 }
 
-func (f *fakeVulnerabilityFindingStore) GetVulnerabilityGroups(_ context.Context, digests []vulnerabilityfindingstore.DeviceImageDigest, _ store.ListParams) (*vulnerabilityfindingstore.VulnerabilityGroupResult, error) {
+func (f *fakeVulnerabilityFindingStore) GetVulnerabilityGroups(_ context.Context, digests []vulnerabilityfindingstore.DeviceImageDigest, _ store.ListParams, exclusions *vulnerabilityfindingstore.FindingExclusions) (*vulnerabilityfindingstore.VulnerabilityGroupResult, error) {
+	f.lastExclusions = exclusions
 	digestSet := make(map[string]bool, len(digests))
 	for _, d := range digests {
 		digestSet[d.ImageDigest] = true
@@ -169,7 +242,7 @@ func (f *fakeVulnerabilityFindingStore) GetVulnerabilityGroups(_ context.Context
 	groups := map[string]*vulnerabilityfindingstore.VulnerabilityGroup{}
 	var order []string
 	for _, fnd := range f.findings {
-		if !digestSet[fnd.ImageDigest] {
+		if !digestSet[fnd.ImageDigest] || isExcluded(exclusions, fnd.ImageDigest, fnd.CveID) {
 			continue
 		}
 		g, ok := groups[fnd.CveID]
@@ -206,14 +279,15 @@ func (f *fakeVulnerabilityFindingStore) GetVulnerabilityGroups(_ context.Context
 	return result, nil
 }
 
-func (f *fakeVulnerabilityFindingStore) GetFleetVulnerabilitySummary(_ context.Context, digests []vulnerabilityfindingstore.DeviceImageDigest, _ *selector.FieldSelector) (vulnerabilityfindingstore.FleetSeverityCounts, error) {
+func (f *fakeVulnerabilityFindingStore) GetFleetVulnerabilitySummary(_ context.Context, digests []vulnerabilityfindingstore.DeviceImageDigest, _ *selector.FieldSelector, exclusions *vulnerabilityfindingstore.FindingExclusions) (vulnerabilityfindingstore.FleetSeverityCounts, error) {
+	f.lastExclusions = exclusions
 	digestSet := make(map[string]bool, len(digests))
 	for _, d := range digests {
 		digestSet[d.ImageDigest] = true
 	}
 	bestRank := map[string]int{}
 	for _, fnd := range f.findings {
-		if !digestSet[fnd.ImageDigest] {
+		if !digestSet[fnd.ImageDigest] || isExcluded(exclusions, fnd.ImageDigest, fnd.CveID) {
 			continue
 		}
 		rank := fnd.Severity.Rank()
@@ -228,11 +302,13 @@ func (f *fakeVulnerabilityFindingStore) GetFleetVulnerabilitySummary(_ context.C
 	return vulnerabilityfindingstore.FleetSeverityCounts{SeverityCounts: counts, UniqueDigests: int64(len(digests))}, nil
 }
 
-func (f *fakeVulnerabilityFindingStore) GetOrgVulnerabilitySummary(_ context.Context, orgId uuid.UUID) (vulnerabilityfindingstore.OrgVulnerabilitySummary, error) {
+func (f *fakeVulnerabilityFindingStore) GetOrgVulnerabilitySummary(_ context.Context, orgId uuid.UUID, exclusions *vulnerabilityfindingstore.FindingExclusions) (vulnerabilityfindingstore.OrgVulnerabilitySummary, error) {
+	f.lastExclusions = exclusions
 	return f.orgSummary[orgId], nil
 }
 
-func (f *fakeVulnerabilityFindingStore) GetVulnerabilityImpactPage(_ context.Context, _ uuid.UUID, _ string, _ store.ListParams) (*vulnerabilityfindingstore.ImpactPageResult, error) {
+func (f *fakeVulnerabilityFindingStore) GetVulnerabilityImpactPage(_ context.Context, _ uuid.UUID, _ string, _ store.ListParams, exclusions *vulnerabilityfindingstore.FindingExclusions) (*vulnerabilityfindingstore.ImpactPageResult, error) {
+	f.lastExclusions = exclusions
 	if f.impactPageErr != nil {
 		return nil, f.impactPageErr
 	}
@@ -242,7 +318,7 @@ func (f *fakeVulnerabilityFindingStore) GetVulnerabilityImpactPage(_ context.Con
 	return &vulnerabilityfindingstore.ImpactPageResult{}, nil
 }
 
-func (f *fakeVulnerabilityFindingStore) GetImpactDigestDetails(_ context.Context, _ uuid.UUID, _ string, _ []string, _ bool) ([]vulnerabilityfindingstore.ImpactDigestDetail, error) {
+func (f *fakeVulnerabilityFindingStore) GetImpactDigestDetails(_ context.Context, _ uuid.UUID, _ string, _ []string, _ bool, _ *vulnerabilityfindingstore.FindingExclusions) ([]vulnerabilityfindingstore.ImpactDigestDetail, error) {
 	return f.impactDetails, f.impactDetailsErr
 }
 
@@ -269,7 +345,9 @@ func newTestHandler(vulnerabilityEnabled bool) (*ServiceHandler, *fakeVulnerabil
 	devStore := newFakeDeviceStore()
 	flStore := newFakeFleetStore()
 	evStore := &fakeEventsService{}
-	h := NewServiceHandler(vfStore, devStore, flStore, evStore, vulnerabilityEnabled, logrus.New())
+	exStore := &fakeVulnerabilityExceptionStore{exceptions: map[string]domain.VulnerabilityException{}}
+	catStore := &fakeCatalogStore{items: map[string]*domain.CatalogItem{}}
+	h := NewServiceHandler(vfStore, exStore, devStore, flStore, catStore, evStore, vulnerabilityEnabled, logrus.New())
 	return h, vfStore, devStore, flStore, evStore
 }
 
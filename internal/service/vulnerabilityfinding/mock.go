@@ -41,6 +41,35 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateVulnerabilityException mocks base method.
+func (m *MockService) CreateVulnerabilityException(ctx context.Context, orgId uuid.UUID, exception domain.VulnerabilityException) (*domain.VulnerabilityException, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVulnerabilityException", ctx, orgId, exception)
+	ret0, _ := ret[0].(*domain.VulnerabilityException)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateVulnerabilityException indicates an expected call of CreateVulnerabilityException.
+func (mr *MockServiceMockRecorder) CreateVulnerabilityException(ctx, orgId, exception any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVulnerabilityException", reflect.TypeOf((*MockService)(nil).CreateVulnerabilityException), ctx, orgId, exception)
+}
+
+// DeleteVulnerabilityException mocks base method.
+func (m *MockService) DeleteVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVulnerabilityException", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteVulnerabilityException indicates an expected call of DeleteVulnerabilityException.
+func (mr *MockServiceMockRecorder) DeleteVulnerabilityException(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVulnerabilityException", reflect.TypeOf((*MockService)(nil).DeleteVulnerabilityException), ctx, orgId, name)
+}
+
 // GetDeviceVulnerabilities mocks base method.
 func (m *MockService) GetDeviceVulnerabilities(ctx context.Context, orgId uuid.UUID, name string, params domain.GetDeviceVulnerabilitiesParams) (*domain.VulnerabilityList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -101,6 +130,21 @@ func (mr *MockServiceMockRecorder) GetFleetVulnerabilitySummary(ctx, orgId, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFleetVulnerabilitySummary", reflect.TypeOf((*MockService)(nil).GetFleetVulnerabilitySummary), ctx, orgId, name, params)
 }
 
+// GetVulnerabilityException mocks base method.
+func (m *MockService) GetVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) (*domain.VulnerabilityException, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVulnerabilityException", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.VulnerabilityException)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetVulnerabilityException indicates an expected call of GetVulnerabilityException.
+func (mr *MockServiceMockRecorder) GetVulnerabilityException(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVulnerabilityException", reflect.TypeOf((*MockService)(nil).GetVulnerabilityException), ctx, orgId, name)
+}
+
 // GetVulnerabilityImpact mocks base method.
 func (m *MockService) GetVulnerabilityImpact(ctx context.Context, orgId uuid.UUID, cveId string, params domain.GetVulnerabilityImpactParams) (*domain.VulnerabilityImpact, domain.Status) {
 	m.ctrl.T.Helper()
@@ -131,6 +175,21 @@ func (mr *MockServiceMockRecorder) GetVulnerabilitySummary(ctx, orgId any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVulnerabilitySummary", reflect.TypeOf((*MockService)(nil).GetVulnerabilitySummary), ctx, orgId)
 }
 
+// ImportVulnerabilityExceptions mocks base method.
+func (m *MockService) ImportVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, document domain.OpenVEXDocument) (*domain.VulnerabilityExceptionList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVulnerabilityExceptions", ctx, orgId, document)
+	ret0, _ := ret[0].(*domain.VulnerabilityExceptionList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ImportVulnerabilityExceptions indicates an expected call of ImportVulnerabilityExceptions.
+func (mr *MockServiceMockRecorder) ImportVulnerabilityExceptions(ctx, orgId, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVulnerabilityExceptions", reflect.TypeOf((*MockService)(nil).ImportVulnerabilityExceptions), ctx, orgId, document)
+}
+
 // ListVulnerabilities mocks base method.
 func (m *MockService) ListVulnerabilities(ctx context.Context, orgId uuid.UUID, params domain.ListVulnerabilitiesParams) (*domain.VulnerabilityGroupList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -146,6 +205,36 @@ func (mr *MockServiceMockRecorder) ListVulnerabilities(ctx, orgId, params any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVulnerabilities", reflect.TypeOf((*MockService)(nil).ListVulnerabilities), ctx, orgId, params)
 }
 
+// ListVulnerabilityExceptions mocks base method.
+func (m *MockService) ListVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, params domain.ListVulnerabilityExceptionsParams) (*domain.VulnerabilityExceptionList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVulnerabilityExceptions", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.VulnerabilityExceptionList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListVulnerabilityExceptions indicates an expected call of ListVulnerabilityExceptions.
+func (mr *MockServiceMockRecorder) ListVulnerabilityExceptions(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVulnerabilityExceptions", reflect.TypeOf((*MockService)(nil).ListVulnerabilityExceptions), ctx, orgId, params)
+}
+
+// ReplaceVulnerabilityException mocks base method.
+func (m *MockService) ReplaceVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string, exception domain.VulnerabilityException) (*domain.VulnerabilityException, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceVulnerabilityException", ctx, orgId, name, exception)
+	ret0, _ := ret[0].(*domain.VulnerabilityException)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceVulnerabilityException indicates an expected call of ReplaceVulnerabilityException.
+func (mr *MockServiceMockRecorder) ReplaceVulnerabilityException(ctx, orgId, name, exception any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceVulnerabilityException", reflect.TypeOf((*MockService)(nil).ReplaceVulnerabilityException), ctx, orgId, name, exception)
+}
+
 // SyncDeviceCVELifecycleEvents mocks base method.
 func (m *MockService) SyncDeviceCVELifecycleEvents(ctx context.Context) error {
 	m.ctrl.T.Helper()
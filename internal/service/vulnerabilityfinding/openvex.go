@@ -0,0 +1,202 @@
+package vulnerabilityfinding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+	"github.com/opencontainers/go-digest"
+	"github.com/samber/lo"
+)
+
+// OpenVEX statement statuses (https://github.com/openvex/spec).
+const (
+	openVEXStatusNotAffected = "not_affected"
+	openVEXStatusFixed       = "fixed"
+)
+
+var cveIDRegexp = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+// ImportVulnerabilityExceptions converts the not_affected and fixed statements of an OpenVEX
+// document into NotAffected exceptions scoped to the image digests of the statement's products.
+// Imported exceptions have deterministic names, so importing a document again updates them.
+func (h *ServiceHandler) ImportVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, document domain.OpenVEXDocument) (*domain.VulnerabilityExceptionList, domain.Status) {
+	if !h.vulnerabilityEnabled {
+		return nil, h.featureDisabledStatus()
+	}
+
+	exceptions, err := exceptionsFromOpenVEX(document)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+	for i := range exceptions {
+		if errs := exceptions[i].Validate(); len(errs) > 0 {
+			return nil, domain.StatusBadRequest(fmt.Sprintf("statement for %s: %s", exceptions[i].Spec.CveId, errors.Join(errs...).Error()))
+		}
+	}
+
+	items := make([]domain.VulnerabilityException, 0, len(exceptions))
+	for i := range exceptions {
+		result, created, err := h.exceptionStore.CreateOrUpdate(ctx, orgId, &exceptions[i], h.callbackVulnerabilityExceptionUpdated)
+		if err != nil {
+			return nil, common.StoreErrorToApiStatus(err, created, domain.VulnerabilityExceptionKind, exceptions[i].Metadata.Name)
+		}
+		items = append(items, *result)
+	}
+
+	return &domain.VulnerabilityExceptionList{
+		ApiVersion: domain.VulnerabilityAPIVersion,
+		Kind:       domain.VulnerabilityExceptionListKind,
+		Items:      items,
+	}, domain.StatusOK()
+}
+
+// exceptionsFromOpenVEX maps an OpenVEX document to exceptions. Statements with other statuses, and
+// products that do not identify an image digest, are skipped. Later statements override earlier
+// ones for the same (CVE, digest), as the OpenVEX spec orders statements chronologically.
+func exceptionsFromOpenVEX(document domain.OpenVEXDocument) ([]domain.VulnerabilityException, error) {
+	if !strings.HasPrefix(document.Context, domain.OpenVEXContextPrefix) {
+		return nil, fmt.Errorf("@context %q is not an OpenVEX context", document.Context)
+	}
+
+	byName := map[string]domain.VulnerabilityException{}
+	for i, statement := range document.Statements {
+		if statement.Status != openVEXStatusNotAffected && statement.Status != openVEXStatusFixed {
+			continue
+		}
+		cveID := openVEXVulnerabilityID(statement.Vulnerability)
+		if cveID == "" {
+			return nil, fmt.Errorf("statements[%d]: vulnerability has no name", i)
+		}
+
+		spec := domain.VulnerabilityExceptionSpec{
+			CveId:         cveID,
+			State:         domain.VulnerabilityExceptionStateNotAffected,
+			Justification: openVEXJustificationText(statement),
+		}
+		if statement.Status == openVEXStatusNotAffected && statement.Justification != nil {
+			justification := domain.VexJustification(*statement.Justification)
+			spec.VexJustification = &justification
+		}
+
+		for _, product := range lo.FromPtr(statement.Products) {
+			imageDigest := openVEXProductDigest(product)
+			if imageDigest == "" {
+				continue
+			}
+			name := openVEXExceptionName(cveID, imageDigest)
+			exceptionSpec := spec
+			exceptionSpec.Scope = domain.VulnerabilityExceptionScope{ImageDigest: lo.ToPtr(imageDigest)}
+			exception := domain.VulnerabilityException{
+				ApiVersion: fmt.Sprintf("%s/%s", domain.APIGroup, domain.VulnerabilityAPIVersion),
+				Kind:       domain.VulnerabilityExceptionKind,
+				Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+				Spec:       exceptionSpec,
+			}
+			if document.Id != nil {
+				exception.Metadata.Annotations = &map[string]string{domain.VulnerabilityExceptionAnnotationOpenVEXDocument: *document.Id}
+			}
+			byName[name] = exception
+		}
+	}
+
+	names := lo.Keys(byName)
+	sort.Strings(names)
+	exceptions := make([]domain.VulnerabilityException, 0, len(names))
+	for _, name := range names {
+		exceptions = append(exceptions, byName[name])
+	}
+	return exceptions, nil
+}
+
+// openVEXVulnerabilityID prefers the CVE identifier when the statement names another ID (e.g. a GHSA)
+// and lists the CVE as an alias, since findings are keyed by CVE.
+func openVEXVulnerabilityID(v domain.OpenVEXVulnerability) string {
+	name := strings.TrimSpace(lo.FromPtr(v.Name))
+	if cveIDRegexp.MatchString(name) {
+		return name
+	}
+	for _, alias := range lo.FromPtr(v.Aliases) {
+		if cveIDRegexp.MatchString(alias) {
+			return alias
+		}
+	}
+	return name
+}
+
+func openVEXJustificationText(statement domain.OpenVEXStatement) string {
+	switch {
+	case lo.FromPtr(statement.ImpactStatement) != "":
+		return *statement.ImpactStatement
+	case lo.FromPtr(statement.StatusNotes) != "":
+		return *statement.StatusNotes
+	case lo.FromPtr(statement.Justification) != "":
+		return *statement.Justification
+	case statement.Status == openVEXStatusFixed:
+		return "fixed according to an imported OpenVEX statement"
+	default:
+		return "not affected according to an imported OpenVEX statement"
+	}
+}
+
+// openVEXProductDigest extracts the image digest a product refers to. It accepts an OCI package URL
+// (pkg:oci/app@sha256%3A...), an image reference pinned by digest (quay.io/org/app@sha256:...), or a
+// sha-256 hash. It returns "" when the product does not identify a digest.
+func openVEXProductDigest(product domain.OpenVEXProduct) string {
+	candidates := []string{lo.FromPtr(product.Id)}
+	if product.Identifiers != nil {
+		candidates = append(candidates, (*product.Identifiers)["purl"])
+	}
+	for _, candidate := range candidates {
+		if d := digestFromReference(candidate); d != "" {
+			return d
+		}
+	}
+	if product.Hashes != nil {
+		if hex := (*product.Hashes)["sha-256"]; hex != "" {
+			if d, err := digest.Parse("sha256:" + strings.ToLower(hex)); err == nil {
+				return d.String()
+			}
+		}
+	}
+	return ""
+}
+
+func digestFromReference(ref string) string {
+	idx := strings.LastIndex(ref, "@")
+	if idx < 0 {
+		return ""
+	}
+	version := ref[idx+1:]
+	if q := strings.IndexAny(version, "?#"); q >= 0 {
+		version = version[:q]
+	}
+	if strings.HasPrefix(ref, "pkg:") {
+		unescaped, err := url.PathUnescape(version)
+		if err != nil {
+			return ""
+		}
+		version = unescaped
+	}
+	d, err := digest.Parse(version)
+	if err != nil {
+		return ""
+	}
+	return d.String()
+}
+
+// openVEXExceptionName derives a stable resource name from the CVE and digest.
+func openVEXExceptionName(cveID, imageDigest string) string {
+	encoded := digest.Digest(imageDigest).Encoded()
+	if len(encoded) > 12 {
+		encoded = encoded[:12]
+	}
+	return fmt.Sprintf("vex-%s-%s", strings.ToLower(cveID), encoded)
+}
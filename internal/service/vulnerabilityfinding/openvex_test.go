@@ -0,0 +1,96 @@
+package vulnerabilityfinding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+const testOpenVEXDocument = `{
+  "@context": "https://openvex.dev/ns/v0.2.0",
+  "@id": "https://example.com/vex/os-1.0",
+  "author": "Example Security",
+  "statements": [
+    {
+      "vulnerability": {"name": "CVE-2024-0001"},
+      "products": [
+        {"@id": "pkg:oci/os@sha256%3A` + "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" + `?repository_url=quay.io/example/os"},
+        {"@id": "quay.io/example/os@sha256:` + "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" + `"},
+        {"@id": "pkg:generic/no-digest@1.0"}
+      ],
+      "status": "not_affected",
+      "justification": "vulnerable_code_not_in_execute_path",
+      "impact_statement": "The affected parser is never invoked."
+    },
+    {
+      "vulnerability": {"name": "GHSA-35jh-r3h4-6jhm", "aliases": ["CVE-2024-0002"]},
+      "products": [
+        {"@id": "urn:example:os", "hashes": {"sha-256": "` + "cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc" + `"}}
+      ],
+      "status": "fixed"
+    },
+    {
+      "vulnerability": {"name": "CVE-2024-0003"},
+      "products": [{"@id": "quay.io/example/os@sha256:` + "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" + `"}],
+      "status": "affected"
+    }
+  ]
+}`
+
+func parseTestOpenVEX(t *testing.T) domain.OpenVEXDocument {
+	var document domain.OpenVEXDocument
+	require.NoError(t, json.Unmarshal([]byte(testOpenVEXDocument), &document))
+	return document
+}
+
+func TestExceptionsFromOpenVEX(t *testing.T) {
+	require := require.New(t)
+
+	exceptions, err := exceptionsFromOpenVEX(parseTestOpenVEX(t))
+	require.NoError(err)
+	require.Len(exceptions, 3)
+
+	byName := lo.KeyBy(exceptions, func(e domain.VulnerabilityException) string { return *e.Metadata.Name })
+
+	first := byName["vex-cve-2024-0001-aaaaaaaaaaaa"]
+	require.Equal("sha256:"+strings.Repeat("a", 64), lo.FromPtr(first.Spec.Scope.ImageDigest))
+	require.Equal(domain.VulnerabilityExceptionStateNotAffected, first.Spec.State)
+	require.Equal("The affected parser is never invoked.", first.Spec.Justification)
+	require.Equal(domain.VexJustification("vulnerable_code_not_in_execute_path"), lo.FromPtr(first.Spec.VexJustification))
+	require.Equal("https://example.com/vex/os-1.0", (*first.Metadata.Annotations)[domain.VulnerabilityExceptionAnnotationOpenVEXDocument])
+	require.Empty(first.Validate())
+
+	require.Contains(byName, "vex-cve-2024-0001-bbbbbbbbbbbb")
+
+	fixed := byName["vex-cve-2024-0002-cccccccccccc"]
+	require.Equal("CVE-2024-0002", fixed.Spec.CveId)
+	require.Nil(fixed.Spec.VexJustification)
+	require.Empty(fixed.Validate())
+}
+
+func TestExceptionsFromOpenVEXRejectsOtherDocuments(t *testing.T) {
+	_, err := exceptionsFromOpenVEX(domain.OpenVEXDocument{Context: "https://cyclonedx.org/schema"})
+	require.Error(t, err)
+}
+
+func TestImportVulnerabilityExceptions(t *testing.T) {
+	require := require.New(t)
+	h, _, _, _, _ := newTestHandler(true)
+	orgId := uuid.New()
+
+	list, status := h.ImportVulnerabilityExceptions(context.Background(), orgId, parseTestOpenVEX(t))
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Len(list.Items, 3)
+
+	// Importing the same document again updates the exceptions in place.
+	_, status = h.ImportVulnerabilityExceptions(context.Background(), orgId, parseTestOpenVEX(t))
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Len(h.exceptionStore.(*fakeVulnerabilityExceptionStore).exceptions, 3)
+}
@@ -16,4 +16,12 @@ type Service interface {
 	GetFleetVulnerabilities(ctx context.Context, orgId uuid.UUID, name string, params domain.GetFleetVulnerabilitiesParams) (*domain.VulnerabilityGroupList, domain.Status)
 	GetFleetVulnerabilitySummary(ctx context.Context, orgId uuid.UUID, name string, params domain.GetFleetVulnerabilitySummaryParams) (*domain.FleetVulnerabilitySummaryResponse, domain.Status)
 	SyncDeviceCVELifecycleEvents(ctx context.Context) error
+
+	// VulnerabilityException
+	CreateVulnerabilityException(ctx context.Context, orgId uuid.UUID, exception domain.VulnerabilityException) (*domain.VulnerabilityException, domain.Status)
+	ListVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, params domain.ListVulnerabilityExceptionsParams) (*domain.VulnerabilityExceptionList, domain.Status)
+	GetVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) (*domain.VulnerabilityException, domain.Status)
+	ReplaceVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string, exception domain.VulnerabilityException) (*domain.VulnerabilityException, domain.Status)
+	DeleteVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) domain.Status
+	ImportVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, document domain.OpenVEXDocument) (*domain.VulnerabilityExceptionList, domain.Status)
 }
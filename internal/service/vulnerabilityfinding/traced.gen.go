@@ -44,6 +44,22 @@ func endSpan(span trace.Span, st domain.Status) {
 	span.End()
 }
 
+func (_d *TracedService) CreateVulnerabilityException(ctx context.Context, orgId uuid.UUID, exception domain.VulnerabilityException) (vp1 *domain.VulnerabilityException, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "CreateVulnerabilityException")
+
+	vp1, s1 = _d.inner.CreateVulnerabilityException(ctx, orgId, exception)
+	endSpan(span, s1)
+	return vp1, s1
+}
+
+func (_d *TracedService) DeleteVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) (s1 domain.Status) {
+	ctx, span := startSpan(ctx, "DeleteVulnerabilityException")
+
+	s1 = _d.inner.DeleteVulnerabilityException(ctx, orgId, name)
+	endSpan(span, s1)
+	return s1
+}
+
 func (_d *TracedService) GetDeviceVulnerabilities(ctx context.Context, orgId uuid.UUID, name string, params domain.GetDeviceVulnerabilitiesParams) (vp1 *domain.VulnerabilityList, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "GetDeviceVulnerabilities")
 
@@ -76,6 +92,14 @@ func (_d *TracedService) GetFleetVulnerabilitySummary(ctx context.Context, orgId
 	return fp1, s1
 }
 
+func (_d *TracedService) GetVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string) (vp1 *domain.VulnerabilityException, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetVulnerabilityException")
+
+	vp1, s1 = _d.inner.GetVulnerabilityException(ctx, orgId, name)
+	endSpan(span, s1)
+	return vp1, s1
+}
+
 func (_d *TracedService) GetVulnerabilityImpact(ctx context.Context, orgId uuid.UUID, cveId string, params domain.GetVulnerabilityImpactParams) (vp1 *domain.VulnerabilityImpact, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "GetVulnerabilityImpact")
 
@@ -92,6 +116,14 @@ func (_d *TracedService) GetVulnerabilitySummary(ctx context.Context, orgId uuid
 	return vp1, s1
 }
 
+func (_d *TracedService) ImportVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, document domain.OpenVEXDocument) (vp1 *domain.VulnerabilityExceptionList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ImportVulnerabilityExceptions")
+
+	vp1, s1 = _d.inner.ImportVulnerabilityExceptions(ctx, orgId, document)
+	endSpan(span, s1)
+	return vp1, s1
+}
+
 func (_d *TracedService) ListVulnerabilities(ctx context.Context, orgId uuid.UUID, params domain.ListVulnerabilitiesParams) (vp1 *domain.VulnerabilityGroupList, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "ListVulnerabilities")
 
@@ -100,6 +132,22 @@ func (_d *TracedService) ListVulnerabilities(ctx context.Context, orgId uuid.UUI
 	return vp1, s1
 }
 
+func (_d *TracedService) ListVulnerabilityExceptions(ctx context.Context, orgId uuid.UUID, params domain.ListVulnerabilityExceptionsParams) (vp1 *domain.VulnerabilityExceptionList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListVulnerabilityExceptions")
+
+	vp1, s1 = _d.inner.ListVulnerabilityExceptions(ctx, orgId, params)
+	endSpan(span, s1)
+	return vp1, s1
+}
+
+func (_d *TracedService) ReplaceVulnerabilityException(ctx context.Context, orgId uuid.UUID, name string, exception domain.VulnerabilityException) (vp1 *domain.VulnerabilityException, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceVulnerabilityException")
+
+	vp1, s1 = _d.inner.ReplaceVulnerabilityException(ctx, orgId, name, exception)
+	endSpan(span, s1)
+	return vp1, s1
+}
+
 func (_d *TracedService) SyncDeviceCVELifecycleEvents(ctx context.Context) (err error) {
 	ctx, span := startSpan(ctx, "SyncDeviceCVELifecycleEvents")
 
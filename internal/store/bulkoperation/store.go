@@ -0,0 +1,108 @@
+package bulkoperation
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Store interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, op *domain.BulkOperation, callbackEvent store.EventCallback) (*domain.BulkOperation, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.BulkOperationList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, callbackEvent store.EventCallback) error
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, op *domain.BulkOperation) (*domain.BulkOperation, error)
+}
+
+type BulkOperationStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *store.GenericStore[*model.BulkOperation, model.BulkOperation, domain.BulkOperation, domain.BulkOperationList]
+	eventCallbackCaller store.EventCallbackCaller
+}
+
+// Make sure we conform to the Store interface
+var _ Store = (*BulkOperationStore)(nil)
+
+func NewBulkOperationStore(db *gorm.DB, log logrus.FieldLogger) Store {
+	genericStore := store.NewGenericStore[*model.BulkOperation, model.BulkOperation, domain.BulkOperation, domain.BulkOperationList](
+		db,
+		log,
+		model.NewBulkOperationFromApiResource,
+		(*model.BulkOperation).ToApiResource,
+		model.BulkOperationsToApiResource,
+	)
+	return &BulkOperationStore{
+		dbHandler:           db,
+		log:                 log,
+		genericStore:        genericStore,
+		eventCallbackCaller: store.CallEventCallback(domain.BulkOperationKind, log),
+	}
+}
+
+func (s *BulkOperationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *BulkOperationStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.BulkOperation{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for BulkOperation labels
+	if !db.Migrator().HasIndex(&model.BulkOperation{}, "idx_bulk_operations_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_bulk_operations_labels ON bulk_operations USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.BulkOperation{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *BulkOperationStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.BulkOperation, eventCallback store.EventCallback) (*domain.BulkOperation, error) {
+	op, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, op, true, err)
+	return op, err
+}
+
+func (s *BulkOperationStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *BulkOperationStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.BulkOperationList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *BulkOperationStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.BulkOperation{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	}
+	return err
+}
+
+// UpdateStatus reports the progress of an operation. Progress updates are frequent and only of
+// interest to whoever polls the operation, so they do not emit events. It returns
+// ErrResourceNotFound if the operation was deleted, which tells the worker to stop.
+func (s *BulkOperationStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.BulkOperation) (*domain.BulkOperation, error) {
+	if _, err := s.Get(ctx, orgId, lo.FromPtr(resource.Metadata.Name)); err != nil {
+		return nil, err
+	}
+	return s.genericStore.UpdateStatus(ctx, orgId, resource)
+}
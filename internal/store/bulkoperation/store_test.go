@@ -0,0 +1,18 @@
+package bulkoperation
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewBulkOperationStore_ReturnsNonNilStore proves the constructor builds a
+// working Store without dialing the database.
+func TestNewBulkOperationStore_ReturnsNonNilStore(t *testing.T) {
+	req := require.New(t)
+
+	s := NewBulkOperationStore(nil, logrus.New())
+
+	req.NotNil(s)
+}
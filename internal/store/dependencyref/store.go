@@ -27,7 +27,10 @@ type Store interface {
 	BulkUpsertDeviceRefs(ctx context.Context, orgID uuid.UUID, refs []model.DependencyRef) error
 	ListDueGitDependencies(ctx context.Context, orgID uuid.UUID, pollInterval time.Duration) ([]model.GitDependencyProbe, error)
 	ListDueHttpDependencies(ctx context.Context, orgID uuid.UUID, pollInterval time.Duration) ([]model.HttpDependencyProbe, error)
+	ListDueVaultDependencies(ctx context.Context, orgID uuid.UUID, pollInterval time.Duration) ([]model.VaultDependencyProbe, error)
+	ListDueOciDependencies(ctx context.Context, orgID uuid.UUID, pollInterval time.Duration) ([]model.OciDependencyProbe, error)
 	ListSecretDependencyTargets(ctx context.Context, secretNamespace, secretName, newFingerprint string) ([]model.SecretDependencyRef, error)
+	ListNativeSecretDependencyTargets(ctx context.Context, orgID uuid.UUID, secretName string) ([]model.SecretDependencyRef, error)
 }
 
 type DependencyRefStore struct {
@@ -212,6 +215,56 @@ func (s *DependencyRefStore) ListDueHttpDependencies(ctx context.Context, orgID
 	return probes, nil
 }
 
+// ListDueVaultDependencies returns Vault dependency probes that are due for
+// polling. Mirrors ListDueGitDependencies but filters by ref_type='vault' and
+// groups by (repository_name, vault_path).
+func (s *DependencyRefStore) ListDueVaultDependencies(ctx context.Context, orgID uuid.UUID, pollInterval time.Duration) ([]model.VaultDependencyProbe, error) {
+	var probes []model.VaultDependencyProbe
+	err := s.getDB(ctx).
+		Table("dependency_refs dr").
+		Select(
+			"dr.repository_name, dr.vault_path, ss.fingerprint, r.spec AS repo_spec, "+
+				"array_agg(DISTINCT dr.fleet_name) FILTER (WHERE dr.fleet_name <> '' AND dr.device_name = '') AS fleet_names, "+
+				"array_agg(DISTINCT dr.device_name) FILTER (WHERE dr.device_name <> '') AS device_names",
+		).
+		Joins("LEFT JOIN sync_states ss ON ss.org_id = dr.org_id AND ss.resource_key = dr.resource_key").
+		Joins("LEFT JOIN repositories r ON r.org_id = dr.org_id AND r.name = dr.repository_name").
+		Where("dr.org_id = ?", orgID).
+		Where("dr.ref_type = 'vault'").
+		Where("ss.last_checked_at IS NULL OR ss.last_checked_at + ? * INTERVAL '1 second' < NOW()", pollInterval.Seconds()).
+		Group("dr.repository_name, dr.vault_path, ss.fingerprint, r.spec").
+		Scan(&probes).Error
+	if err != nil {
+		return nil, storeutil.ErrorFromGormError(err)
+	}
+	return probes, nil
+}
+
+// ListDueOciDependencies returns OCI artifact dependency probes that are due for
+// polling. Mirrors ListDueGitDependencies but filters by ref_type='oci' and
+// groups by (repository_name, oci_artifact, revision).
+func (s *DependencyRefStore) ListDueOciDependencies(ctx context.Context, orgID uuid.UUID, pollInterval time.Duration) ([]model.OciDependencyProbe, error) {
+	var probes []model.OciDependencyProbe
+	err := s.getDB(ctx).
+		Table("dependency_refs dr").
+		Select(
+			"dr.repository_name, dr.oci_artifact, dr.revision, ss.fingerprint, r.spec AS repo_spec, "+
+				"array_agg(DISTINCT dr.fleet_name) FILTER (WHERE dr.fleet_name <> '' AND dr.device_name = '') AS fleet_names, "+
+				"array_agg(DISTINCT dr.device_name) FILTER (WHERE dr.device_name <> '') AS device_names",
+		).
+		Joins("LEFT JOIN sync_states ss ON ss.org_id = dr.org_id AND ss.resource_key = dr.resource_key").
+		Joins("LEFT JOIN repositories r ON r.org_id = dr.org_id AND r.name = dr.repository_name").
+		Where("dr.org_id = ?", orgID).
+		Where("dr.ref_type = 'oci'").
+		Where("ss.last_checked_at IS NULL OR ss.last_checked_at + ? * INTERVAL '1 second' < NOW()", pollInterval.Seconds()).
+		Group("dr.repository_name, dr.oci_artifact, dr.revision, ss.fingerprint, r.spec").
+		Scan(&probes).Error
+	if err != nil {
+		return nil, storeutil.ErrorFromGormError(err)
+	}
+	return probes, nil
+}
+
 // ListSecretDependencyTargets returns flat rows of (orgID, fleetName, deviceName)
 // for all dependency_refs matching the given secret where the stored fingerprint
 // differs from newFingerprint (or no fingerprint exists yet).
@@ -231,3 +284,22 @@ func (s *DependencyRefStore) ListSecretDependencyTargets(ctx context.Context, se
 	}
 	return refs, nil
 }
+
+// ListNativeSecretDependencyTargets returns flat rows of (orgID, fleetName, deviceName)
+// for all dependency_refs of the organization matching the given Secret resource.
+// Unlike K8s secrets, Secret resources are only fanned out when their spec
+// changes, so no fingerprint comparison is needed.
+func (s *DependencyRefStore) ListNativeSecretDependencyTargets(ctx context.Context, orgID uuid.UUID, secretName string) ([]model.SecretDependencyRef, error) {
+	var refs []model.SecretDependencyRef
+	err := s.getDB(ctx).
+		Table("dependency_refs dr").
+		Select("dr.org_id, dr.fleet_name, dr.device_name").
+		Where("dr.org_id = ?", orgID).
+		Where("dr.ref_type = 'native-secret'").
+		Where("dr.secret_name = ?", secretName).
+		Scan(&refs).Error
+	if err != nil {
+		return nil, storeutil.ErrorFromGormError(err)
+	}
+	return refs, nil
+}
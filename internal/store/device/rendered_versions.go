@@ -0,0 +1,112 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxRenderedVersionsPerDevice bounds the number of rendered versions retained for a single device.
+const MaxRenderedVersionsPerDevice = 20
+
+// DeviceRenderedVersion describes a rendered version to retain.
+type DeviceRenderedVersion struct {
+	Version         int64
+	TemplateVersion string
+	// RollbackOf is the rendered version restored by a rollback, if any.
+	RollbackOf *int64
+	Rendered   DeviceRendered
+}
+
+// AppendRenderedVersion retains a rendered version and drops the oldest versions of the
+// device beyond MaxRenderedVersionsPerDevice.
+func (s *DeviceStore) AppendRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version DeviceRenderedVersion) error {
+	cfg := version.Rendered.Config
+	if strings.TrimSpace(cfg) == "" {
+		cfg = "[]"
+	}
+	apps := version.Rendered.Applications
+	if strings.TrimSpace(apps) == "" {
+		apps = "[]"
+	}
+	record := model.DeviceRenderedVersion{
+		OrgID:                orgId,
+		DeviceName:           name,
+		RenderedVersion:      version.Version,
+		TemplateVersion:      version.TemplateVersion,
+		RollbackOf:           version.RollbackOf,
+		CreatedAt:            time.Now().UTC(),
+		RenderedConfig:       model.MakeJSONField(json.RawMessage(cfg)),
+		RenderedApplications: model.MakeJSONField(json.RawMessage(apps)),
+		RenderedOs:           model.MakeJSONField(domain.DeviceOsSpec{Image: version.Rendered.OsImage}),
+	}
+	return s.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		// A version is rendered once; a retried render of the same version keeps the first row.
+		if err := tx.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(&record).Error; err != nil {
+			return store.ErrorFromGormError(err)
+		}
+		keep := tx.Model(&model.DeviceRenderedVersion{}).
+			Select("rendered_version").
+			Where("org_id = ? AND device_name = ?", orgId, name).
+			Order("rendered_version DESC").
+			Limit(MaxRenderedVersionsPerDevice)
+		err := tx.Where("org_id = ? AND device_name = ? AND rendered_version NOT IN (?)", orgId, name, keep).
+			Delete(&model.DeviceRenderedVersion{}).Error
+		return store.ErrorFromGormError(err)
+	})
+}
+
+func (s *DeviceStore) ListRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, limit int) ([]domain.DeviceRenderedVersion, error) {
+	query := s.getDB(ctx).
+		Omit("rendered_config", "rendered_applications", "rendered_os").
+		Where("org_id = ? AND device_name = ?", orgId, name)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var records []model.DeviceRenderedVersion
+	if err := query.Order("rendered_version DESC").Find(&records).Error; err != nil {
+		return nil, store.ErrorFromGormError(err)
+	}
+
+	versions := make([]domain.DeviceRenderedVersion, 0, len(records))
+	for i := range records {
+		version, err := records[i].ToApiResource(ctx, false)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, *version)
+	}
+	return versions, nil
+}
+
+func (s *DeviceStore) GetRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error) {
+	var record model.DeviceRenderedVersion
+	err := s.getDB(ctx).
+		Where("org_id = ? AND device_name = ? AND rendered_version = ?", orgId, name, version).
+		Take(&record).Error
+	if err != nil {
+		return nil, store.ErrorFromGormError(err)
+	}
+	return record.ToApiResource(ctx, true)
+}
+
+func (s *DeviceStore) GetPrecedingRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error) {
+	var record model.DeviceRenderedVersion
+	err := s.getDB(ctx).
+		Where("org_id = ? AND device_name = ? AND rendered_version < ?", orgId, name, version).
+		Order("rendered_version DESC").
+		Take(&record).Error
+	if err != nil {
+		return nil, store.ErrorFromGormError(err)
+	}
+	return record.ToApiResource(ctx, true)
+}
@@ -0,0 +1,78 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxStatusHistoryEntriesPerDevice bounds the number of transitions kept for a single device,
+// so that a flapping device cannot grow the table without limit between retention runs.
+const MaxStatusHistoryEntriesPerDevice = 100
+
+// AppendStatusHistory records a status transition and drops the oldest transitions of the
+// device beyond MaxStatusHistoryEntriesPerDevice.
+func (s *DeviceStore) AppendStatusHistory(ctx context.Context, orgId uuid.UUID, name string, entry domain.DeviceStatusHistoryEntry) error {
+	record := model.DeviceStatusHistory{
+		OrgID:      orgId,
+		DeviceName: name,
+		Timestamp:  entry.Timestamp,
+		Entry:      model.MakeJSONField(entry),
+	}
+	return s.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&record).Error; err != nil {
+			return store.ErrorFromGormError(err)
+		}
+		keep := tx.Model(&model.DeviceStatusHistory{}).
+			Select("id").
+			Where("org_id = ? AND device_name = ?", orgId, name).
+			Order("timestamp DESC, id DESC").
+			Limit(MaxStatusHistoryEntriesPerDevice)
+		err := tx.Where("org_id = ? AND device_name = ? AND id NOT IN (?)", orgId, name, keep).
+			Delete(&model.DeviceStatusHistory{}).Error
+		return store.ErrorFromGormError(err)
+	})
+}
+
+func (s *DeviceStore) ListStatusHistory(ctx context.Context, orgId uuid.UUID, name string, from, to *time.Time, limit int) ([]domain.DeviceStatusHistoryEntry, error) {
+	query := s.getDB(ctx).Where("org_id = ? AND device_name = ?", orgId, name)
+	if from != nil {
+		query = query.Where("timestamp >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("timestamp < ?", *to)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var records []model.DeviceStatusHistory
+	if err := query.Order("timestamp DESC, id DESC").Find(&records).Error; err != nil {
+		return nil, store.ErrorFromGormError(err)
+	}
+
+	entries := make([]domain.DeviceStatusHistoryEntry, 0, len(records))
+	for _, r := range records {
+		if r.Entry == nil {
+			continue
+		}
+		entries = append(entries, r.Entry.Data)
+	}
+	return entries, nil
+}
+
+// DeleteStatusHistoryOlderThan deletes status transitions of all devices recorded before the cutoff.
+func (s *DeviceStore) DeleteStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (int64, error) {
+	result := s.getDB(ctx).Where("timestamp < ?", cutoffTime).Delete(&model.DeviceStatusHistory{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete device status history: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
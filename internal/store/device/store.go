@@ -81,6 +81,13 @@ type Store interface {
 	Healthcheck(ctx context.Context, orgId uuid.UUID, names []string) error
 	ProcessAwaitingReconnectAnnotation(ctx context.Context, orgId uuid.UUID, deviceName string, deviceReportedVersion *string) (bool, error)
 	GetLastSeen(ctx context.Context, orgId uuid.UUID, name string) (*time.Time, error)
+	// ListStatusHistory returns the recorded status transitions of a device, newest first.
+	ListStatusHistory(ctx context.Context, orgId uuid.UUID, name string, from, to *time.Time, limit int) ([]domain.DeviceStatusHistoryEntry, error)
+	// ListRenderedVersions returns the retained rendered versions of a device without their specs, newest first.
+	ListRenderedVersions(ctx context.Context, orgId uuid.UUID, name string, limit int) ([]domain.DeviceRenderedVersion, error)
+	GetRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error)
+	// GetPrecedingRenderedVersion returns the newest retained rendered version older than version.
+	GetPrecedingRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version int64) (*domain.DeviceRenderedVersion, error)
 
 	// Used internally
 	SetServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition, callback ServiceConditionsCallback) error
@@ -90,6 +97,9 @@ type Store interface {
 	SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner string) error
 	ListConnectivityChanged(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, cutoffTime time.Time) (*domain.DeviceList, error)
 	GetWithTimestamp(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error)
+	AppendStatusHistory(ctx context.Context, orgId uuid.UUID, name string, entry domain.DeviceStatusHistoryEntry) error
+	DeleteStatusHistoryOlderThan(ctx context.Context, cutoffTime time.Time) (int64, error)
+	AppendRenderedVersion(ctx context.Context, orgId uuid.UUID, name string, version DeviceRenderedVersion) error
 
 	// Used only by rollout
 	Count(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (int64, error)
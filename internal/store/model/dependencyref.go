@@ -47,29 +47,35 @@ func (StringArray) GormDataType() string {
 	return "text[]"
 }
 
-// DependencyRef maps a fleet or device to an external dependency (git repo,
-// HTTP resource, or K8s secret). The sync controller reads these rows as a
-// polling work list (git/HTTP) and fan-out lookup (all types).
+// DependencyRef maps a fleet or device to a dependency (git repo, HTTP
+// resource, K8s secret, Vault secret, Secret resource, or OCI artifact). The sync
+// controller reads these rows as a polling work list (git/HTTP/Vault/OCI) and
+// fan-out lookup (all types).
 type DependencyRef struct {
 	OrgID       uuid.UUID `gorm:"type:uuid;primaryKey"`
-	ResourceKey string    `gorm:"primaryKey"` // e.g. "git:repo/ref", "http:repo/path", "secret:ns/name"
+	ResourceKey string    `gorm:"primaryKey"` // e.g. "git:repo/ref", "http:repo/path", "secret:ns/name", "vault:repo/path", "native-secret:name", "oci:repo/artifact:ref"
 	FleetName   *string   `gorm:"primaryKey;default:''"`
 	DeviceName  *string   `gorm:"primaryKey;default:''"`
 
-	RefType            string // "git", "http", "secret"
+	RefType            string // "git", "http", "secret", "vault", "native-secret", "oci"
 	RepositoryName     *string
 	Revision           *string
 	HTTPSuffix         *string
 	SecretName         *string
 	SecretNamespace    *string
 	ConfigProviderName string
+
+	// The Vault and OCI columns are added by a versioned migration, see internal/migration.
+	VaultPath   *string `gorm:"-:migration"`
+	OciArtifact *string `gorm:"-:migration"`
 }
 
 func (DependencyRef) TableName() string {
 	return "dependency_refs"
 }
 
-// SecretDependencyRef is a flat row returned by ListSecretDependencyTargets.
+// SecretDependencyRef is a flat row returned by ListSecretDependencyTargets and
+// ListNativeSecretDependencyTargets.
 // One row per (org, fleet/device) referencing the queried secret. The query is
 // cross-org because the K8s informer has no org context — it only knows
 // (namespace, name) from the watch event.
@@ -104,3 +110,27 @@ type HttpDependencyProbe struct {
 	DeviceNames    StringArray
 	RepoSpec       *JSONField[domain.RepositorySpec] `gorm:"type:jsonb"`
 }
+
+// VaultDependencyProbe is the result of ListDueVaultDependencies — one row per
+// unique (repository_name, vault_path) pair that is due for polling.
+type VaultDependencyProbe struct {
+	RepositoryName string
+	VaultPath      string
+	Fingerprint    *string
+	FleetNames     StringArray
+	DeviceNames    StringArray
+	RepoSpec       *JSONField[domain.RepositorySpec] `gorm:"type:jsonb"`
+}
+
+// OciDependencyProbe is the result of ListDueOciDependencies — one row per
+// unique (repository_name, oci_artifact, revision) triple that is due for
+// polling. Revision holds the tag or digest the artifact is referenced by.
+type OciDependencyProbe struct {
+	RepositoryName string
+	OciArtifact    string
+	Revision       string
+	Fingerprint    *string
+	FleetNames     StringArray
+	DeviceNames    StringArray
+	RepoSpec       *JSONField[domain.RepositorySpec] `gorm:"type:jsonb"`
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// DeviceRenderedVersion retains a rendered version of a device's specification so that it can
+// be inspected, compared and re-delivered by a rollback. Rows are removed together with the
+// device and trimmed per device on insert.
+type DeviceRenderedVersion struct {
+	OrgID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	DeviceName      string    `gorm:"type:text;primaryKey"`
+	RenderedVersion int64     `gorm:"primaryKey;autoIncrement:false"`
+	TemplateVersion string    `gorm:"type:text"`
+
+	// The rendered version this version restored, if it was delivered by a rollback.
+	RollbackOf *int64
+
+	CreatedAt time.Time `gorm:"type:timestamptz;not null"`
+
+	// Encrypted at rest as a whole blob, like Device.RenderedConfig.
+	RenderedConfig *JSONField[json.RawMessage] `gorm:"type:jsonb"`
+
+	// Encrypted at rest as a whole blob, like Device.RenderedApplications.
+	RenderedApplications *JSONField[json.RawMessage] `gorm:"type:jsonb"`
+
+	RenderedOs *JSONField[domain.DeviceOsSpec] `gorm:"type:jsonb"`
+
+	Device *Device `gorm:"foreignKey:OrgID,DeviceName;references:OrgID,Name;constraint:OnDelete:CASCADE"`
+}
+
+func (DeviceRenderedVersion) TableName() string {
+	return "device_rendered_versions"
+}
+
+// ToApiResource converts the row to its API representation. The rendered specification is
+// only decrypted and included when withSpec is set.
+func (v *DeviceRenderedVersion) ToApiResource(ctx context.Context, withSpec bool) (*domain.DeviceRenderedVersion, error) {
+	result := &domain.DeviceRenderedVersion{
+		RenderedVersion: strconv.FormatInt(v.RenderedVersion, 10),
+		CreatedAt:       v.CreatedAt.UTC(),
+	}
+	if v.TemplateVersion != "" {
+		result.TemplateVersion = &v.TemplateVersion
+	}
+	if v.RollbackOf != nil {
+		result.RollbackOf = lo.ToPtr(strconv.FormatInt(*v.RollbackOf, 10))
+	}
+	if !withSpec {
+		return result, nil
+	}
+
+	spec := domain.DeviceSpec{}
+	if data, err := decryptRenderedField(ctx, v.RenderedConfig); err != nil {
+		return nil, fmt.Errorf("decrypt rendered config: %w", err)
+	} else if data != nil {
+		var config []domain.ConfigProviderSpec
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("unmarshal rendered config: %w", err)
+		}
+		spec.Config = &config
+	}
+	if data, err := decryptRenderedField(ctx, v.RenderedApplications); err != nil {
+		return nil, fmt.Errorf("decrypt rendered applications: %w", err)
+	} else if data != nil {
+		var apps []domain.ApplicationProviderSpec
+		if err := json.Unmarshal(data, &apps); err != nil {
+			return nil, fmt.Errorf("unmarshal rendered applications: %w", err)
+		}
+		spec.Applications = &apps
+	}
+	if v.RenderedOs != nil && v.RenderedOs.Data.Image != "" {
+		spec.Os = &v.RenderedOs.Data
+	}
+	result.Spec = &spec
+	return result, nil
+}
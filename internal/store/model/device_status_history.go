@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+)
+
+// DeviceStatusHistory records a significant transition of a device's status. Rows are
+// removed together with the device, trimmed per device on insert and aged out by the
+// retention task.
+type DeviceStatusHistory struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement"`
+	OrgID      uuid.UUID `gorm:"type:uuid;not null;index:idx_device_status_history_device,priority:1"`
+	DeviceName string    `gorm:"type:text;not null;index:idx_device_status_history_device,priority:2"`
+	Timestamp  time.Time `gorm:"type:timestamptz;not null;index:idx_device_status_history_device,priority:3;index"`
+
+	Entry *JSONField[domain.DeviceStatusHistoryEntry] `gorm:"type:jsonb"`
+
+	Device *Device `gorm:"foreignKey:OrgID,DeviceName;references:OrgID,Name;constraint:OnDelete:CASCADE"`
+}
+
+func (DeviceStatusHistory) TableName() string {
+	return "device_status_history"
+}
@@ -35,9 +35,14 @@ var encryptionRegistry = []EncryptedField{
 	{domain.RepositoryKind, []string{"Spec", "sshConfig", "sshPrivateKey"}},
 	{domain.RepositoryKind, []string{"Spec", "sshConfig", "privateKeyPassphrase"}},
 	{domain.RepositoryKind, []string{"Spec", "ociAuth", "password"}},
+	{domain.RepositoryKind, []string{"Spec", "vaultAuth", "token"}},
+	{domain.RepositoryKind, []string{"Spec", "vaultAuth", "secretId"}},
 	{domain.AuthProviderKind, []string{"Spec", "clientSecret"}},
 	{domain.DeviceKind, []string{"RenderedConfig"}},
 	{domain.DeviceKind, []string{"RenderedApplications"}},
+	{domain.DeviceRenderedVersionKind, []string{"RenderedConfig"}},
+	{domain.DeviceRenderedVersionKind, []string{"RenderedApplications"}},
+	{domain.SecretKind, []string{"Spec"}},
 }
 
 // encryptionPathsByKind is built from encryptionRegistry on init for O(1) lookup.
@@ -78,9 +83,11 @@ func hashFields(fields []EncryptedField) string {
 // EncryptionHandlers returns encryption handlers for all model types.
 func EncryptionHandlers() map[string]encryption.ModelEncryptHandler {
 	return map[string]encryption.ModelEncryptHandler{
-		domain.RepositoryKind:   genericEncryptHandler(domain.RepositoryKind),
-		domain.AuthProviderKind: genericEncryptHandler(domain.AuthProviderKind),
-		domain.DeviceKind:       genericEncryptHandler(domain.DeviceKind),
+		domain.RepositoryKind:            genericEncryptHandler(domain.RepositoryKind),
+		domain.AuthProviderKind:          genericEncryptHandler(domain.AuthProviderKind),
+		domain.DeviceKind:                genericEncryptHandler(domain.DeviceKind),
+		domain.DeviceRenderedVersionKind: genericEncryptHandler(domain.DeviceRenderedVersionKind),
+		domain.SecretKind:                genericEncryptHandler(domain.SecretKind),
 	}
 }
 
@@ -148,11 +148,13 @@ func TestEncryptionHandlers_Registry(t *testing.T) {
 	_ = setupEncryption(t)
 
 	handlers := EncryptionHandlers()
-	require.Len(t, handlers, 3, "Should have 3 handlers registered")
+	require.Len(t, handlers, 5, "Should have 5 handlers registered")
 
 	require.Contains(t, handlers, domain.RepositoryKind)
 	require.Contains(t, handlers, domain.AuthProviderKind)
 	require.Contains(t, handlers, domain.DeviceKind)
+	require.Contains(t, handlers, domain.DeviceRenderedVersionKind)
+	require.Contains(t, handlers, domain.SecretKind)
 
 	noopEncrypt := func(_ context.Context, data []byte) ([]byte, error) {
 		return data, nil
@@ -170,6 +172,10 @@ func TestEncryptionHandlers_Registry(t *testing.T) {
 				model = &AuthProvider{}
 			case domain.DeviceKind:
 				model = &Device{}
+			case domain.DeviceRenderedVersionKind:
+				model = &DeviceRenderedVersion{}
+			case domain.SecretKind:
+				model = &Secret{}
 			default:
 				t.Fatalf("Unknown model type: %s", modelName)
 			}
@@ -211,7 +217,7 @@ func TestRegistryHash_ChangesWhenFieldAdded(t *testing.T) {
 
 func TestPathsForKind_AllKinds(t *testing.T) {
 	repoPaths := PathsForKind(domain.RepositoryKind)
-	require.Len(t, repoPaths, 7, "Repository should have 7 encrypted paths")
+	require.Len(t, repoPaths, 9, "Repository should have 9 encrypted paths")
 
 	for _, p := range repoPaths {
 		require.True(t, len(p) >= 2, "Each path should have at least 2 segments (field + key)")
@@ -227,6 +233,15 @@ func TestPathsForKind_AllKinds(t *testing.T) {
 	require.Equal(t, []string{"RenderedConfig"}, devicePaths[0])
 	require.Equal(t, []string{"RenderedApplications"}, devicePaths[1])
 
+	renderedVersionPaths := PathsForKind(domain.DeviceRenderedVersionKind)
+	require.Len(t, renderedVersionPaths, 2, "DeviceRenderedVersion should have 2 encrypted paths")
+	require.Equal(t, []string{"RenderedConfig"}, renderedVersionPaths[0])
+	require.Equal(t, []string{"RenderedApplications"}, renderedVersionPaths[1])
+
+	secretPaths := PathsForKind(domain.SecretKind)
+	require.Len(t, secretPaths, 1, "Secret should have 1 encrypted path")
+	require.Equal(t, []string{"Spec"}, secretPaths[0])
+
 	unknownPaths := PathsForKind("UnknownKind")
 	require.Nil(t, unknownPaths, "Unknown kind should return nil")
 }
@@ -195,3 +195,6 @@ var _ ResourceInterface = (*ResourceSync)(nil)
 var _ ResourceInterface = (*TemplateVersion)(nil)
 var _ ResourceInterface = (*Event)(nil)
 var _ ResourceInterface = (*AuthProvider)(nil)
+var _ ResourceInterface = (*VulnerabilityException)(nil)
+var _ ResourceInterface = (*Secret)(nil)
+var _ ResourceInterface = (*BulkOperation)(nil)
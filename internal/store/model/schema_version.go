@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// SchemaVersion records a versioned migration that has been applied to the database. The
+// schema version of the database is the highest applied version.
+type SchemaVersion struct {
+	Version     int64     `gorm:"primaryKey;autoIncrement:false"`
+	Description string    `gorm:"not null"`
+	AppliedAt   time.Time `gorm:"not null"`
+}
+
+func (SchemaVersion) TableName() string {
+	return "schema_version"
+}
@@ -0,0 +1,167 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// Secret holds sensitive data for devices. Its spec is encrypted at rest as a whole (see
+// encryptionRegistry), so it is stored as raw JSON rather than as a typed spec.
+type Secret struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[json.RawMessage] `gorm:"type:jsonb"`
+}
+
+func (s *Secret) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
+
+func NewSecretFromApiResource(resource *domain.Secret) (*Secret, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &Secret{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+
+	spec, err := json.Marshal(resource.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal secret spec: %w", err)
+	}
+
+	return &Secret{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtr(resource.Metadata.Annotations),
+			Generation:      clonePtr(resource.Metadata.Generation),
+			Owner:           clonePtr(resource.Metadata.Owner),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(json.RawMessage(spec)),
+	}, nil
+}
+
+func SecretAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.SecretAPIVersion)
+}
+
+// decryptSecretSpec returns the plaintext spec of a secret, whether it is stored encrypted or not.
+func decryptSecretSpec(field *JSONField[json.RawMessage]) (domain.SecretSpec, error) {
+	spec := domain.SecretSpec{Data: map[string]string{}}
+	raw, err := decryptRenderedField(context.Background(), field)
+	if err != nil {
+		return spec, fmt.Errorf("decrypt secret spec: %w", err)
+	}
+	if raw == nil {
+		return spec, nil
+	}
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return spec, fmt.Errorf("unmarshal secret spec: %w", err)
+	}
+	if spec.Data == nil {
+		spec.Data = map[string]string{}
+	}
+	return spec, nil
+}
+
+func (s *Secret) ToApiResource(opts ...APIResourceOption) (*domain.Secret, error) {
+	if s == nil {
+		return &domain.Secret{}, nil
+	}
+
+	spec, err := decryptSecretSpec(s.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Secret{
+		ApiVersion: SecretAPIVersion(),
+		Kind:       domain.SecretKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(s.Name),
+			CreationTimestamp: lo.ToPtr(s.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(s.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(s.Resource.Annotations)),
+			Generation:        clonePtr(s.Generation),
+			Owner:             clonePtr(s.Owner),
+			ResourceVersion:   lo.Ternary(s.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(s.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func SecretsToApiResource(secrets []Secret, cont *string, numRemaining *int64) (domain.SecretList, error) {
+	items := make([]domain.Secret, len(secrets))
+	for i, secret := range secrets {
+		apiResource, err := secret.ToApiResource()
+		if err != nil {
+			return domain.SecretList{}, err
+		}
+		items[i] = *apiResource
+	}
+	ret := domain.SecretList{
+		ApiVersion: SecretAPIVersion(),
+		Kind:       domain.SecretListKind,
+		Items:      items,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (s *Secret) GetKind() string {
+	return domain.SecretKind
+}
+
+func (s *Secret) HasNilSpec() bool {
+	return s.Spec == nil
+}
+
+// HasSameSpecAs compares the plaintext specs, as one side may be freshly read (encrypted) and the
+// other built from an API request (not yet encrypted).
+func (s *Secret) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*Secret)
+	if !ok || other == nil {
+		return false
+	}
+	if s.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if s.Spec == nil || other.Spec == nil {
+		return false
+	}
+	spec, err := decryptSecretSpec(s.Spec)
+	if err != nil {
+		return false
+	}
+	otherSpec, err := decryptSecretSpec(other.Spec)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(spec, otherSpec)
+}
+
+func (s *Secret) GetStatusAsJson() ([]byte, error) {
+	return []byte("null"), nil
+}
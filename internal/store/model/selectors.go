@@ -9,7 +9,7 @@ import (
 
 // Define additional custom selectors for various resources,
 // including Device, Fleet, EnrollmentRequest, ResourceSync, Repository,
-// and CertificateSigningRequest. Each resource is equipped with a set of selectors
+// CertificateSigningRequest, and VulnerabilityException. Each resource is equipped with a set of selectors
 // that map field paths to their corresponding types.
 type selectorToTypeMap map[selector.SelectorName]selector.SelectorType
 
@@ -45,6 +45,12 @@ var (
 	certificateSigningRequestStatusSelectors = selectorToTypeMap{
 		selector.NewSelectorName("status.certificate"): selector.String,
 	}
+	vulnerabilityExceptionSpecSelectors = selectorToTypeMap{
+		selector.NewSelectorName("spec.cveId"):             selector.String,
+		selector.NewSelectorName("spec.state"):             selector.String,
+		selector.NewSelectorName("spec.scope.imageDigest"): selector.String,
+		selector.NewSelectorName("spec.scope.fleet"):       selector.String,
+	}
 )
 
 func (m *Device) MapSelectorName(name selector.SelectorName) []selector.SelectorName {
@@ -169,6 +175,21 @@ func (m *CertificateSigningRequest) ListSelectors() selector.SelectorNameSet {
 	return selector.NewSelectorFieldNameSet().Add(keys...)
 }
 
+func (m *VulnerabilityException) ResolveSelector(name selector.SelectorName) (*selector.SelectorField, error) {
+	if typ, exists := vulnerabilityExceptionSpecSelectors[name]; exists {
+		return makeJSONBSelectorField(name, typ)
+	}
+	return nil, fmt.Errorf("unable to resolve selector for vulnerability exception")
+}
+
+func (m *VulnerabilityException) ListSelectors() selector.SelectorNameSet {
+	keys := make([]selector.SelectorName, 0, len(vulnerabilityExceptionSpecSelectors))
+	for sn := range vulnerabilityExceptionSpecSelectors {
+		keys = append(keys, sn)
+	}
+	return selector.NewSelectorFieldNameSet().Add(keys...)
+}
+
 func makeJSONBSelectorField(selectorName selector.SelectorName, selectorType selector.SelectorType) (*selector.SelectorField, error) {
 	selectorStr := selectorName.String()
 	if len(selectorStr) == 0 {
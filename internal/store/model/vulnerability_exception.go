@@ -0,0 +1,128 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// VulnerabilityException records an operator decision to suppress a CVE
+// for a given scope. It has no status; expiry is evaluated at query time.
+type VulnerabilityException struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.VulnerabilityExceptionSpec] `gorm:"type:jsonb"`
+}
+
+func (e *VulnerabilityException) String() string {
+	val, _ := json.Marshal(e)
+	return string(val)
+}
+
+func NewVulnerabilityExceptionFromApiResource(resource *domain.VulnerabilityException) (*VulnerabilityException, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &VulnerabilityException{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &VulnerabilityException{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtr(resource.Metadata.Annotations),
+			Generation:      clonePtr(resource.Metadata.Generation),
+			Owner:           clonePtr(resource.Metadata.Owner),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func VulnerabilityExceptionAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.VulnerabilityAPIVersion)
+}
+
+func (e *VulnerabilityException) ToApiResource(opts ...APIResourceOption) (*domain.VulnerabilityException, error) {
+	if e == nil {
+		return &domain.VulnerabilityException{}, nil
+	}
+
+	var spec domain.VulnerabilityExceptionSpec
+	if e.Spec != nil {
+		spec = e.Spec.Data
+	}
+
+	return &domain.VulnerabilityException{
+		ApiVersion: VulnerabilityExceptionAPIVersion(),
+		Kind:       domain.VulnerabilityExceptionKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(e.Name),
+			CreationTimestamp: lo.ToPtr(e.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(e.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(e.Resource.Annotations)),
+			Generation:        clonePtr(e.Generation),
+			Owner:             clonePtr(e.Owner),
+			ResourceVersion:   lo.Ternary(e.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(e.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func VulnerabilityExceptionsToApiResource(exceptions []VulnerabilityException, cont *string, numRemaining *int64) (domain.VulnerabilityExceptionList, error) {
+	items := make([]domain.VulnerabilityException, len(exceptions))
+	for i, exception := range exceptions {
+		apiResource, _ := exception.ToApiResource()
+		items[i] = *apiResource
+	}
+	ret := domain.VulnerabilityExceptionList{
+		ApiVersion: VulnerabilityExceptionAPIVersion(),
+		Kind:       domain.VulnerabilityExceptionListKind,
+		Items:      items,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (e *VulnerabilityException) GetKind() string {
+	return domain.VulnerabilityExceptionKind
+}
+
+func (e *VulnerabilityException) HasNilSpec() bool {
+	return e.Spec == nil
+}
+
+func (e *VulnerabilityException) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*VulnerabilityException)
+	if !ok || other == nil {
+		return false
+	}
+	if e.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if e.Spec == nil || other.Spec == nil {
+		return false
+	}
+	return reflect.DeepEqual(e.Spec.Data, other.Spec.Data)
+}
+
+func (e *VulnerabilityException) GetStatusAsJson() ([]byte, error) {
+	return []byte("null"), nil
+}
@@ -103,3 +103,13 @@ type VulnerabilityFinding struct {
 	FirstSeenAt time.Time                       `gorm:"type:timestamptz;not null;default:now()"`
 	UpdatedAt   time.Time                       `gorm:"type:timestamptz;not null;autoUpdateTime"`
 }
+
+// ImageSBOM is an SBOM document generated by the image builder for an image it pushed, keyed by
+// the image's manifest digest. The offline vulnerability scanner matches it against imported
+// advisories.
+type ImageSBOM struct {
+	ImageDigest string    `gorm:"type:text;not null;primaryKey"`
+	Document    []byte    `gorm:"type:bytea;not null"`
+	CreatedAt   time.Time `gorm:"type:timestamptz;not null;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"type:timestamptz;not null;autoUpdateTime"`
+}
@@ -0,0 +1,111 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Store interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, callbackEvent store.EventCallback) (*domain.Secret, error)
+	Update(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, callbackEvent store.EventCallback) (*domain.Secret, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, secret *domain.Secret, callbackEvent store.EventCallback) (*domain.Secret, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.SecretList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, callbackEvent store.EventCallback) error
+}
+
+type SecretStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *store.GenericStore[*model.Secret, model.Secret, domain.Secret, domain.SecretList]
+	eventCallbackCaller store.EventCallbackCaller
+}
+
+// Make sure we conform to the Store interface
+var _ Store = (*SecretStore)(nil)
+
+func NewSecretStore(db *gorm.DB, log logrus.FieldLogger) Store {
+	genericStore := store.NewGenericStore[*model.Secret, model.Secret, domain.Secret, domain.SecretList](
+		db,
+		log,
+		model.NewSecretFromApiResource,
+		(*model.Secret).ToApiResource,
+		model.SecretsToApiResource,
+	)
+	return &SecretStore{
+		dbHandler:           db,
+		log:                 log,
+		genericStore:        genericStore,
+		eventCallbackCaller: store.CallEventCallback(domain.SecretKind, log),
+	}
+}
+
+func (s *SecretStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *SecretStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.Secret{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for Secret labels
+	if !db.Migrator().HasIndex(&model.Secret{}, "idx_secrets_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_secrets_labels ON secrets USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.Secret{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *SecretStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback store.EventCallback) (*domain.Secret, error) {
+	secret, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, secret, true, err)
+	return secret, err
+}
+
+func (s *SecretStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback store.EventCallback) (*domain.Secret, error) {
+	newSecret, oldSecret, err := s.genericStore.Update(ctx, orgId, resource, nil, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecret, newSecret, false, err)
+	return newSecret, err
+}
+
+func (s *SecretStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.Secret, eventCallback store.EventCallback) (*domain.Secret, bool, error) {
+	newSecret, oldSecret, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSecret, newSecret, created, err)
+	return newSecret, created, err
+}
+
+func (s *SecretStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Secret, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *SecretStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.SecretList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *SecretStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.Secret{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	}
+	return err
+}
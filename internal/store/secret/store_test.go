@@ -0,0 +1,18 @@
+package secret
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewSecretStore_ReturnsNonNilStore proves the constructor builds a
+// working Store without dialing the database.
+func TestNewSecretStore_ReturnsNonNilStore(t *testing.T) {
+	req := require.New(t)
+
+	s := NewSecretStore(nil, logrus.New())
+
+	req.NotNil(s)
+}
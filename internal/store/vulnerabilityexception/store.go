@@ -0,0 +1,120 @@
+package vulnerabilityexception
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Store interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, exception *domain.VulnerabilityException, callbackEvent store.EventCallback) (*domain.VulnerabilityException, error)
+	Update(ctx context.Context, orgId uuid.UUID, exception *domain.VulnerabilityException, callbackEvent store.EventCallback) (*domain.VulnerabilityException, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, exception *domain.VulnerabilityException, callbackEvent store.EventCallback) (*domain.VulnerabilityException, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.VulnerabilityException, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.VulnerabilityExceptionList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, callbackEvent store.EventCallback) error
+}
+
+type VulnerabilityExceptionStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *store.GenericStore[*model.VulnerabilityException, model.VulnerabilityException, domain.VulnerabilityException, domain.VulnerabilityExceptionList]
+	eventCallbackCaller store.EventCallbackCaller
+}
+
+// Make sure we conform to the Store interface
+var _ Store = (*VulnerabilityExceptionStore)(nil)
+
+func NewVulnerabilityExceptionStore(db *gorm.DB, log logrus.FieldLogger) Store {
+	genericStore := store.NewGenericStore[*model.VulnerabilityException, model.VulnerabilityException, domain.VulnerabilityException, domain.VulnerabilityExceptionList](
+		db,
+		log,
+		model.NewVulnerabilityExceptionFromApiResource,
+		(*model.VulnerabilityException).ToApiResource,
+		model.VulnerabilityExceptionsToApiResource,
+	)
+	return &VulnerabilityExceptionStore{
+		dbHandler:           db,
+		log:                 log,
+		genericStore:        genericStore,
+		eventCallbackCaller: store.CallEventCallback(domain.VulnerabilityExceptionKind, log),
+	}
+}
+
+func (s *VulnerabilityExceptionStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *VulnerabilityExceptionStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.VulnerabilityException{}); err != nil {
+		return err
+	}
+
+	// Exceptions are resolved per CVE at query time, so index the CVE ID inside the spec.
+	if !db.Migrator().HasIndex(&model.VulnerabilityException{}, "idx_vulnerability_exceptions_cve_id") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_vulnerability_exceptions_cve_id ON vulnerability_exceptions ((spec->>'cveId'))").Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for VulnerabilityException labels
+	if !db.Migrator().HasIndex(&model.VulnerabilityException{}, "idx_vulnerability_exceptions_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_vulnerability_exceptions_labels ON vulnerability_exceptions USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.VulnerabilityException{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *VulnerabilityExceptionStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.VulnerabilityException, eventCallback store.EventCallback) (*domain.VulnerabilityException, error) {
+	exception, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, exception, true, err)
+	return exception, err
+}
+
+func (s *VulnerabilityExceptionStore) Update(ctx context.Context, orgId uuid.UUID, resource *domain.VulnerabilityException, eventCallback store.EventCallback) (*domain.VulnerabilityException, error) {
+	newException, oldException, err := s.genericStore.Update(ctx, orgId, resource, nil, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldException, newException, false, err)
+	return newException, err
+}
+
+func (s *VulnerabilityExceptionStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.VulnerabilityException, eventCallback store.EventCallback) (*domain.VulnerabilityException, bool, error) {
+	newException, oldException, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldException, newException, created, err)
+	return newException, created, err
+}
+
+func (s *VulnerabilityExceptionStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.VulnerabilityException, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *VulnerabilityExceptionStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.VulnerabilityExceptionList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *VulnerabilityExceptionStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.VulnerabilityException{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	}
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -113,6 +114,67 @@ type VulnerabilityGroup struct {
 	Findings       []model.VulnerabilityFinding
 }
 
+// FindingExclusions lists the findings that VulnerabilityExceptions suppress. ByDigest maps an
+// image digest to the excepted CVE IDs for that digest; ByOwner maps a device owner (for example
+// Fleet/edge) to the excepted CVE IDs for devices with that owner. ByOwner is only honoured by
+// queries that join devices. A nil *FindingExclusions disables filtering.
+type FindingExclusions struct {
+	ByDigest map[string][]string
+	ByOwner  map[string][]string
+}
+
+// IsEmpty reports whether the exclusions would filter nothing.
+func (e *FindingExclusions) IsEmpty() bool {
+	return e == nil || (len(e.ByDigest) == 0 && len(e.ByOwner) == 0)
+}
+
+// applyDigests adds a NOT (...) clause removing the excepted (image_digest, cve_id) pairs.
+// findingAlias is the vulnerability_findings alias, or empty for unqualified columns.
+func (e *FindingExclusions) applyDigests(query *gorm.DB, findingAlias string) *gorm.DB {
+	if e == nil {
+		return query
+	}
+	return applyExclusionPairs(query, e.ByDigest, qualifyColumn(findingAlias, "image_digest"), qualifyColumn(findingAlias, "cve_id"))
+}
+
+// applyOwners adds a NOT (...) clause removing excepted CVEs for devices with the given owners.
+func (e *FindingExclusions) applyOwners(query *gorm.DB, deviceAlias, findingAlias string) *gorm.DB {
+	if e == nil {
+		return query
+	}
+	return applyExclusionPairs(query, e.ByOwner, qualifyColumn(deviceAlias, "owner"), qualifyColumn(findingAlias, "cve_id"))
+}
+
+func applyExclusionPairs(query *gorm.DB, pairs map[string][]string, keyColumn, cveColumn string) *gorm.DB {
+	keys := make([]string, 0, len(pairs))
+	for key, cves := range pairs {
+		if len(cves) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return query
+	}
+	sort.Strings(keys)
+
+	conds := make([]string, 0, len(keys))
+	args := make([]any, 0, 2*len(keys))
+	for _, key := range keys {
+		conds = append(conds, fmt.Sprintf("(%s = ? AND %s IN ?)", keyColumn, cveColumn))
+		args = append(args, key, pairs[key])
+	}
+	// A key that is NULL, such as the owner of a device without one, makes the conditions NULL
+	// rather than false, which must not exclude the row.
+	return query.Where("NOT COALESCE("+strings.Join(conds, " OR ")+", FALSE)", args...)
+}
+
+func qualifyColumn(alias, column string) string {
+	if alias == "" {
+		return column
+	}
+	return alias + "." + column
+}
+
 type Store interface {
 	InitialMigration(ctx context.Context) error
 	ListDeployedImageDigests(ctx context.Context) ([]string, error)
@@ -123,9 +185,9 @@ type Store interface {
 	// GetVulnerabilities returns paginated findings for a single image digest.
 	// Supports cursor-based pagination via store.ListParams.Continue and sorting via store.ListParams.SortColumns
 	// (store.SortBySeverity, store.SortByCvssScore, store.SortByPublishedAt, store.SortByCveId).
-	GetVulnerabilities(ctx context.Context, digest string, listParams store.ListParams) (*VulnerabilityListResult, error)
+	GetVulnerabilities(ctx context.Context, digest string, listParams store.ListParams, exclusions *FindingExclusions) (*VulnerabilityListResult, error)
 	// GetVulnerabilitySummary returns the severity counts for a single image digest.
-	GetVulnerabilitySummary(ctx context.Context, digest string, fieldSelector *selector.FieldSelector) (SeverityCounts, error)
+	GetVulnerabilitySummary(ctx context.Context, digest string, fieldSelector *selector.FieldSelector, exclusions *FindingExclusions) (SeverityCounts, error)
 	// ListFleetDeviceImageDigests returns the (image, imageDigest, deviceCount) rows for all
 	// non-deleted devices that belong to the given fleet within the given org.
 	ListFleetDeviceImageDigests(ctx context.Context, orgId uuid.UUID, fleetName string) ([]DeviceImageDigest, error)
@@ -135,21 +197,21 @@ type Store interface {
 	// GetVulnerabilityGroups returns findings grouped by CVE across multiple image digests.
 	// Supports cursor-based pagination via store.ListParams.Continue and sorting via store.ListParams.SortColumns
 	// (store.SortBySeverity, store.SortByCvssScore, store.SortByPublishedAt, store.SortByCveId).
-	GetVulnerabilityGroups(ctx context.Context, digests []DeviceImageDigest, listParams store.ListParams) (*VulnerabilityGroupResult, error)
+	GetVulnerabilityGroups(ctx context.Context, digests []DeviceImageDigest, listParams store.ListParams, exclusions *FindingExclusions) (*VulnerabilityGroupResult, error)
 	// GetFleetVulnerabilitySummary returns the severity summary across multiple image digests using SQL aggregation.
-	GetFleetVulnerabilitySummary(ctx context.Context, digests []DeviceImageDigest, fieldSelector *selector.FieldSelector) (FleetSeverityCounts, error)
+	GetFleetVulnerabilitySummary(ctx context.Context, digests []DeviceImageDigest, fieldSelector *selector.FieldSelector, exclusions *FindingExclusions) (FleetSeverityCounts, error)
 
 	// GetOrgVulnerabilitySummary returns per-severity CVE and affected-device counts for an organization.
-	GetOrgVulnerabilitySummary(ctx context.Context, orgID uuid.UUID) (OrgVulnerabilitySummary, error)
+	GetOrgVulnerabilitySummary(ctx context.Context, orgID uuid.UUID, exclusions *FindingExclusions) (OrgVulnerabilitySummary, error)
 	// FindAnyFindingForCVE returns any finding row for the CVE, or nil if none exist.
 	FindAnyFindingForCVE(ctx context.Context, cveID string) (*model.VulnerabilityFinding, error)
 	// FindingsForCVEAndImageDigests returns findings for the CVE limited to the given digests (empty digests => empty slice).
 	FindingsForCVEAndImageDigests(ctx context.Context, cveID string, imageDigests []string) ([]model.VulnerabilityFinding, error)
 	// GetVulnerabilityImpactPage returns one page of fleets with fleet totals, digest lists, and top-level CVE aggregates.
 	// Supports cursor-based pagination via store.ListParams.Continue and sorting via store.ListParams.SortColumns (store.SortByOwner or store.SortByDeviceAffected).
-	GetVulnerabilityImpactPage(ctx context.Context, orgID uuid.UUID, cveID string, listParams store.ListParams) (*ImpactPageResult, error)
+	GetVulnerabilityImpactPage(ctx context.Context, orgID uuid.UUID, cveID string, listParams store.ListParams, exclusions *FindingExclusions) (*ImpactPageResult, error)
 	// GetImpactDigestDetails returns per-(owner, digest) device counts and vulnerability metadata for the given owners.
-	GetImpactDigestDetails(ctx context.Context, orgID uuid.UUID, cveID string, owners []string, includeNull bool) ([]ImpactDigestDetail, error)
+	GetImpactDigestDetails(ctx context.Context, orgID uuid.UUID, cveID string, owners []string, includeNull bool, exclusions *FindingExclusions) ([]ImpactDigestDetail, error)
 
 	// ListCVEEventResolutionCandidates returns open Warning/Critical CVE events that merit a Resolved event (PostgreSQL only).
 	ListCVEEventResolutionCandidates(ctx context.Context) ([]CVEEventResolutionCandidate, error)
@@ -166,6 +228,12 @@ type Store interface {
 	// BatchUpdateOpenEvents applies CVE event actions to device_open_cve_events using batch INSERT/UPDATE/DELETE.
 	// Returns the actions that were actually applied (for event emission).
 	BatchUpdateOpenEvents(ctx context.Context, actions []model.CVEEventAction) ([]model.CVEEventAction, error)
+
+	// UpsertImageSBOM stores the SBOM document for an image digest, replacing any earlier one.
+	UpsertImageSBOM(ctx context.Context, imageDigest string, document []byte) error
+	// GetImageSBOMs returns the stored SBOM documents for the given digests. Digests without an
+	// SBOM are absent from the returned map.
+	GetImageSBOMs(ctx context.Context, imageDigests []string) (map[string][]byte, error)
 }
 
 type VulnerabilityFindingStore struct {
@@ -384,8 +452,10 @@ func (s *VulnerabilityFindingStore) GetVulnerabilities(
 	ctx context.Context,
 	digest string,
 	listParams store.ListParams,
+	exclusions *FindingExclusions,
 ) (*VulnerabilityListResult, error) {
 	query := s.getDB(ctx).Model(&model.VulnerabilityFinding{}).Where("image_digest = ?", digest)
+	query = exclusions.applyDigests(query, "")
 
 	if listParams.FieldSelector != nil {
 		q, p, err := listParams.FieldSelector.Parse(ctx, &vulnerabilityFindingResolver{})
@@ -429,6 +499,7 @@ func (s *VulnerabilityFindingStore) GetVulnerabilities(
 		nextContinue = buildVulnerabilityContinueToken(findings[len(findings)-1], listParams, func() int64 {
 			var total int64
 			countQuery := s.getDB(ctx).Model(&model.VulnerabilityFinding{}).Where("image_digest = ?", digest)
+			countQuery = exclusions.applyDigests(countQuery, "")
 			if listParams.FieldSelector != nil {
 				q, p, _ := listParams.FieldSelector.Parse(ctx, &vulnerabilityFindingResolver{})
 				countQuery = countQuery.Where(q, p...)
@@ -449,8 +520,10 @@ func (s *VulnerabilityFindingStore) GetVulnerabilitySummary(
 	ctx context.Context,
 	digest string,
 	fs *selector.FieldSelector,
+	exclusions *FindingExclusions,
 ) (SeverityCounts, error) {
 	query := s.getDB(ctx).Model(&model.VulnerabilityFinding{}).Where("image_digest = ?", digest)
+	query = exclusions.applyDigests(query, "")
 	if fs != nil {
 		q, p, err := fs.Parse(ctx, &vulnerabilityFindingResolver{})
 		if err != nil {
@@ -557,7 +630,7 @@ func (s *VulnerabilityFindingStore) ListOrgDeviceImageDigests(
 	return digests, nil
 }
 
-func (s *VulnerabilityFindingStore) GetOrgVulnerabilitySummary(ctx context.Context, orgID uuid.UUID) (OrgVulnerabilitySummary, error) {
+func (s *VulnerabilityFindingStore) GetOrgVulnerabilitySummary(ctx context.Context, orgID uuid.UUID, exclusions *FindingExclusions) (OrgVulnerabilitySummary, error) {
 	type severityRankRow struct {
 		SeverityRank int
 		Count        int64
@@ -570,8 +643,9 @@ func (s *VulnerabilityFindingStore) GetOrgVulnerabilitySummary(ctx context.Conte
 		Table("vulnerability_findings AS vf").
 		Select("vf.cve_id, MIN("+severityOrderExpr+") AS severity_rank").
 		Joins("INNER JOIN devices AS d ON d.status->'os'->>'imageDigest' = vf.image_digest AND d.org_id = ? AND d.deleted_at IS NULL", orgID).
-		Where("vf.status <> ?", model.VulnerabilityStatusNotAffected).
-		Group("vf.cve_id")
+		Where("vf.status <> ?", model.VulnerabilityStatusNotAffected)
+	worstPerCVE = exclusions.applyDigests(worstPerCVE, "vf")
+	worstPerCVE = exclusions.applyOwners(worstPerCVE, "d", "vf").Group("vf.cve_id")
 
 	var rows []severityRankRow
 	err := s.getDB(ctx).
@@ -633,6 +707,7 @@ func (s *VulnerabilityFindingStore) GetVulnerabilityImpactPage(
 	orgID uuid.UUID,
 	cveID string,
 	listParams store.ListParams,
+	exclusions *FindingExclusions,
 ) (*ImpactPageResult, error) {
 	// Base query: devices with CVE findings.
 	baseWhere := s.getDB(ctx).
@@ -644,6 +719,8 @@ func (s *VulnerabilityFindingStore) GetVulnerabilityImpactPage(
 		Where("vf.status <> ?", model.VulnerabilityStatusNotAffected).
 		Where("(d.status->'os'->>'imageDigest') IS NOT NULL").
 		Where("(d.status->'os'->>'imageDigest') <> ''")
+	baseWhere = exclusions.applyDigests(baseWhere, "vf")
+	baseWhere = exclusions.applyOwners(baseWhere, "d", "vf")
 
 	if listParams.FieldSelector != nil {
 		q, p, err := listParams.FieldSelector.Parse(ctx, &vulnerabilityImpactResolver{})
@@ -731,6 +808,7 @@ func (s *VulnerabilityFindingStore) GetImpactDigestDetails(
 	cveID string,
 	owners []string,
 	includeNull bool,
+	exclusions *FindingExclusions,
 ) ([]ImpactDigestDetail, error) {
 	query := s.getDB(ctx).
 		Table("devices AS d").
@@ -754,6 +832,8 @@ func (s *VulnerabilityFindingStore) GetImpactDigestDetails(
 		Where("vf.status <> ?", model.VulnerabilityStatusNotAffected).
 		Where("(d.status->'os'->>'imageDigest') IS NOT NULL").
 		Where("(d.status->'os'->>'imageDigest') <> ''")
+	query = exclusions.applyDigests(query, "vf")
+	query = exclusions.applyOwners(query, "d", "vf")
 
 	switch {
 	case len(owners) > 0 && includeNull:
@@ -826,6 +906,7 @@ func (s *VulnerabilityFindingStore) GetVulnerabilityGroups(
 	ctx context.Context,
 	digests []DeviceImageDigest,
 	listParams store.ListParams,
+	exclusions *FindingExclusions,
 ) (*VulnerabilityGroupResult, error) {
 	if len(digests) == 0 {
 		return &VulnerabilityGroupResult{}, nil
@@ -836,6 +917,7 @@ func (s *VulnerabilityFindingStore) GetVulnerabilityGroups(
 	}
 
 	baseQuery := s.getDB(ctx).Model(&model.VulnerabilityFinding{}).Where("image_digest IN ?", digestStrs)
+	baseQuery = exclusions.applyDigests(baseQuery, "")
 	if listParams.FieldSelector != nil {
 		q, p, err := listParams.FieldSelector.Parse(ctx, &vulnerabilityFindingResolver{})
 		if err != nil {
@@ -935,6 +1017,7 @@ func (s *VulnerabilityFindingStore) GetFleetVulnerabilitySummary(
 	ctx context.Context,
 	digests []DeviceImageDigest,
 	fs *selector.FieldSelector,
+	exclusions *FindingExclusions,
 ) (FleetSeverityCounts, error) {
 	if len(digests) == 0 {
 		return FleetSeverityCounts{}, nil
@@ -945,6 +1028,7 @@ func (s *VulnerabilityFindingStore) GetFleetVulnerabilitySummary(
 	}
 
 	query := s.getDB(ctx).Model(&model.VulnerabilityFinding{}).Where("image_digest IN ?", digestStrs)
+	query = exclusions.applyDigests(query, "")
 	if fs != nil {
 		q, p, err := fs.Parse(ctx, &vulnerabilityFindingResolver{})
 		if err != nil {
@@ -2130,3 +2214,30 @@ func (s *VulnerabilityFindingStore) batchDeleteOpenEvents(db *gorm.DB, actions [
 	}
 	return deleted, nil
 }
+
+// UpsertImageSBOM stores the SBOM document for an image digest, replacing any earlier one.
+func (s *VulnerabilityFindingStore) UpsertImageSBOM(ctx context.Context, imageDigest string, document []byte) error {
+	sbom := model.ImageSBOM{ImageDigest: imageDigest, Document: document}
+	err := s.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "image_digest"}},
+		DoUpdates: clause.AssignmentColumns([]string{"document", "updated_at"}),
+	}).Create(&sbom).Error
+	return store.ErrorFromGormError(err)
+}
+
+// GetImageSBOMs returns the stored SBOM documents for the given digests. Digests without an
+// SBOM are absent from the returned map.
+func (s *VulnerabilityFindingStore) GetImageSBOMs(ctx context.Context, imageDigests []string) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(imageDigests))
+	if len(imageDigests) == 0 {
+		return result, nil
+	}
+	var sboms []model.ImageSBOM
+	if err := s.getDB(ctx).Where("image_digest IN ?", imageDigests).Find(&sboms).Error; err != nil {
+		return nil, store.ErrorFromGormError(err)
+	}
+	for _, sbom := range sboms {
+		result[sbom.ImageDigest] = sbom.Document
+	}
+	return result, nil
+}
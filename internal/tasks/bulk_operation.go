@@ -0,0 +1,268 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	bulkoperationservice "github.com/flightctl/flightctl/internal/service/bulkoperation"
+	"github.com/flightctl/flightctl/internal/service/common"
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The bulkOperation task is triggered when a BulkOperation is created. It applies the operation
+// to every device matching the operation's selectors, one page of devices at a time, and records
+// the progress and the per-device failures in the operation's status after each page.
+//
+// Behavior:
+// - Operations that already finished are skipped, so redelivered events do not re-apply them.
+// - The position of the next page is saved with the progress, so a retried task resumes a running
+//   operation after the last recorded page instead of re-applying it from the first device.
+// - Deleting the operation stops it before its next page of devices.
+// - A failure on one device does not stop the operation; it fails once all devices were tried.
+// - Invalid selectors fail the operation rather than the task, as retrying cannot fix them.
+
+const (
+	// bulkOperationMaxFailures bounds the number of per-device failures kept in the status.
+	bulkOperationMaxFailures = 100
+	// bulkOperationItemsPerPage keeps a page of per-device updates well within the iteration
+	// timeout, and bounds how stale the reported progress can get.
+	bulkOperationItemsPerPage = 100
+)
+
+func bulkOperation(ctx context.Context, orgId uuid.UUID, event domain.Event, bulkOperationSvc bulkoperationservice.Service, deviceSvc deviceservice.Service, log logrus.FieldLogger) error {
+	logic := NewBulkOperationLogic(log, bulkOperationSvc, deviceSvc, orgId, event)
+
+	if err := logic.RunBulkOperation(ctx); err != nil {
+		log.Errorf("failed to run bulk operation %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+type BulkOperationLogic struct {
+	log              logrus.FieldLogger
+	bulkOperationSvc bulkoperationservice.Service
+	deviceSvc        deviceservice.Service
+	orgId            uuid.UUID
+	event            domain.Event
+	itemsPerPage     int
+}
+
+func NewBulkOperationLogic(log logrus.FieldLogger, bulkOperationSvc bulkoperationservice.Service, deviceSvc deviceservice.Service, orgId uuid.UUID, event domain.Event) BulkOperationLogic {
+	return BulkOperationLogic{
+		log:              log,
+		bulkOperationSvc: bulkOperationSvc,
+		deviceSvc:        deviceSvc,
+		orgId:            orgId,
+		event:            event,
+		itemsPerPage:     bulkOperationItemsPerPage,
+	}
+}
+
+func (b BulkOperationLogic) RunBulkOperation(ctx context.Context) error {
+	name := b.event.InvolvedObject.Name
+	op, status := b.bulkOperationSvc.GetBulkOperation(ctx, b.orgId, name)
+	if status.Code == http.StatusNotFound {
+		// Deleted before it started.
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching bulk operation: %s", status.Message)
+	}
+	if op.Status != nil && lo.Contains([]domain.BulkOperationPhase{domain.BulkOperationPhaseSucceeded, domain.BulkOperationPhaseFailed}, op.Status.Phase) {
+		return nil
+	}
+
+	listParams := domain.ListDevicesParams{
+		LabelSelector: op.Spec.LabelSelector,
+		FieldSelector: op.Spec.FieldSelector,
+		Limit:         lo.ToPtr(int32(b.itemsPerPage)),
+	}
+
+	if op.Status != nil && op.Status.Phase == domain.BulkOperationPhaseRunning {
+		// A previous attempt was interrupted. Without a next page, it applied the operation to
+		// all devices and only failed to record the outcome.
+		if op.Status.Continue == nil && op.Status.SucceededDevices+op.Status.FailedDevices > 0 {
+			return b.finish(ctx, op, "")
+		}
+		b.log.Infof("resuming bulk operation %s/%s after %d devices", b.orgId, name, op.Status.SucceededDevices+op.Status.FailedDevices)
+		listParams.Continue = op.Status.Continue
+	} else {
+		op.Status = &domain.BulkOperationStatus{
+			Phase:     domain.BulkOperationPhaseRunning,
+			StartTime: lo.ToPtr(time.Now().UTC()),
+		}
+		matched, status := b.deviceSvc.CountDevices(ctx, b.orgId, domain.ListDevicesParams{LabelSelector: op.Spec.LabelSelector, FieldSelector: op.Spec.FieldSelector}, nil)
+		switch {
+		case status.Code == http.StatusBadRequest:
+			return b.finish(ctx, op, fmt.Sprintf("Invalid selector: %s", status.Message))
+		case status.Code != http.StatusOK:
+			return fmt.Errorf("counting devices: %s", status.Message)
+		}
+		op.Status.MatchedDevices = matched
+		if done, err := b.updateStatus(ctx, op); done || err != nil {
+			return err
+		}
+	}
+
+	for {
+		nextContinue, err := b.runPage(ctx, op, listParams)
+		if err != nil {
+			return err
+		}
+		op.Status.Continue = nextContinue
+		if done, err := b.updateStatus(ctx, op); done || err != nil {
+			return err
+		}
+		if nextContinue == nil {
+			break
+		}
+		listParams.Continue = nextContinue
+	}
+
+	return b.finish(ctx, op, "")
+}
+
+// runPage applies the operation to one page of devices. It returns the continue token of the
+// next page, or nil if this was the last page.
+func (b BulkOperationLogic) runPage(ctx context.Context, op *domain.BulkOperation, listParams domain.ListDevicesParams) (*string, error) {
+	iterCtx, cancel := fleetRolloutIterationContext(ctx, fleetRolloutIterationTimeout)
+	defer cancel()
+
+	devices, status := b.deviceSvc.ListDevices(iterCtx, b.orgId, listParams, nil)
+	if status.Code != http.StatusOK {
+		return nil, fmt.Errorf("listing devices: %s", status.Message)
+	}
+
+	for i := range devices.Items {
+		device := &devices.Items[i]
+		deviceName := lo.FromPtr(device.Metadata.Name)
+		if err := b.applyToDevice(iterCtx, op.Spec, device); err != nil {
+			b.log.Warnf("bulk operation %s/%s failed on device %s: %v", b.orgId, lo.FromPtr(op.Metadata.Name), deviceName, err)
+			op.Status.FailedDevices++
+			if len(lo.FromPtr(op.Status.Failures)) < bulkOperationMaxFailures {
+				op.Status.Failures = lo.ToPtr(append(lo.FromPtr(op.Status.Failures), domain.BulkOperationDeviceFailure{DeviceName: deviceName, Message: err.Error()}))
+			}
+			continue
+		}
+		op.Status.SucceededDevices++
+	}
+	return devices.Metadata.Continue, nil
+}
+
+func (b BulkOperationLogic) applyToDevice(ctx context.Context, spec domain.BulkOperationSpec, device *domain.Device) error {
+	name := lo.FromPtr(device.Metadata.Name)
+	var status domain.Status
+	switch spec.Operation {
+	case domain.BulkOperationTypePatchLabels:
+		patch := labelsPatch(lo.FromPtr(device.Metadata.Labels), lo.FromPtr(spec.Labels))
+		if len(patch) == 0 {
+			return nil
+		}
+		_, status = b.deviceSvc.PatchDevice(ctx, b.orgId, name, patch, false, false)
+	case domain.BulkOperationTypePatchAnnotations:
+		status = b.deviceSvc.UpdateDeviceAnnotations(ctx, b.orgId, name, lo.FromPtr(spec.Annotations.Set), lo.FromPtr(spec.Annotations.Remove))
+	case domain.BulkOperationTypeSetOwner:
+		newOwner := lo.EmptyableToPtr(lo.FromPtr(spec.Owner))
+		_, status = b.deviceSvc.SetDeviceOwner(ctx, b.orgId, name, device.Metadata.Owner, newOwner)
+	case domain.BulkOperationTypeDecommission:
+		_, status = b.deviceSvc.DecommissionDevice(ctx, b.orgId, name, lo.FromPtr(spec.Decommission))
+	case domain.BulkOperationTypeStartApplication:
+		_, status = b.deviceSvc.StartDeviceApplication(ctx, b.orgId, name, lo.FromPtr(spec.ApplicationName))
+	case domain.BulkOperationTypeStopApplication:
+		_, status = b.deviceSvc.StopDeviceApplication(ctx, b.orgId, name, lo.FromPtr(spec.ApplicationName))
+	case domain.BulkOperationTypeRestartApplication:
+		_, status = b.deviceSvc.RestartDeviceApplication(ctx, b.orgId, name, lo.FromPtr(spec.ApplicationName))
+	case domain.BulkOperationTypeResume:
+		_, status = b.deviceSvc.ResumeDevices(ctx, b.orgId, domain.DeviceResumeRequest{FieldSelector: lo.ToPtr("metadata.name=" + name)})
+	default:
+		return fmt.Errorf("unsupported operation %q", spec.Operation)
+	}
+	if status.Code < 200 || status.Code >= 300 {
+		return common.ApiStatusToErr(status)
+	}
+	return nil
+}
+
+// labelsPatch returns the JSON patch that applies the label changes to a device with the given
+// labels. Removing a label the device does not have is a no-op rather than an error.
+func labelsPatch(current map[string]string, changes domain.BulkOperationMetadataPatch) domain.PatchRequest {
+	patch := domain.PatchRequest{}
+	add := func(op domain.PatchRequestOp, path string, value interface{}) {
+		patch = append(patch, struct {
+			Op    domain.PatchRequestOp `json:"op"`
+			Path  string                `json:"path"`
+			Value interface{}           `json:"value,omitempty"`
+		}{Op: op, Path: path, Value: value})
+	}
+
+	if current == nil && len(lo.FromPtr(changes.Set)) > 0 {
+		add(domain.PatchOpAdd, "/metadata/labels", map[string]string{})
+	}
+	set := lo.FromPtr(changes.Set)
+	for _, key := range slices.Sorted(maps.Keys(set)) {
+		value := set[key]
+		if existing, ok := current[key]; ok && existing == value {
+			continue
+		}
+		add(domain.PatchOpAdd, "/metadata/labels/"+escapeJSONPointer(key), value)
+	}
+	for _, key := range lo.FromPtr(changes.Remove) {
+		if _, ok := current[key]; ok {
+			add(domain.PatchOpRemove, "/metadata/labels/"+escapeJSONPointer(key), nil)
+		}
+	}
+	return patch
+}
+
+// escapeJSONPointer escapes a key for use as a JSON pointer reference token (RFC 6901).
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// updateStatus saves the progress of the operation. It returns true if the operation was deleted
+// and must stop.
+func (b BulkOperationLogic) updateStatus(ctx context.Context, op *domain.BulkOperation) (bool, error) {
+	statusCtx, cancel := fleetRolloutIterationContext(ctx, EventProcessingTimeout)
+	defer cancel()
+
+	_, status := b.bulkOperationSvc.ReplaceBulkOperationStatus(statusCtx, b.orgId, *op)
+	switch status.Code {
+	case http.StatusOK:
+		return false, nil
+	case http.StatusNotFound:
+		b.log.Infof("bulk operation %s/%s was deleted, stopping", b.orgId, lo.FromPtr(op.Metadata.Name))
+		return true, nil
+	default:
+		return false, fmt.Errorf("updating bulk operation status: %s", status.Message)
+	}
+}
+
+// finish records the outcome of the operation. A non-empty message means the operation could not
+// run at all.
+func (b BulkOperationLogic) finish(ctx context.Context, op *domain.BulkOperation, message string) error {
+	switch {
+	case message != "":
+		op.Status.Phase = domain.BulkOperationPhaseFailed
+	case op.Status.FailedDevices > 0:
+		op.Status.Phase = domain.BulkOperationPhaseFailed
+		message = fmt.Sprintf("Failed on %d of %d devices", op.Status.FailedDevices, op.Status.FailedDevices+op.Status.SucceededDevices)
+	default:
+		op.Status.Phase = domain.BulkOperationPhaseSucceeded
+		message = fmt.Sprintf("Applied to %d devices", op.Status.SucceededDevices)
+	}
+	op.Status.Message = lo.ToPtr(message)
+	op.Status.CompletionTime = lo.ToPtr(time.Now().UTC())
+	_, err := b.updateStatus(ctx, op)
+	return err
+}
@@ -0,0 +1,193 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	bulkoperationservice "github.com/flightctl/flightctl/internal/service/bulkoperation"
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func newTestBulkOperation(spec domain.BulkOperationSpec) *domain.BulkOperation {
+	return &domain.BulkOperation{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("bulk-op")},
+		Spec:     spec,
+		Status:   &domain.BulkOperationStatus{Phase: domain.BulkOperationPhasePending},
+	}
+}
+
+func TestBulkOperationLogic_RunBulkOperation(t *testing.T) {
+	orgId := uuid.New()
+	ctx := context.Background()
+	event := createTestEvent(domain.BulkOperationKind, domain.EventReasonResourceCreated, "bulk-op")
+
+	t.Run("When the operation runs it should apply it to every device and record the failures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBulkOperationSvc := bulkoperationservice.NewMockService(ctrl)
+		mockDeviceSvc := deviceservice.NewMockService(ctrl)
+
+		op := newTestBulkOperation(domain.BulkOperationSpec{
+			LabelSelector: lo.ToPtr("site=factory-1"),
+			Operation:     domain.BulkOperationTypeSetOwner,
+			Owner:         lo.ToPtr("Fleet/new"),
+		})
+		mockBulkOperationSvc.EXPECT().GetBulkOperation(gomock.Any(), orgId, "bulk-op").Return(op, statusOK)
+		mockDeviceSvc.EXPECT().CountDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).Return(int64(2), statusOK)
+		mockDeviceSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).Return(&domain.DeviceList{
+			Items: []domain.Device{*createTestDevice("device-1", "Fleet/old"), *createTestDevice("device-2", "Fleet/old")},
+		}, statusOK)
+		mockDeviceSvc.EXPECT().SetDeviceOwner(gomock.Any(), orgId, "device-1", lo.ToPtr("Fleet/old"), lo.ToPtr("Fleet/new")).Return(nil, statusOK)
+		mockDeviceSvc.EXPECT().SetDeviceOwner(gomock.Any(), orgId, "device-2", lo.ToPtr("Fleet/old"), lo.ToPtr("Fleet/new")).Return(nil, domain.StatusConflict("owner changed"))
+
+		var last domain.BulkOperationStatus
+		mockBulkOperationSvc.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), orgId, gomock.Any()).Times(3).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				last = *op.Status
+				return &op, statusOK
+			})
+
+		logic := NewBulkOperationLogic(logrus.New(), mockBulkOperationSvc, mockDeviceSvc, orgId, event)
+		require.NoError(t, logic.RunBulkOperation(ctx))
+
+		assert.Equal(t, domain.BulkOperationPhaseFailed, last.Phase)
+		assert.Equal(t, int64(2), last.MatchedDevices)
+		assert.Equal(t, int64(1), last.SucceededDevices)
+		assert.Equal(t, int64(1), last.FailedDevices)
+		require.Len(t, lo.FromPtr(last.Failures), 1)
+		assert.Equal(t, "device-2", lo.FromPtr(last.Failures)[0].DeviceName)
+		assert.NotNil(t, last.CompletionTime)
+	})
+
+	t.Run("When the operation was deleted while running it should stop", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBulkOperationSvc := bulkoperationservice.NewMockService(ctrl)
+		mockDeviceSvc := deviceservice.NewMockService(ctrl)
+
+		op := newTestBulkOperation(domain.BulkOperationSpec{LabelSelector: lo.ToPtr("site=factory-1"), Operation: domain.BulkOperationTypeResume})
+		mockBulkOperationSvc.EXPECT().GetBulkOperation(gomock.Any(), orgId, "bulk-op").Return(op, statusOK)
+		mockDeviceSvc.EXPECT().CountDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).Return(int64(5), statusOK)
+		mockBulkOperationSvc.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), orgId, gomock.Any()).Return(nil, domain.StatusResourceNotFound(domain.BulkOperationKind, "bulk-op"))
+
+		logic := NewBulkOperationLogic(logrus.New(), mockBulkOperationSvc, mockDeviceSvc, orgId, event)
+		require.NoError(t, logic.RunBulkOperation(ctx))
+	})
+
+	t.Run("When the selector is invalid it should fail the operation without retrying", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBulkOperationSvc := bulkoperationservice.NewMockService(ctrl)
+		mockDeviceSvc := deviceservice.NewMockService(ctrl)
+
+		op := newTestBulkOperation(domain.BulkOperationSpec{FieldSelector: lo.ToPtr("spec.unknown=x"), Operation: domain.BulkOperationTypeResume})
+		mockBulkOperationSvc.EXPECT().GetBulkOperation(gomock.Any(), orgId, "bulk-op").Return(op, statusOK)
+		mockDeviceSvc.EXPECT().CountDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).Return(int64(0), domain.StatusBadRequest("unknown field"))
+		mockBulkOperationSvc.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				assert.Equal(t, domain.BulkOperationPhaseFailed, op.Status.Phase)
+				return &op, statusOK
+			})
+
+		logic := NewBulkOperationLogic(logrus.New(), mockBulkOperationSvc, mockDeviceSvc, orgId, event)
+		require.NoError(t, logic.RunBulkOperation(ctx))
+	})
+
+	t.Run("When a running operation is retried it should resume after the last recorded page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBulkOperationSvc := bulkoperationservice.NewMockService(ctrl)
+		mockDeviceSvc := deviceservice.NewMockService(ctrl)
+
+		op := newTestBulkOperation(domain.BulkOperationSpec{
+			LabelSelector: lo.ToPtr("site=factory-1"),
+			Operation:     domain.BulkOperationTypeSetOwner,
+			Owner:         lo.ToPtr("Fleet/new"),
+		})
+		op.Status = &domain.BulkOperationStatus{
+			Phase:            domain.BulkOperationPhaseRunning,
+			MatchedDevices:   3,
+			SucceededDevices: 2,
+			Continue:         lo.ToPtr("page-2"),
+		}
+		mockBulkOperationSvc.EXPECT().GetBulkOperation(gomock.Any(), orgId, "bulk-op").Return(op, statusOK)
+		mockDeviceSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, params domain.ListDevicesParams, _ *selector.AnnotationSelector) (*domain.DeviceList, domain.Status) {
+				assert.Equal(t, "page-2", lo.FromPtr(params.Continue))
+				return &domain.DeviceList{Items: []domain.Device{*createTestDevice("device-3", "Fleet/old")}}, statusOK
+			})
+		mockDeviceSvc.EXPECT().SetDeviceOwner(gomock.Any(), orgId, "device-3", lo.ToPtr("Fleet/old"), lo.ToPtr("Fleet/new")).Return(nil, statusOK)
+
+		var statuses []domain.BulkOperationStatus
+		mockBulkOperationSvc.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), orgId, gomock.Any()).Times(2).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				statuses = append(statuses, *op.Status)
+				return &op, statusOK
+			})
+
+		logic := NewBulkOperationLogic(logrus.New(), mockBulkOperationSvc, mockDeviceSvc, orgId, event)
+		require.NoError(t, logic.RunBulkOperation(ctx))
+
+		require.Len(t, statuses, 2)
+		assert.Nil(t, statuses[0].Continue, "the last page must clear the resume point")
+		last := statuses[1]
+		assert.Equal(t, domain.BulkOperationPhaseSucceeded, last.Phase)
+		assert.Equal(t, int64(3), last.MatchedDevices)
+		assert.Equal(t, int64(3), last.SucceededDevices)
+	})
+
+	t.Run("When a running operation was applied to all devices it should only record the outcome", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBulkOperationSvc := bulkoperationservice.NewMockService(ctrl)
+		mockDeviceSvc := deviceservice.NewMockService(ctrl)
+
+		op := newTestBulkOperation(domain.BulkOperationSpec{LabelSelector: lo.ToPtr("site=factory-1"), Operation: domain.BulkOperationTypeResume})
+		op.Status = &domain.BulkOperationStatus{Phase: domain.BulkOperationPhaseRunning, MatchedDevices: 2, SucceededDevices: 2}
+		mockBulkOperationSvc.EXPECT().GetBulkOperation(gomock.Any(), orgId, "bulk-op").Return(op, statusOK)
+		mockBulkOperationSvc.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, op domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				assert.Equal(t, domain.BulkOperationPhaseSucceeded, op.Status.Phase)
+				return &op, statusOK
+			})
+
+		logic := NewBulkOperationLogic(logrus.New(), mockBulkOperationSvc, mockDeviceSvc, orgId, event)
+		require.NoError(t, logic.RunBulkOperation(ctx))
+	})
+
+	t.Run("When the operation already finished it should not run it again", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBulkOperationSvc := bulkoperationservice.NewMockService(ctrl)
+		mockDeviceSvc := deviceservice.NewMockService(ctrl)
+
+		op := newTestBulkOperation(domain.BulkOperationSpec{LabelSelector: lo.ToPtr("site=factory-1"), Operation: domain.BulkOperationTypeResume})
+		op.Status.Phase = domain.BulkOperationPhaseSucceeded
+		mockBulkOperationSvc.EXPECT().GetBulkOperation(gomock.Any(), orgId, "bulk-op").Return(op, statusOK)
+
+		logic := NewBulkOperationLogic(logrus.New(), mockBulkOperationSvc, mockDeviceSvc, orgId, event)
+		require.NoError(t, logic.RunBulkOperation(ctx))
+	})
+}
+
+func TestLabelsPatch(t *testing.T) {
+	current := map[string]string{"env": "dev", "site": "a"}
+	patch := labelsPatch(current, domain.BulkOperationMetadataPatch{
+		Set:    &map[string]string{"env": "prod", "site": "a", "example.com/tier": "edge"},
+		Remove: &[]string{"site", "missing"},
+	})
+
+	require.Len(t, patch, 3)
+	assert.Equal(t, "/metadata/labels/env", patch[0].Path)
+	assert.Equal(t, "/metadata/labels/example.com~1tier", patch[1].Path)
+	assert.Equal(t, domain.PatchOpRemove, patch[2].Op)
+	assert.Equal(t, "/metadata/labels/site", patch[2].Path)
+}
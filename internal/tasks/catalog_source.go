@@ -0,0 +1,351 @@
+package tasks
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/oci"
+	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	"github.com/flightctl/flightctl/internal/service/common"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+// CatalogSourceSyncInterval is how often catalogs with a remote source are synced.
+const CatalogSourceSyncInterval = 5 * time.Minute
+
+// maxCatalogIndexSize bounds the size of a catalog index, as it is held in memory while it is
+// parsed.
+const maxCatalogIndexSize = 16 << 20
+
+// catalogIndex is the document a catalog source publishes: the catalog items, each with its
+// versions and channels. Only the name, labels and annotations of the item metadata are used.
+type catalogIndex struct {
+	Items []domain.CatalogItem `json:"items"`
+}
+
+// fetchCatalogIndexFunc pulls the catalog index a source points to and returns its verified content
+// and the manifest digest it was resolved to.
+type fetchCatalogIndexFunc func(ctx context.Context, repo *domain.Repository, source *domain.CatalogSource) ([]byte, string, error)
+
+// CatalogSourceSync mirrors the signed catalog indexes that catalogs with a source point to into
+// their catalogs. Mirrored items are owned by their catalog, which keeps them read-only through
+// the API.
+type CatalogSourceSync struct {
+	log           logrus.FieldLogger
+	catalogSvc    catalogservice.Service
+	repositorySvc repositoryservice.Service
+	fetchIndex    fetchCatalogIndexFunc
+	nowFn         func() time.Time
+}
+
+func NewCatalogSourceSync(log logrus.FieldLogger, catalogSvc catalogservice.Service, repositorySvc repositoryservice.Service) *CatalogSourceSync {
+	return &CatalogSourceSync{
+		log:           log,
+		catalogSvc:    catalogSvc,
+		repositorySvc: repositorySvc,
+		fetchIndex:    FetchCatalogIndex,
+		nowFn:         time.Now,
+	}
+}
+
+func (c *CatalogSourceSync) Poll(ctx context.Context, orgId uuid.UUID) {
+	log := log.WithReqIDFromCtx(ctx, c.log)
+	log.Info("Running catalog source sync")
+
+	params := domain.ListCatalogsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		catalogs, status := c.catalogSvc.ListCatalogs(ctx, orgId, params)
+		if status.Code != http.StatusOK {
+			log.Errorf("failed to list catalogs: %s", status.Message)
+			return
+		}
+		for i := range catalogs.Items {
+			catalog := &catalogs.Items[i]
+			if catalog.Spec.Source == nil {
+				c.clearSyncStatus(ctx, log, orgId, catalog)
+				continue
+			}
+			if err := c.syncCatalog(ctx, log, orgId, catalog); err != nil {
+				log.Errorf("catalog %s: %v", lo.FromPtr(catalog.Metadata.Name), err)
+			}
+		}
+		if catalogs.Metadata.Continue == nil {
+			return
+		}
+		params.Continue = catalogs.Metadata.Continue
+	}
+}
+
+// syncCatalog mirrors the catalog index of one catalog and records the outcome in its status.
+func (c *CatalogSourceSync) syncCatalog(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, catalog *domain.Catalog) error {
+	name := lo.FromPtr(catalog.Metadata.Name)
+	source := catalog.Spec.Source
+	if catalog.Status == nil {
+		catalog.Status = &domain.CatalogStatus{}
+	}
+	if catalog.Status.Conditions == nil {
+		catalog.Status.Conditions = []domain.Condition{}
+	}
+	original := *catalog.Status
+	original.Conditions = append([]domain.Condition{}, catalog.Status.Conditions...)
+
+	index, revision, err := c.pullIndex(ctx, orgId, source)
+	domain.SetStatusConditionByError(&catalog.Status.Conditions, domain.ConditionTypeCatalogAccessible, "Accessible", "FetchFailed", err)
+	if err != nil {
+		return c.updateStatus(ctx, orgId, catalog, &original, err)
+	}
+
+	generation := lo.FromPtr(catalog.Metadata.Generation)
+	if revision == lo.FromPtr(catalog.Status.ObservedDigest) &&
+		generation == lo.FromPtr(catalog.Status.ObservedGeneration) &&
+		len(lo.FromPtr(catalog.Status.Conflicts)) == 0 {
+		return c.updateStatus(ctx, orgId, catalog, &original, nil)
+	}
+
+	log.Infof("catalog %s: mirroring catalog index %s", name, revision)
+	conflicts, err := c.mirrorIndex(ctx, orgId, name, index)
+	if err != nil {
+		domain.SetStatusConditionByError(&catalog.Status.Conditions, domain.ConditionTypeCatalogSynced, "Synced", "SyncFailed", err)
+		return c.updateStatus(ctx, orgId, catalog, &original, err)
+	}
+
+	catalog.Status.ObservedDigest = lo.ToPtr(revision)
+	catalog.Status.ObservedGeneration = lo.ToPtr(generation)
+	catalog.Status.LastSyncTime = lo.ToPtr(c.nowFn().UTC())
+	catalog.Status.Conflicts = nil
+	if len(conflicts) > 0 {
+		catalog.Status.Conflicts = &conflicts
+		err = fmt.Errorf("%d catalog item(s) could not be mirrored", len(conflicts))
+	}
+	domain.SetStatusConditionByError(&catalog.Status.Conditions, domain.ConditionTypeCatalogSynced, "Synced", "Conflict", err)
+	return c.updateStatus(ctx, orgId, catalog, &original, err)
+}
+
+// pullIndex fetches, verifies and parses the catalog index a source points to.
+func (c *CatalogSourceSync) pullIndex(ctx context.Context, orgId uuid.UUID, source *domain.CatalogSource) (*catalogIndex, string, error) {
+	repo, status := c.repositorySvc.GetRepository(ctx, orgId, source.Repository)
+	if status.Code != http.StatusOK {
+		return nil, "", fmt.Errorf("getting repository %s: %w", source.Repository, common.ApiStatusToErr(status))
+	}
+	data, revision, err := c.fetchIndex(ctx, repo, source)
+	if err != nil {
+		return nil, "", err
+	}
+	var index catalogIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, "", fmt.Errorf("parsing catalog index: %w", err)
+	}
+	return &index, revision, nil
+}
+
+// mirrorIndex creates or updates the items of the index in the catalog and deletes the mirrored
+// items that are no longer in it. Items that cannot be mirrored are returned as conflicts rather
+// than failing the sync, so that one bad item does not hold back the rest of the catalog.
+func (c *CatalogSourceSync) mirrorIndex(ctx context.Context, orgId uuid.UUID, catalogName string, index *catalogIndex) ([]domain.CatalogSyncConflict, error) {
+	owner := util.SetResourceOwner(domain.CatalogKind, catalogName)
+	existing, err := c.listItems(ctx, orgId, catalogName)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []domain.CatalogSyncConflict
+	addConflict := func(item string, reason string) {
+		conflicts = append(conflicts, domain.CatalogSyncConflict{Item: item, Reason: reason})
+	}
+
+	inIndex := make(map[string]bool, len(index.Items))
+	for _, item := range index.Items {
+		itemName := lo.FromPtr(item.Metadata.Name)
+		if itemName == "" {
+			addConflict("", "catalog index contains an item without a name")
+			continue
+		}
+		if inIndex[itemName] {
+			addConflict(itemName, "item appears more than once in the catalog index")
+			continue
+		}
+		inIndex[itemName] = true
+
+		if current, ok := existing[itemName]; ok && lo.FromPtr(current.Metadata.Owner) != *owner {
+			addConflict(itemName, "an item with this name exists and is not mirrored from the catalog source")
+			continue
+		}
+
+		itemToUpdate := domain.CatalogItem{
+			ApiVersion: domain.CatalogAPIVersion,
+			Kind:       domain.CatalogItemKind,
+			Metadata: domain.CatalogItemMeta{
+				Name:        item.Metadata.Name,
+				Catalog:     catalogName,
+				Labels:      item.Metadata.Labels,
+				Annotations: item.Metadata.Annotations,
+				Owner:       owner,
+			},
+			Spec: item.Spec,
+		}
+		if errs := itemToUpdate.Validate(); len(errs) > 0 {
+			addConflict(itemName, errors.Join(errs...).Error())
+			continue
+		}
+		if current, ok := existing[itemName]; ok && domain.CatalogItemSpecsAreEqual(current.Spec, itemToUpdate.Spec) &&
+			maps.Equal(lo.FromPtr(current.Metadata.Labels), lo.FromPtr(itemToUpdate.Metadata.Labels)) &&
+			maps.Equal(lo.FromPtr(current.Metadata.Annotations), lo.FromPtr(itemToUpdate.Metadata.Annotations)) {
+			continue
+		}
+		if _, status := c.catalogSvc.ReplaceCatalogItem(ctx, orgId, catalogName, itemName, itemToUpdate, false); status.Code != http.StatusOK && status.Code != http.StatusCreated {
+			addConflict(itemName, status.Message)
+		}
+	}
+
+	for itemName, current := range existing {
+		if inIndex[itemName] || lo.FromPtr(current.Metadata.Owner) != *owner {
+			continue
+		}
+		if status := c.catalogSvc.DeleteCatalogItem(ctx, orgId, catalogName, itemName, false); status.Code != http.StatusOK {
+			addConflict(itemName, fmt.Sprintf("item was removed from the catalog index but could not be deleted: %s", status.Message))
+		}
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool { return conflicts[i].Item < conflicts[j].Item })
+	return conflicts, nil
+}
+
+func (c *CatalogSourceSync) listItems(ctx context.Context, orgId uuid.UUID, catalogName string) (map[string]domain.CatalogItem, error) {
+	items := make(map[string]domain.CatalogItem)
+	params := domain.ListCatalogItemsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		list, status := c.catalogSvc.ListCatalogItems(ctx, orgId, catalogName, params)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Errorf("listing catalog items: %w", common.ApiStatusToErr(status))
+		}
+		for _, item := range list.Items {
+			items[lo.FromPtr(item.Metadata.Name)] = item
+		}
+		if list.Metadata.Continue == nil {
+			return items, nil
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// clearSyncStatus removes the sync state of a catalog whose source was removed.
+func (c *CatalogSourceSync) clearSyncStatus(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, catalog *domain.Catalog) {
+	if catalog.Status == nil {
+		return
+	}
+	original := *catalog.Status
+	original.Conditions = append([]domain.Condition{}, catalog.Status.Conditions...)
+
+	domain.RemoveStatusCondition(&catalog.Status.Conditions, domain.ConditionTypeCatalogAccessible)
+	domain.RemoveStatusCondition(&catalog.Status.Conditions, domain.ConditionTypeCatalogSynced)
+	catalog.Status.ObservedDigest = nil
+	catalog.Status.ObservedGeneration = nil
+	catalog.Status.LastSyncTime = nil
+	catalog.Status.Conflicts = nil
+	if err := c.updateStatus(ctx, orgId, catalog, &original, nil); err != nil {
+		log.Errorf("catalog %s: %v", lo.FromPtr(catalog.Metadata.Name), err)
+	}
+}
+
+// updateStatus writes the catalog status if it changed, and returns syncErr or the error of the
+// write.
+func (c *CatalogSourceSync) updateStatus(ctx context.Context, orgId uuid.UUID, catalog *domain.Catalog, original *domain.CatalogStatus, syncErr error) error {
+	if catalogStatusesAreEqual(original, catalog.Status) {
+		return syncErr
+	}
+	name := lo.FromPtr(catalog.Metadata.Name)
+	if _, status := c.catalogSvc.ReplaceCatalogStatus(ctx, orgId, name, *catalog); status.Code != http.StatusOK {
+		return errors.Join(syncErr, fmt.Errorf("updating status: %w", common.ApiStatusToErr(status)))
+	}
+	return syncErr
+}
+
+func catalogStatusesAreEqual(a, b *domain.CatalogStatus) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+// FetchCatalogIndex pulls the catalog index artifact a source points to from its OCI repository,
+// verifies its signature and returns the index and the manifest digest.
+func FetchCatalogIndex(ctx context.Context, repo *domain.Repository, source *domain.CatalogSource) ([]byte, string, error) {
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to determine repository type: %w", err)
+	}
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeOci {
+		return nil, "", fmt.Errorf("repository %s is of type %s, catalog sources require an OCI repository", lo.FromPtr(repo.Metadata.Name), repoType)
+	}
+	ociSpec, err := repo.Spec.AsOciRepoSpec()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get OCI repo spec: %w", err)
+	}
+	target, err := oci.BuildOciRepoRef(ctx, &ociSpec, ociSpec.Registry+"/"+strings.TrimPrefix(source.Artifact, "/"))
+	if err != nil {
+		return nil, "", err
+	}
+	return fetchCatalogIndex(ctx, target, source)
+}
+
+func fetchCatalogIndex(ctx context.Context, src oras.ReadOnlyTarget, source *domain.CatalogSource) ([]byte, string, error) {
+	desc, err := src.Resolve(ctx, source.TargetRevision)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolving %s: %w", source.TargetRevision, err)
+	}
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, "", fmt.Errorf("%s resolves to %s, expected an OCI manifest", source.TargetRevision, desc.MediaType)
+	}
+	manifestBytes, err := content.FetchAll(ctx, src, desc)
+	if err != nil {
+		return nil, "", fmt.Errorf("fetching manifest: %w", err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, "", fmt.Errorf("parsing manifest: %w", err)
+	}
+
+	layers := lo.Filter(manifest.Layers, func(layer ocispec.Descriptor, _ int) bool {
+		return layer.MediaType == domain.CatalogIndexMediaType
+	})
+	if len(layers) != 1 {
+		return nil, "", fmt.Errorf("artifact must have exactly one layer of media type %s, found %d", domain.CatalogIndexMediaType, len(layers))
+	}
+	if layers[0].Size > maxCatalogIndexSize {
+		return nil, "", fmt.Errorf("catalog index exceeds the maximum size of %d bytes", maxCatalogIndexSize)
+	}
+
+	encodedSignature, ok := manifest.Annotations[domain.CatalogIndexSignatureAnnotation]
+	if !ok {
+		return nil, "", fmt.Errorf("catalog index is not signed: manifest has no %s annotation", domain.CatalogIndexSignatureAnnotation)
+	}
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding catalog index signature: %w", err)
+	}
+
+	data, err := content.FetchAll(ctx, src, layers[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("fetching catalog index: %w", err)
+	}
+	if err := source.VerifySignature(data, signature); err != nil {
+		return nil, "", fmt.Errorf("verifying catalog index signature: %w", err)
+	}
+	return data, desc.Digest.String(), nil
+}
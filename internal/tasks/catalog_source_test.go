@@ -0,0 +1,281 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/google/uuid"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+const catalogSourceOwner = "Catalog/vendor"
+
+func newCatalogSigningKey(t *testing.T) (string, ed25519.PrivateKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), private
+}
+
+func catalogSourceItem(name string, owner *string, versions ...string) domain.CatalogItem {
+	item := domain.CatalogItem{
+		Metadata: domain.CatalogItemMeta{Name: lo.ToPtr(name), Catalog: "vendor", Owner: owner},
+		Spec: domain.CatalogItemSpec{
+			Type:      domain.CatalogItemTypeContainer,
+			Artifacts: []domain.CatalogItemArtifact{{Type: domain.CatalogItemArtifactTypeContainer, Uri: "quay.io/vendor/" + name}},
+		},
+	}
+	for _, version := range versions {
+		item.Spec.Versions = append(item.Spec.Versions, domain.CatalogItemVersion{
+			Version:    version,
+			Channels:   []string{"stable"},
+			References: map[domain.CatalogItemArtifactType]string{domain.CatalogItemArtifactTypeContainer: version},
+		})
+	}
+	return item
+}
+
+func TestFetchCatalogIndex(t *testing.T) {
+	ctx := context.Background()
+	publicKey, privateKey := newCatalogSigningKey(t)
+	index := []byte(`{"items":[]}`)
+
+	store := memory.New()
+	indexLayer := content.NewDescriptorFromBytes(domain.CatalogIndexMediaType, index)
+	otherLayer := content.NewDescriptorFromBytes("application/json", index)
+	for _, layer := range []ocispec.Descriptor{indexLayer, otherLayer} {
+		require.NoError(t, store.Push(ctx, layer, bytes.NewReader(index)))
+	}
+	push := func(tag string, layers []ocispec.Descriptor, annotations map[string]string) {
+		manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.flightctl.catalog", oras.PackManifestOptions{
+			Layers:              layers,
+			ManifestAnnotations: annotations,
+		})
+		require.NoError(t, err)
+		require.NoError(t, store.Tag(ctx, manifest, tag))
+	}
+	signed := map[string]string{domain.CatalogIndexSignatureAnnotation: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, index))}
+	_, otherKey := newCatalogSigningKey(t)
+
+	push("signed", []ocispec.Descriptor{indexLayer}, signed)
+	push("unsigned", []ocispec.Descriptor{indexLayer}, nil)
+	push("wrong-key", []ocispec.Descriptor{indexLayer}, map[string]string{
+		domain.CatalogIndexSignatureAnnotation: base64.StdEncoding.EncodeToString(ed25519.Sign(otherKey, index)),
+	})
+	push("no-index", []ocispec.Descriptor{otherLayer}, signed)
+
+	tests := []struct {
+		name        string
+		revision    string
+		errContains string
+	}{
+		{name: "When the index is signed with the source key it returns the index", revision: "signed"},
+		{name: "When the manifest has no signature it fails", revision: "unsigned", errContains: "not signed"},
+		{name: "When the index is signed with another key it fails", revision: "wrong-key", errContains: "signature"},
+		{name: "When the artifact has no index layer it fails", revision: "no-index", errContains: domain.CatalogIndexMediaType},
+		{name: "When the revision does not exist it fails", revision: "missing", errContains: "resolving"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &domain.CatalogSource{TargetRevision: tt.revision, PublicKey: publicKey}
+			data, revision, err := fetchCatalogIndex(ctx, store, source)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, index, data)
+			require.NotEmpty(t, revision)
+		})
+	}
+}
+
+func TestCatalogSourceSync_Poll(t *testing.T) {
+	ok := domain.Status{Code: http.StatusOK}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	owner := lo.ToPtr(catalogSourceOwner)
+
+	sourceCatalog := func(status *domain.CatalogStatus) *domain.Catalog {
+		return &domain.Catalog{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("vendor"), Generation: lo.ToPtr(int64(1))},
+			Spec: domain.CatalogSpec{Source: &domain.CatalogSource{
+				Repository:     "vendor-registry",
+				Artifact:       "vendor/catalog",
+				TargetRevision: "stable",
+			}},
+			Status: status,
+		}
+	}
+	indexOf := func(items ...domain.CatalogItem) []byte {
+		data, err := json.Marshal(catalogIndex{Items: items})
+		require.NoError(t, err)
+		return data
+	}
+
+	tests := []struct {
+		name       string
+		catalog    *domain.Catalog
+		index      []byte
+		fetchErr   error
+		existing   []domain.CatalogItem
+		expect     func(catalogSvc *catalogservice.MockService)
+		wantStatus func(t *testing.T, status *domain.CatalogStatus)
+	}{
+		{
+			name:    "When items are new it mirrors them as owned by the catalog",
+			catalog: sourceCatalog(nil),
+			index:   indexOf(catalogSourceItem("app", nil, "1.0.0")),
+			expect: func(catalogSvc *catalogservice.MockService) {
+				catalogSvc.EXPECT().ReplaceCatalogItem(gomock.Any(), gomock.Any(), "vendor", "app", gomock.Any(), false).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _, _ string, item domain.CatalogItem, _ bool) (*domain.CatalogItem, domain.Status) {
+						require.Equal(t, catalogSourceOwner, lo.FromPtr(item.Metadata.Owner))
+						require.Equal(t, "vendor", item.Metadata.Catalog)
+						return &item, domain.Status{Code: http.StatusCreated}
+					})
+			},
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.Equal(t, "sha256:index", lo.FromPtr(status.ObservedDigest))
+				require.Equal(t, now, lo.FromPtr(status.LastSyncTime))
+				require.Nil(t, status.Conflicts)
+				require.True(t, domain.IsStatusConditionTrue(status.Conditions, domain.ConditionTypeCatalogAccessible))
+				require.True(t, domain.IsStatusConditionTrue(status.Conditions, domain.ConditionTypeCatalogSynced))
+			},
+		},
+		{
+			name:     "When a local item has the same name it reports a conflict and leaves it alone",
+			catalog:  sourceCatalog(nil),
+			index:    indexOf(catalogSourceItem("app", nil, "1.0.0")),
+			existing: []domain.CatalogItem{catalogSourceItem("app", nil, "0.9.0")},
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.Len(t, lo.FromPtr(status.Conflicts), 1)
+				require.Equal(t, "app", lo.FromPtr(status.Conflicts)[0].Item)
+				require.True(t, domain.IsStatusConditionFalse(status.Conditions, domain.ConditionTypeCatalogSynced))
+			},
+		},
+		{
+			name:     "When a mirrored item left the index it deletes it",
+			catalog:  sourceCatalog(nil),
+			index:    indexOf(catalogSourceItem("app", nil, "1.0.0")),
+			existing: []domain.CatalogItem{catalogSourceItem("app", owner, "1.0.0"), catalogSourceItem("old", owner, "1.0.0")},
+			expect: func(catalogSvc *catalogservice.MockService) {
+				catalogSvc.EXPECT().DeleteCatalogItem(gomock.Any(), gomock.Any(), "vendor", "old", false).Return(ok)
+			},
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.Nil(t, status.Conflicts)
+			},
+		},
+		{
+			name:     "When a removed item is still in use it reports a conflict",
+			catalog:  sourceCatalog(nil),
+			index:    indexOf(),
+			existing: []domain.CatalogItem{catalogSourceItem("app", owner, "1.0.0")},
+			expect: func(catalogSvc *catalogservice.MockService) {
+				catalogSvc.EXPECT().DeleteCatalogItem(gomock.Any(), gomock.Any(), "vendor", "app", false).
+					Return(domain.StatusConflict("versions are in use"))
+			},
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.Len(t, lo.FromPtr(status.Conflicts), 1)
+				require.Contains(t, lo.FromPtr(status.Conflicts)[0].Reason, "versions are in use")
+			},
+		},
+		{
+			name:    "When an item in the index is invalid it reports a conflict",
+			catalog: sourceCatalog(nil),
+			index:   indexOf(catalogSourceItem("app", nil)),
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.Len(t, lo.FromPtr(status.Conflicts), 1)
+			},
+		},
+		{
+			name:     "When the index cannot be fetched it marks the catalog inaccessible",
+			catalog:  sourceCatalog(nil),
+			fetchErr: context.DeadlineExceeded,
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.True(t, domain.IsStatusConditionFalse(status.Conditions, domain.ConditionTypeCatalogAccessible))
+				require.Nil(t, status.ObservedDigest)
+			},
+		},
+		{
+			name: "When the index was already mirrored it does nothing",
+			catalog: sourceCatalog(&domain.CatalogStatus{
+				Conditions: []domain.Condition{
+					{Type: domain.ConditionTypeCatalogAccessible, Status: domain.ConditionStatusTrue, Reason: "Accessible", Message: "Accessible"},
+				},
+				ObservedDigest:     lo.ToPtr("sha256:index"),
+				ObservedGeneration: lo.ToPtr(int64(1)),
+			}),
+			index: indexOf(catalogSourceItem("app", nil, "1.0.0")),
+		},
+		{
+			name: "When the source was removed it clears the sync status",
+			catalog: func() *domain.Catalog {
+				catalog := sourceCatalog(&domain.CatalogStatus{
+					Conditions:     []domain.Condition{{Type: domain.ConditionTypeCatalogSynced, Status: domain.ConditionStatusTrue}},
+					ObservedDigest: lo.ToPtr("sha256:index"),
+				})
+				catalog.Spec.Source = nil
+				return catalog
+			}(),
+			wantStatus: func(t *testing.T, status *domain.CatalogStatus) {
+				require.Empty(t, status.Conditions)
+				require.Nil(t, status.ObservedDigest)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			catalogSvc := catalogservice.NewMockService(ctrl)
+			repositorySvc := repositoryservice.NewMockService(ctrl)
+			orgId := uuid.New()
+
+			catalogSvc.EXPECT().ListCatalogs(gomock.Any(), orgId, gomock.Any()).
+				Return(&domain.CatalogList{Items: []domain.Catalog{*tt.catalog}}, ok)
+			if tt.catalog.Spec.Source != nil {
+				repositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, "vendor-registry").Return(&domain.Repository{}, ok)
+			}
+			if tt.index != nil && (tt.catalog.Status == nil || tt.catalog.Status.ObservedDigest == nil) {
+				catalogSvc.EXPECT().ListCatalogItems(gomock.Any(), orgId, "vendor", gomock.Any()).
+					Return(&domain.CatalogItemList{Items: tt.existing}, ok)
+			}
+			if tt.expect != nil {
+				tt.expect(catalogSvc)
+			}
+			if tt.wantStatus != nil {
+				catalogSvc.EXPECT().ReplaceCatalogStatus(gomock.Any(), orgId, "vendor", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, catalog domain.Catalog) (*domain.Catalog, domain.Status) {
+						tt.wantStatus(t, catalog.Status)
+						return &catalog, ok
+					})
+			}
+
+			sync := NewCatalogSourceSync(logrus.New(), catalogSvc, repositorySvc)
+			sync.nowFn = func() time.Time { return now }
+			sync.fetchIndex = func(context.Context, *domain.Repository, *domain.CatalogSource) ([]byte, string, error) {
+				return tt.index, "sha256:index", tt.fetchErr
+			}
+			sync.Poll(context.Background(), orgId)
+		})
+	}
+}
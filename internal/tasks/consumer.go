@@ -14,12 +14,14 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/metrics/worker"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/kvstore"
+	bulkoperationservice "github.com/flightctl/flightctl/internal/service/bulkoperation"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
 	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	eventservice "github.com/flightctl/flightctl/internal/service/event"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
 	templateversionservice "github.com/flightctl/flightctl/internal/service/templateversion"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
@@ -30,7 +32,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func dispatchTasks(fleetSvc fleetservice.Service, templateversionSvc templateversionservice.Service, deviceSvc deviceservice.Service, dependencyrefSvc dependencyrefservice.Service, repositorySvc repositoryservice.Service, catalogSvc catalogservice.Service, eventSvc eventservice.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, workerMetrics *worker.WorkerCollector, encryptionMigrator *EncryptionMigrator, queuePublisher queues.QueueProducer) queues.ConsumeHandler {
+func dispatchTasks(fleetSvc fleetservice.Service, templateversionSvc templateversionservice.Service, deviceSvc deviceservice.Service, dependencyrefSvc dependencyrefservice.Service, repositorySvc repositoryservice.Service, secretSvc secretservice.Service, bulkOperationSvc bulkoperationservice.Service, catalogSvc catalogservice.Service, eventSvc eventservice.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, workerMetrics *worker.WorkerCollector, encryptionMigrator *EncryptionMigrator, queuePublisher queues.QueueProducer) queues.ConsumeHandler {
 	return func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
 		startTime := time.Now()
 
@@ -93,7 +95,7 @@ func dispatchTasks(fleetSvc fleetservice.Service, templateversionSvc templatever
 		if shouldValidateFleet(ctx, eventWithOrgId.Event, log) {
 			taskName = "fleetValidation"
 			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
-				return fleetValidate(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, fleetSvc, templateversionSvc, deviceSvc, repositorySvc, k8sClient, log)
+				return fleetValidate(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, fleetSvc, templateversionSvc, deviceSvc, repositorySvc, secretSvc, k8sClient, log)
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
@@ -107,7 +109,7 @@ func dispatchTasks(fleetSvc fleetservice.Service, templateversionSvc templatever
 		if shouldRenderDevice(ctx, eventWithOrgId.Event, log) {
 			taskName = "deviceRender"
 			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
-				return deviceRender(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, deviceSvc, repositorySvc, catalogSvc, k8sClient, kvStore, cfg, log)
+				return deviceRender(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, deviceSvc, repositorySvc, secretSvc, catalogSvc, k8sClient, kvStore, cfg, log)
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
@@ -118,6 +120,13 @@ func dispatchTasks(fleetSvc fleetservice.Service, templateversionSvc templatever
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldUpdateSecretReferers(ctx, eventWithOrgId.Event, log) {
+			taskName = "secretUpdate"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return secretUpdate(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, secretSvc, dependencyrefSvc, eventSvc, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 		if shouldReconcileFleetApplicationLifecycle(ctx, eventWithOrgId.Event, log) {
 			taskName = "fleetApplicationLifecycle"
 			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
@@ -125,6 +134,13 @@ func dispatchTasks(fleetSvc fleetservice.Service, templateversionSvc templatever
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldRunBulkOperation(ctx, eventWithOrgId.Event, log) {
+			taskName = "bulkOperation"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return bulkOperation(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, bulkOperationSvc, deviceSvc, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 		if shouldRunEncryptionMigration(eventWithOrgId.Event) {
 			taskName = "encryptionMigration"
 			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
@@ -281,6 +297,7 @@ func shouldRenderDevice(ctx context.Context, event domain.Event, log logrus.Fiel
 		domain.EventReasonDependencyChangeDetected,
 		domain.EventReasonResourceCreated,
 		domain.EventReasonFleetRolloutDeviceSelected, domain.EventReasonDeviceConflictResolved,
+		domain.EventReasonDeviceRollbackCleared,
 		domain.EventReasonDeviceDecommissioned, domain.EventReasonApplicationLifecycleChanged}, event.Reason) {
 		return true
 	}
@@ -325,6 +342,24 @@ func shouldUpdateRepositoryReferers(ctx context.Context, event domain.Event, log
 	return false
 }
 
+func shouldUpdateSecretReferers(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	// If a secret's data was updated, return true
+	if event.Reason == domain.EventReasonResourceUpdated && event.InvolvedObject.Kind == domain.SecretKind {
+		return hasUpdatedFields(event.Details, log, domain.Spec)
+	}
+
+	// If a secret was created, return true so that referers waiting for it are rendered
+	if event.Reason == domain.EventReasonResourceCreated && event.InvolvedObject.Kind == domain.SecretKind {
+		return true
+	}
+
+	return false
+}
+
+func shouldRunBulkOperation(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	return event.Reason == domain.EventReasonResourceCreated && event.InvolvedObject.Kind == domain.BulkOperationKind
+}
+
 func shouldRunEncryptionMigration(event domain.Event) bool {
 	return event.Reason == EventReasonEncryptionMigrationBatch
 }
@@ -412,6 +447,8 @@ func LaunchConsumers(ctx context.Context,
 	deviceSvc deviceservice.Service,
 	dependencyrefSvc dependencyrefservice.Service,
 	repositorySvc repositoryservice.Service,
+	secretSvc secretservice.Service,
+	bulkOperationSvc bulkoperationservice.Service,
 	catalogSvc catalogservice.Service,
 	eventSvc eventservice.Service,
 	k8sClient k8sclient.K8SClient,
@@ -438,7 +475,7 @@ func LaunchConsumers(ctx context.Context,
 			return err
 		}
 		for j := 0; j != threadsPerConsumer; j++ {
-			if err = consumer.Consume(ctx, dispatchTasks(fleetSvc, templateversionSvc, deviceSvc, dependencyrefSvc, repositorySvc, catalogSvc, eventSvc, k8sClient, kvStore, cfg, workerMetrics, encryptionMigrator, queuePublisher)); err != nil {
+			if err = consumer.Consume(ctx, dispatchTasks(fleetSvc, templateversionSvc, deviceSvc, dependencyrefSvc, repositorySvc, secretSvc, bulkOperationSvc, catalogSvc, eventSvc, k8sClient, kvStore, cfg, workerMetrics, encryptionMigrator, queuePublisher)); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,279 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/metrics/periodic"
+	"github.com/flightctl/flightctl/internal/oci"
+	"github.com/flightctl/flightctl/internal/service/common"
+	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	syncstateservice "github.com/flightctl/flightctl/internal/service/syncstate"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// ociResolveFunc is the injectable function type for testing.
+// Returns the manifest digest the reference (tag or digest) of the artifact currently resolves to.
+type ociResolveFunc func(ctx context.Context, ociSpec *domain.OciRepoSpec, artifact, reference string) (string, error)
+
+type DependencySyncOci struct {
+	log              logrus.FieldLogger
+	dependencyrefSvc dependencyrefservice.Service
+	eventSvc         eventservice.Service
+	syncstateSvc     syncstateservice.Service
+	cfg              *config.Config
+	resolve          ociResolveFunc
+	maxConcurrent    int
+	metrics          *periodic.DependencySyncCollector
+}
+
+func NewDependencySyncOci(log logrus.FieldLogger, dependencyrefSvc dependencyrefservice.Service, eventSvc eventservice.Service, syncstateSvc syncstateservice.Service,
+	cfg *config.Config, metrics *periodic.DependencySyncCollector) *DependencySyncOci {
+	return &DependencySyncOci{
+		log:              log,
+		dependencyrefSvc: dependencyrefSvc,
+		eventSvc:         eventSvc,
+		syncstateSvc:     syncstateSvc,
+		cfg:              cfg,
+		resolve:          resolveOciArtifactDigest,
+		maxConcurrent:    10,
+		metrics:          metrics,
+	}
+}
+
+type ociProbeResult struct {
+	probe       *model.OciDependencyProbe
+	resourceKey string
+	fingerprint string
+	changed     bool
+	firstSeen   bool
+	probeErr    string
+	skip        bool
+}
+
+func (d *DependencySyncOci) Poll(ctx context.Context, orgId uuid.UUID) {
+	if d.metrics != nil {
+		d.metrics.ObserveProbeCycle(periodic.RefTypeOci)
+	}
+
+	pollInterval := d.cfg.GetDependenciesSyncPollInterval()
+	probeStart := time.Now()
+
+	probes, status := d.dependencyrefSvc.ListDueOciDependencies(ctx, orgId, pollInterval)
+	if status.Code != http.StatusOK {
+		d.log.Errorf("failed listing due OCI dependencies: %s", status.Message)
+		return
+	}
+	if len(probes) == 0 {
+		return
+	}
+
+	repoGroups := make(map[string][]*model.OciDependencyProbe)
+	for i := range probes {
+		repoGroups[probes[i].RepositoryName] = append(repoGroups[probes[i].RepositoryName], &probes[i])
+	}
+
+	var (
+		mu      sync.Mutex
+		results []ociProbeResult
+	)
+
+	sem := make(chan struct{}, d.maxConcurrent)
+	var wg sync.WaitGroup
+
+	for _, group := range repoGroups {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res := d.probeRepoGroup(ctx, group)
+			mu.Lock()
+			results = append(results, res...)
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	if d.metrics != nil {
+		d.metrics.ObserveProbeLatency(periodic.RefTypeOci, time.Since(probeStart))
+	}
+
+	d.reconcile(ctx, orgId, results)
+}
+
+func (d *DependencySyncOci) probeRepoGroup(ctx context.Context, group []*model.OciDependencyProbe) []ociProbeResult {
+	first := group[0]
+	if first.RepoSpec == nil {
+		var results []ociProbeResult
+		for _, p := range group {
+			d.log.Warnf("repository %s not found (no spec in JOIN result)", p.RepositoryName)
+			results = append(results, ociProbeResult{probe: p, skip: true})
+		}
+		return results
+	}
+
+	ociSpec, err := first.RepoSpec.Data.AsOciRepoSpec()
+	if err != nil {
+		var results []ociProbeResult
+		for _, p := range group {
+			d.log.WithError(err).Warnf("failed decoding OCI spec for repository %s", p.RepositoryName)
+			results = append(results, ociProbeResult{probe: p, skip: true})
+		}
+		return results
+	}
+
+	var results []ociProbeResult
+	for _, probe := range group {
+		probeCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		r := d.probeArtifact(probeCtx, &ociSpec, probe)
+		cancel()
+		results = append(results, r)
+	}
+	return results
+}
+
+func (d *DependencySyncOci) probeArtifact(ctx context.Context, ociSpec *domain.OciRepoSpec, probe *model.OciDependencyProbe) ociProbeResult {
+	rk := ociResourceKey(probe.RepositoryName, probe.OciArtifact, probe.Revision)
+
+	manifestDigest, err := d.resolve(ctx, ociSpec, probe.OciArtifact, probe.Revision)
+	if err != nil {
+		d.log.WithError(err).Warnf("OCI probe failed for %s", rk)
+		if d.metrics != nil {
+			d.metrics.ObserveProbeError(periodic.RefTypeOci)
+		}
+		return ociProbeResult{probe: probe, resourceKey: rk, skip: true, probeErr: sanitizeError(err)}
+	}
+
+	r := ociProbeResult{
+		probe:       probe,
+		resourceKey: rk,
+		fingerprint: manifestDigest,
+	}
+	switch {
+	case probe.Fingerprint == nil:
+		r.firstSeen = true
+	case r.fingerprint != *probe.Fingerprint:
+		r.changed = true
+	}
+	return r
+}
+
+func (d *DependencySyncOci) reconcile(ctx context.Context, orgId uuid.UUID, results []ociProbeResult) {
+	now := time.Now().UTC()
+
+	for _, r := range results {
+		if r.probeErr != "" {
+			for _, fleetName := range r.probe.FleetNames {
+				event := common.GetDependencySyncProbeFailedEvent(ctx, domain.FleetKind, fleetName, r.resourceKey, r.probeErr)
+				if event != nil {
+					d.eventSvc.CreateEvent(ctx, orgId, event)
+				}
+			}
+			for _, deviceName := range r.probe.DeviceNames {
+				event := common.GetDependencySyncProbeFailedEvent(ctx, domain.DeviceKind, deviceName, r.resourceKey, r.probeErr)
+				if event != nil {
+					d.eventSvc.CreateEvent(ctx, orgId, event)
+				}
+			}
+			continue
+		}
+		if !r.changed {
+			continue
+		}
+		if d.metrics != nil {
+			d.metrics.ObserveProbeChange(periodic.RefTypeOci)
+		}
+		for _, fleetName := range r.probe.FleetNames {
+			event := common.GetDependencyChangeDetectedEvent(ctx, domain.FleetKind, fleetName, r.resourceKey, r.fingerprint)
+			if event != nil {
+				d.eventSvc.CreateEvent(ctx, orgId, event)
+			}
+		}
+		for _, deviceName := range r.probe.DeviceNames {
+			event := common.GetDependencyChangeDetectedEvent(ctx, domain.DeviceKind, deviceName, r.resourceKey, r.fingerprint)
+			if event != nil {
+				d.eventSvc.CreateEvent(ctx, orgId, event)
+			}
+		}
+	}
+
+	var upsertStates []model.SyncState
+	var unchangedKeys []string
+
+	for _, r := range results {
+		if r.resourceKey == "" {
+			continue
+		}
+		if r.probeErr != "" {
+			upsertStates = append(upsertStates, model.SyncState{
+				OrgID:         orgId,
+				ResourceKey:   r.resourceKey,
+				ProbeStatus:   "ProbeFailed",
+				ProbeMessage:  r.probeErr,
+				LastCheckedAt: now,
+			})
+			continue
+		}
+		if r.firstSeen || r.changed {
+			upsertStates = append(upsertStates, model.SyncState{
+				OrgID:         orgId,
+				ResourceKey:   r.resourceKey,
+				Fingerprint:   r.fingerprint,
+				ProbeStatus:   "Synced",
+				LastCheckedAt: now,
+				LastChangeAt:  &now,
+			})
+		} else {
+			unchangedKeys = append(unchangedKeys, r.resourceKey)
+		}
+	}
+
+	if len(upsertStates) > 0 {
+		if st := d.syncstateSvc.BulkUpsertSyncState(ctx, orgId, upsertStates); st.Code != http.StatusOK {
+			d.log.Errorf("failed bulk upserting sync states: %s", st.Message)
+			return
+		}
+	}
+
+	if len(unchangedKeys) > 0 {
+		if st := d.syncstateSvc.BulkUpdateSyncStateLastCheckedAt(ctx, orgId, unchangedKeys, now); st.Code != http.StatusOK {
+			d.log.Errorf("failed bulk updating last_checked_at: %s", st.Message)
+		}
+	}
+}
+
+// ociResourceKey identifies an artifact reference. Digests are separated by "@" and tags by ":",
+// as in image references.
+func ociResourceKey(repoName, artifact, reference string) string {
+	artifact = strings.Trim(artifact, "/")
+	if validation.OciImageDigestRegexp.MatchString(reference) {
+		return fmt.Sprintf("oci:%s/%s@%s", repoName, artifact, reference)
+	}
+	return fmt.Sprintf("oci:%s/%s:%s", repoName, artifact, reference)
+}
+
+// resolveOciArtifactDigest resolves the reference with a HEAD request for the manifest, so that
+// polling never transfers the artifact itself.
+func resolveOciArtifactDigest(ctx context.Context, ociSpec *domain.OciRepoSpec, artifact, reference string) (string, error) {
+	target, err := oci.BuildOciRepoRef(ctx, ociSpec, ociSpec.Registry+"/"+strings.TrimPrefix(artifact, "/"))
+	if err != nil {
+		return "", err
+	}
+	desc, err := target.Resolve(ctx, reference)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", reference, err)
+	}
+	return desc.Digest.String(), nil
+}
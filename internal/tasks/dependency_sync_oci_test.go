@@ -0,0 +1,151 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	syncstateservice "github.com/flightctl/flightctl/internal/service/syncstate"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func ociRepoSpec(t *testing.T) *model.JSONField[api.RepositorySpec] {
+	t.Helper()
+	spec := api.RepositorySpec{}
+	require.NoError(t, spec.FromOciRepoSpec(api.OciRepoSpec{Type: api.OciRepoSpecTypeOci, Registry: "quay.io"}))
+	return model.MakeJSONField(spec)
+}
+
+// staticOciResolver resolves artifact references from a map keyed by "<artifact>:<reference>".
+func staticOciResolver(digests map[string]string) ociResolveFunc {
+	return func(_ context.Context, _ *domain.OciRepoSpec, artifact, reference string) (string, error) {
+		d, ok := digests[artifact+":"+reference]
+		if !ok {
+			return "", errors.New("manifest unknown")
+		}
+		return d, nil
+	}
+}
+
+func TestDependencySyncOci_Poll(t *testing.T) {
+	orgId := uuid.New()
+	ctx := context.Background()
+	pollInterval := 15 * time.Minute
+
+	t.Run("When a tag moved it should record the new digest and emit events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		repoSpec := ociRepoSpec(t)
+		probes := []model.OciDependencyProbe{
+			{RepositoryName: "registry", OciArtifact: "myorg/app", Revision: "v1", Fingerprint: lo.ToPtr("sha256:old"), FleetNames: model.StringArray{"fleet-1"}, DeviceNames: model.StringArray{"device-1"}, RepoSpec: repoSpec},
+			{RepositoryName: "registry", OciArtifact: "myorg/base", Revision: "v1", Fingerprint: lo.ToPtr("sha256:base"), FleetNames: model.StringArray{"fleet-2"}, RepoSpec: repoSpec},
+		}
+		mockDependencyRefSvc.EXPECT().ListDueOciDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+
+		mockSyncStateSvc.EXPECT().BulkUpsertSyncState(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, states []model.SyncState) domain.Status {
+				require.Len(t, states, 1)
+				assert.Equal(t, "oci:registry/myorg/app:v1", states[0].ResourceKey)
+				assert.Equal(t, "sha256:new", states[0].Fingerprint)
+				assert.Equal(t, "Synced", states[0].ProbeStatus)
+				return statusOK
+			})
+		mockSyncStateSvc.EXPECT().BulkUpdateSyncStateLastCheckedAt(gomock.Any(), orgId, []string{"oci:registry/myorg/base:v1"}, gomock.Any()).Return(statusOK)
+
+		var events []emittedEvent
+		mockEventSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Times(2).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+			require.Equal(t, domain.EventReasonDependencyChangeDetected, event.Reason)
+			events = append(events, emittedEvent{kind: event.InvolvedObject.Kind, name: event.InvolvedObject.Name})
+		})
+
+		d := NewDependencySyncOci(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.resolve = staticOciResolver(map[string]string{"myorg/app:v1": "sha256:new", "myorg/base:v1": "sha256:base"})
+		d.Poll(ctx, orgId)
+
+		assert.ElementsMatch(t, []emittedEvent{
+			{kind: string(domain.FleetKind), name: "fleet-1"},
+			{kind: string(domain.DeviceKind), name: "device-1"},
+		}, events)
+	})
+
+	t.Run("When an artifact is pinned by digest it should key it with @", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		pinned := "sha256:" + strings.Repeat("a", 64)
+		probes := []model.OciDependencyProbe{
+			{RepositoryName: "registry", OciArtifact: "myorg/app", Revision: pinned, FleetNames: model.StringArray{"fleet-1"}, RepoSpec: ociRepoSpec(t)},
+		}
+		mockDependencyRefSvc.EXPECT().ListDueOciDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+		mockSyncStateSvc.EXPECT().BulkUpsertSyncState(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, states []model.SyncState) domain.Status {
+				require.Len(t, states, 1)
+				assert.Equal(t, "oci:registry/myorg/app@"+pinned, states[0].ResourceKey)
+				assert.Equal(t, pinned, states[0].Fingerprint)
+				return statusOK
+			})
+
+		d := NewDependencySyncOci(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.resolve = staticOciResolver(map[string]string{"myorg/app:" + pinned: pinned})
+		d.Poll(ctx, orgId)
+	})
+
+	t.Run("When a tag no longer resolves it should emit a probe failure and record ProbeFailed status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		probes := []model.OciDependencyProbe{
+			{RepositoryName: "registry", OciArtifact: "myorg/app", Revision: "gone", Fingerprint: lo.ToPtr("sha256:old"), FleetNames: model.StringArray{"fleet-1"}, RepoSpec: ociRepoSpec(t)},
+		}
+		mockDependencyRefSvc.EXPECT().ListDueOciDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+		mockSyncStateSvc.EXPECT().BulkUpsertSyncState(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, states []model.SyncState) domain.Status {
+				require.Len(t, states, 1)
+				assert.Equal(t, "ProbeFailed", states[0].ProbeStatus)
+				assert.Contains(t, states[0].ProbeMessage, "manifest unknown")
+				return statusOK
+			})
+		mockEventSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Times(1)
+
+		d := NewDependencySyncOci(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.resolve = staticOciResolver(map[string]string{})
+		d.Poll(ctx, orgId)
+	})
+
+	t.Run("When the repository no longer exists it should skip the probe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		probes := []model.OciDependencyProbe{{RepositoryName: "deleted", OciArtifact: "myorg/app", Revision: "v1", FleetNames: model.StringArray{"fleet-1"}}}
+		mockDependencyRefSvc.EXPECT().ListDueOciDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+
+		d := NewDependencySyncOci(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.Poll(ctx, orgId)
+	})
+}
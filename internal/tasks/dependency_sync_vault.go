@@ -0,0 +1,270 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/metrics/periodic"
+	"github.com/flightctl/flightctl/internal/service/common"
+	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	syncstateservice "github.com/flightctl/flightctl/internal/service/syncstate"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/vault"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// vaultVersionFunc is the injectable function type for testing.
+// Returns the current KV version of the secret at secretPath.
+type vaultVersionFunc func(ctx context.Context, client *vault.Client, secretPath string) (int, error)
+
+type DependencySyncVault struct {
+	log              logrus.FieldLogger
+	dependencyrefSvc dependencyrefservice.Service
+	eventSvc         eventservice.Service
+	syncstateSvc     syncstateservice.Service
+	cfg              *config.Config
+	readVersion      vaultVersionFunc
+	maxConcurrent    int
+	metrics          *periodic.DependencySyncCollector
+}
+
+func NewDependencySyncVault(log logrus.FieldLogger, dependencyrefSvc dependencyrefservice.Service, eventSvc eventservice.Service, syncstateSvc syncstateservice.Service,
+	cfg *config.Config, metrics *periodic.DependencySyncCollector) *DependencySyncVault {
+	return &DependencySyncVault{
+		log:              log,
+		dependencyrefSvc: dependencyrefSvc,
+		eventSvc:         eventSvc,
+		syncstateSvc:     syncstateSvc,
+		cfg:              cfg,
+		readVersion:      readVaultSecretVersion,
+		maxConcurrent:    10,
+		metrics:          metrics,
+	}
+}
+
+type vaultProbeResult struct {
+	probe       *model.VaultDependencyProbe
+	resourceKey string
+	fingerprint string
+	changed     bool
+	firstSeen   bool
+	probeErr    string
+	skip        bool
+}
+
+func (d *DependencySyncVault) Poll(ctx context.Context, orgId uuid.UUID) {
+	if d.metrics != nil {
+		d.metrics.ObserveProbeCycle(periodic.RefTypeVault)
+	}
+
+	pollInterval := d.cfg.GetDependenciesSyncPollInterval()
+	probeStart := time.Now()
+
+	probes, status := d.dependencyrefSvc.ListDueVaultDependencies(ctx, orgId, pollInterval)
+	if status.Code != http.StatusOK {
+		d.log.Errorf("failed listing due Vault dependencies: %s", status.Message)
+		return
+	}
+	if len(probes) == 0 {
+		return
+	}
+
+	repoGroups := make(map[string][]*model.VaultDependencyProbe)
+	for i := range probes {
+		repoGroups[probes[i].RepositoryName] = append(repoGroups[probes[i].RepositoryName], &probes[i])
+	}
+
+	var (
+		mu      sync.Mutex
+		results []vaultProbeResult
+	)
+
+	sem := make(chan struct{}, d.maxConcurrent)
+	var wg sync.WaitGroup
+
+	for _, group := range repoGroups {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res := d.probeRepoGroup(ctx, group)
+			mu.Lock()
+			results = append(results, res...)
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	if d.metrics != nil {
+		d.metrics.ObserveProbeLatency(periodic.RefTypeVault, time.Since(probeStart))
+	}
+
+	d.reconcile(ctx, orgId, results)
+}
+
+func (d *DependencySyncVault) probeRepoGroup(ctx context.Context, group []*model.VaultDependencyProbe) []vaultProbeResult {
+	first := group[0]
+	if first.RepoSpec == nil {
+		var results []vaultProbeResult
+		for _, p := range group {
+			d.log.Warnf("repository %s not found (no spec in JOIN result)", p.RepositoryName)
+			results = append(results, vaultProbeResult{probe: p, skip: true})
+		}
+		return results
+	}
+
+	// A single client per repository so that AppRole logins are shared by its secrets.
+	client, err := vault.NewClientForSpec(first.RepoSpec.Data)
+	if err != nil {
+		var results []vaultProbeResult
+		for _, p := range group {
+			d.log.WithError(err).Warnf("failed building Vault client for repository %s", p.RepositoryName)
+			results = append(results, vaultProbeResult{probe: p, skip: true})
+		}
+		return results
+	}
+
+	var results []vaultProbeResult
+	for _, probe := range group {
+		probeCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		r := d.probeSecret(probeCtx, client, probe)
+		cancel()
+		results = append(results, r)
+	}
+	return results
+}
+
+func (d *DependencySyncVault) probeSecret(ctx context.Context, client *vault.Client, probe *model.VaultDependencyProbe) vaultProbeResult {
+	rk := vaultResourceKey(probe.RepositoryName, probe.VaultPath)
+
+	version, err := d.readVersion(ctx, client, probe.VaultPath)
+	if err != nil {
+		d.log.WithError(err).Warnf("Vault probe failed for %s", rk)
+		if d.metrics != nil {
+			d.metrics.ObserveProbeError(periodic.RefTypeVault)
+		}
+		return vaultProbeResult{probe: probe, resourceKey: rk, skip: true, probeErr: sanitizeError(err)}
+	}
+
+	r := vaultProbeResult{
+		probe:       probe,
+		resourceKey: rk,
+		fingerprint: vault.Fingerprint(version),
+	}
+	switch {
+	case probe.Fingerprint == nil:
+		r.firstSeen = true
+	case r.fingerprint != *probe.Fingerprint:
+		r.changed = true
+	}
+	return r
+}
+
+func (d *DependencySyncVault) reconcile(ctx context.Context, orgId uuid.UUID, results []vaultProbeResult) {
+	now := time.Now().UTC()
+
+	for _, r := range results {
+		if r.probeErr != "" {
+			for _, fleetName := range r.probe.FleetNames {
+				event := common.GetDependencySyncProbeFailedEvent(ctx, domain.FleetKind, fleetName, r.resourceKey, r.probeErr)
+				if event != nil {
+					d.eventSvc.CreateEvent(ctx, orgId, event)
+				}
+			}
+			for _, deviceName := range r.probe.DeviceNames {
+				event := common.GetDependencySyncProbeFailedEvent(ctx, domain.DeviceKind, deviceName, r.resourceKey, r.probeErr)
+				if event != nil {
+					d.eventSvc.CreateEvent(ctx, orgId, event)
+				}
+			}
+			continue
+		}
+		if !r.changed {
+			continue
+		}
+		if d.metrics != nil {
+			d.metrics.ObserveProbeChange(periodic.RefTypeVault)
+		}
+		for _, fleetName := range r.probe.FleetNames {
+			event := common.GetDependencyChangeDetectedEvent(ctx, domain.FleetKind, fleetName, r.resourceKey, r.fingerprint)
+			if event != nil {
+				d.eventSvc.CreateEvent(ctx, orgId, event)
+			}
+		}
+		for _, deviceName := range r.probe.DeviceNames {
+			event := common.GetDependencyChangeDetectedEvent(ctx, domain.DeviceKind, deviceName, r.resourceKey, r.fingerprint)
+			if event != nil {
+				d.eventSvc.CreateEvent(ctx, orgId, event)
+			}
+		}
+	}
+
+	var upsertStates []model.SyncState
+	var unchangedKeys []string
+
+	for _, r := range results {
+		if r.resourceKey == "" {
+			continue
+		}
+		if r.probeErr != "" {
+			upsertStates = append(upsertStates, model.SyncState{
+				OrgID:         orgId,
+				ResourceKey:   r.resourceKey,
+				ProbeStatus:   "ProbeFailed",
+				ProbeMessage:  r.probeErr,
+				LastCheckedAt: now,
+			})
+			continue
+		}
+		if r.firstSeen || r.changed {
+			upsertStates = append(upsertStates, model.SyncState{
+				OrgID:         orgId,
+				ResourceKey:   r.resourceKey,
+				Fingerprint:   r.fingerprint,
+				ProbeStatus:   "Synced",
+				LastCheckedAt: now,
+				LastChangeAt:  &now,
+			})
+		} else {
+			unchangedKeys = append(unchangedKeys, r.resourceKey)
+		}
+	}
+
+	if len(upsertStates) > 0 {
+		if st := d.syncstateSvc.BulkUpsertSyncState(ctx, orgId, upsertStates); st.Code != http.StatusOK {
+			d.log.Errorf("failed bulk upserting sync states: %s", st.Message)
+			return
+		}
+	}
+
+	if len(unchangedKeys) > 0 {
+		if st := d.syncstateSvc.BulkUpdateSyncStateLastCheckedAt(ctx, orgId, unchangedKeys, now); st.Code != http.StatusOK {
+			d.log.Errorf("failed bulk updating last_checked_at: %s", st.Message)
+		}
+	}
+}
+
+func vaultResourceKey(repoName, secretPath string) string {
+	return fmt.Sprintf("vault:%s/%s", repoName, strings.Trim(secretPath, "/"))
+}
+
+// readVaultSecretVersion reads the current version from the secret's metadata, so that polling
+// never transfers the secret itself. A secret that no longer exists is reported as a probe failure.
+func readVaultSecretVersion(ctx context.Context, client *vault.Client, secretPath string) (int, error) {
+	version, err := client.ReadSecretVersion(ctx, secretPath)
+	if errors.Is(err, vault.ErrSecretNotFound) {
+		return 0, fmt.Errorf("path %s does not exist in Vault", secretPath)
+	}
+	return version, err
+}
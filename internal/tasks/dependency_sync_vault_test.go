@@ -0,0 +1,175 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	syncstateservice "github.com/flightctl/flightctl/internal/service/syncstate"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// newVaultStandIn serves the KV version 2 endpoints of a Vault server for the secrets in versions.
+// Each secret holds a single "value" key set to "v<version>"; any other path returns 404.
+func newVaultStandIn(t *testing.T, versions map[string]int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kind, secretPath, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/secret/"), "/")
+		version, ok := versions[secretPath]
+		if r.Header.Get("X-Vault-Token") != "token" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch kind {
+		case "metadata":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"current_version": version}})
+		case "data":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"data":     map[string]any{"value": fmt.Sprintf("v%d", version)},
+				"metadata": map[string]any{"version": version},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func vaultRepoSpec(t *testing.T, url string) *model.JSONField[api.RepositorySpec] {
+	t.Helper()
+	auth := api.VaultAuth{}
+	require.NoError(t, auth.FromVaultTokenAuth(api.VaultTokenAuth{AuthType: api.VaultAuthTypeToken, Token: "token"}))
+	spec := api.RepositorySpec{}
+	require.NoError(t, spec.FromVaultRepoSpec(api.VaultRepoSpec{
+		Type:      api.VaultRepoSpecTypeVault,
+		Url:       url,
+		VaultAuth: auth,
+	}))
+	return model.MakeJSONField(spec)
+}
+
+func TestDependencySyncVault_Poll(t *testing.T) {
+	orgId := uuid.New()
+	ctx := context.Background()
+	pollInterval := 15 * time.Minute
+
+	t.Run("When a secret was rotated it should record the new version and emit events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		server := newVaultStandIn(t, map[string]int{"edge/site-a": 3, "edge/site-b": 1})
+		repoSpec := vaultRepoSpec(t, server.URL)
+		probes := []model.VaultDependencyProbe{
+			{RepositoryName: "vault", VaultPath: "edge/site-a", Fingerprint: lo.ToPtr("2"), FleetNames: model.StringArray{"fleet-1"}, DeviceNames: model.StringArray{"device-1"}, RepoSpec: repoSpec},
+			{RepositoryName: "vault", VaultPath: "edge/site-b", Fingerprint: lo.ToPtr("1"), FleetNames: model.StringArray{"fleet-2"}, RepoSpec: repoSpec},
+		}
+		mockDependencyRefSvc.EXPECT().ListDueVaultDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+
+		mockSyncStateSvc.EXPECT().BulkUpsertSyncState(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, states []model.SyncState) domain.Status {
+				require.Len(t, states, 1)
+				assert.Equal(t, "vault:vault/edge/site-a", states[0].ResourceKey)
+				assert.Equal(t, "3", states[0].Fingerprint)
+				assert.Equal(t, "Synced", states[0].ProbeStatus)
+				return statusOK
+			})
+		mockSyncStateSvc.EXPECT().BulkUpdateSyncStateLastCheckedAt(gomock.Any(), orgId, []string{"vault:vault/edge/site-b"}, gomock.Any()).Return(statusOK)
+
+		var events []emittedEvent
+		mockEventSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Times(2).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+			require.Equal(t, domain.EventReasonDependencyChangeDetected, event.Reason)
+			events = append(events, emittedEvent{kind: event.InvolvedObject.Kind, name: event.InvolvedObject.Name})
+		})
+
+		d := NewDependencySyncVault(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.Poll(ctx, orgId)
+
+		assert.ElementsMatch(t, []emittedEvent{
+			{kind: string(domain.FleetKind), name: "fleet-1"},
+			{kind: string(domain.DeviceKind), name: "device-1"},
+		}, events)
+	})
+
+	t.Run("When a secret is seen for the first time it should record its version without emitting events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		server := newVaultStandIn(t, map[string]int{"edge/site-a": 5})
+		probes := []model.VaultDependencyProbe{
+			{RepositoryName: "vault", VaultPath: "/edge/site-a/", FleetNames: model.StringArray{"fleet-1"}, RepoSpec: vaultRepoSpec(t, server.URL)},
+		}
+		mockDependencyRefSvc.EXPECT().ListDueVaultDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+		mockSyncStateSvc.EXPECT().BulkUpsertSyncState(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, states []model.SyncState) domain.Status {
+				require.Len(t, states, 1)
+				assert.Equal(t, "vault:vault/edge/site-a", states[0].ResourceKey)
+				assert.Equal(t, "5", states[0].Fingerprint)
+				return statusOK
+			})
+
+		d := NewDependencySyncVault(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.Poll(ctx, orgId)
+	})
+
+	t.Run("When a secret is missing it should emit a probe failure and record ProbeFailed status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		server := newVaultStandIn(t, map[string]int{})
+		probes := []model.VaultDependencyProbe{
+			{RepositoryName: "vault", VaultPath: "edge/gone", Fingerprint: lo.ToPtr("4"), FleetNames: model.StringArray{"fleet-1"}, RepoSpec: vaultRepoSpec(t, server.URL)},
+		}
+		mockDependencyRefSvc.EXPECT().ListDueVaultDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+		mockSyncStateSvc.EXPECT().BulkUpsertSyncState(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, states []model.SyncState) domain.Status {
+				require.Len(t, states, 1)
+				assert.Equal(t, "ProbeFailed", states[0].ProbeStatus)
+				assert.Contains(t, states[0].ProbeMessage, "path edge/gone does not exist in Vault")
+				return statusOK
+			})
+		mockEventSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Times(1)
+
+		d := NewDependencySyncVault(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.Poll(ctx, orgId)
+	})
+
+	t.Run("When the repository no longer exists it should skip the probe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+		mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+		probes := []model.VaultDependencyProbe{{RepositoryName: "deleted", VaultPath: "edge/site-a", FleetNames: model.StringArray{"fleet-1"}}}
+		mockDependencyRefSvc.EXPECT().ListDueVaultDependencies(gomock.Any(), orgId, pollInterval).Return(probes, statusOK)
+
+		d := NewDependencySyncVault(logrus.New(), mockDependencyRefSvc, mockEventSvc, mockSyncStateSvc, &config.Config{}, nil)
+		d.Poll(ctx, orgId)
+	})
+}
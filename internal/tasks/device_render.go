@@ -3,9 +3,11 @@ package tasks
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/fs"
 	"net/http"
 	"path/filepath"
 	"strconv"
@@ -22,10 +24,14 @@ import (
 	"github.com/flightctl/flightctl/internal/service/common"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/flightctl/flightctl/internal/vault"
 	"github.com/flightctl/flightctl/pkg/ignition"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
+	"github.com/go-git/go-billy/v5"
+	billyutil "github.com/go-git/go-billy/v5/util"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -49,8 +55,8 @@ import (
 // This design ensures the task can be retried safely, detects mid-write inconsistencies,
 // and avoids unnecessary reprocessing when the output is already up to date.
 
-func deviceRender(ctx context.Context, orgId uuid.UUID, event domain.Event, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, catalogSvc catalogservice.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, log logrus.FieldLogger) error {
-	logic := NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, k8sClient, kvStore, cfg, orgId, event)
+func deviceRender(ctx context.Context, orgId uuid.UUID, event domain.Event, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, secretSvc secretservice.Service, catalogSvc catalogservice.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, log logrus.FieldLogger) error {
+	logic := NewDeviceRenderLogic(log, deviceSvc, repositorySvc, secretSvc, catalogSvc, k8sClient, kvStore, cfg, orgId, event)
 	if event.InvolvedObject.Kind == domain.DeviceKind {
 		err := logic.RenderDevice(ctx)
 		if err != nil {
@@ -68,6 +74,7 @@ type DeviceRenderLogic struct {
 	log               logrus.FieldLogger
 	deviceSvc         deviceservice.Service
 	repositorySvc     repositoryservice.Service
+	secretSvc         secretservice.Service
 	catalogSvc        catalogservice.Service
 	k8sClient         k8sclient.K8SClient
 	kvStore           kvstore.KVStore
@@ -81,22 +88,28 @@ type DeviceRenderLogic struct {
 	vmConverter       VmConverterFn
 	vmRenderOptions   VmRenderOptions
 	customVmConverter bool
+	fetchOciArtifact  ociArtifactFetchFunc
 }
 
-func NewDeviceRenderLogic(log logrus.FieldLogger, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, catalogSvc catalogservice.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, orgId uuid.UUID, event domain.Event) DeviceRenderLogic {
+// ociArtifactFetchFunc pulls an artifact and returns its unpacked content and manifest digest.
+type ociArtifactFetchFunc func(ctx context.Context, repo *domain.Repository, artifact string, reference string) (billy.Filesystem, string, error)
+
+func NewDeviceRenderLogic(log logrus.FieldLogger, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, secretSvc secretservice.Service, catalogSvc catalogservice.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, cfg *config.Config, orgId uuid.UUID, event domain.Event) DeviceRenderLogic {
 	opts := vmRenderOptionsFromConfig(cfg, "")
 	return DeviceRenderLogic{
-		log:             log,
-		deviceSvc:       deviceSvc,
-		repositorySvc:   repositorySvc,
-		catalogSvc:      catalogSvc,
-		k8sClient:       k8sClient,
-		kvStore:         kvStore,
-		cfg:             cfg,
-		orgId:           orgId,
-		event:           event,
-		vmConverter:     NewVmConverter(vmToQuadletBinary, opts),
-		vmRenderOptions: opts,
+		log:              log,
+		deviceSvc:        deviceSvc,
+		repositorySvc:    repositorySvc,
+		secretSvc:        secretSvc,
+		catalogSvc:       catalogSvc,
+		k8sClient:        k8sClient,
+		kvStore:          kvStore,
+		cfg:              cfg,
+		orgId:            orgId,
+		event:            event,
+		vmConverter:      NewVmConverter(vmToQuadletBinary, opts),
+		vmRenderOptions:  opts,
+		fetchOciArtifact: FetchOciArtifact,
 	}
 }
 
@@ -133,11 +146,13 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 
 	// bypassHashCheck is true for event reasons that must always produce a fresh render even
 	// though specHash (computed from device.Spec alone) hasn't changed: dependency changes and
-	// application lifecycle changes affect the rendered output without changing device.Spec.
+	// application lifecycle changes affect the rendered output without changing device.Spec,
+	// and a cleared rollback must replace the restored version with a render of the spec.
 	bypassHashCheck := lo.Contains([]domain.EventReason{
 		domain.EventReasonDependencyChangeDetected,
 		domain.EventReasonFleetRolloutDeviceSelected,
 		domain.EventReasonApplicationLifecycleChanged,
+		domain.EventReasonDeviceRollbackCleared,
 	}, t.event.Reason)
 
 	// If device.Spec or device.Spec.Config are nil, we still want to render an empty ignition config
@@ -167,6 +182,11 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 				return nil
 			}
 		}
+		// Don't render over a manual rollback until it is cleared
+		if val, ok := annotations[domain.DeviceAnnotationRollbackVersion]; ok {
+			t.log.Infof("Device %s is rolled back to rendered version %s", t.event.InvolvedObject.Name, val)
+			return nil
+		}
 
 		// FleetRolloutDeviceSelected is re-emitted by the disruption-budget reconciler on every
 		// reconcile tick for any device still mid-rollout, without knowing whether an earlier
@@ -332,6 +352,9 @@ func (t *DeviceRenderLogic) renderApplications(ctx context.Context) ([]byte, err
 	for i := range *t.applications {
 		application := (*t.applications)[i]
 		name, renderedApplication, renderErr := renderApplication(ctx, &application, t.vmConverter, t.vmRenderOptions, t.kvStore, t.orgId, t.catalogSvc)
+		if renderErr == nil && renderedApplication != nil {
+			renderErr = t.resolveApplicationEnvFrom(ctx, renderedApplication)
+		}
 		applicationName := util.DefaultIfNil(name, "<unknown>")
 
 		// Append invalid configs only if there's an error
@@ -365,6 +388,118 @@ func (t *DeviceRenderLogic) renderApplications(ctx context.Context) ([]byte, err
 	return renderedApplicationBytes, nil
 }
 
+// resolveApplicationEnvFrom replaces the envFrom sources of an application with the environment
+// variables they resolve to, so that the device receives plain envVars.
+func (t *DeviceRenderLogic) resolveApplicationEnvFrom(ctx context.Context, app *domain.ApplicationProviderSpec) error {
+	appType, err := app.GetAppType()
+	if err != nil {
+		return fmt.Errorf("%w: failed to get app type: %w", ErrUnknownApplicationType, err)
+	}
+	lookup := func(name string) (map[string]string, error) {
+		data, _, err := t.getNativeSecretData(ctx, name)
+		return data, err
+	}
+
+	switch appType {
+	case domain.AppTypeContainer:
+		container, err := app.AsContainerApplication()
+		if err != nil {
+			return fmt.Errorf("failed to parse application spec for type %s: %w", appType, err)
+		}
+		if container.EnvFrom == nil {
+			return nil
+		}
+		if container.EnvVars, err = resolveEnvFrom(container.EnvVars, *container.EnvFrom, lookup); err != nil {
+			return err
+		}
+		if err := app.FromContainerApplication(container); err != nil {
+			return err
+		}
+	case domain.AppTypeCompose:
+		compose, err := app.AsComposeApplication()
+		if err != nil {
+			return fmt.Errorf("failed to parse application spec for type %s: %w", appType, err)
+		}
+		if compose.EnvFrom == nil {
+			return nil
+		}
+		if compose.EnvVars, err = resolveEnvFrom(compose.EnvVars, *compose.EnvFrom, lookup); err != nil {
+			return err
+		}
+		if err := app.FromComposeApplication(compose); err != nil {
+			return err
+		}
+	case domain.AppTypeQuadlet:
+		quadlet, err := app.AsQuadletApplication()
+		if err != nil {
+			return fmt.Errorf("failed to parse application spec for type %s: %w", appType, err)
+		}
+		if quadlet.EnvFrom == nil {
+			return nil
+		}
+		if quadlet.EnvVars, err = resolveEnvFrom(quadlet.EnvVars, *quadlet.EnvFrom, lookup); err != nil {
+			return err
+		}
+		if err := app.FromQuadletApplication(quadlet); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	return removeApplicationEnvFrom(app)
+}
+
+// removeApplicationEnvFrom drops envFrom from the application. The generated application types
+// keep the raw document they were decoded from, so clearing the field alone would not remove it.
+func removeApplicationEnvFrom(app *domain.ApplicationProviderSpec) error {
+	raw, err := app.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal application: %w", err)
+	}
+	object := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return fmt.Errorf("failed to unmarshal application: %w", err)
+	}
+	delete(object, "envFrom")
+	raw, err = json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application: %w", err)
+	}
+	return app.UnmarshalJSON(raw)
+}
+
+// resolveEnvFrom merges the keys of the referenced secrets into envVars. Each key, with the
+// source's prefix prepended, becomes a variable whose value is the decoded secret value. Variables
+// already set in envVars take precedence, and later sources override earlier ones.
+func resolveEnvFrom(envVars *map[string]string, envFrom []domain.ApplicationEnvFromSource, lookup func(name string) (map[string]string, error)) (*map[string]string, error) {
+	resolved := map[string]string{}
+	for _, source := range envFrom {
+		data, err := lookup(source.SecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		prefix := lo.FromPtr(source.Prefix)
+		for key, value := range data {
+			name := prefix + key
+			if !validation.EnvVarNameRegexp.MatchString(name) {
+				return nil, fmt.Errorf("key %q of Secret %s is not a valid environment variable name", name, source.SecretRef.Name)
+			}
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding key %q of Secret %s: %w", key, source.SecretRef.Name, err)
+			}
+			resolved[name] = string(decoded)
+		}
+	}
+	for name, value := range lo.FromPtr(envVars) {
+		resolved[name] = value
+	}
+	if len(resolved) == 0 {
+		return envVars, nil
+	}
+	return &resolved, nil
+}
+
 func (t *DeviceRenderLogic) renderConfig(ctx context.Context) (*config_latest_types.Config, []string, []ConfigRefFingerprint, error) {
 	ignitionConfig := &config_latest_types.Config{
 		Ignition: config_latest_types.Ignition{
@@ -427,6 +562,20 @@ type cachedSecretData struct {
 	ResourceVersion string            `json:"resourceVersion,omitempty"`
 }
 
+// cachedNativeSecretData wraps the base64-encoded data of a Secret resource with its fingerprint
+// for KV storage.
+type cachedNativeSecretData struct {
+	Data        map[string]string `json:"data"`
+	Fingerprint string            `json:"fingerprint"`
+}
+
+// cachedOciArtifact holds the unpacked files of an OCI artifact, keyed by their path relative to
+// the artifact root, with the manifest digest they were pulled at.
+type cachedOciArtifact struct {
+	Files  map[string][]byte `json:"files"`
+	Digest string            `json:"digest"`
+}
+
 type RenderItem interface {
 	MarshalJSON() ([]byte, error)
 }
@@ -446,6 +595,12 @@ func (t *DeviceRenderLogic) renderConfigItem(ctx context.Context, configItem *do
 		return t.renderInlineConfig(configItem, ignitionConfig)
 	case domain.HttpConfigProviderType:
 		return t.renderHttpProviderConfig(ctx, configItem, ignitionConfig)
+	case domain.VaultConfigProviderType:
+		return t.renderVaultConfig(ctx, configItem, ignitionConfig)
+	case domain.OciConfigProviderType:
+		return t.renderOciConfig(ctx, configItem, ignitionConfig)
+	case domain.SecretConfigProviderType:
+		return t.renderSecretConfig(ctx, configItem, ignitionConfig)
 	default:
 		return nil, nil, nil, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -704,6 +859,334 @@ func (t *DeviceRenderLogic) renderK8sConfig(ctx context.Context, configItem *dom
 	return &k8sSpec.Name, nil, fingerprint, nil
 }
 
+// renderSecretConfig renders a secretResourceRef, which references a Secret resource of the
+// device's organization.
+func (t *DeviceRenderLogic) renderSecretConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, *string, error) {
+	secretSpec, err := configItem.AsSecretConfigProviderSpec()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: failed getting config item as SecretConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	secretName := secretSpec.SecretResourceRef.Name
+	secretData, fingerprint, err := t.getNativeSecretData(ctx, secretName)
+	if err != nil {
+		return &secretSpec.Name, nil, nil, err
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return &secretSpec.Name, nil, nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	base := filepath.Clean(secretSpec.SecretResourceRef.MountPath)
+	for name, value := range secretData {
+		if name == "." || name == ".." || strings.ContainsRune(name, '/') {
+			return &secretSpec.Name, nil, nil, fmt.Errorf("invalid secret key %q: must be a single file name", name)
+		}
+		contents, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return &secretSpec.Name, nil, nil, fmt.Errorf("failed decoding key %q of Secret %s: %w", name, secretName, err)
+		}
+		dest := filepath.Join(base, name)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return &secretSpec.Name, nil, nil, fmt.Errorf("invalid secret-derived path %q: %w", dest, err)
+		}
+		ignitionWrapper.SetFile(dest, contents, 0o644, false, secretSpec.SecretResourceRef.User.String(), secretSpec.SecretResourceRef.Group)
+	}
+
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	return &secretSpec.Name, nil, &fingerprint, nil
+}
+
+// getNativeSecretData returns the base64-encoded data of a Secret resource and its fingerprint.
+// Like Kubernetes secrets, the data is cached per template version so that a fleet rollout
+// delivers the same data to all of its devices.
+func (t *DeviceRenderLogic) getNativeSecretData(ctx context.Context, secretName string) (map[string]string, string, error) {
+	var secretData map[string]string
+	var fingerprint string
+	var key kvstore.NativeSecretKey
+	needToStoreData := false
+
+	depFingerprint, depResourceKey := t.getDepChangeDetails()
+	if depResourceKey != nativeSecretResourceKey(secretName) {
+		depFingerprint = ""
+	}
+
+	if t.ownerFleet != nil {
+		key = kvstore.NativeSecretKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			Name:            secretName,
+		}
+		data, err := t.kvStore.Get(ctx, key.ComposeKey())
+		if err != nil {
+			return nil, "", fmt.Errorf("failed fetching cached secret data: %w", err)
+		}
+		if data != nil {
+			var cached cachedNativeSecretData
+			if err = json.Unmarshal(data, &cached); err != nil {
+				return nil, "", fmt.Errorf("failed parsing cached secret data: %w", err)
+			}
+			if depFingerprint != "" && cached.Fingerprint != depFingerprint {
+				if err := t.kvStore.Delete(ctx, key.ComposeKey()); err != nil {
+					return nil, "", fmt.Errorf("failed invalidating secret cache: %w", err)
+				}
+				needToStoreData = true
+			} else {
+				secretData = cached.Data
+				fingerprint = cached.Fingerprint
+			}
+		} else {
+			needToStoreData = true
+		}
+	}
+
+	if secretData == nil {
+		secret, status := t.secretSvc.GetSecret(ctx, t.orgId, secretName)
+		if status.Code != http.StatusOK {
+			return nil, "", fmt.Errorf("failed getting Secret %s: %s", secretName, status.Message)
+		}
+		secretData = secret.Spec.Data
+		fingerprint = nativeSecretFingerprint(secretData)
+	}
+
+	if needToStoreData {
+		secretDataToStore, err := json.Marshal(cachedNativeSecretData{Data: secretData, Fingerprint: fingerprint})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed marshalling Secret %s: %w", secretName, err)
+		}
+		if _, err := t.kvStore.SetNX(ctx, key.ComposeKey(), secretDataToStore); err != nil {
+			return nil, "", fmt.Errorf("failed storing Secret %s: %w", secretName, err)
+		}
+	}
+
+	return secretData, fingerprint, nil
+}
+
+func (t *DeviceRenderLogic) renderVaultConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, *string, error) {
+	vaultSpec, err := configItem.AsVaultConfigProviderSpec()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: failed getting config item as VaultConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	repoName := vaultSpec.VaultRef.Repository
+	secretPath := vaultSpec.VaultRef.Path
+
+	repo, status := t.repositorySvc.GetRepository(ctx, t.orgId, repoName)
+	if status.Code != http.StatusOK {
+		return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, repoName, status.Message)
+	}
+
+	var secretData map[string][]byte
+	var version string
+	var key kvstore.VaultSecretKey
+	needToStoreData := false
+
+	depFingerprint, depResourceKey := t.getDepChangeDetails()
+	if depResourceKey != vaultResourceKey(repoName, secretPath) {
+		depFingerprint = ""
+	}
+
+	if t.ownerFleet != nil {
+		key = kvstore.VaultSecretKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			Repository:      repoName,
+			Path:            secretPath,
+		}
+		data, err := t.kvStore.Get(ctx, key.ComposeKey())
+		if err != nil {
+			return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed fetching cached secret data: %w", err)
+		}
+		if data != nil {
+			var cached cachedSecretData
+			if err = json.Unmarshal(data, &cached); err != nil {
+				return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed parsing cached secret data: %w", err)
+			}
+			// The cached KV version is the freshness signal, as for Kubernetes secrets.
+			if depFingerprint != "" && cached.ResourceVersion != depFingerprint {
+				if err := t.kvStore.Delete(ctx, key.ComposeKey()); err != nil {
+					return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed invalidating secret cache: %w", err)
+				}
+				needToStoreData = true
+			} else {
+				secretData = cached.Data
+				version = cached.ResourceVersion
+			}
+		} else {
+			needToStoreData = true
+		}
+	}
+
+	if secretData == nil {
+		client, err := vault.NewClientForSpec(repo.Spec)
+		if err != nil {
+			return &vaultSpec.Name, &repoName, nil, err
+		}
+		secret, err := client.ReadSecret(ctx, secretPath)
+		if err != nil {
+			return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed reading Vault secret %s from repository %s: %w", secretPath, repoName, err)
+		}
+		secretData = secret.Data
+		version = secret.Fingerprint()
+	}
+
+	if needToStoreData {
+		secretDataToStore, err := json.Marshal(cachedSecretData{Data: secretData, ResourceVersion: version})
+		if err != nil {
+			return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed marshalling Vault secret %s: %w", secretPath, err)
+		}
+		if _, err := t.kvStore.SetNX(ctx, key.ComposeKey(), secretDataToStore); err != nil {
+			return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed storing Vault secret %s: %w", secretPath, err)
+		}
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return &vaultSpec.Name, &repoName, nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	mode := 0o644
+	if vaultSpec.VaultRef.Mode != nil {
+		mode = *vaultSpec.VaultRef.Mode
+	}
+	base := filepath.Clean(vaultSpec.VaultRef.MountPath)
+	for name, contents := range secretData {
+		if name == "." || name == ".." || strings.ContainsRune(name, '/') {
+			return &vaultSpec.Name, &repoName, nil, fmt.Errorf("invalid secret key %q: must be a single file name", name)
+		}
+		dest := filepath.Join(base, name)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return &vaultSpec.Name, &repoName, nil, fmt.Errorf("invalid secret-derived path %q: %w", dest, err)
+		}
+		ignitionWrapper.SetFile(dest, contents, mode, false, vaultSpec.VaultRef.User.String(), vaultSpec.VaultRef.Group)
+	}
+
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	var fingerprint *string
+	if version != "" {
+		fingerprint = &version
+	}
+	return &vaultSpec.Name, &repoName, fingerprint, nil
+}
+
+func (t *DeviceRenderLogic) renderOciConfig(ctx context.Context, configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, *string, error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	ref := ociSpec.OciRef
+	repoName := ref.Repository
+
+	repo, status := t.repositorySvc.GetRepository(ctx, t.orgId, repoName)
+	if status.Code != http.StatusOK {
+		return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, repoName, status.Message)
+	}
+
+	var artifact *cachedOciArtifact
+	var key kvstore.OciArtifactKey
+	needToStoreData := false
+
+	depFingerprint, depResourceKey := t.getDepChangeDetails()
+	if depResourceKey != ociResourceKey(repoName, ref.Artifact, ref.Reference) {
+		depFingerprint = ""
+	}
+
+	// Devices of a fleet share the digest a tag resolved to for a template version until the
+	// dependency sync reports that the tag moved.
+	if t.ownerFleet != nil {
+		key = kvstore.OciArtifactKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			Repository:      repoName,
+			Artifact:        ref.Artifact,
+			Reference:       ref.Reference,
+		}
+		data, err := t.kvStore.Get(ctx, key.ComposeKey())
+		if err != nil {
+			return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed fetching cached artifact: %w", err)
+		}
+		if data != nil {
+			var cached cachedOciArtifact
+			if err = json.Unmarshal(data, &cached); err != nil {
+				return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed parsing cached artifact: %w", err)
+			}
+			if depFingerprint != "" && cached.Digest != depFingerprint {
+				if err := t.kvStore.Delete(ctx, key.ComposeKey()); err != nil {
+					return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed invalidating artifact cache: %w", err)
+				}
+				needToStoreData = true
+			} else {
+				artifact = &cached
+			}
+		} else {
+			needToStoreData = true
+		}
+	}
+
+	if artifact == nil {
+		mfs, manifestDigest, err := t.fetchOciArtifact(ctx, repo, ref.Artifact, ref.Reference)
+		if err != nil {
+			return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed pulling artifact %s:%s from repository %s: %w", ref.Artifact, ref.Reference, repoName, err)
+		}
+		files, err := readFileSystemFiles(mfs)
+		if err != nil {
+			return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed reading artifact %s:%s: %w", ref.Artifact, ref.Reference, err)
+		}
+		artifact = &cachedOciArtifact{Files: files, Digest: manifestDigest}
+	}
+
+	if needToStoreData {
+		artifactToStore, err := json.Marshal(artifact)
+		if err != nil {
+			return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed marshalling artifact %s:%s: %w", ref.Artifact, ref.Reference, err)
+		}
+		if _, err := t.kvStore.SetNX(ctx, key.ComposeKey(), artifactToStore); err != nil {
+			return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed storing artifact %s:%s: %w", ref.Artifact, ref.Reference, err)
+		}
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return &ociSpec.Name, &repoName, nil, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	mode := 0o644
+	if ref.Mode != nil {
+		mode = *ref.Mode
+	}
+	base := filepath.Clean(ref.MountPath)
+	for name, contents := range artifact.Files {
+		dest := filepath.Join(base, name)
+		if err := validation.DenyForbiddenDevicePath(dest); err != nil {
+			return &ociSpec.Name, &repoName, nil, fmt.Errorf("invalid path from OCI artifact %q: %w", dest, err)
+		}
+		ignitionWrapper.SetFile(dest, contents, mode, false, ref.User.String(), ref.Group)
+	}
+
+	*ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(**ignitionConfig))
+	return &ociSpec.Name, &repoName, &artifact.Digest, nil
+}
+
+// readFileSystemFiles returns the contents of all regular files in mfs, keyed by their path
+// relative to its root.
+func readFileSystemFiles(mfs billy.Filesystem) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := billyutil.Walk(mfs, "/", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		contents, err := billyutil.ReadFile(mfs, path)
+		if err != nil {
+			return err
+		}
+		files[strings.TrimPrefix(path, "/")] = contents
+		return nil
+	})
+	return files, err
+}
+
 func (t *DeviceRenderLogic) renderInlineConfig(configItem *domain.ConfigProviderSpec, ignitionConfig **config_latest_types.Config) (*string, *string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -22,7 +22,11 @@ import (
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	billyutil "github.com/go-git/go-billy/v5/util"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -163,7 +167,7 @@ func newFleetOwnedLogic(
 	event domain.Event,
 	fleet, templateVersion string,
 ) DeviceRenderLogic {
-	l := NewDeviceRenderLogic(logrus.New(), nil, repositorySvc, nil, k8s, kv, &config.Config{}, orgId, event)
+	l := NewDeviceRenderLogic(logrus.New(), nil, repositorySvc, nil, nil, k8s, kv, &config.Config{}, orgId, event)
 	l.ownerFleet = &fleet
 	l.templateVersion = &templateVersion
 	return l
@@ -603,6 +607,184 @@ func TestRenderK8sConfig_CacheInvalidation(t *testing.T) {
 	}
 }
 
+// TestRenderVaultConfig_CacheInvalidation verifies that the cached KV version of a Vault
+// secret is invalidated when a dependency change reports a newer version.
+func TestRenderVaultConfig_CacheInvalidation(t *testing.T) {
+	const (
+		repoName    = "vault"
+		secretPath  = "edge/site-a"
+		fleet       = "my-fleet"
+		tmplVersion = "tv-1"
+		mountPath   = "/etc/site"
+	)
+
+	orgId := uuid.New()
+
+	tests := []struct {
+		name             string
+		eventFingerprint string
+		eventResourceKey string
+		expectDelete     bool
+		expectedValue    string
+	}{
+		{
+			name:             "When cached version matches event fingerprint it should serve from cache",
+			eventFingerprint: "2",
+			eventResourceKey: "vault:vault/edge/site-a",
+			expectedValue:    "cached",
+		},
+		{
+			name:             "When cached version is stale it should delete cache and re-read the secret",
+			eventFingerprint: "3",
+			eventResourceKey: "vault:vault/edge/site-a",
+			expectDelete:     true,
+			expectedValue:    "v3",
+		},
+		{
+			name:             "When resource key does not match it should serve from cache",
+			eventFingerprint: "3",
+			eventResourceKey: "vault:vault/edge/site-b",
+			expectedValue:    "cached",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newVaultStandIn(t, map[string]int{secretPath: 3})
+			repo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr(repoName)}, Spec: vaultRepoSpec(t, server.URL).Data}
+			mockSvc := repositoryservice.NewMockService(ctrl)
+			mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, repoName).Return(repo, statusOK)
+
+			kv := newTestKVStore()
+			vaultKey := kvstore.VaultSecretKey{OrgID: orgId, Fleet: fleet, TemplateVersion: tmplVersion, Repository: repoName, Path: secretPath}
+			cachedBytes, err := json.Marshal(cachedSecretData{Data: map[string][]byte{"value": []byte("cached")}, ResourceVersion: "2"})
+			require.NoError(t, err)
+			kv.seed(vaultKey.ComposeKey(), cachedBytes)
+
+			l := newFleetOwnedLogic(mockSvc, nil, kv, orgId, newDepChangeEvent("device1", tt.eventResourceKey, tt.eventFingerprint), fleet, tmplVersion)
+
+			configItem := domain.ConfigProviderSpec{}
+			vaultSpec := domain.VaultConfigProviderSpec{Name: "vault-config"}
+			vaultSpec.VaultRef.Repository = repoName
+			vaultSpec.VaultRef.Path = secretPath
+			vaultSpec.VaultRef.MountPath = mountPath
+			vaultSpec.VaultRef.Mode = lo.ToPtr(0o600)
+			require.NoError(t, configItem.FromVaultConfigProviderSpec(vaultSpec))
+			empty := emptyIgnitionConfig()
+			ignCfg := &empty
+
+			_, referencedRepo, _, err := l.renderVaultConfig(context.Background(), &configItem, &ignCfg)
+			require.NoError(t, err)
+			assert.Equal(t, repoName, lo.FromPtr(referencedRepo))
+			assert.Equal(t, tt.expectDelete, kv.wasDeleted(vaultKey.ComposeKey()))
+
+			require.Len(t, ignCfg.Storage.Files, 1)
+			file := ignCfg.Storage.Files[0]
+			assert.Equal(t, mountPath+"/value", file.Path)
+			assert.Equal(t, 0o600, lo.FromPtr(file.Mode))
+			assert.Equal(t, tt.expectedValue, decodeIgnitionFileContent(t, lo.FromPtr(file.Contents.Source)))
+		})
+	}
+}
+
+// TestRenderOciConfig_CacheInvalidation verifies that the artifact cached for a fleet template
+// version is re-pulled when a dependency change reports that its tag moved.
+func TestRenderOciConfig_CacheInvalidation(t *testing.T) {
+	const (
+		repoName    = "registry"
+		artifact    = "myorg/device-config"
+		reference   = "v1"
+		fleet       = "my-fleet"
+		tmplVersion = "tv-1"
+		mountPath   = "/etc/app"
+	)
+
+	orgId := uuid.New()
+
+	tests := []struct {
+		name             string
+		eventFingerprint string
+		eventResourceKey string
+		expectDelete     bool
+		expectedValue    string
+		expectedDigest   string
+	}{
+		{
+			name:             "When cached digest matches event fingerprint it should serve from cache",
+			eventFingerprint: "sha256:old",
+			eventResourceKey: "oci:registry/myorg/device-config:v1",
+			expectedValue:    "cached",
+			expectedDigest:   "sha256:old",
+		},
+		{
+			name:             "When cached digest is stale it should delete cache and re-pull the artifact",
+			eventFingerprint: "sha256:new",
+			eventResourceKey: "oci:registry/myorg/device-config:v1",
+			expectDelete:     true,
+			expectedValue:    "pulled",
+			expectedDigest:   "sha256:new",
+		},
+		{
+			name:             "When resource key does not match it should serve from cache",
+			eventFingerprint: "sha256:new",
+			eventResourceKey: "oci:registry/myorg/other:v1",
+			expectedValue:    "cached",
+			expectedDigest:   "sha256:old",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr(repoName)}}
+			mockSvc := repositoryservice.NewMockService(ctrl)
+			mockSvc.EXPECT().GetRepository(gomock.Any(), orgId, repoName).Return(repo, statusOK)
+
+			kv := newTestKVStore()
+			ociKey := kvstore.OciArtifactKey{OrgID: orgId, Fleet: fleet, TemplateVersion: tmplVersion, Repository: repoName, Artifact: artifact, Reference: reference}
+			cachedBytes, err := json.Marshal(cachedOciArtifact{Files: map[string][]byte{"conf.d/app.yaml": []byte("cached")}, Digest: "sha256:old"})
+			require.NoError(t, err)
+			kv.seed(ociKey.ComposeKey(), cachedBytes)
+
+			l := newFleetOwnedLogic(mockSvc, nil, kv, orgId, newDepChangeEvent("device1", tt.eventResourceKey, tt.eventFingerprint), fleet, tmplVersion)
+			l.fetchOciArtifact = func(_ context.Context, _ *domain.Repository, gotArtifact string, gotReference string) (billy.Filesystem, string, error) {
+				require.Equal(t, artifact, gotArtifact)
+				require.Equal(t, reference, gotReference)
+				mfs := memfs.New()
+				require.NoError(t, billyutil.WriteFile(mfs, "conf.d/app.yaml", []byte("pulled"), 0o644))
+				return mfs, "sha256:new", nil
+			}
+
+			configItem := domain.ConfigProviderSpec{}
+			ociSpec := domain.OciConfigProviderSpec{Name: "app-config"}
+			ociSpec.OciRef.Repository = repoName
+			ociSpec.OciRef.Artifact = artifact
+			ociSpec.OciRef.Reference = reference
+			ociSpec.OciRef.MountPath = mountPath
+			require.NoError(t, configItem.FromOciConfigProviderSpec(ociSpec))
+			empty := emptyIgnitionConfig()
+			ignCfg := &empty
+
+			_, referencedRepo, fingerprint, err := l.renderOciConfig(context.Background(), &configItem, &ignCfg)
+			require.NoError(t, err)
+			assert.Equal(t, repoName, lo.FromPtr(referencedRepo))
+			assert.Equal(t, tt.expectedDigest, lo.FromPtr(fingerprint))
+			assert.Equal(t, tt.expectDelete, kv.wasDeleted(ociKey.ComposeKey()))
+
+			require.Len(t, ignCfg.Storage.Files, 1)
+			file := ignCfg.Storage.Files[0]
+			assert.Equal(t, mountPath+"/conf.d/app.yaml", file.Path)
+			assert.Equal(t, 0o644, lo.FromPtr(file.Mode))
+			assert.Equal(t, tt.expectedValue, decodeIgnitionFileContent(t, lo.FromPtr(file.Contents.Source)))
+		})
+	}
+}
+
 // decodeIgnitionFileContent decodes the data URL (data:...;base64,<b64>) that ignition
 // uses to embed file contents and returns the raw bytes as a string.
 func decodeIgnitionFileContent(t *testing.T, source string) string {
@@ -744,7 +926,7 @@ func TestRenderDevice_PermanentError(t *testing.T) {
 	mockSvc.EXPECT().UpdateServerSideDeviceStatus(gomock.Any(), orgId, deviceName).Return(nil)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonFleetRolloutDeviceSelected, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -784,7 +966,7 @@ func TestRenderDevice_RetryableError(t *testing.T) {
 	mockK8S.EXPECT().GetSecret(gomock.Any(), namespace, secretName).Return(nil, fmt.Errorf("connection to apiserver: %w", io.EOF))
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, mockK8S, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, nil, mockK8S, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -824,7 +1006,7 @@ func TestRenderDevice_PermanentError_StandaloneDevice(t *testing.T) {
 	mockSvc.EXPECT().UpdateServerSideDeviceStatus(gomock.Any(), orgId, deviceName).Return(nil)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -873,7 +1055,7 @@ func TestRenderDevice_ExternalError_FleetOwned_NoAnnotations(t *testing.T) {
 	mockSvc.EXPECT().UpdateServerSideDeviceStatus(gomock.Any(), orgId, deviceName).Return(nil)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonFleetRolloutDeviceSelected, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -913,7 +1095,7 @@ func TestRenderDevice_PermanentAppError(t *testing.T) {
 	mockSvc.EXPECT().UpdateServerSideDeviceStatus(gomock.Any(), orgId, deviceName).Return(nil)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockSvc, nil, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -1004,7 +1186,7 @@ func TestRenderDevice_CatalogItemRef_ResolvesOsImage(t *testing.T) {
 	mockDeviceSvc.EXPECT().UpdateRenderedDevice(gomock.Any(), orgId, deviceName, gomock.Any(), gomock.Any(), gomock.Any(), expectedOsImage, gomock.Any(), gomock.Any()).Return(statusOK)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.NoError(t, err)
@@ -1039,7 +1221,7 @@ func TestRenderDevice_NoCatalogItemRef_PassesPlainOsImage(t *testing.T) {
 	mockDeviceSvc.EXPECT().UpdateRenderedDevice(gomock.Any(), orgId, deviceName, gomock.Any(), gomock.Any(), gomock.Any(), plainImage, gomock.Any(), gomock.Any()).Return(statusOK)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, nil, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.NoError(t, err)
@@ -1080,7 +1262,7 @@ func TestRenderDevice_CatalogRefAndPlainImage_ResolveIndependently(t *testing.T)
 	)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
 	require.NoError(t, logic.RenderDevice(context.Background()))
 
 	// Plain-image spec: passes the image through directly.
@@ -1100,7 +1282,7 @@ func TestRenderDevice_CatalogRefAndPlainImage_ResolveIndependently(t *testing.T)
 	)
 
 	event2 := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic2 := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event2)
+	logic2 := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event2)
 	require.NoError(t, logic2.RenderDevice(context.Background()))
 }
 
@@ -1130,7 +1312,7 @@ func TestRenderDevice_CatalogItemRef_WrongType(t *testing.T) {
 	mockDeviceSvc.EXPECT().UpdateServerSideDeviceStatus(gomock.Any(), orgId, deviceName).Return(nil)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -1162,7 +1344,7 @@ func TestRenderDevice_CatalogItemRef_UnknownVersion(t *testing.T) {
 	mockDeviceSvc.EXPECT().UpdateServerSideDeviceStatus(gomock.Any(), orgId, deviceName).Return(nil)
 
 	event := createTestEvent(domain.DeviceKind, domain.EventReasonResourceUpdated, deviceName)
-	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
+	logic := NewDeviceRenderLogic(logrus.New(), mockDeviceSvc, nil, nil, mockCatalogSvc, nil, newTestKVStore(), &config.Config{}, orgId, event)
 
 	err := logic.RenderDevice(context.Background())
 	require.Error(t, err)
@@ -1383,3 +1565,151 @@ func TestRenderApplication_ImageMountVolumeCatalogRef_ResolvesToImage(t *testing
 		assert.Equal(t, "/data", imgMountProvider.Mount.Path)
 	})
 }
+
+// TestRenderSecretConfig_CacheInvalidation verifies that the cached data of a Secret
+// resource is invalidated when a dependency change reports a different fingerprint.
+func TestRenderSecretConfig_CacheInvalidation(t *testing.T) {
+	const (
+		fleet       = "my-fleet"
+		tmplVersion = "tv-1"
+		secretName  = "db-credentials"
+		mountPath   = "/etc/db"
+	)
+
+	orgId := uuid.New()
+	cachedData := map[string]string{"password": base64.StdEncoding.EncodeToString([]byte("cached"))}
+	currentData := map[string]string{"password": base64.StdEncoding.EncodeToString([]byte("rotated"))}
+
+	tests := []struct {
+		name             string
+		eventFingerprint string
+		eventResourceKey string
+		expectFetch      bool
+		expectedValue    string
+	}{
+		{
+			name:             "When cached fingerprint matches event fingerprint it should serve from cache",
+			eventFingerprint: nativeSecretFingerprint(cachedData),
+			eventResourceKey: nativeSecretResourceKey(secretName),
+			expectedValue:    "cached",
+		},
+		{
+			name:             "When cached fingerprint is stale it should delete cache and re-read the secret",
+			eventFingerprint: nativeSecretFingerprint(currentData),
+			eventResourceKey: nativeSecretResourceKey(secretName),
+			expectFetch:      true,
+			expectedValue:    "rotated",
+		},
+		{
+			name:             "When resource key does not match it should serve from cache",
+			eventFingerprint: nativeSecretFingerprint(currentData),
+			eventResourceKey: nativeSecretResourceKey("other"),
+			expectedValue:    "cached",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretSvc := secretservice.NewMockService(ctrl)
+			if tt.expectFetch {
+				mockSecretSvc.EXPECT().GetSecret(gomock.Any(), orgId, secretName).Return(
+					&domain.Secret{Spec: domain.SecretSpec{Data: currentData}}, statusOK)
+			}
+
+			kv := newTestKVStore()
+			key := kvstore.NativeSecretKey{OrgID: orgId, Fleet: fleet, TemplateVersion: tmplVersion, Name: secretName}
+			cachedBytes, err := json.Marshal(cachedNativeSecretData{Data: cachedData, Fingerprint: nativeSecretFingerprint(cachedData)})
+			require.NoError(t, err)
+			kv.seed(key.ComposeKey(), cachedBytes)
+
+			l := newFleetOwnedLogic(nil, nil, kv, orgId, newDepChangeEvent("device1", tt.eventResourceKey, tt.eventFingerprint), fleet, tmplVersion)
+			l.secretSvc = mockSecretSvc
+
+			configItem := domain.ConfigProviderSpec{}
+			secretSpec := domain.SecretConfigProviderSpec{Name: "db-config"}
+			secretSpec.SecretResourceRef.Name = secretName
+			secretSpec.SecretResourceRef.MountPath = mountPath
+			require.NoError(t, configItem.FromSecretConfigProviderSpec(secretSpec))
+			empty := emptyIgnitionConfig()
+			ignCfg := &empty
+
+			_, _, _, err = l.renderSecretConfig(context.Background(), &configItem, &ignCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectFetch, kv.wasDeleted(key.ComposeKey()))
+
+			require.Len(t, ignCfg.Storage.Files, 1)
+			file := ignCfg.Storage.Files[0]
+			assert.Equal(t, mountPath+"/password", file.Path)
+			assert.Equal(t, tt.expectedValue, decodeIgnitionFileContent(t, lo.FromPtr(file.Contents.Source)))
+		})
+	}
+}
+
+func TestResolveApplicationEnvFrom(t *testing.T) {
+	orgId := uuid.New()
+	secretData := map[string]string{
+		"USER":     base64.StdEncoding.EncodeToString([]byte("admin")),
+		"PASSWORD": base64.StdEncoding.EncodeToString([]byte("s3cr3t")),
+	}
+
+	makeApp := func(t *testing.T, envVars map[string]string, envFrom []domain.ApplicationEnvFromSource) domain.ApplicationProviderSpec {
+		t.Helper()
+		containerApp := domain.ContainerApplication{
+			AppType: domain.AppTypeContainer,
+			Name:    lo.ToPtr("db"),
+			EnvVars: lo.Ternary(envVars != nil, &envVars, nil),
+			EnvFrom: &envFrom,
+		}
+		require.NoError(t, containerApp.FromImageApplicationProviderSpec(domain.ImageApplicationProviderSpec{Image: "quay.io/test/db:v1"}))
+		var app domain.ApplicationProviderSpec
+		require.NoError(t, app.FromContainerApplication(containerApp))
+		return app
+	}
+
+	t.Run("When envFrom references a secret it should replace it with prefixed envVars", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSecretSvc := secretservice.NewMockService(ctrl)
+		mockSecretSvc.EXPECT().GetSecret(gomock.Any(), orgId, "db-credentials").Return(&domain.Secret{Spec: domain.SecretSpec{Data: secretData}}, statusOK)
+
+		l := NewDeviceRenderLogic(logrus.New(), nil, nil, mockSecretSvc, nil, nil, newTestKVStore(), &config.Config{}, orgId, domain.Event{})
+		app := makeApp(t, map[string]string{"DB_USER": "explicit"}, []domain.ApplicationEnvFromSource{
+			{SecretRef: domain.SecretReference{Name: "db-credentials"}, Prefix: lo.ToPtr("DB_")},
+		})
+
+		require.NoError(t, l.resolveApplicationEnvFrom(context.Background(), &app))
+
+		container, err := app.AsContainerApplication()
+		require.NoError(t, err)
+		assert.Nil(t, container.EnvFrom)
+		assert.Equal(t, map[string]string{"DB_USER": "explicit", "DB_PASSWORD": "s3cr3t"}, lo.FromPtr(container.EnvVars))
+	})
+
+	t.Run("When a secret key is not a valid variable name it should fail", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSecretSvc := secretservice.NewMockService(ctrl)
+		mockSecretSvc.EXPECT().GetSecret(gomock.Any(), orgId, "tls").Return(
+			&domain.Secret{Spec: domain.SecretSpec{Data: map[string]string{"tls.crt": "Y2VydA=="}}}, statusOK)
+
+		l := NewDeviceRenderLogic(logrus.New(), nil, nil, mockSecretSvc, nil, nil, newTestKVStore(), &config.Config{}, orgId, domain.Event{})
+		app := makeApp(t, nil, []domain.ApplicationEnvFromSource{{SecretRef: domain.SecretReference{Name: "tls"}}})
+
+		require.Error(t, l.resolveApplicationEnvFrom(context.Background(), &app))
+	})
+
+	t.Run("When the secret does not exist it should fail", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSecretSvc := secretservice.NewMockService(ctrl)
+		mockSecretSvc.EXPECT().GetSecret(gomock.Any(), orgId, "missing").Return(nil, domain.StatusResourceNotFound(domain.SecretKind, "missing"))
+
+		l := NewDeviceRenderLogic(logrus.New(), nil, nil, mockSecretSvc, nil, nil, newTestKVStore(), &config.Config{}, orgId, domain.Event{})
+		app := makeApp(t, nil, []domain.ApplicationEnvFromSource{{SecretRef: domain.SecretReference{Name: "missing"}}})
+
+		require.Error(t, l.resolveApplicationEnvFrom(context.Background(), &app))
+	})
+}
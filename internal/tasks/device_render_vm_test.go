@@ -469,11 +469,11 @@ func TestVmRenderOptionsFromConfig(t *testing.T) {
 		}
 	}`), cfg))
 
-	logic := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, cfg, [16]byte{}, domain.Event{})
+	logic := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, nil, cfg, [16]byte{}, domain.Event{})
 	assert.Equal(t, "registry.example.com/kubevirt/virt-launcher:custom", logic.vmRenderOptions.LauncherImage)
 	assert.False(t, logic.vmRenderOptions.PasstWorkarounds)
 
-	defaults := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, &config.Config{}, [16]byte{}, domain.Event{})
+	defaults := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, nil, &config.Config{}, [16]byte{}, domain.Event{})
 	assert.Equal(t, config.DefaultVirtLauncherImage, defaults.vmRenderOptions.LauncherImage)
 	assert.False(t, defaults.vmRenderOptions.PasstWorkarounds)
 }
@@ -547,7 +547,7 @@ func TestBindVmLauncher_SelectsPerOSImage(t *testing.T) {
 		}
 	}`), cfg))
 
-	logic := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, cfg, [16]byte{}, domain.Event{})
+	logic := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, nil, cfg, [16]byte{}, domain.Event{})
 	device := &domain.Device{Status: &domain.DeviceStatus{}}
 	device.Status.SystemInfo.Set(deviceDistroIdKey, "rhel")
 	device.Status.SystemInfo.Set(deviceDistroVersionKey, "10.0 (Coughlan)")
@@ -569,7 +569,7 @@ func TestBindVmLauncher_UnknownOSUsesDefault(t *testing.T) {
 		}
 	}`), cfg))
 
-	logic := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, cfg, [16]byte{}, domain.Event{})
+	logic := NewDeviceRenderLogic(logrus.New(), nil, nil, nil, nil, nil, nil, cfg, [16]byte{}, domain.Event{})
 	device := &domain.Device{Status: &domain.DeviceStatus{}}
 	device.Status.SystemInfo.Set(deviceDistroIdKey, "fedora")
 	device.Status.SystemInfo.Set(deviceDistroVersionKey, "42 (Adams)")
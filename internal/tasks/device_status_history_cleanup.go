@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DeviceStatusHistoryCleanupPollingInterval is the interval at which the device status history cleanup task runs.
+	DeviceStatusHistoryCleanupPollingInterval = 10 * time.Minute
+	DeviceStatusHistoryCleanupTaskName        = "device-status-history-cleanup"
+)
+
+type DeviceStatusHistoryCleanup struct {
+	log             logrus.FieldLogger
+	deviceSvc       deviceservice.Service
+	retentionPeriod util.Duration
+}
+
+func NewDeviceStatusHistoryCleanup(log logrus.FieldLogger, deviceSvc deviceservice.Service, retentionPeriod util.Duration) *DeviceStatusHistoryCleanup {
+	return &DeviceStatusHistoryCleanup{
+		log:             log,
+		deviceSvc:       deviceSvc,
+		retentionPeriod: retentionPeriod,
+	}
+}
+
+// Poll deletes device status transitions older than the configured retention period
+func (t *DeviceStatusHistoryCleanup) Poll(ctx context.Context) {
+	t.log.Infof("Running DeviceStatusHistoryCleanup Polling (retention period: %s)", t.retentionPeriod.String())
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cutoffTime := time.Now().Add(-time.Duration(t.retentionPeriod))
+	numDeleted, status := t.deviceSvc.DeleteDeviceStatusHistoryOlderThan(ctx, cutoffTime)
+	if status.Code != http.StatusOK {
+		t.log.Errorf("failed to clean up device status history: %s", status.Message)
+		return
+	}
+	t.log.Infof("cleaned up %d device status history entries", numDeleted)
+}
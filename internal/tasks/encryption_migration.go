@@ -128,6 +128,8 @@ func NewEncryptionMigrator(lifecycleCtx context.Context, db *gorm.DB, manager *e
 	m.RegisterResource(newRepositoryEncryptionResource(db, manager))
 	m.RegisterResource(newAuthProviderEncryptionResource(db, manager))
 	m.RegisterResource(newDeviceEncryptionResource(db, manager))
+	m.RegisterResource(newDeviceRenderedVersionEncryptionResource(db, manager))
+	m.RegisterResource(newSecretEncryptionResource(db, manager))
 	return m
 }
 
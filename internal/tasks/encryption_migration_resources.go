@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -191,6 +193,148 @@ func (r *deviceMigratableRow) Persist(ctx context.Context) error {
 	return persistMigratedModel(ctx, r.db, r.row, r.row.OrgID, r.row.Name, expected, []string{"RenderedConfig", "RenderedApplications", "ResourceVersion", "UpdatedAt"})
 }
 
+// deviceRenderedVersionEncryptionResource pages retained rendered versions by (device name,
+// rendered version). Row names have the form "<device>/<version>".
+type deviceRenderedVersionEncryptionResource struct {
+	db      *gorm.DB
+	mgr     *encryption.Manager
+	handler encryption.ModelEncryptHandler
+}
+
+func newDeviceRenderedVersionEncryptionResource(db *gorm.DB, mgr *encryption.Manager) *deviceRenderedVersionEncryptionResource {
+	return &deviceRenderedVersionEncryptionResource{
+		db:      db,
+		mgr:     mgr,
+		handler: model.EncryptionHandlers()[domain.DeviceRenderedVersionKind],
+	}
+}
+
+func (r *deviceRenderedVersionEncryptionResource) Kind() string {
+	return domain.DeviceRenderedVersionKind
+}
+
+func (r *deviceRenderedVersionEncryptionResource) NextPage(ctx context.Context, orgID uuid.UUID, afterName string, limit int) ([]EncryptionMigratableRow, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	var rows []model.DeviceRenderedVersion
+	q := r.db.WithContext(ctx).Model(&model.DeviceRenderedVersion{}).
+		Where("org_id = ?", orgID).
+		Order("device_name ASC, rendered_version ASC").
+		Limit(limit)
+	if afterName != "" {
+		deviceName, version, err := parseDeviceRenderedVersionRowName(afterName)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Where("(device_name, rendered_version) > (?, ?)", deviceName, version)
+	}
+	if err := q.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]EncryptionMigratableRow, 0, len(rows))
+	for i := range rows {
+		out = append(out, &deviceRenderedVersionMigratableRow{db: r.db, mgr: r.mgr, handler: r.handler, row: &rows[i]})
+	}
+	return out, nil
+}
+
+func parseDeviceRenderedVersionRowName(name string) (string, int64, error) {
+	idx := strings.LastIndex(name, "/")
+	if idx < 0 {
+		return "", 0, fmt.Errorf("invalid rendered version checkpoint %q", name)
+	}
+	version, err := strconv.ParseInt(name[idx+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid rendered version checkpoint %q: %w", name, err)
+	}
+	return name[:idx], version, nil
+}
+
+type deviceRenderedVersionMigratableRow struct {
+	db      *gorm.DB
+	mgr     *encryption.Manager
+	handler encryption.ModelEncryptHandler
+	row     *model.DeviceRenderedVersion
+}
+
+func (r *deviceRenderedVersionMigratableRow) OrgID() uuid.UUID { return r.row.OrgID }
+func (r *deviceRenderedVersionMigratableRow) Name() string {
+	return fmt.Sprintf("%s/%d", r.row.DeviceName, r.row.RenderedVersion)
+}
+
+func (r *deviceRenderedVersionMigratableRow) Migrate(ctx context.Context, encrypt encryption.EncryptFunc) (bool, []string, error) {
+	return migrateModelRow(ctx, r.row, domain.DeviceRenderedVersionKind, encrypt, r.mgr, r.handler)
+}
+
+// Persist writes the re-encrypted blobs. Retained versions are immutable, so there is no
+// resource version to check; a row trimmed in the meantime is simply skipped.
+func (r *deviceRenderedVersionMigratableRow) Persist(ctx context.Context) error {
+	return r.db.WithContext(ctx).Model(r.row).
+		Where("org_id = ? AND device_name = ? AND rendered_version = ?", r.row.OrgID, r.row.DeviceName, r.row.RenderedVersion).
+		Select("RenderedConfig", "RenderedApplications").
+		Updates(r.row).Error
+}
+
+type secretEncryptionResource struct {
+	db      *gorm.DB
+	mgr     *encryption.Manager
+	handler encryption.ModelEncryptHandler
+}
+
+func newSecretEncryptionResource(db *gorm.DB, mgr *encryption.Manager) *secretEncryptionResource {
+	return &secretEncryptionResource{
+		db:      db,
+		mgr:     mgr,
+		handler: model.EncryptionHandlers()[domain.SecretKind],
+	}
+}
+
+func (r *secretEncryptionResource) Kind() string { return domain.SecretKind }
+
+func (r *secretEncryptionResource) NextPage(ctx context.Context, orgID uuid.UUID, afterName string, limit int) ([]EncryptionMigratableRow, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	var rows []model.Secret
+	q := r.db.WithContext(ctx).Model(&model.Secret{}).
+		Where("org_id = ? AND spec IS NOT NULL", orgID).
+		Order("name ASC").
+		Limit(limit)
+	if afterName != "" {
+		q = q.Where("name > ?", afterName)
+	}
+	if err := q.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make([]EncryptionMigratableRow, 0, len(rows))
+	for i := range rows {
+		out = append(out, &secretMigratableRow{db: r.db, mgr: r.mgr, handler: r.handler, row: &rows[i]})
+	}
+	return out, nil
+}
+
+type secretMigratableRow struct {
+	db      *gorm.DB
+	mgr     *encryption.Manager
+	handler encryption.ModelEncryptHandler
+	row     *model.Secret
+}
+
+func (r *secretMigratableRow) OrgID() uuid.UUID { return r.row.OrgID }
+func (r *secretMigratableRow) Name() string     { return r.row.Name }
+
+func (r *secretMigratableRow) Migrate(ctx context.Context, encrypt encryption.EncryptFunc) (bool, []string, error) {
+	return migrateModelRow(ctx, r.row, domain.SecretKind, encrypt, r.mgr, r.handler)
+}
+
+func (r *secretMigratableRow) Persist(ctx context.Context) error {
+	expected := resourceVersionValue(r.row.ResourceVersion)
+	newRV := expected + 1
+	r.row.ResourceVersion = &newRV
+	return persistMigratedModel(ctx, r.db, r.row, r.row.OrgID, r.row.Name, expected, []string{"Spec", "ResourceVersion", "UpdatedAt"})
+}
+
 func migrateModelRow(ctx context.Context, row any, kind string, encrypt encryption.EncryptFunc, mgr *encryption.Manager, handler encryption.ModelEncryptHandler) (bool, []string, error) {
 	if handler == nil {
 		return false, nil, fmt.Errorf("missing encryption handler for %s", kind)
@@ -0,0 +1,330 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	"github.com/flightctl/flightctl/internal/service/common"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// FleetAutoUpgradeInterval is the interval at which fleets with an auto-upgrade policy are checked for
+	// newer catalog item versions on their channels. Schedule windows are validated to be at least as long.
+	FleetAutoUpgradeInterval = domain.FleetAutoUpgradeCheckInterval
+)
+
+// catalogItemUpgrade is a single catalog item reference in a fleet template that moves to a newer version.
+type catalogItemUpgrade struct {
+	catalog     string
+	item        string
+	channel     string
+	fromVersion string
+	toVersion   string
+}
+
+func (u catalogItemUpgrade) String() string {
+	return fmt.Sprintf("%s/%s@%s:%s->%s", u.catalog, u.item, u.channel, u.fromVersion, u.toVersion)
+}
+
+func (u catalogItemUpgrade) details() domain.FleetAutoUpgradeDetails {
+	return domain.FleetAutoUpgradeDetails{
+		Catalog:     u.catalog,
+		Item:        u.item,
+		Channel:     u.channel,
+		FromVersion: u.fromVersion,
+		ToVersion:   u.toVersion,
+	}
+}
+
+// upgradePlan returns the canonical representation of a set of upgrades, used as the value of the pending
+// and approved annotations so that an approval only applies to the exact upgrades it was given for.
+func upgradePlan(upgrades []catalogItemUpgrade) string {
+	entries := lo.Map(upgrades, func(u catalogItemUpgrade, _ int) string { return u.String() })
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+type FleetAutoUpgrade struct {
+	log        logrus.FieldLogger
+	fleetSvc   fleetservice.Service
+	catalogSvc catalogservice.Service
+	eventSvc   eventservice.Service
+	nowFn      func() time.Time
+}
+
+func NewFleetAutoUpgrade(log logrus.FieldLogger, fleetSvc fleetservice.Service, catalogSvc catalogservice.Service, eventSvc eventservice.Service) *FleetAutoUpgrade {
+	return &FleetAutoUpgrade{
+		log:        log,
+		fleetSvc:   fleetSvc,
+		catalogSvc: catalogSvc,
+		eventSvc:   eventSvc,
+		nowFn:      time.Now,
+	}
+}
+
+// Poll upgrades the catalog item references of fleets with an auto-upgrade policy to the newer versions
+// available on their channels.
+func (t *FleetAutoUpgrade) Poll(ctx context.Context, orgID uuid.UUID) {
+	t.log.Info("Running FleetAutoUpgrade Polling")
+
+	listParams := domain.ListFleetsParams{
+		Limit: lo.ToPtr(int32(ItemsPerPage)),
+	}
+	for {
+		if ctx.Err() != nil {
+			t.log.Warn("Context cancelled during fleet auto-upgrade, stopping early")
+			return
+		}
+
+		fleets, status := t.fleetSvc.ListFleets(ctx, orgID, listParams)
+		if status.Code != http.StatusOK {
+			t.log.Errorf("Failed to list fleets: %s", status.Message)
+			return
+		}
+
+		// Catalog items are shared by many fleets, so fetch each one once per page
+		catalogItems := map[string]*domain.CatalogItem{}
+		for i := range fleets.Items {
+			fleet := &fleets.Items[i]
+			if fleet.Spec.AutoUpgrade == nil {
+				continue
+			}
+			if err := t.syncFleet(ctx, orgID, fleet, catalogItems); err != nil {
+				t.log.Errorf("Failed to auto-upgrade fleet %s: %v", lo.FromPtr(fleet.Metadata.Name), err)
+			}
+		}
+
+		if fleets.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = fleets.Metadata.Continue
+	}
+}
+
+func (t *FleetAutoUpgrade) syncFleet(ctx context.Context, orgID uuid.UUID, fleet *domain.Fleet, catalogItems map[string]*domain.CatalogItem) error {
+	name := lo.FromPtr(fleet.Metadata.Name)
+	if fleet.Metadata.Owner != nil {
+		// The owner (e.g. a ResourceSync) is the source of truth for the template and would revert the upgrade
+		t.log.Debugf("Skipping auto-upgrade of fleet %s owned by %s", name, *fleet.Metadata.Owner)
+		return nil
+	}
+
+	upgrades, err := t.findUpgrades(ctx, orgID, &fleet.Spec.Template.Spec, catalogItems)
+	if err != nil {
+		return err
+	}
+	plan := upgradePlan(upgrades)
+	pending, _ := fleet.GetAnnotation(domain.FleetAnnotationAutoUpgradePending)
+	approved, _ := fleet.GetAnnotation(domain.FleetAnnotationAutoUpgradeApproved)
+
+	if len(upgrades) == 0 {
+		if pending != "" || approved != "" {
+			return t.updateAnnotations(ctx, orgID, name, nil, domain.FleetAnnotationAutoUpgradePending, domain.FleetAnnotationAutoUpgradeApproved)
+		}
+		return nil
+	}
+
+	var waitReason string
+	switch {
+	case lo.FromPtr(fleet.Spec.AutoUpgrade.RequireApproval) && approved != plan:
+		waitReason = fmt.Sprintf("waiting for approval by setting the %s annotation to %q", domain.FleetAnnotationAutoUpgradeApproved, plan)
+	case fleet.Spec.AutoUpgrade.Schedule != nil:
+		ready, err := isScheduleWindowOpen(fleet.Spec.AutoUpgrade.Schedule, t.nowFn())
+		if err != nil {
+			return fmt.Errorf("invalid auto-upgrade schedule: %w", err)
+		}
+		if !ready {
+			waitReason = fmt.Sprintf("waiting for the schedule window %q", fleet.Spec.AutoUpgrade.Schedule.At)
+		}
+	}
+
+	if waitReason != "" {
+		if pending == plan {
+			return nil
+		}
+		deleteKeys := []string{}
+		if approved != "" && approved != plan {
+			deleteKeys = append(deleteKeys, domain.FleetAnnotationAutoUpgradeApproved)
+		}
+		if err := t.updateAnnotations(ctx, orgID, name, map[string]string{domain.FleetAnnotationAutoUpgradePending: plan}, deleteKeys...); err != nil {
+			return err
+		}
+		for _, u := range upgrades {
+			t.eventSvc.CreateEvent(ctx, orgID, common.GetFleetAutoUpgradePendingEvent(ctx, name, u.details(), waitReason))
+		}
+		return nil
+	}
+
+	return t.applyUpgrades(ctx, orgID, fleet, upgrades)
+}
+
+// findUpgrades returns the upgrades available for the catalog item references in the given spec that
+// follow a channel.
+func (t *FleetAutoUpgrade) findUpgrades(ctx context.Context, orgID uuid.UUID, spec *domain.DeviceSpec, catalogItems map[string]*domain.CatalogItem) ([]catalogItemUpgrade, error) {
+	var upgrades []catalogItemUpgrade
+	seen := map[catalogItemUpgrade]struct{}{}
+	for _, ref := range common.CollectCatalogItemRefs(spec) {
+		channel := lo.FromPtr(ref.Channel)
+		if channel == "" {
+			continue
+		}
+
+		key := ref.Catalog + "/" + ref.Item
+		item, ok := catalogItems[key]
+		if !ok {
+			var status domain.Status
+			item, status = t.catalogSvc.GetCatalogItem(ctx, orgID, ref.Catalog, ref.Item)
+			switch {
+			case status.Code == http.StatusNotFound:
+				item = nil
+			case status.Code != http.StatusOK:
+				return nil, fmt.Errorf("getting catalog item %s: %s", key, status.Message)
+			}
+			catalogItems[key] = item
+		}
+		if item == nil {
+			continue
+		}
+
+		target := item.Spec.UpgradeTarget(ref.Version, channel)
+		if target == nil {
+			continue
+		}
+		upgrade := catalogItemUpgrade{
+			catalog:     ref.Catalog,
+			item:        ref.Item,
+			channel:     channel,
+			fromVersion: ref.Version,
+			toVersion:   target.Version,
+		}
+		if _, ok := seen[upgrade]; ok {
+			continue
+		}
+		seen[upgrade] = struct{}{}
+		upgrades = append(upgrades, upgrade)
+	}
+	return upgrades, nil
+}
+
+// applyUpgrades rewrites the fleet template to the upgraded versions, which makes the fleet roll out a new
+// TemplateVersion, and clears the pending upgrade.
+func (t *FleetAutoUpgrade) applyUpgrades(ctx context.Context, orgID uuid.UUID, fleet *domain.Fleet, upgrades []catalogItemUpgrade) error {
+	name := lo.FromPtr(fleet.Metadata.Name)
+	spec, err := upgradeCatalogItemRefs(&fleet.Spec.Template.Spec, upgrades)
+	if err != nil {
+		return err
+	}
+	fleet.Spec.Template.Spec = *spec
+
+	annotations := lo.OmitByKeys(lo.FromPtr(fleet.Metadata.Annotations),
+		[]string{domain.FleetAnnotationAutoUpgradePending, domain.FleetAnnotationAutoUpgradeApproved})
+	fleet.Metadata.Annotations = &annotations
+	fleet.Status = nil
+
+	if _, status := t.fleetSvc.ReplaceFleet(ctx, orgID, name, *fleet, false); status.Code != http.StatusOK {
+		return fmt.Errorf("updating fleet template: %s", status.Message)
+	}
+
+	for _, u := range upgrades {
+		t.log.Infof("Upgraded catalog item %s/%s in fleet %s from version %s to %s", u.catalog, u.item, name, u.fromVersion, u.toVersion)
+		t.eventSvc.CreateEvent(ctx, orgID, common.GetFleetAutoUpgradedEvent(ctx, name, u.details()))
+	}
+	return nil
+}
+
+func (t *FleetAutoUpgrade) updateAnnotations(ctx context.Context, orgID uuid.UUID, name string, annotations map[string]string, deleteKeys ...string) error {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if status := t.fleetSvc.UpdateFleetAnnotations(ctx, orgID, name, annotations, deleteKeys); status.Code != http.StatusOK {
+		return fmt.Errorf("updating fleet annotations: %s", status.Message)
+	}
+	return nil
+}
+
+// upgradeCatalogItemRefs returns a copy of the spec with the versions of the upgraded catalog item references
+// replaced. References appear in the OS spec, in applications and in application volumes, so the spec is
+// rewritten in its JSON form rather than per provider type.
+func upgradeCatalogItemRefs(spec *domain.DeviceSpec, upgrades []catalogItemUpgrade) (*domain.DeviceSpec, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling device spec: %w", err)
+	}
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshalling device spec: %w", err)
+	}
+
+	var walk func(node any)
+	walk = func(node any) {
+		switch n := node.(type) {
+		case map[string]any:
+			if ref, ok := n["catalogItemRef"].(map[string]any); ok {
+				for _, u := range upgrades {
+					if ref["catalog"] == u.catalog && ref["item"] == u.item && ref["channel"] == u.channel && ref["version"] == u.fromVersion {
+						ref["version"] = u.toVersion
+						break
+					}
+				}
+			}
+			for _, v := range n {
+				walk(v)
+			}
+		case []any:
+			for _, v := range n {
+				walk(v)
+			}
+		}
+	}
+	walk(doc)
+
+	if data, err = json.Marshal(doc); err != nil {
+		return nil, fmt.Errorf("marshalling upgraded device spec: %w", err)
+	}
+	var upgraded domain.DeviceSpec
+	if err := json.Unmarshal(data, &upgraded); err != nil {
+		return nil, fmt.Errorf("unmarshalling upgraded device spec: %w", err)
+	}
+	return &upgraded, nil
+}
+
+// isScheduleWindowOpen reports whether now falls within the start grace duration of the most recent time
+// the schedule's cron expression fired, the same window devices use for their update schedules.
+func isScheduleWindowOpen(schedule *domain.UpdateSchedule, now time.Time) (bool, error) {
+	location := time.Local
+	if schedule.TimeZone != nil {
+		loc, err := time.LoadLocation(*schedule.TimeZone)
+		if err != nil {
+			return false, fmt.Errorf("invalid time zone: %w", err)
+		}
+		location = loc
+	}
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	cronExpr, err := parser.Parse(schedule.At)
+	if err != nil {
+		return false, fmt.Errorf("invalid cron expression: %w", err)
+	}
+	grace, err := time.ParseDuration(schedule.StartGraceDuration)
+	if err != nil {
+		return false, fmt.Errorf("invalid start grace duration: %w", err)
+	}
+
+	now = now.In(location)
+	nextRun := cronExpr.Next(now)
+	interval := cronExpr.Next(nextRun).Sub(nextRun)
+	lastRun := cronExpr.Next(now.Add(-interval))
+	return now.Equal(lastRun) || (now.After(lastRun) && !now.After(lastRun.Add(grace))), nil
+}
@@ -0,0 +1,281 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	"github.com/flightctl/flightctl/internal/service/common"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+const autoUpgradePlan = "infra/base-os@stable:1.0.0->1.1.0"
+
+func autoUpgradeCatalogItem() *domain.CatalogItem {
+	return &domain.CatalogItem{
+		Metadata: domain.CatalogItemMeta{Name: lo.ToPtr("base-os")},
+		Spec: domain.CatalogItemSpec{
+			Versions: []domain.CatalogItemVersion{
+				{Version: "1.0.0", Channels: []string{"stable"}},
+				{Version: "1.1.0", Channels: []string{"stable"}, Replaces: lo.ToPtr("1.0.0")},
+			},
+		},
+	}
+}
+
+func autoUpgradeFleet(policy *domain.FleetAutoUpgradePolicy, channel *string, annotations map[string]string) *domain.Fleet {
+	return &domain.Fleet{
+		Metadata: domain.ObjectMeta{
+			Name:        lo.ToPtr("test-fleet"),
+			Annotations: &annotations,
+		},
+		Spec: domain.FleetSpec{
+			AutoUpgrade: policy,
+			Template: struct {
+				Metadata *domain.ObjectMeta `json:"metadata,omitempty"`
+				Spec     domain.DeviceSpec  `json:"spec"`
+			}{
+				Spec: domain.DeviceSpec{
+					Os: &domain.DeviceOsSpec{
+						CatalogItemRef: &domain.CatalogItemRefSpec{
+							Catalog: "infra",
+							Item:    "base-os",
+							Version: "1.0.0",
+							Channel: channel,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func matchesAutoUpgradeEvent(reason domain.EventReason) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		event, ok := x.(*domain.Event)
+		if !ok || event.Reason != reason || event.Details == nil {
+			return false
+		}
+		details, err := event.Details.AsFleetAutoUpgradeDetails()
+		return err == nil && details.FromVersion == "1.0.0" && details.ToVersion == "1.1.0" && details.Channel == "stable"
+	})
+}
+
+func TestFleetAutoUpgrade_Poll(t *testing.T) {
+	ok := domain.Status{Code: http.StatusOK}
+
+	tests := []struct {
+		name   string
+		fleet  *domain.Fleet
+		expect func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService)
+	}{
+		{
+			name:  "When a newer version is on the channel it upgrades the template and emits an event",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{}, lo.ToPtr("stable"), map[string]string{"keep": "me"}),
+			expect: func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService) {
+				fleetSvc.EXPECT().ReplaceFleet(gomock.Any(), gomock.Any(), "test-fleet", gomock.Any(), false).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, fleet domain.Fleet, _ bool) (*domain.Fleet, domain.Status) {
+						require.Equal(t, "1.1.0", fleet.Spec.Template.Spec.Os.CatalogItemRef.Version)
+						require.Equal(t, map[string]string{"keep": "me"}, *fleet.Metadata.Annotations)
+						return &fleet, ok
+					})
+				eventSvc.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), matchesAutoUpgradeEvent(domain.EventReasonFleetAutoUpgraded))
+			},
+		},
+		{
+			name:  "When the reference has no channel it is left alone",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{}, nil, map[string]string{}),
+		},
+		{
+			name:  "When the fleet has no auto-upgrade policy it is left alone",
+			fleet: autoUpgradeFleet(nil, lo.ToPtr("stable"), map[string]string{}),
+		},
+		{
+			name: "When the fleet is owned by a ResourceSync it is left alone",
+			fleet: func() *domain.Fleet {
+				fleet := autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{}, lo.ToPtr("stable"), map[string]string{})
+				fleet.Metadata.Owner = lo.ToPtr("ResourceSync/fleets")
+				return fleet
+			}(),
+		},
+		{
+			name:  "When approval is required it records the pending upgrade and emits an event",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{RequireApproval: lo.ToPtr(true)}, lo.ToPtr("stable"), map[string]string{}),
+			expect: func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService) {
+				fleetSvc.EXPECT().UpdateFleetAnnotations(gomock.Any(), gomock.Any(), "test-fleet",
+					map[string]string{domain.FleetAnnotationAutoUpgradePending: autoUpgradePlan}, []string{}).Return(ok)
+				eventSvc.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), matchesAutoUpgradeEvent(domain.EventReasonFleetAutoUpgradePending))
+			},
+		},
+		{
+			name: "When the pending upgrade is already recorded it does nothing",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{RequireApproval: lo.ToPtr(true)}, lo.ToPtr("stable"),
+				map[string]string{domain.FleetAnnotationAutoUpgradePending: autoUpgradePlan}),
+		},
+		{
+			name: "When the approval is for another upgrade it is dropped",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{RequireApproval: lo.ToPtr(true)}, lo.ToPtr("stable"),
+				map[string]string{domain.FleetAnnotationAutoUpgradeApproved: "infra/base-os@stable:0.9.0->1.0.0"}),
+			expect: func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService) {
+				fleetSvc.EXPECT().UpdateFleetAnnotations(gomock.Any(), gomock.Any(), "test-fleet",
+					map[string]string{domain.FleetAnnotationAutoUpgradePending: autoUpgradePlan},
+					[]string{domain.FleetAnnotationAutoUpgradeApproved}).Return(ok)
+				eventSvc.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), matchesAutoUpgradeEvent(domain.EventReasonFleetAutoUpgradePending))
+			},
+		},
+		{
+			name: "When the pending upgrade is approved it upgrades the template and clears the annotations",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{RequireApproval: lo.ToPtr(true)}, lo.ToPtr("stable"),
+				map[string]string{
+					domain.FleetAnnotationAutoUpgradePending:  autoUpgradePlan,
+					domain.FleetAnnotationAutoUpgradeApproved: autoUpgradePlan,
+				}),
+			expect: func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService) {
+				fleetSvc.EXPECT().ReplaceFleet(gomock.Any(), gomock.Any(), "test-fleet", gomock.Any(), false).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, fleet domain.Fleet, _ bool) (*domain.Fleet, domain.Status) {
+						require.Equal(t, "1.1.0", fleet.Spec.Template.Spec.Os.CatalogItemRef.Version)
+						require.Empty(t, *fleet.Metadata.Annotations)
+						return &fleet, ok
+					})
+				eventSvc.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), matchesAutoUpgradeEvent(domain.EventReasonFleetAutoUpgraded))
+			},
+		},
+		{
+			name: "When the schedule window is closed it records the pending upgrade",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{
+				Schedule: &domain.UpdateSchedule{At: "0 2 * * *", StartGraceDuration: "1h", TimeZone: lo.ToPtr("UTC")},
+			}, lo.ToPtr("stable"), map[string]string{}),
+			expect: func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService) {
+				fleetSvc.EXPECT().UpdateFleetAnnotations(gomock.Any(), gomock.Any(), "test-fleet",
+					map[string]string{domain.FleetAnnotationAutoUpgradePending: autoUpgradePlan}, []string{}).Return(ok)
+				eventSvc.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), matchesAutoUpgradeEvent(domain.EventReasonFleetAutoUpgradePending))
+			},
+		},
+		{
+			name: "When the schedule window is open it upgrades the template",
+			fleet: autoUpgradeFleet(&domain.FleetAutoUpgradePolicy{
+				Schedule: &domain.UpdateSchedule{At: "0 12 * * *", StartGraceDuration: "1h", TimeZone: lo.ToPtr("UTC")},
+			}, lo.ToPtr("stable"), map[string]string{}),
+			expect: func(fleetSvc *fleetservice.MockService, eventSvc *eventservice.MockService) {
+				fleetSvc.EXPECT().ReplaceFleet(gomock.Any(), gomock.Any(), "test-fleet", gomock.Any(), false).Return(&domain.Fleet{}, ok)
+				eventSvc.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), matchesAutoUpgradeEvent(domain.EventReasonFleetAutoUpgraded))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			orgId := uuid.New()
+			mockFleetSvc := fleetservice.NewMockService(ctrl)
+			mockCatalogSvc := catalogservice.NewMockService(ctrl)
+			mockEventSvc := eventservice.NewMockService(ctrl)
+
+			mockFleetSvc.EXPECT().ListFleets(gomock.Any(), orgId, gomock.Any()).
+				Return(&domain.FleetList{Items: []domain.Fleet{*tt.fleet}}, domain.Status{Code: http.StatusOK})
+			mockCatalogSvc.EXPECT().GetCatalogItem(gomock.Any(), orgId, "infra", "base-os").
+				Return(autoUpgradeCatalogItem(), domain.Status{Code: http.StatusOK}).AnyTimes()
+			if tt.expect != nil {
+				tt.expect(mockFleetSvc, mockEventSvc)
+			}
+
+			task := NewFleetAutoUpgrade(logrus.New(), mockFleetSvc, mockCatalogSvc, mockEventSvc)
+			task.nowFn = func() time.Time { return time.Date(2026, 3, 14, 12, 30, 0, 0, time.UTC) }
+			task.Poll(context.Background(), orgId)
+		})
+	}
+}
+
+func TestFleetAutoUpgrade_FindUpgradesAcrossPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgId := uuid.New()
+	mockFleetSvc := fleetservice.NewMockService(ctrl)
+	mockCatalogSvc := catalogservice.NewMockService(ctrl)
+	mockEventSvc := eventservice.NewMockService(ctrl)
+
+	policy := &domain.FleetAutoUpgradePolicy{RequireApproval: lo.ToPtr(true)}
+	first := autoUpgradeFleet(policy, lo.ToPtr("stable"), map[string]string{domain.FleetAnnotationAutoUpgradePending: autoUpgradePlan})
+	second := autoUpgradeFleet(policy, lo.ToPtr("stable"), map[string]string{domain.FleetAnnotationAutoUpgradePending: autoUpgradePlan})
+	second.Metadata.Name = lo.ToPtr("other-fleet")
+
+	gomock.InOrder(
+		mockFleetSvc.EXPECT().ListFleets(gomock.Any(), orgId, gomock.Any()).
+			Return(&domain.FleetList{Items: []domain.Fleet{*first}, Metadata: domain.ListMeta{Continue: lo.ToPtr("next")}}, domain.Status{Code: http.StatusOK}),
+		mockFleetSvc.EXPECT().ListFleets(gomock.Any(), orgId, gomock.Cond(func(x any) bool {
+			return lo.FromPtr(x.(domain.ListFleetsParams).Continue) == "next"
+		})).Return(&domain.FleetList{Items: []domain.Fleet{*second}}, domain.Status{Code: http.StatusOK}),
+	)
+	mockCatalogSvc.EXPECT().GetCatalogItem(gomock.Any(), orgId, "infra", "base-os").
+		Return(autoUpgradeCatalogItem(), domain.Status{Code: http.StatusOK}).Times(2)
+
+	NewFleetAutoUpgrade(logrus.New(), mockFleetSvc, mockCatalogSvc, mockEventSvc).Poll(context.Background(), orgId)
+}
+
+func TestUpgradeCatalogItemRefs(t *testing.T) {
+	container := domain.ContainerApplication{
+		AppType: domain.AppTypeContainer,
+		Name:    lo.ToPtr("keycloak"),
+	}
+	require.NoError(t, container.FromCatalogItemRefApplicationProviderSpec(domain.CatalogItemRefApplicationProviderSpec{
+		CatalogItemRef: domain.CatalogItemRefSpec{Catalog: "infra", Item: "keycloak", Version: "24.0.4", Channel: lo.ToPtr("stable")},
+	}))
+	var app domain.ApplicationProviderSpec
+	require.NoError(t, app.FromContainerApplication(container))
+
+	spec := &domain.DeviceSpec{
+		Os: &domain.DeviceOsSpec{
+			CatalogItemRef: &domain.CatalogItemRefSpec{Catalog: "infra", Item: "base-os", Version: "1.0.0", Channel: lo.ToPtr("fast")},
+		},
+		Applications: &[]domain.ApplicationProviderSpec{app},
+	}
+
+	upgraded, err := upgradeCatalogItemRefs(spec, []catalogItemUpgrade{
+		{catalog: "infra", item: "keycloak", channel: "stable", fromVersion: "24.0.4", toVersion: "24.0.5"},
+		{catalog: "infra", item: "base-os", channel: "stable", fromVersion: "1.0.0", toVersion: "1.1.0"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "1.0.0", upgraded.Os.CatalogItemRef.Version, "references on other channels must not change")
+	refs := common.CollectCatalogItemRefs(upgraded)
+	require.Len(t, refs, 2)
+	require.Equal(t, "24.0.5", refs[1].Version)
+	require.Equal(t, "24.0.4", common.CollectCatalogItemRefs(spec)[1].Version, "the original spec must not change")
+}
+
+func TestIsScheduleWindowOpen(t *testing.T) {
+	schedule := &domain.UpdateSchedule{At: "0 2 * * 6", StartGraceDuration: "2h", TimeZone: lo.ToPtr("America/New_York")}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{name: "at the start of the window", now: time.Date(2026, 3, 7, 7, 0, 0, 0, time.UTC), want: true},
+		{name: "within the grace duration", now: time.Date(2026, 3, 7, 8, 30, 0, 0, time.UTC), want: true},
+		{name: "after the grace duration", now: time.Date(2026, 3, 7, 9, 1, 0, 0, time.UTC), want: false},
+		{name: "on another day", now: time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isScheduleWindowOpen(schedule, tt.now)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := isScheduleWindowOpen(&domain.UpdateSchedule{At: "0 2 * * 6", StartGraceDuration: "2h", TimeZone: lo.ToPtr("Nowhere/Special")}, time.Now())
+	require.Error(t, err)
+}
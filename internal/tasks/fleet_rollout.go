@@ -325,6 +325,9 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	deviceApps, appErrs := f.getDeviceApps(device, templateVersion)
 	errs = append(errs, appErrs...)
 
+	registryMirrors, mirrorErrs := getDeviceRegistryMirrors(device, templateVersion)
+	errs = append(errs, mirrorErrs...)
+
 	if len(errs) > 0 {
 		annotations := map[string]string{
 			domain.DeviceAnnotationLastRolloutError: errors.Join(errs...).Error(),
@@ -340,12 +343,13 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	}
 
 	newDeviceSpec := domain.DeviceSpec{
-		Config:       deviceConfig,
-		Os:           osSpec,
-		Systemd:      templateVersion.Status.Systemd,
-		Resources:    templateVersion.Status.Resources,
-		Applications: deviceApps,
-		UpdatePolicy: templateVersion.Status.UpdatePolicy,
+		Config:          deviceConfig,
+		Os:              osSpec,
+		Systemd:         templateVersion.Status.Systemd,
+		Resources:       templateVersion.Status.Resources,
+		Applications:    deviceApps,
+		UpdatePolicy:    templateVersion.Status.UpdatePolicy,
+		RegistryMirrors: registryMirrors,
 	}
 
 	errs = newDeviceSpec.Validate(false)
@@ -370,6 +374,43 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	return depRefs, nil
 }
 
+// getDeviceRegistryMirrors replaces the parameters in the locations of the fleet template's
+// registry mirrors. Mirrors whose location resolves to an empty string are omitted, which allows
+// selecting a site's mirror by a device label, and so are registries left without mirrors.
+func getDeviceRegistryMirrors(device *domain.Device, templateVersion *domain.TemplateVersion) (*[]domain.RegistryMirror, []error) {
+	if templateVersion.Status.RegistryMirrors == nil {
+		return nil, nil
+	}
+
+	registryMirrors := []domain.RegistryMirror{}
+	errs := []error{}
+	for _, registryMirror := range *templateVersion.Status.RegistryMirrors {
+		mirrors := []domain.RegistryMirrorLocation{}
+		for _, mirror := range registryMirror.Mirrors {
+			location, err := ReplaceParametersInString(mirror.Location, device)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed replacing parameters in mirror of registry %s: %w", registryMirror.Registry, err))
+				continue
+			}
+			location = strings.TrimSpace(location)
+			if location == "" {
+				continue
+			}
+			mirrors = append(mirrors, domain.RegistryMirrorLocation{Location: location, Insecure: mirror.Insecure})
+		}
+		if len(mirrors) > 0 {
+			registryMirrors = append(registryMirrors, domain.RegistryMirror{Registry: registryMirror.Registry, Mirrors: mirrors})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	if len(registryMirrors) == 0 {
+		return nil, nil
+	}
+	return &registryMirrors, nil
+}
+
 // getDeviceApps evaluates the fleet template's applications against the device's labels
 // (parameter substitution). The device's DeviceAnnotationApplicationLifecycle annotation is
 // not overlaid here: it is applied by the device render task directly onto
@@ -842,6 +883,24 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 			for _, ref := range refs {
 				depRefs[ref.ResourceKey] = ref
 			}
+		case domain.VaultConfigProviderType:
+			var refs []model.DependencyRef
+			newConfigItem, refs, errs = f.replaceVaultConfigParameters(device, configItem)
+			for _, ref := range refs {
+				depRefs[ref.ResourceKey] = ref
+			}
+		case domain.OciConfigProviderType:
+			var refs []model.DependencyRef
+			newConfigItem, refs, errs = f.replaceOciConfigParameters(device, configItem)
+			for _, ref := range refs {
+				depRefs[ref.ResourceKey] = ref
+			}
+		case domain.SecretConfigProviderType:
+			var refs []model.DependencyRef
+			newConfigItem, refs, errs = f.replaceSecretConfigParameters(device, configItem)
+			for _, ref := range refs {
+				depRefs[ref.ResourceKey] = ref
+			}
 		default:
 			errs = append(errs, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType))
 		}
@@ -961,6 +1020,51 @@ func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Dev
 	return &newConfigItem, refs, nil
 }
 
+func (f FleetRolloutsLogic) replaceSecretConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+	secretSpec, err := configItem.AsSecretConfigProviderSpec()
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed to convert config to secret config: %w", err)}
+	}
+
+	errs := []error{}
+	originalName := secretSpec.SecretResourceRef.Name
+
+	secretSpec.SecretResourceRef.Name, err = ReplaceParametersInString(secretSpec.SecretResourceRef.Name, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in name in secret config %s: %w", secretSpec.Name, err))
+	}
+
+	secretSpec.SecretResourceRef.MountPath, err = ReplaceParametersInString(secretSpec.SecretResourceRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in secret config %s: %w", secretSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	var refs []model.DependencyRef
+	if isParameterized(originalName) {
+		deviceName := lo.FromPtr(device.Metadata.Name)
+		ownerFleetName, _, _ := getOwnerFleet(device)
+		refs = append(refs, model.DependencyRef{
+			FleetName:   &ownerFleetName,
+			DeviceName:  &deviceName,
+			RefType:     "native-secret",
+			ResourceKey: nativeSecretResourceKey(secretSpec.SecretResourceRef.Name),
+			SecretName:  &secretSpec.SecretResourceRef.Name,
+		})
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromSecretConfigProviderSpec(secretSpec)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed converting secret config: %w", err)}
+	}
+
+	return &newConfigItem, refs, nil
+}
+
 func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -1071,6 +1175,99 @@ func (f FleetRolloutsLogic) replaceHTTPConfigParameters(device *domain.Device, c
 	return &newConfigItem, refs, nil
 }
 
+func (f FleetRolloutsLogic) replaceVaultConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+	vaultSpec, err := configItem.AsVaultConfigProviderSpec()
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed to convert config to vault config: %w", err)}
+	}
+
+	errs := []error{}
+	originalPath := vaultSpec.VaultRef.Path
+
+	vaultSpec.VaultRef.Path, err = ReplaceParametersInString(vaultSpec.VaultRef.Path, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in path in vault config %s: %w", vaultSpec.Name, err))
+	}
+
+	vaultSpec.VaultRef.MountPath, err = ReplaceParametersInString(vaultSpec.VaultRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mount path in vault config %s: %w", vaultSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	var refs []model.DependencyRef
+	if isParameterized(originalPath) {
+		deviceName := lo.FromPtr(device.Metadata.Name)
+		ownerFleetName, _, _ := getOwnerFleet(device)
+		refs = append(refs, model.DependencyRef{
+			FleetName:      &ownerFleetName,
+			DeviceName:     &deviceName,
+			RefType:        "vault",
+			ResourceKey:    vaultResourceKey(vaultSpec.VaultRef.Repository, vaultSpec.VaultRef.Path),
+			RepositoryName: &vaultSpec.VaultRef.Repository,
+			VaultPath:      &vaultSpec.VaultRef.Path,
+		})
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromVaultConfigProviderSpec(vaultSpec)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed converting vault config: %w", err)}
+	}
+
+	return &newConfigItem, refs, nil
+}
+
+func (f FleetRolloutsLogic) replaceOciConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed to convert config to oci config: %w", err)}
+	}
+
+	errs := []error{}
+	originalReference := ociSpec.OciRef.Reference
+
+	ociSpec.OciRef.Reference, err = ReplaceParametersInString(ociSpec.OciRef.Reference, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in reference in oci config %s: %w", ociSpec.Name, err))
+	}
+
+	ociSpec.OciRef.MountPath, err = ReplaceParametersInString(ociSpec.OciRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mount path in oci config %s: %w", ociSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	var refs []model.DependencyRef
+	if isParameterized(originalReference) {
+		deviceName := lo.FromPtr(device.Metadata.Name)
+		ownerFleetName, _, _ := getOwnerFleet(device)
+		refs = append(refs, model.DependencyRef{
+			FleetName:      &ownerFleetName,
+			DeviceName:     &deviceName,
+			RefType:        "oci",
+			ResourceKey:    ociResourceKey(ociSpec.OciRef.Repository, ociSpec.OciRef.Artifact, ociSpec.OciRef.Reference),
+			RepositoryName: &ociSpec.OciRef.Repository,
+			OciArtifact:    &ociSpec.OciRef.Artifact,
+			Revision:       &ociSpec.OciRef.Reference,
+		})
+	}
+
+	newConfigItem := domain.ConfigProviderSpec{}
+	err = newConfigItem.FromOciConfigProviderSpec(ociSpec)
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("failed converting oci config: %w", err)}
+	}
+
+	return &newConfigItem, refs, nil
+}
+
 func (f FleetRolloutsLogic) updateDeviceInStore(ctx context.Context, device *domain.Device, newDeviceSpec *domain.DeviceSpec, templateVersionName string, delayDeviceRender bool) error {
 	var status domain.Status
 	setAnnotations := map[string]string{domain.DeviceAnnotationTemplateVersion: templateVersionName}
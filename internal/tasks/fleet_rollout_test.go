@@ -946,6 +946,68 @@ func TestFleetRolloutsLogic_ReplaceContainerApplicationParameters(t *testing.T)
 	}
 }
 
+func TestGetDeviceRegistryMirrors(t *testing.T) {
+	registryMirrors := []domain.RegistryMirror{
+		{
+			Registry: "quay.io",
+			Mirrors: []domain.RegistryMirrorLocation{
+				{Location: `{{ getOrDefault .metadata.labels "registry-mirror" "" }}`, Insecure: lo.ToPtr(true)},
+				{Location: "registry.example.com/quay"},
+			},
+		},
+		{
+			Registry: "registry.redhat.io",
+			Mirrors:  []domain.RegistryMirrorLocation{{Location: `{{ getOrDefault .metadata.labels "registry-mirror" "" }}`}},
+		},
+	}
+	templateVersion := &domain.TemplateVersion{Status: &domain.TemplateVersionStatus{RegistryMirrors: &registryMirrors}}
+
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		expected *[]domain.RegistryMirror
+	}{
+		{
+			name:   "mirror selected by label",
+			labels: map[string]string{"registry-mirror": "10.0.0.5:5000"},
+			expected: &[]domain.RegistryMirror{
+				{
+					Registry: "quay.io",
+					Mirrors: []domain.RegistryMirrorLocation{
+						{Location: "10.0.0.5:5000", Insecure: lo.ToPtr(true)},
+						{Location: "registry.example.com/quay"},
+					},
+				},
+				{
+					Registry: "registry.redhat.io",
+					Mirrors:  []domain.RegistryMirrorLocation{{Location: "10.0.0.5:5000"}},
+				},
+			},
+		},
+		{
+			name:   "no mirror for the device's site",
+			labels: map[string]string{},
+			expected: &[]domain.RegistryMirror{
+				{
+					Registry: "quay.io",
+					Mirrors:  []domain.RegistryMirrorLocation{{Location: "registry.example.com/quay"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			device := createTestDeviceWithLabels("mydevice", "fleet/test", tt.labels)
+
+			result, errs := getDeviceRegistryMirrors(device, templateVersion)
+			require.Empty(errs)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
 func TestFleetRolloutsLogic_ReplaceHelmApplicationParameters(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -13,6 +13,7 @@ import (
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
 	templateversionservice "github.com/flightctl/flightctl/internal/service/templateversion"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
@@ -36,8 +37,8 @@ import (
 // This design avoids unnecessary object creation, ensures consistency, and allows
 // safe reprocessing of the task without side effects.
 
-func fleetValidate(ctx context.Context, orgId uuid.UUID, event domain.Event, fleetSvc fleetservice.Service, templateversionSvc templateversionservice.Service, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, k8sClient k8sclient.K8SClient, log logrus.FieldLogger) error {
-	logic := NewFleetValidateLogic(log, fleetSvc, templateversionSvc, deviceSvc, repositorySvc, k8sClient, orgId, event)
+func fleetValidate(ctx context.Context, orgId uuid.UUID, event domain.Event, fleetSvc fleetservice.Service, templateversionSvc templateversionservice.Service, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, secretSvc secretservice.Service, k8sClient k8sclient.K8SClient, log logrus.FieldLogger) error {
+	logic := NewFleetValidateLogic(log, fleetSvc, templateversionSvc, deviceSvc, repositorySvc, secretSvc, k8sClient, orgId, event)
 	switch {
 	case event.InvolvedObject.Kind == domain.FleetKind:
 		err := logic.CreateNewTemplateVersionIfFleetValid(ctx)
@@ -56,19 +57,21 @@ type FleetValidateLogic struct {
 	templateversionSvc templateversionservice.Service
 	deviceSvc          deviceservice.Service
 	repositorySvc      repositoryservice.Service
+	secretSvc          secretservice.Service
 	k8sClient          k8sclient.K8SClient
 	orgId              uuid.UUID
 	event              domain.Event
 	templateConfig     *[]domain.ConfigProviderSpec
 }
 
-func NewFleetValidateLogic(log logrus.FieldLogger, fleetSvc fleetservice.Service, templateversionSvc templateversionservice.Service, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, k8sClient k8sclient.K8SClient, orgId uuid.UUID, event domain.Event) FleetValidateLogic {
+func NewFleetValidateLogic(log logrus.FieldLogger, fleetSvc fleetservice.Service, templateversionSvc templateversionservice.Service, deviceSvc deviceservice.Service, repositorySvc repositoryservice.Service, secretSvc secretservice.Service, k8sClient k8sclient.K8SClient, orgId uuid.UUID, event domain.Event) FleetValidateLogic {
 	return FleetValidateLogic{
 		log:                log,
 		fleetSvc:           fleetSvc,
 		templateversionSvc: templateversionSvc,
 		deviceSvc:          deviceSvc,
 		repositorySvc:      repositorySvc,
+		secretSvc:          secretSvc,
 		k8sClient:          k8sClient,
 		orgId:              orgId,
 		event:              event,
@@ -104,12 +107,13 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		},
 		Spec: domain.TemplateVersionSpec{Fleet: *fleet.Metadata.Name},
 		Status: &domain.TemplateVersionStatus{
-			Applications: fleet.Spec.Template.Spec.Applications,
-			Config:       fleet.Spec.Template.Spec.Config,
-			Os:           fleet.Spec.Template.Spec.Os,
-			Resources:    fleet.Spec.Template.Spec.Resources,
-			Systemd:      fleet.Spec.Template.Spec.Systemd,
-			UpdatePolicy: fleet.Spec.Template.Spec.UpdatePolicy,
+			Applications:    fleet.Spec.Template.Spec.Applications,
+			Config:          fleet.Spec.Template.Spec.Config,
+			Os:              fleet.Spec.Template.Spec.Os,
+			Resources:       fleet.Spec.Template.Spec.Resources,
+			Systemd:         fleet.Spec.Template.Spec.Systemd,
+			UpdatePolicy:    fleet.Spec.Template.Spec.UpdatePolicy,
+			RegistryMirrors: fleet.Spec.Template.Spec.RegistryMirrors,
 		},
 	}
 
@@ -211,6 +215,12 @@ func (t *FleetValidateLogic) validateConfigItem(ctx context.Context, configItem
 		return t.validateInlineConfig(configItem)
 	case domain.HttpConfigProviderType:
 		return t.validateHttpProviderConfig(ctx, configItem)
+	case domain.VaultConfigProviderType:
+		return t.validateVaultConfig(ctx, configItem)
+	case domain.OciConfigProviderType:
+		return t.validateOciConfig(ctx, configItem)
+	case domain.SecretConfigProviderType:
+		return t.validateSecretConfig(ctx, configItem)
 	default:
 		return nil, nil, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -250,6 +260,24 @@ func (t *FleetValidateLogic) validateK8sConfig(ctx context.Context, configItem *
 	return &k8sSpec.Name, nil, nil
 }
 
+// validateSecretConfig checks that a secretResourceRef references an existing Secret resource of
+// the organization. Parameterized names are resolved per device at render time.
+func (t *FleetValidateLogic) validateSecretConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	secretSpec, err := configItem.AsSecretConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as SecretConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	if isParameterized(secretSpec.SecretResourceRef.Name) {
+		return &secretSpec.Name, nil, nil
+	}
+	_, status := t.secretSvc.GetSecret(ctx, t.orgId, secretSpec.SecretResourceRef.Name)
+	if status.Code != http.StatusOK {
+		return &secretSpec.Name, nil, fmt.Errorf("failed getting Secret %s: %s", secretSpec.SecretResourceRef.Name, status.Message)
+	}
+
+	return &secretSpec.Name, nil, nil
+}
+
 func (t *FleetValidateLogic) validateInlineConfig(configItem *domain.ConfigProviderSpec) (*string, *string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -278,6 +306,48 @@ func (t *FleetValidateLogic) validateHttpProviderConfig(ctx context.Context, con
 	return &httpConfigProviderSpec.Name, &httpConfigProviderSpec.HttpRef.Repository, nil
 }
 
+func (t *FleetValidateLogic) validateVaultConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	vaultSpec, err := configItem.AsVaultConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as VaultConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	repo, status := t.repositorySvc.GetRepository(ctx, t.orgId, vaultSpec.VaultRef.Repository)
+	if status.Code != http.StatusOK {
+		return &vaultSpec.Name, &vaultSpec.VaultRef.Repository, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, vaultSpec.VaultRef.Repository, status.Message)
+	}
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return &vaultSpec.Name, &vaultSpec.VaultRef.Repository, fmt.Errorf("failed to determine repository type: %w", err)
+	}
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeVault {
+		return &vaultSpec.Name, &vaultSpec.VaultRef.Repository, fmt.Errorf("repository %s is of type %q, but vaultRef requires a repository of type %q", vaultSpec.VaultRef.Repository, repoType, domain.RepoSpecTypeVault)
+	}
+
+	return &vaultSpec.Name, &vaultSpec.VaultRef.Repository, nil
+}
+
+func (t *FleetValidateLogic) validateOciConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	repo, status := t.repositorySvc.GetRepository(ctx, t.orgId, ociSpec.OciRef.Repository)
+	if status.Code != http.StatusOK {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, ociSpec.OciRef.Repository, status.Message)
+	}
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, fmt.Errorf("failed to determine repository type: %w", err)
+	}
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeOci {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, fmt.Errorf("repository %s is of type %q, but ociRef requires a repository of type %q", ociSpec.OciRef.Repository, repoType, domain.RepoSpecTypeOci)
+	}
+
+	return &ociSpec.Name, &ociSpec.OciRef.Repository, nil
+}
+
 func (t *FleetValidateLogic) getFingerprint() string {
 	if t.event.Reason != domain.EventReasonDependencyChangeDetected || t.event.Details == nil {
 		return ""
@@ -96,7 +96,7 @@ func TestFleetValidateLogic_CreateNewTemplateVersionIfFleetValid_ImmediateRollou
 			mockFleetSvc.EXPECT().UpdateFleetConditions(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(domain.Status{Code: http.StatusOK})
 
 			// Create FleetValidateLogic instance
-			logic := NewFleetValidateLogic(log, mockFleetSvc, mockTemplateVersionSvc, mockDeviceSvc, mockRepositorySvc, mockK8SClient, orgId, event)
+			logic := NewFleetValidateLogic(log, mockFleetSvc, mockTemplateVersionSvc, mockDeviceSvc, mockRepositorySvc, nil, mockK8SClient, orgId, event)
 
 			// Execute
 			err := logic.CreateNewTemplateVersionIfFleetValid(context.Background())
@@ -268,3 +268,13 @@ func makeSecretConfigItem(t *testing.T, name, namespace, secretName string) doma
 	require.NoError(t, item.FromKubernetesSecretProviderSpec(*secretSpec))
 	return item
 }
+
+func makeSecretResourceConfigItem(t *testing.T, name, secretName string) domain.ConfigProviderSpec {
+	t.Helper()
+	secretSpec := &domain.SecretConfigProviderSpec{Name: name}
+	secretSpec.SecretResourceRef.Name = secretName
+	secretSpec.SecretResourceRef.MountPath = "/etc/secrets"
+	item := domain.ConfigProviderSpec{}
+	require.NoError(t, item.FromSecretConfigProviderSpec(*secretSpec))
+	return item
+}
@@ -14,6 +14,21 @@ import (
 )
 
 func sendHTTPrequest(ctx context.Context, repoSpec domain.RepositorySpec, repoURL string) ([]byte, error) {
+	resp, err := openHTTPrequest(ctx, repoSpec, repoURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	return body, nil
+}
+
+// openHTTPrequest sends a GET request authenticated for the HTTP repository. It returns the
+// response if the request succeeded, whose body the caller must close.
+func openHTTPrequest(ctx context.Context, repoSpec domain.RepositorySpec, repoURL string) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", repoURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
@@ -36,15 +51,11 @@ func sendHTTPrequest(ctx context.Context, repoSpec domain.RepositorySpec, repoUR
 	if err != nil {
 		return nil, fmt.Errorf("sending request: %w", err)
 	}
-	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
 		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
 	}
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading response body: %w", err)
-	}
-	return body, nil
+	return resp, nil
 }
 
 func buildHttpRepoRequestAuth(repoHttpSpec domain.HttpRepoSpec, req *http.Request) (*http.Request, *tls.Config, error) {
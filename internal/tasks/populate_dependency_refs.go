@@ -13,6 +13,7 @@ import (
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -52,6 +53,7 @@ func (p PopulateDependencyRefsLogic) PopulateForFleet(ctx context.Context, fleet
 	}
 
 	refs := collectConfigRefs(p.log, fleet.Spec.Template.Spec.Config, fleetName, "")
+	refs = append(refs, collectEnvFromRefs(p.log, fleet.Spec.Template.Spec.Applications, fleetName, "")...)
 	if st := p.dependencyrefSvc.ReplaceDependencyRefsByFleet(ctx, p.orgId, fleetName, refs); st.Code != http.StatusOK {
 		return fmt.Errorf("replacing dependency refs for fleet %s: %s", fleetName, st.Message)
 	}
@@ -75,11 +77,14 @@ func (p PopulateDependencyRefsLogic) PopulateForDevice(ctx context.Context, devi
 	}
 
 	var config *[]domain.ConfigProviderSpec
+	var applications *[]domain.ApplicationProviderSpec
 	if device.Spec != nil {
 		config = device.Spec.Config
+		applications = device.Spec.Applications
 	}
 
 	refs := collectConfigRefs(p.log, config, "", deviceName)
+	refs = append(refs, collectEnvFromRefs(p.log, applications, "", deviceName)...)
 	if st := p.dependencyrefSvc.ReplaceStandaloneDeviceDependencyRefs(ctx, p.orgId, deviceName, refs); st.Code != http.StatusOK {
 		return fmt.Errorf("replacing dependency refs for standalone device %s: %s", deviceName, st.Message)
 	}
@@ -210,6 +215,68 @@ func collectConfigRefs(log logrus.FieldLogger, config *[]domain.ConfigProviderSp
 				SecretNamespace:    &k8sSpec.SecretRef.Namespace,
 				ConfigProviderName: k8sSpec.Name,
 			}
+		case domain.SecretConfigProviderType:
+			secretSpec, err := configItem.AsSecretConfigProviderSpec()
+			if err != nil {
+				log.WithError(err).Warn("skipping Secret config item that failed to decode")
+				continue
+			}
+			if isParameterized(secretSpec.SecretResourceRef.Name) {
+				continue
+			}
+			warnDuplicateConfigName(log, seenNames, warnedNames, secretSpec.Name, i, fleetName, deviceName)
+			addNativeSecretRef(refs, secretSpec.SecretResourceRef.Name, secretSpec.Name, fleetName, deviceName)
+		case domain.VaultConfigProviderType:
+			vaultSpec, err := configItem.AsVaultConfigProviderSpec()
+			if err != nil {
+				log.WithError(err).Warn("skipping Vault config item that failed to decode")
+				continue
+			}
+			if isParameterized(vaultSpec.VaultRef.Path) {
+				continue
+			}
+			warnDuplicateConfigName(log, seenNames, warnedNames, vaultSpec.Name, i, fleetName, deviceName)
+			key := vaultResourceKey(vaultSpec.VaultRef.Repository, vaultSpec.VaultRef.Path)
+			if _, exists := refs[key]; exists {
+				continue
+			}
+			fn := fleetName
+			dn := deviceName
+			refs[key] = model.DependencyRef{
+				FleetName:          &fn,
+				DeviceName:         &dn,
+				RefType:            "vault",
+				ResourceKey:        key,
+				RepositoryName:     &vaultSpec.VaultRef.Repository,
+				VaultPath:          &vaultSpec.VaultRef.Path,
+				ConfigProviderName: vaultSpec.Name,
+			}
+		case domain.OciConfigProviderType:
+			ociSpec, err := configItem.AsOciConfigProviderSpec()
+			if err != nil {
+				log.WithError(err).Warn("skipping OCI config item that failed to decode")
+				continue
+			}
+			if isParameterized(ociSpec.OciRef.Reference) {
+				continue
+			}
+			warnDuplicateConfigName(log, seenNames, warnedNames, ociSpec.Name, i, fleetName, deviceName)
+			key := ociResourceKey(ociSpec.OciRef.Repository, ociSpec.OciRef.Artifact, ociSpec.OciRef.Reference)
+			if _, exists := refs[key]; exists {
+				continue
+			}
+			fn := fleetName
+			dn := deviceName
+			refs[key] = model.DependencyRef{
+				FleetName:          &fn,
+				DeviceName:         &dn,
+				RefType:            "oci",
+				ResourceKey:        key,
+				RepositoryName:     &ociSpec.OciRef.Repository,
+				OciArtifact:        &ociSpec.OciRef.Artifact,
+				Revision:           &ociSpec.OciRef.Reference,
+				ConfigProviderName: ociSpec.Name,
+			}
 		}
 	}
 
@@ -220,6 +287,85 @@ func collectConfigRefs(log logrus.FieldLogger, config *[]domain.ConfigProviderSp
 	return result
 }
 
+// collectEnvFromRefs returns dependency refs for the Secret resources that applications
+// reference via envFrom.
+func collectEnvFromRefs(log logrus.FieldLogger, applications *[]domain.ApplicationProviderSpec, fleetName string, deviceName string) []model.DependencyRef {
+	if applications == nil {
+		return nil
+	}
+
+	refs := make(map[string]model.DependencyRef)
+	for _, app := range *applications {
+		appName, envFrom, err := applicationEnvFrom(app)
+		if err != nil {
+			log.WithError(err).Warn("skipping application that failed to decode during dependency ref collection")
+			continue
+		}
+		for _, source := range envFrom {
+			if isParameterized(source.SecretRef.Name) {
+				continue
+			}
+			addNativeSecretRef(refs, source.SecretRef.Name, appName, fleetName, deviceName)
+		}
+	}
+
+	result := make([]model.DependencyRef, 0, len(refs))
+	for _, ref := range refs {
+		result = append(result, ref)
+	}
+	return result
+}
+
+// applicationEnvFrom returns the name and envFrom sources of an application, if its type
+// supports them.
+func applicationEnvFrom(app domain.ApplicationProviderSpec) (string, []domain.ApplicationEnvFromSource, error) {
+	appType, err := app.GetAppType()
+	if err != nil {
+		return "", nil, err
+	}
+	switch appType {
+	case domain.AppTypeContainer:
+		container, err := app.AsContainerApplication()
+		if err != nil {
+			return "", nil, err
+		}
+		return lo.FromPtr(container.Name), lo.FromPtr(container.EnvFrom), nil
+	case domain.AppTypeCompose:
+		compose, err := app.AsComposeApplication()
+		if err != nil {
+			return "", nil, err
+		}
+		return lo.FromPtr(compose.Name), lo.FromPtr(compose.EnvFrom), nil
+	case domain.AppTypeQuadlet:
+		quadlet, err := app.AsQuadletApplication()
+		if err != nil {
+			return "", nil, err
+		}
+		return lo.FromPtr(quadlet.Name), lo.FromPtr(quadlet.EnvFrom), nil
+	default:
+		return "", nil, nil
+	}
+}
+
+// addNativeSecretRef adds a dependency ref on a Secret resource unless one already exists.
+func addNativeSecretRef(refs map[string]model.DependencyRef, secretName, providerName, fleetName, deviceName string) {
+	key := nativeSecretResourceKey(secretName)
+	if _, exists := refs[key]; exists {
+		return
+	}
+	name := secretName
+	fn := fleetName
+	dn := deviceName
+	refs[key] = model.DependencyRef{
+		FleetName:          &fn,
+		DeviceName:         &dn,
+		RefType:            "native-secret",
+		ResourceKey:        key,
+		SecretName:         &name,
+		ConfigProviderName: providerName,
+	}
+}
+
 // warnDuplicateConfigName logs a single warning per unique duplicate name to
 // surface pre-existing specs that have not yet been corrected. The warnedNames
 // map ensures each name is warned about at most once per call to collectConfigRefs,
@@ -705,6 +705,21 @@ func TestCollectConfigRefs_DeduplicatesByResourceKey(t *testing.T) {
 		assert.Equal(t, "secret:prod/db-creds", refs[0].ResourceKey)
 	})
 
+	t.Run("When two secret configs reference the same Secret resource it should produce one native-secret ref", func(t *testing.T) {
+		config := &[]domain.ConfigProviderSpec{
+			makeSecretResourceConfigItem(t, "secret-a", "db-creds"),
+			makeSecretResourceConfigItem(t, "secret-b", "db-creds"),
+		}
+
+		refs := collectConfigRefs(logrus.New(), config, testFleet, "")
+
+		require.Len(t, refs, 1)
+		assert.Equal(t, "native-secret", refs[0].RefType)
+		assert.Equal(t, "native-secret:db-creds", refs[0].ResourceKey)
+		assert.Equal(t, "db-creds", lo.FromPtr(refs[0].SecretName))
+		assert.Nil(t, refs[0].SecretNamespace)
+	})
+
 	t.Run("When configs have distinct resource keys it should produce all refs", func(t *testing.T) {
 		config := &[]domain.ConfigProviderSpec{
 			makeGitConfigItem(t, "cfg-a", "repo-a", "main"),
@@ -820,3 +835,44 @@ func makeUpdateDetails(t *testing.T, fields ...domain.ResourceUpdatedDetailsUpda
 	require.NoError(t, ed.FromResourceUpdatedDetails(details))
 	return &ed
 }
+
+func TestCollectEnvFromRefs(t *testing.T) {
+	makeApp := func(t *testing.T, name string, secretNames ...string) domain.ApplicationProviderSpec {
+		t.Helper()
+		envFrom := make([]domain.ApplicationEnvFromSource, 0, len(secretNames))
+		for _, secretName := range secretNames {
+			envFrom = append(envFrom, domain.ApplicationEnvFromSource{SecretRef: domain.SecretReference{Name: secretName}})
+		}
+		containerApp := domain.ContainerApplication{AppType: domain.AppTypeContainer, Name: lo.ToPtr(name), EnvFrom: &envFrom}
+		require.NoError(t, containerApp.FromImageApplicationProviderSpec(domain.ImageApplicationProviderSpec{Image: "quay.io/test/app:v1"}))
+		var app domain.ApplicationProviderSpec
+		require.NoError(t, app.FromContainerApplication(containerApp))
+		return app
+	}
+
+	t.Run("When applications reference secrets via envFrom it should produce one ref per secret", func(t *testing.T) {
+		apps := &[]domain.ApplicationProviderSpec{
+			makeApp(t, "db", "db-creds"),
+			makeApp(t, "web", "db-creds", "api-token"),
+		}
+
+		refs := collectEnvFromRefs(logrus.New(), apps, "my-fleet", "")
+
+		require.Len(t, refs, 2)
+		keys := map[string]string{}
+		for _, ref := range refs {
+			assert.Equal(t, "native-secret", ref.RefType)
+			assert.Equal(t, "my-fleet", lo.FromPtr(ref.FleetName))
+			keys[ref.ResourceKey] = ref.ConfigProviderName
+		}
+		assert.Equal(t, map[string]string{"native-secret:db-creds": "db", "native-secret:api-token": "web"}, keys)
+	})
+
+	t.Run("When the secret name is parameterized it should skip it", func(t *testing.T) {
+		apps := &[]domain.ApplicationProviderSpec{makeApp(t, "db", "{{ .metadata.name }}-creds")}
+
+		refs := collectEnvFromRefs(logrus.New(), apps, "my-fleet", "")
+
+		require.Empty(t, refs)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/encryption"
 	"github.com/flightctl/flightctl/internal/service/common"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/flightctl/flightctl/internal/vault"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
@@ -54,9 +55,10 @@ func NewRepoTester(log logrus.FieldLogger, repositorySvc repositoryservice.Servi
 
 // Cached tester instances (stateless, safe to reuse)
 var (
-	ociRepoTester  = &OciRepoTester{}
-	httpRepoTester = &HttpRepoTester{}
-	gitRepoTester  = &GitRepoTester{}
+	ociRepoTester   = &OciRepoTester{}
+	httpRepoTester  = &HttpRepoTester{}
+	gitRepoTester   = &GitRepoTester{}
+	vaultRepoTester = &VaultRepoTester{}
 )
 
 // DefaultRepoTesterMapping returns the appropriate TypeSpecificRepoTester based on the repository spec.
@@ -73,6 +75,8 @@ func DefaultRepoTesterMapping(repository *domain.Repository) TypeSpecificRepoTes
 		return httpRepoTester
 	case domain.RepoSpecTypeGit:
 		return gitRepoTester
+	case domain.RepoSpecTypeVault:
+		return vaultRepoTester
 	default:
 		return nil
 	}
@@ -149,6 +153,9 @@ type HttpRepoTester struct {
 type OciRepoTester struct {
 }
 
+type VaultRepoTester struct {
+}
+
 func (r *GitRepoTester) TestAccess(ctx context.Context, repository *domain.Repository) error {
 	repoURL, err := repository.Spec.GetRepoURL()
 	if err != nil {
@@ -183,6 +190,14 @@ func (r *GitRepoTester) TestAccess(ctx context.Context, repository *domain.Repos
 	return nil
 }
 
+func (r *VaultRepoTester) TestAccess(ctx context.Context, repository *domain.Repository) error {
+	client, err := vault.NewClientForSpec(repository.Spec)
+	if err != nil {
+		return err
+	}
+	return client.TestAccess(ctx)
+}
+
 func (r *HttpRepoTester) TestAccess(ctx context.Context, repository *domain.Repository) error {
 	repoHttpSpec, err := repository.Spec.AsHttpRepoSpec()
 	if err != nil {
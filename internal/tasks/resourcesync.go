@@ -396,9 +396,8 @@ func NeedsSyncToHash(rs *domain.ResourceSync, hash string) bool {
 
 func (r *ResourceSync) parseAndValidateResources(ctx context.Context, rs *domain.ResourceSync, repo *domain.Repository, gitCloneRepo cloneGitRepoFunc) ([]GenericResourceMap, error) {
 	path := rs.Spec.Path
-	revision := rs.Spec.TargetRevision
-	mfs, hash, err := gitCloneRepo(ctx, repo, &revision, lo.ToPtr(1), r.cfg)
-	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncAccessible, "accessible", "failed to clone repository", err)
+	mfs, hash, err := r.fetchSource(ctx, rs, repo, gitCloneRepo)
+	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncAccessible, "accessible", sourceFetchFailedReason(repo), err)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,248 @@
+package tasks
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/oci"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/samber/lo"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+// maxResourceSyncSourceSize bounds the unpacked size of an OCI artifact or HTTP archive, as the
+// content is held in memory while it is parsed.
+const maxResourceSyncSourceSize = 64 << 20
+
+// orasUnpackAnnotation marks layers that `oras push` created from a directory.
+const orasUnpackAnnotation = "io.deis.oras.content.unpack"
+
+// fetchSource fetches the content of the repository a ResourceSync points to into an in-memory
+// filesystem. It returns the revision the content was resolved to: the commit hash for git
+// repositories, the manifest digest for OCI repositories, and the SHA-256 digest of the archive
+// for HTTP repositories.
+func (r *ResourceSync) fetchSource(ctx context.Context, rs *domain.ResourceSync, repo *domain.Repository, gitCloneRepo cloneGitRepoFunc) (billy.Filesystem, string, error) {
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to determine repository type: %w", err)
+	}
+
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeOci && rs.Spec.Artifact != nil {
+		return nil, "", fmt.Errorf("spec.artifact is only supported for OCI repositories, but repository %s is of type %s", lo.FromPtr(repo.Metadata.Name), repoType)
+	}
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeHttp && rs.Spec.Archive != nil {
+		return nil, "", fmt.Errorf("spec.archive is only supported for HTTP repositories, but repository %s is of type %s", lo.FromPtr(repo.Metadata.Name), repoType)
+	}
+
+	switch domain.RepoSpecType(repoType) {
+	case domain.RepoSpecTypeGit:
+		revision := rs.Spec.TargetRevision
+		return gitCloneRepo(ctx, repo, &revision, lo.ToPtr(1), r.cfg)
+	case domain.RepoSpecTypeOci:
+		if rs.Spec.Artifact == nil {
+			return nil, "", fmt.Errorf("spec.artifact is required for OCI repositories")
+		}
+		return FetchOciArtifact(ctx, repo, *rs.Spec.Artifact, rs.Spec.TargetRevision)
+	case domain.RepoSpecTypeHttp:
+		if rs.Spec.Archive == nil {
+			return nil, "", fmt.Errorf("spec.archive is required for HTTP repositories")
+		}
+		return FetchHttpArchive(ctx, repo, *rs.Spec.Archive)
+	default:
+		return nil, "", fmt.Errorf("unsupported repository type %q", repoType)
+	}
+}
+
+// sourceFetchFailedReason returns the Accessible condition message used when fetching fails.
+func sourceFetchFailedReason(repo *domain.Repository) string {
+	repoType, _ := repo.Spec.Discriminator()
+	switch domain.RepoSpecType(repoType) {
+	case domain.RepoSpecTypeOci:
+		return "failed to pull artifact"
+	case domain.RepoSpecTypeHttp:
+		return "failed to download archive"
+	default:
+		return "failed to clone repository"
+	}
+}
+
+// FetchOciArtifact pulls an artifact (e.g. one pushed with `oras push`) from an OCI repository and
+// unpacks its layers into an in-memory filesystem. The revision is a tag or a digest. It returns
+// the manifest digest.
+func FetchOciArtifact(ctx context.Context, repo *domain.Repository, artifact string, revision string) (billy.Filesystem, string, error) {
+	ociSpec, err := repo.Spec.AsOciRepoSpec()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get OCI repo spec: %w", err)
+	}
+	target, err := oci.BuildOciRepoRef(ctx, &ociSpec, ociSpec.Registry+"/"+strings.TrimPrefix(artifact, "/"))
+	if err != nil {
+		return nil, "", err
+	}
+	return extractOciArtifact(ctx, target, revision)
+}
+
+func extractOciArtifact(ctx context.Context, src oras.ReadOnlyTarget, reference string) (billy.Filesystem, string, error) {
+	desc, err := src.Resolve(ctx, reference)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolving %s: %w", reference, err)
+	}
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, "", fmt.Errorf("%s resolves to %s, expected an OCI manifest", reference, desc.MediaType)
+	}
+	manifestBytes, err := content.FetchAll(ctx, src, desc)
+	if err != nil {
+		return nil, "", fmt.Errorf("fetching manifest: %w", err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, "", fmt.Errorf("parsing manifest: %w", err)
+	}
+
+	mfs := memfs.New()
+	var total int64
+	for _, layer := range manifest.Layers {
+		total += layer.Size
+		if total > maxResourceSyncSourceSize {
+			return nil, "", fmt.Errorf("artifact exceeds the maximum size of %d bytes", maxResourceSyncSourceSize)
+		}
+		blob, err := content.FetchAll(ctx, src, layer)
+		if err != nil {
+			return nil, "", fmt.Errorf("fetching layer %s: %w", layer.Digest, err)
+		}
+		if err := unpackOciLayer(mfs, layer, blob); err != nil {
+			return nil, "", fmt.Errorf("unpacking layer %s: %w", layer.Digest, err)
+		}
+	}
+	return mfs, desc.Digest.String(), nil
+}
+
+// unpackOciLayer extracts archive layers and writes file layers at the path given by their title
+// annotation. Layers that are neither are ignored.
+func unpackOciLayer(mfs billy.Filesystem, layer ocispec.Descriptor, blob []byte) error {
+	if strings.Contains(layer.MediaType, "tar") || layer.Annotations[orasUnpackAnnotation] == "true" {
+		return extractTarArchive(mfs, blob)
+	}
+	title := layer.Annotations[ocispec.AnnotationTitle]
+	if title == "" {
+		return nil
+	}
+	name, ok := cleanArchivePath(title)
+	if !ok {
+		return nil
+	}
+	return util.WriteFile(mfs, name, blob, 0o644)
+}
+
+// FetchHttpArchive downloads a tar or gzip-compressed tar archive from an HTTP repository and
+// unpacks it into an in-memory filesystem. The archive path is relative to the repository URL. It
+// returns the digest of the archive, as HTTP servers have no revision that identifies its content.
+func FetchHttpArchive(ctx context.Context, repo *domain.Repository, archivePath string) (billy.Filesystem, string, error) {
+	httpSpec, err := repo.Spec.AsHttpRepoSpec()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get HTTP repo spec: %w", err)
+	}
+	archiveURL, err := url.JoinPath(httpSpec.Url, archivePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("building archive URL: %w", err)
+	}
+	resp, err := openHTTPrequest(ctx, repo.Spec, archiveURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("downloading %s: %w", archiveURL, err)
+	}
+	defer resp.Body.Close()
+	// Reading one byte past the limit tells an archive of the maximum size from a larger one,
+	// without downloading the rest of it.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResourceSyncSourceSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("downloading %s: %w", archiveURL, err)
+	}
+	if len(body) > maxResourceSyncSourceSize {
+		return nil, "", fmt.Errorf("archive exceeds the maximum size of %d bytes", maxResourceSyncSourceSize)
+	}
+
+	mfs := memfs.New()
+	if err := extractTarArchive(mfs, body); err != nil {
+		return nil, "", fmt.Errorf("unpacking %s: %w", archiveURL, err)
+	}
+	return mfs, digest.FromBytes(body).String(), nil
+}
+
+// extractTarArchive unpacks the regular files and directories of a tar archive, gzip-compressed
+// or not, into mfs. Links and special files are skipped.
+func extractTarArchive(mfs billy.Filesystem, data []byte) error {
+	var reader io.Reader = bytes.NewReader(data)
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return fmt.Errorf("opening gzip stream: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	tr := tar.NewReader(reader)
+	var total int64
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+		name, ok := cleanArchivePath(header.Name)
+		if !ok {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := mfs.MkdirAll(name, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			total += header.Size
+			if total > maxResourceSyncSourceSize {
+				return fmt.Errorf("archive content exceeds the maximum size of %d bytes", maxResourceSyncSourceSize)
+			}
+			if err := writeArchiveFile(mfs, name, tr, header.Size); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeArchiveFile(mfs billy.Filesystem, name string, r io.Reader, size int64) error {
+	if err := mfs.MkdirAll(path.Dir(name), 0o755); err != nil {
+		return err
+	}
+	f, err := mfs.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.CopyN(f, r, size)
+	return err
+}
+
+// cleanArchivePath roots an archive entry name so that it cannot escape the filesystem root. It
+// returns false for entries that name the root itself.
+func cleanArchivePath(name string) (string, bool) {
+	cleaned := strings.TrimPrefix(path.Clean("/"+name), "/")
+	return cleaned, cleaned != ""
+}
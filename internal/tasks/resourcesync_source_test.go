@@ -0,0 +1,188 @@
+package tasks
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+const testFleetYAML = `apiVersion: flightctl.io/v1beta1
+kind: Fleet
+metadata:
+  name: fleet-a
+spec:
+  template:
+    spec: {}
+`
+
+func buildTestArchive(t *testing.T, compress bool, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var tw *tar.Writer
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(gz)
+	} else {
+		tw = tar.NewWriter(&buf)
+	}
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "link", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink}))
+	require.NoError(t, tw.Close())
+	if gz != nil {
+		require.NoError(t, gz.Close())
+	}
+	return buf.Bytes()
+}
+
+func readTestFile(t *testing.T, mfs billy.Filesystem, name string) string {
+	t.Helper()
+	data, err := util.ReadFile(mfs, name)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestExtractTarArchive(t *testing.T) {
+	tests := []struct {
+		name     string
+		compress bool
+	}{
+		{name: "When the archive is gzip-compressed it should unpack it", compress: true},
+		{name: "When the archive is uncompressed it should unpack it", compress: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			mfs := memfs.New()
+			archive := buildTestArchive(t, tt.compress, map[string]string{
+				"fleets/fleet-a.yaml": testFleetYAML,
+				"../escape.yaml":      "kind: Fleet",
+			})
+
+			require.NoError(extractTarArchive(mfs, archive))
+			require.Equal(testFleetYAML, readTestFile(t, mfs, "/fleets/fleet-a.yaml"))
+			// Entries are rooted at the filesystem root, so ".." cannot escape it.
+			require.Equal("kind: Fleet", readTestFile(t, mfs, "/escape.yaml"))
+			_, err := mfs.Lstat("/link")
+			require.Error(err)
+		})
+	}
+}
+
+func TestExtractOciArtifact(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	pushBlob := func(mediaType string, data []byte, annotations map[string]string) ocispec.Descriptor {
+		desc := content.NewDescriptorFromBytes(mediaType, data)
+		desc.Annotations = annotations
+		require.NoError(t, store.Push(ctx, desc, bytes.NewReader(data)))
+		return desc
+	}
+
+	dirLayer := pushBlob(ocispec.MediaTypeImageLayerGzip, buildTestArchive(t, true, map[string]string{
+		"resources/fleet-a.yaml": testFleetYAML,
+	}), map[string]string{ocispec.AnnotationTitle: "resources", orasUnpackAnnotation: "true"})
+	fileLayer := pushBlob("application/yaml", []byte(testFleetYAML), map[string]string{ocispec.AnnotationTitle: "single/fleet.yaml"})
+	untitledLayer := pushBlob("application/octet-stream", []byte("ignored"), nil)
+
+	manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.flightctl.resources", oras.PackManifestOptions{
+		Layers: []ocispec.Descriptor{dirLayer, fileLayer, untitledLayer},
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(ctx, manifest, "v1"))
+
+	t.Run("When resolving a tag it should unpack all layers and return the manifest digest", func(t *testing.T) {
+		require := require.New(t)
+		mfs, revision, err := extractOciArtifact(ctx, store, "v1")
+		require.NoError(err)
+		require.Equal(manifest.Digest.String(), revision)
+		require.Equal(testFleetYAML, readTestFile(t, mfs, "/resources/fleet-a.yaml"))
+		require.Equal(testFleetYAML, readTestFile(t, mfs, "/single/fleet.yaml"))
+	})
+
+	t.Run("When the tag does not exist it should fail", func(t *testing.T) {
+		_, _, err := extractOciArtifact(ctx, store, "missing")
+		require.Error(t, err)
+	})
+}
+
+func TestFetchHttpArchive(t *testing.T) {
+	archive := buildTestArchive(t, true, map[string]string{"resources/fleet-a.yaml": testFleetYAML})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases/config-1.0.tar.gz":
+			_, _ = w.Write(archive)
+		case "/releases/oversized.tar.gz":
+			_, _ = w.Write(make([]byte, maxResourceSyncSourceSize+1))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	repo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("archives")}, Spec: httpRepoSpec(t, server.URL+"/releases").Data}
+
+	t.Run("When the archive exists it should unpack it and return its digest", func(t *testing.T) {
+		require := require.New(t)
+		mfs, revision, err := FetchHttpArchive(context.Background(), repo, "config-1.0.tar.gz")
+		require.NoError(err)
+		require.Equal(digest.FromBytes(archive).String(), revision)
+		require.Equal(testFleetYAML, readTestFile(t, mfs, "/resources/fleet-a.yaml"))
+	})
+
+	t.Run("When the archive does not exist it should fail", func(t *testing.T) {
+		_, _, err := FetchHttpArchive(context.Background(), repo, "config-2.0.tar.gz")
+		require.Error(t, err)
+	})
+
+	t.Run("When the archive exceeds the maximum size it should fail", func(t *testing.T) {
+		_, _, err := FetchHttpArchive(context.Background(), repo, "oversized.tar.gz")
+		require.ErrorContains(t, err, "exceeds the maximum size")
+	})
+}
+
+func TestResourceSyncFetchSource_ArtifactOnlyForOci(t *testing.T) {
+	require := require.New(t)
+	r := &ResourceSync{}
+	repo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("archives")}, Spec: httpRepoSpec(t, "https://example.com").Data}
+	rs := &domain.ResourceSync{Spec: domain.ResourceSyncSpec{Repository: "archives", Archive: lo.ToPtr("config.tar.gz"), Artifact: lo.ToPtr("myorg/config")}}
+
+	_, _, err := r.fetchSource(context.Background(), rs, repo, nil)
+	require.ErrorContains(err, "spec.artifact is only supported for OCI repositories")
+}
+
+func TestResourceSyncFetchSource_ArchiveOnlyForHttp(t *testing.T) {
+	require := require.New(t)
+	r := &ResourceSync{}
+	httpRepo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("archives")}, Spec: httpRepoSpec(t, "https://example.com").Data}
+
+	_, _, err := r.fetchSource(context.Background(), &domain.ResourceSync{Spec: domain.ResourceSyncSpec{Repository: "archives"}}, httpRepo, nil)
+	require.ErrorContains(err, "spec.archive is required for HTTP repositories")
+
+	gitRepo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("git")}}
+	require.NoError(gitRepo.Spec.FromGitRepoSpec(domain.GitRepoSpec{Url: "https://example.com/repo.git", Type: domain.GitRepoSpecTypeGit}))
+	rs := &domain.ResourceSync{Spec: domain.ResourceSyncSpec{Repository: "git", TargetRevision: "main", Archive: lo.ToPtr("config.tar.gz")}}
+	_, _, err = r.fetchSource(context.Background(), rs, gitRepo, nil)
+	require.ErrorContains(err, "spec.archive is only supported for HTTP repositories")
+}
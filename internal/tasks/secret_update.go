@@ -0,0 +1,102 @@
+package tasks
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// The secretUpdate task is triggered when a Secret resource is created or its data is updated.
+// It emits a DependencyChangeDetected event for every fleet and device that references the
+// secret, so that fleets roll out a new template version and devices are re-rendered with the
+// new data.
+//
+// This task is idempotent: it only reads the secret and its dependency refs, and re-emitting the
+// events results in the same re-render.
+
+func secretUpdate(ctx context.Context, orgId uuid.UUID, event domain.Event, secretSvc secretservice.Service, dependencyrefSvc dependencyrefservice.Service, eventSvc eventservice.Service, log logrus.FieldLogger) error {
+	logic := NewSecretUpdateLogic(log, secretSvc, dependencyrefSvc, eventSvc, orgId, event)
+
+	if err := logic.HandleSecretUpdate(ctx); err != nil {
+		log.Errorf("failed to notify associated resources of update to secret %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+type SecretUpdateLogic struct {
+	log              logrus.FieldLogger
+	secretSvc        secretservice.Service
+	dependencyrefSvc dependencyrefservice.Service
+	eventSvc         eventservice.Service
+	orgId            uuid.UUID
+	event            domain.Event
+}
+
+func NewSecretUpdateLogic(log logrus.FieldLogger, secretSvc secretservice.Service, dependencyrefSvc dependencyrefservice.Service, eventSvc eventservice.Service, orgId uuid.UUID, event domain.Event) SecretUpdateLogic {
+	return SecretUpdateLogic{log: log, secretSvc: secretSvc, dependencyrefSvc: dependencyrefSvc, eventSvc: eventSvc, orgId: orgId, event: event}
+}
+
+func (t *SecretUpdateLogic) HandleSecretUpdate(ctx context.Context) error {
+	name := t.event.InvolvedObject.Name
+	secret, status := t.secretSvc.GetSecret(ctx, t.orgId, name)
+	if status.Code == http.StatusNotFound {
+		// Deleted since the event was emitted; referers are re-rendered when it is recreated.
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching secret: %s", status.Message)
+	}
+
+	refs, status := t.dependencyrefSvc.ListNativeSecretDependencyTargets(ctx, t.orgId, name)
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("fetching dependency targets: %s", status.Message)
+	}
+
+	resourceKey := nativeSecretResourceKey(name)
+	fingerprint := nativeSecretFingerprint(secret.Spec.Data)
+	for _, ref := range refs {
+		var kind domain.ResourceKind = domain.FleetKind
+		targetName := ref.FleetName
+		if ref.DeviceName != "" {
+			kind, targetName = domain.DeviceKind, ref.DeviceName
+		}
+		if event := common.GetDependencyChangeDetectedEvent(ctx, kind, targetName, resourceKey, fingerprint); event != nil {
+			t.eventSvc.CreateEvent(ctx, t.orgId, event)
+		}
+	}
+
+	return nil
+}
+
+// nativeSecretResourceKey returns the dependency ref resource key of a Secret resource.
+func nativeSecretResourceKey(name string) string {
+	return "native-secret:" + name
+}
+
+// nativeSecretFingerprint returns a hash of the secret's data that changes whenever any key or
+// value changes.
+func nativeSecretFingerprint(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(h, "%s=%s\n", key, data[key])
+	}
+	return fmt.Sprintf("sha256:%x", h.Sum(nil))
+}
@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
+	eventservice "github.com/flightctl/flightctl/internal/service/event"
+	secretservice "github.com/flightctl/flightctl/internal/service/secret"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestSecretUpdate_HandleSecretUpdate(t *testing.T) {
+	orgId := uuid.New()
+	ctx := context.Background()
+	data := map[string]string{"password": "czNjcjN0"}
+	event := createTestEvent(domain.SecretKind, domain.EventReasonResourceUpdated, "db-credentials")
+
+	t.Run("When a secret changes it should emit events for every referencing fleet and device", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSecretSvc := secretservice.NewMockService(ctrl)
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+
+		mockSecretSvc.EXPECT().GetSecret(gomock.Any(), orgId, "db-credentials").Return(&domain.Secret{Spec: domain.SecretSpec{Data: data}}, statusOK)
+		mockDependencyRefSvc.EXPECT().ListNativeSecretDependencyTargets(gomock.Any(), orgId, "db-credentials").Return([]model.SecretDependencyRef{
+			{OrgID: orgId, FleetName: "fleet-1"},
+			{OrgID: orgId, FleetName: "fleet-1", DeviceName: "device-1"},
+		}, statusOK)
+
+		var events []emittedEvent
+		mockEventSvc.EXPECT().CreateEvent(gomock.Any(), orgId, gomock.Any()).Times(2).Do(func(_ context.Context, _ uuid.UUID, event *domain.Event) {
+			require.Equal(t, domain.EventReasonDependencyChangeDetected, event.Reason)
+			details, err := event.Details.AsDependencyChangeDetectedDetails()
+			require.NoError(t, err)
+			assert.Equal(t, "native-secret:db-credentials", details.ResourceKey)
+			assert.Equal(t, nativeSecretFingerprint(data), details.Fingerprint)
+			events = append(events, emittedEvent{kind: event.InvolvedObject.Kind, name: event.InvolvedObject.Name})
+		})
+
+		logic := NewSecretUpdateLogic(logrus.New(), mockSecretSvc, mockDependencyRefSvc, mockEventSvc, orgId, event)
+		require.NoError(t, logic.HandleSecretUpdate(ctx))
+
+		assert.ElementsMatch(t, []emittedEvent{
+			{kind: string(domain.FleetKind), name: "fleet-1"},
+			{kind: string(domain.DeviceKind), name: "device-1"},
+		}, events)
+	})
+
+	t.Run("When the secret was deleted it should do nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSecretSvc := secretservice.NewMockService(ctrl)
+		mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+		mockEventSvc := eventservice.NewMockService(ctrl)
+
+		mockSecretSvc.EXPECT().GetSecret(gomock.Any(), orgId, "db-credentials").Return(nil, domain.StatusResourceNotFound(domain.SecretKind, "db-credentials"))
+
+		logic := NewSecretUpdateLogic(logrus.New(), mockSecretSvc, mockDependencyRefSvc, mockEventSvc, orgId, event)
+		require.NoError(t, logic.HandleSecretUpdate(ctx))
+	})
+}
+
+func TestNativeSecretFingerprint(t *testing.T) {
+	a := nativeSecretFingerprint(map[string]string{"a": "MQ==", "b": "Mg=="})
+
+	assert.Equal(t, a, nativeSecretFingerprint(map[string]string{"b": "Mg==", "a": "MQ=="}), "key order must not matter")
+	assert.NotEqual(t, a, nativeSecretFingerprint(map[string]string{"a": "MQ==", "b": "Mw=="}), "a changed value must change the fingerprint")
+	assert.NotEqual(t, a, nativeSecretFingerprint(map[string]string{"a": "MQ=="}), "a removed key must change the fingerprint")
+}
@@ -12,12 +12,13 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/store/model"
 	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
+	"github.com/flightctl/flightctl/internal/vulnscan"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	// VulnerabilitySyncInterval is the default interval between Trustify sync runs.
+	// VulnerabilitySyncInterval is the default interval between vulnerability sync runs.
 	VulnerabilitySyncInterval = 15 * time.Minute
 	VulnerabilitySyncTaskName = "vulnerability-sync"
 
@@ -38,18 +39,18 @@ type CheckpointStore interface {
 	GetDatabaseTime(ctx context.Context) (time.Time, error)
 }
 
-// VulnerabilitySync queries Trustify for CVE findings for each unique OS image
-// digest currently deployed across all devices, and upserts the results into
-// the vulnerability_findings table.
+// VulnerabilitySync queries the configured scanner (Trustify or the offline OSV
+// scanner) for CVE findings for each unique OS image digest currently deployed
+// across all devices, and upserts the results into the vulnerability_findings table.
 type VulnerabilitySync struct {
 	log          logrus.FieldLogger
-	vulnClient   trustifyv2.VulnerabilityClient
+	scanner      vulnscan.Scanner
 	findingStore FindingStoreUpsertLister
 	checkpoint   CheckpointStore
 	eventEmitter CVEEventEmitter
 }
 
-// FindingStoreUpsertLister is the subset of VulnerabilityFinding the sync task depends on for Trustify ingestion.
+// FindingStoreUpsertLister is the subset of VulnerabilityFinding the sync task depends on for scanner ingestion.
 type FindingStoreUpsertLister interface {
 	ListDeployedImageDigests(ctx context.Context) ([]string, error)
 	// UpsertFindings inserts or updates vulnerability findings and returns the findings
@@ -63,14 +64,14 @@ type FindingStoreUpsertLister interface {
 
 func NewVulnerabilitySync(
 	log logrus.FieldLogger,
-	vulnClient trustifyv2.VulnerabilityClient,
+	scanner vulnscan.Scanner,
 	findingStore FindingStoreUpsertLister,
 	checkpoint CheckpointStore,
 	eventEmitter CVEEventEmitter,
 ) *VulnerabilitySync {
 	return &VulnerabilitySync{
 		log:          log,
-		vulnClient:   vulnClient,
+		scanner:      scanner,
 		findingStore: findingStore,
 		checkpoint:   checkpoint,
 		eventEmitter: eventEmitter,
@@ -78,7 +79,7 @@ func NewVulnerabilitySync(
 }
 
 // Poll discovers all deployed image digests, fetches their CVE findings from
-// Trustify, upserts the results, and emits CVE lifecycle events using a delta-based approach.
+// the scanner, upserts the results, and emits CVE lifecycle events using a delta-based approach.
 func (t *VulnerabilitySync) Poll(ctx context.Context) {
 	t.log.Info("Running VulnerabilitySync polling")
 
@@ -88,7 +89,7 @@ func (t *VulnerabilitySync) Poll(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// Phase 1: Fetch and upsert findings from Trustify
+	// Phase 1: Fetch and upsert findings from the scanner
 	changed, err := t.syncFindings(ctx)
 	if err != nil {
 		t.log.WithError(err).Error("Failed to sync vulnerability findings")
@@ -99,7 +100,7 @@ func (t *VulnerabilitySync) Poll(ctx context.Context) {
 	t.runCVELifecyclePhase(ctx, changed)
 }
 
-// syncFindings queries Trustify for CVE findings and upserts them into the database.
+// syncFindings queries the scanner for CVE findings and upserts them into the database.
 // Returns the list of findings that were actually changed (inserted or updated).
 func (t *VulnerabilitySync) syncFindings(ctx context.Context) ([]model.ChangedFinding, error) {
 	t.log.Debug("Querying deployed image digests")
@@ -115,16 +116,16 @@ func (t *VulnerabilitySync) syncFindings(ctx context.Context) ([]model.ChangedFi
 
 	t.log.Infof("Syncing vulnerabilities for %d unique image digests", len(digests))
 
-	findingsMap, err := t.vulnClient.GetVulnerabilitiesForDigests(ctx, digests)
+	findingsMap, err := t.scanner.GetVulnerabilitiesForDigests(ctx, digests)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get vulnerabilities from Trustify: %w", err)
+		return nil, fmt.Errorf("failed to get vulnerabilities from scanner: %w", err)
 	}
 
 	var allFindings []model.VulnerabilityFinding
 	var skippedCount int
 	for digest, findings := range findingsMap {
 		if findings == nil {
-			t.log.Debugf("No SBOM found in Trustify for digest %s", digest)
+			t.log.Debugf("No SBOM found for digest %s", digest)
 			continue
 		}
 
@@ -144,7 +145,7 @@ func (t *VulnerabilitySync) syncFindings(ctx context.Context) ([]model.ChangedFi
 	}
 
 	if len(allFindings) == 0 {
-		t.log.Info("No vulnerability findings to upsert from Trustify")
+		t.log.Info("No vulnerability findings to upsert")
 		return nil, nil
 	}
 
@@ -159,7 +160,7 @@ func (t *VulnerabilitySync) syncFindings(ctx context.Context) ([]model.ChangedFi
 
 // runCVELifecyclePhase computes and applies CVE lifecycle events using a delta-based approach.
 // It processes:
-// 1. Changed findings (from Trustify sync) - new CVEs, severity changes, status changes
+// 1. Changed findings (from the scanner sync) - new CVEs, severity changes, status changes
 // 2. Device image changes (DeviceOSImageChanged events) - resolve old CVEs, create new ones
 func (t *VulnerabilitySync) runCVELifecyclePhase(ctx context.Context, changedFindings []model.ChangedFinding) {
 	if t.eventEmitter == nil || t.checkpoint == nil {
@@ -9,26 +9,59 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 )
 
-func NewFactory() processor.Factory {
+// NewFactory returns the factory of the deviceattrs processor. The fleet label is only set
+// if fleets is not nil.
+func NewFactory(fleets FleetResolver) processor.Factory {
+	f := &factory{fleets: fleets}
 	return processor.NewFactory(
 		component.MustNewType("deviceattrs"),
 		createDefaultConfig,
-		processor.WithMetrics(createMetricsProcessor, component.StabilityLevelAlpha),
+		processor.WithMetrics(f.createMetricsProcessor, component.StabilityLevelAlpha),
+		processor.WithLogs(f.createLogsProcessor, component.StabilityLevelAlpha),
+		processor.WithTraces(f.createTracesProcessor, component.StabilityLevelAlpha),
 	)
 }
 
+type factory struct {
+	fleets FleetResolver
+}
+
 func createDefaultConfig() component.Config {
 	return nil
 }
 
-func createMetricsProcessor(
+func (f *factory) createMetricsProcessor(
 	ctx context.Context,
 	set processor.Settings,
 	cfg component.Config,
 	next consumer.Metrics,
 ) (processor.Metrics, error) {
-	p := &deviceattrs{}
+	p := &deviceattrs{logger: set.Logger, fleets: f.fleets}
 	return processorhelper.NewMetrics(
 		ctx, set, cfg, next, p.processMetrics, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
 	)
 }
+
+func (f *factory) createLogsProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	next consumer.Logs,
+) (processor.Logs, error) {
+	p := &deviceattrs{logger: set.Logger, fleets: f.fleets}
+	return processorhelper.NewLogs(
+		ctx, set, cfg, next, p.processLogs, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
+	)
+}
+
+func (f *factory) createTracesProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	next consumer.Traces,
+) (processor.Traces, error) {
+	p := &deviceattrs{logger: set.Logger, fleets: f.fleets}
+	return processorhelper.NewTraces(
+		ctx, set, cfg, next, p.processTraces, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
+	)
+}
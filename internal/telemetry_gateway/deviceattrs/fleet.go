@@ -0,0 +1,79 @@
+package deviceattrs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// DefaultFleetCacheTTL bounds how long a device keeps the fleet label of its previous owner
+// after it moved to another fleet.
+const DefaultFleetCacheTTL = time.Minute
+
+// FleetResolver returns the name of the fleet owning a device, or an empty string if the
+// device is not owned by a fleet.
+type FleetResolver interface {
+	DeviceFleet(ctx context.Context, orgID uuid.UUID, deviceName string) (string, error)
+}
+
+// DeviceGetter is the part of the device store used to resolve a device's fleet.
+type DeviceGetter interface {
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error)
+}
+
+type fleetKey struct {
+	orgID      uuid.UUID
+	deviceName string
+}
+
+// CachedFleetResolver resolves fleets from the devices' owners and caches the result, so that
+// the store is queried at most once per device and TTL rather than once per export.
+type CachedFleetResolver struct {
+	devices DeviceGetter
+	cache   *ttlcache.Cache[fleetKey, string]
+}
+
+func NewCachedFleetResolver(devices DeviceGetter, ttl time.Duration) *CachedFleetResolver {
+	return &CachedFleetResolver{
+		devices: devices,
+		cache:   ttlcache.New(ttlcache.WithTTL[fleetKey, string](ttl)),
+	}
+}
+
+func (r *CachedFleetResolver) DeviceFleet(ctx context.Context, orgID uuid.UUID, deviceName string) (string, error) {
+	key := fleetKey{orgID: orgID, deviceName: deviceName}
+	if item := r.cache.Get(key); item != nil {
+		return item.Value(), nil
+	}
+
+	device, err := r.devices.Get(ctx, orgID, deviceName)
+	if err != nil && !errors.Is(err, flterrors.ErrResourceNotFound) {
+		return "", err
+	}
+
+	fleet := ""
+	if device != nil && device.Metadata.Owner != nil {
+		kind, name, err := util.GetResourceOwner(device.Metadata.Owner)
+		if err == nil && kind == domain.FleetKind {
+			fleet = name
+		}
+	}
+	r.cache.Set(key, fleet, ttlcache.DefaultTTL)
+	return fleet, nil
+}
+
+// Start starts the cache background cleanup goroutine.
+// Call Stop() to shut it down.
+func (r *CachedFleetResolver) Start() {
+	go r.cache.Start()
+}
+
+func (r *CachedFleetResolver) Stop() {
+	r.cache.Stop()
+}
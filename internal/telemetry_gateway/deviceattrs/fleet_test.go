@@ -0,0 +1,85 @@
+package deviceattrs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDevices struct {
+	devices map[string]*domain.Device
+	err     error
+	calls   int
+}
+
+func (f *fakeDevices) Get(_ context.Context, _ uuid.UUID, name string) (*domain.Device, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	device, ok := f.devices[name]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return device, nil
+}
+
+func deviceOwnedBy(owner *string) *domain.Device {
+	return &domain.Device{Metadata: domain.ObjectMeta{Owner: owner}}
+}
+
+func TestCachedFleetResolver(t *testing.T) {
+	orgID := uuid.New()
+	devices := &fakeDevices{devices: map[string]*domain.Device{
+		"in-fleet":      deviceOwnedBy(util.SetResourceOwner(domain.FleetKind, "fleet1")),
+		"unowned":       deviceOwnedBy(nil),
+		"other-owner":   deviceOwnedBy(util.SetResourceOwner("ResourceSync", "sync1")),
+		"invalid-owner": deviceOwnedBy(lo.ToPtr("invalid")),
+	}}
+	r := NewCachedFleetResolver(devices, time.Minute)
+
+	for name, want := range map[string]string{
+		"in-fleet":      "fleet1",
+		"unowned":       "",
+		"other-owner":   "",
+		"invalid-owner": "",
+		"unknown":       "",
+	} {
+		fleet, err := r.DeviceFleet(context.Background(), orgID, name)
+		require.NoError(t, err, name)
+		require.Equal(t, want, fleet, name)
+	}
+
+	calls := devices.calls
+	fleet, err := r.DeviceFleet(context.Background(), orgID, "in-fleet")
+	require.NoError(t, err)
+	require.Equal(t, "fleet1", fleet)
+	require.Equal(t, calls, devices.calls, "the fleet must be served from the cache")
+
+	_, err = r.DeviceFleet(context.Background(), uuid.New(), "in-fleet")
+	require.NoError(t, err)
+	require.Equal(t, calls+1, devices.calls, "the cache must be keyed by organization")
+}
+
+func TestCachedFleetResolverDoesNotCacheErrors(t *testing.T) {
+	devices := &fakeDevices{err: errors.New("database unavailable")}
+	r := NewCachedFleetResolver(devices, time.Minute)
+	orgID := uuid.New()
+
+	_, err := r.DeviceFleet(context.Background(), orgID, "dev1")
+	require.Error(t, err)
+
+	devices.err = nil
+	devices.devices = map[string]*domain.Device{"dev1": deviceOwnedBy(util.SetResourceOwner(domain.FleetKind, "fleet1"))}
+	fleet, err := r.DeviceFleet(context.Background(), orgID, "dev1")
+	require.NoError(t, err)
+	require.Equal(t, "fleet1", fleet)
+}
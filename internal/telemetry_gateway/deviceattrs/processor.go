@@ -8,18 +8,42 @@ import (
 	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceauth"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 )
 
-type deviceattrs struct{}
+const (
+	DeviceIDAttribute = "device_id"
+	OrgIDAttribute    = "org_id"
+	// FleetAttribute is derived from the owner of the authenticated device. Values sent by the
+	// device are always overwritten or removed.
+	FleetAttribute = "fleet"
+)
+
+type deviceattrs struct {
+	logger *zap.Logger
+	fleets FleetResolver
+}
 
 var (
 	// Signals the processor refuses to process unauthenticated data.
 	ErrUnauthenticated = errors.New("device unauthenticated")
 )
 
-func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
-	var devID, orgID string
+// labels are the attributes the processor sets on the telemetry of a device.
+type labels struct {
+	deviceID string
+	orgID    string
+	fleet    string
+}
+
+// deviceLabels returns the labels of the authenticated device in the context.
+func (p *deviceattrs) deviceLabels(ctx context.Context) (labels, error) {
+	var devID string
+	var orgID uuid.UUID
 
 	if v := ctx.Value(deviceauth.DeviceIDKey); v != nil {
 		if s, ok := v.(string); ok && s != "" {
@@ -28,30 +52,60 @@ func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (p
 	}
 
 	if devID == "" {
-		return pmetric.NewMetrics(),
-			consumererror.NewPermanent(fmt.Errorf("%w: missing device_id in context", ErrUnauthenticated))
+		return labels{}, consumererror.NewPermanent(fmt.Errorf("%w: missing device_id in context", ErrUnauthenticated))
 	}
 
 	if v := ctx.Value(deviceauth.DeviceOrgIDKey); v != nil {
 		switch t := v.(type) {
 		case uuid.UUID:
-			orgID = t.String()
-		case string:
 			orgID = t
+		case string:
+			if id, err := uuid.Parse(t); err == nil {
+				orgID = id
+			}
 		}
 	}
 
-	if orgID == "" {
-		return pmetric.NewMetrics(),
-			consumererror.NewPermanent(fmt.Errorf("%w: missing org_id in context", ErrUnauthenticated))
+	if orgID == uuid.Nil {
+		return labels{}, consumererror.NewPermanent(fmt.Errorf("%w: missing org_id in context", ErrUnauthenticated))
+	}
+
+	l := labels{deviceID: devID, orgID: orgID.String()}
+	if p.fleets != nil {
+		fleet, err := p.fleets.DeviceFleet(ctx, orgID, devID)
+		if err != nil {
+			// Telemetry is still accepted, only without the fleet label.
+			p.logger.Warn("failed to resolve the fleet of the device",
+				zap.String("device_id", devID),
+				zap.String("org_id", l.orgID),
+				zap.Error(err))
+		}
+		l.fleet = fleet
+	}
+	return l, nil
+}
+
+// labelAttributes sets the device labels, overwriting any values the device sent.
+func labelAttributes(attrs pcommon.Map, l labels) {
+	attrs.PutStr(DeviceIDAttribute, l.deviceID)
+	attrs.PutStr(OrgIDAttribute, l.orgID)
+	if l.fleet != "" {
+		attrs.PutStr(FleetAttribute, l.fleet)
+	} else {
+		attrs.Remove(FleetAttribute)
+	}
+}
+
+func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	l, err := p.deviceLabels(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
 	}
 
 	rms := md.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
 		rm := rms.At(i)
-		ra := rm.Resource().Attributes()
-		ra.PutStr("device_id", devID)
-		ra.PutStr("org_id", orgID)
+		labelAttributes(rm.Resource().Attributes(), l)
 
 		sms := rm.ScopeMetrics()
 		for j := 0; j < sms.Len(); j++ {
@@ -62,37 +116,27 @@ func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (p
 				case pmetric.MetricTypeGauge:
 					dps := m.Gauge().DataPoints()
 					for n := 0; n < dps.Len(); n++ {
-						dp := dps.At(n)
-						dp.Attributes().PutStr("device_id", devID)
-						dp.Attributes().PutStr("org_id", orgID)
+						labelAttributes(dps.At(n).Attributes(), l)
 					}
 				case pmetric.MetricTypeSum:
 					dps := m.Sum().DataPoints()
 					for n := 0; n < dps.Len(); n++ {
-						dp := dps.At(n)
-						dp.Attributes().PutStr("device_id", devID)
-						dp.Attributes().PutStr("org_id", orgID)
+						labelAttributes(dps.At(n).Attributes(), l)
 					}
 				case pmetric.MetricTypeHistogram:
 					dps := m.Histogram().DataPoints()
 					for n := 0; n < dps.Len(); n++ {
-						dp := dps.At(n)
-						dp.Attributes().PutStr("device_id", devID)
-						dp.Attributes().PutStr("org_id", orgID)
+						labelAttributes(dps.At(n).Attributes(), l)
 					}
 				case pmetric.MetricTypeExponentialHistogram:
 					dps := m.ExponentialHistogram().DataPoints()
 					for n := 0; n < dps.Len(); n++ {
-						dp := dps.At(n)
-						dp.Attributes().PutStr("device_id", devID)
-						dp.Attributes().PutStr("org_id", orgID)
+						labelAttributes(dps.At(n).Attributes(), l)
 					}
 				case pmetric.MetricTypeSummary:
 					dps := m.Summary().DataPoints()
 					for n := 0; n < dps.Len(); n++ {
-						dp := dps.At(n)
-						dp.Attributes().PutStr("device_id", devID)
-						dp.Attributes().PutStr("org_id", orgID)
+						labelAttributes(dps.At(n).Attributes(), l)
 					}
 				}
 			}
@@ -100,3 +144,31 @@ func (p *deviceattrs) processMetrics(ctx context.Context, md pmetric.Metrics) (p
 	}
 	return md, nil
 }
+
+// processLogs labels only the resources: unlike metrics exported to Prometheus, logs keep
+// their resource attributes all the way to the backend.
+func (p *deviceattrs) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	l, err := p.deviceLabels(ctx)
+	if err != nil {
+		return plog.NewLogs(), err
+	}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		labelAttributes(rls.At(i).Resource().Attributes(), l)
+	}
+	return ld, nil
+}
+
+func (p *deviceattrs) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	l, err := p.deviceLabels(ctx)
+	if err != nil {
+		return ptrace.NewTraces(), err
+	}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		labelAttributes(rss.At(i).Resource().Attributes(), l)
+	}
+	return td, nil
+}
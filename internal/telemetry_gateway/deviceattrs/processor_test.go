@@ -0,0 +1,126 @@
+package deviceattrs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/telemetry_gateway/deviceauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type fakeFleets struct {
+	fleet string
+	err   error
+}
+
+func (f *fakeFleets) DeviceFleet(context.Context, uuid.UUID, string) (string, error) {
+	return f.fleet, f.err
+}
+
+func newProcessor(fleets FleetResolver) *deviceattrs {
+	return &deviceattrs{logger: zap.NewNop(), fleets: fleets}
+}
+
+func authenticatedContext(orgID uuid.UUID) context.Context {
+	ctx := context.WithValue(context.Background(), deviceauth.DeviceIDKey, "dev1")
+	return context.WithValue(ctx, deviceauth.DeviceOrgIDKey, orgID)
+}
+
+func TestProcessMetrics(t *testing.T) {
+	orgID := uuid.New()
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(DeviceIDAttribute, "spoofed")
+	rm.Resource().Attributes().PutStr(FleetAttribute, "spoofed")
+	dp := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetIntValue(1)
+	dp.Attributes().PutStr(FleetAttribute, "spoofed")
+
+	md, err := newProcessor(&fakeFleets{fleet: "fleet1"}).processMetrics(authenticatedContext(orgID), md)
+	require.NoError(t, err)
+
+	want := map[string]any{
+		DeviceIDAttribute: "dev1",
+		OrgIDAttribute:    orgID.String(),
+		FleetAttribute:    "fleet1",
+	}
+	require.Equal(t, want, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	attrs := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).Attributes().AsRaw()
+	require.Equal(t, want, attrs)
+}
+
+func TestProcessMetricsWithoutFleetResolver(t *testing.T) {
+	orgID := uuid.New()
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	dp := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetIntValue(1)
+	dp.Attributes().PutStr(FleetAttribute, "spoofed")
+
+	md, err := newProcessor(nil).processMetrics(authenticatedContext(orgID), md)
+	require.NoError(t, err)
+
+	attrs := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Attributes().AsRaw()
+	require.Equal(t, map[string]any{
+		DeviceIDAttribute: "dev1",
+		OrgIDAttribute:    orgID.String(),
+	}, attrs)
+}
+
+func TestProcessLogs(t *testing.T) {
+	orgID := uuid.New()
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(FleetAttribute, "spoofed")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+
+	ld, err := newProcessor(&fakeFleets{}).processLogs(authenticatedContext(orgID), ld)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]any{
+		DeviceIDAttribute: "dev1",
+		OrgIDAttribute:    orgID.String(),
+	}, ld.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestProcessLogsFleetLookupFailure(t *testing.T) {
+	orgID := uuid.New()
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(FleetAttribute, "spoofed")
+
+	ld, err := newProcessor(&fakeFleets{err: errors.New("database unavailable")}).processLogs(authenticatedContext(orgID), ld)
+	require.NoError(t, err, "telemetry must still be accepted")
+
+	require.Equal(t, map[string]any{
+		DeviceIDAttribute: "dev1",
+		OrgIDAttribute:    orgID.String(),
+	}, ld.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestProcessTraces(t *testing.T) {
+	orgID := uuid.New()
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+
+	td, err := newProcessor(&fakeFleets{fleet: "fleet1"}).processTraces(authenticatedContext(orgID), td)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]any{
+		DeviceIDAttribute: "dev1",
+		OrgIDAttribute:    orgID.String(),
+		FleetAttribute:    "fleet1",
+	}, td.ResourceSpans().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestProcessUnauthenticated(t *testing.T) {
+	_, err := newProcessor(nil).processLogs(context.Background(), plog.NewLogs())
+	require.ErrorIs(t, err, ErrUnauthenticated)
+}
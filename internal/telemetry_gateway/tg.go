@@ -31,6 +31,12 @@ type Option func(*runOptions)
 type runOptions struct {
 	settingsMutators []func(*otelcol.CollectorSettings)
 	cfgMutators      []OTelConfigMutator
+	fleets           deviceattrs.FleetResolver
+}
+
+// WithFleetResolver labels device telemetry with the fleet owning the device.
+func WithFleetResolver(fleets deviceattrs.FleetResolver) Option {
+	return func(ro *runOptions) { ro.fleets = fleets }
 }
 
 // WithCollectorSettings lets callers tweak CollectorSettings before NewCollector.
@@ -132,7 +138,7 @@ func Run(ctx context.Context, cfg *config.Config, opts ...Option) error {
 					component.MustNewType("prometheus"): prometheusreceiver.NewFactory(),
 				},
 				Processors: map[component.Type]processor.Factory{
-					component.MustNewType("deviceattrs"): deviceattrs.NewFactory(),
+					component.MustNewType("deviceattrs"): deviceattrs.NewFactory(ro.fleets),
 				},
 				Exporters: map[component.Type]exporter.Factory{
 					component.MustNewType("otlp"):                  otlpexporter.NewFactory(),
@@ -203,6 +209,25 @@ func buildOTelConfigMap(cfg *config.Config) (map[string]any, error) {
 		return nil, fmt.Errorf("no exporters configured")
 	}
 
+	pipelines := map[string]any{
+		"metrics": map[string]any{
+			"receivers":  []string{"otlp/device"},
+			"processors": []string{"deviceattrs"},
+			"exporters":  exporterNames,
+		},
+	}
+	// Prometheus only handles metrics, so logs and traces are only accepted when they can be
+	// forwarded upstream.
+	if _, ok := exporters["otlp"]; ok {
+		for _, signal := range []string{"logs", "traces"} {
+			pipelines[signal] = map[string]any{
+				"receivers":  []string{"otlp/device"},
+				"processors": []string{"deviceattrs"},
+				"exporters":  []string{"otlp"},
+			}
+		}
+	}
+
 	root := map[string]any{
 		"receivers": map[string]any{
 			"otlp/device": map[string]any{
@@ -226,13 +251,7 @@ func buildOTelConfigMap(cfg *config.Config) (map[string]any, error) {
 		"extensions": map[string]any{"deviceauth": map[string]any{}},
 		"service": map[string]any{
 			"extensions": []string{"deviceauth"},
-			"pipelines": map[string]any{
-				"metrics": map[string]any{
-					"receivers":  []string{"otlp/device"},
-					"processors": []string{"deviceattrs"},
-					"exporters":  exporterNames,
-				},
-			},
+			"pipelines":  pipelines,
 			"telemetry": map[string]any{
 				"logs": map[string]any{"level": cfg.TelemetryGateway.LogLevel},
 			},
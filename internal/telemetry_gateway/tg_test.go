@@ -0,0 +1,82 @@
+package telemetrygateway
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func testConfig(t *testing.T, snippet string) *config.Config {
+	t.Helper()
+	cfg := config.NewDefault()
+	require.NoError(t, yaml.Unmarshal([]byte(snippet), cfg))
+	return cfg
+}
+
+func pipelines(t *testing.T, root map[string]any) map[string]any {
+	t.Helper()
+	service, ok := root["service"].(map[string]any)
+	require.True(t, ok)
+	p, ok := service["pipelines"].(map[string]any)
+	require.True(t, ok)
+	return p
+}
+
+func TestBuildOTelConfigMapWithForwarding(t *testing.T) {
+	cfg := testConfig(t, `
+telemetrygateway:
+  export:
+    prometheus: 0.0.0.0:9464
+  forward:
+    endpoint: collector:4317
+    tls:
+      caFile: /certs/ca.crt
+`)
+
+	root, err := buildOTelConfigMap(cfg)
+	require.NoError(t, err)
+
+	exporters, ok := root["exporters"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, map[string]any{
+		"endpoint": "collector:4317",
+		"tls":      map[string]any{"ca_file": "/certs/ca.crt"},
+	}, exporters["otlp"])
+
+	p := pipelines(t, root)
+	require.Equal(t, map[string]any{
+		"receivers":  []string{"otlp/device"},
+		"processors": []string{"deviceattrs"},
+		"exporters":  []string{"prometheus", "otlp"},
+	}, p["metrics"])
+	for _, signal := range []string{"logs", "traces"} {
+		require.Equal(t, map[string]any{
+			"receivers":  []string{"otlp/device"},
+			"processors": []string{"deviceattrs"},
+			"exporters":  []string{"otlp"},
+		}, p[signal], signal)
+	}
+}
+
+func TestBuildOTelConfigMapWithoutOTLPExporter(t *testing.T) {
+	cfg := testConfig(t, `
+telemetrygateway:
+  export:
+    prometheus: 0.0.0.0:9464
+`)
+
+	root, err := buildOTelConfigMap(cfg)
+	require.NoError(t, err)
+
+	p := pipelines(t, root)
+	require.Contains(t, p, "metrics")
+	require.NotContains(t, p, "logs", "Prometheus cannot export logs")
+	require.NotContains(t, p, "traces", "Prometheus cannot export traces")
+}
+
+func TestBuildOTelConfigMapWithoutExporters(t *testing.T) {
+	_, err := buildOTelConfigMap(config.NewDefault())
+	require.ErrorContains(t, err, "no exporters configured")
+}
@@ -1,6 +1,7 @@
 package transportv1alpha1
 
 import (
+	"encoding/json"
 	"net/http"
 
 	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
@@ -56,3 +57,58 @@ func (h *TransportHandler) GetVulnerabilityImpact(w http.ResponseWriter, r *http
 	body, status := h.vulnerabilityfinding.GetVulnerabilityImpact(r.Context(), transport.OrgIDFromContext(r.Context()), cveId, params)
 	h.SetResponse(w, h.converter.Vulnerability().ImpactFromDomain(body), status)
 }
+
+// (POST /vulnerabilityexceptions)
+func (h *TransportHandler) CreateVulnerabilityException(w http.ResponseWriter, r *http.Request) {
+	var exception apiv1alpha1.VulnerabilityException
+	if err := json.NewDecoder(r.Body).Decode(&exception); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.vulnerabilityfinding.CreateVulnerabilityException(r.Context(), transport.OrgIDFromContext(r.Context()), h.converter.Vulnerability().ExceptionToDomain(exception))
+	h.SetResponse(w, h.converter.Vulnerability().ExceptionFromDomain(body), status)
+}
+
+// (GET /vulnerabilityexceptions)
+func (h *TransportHandler) ListVulnerabilityExceptions(w http.ResponseWriter, r *http.Request, params apiv1alpha1.ListVulnerabilityExceptionsParams) {
+	domainParams := h.converter.Vulnerability().ExceptionListParamsToDomain(params)
+	body, status := h.vulnerabilityfinding.ListVulnerabilityExceptions(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	h.SetResponse(w, h.converter.Vulnerability().ExceptionListFromDomain(body), status)
+}
+
+// (POST /vulnerabilityexceptions/import)
+func (h *TransportHandler) ImportVulnerabilityExceptions(w http.ResponseWriter, r *http.Request) {
+	var document apiv1alpha1.OpenVEXDocument
+	if err := json.NewDecoder(r.Body).Decode(&document); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.vulnerabilityfinding.ImportVulnerabilityExceptions(r.Context(), transport.OrgIDFromContext(r.Context()), h.converter.Vulnerability().OpenVEXDocumentToDomain(document))
+	h.SetResponse(w, h.converter.Vulnerability().ExceptionListFromDomain(body), status)
+}
+
+// (GET /vulnerabilityexceptions/{name})
+func (h *TransportHandler) GetVulnerabilityException(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.vulnerabilityfinding.GetVulnerabilityException(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, h.converter.Vulnerability().ExceptionFromDomain(body), status)
+}
+
+// (PUT /vulnerabilityexceptions/{name})
+func (h *TransportHandler) ReplaceVulnerabilityException(w http.ResponseWriter, r *http.Request, name string) {
+	var exception apiv1alpha1.VulnerabilityException
+	if err := json.NewDecoder(r.Body).Decode(&exception); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.vulnerabilityfinding.ReplaceVulnerabilityException(r.Context(), transport.OrgIDFromContext(r.Context()), name, h.converter.Vulnerability().ExceptionToDomain(exception))
+	h.SetResponse(w, h.converter.Vulnerability().ExceptionFromDomain(body), status)
+}
+
+// (DELETE /vulnerabilityexceptions/{name})
+func (h *TransportHandler) DeleteVulnerabilityException(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.vulnerabilityfinding.DeleteVulnerabilityException(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -0,0 +1,45 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	bulkoperationservice "github.com/flightctl/flightctl/internal/service/bulkoperation"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/bulkoperations)
+func (h *TransportHandler) CreateBulkOperation(w http.ResponseWriter, r *http.Request) {
+	var op apiv1beta1.BulkOperation
+	if err := json.NewDecoder(r.Body).Decode(&op); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainOp := h.converter.BulkOperation().ToDomain(op)
+	body, status := bulkoperationservice.CreateBulkOperationFromUntrusted(r.Context(), h.bulkoperation, transport.OrgIDFromContext(r.Context()), domainOp)
+	apiResult := h.converter.BulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/bulkoperations)
+func (h *TransportHandler) ListBulkOperations(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListBulkOperationsParams) {
+	domainParams := h.converter.BulkOperation().ListParamsToDomain(params)
+	body, status := h.bulkoperation.ListBulkOperations(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.BulkOperation().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/bulkoperations/{name})
+func (h *TransportHandler) GetBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.bulkoperation.GetBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.BulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/bulkoperations/{name})
+func (h *TransportHandler) DeleteBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.bulkoperation.DeleteBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -13,13 +13,14 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-// GetDeviceConsole and GetDeviceApplicationConsole satisfy the server.Transport interface,
-// which is generated from the OpenAPI spec that declares both WS paths.
+// GetDeviceConsole, GetDeviceApplicationConsole, GetDevicePortForward and GetDeviceFileTransfer satisfy the
+// server.Transport interface, which is generated from the OpenAPI spec that declares the WS paths.
 // These stubs are unreachable at runtime: WebsocketHandler.RegisterRoutes (called from
 // internal/api_server/server.go) mounts the real handler at /ws/v1/devices/{name}/console
 // before the generated router, so requests are served by WebsocketHandler.HandleDeviceConsole.
 // Similarly, the application console endpoint is mounted by AppConsoleHandler.RegisterRoutes
-// in internal/remote_access_server/server.go, not by this stub.
+// in internal/remote_access_server/server.go, not by this stub, and so are the port-forward
+// (PortForwardHandler.RegisterRoutes) and file transfer (FileTransferHandler.RegisterRoutes) endpoints.
 func (h *TransportHandler) GetDeviceConsole(w http.ResponseWriter, r *http.Request, _ string) {
 	http.NotFound(w, r)
 }
@@ -28,6 +29,14 @@ func (h *TransportHandler) GetDeviceApplicationConsole(w http.ResponseWriter, r
 	http.NotFound(w, r)
 }
 
+func (h *TransportHandler) GetDevicePortForward(w http.ResponseWriter, r *http.Request, _ string, _ api.GetDevicePortForwardParams) {
+	http.NotFound(w, r)
+}
+
+func (h *TransportHandler) GetDeviceFileTransfer(w http.ResponseWriter, r *http.Request, _ string, _ api.GetDeviceFileTransferParams) {
+	http.NotFound(w, r)
+}
+
 func (h *WebsocketHandler) injectProtocolsToMetadata(metadataStr string, protocols []string) (string, error) {
 	var metadata api.DeviceConsoleSessionMetadata
 	if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
@@ -72,6 +72,37 @@ func (h *TransportHandler) GetDeviceLastSeen(w http.ResponseWriter, r *http.Requ
 	h.SetResponse(w, apiResult, status)
 }
 
+// (GET /api/v1/devices/{name}/history)
+func (h *TransportHandler) GetDeviceStatusHistory(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.GetDeviceStatusHistoryParams) {
+	domainParams := h.converter.Device().StatusHistoryParamsToDomain(params)
+	body, status := h.device.GetDeviceStatusHistory(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainParams)
+	apiResult := h.converter.Device().StatusHistoryFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/devices/{name}/renderedversions)
+func (h *TransportHandler) ListDeviceRenderedVersions(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.ListDeviceRenderedVersionsParams) {
+	domainParams := h.converter.Device().ListRenderedVersionsParamsToDomain(params)
+	body, status := h.device.ListDeviceRenderedVersions(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainParams)
+	apiResult := h.converter.Device().RenderedVersionListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/devices/{name}/renderedversions/{version})
+func (h *TransportHandler) GetDeviceRenderedVersion(w http.ResponseWriter, r *http.Request, name string, version string) {
+	body, status := h.device.GetDeviceRenderedVersion(r.Context(), transport.OrgIDFromContext(r.Context()), name, version)
+	apiResult := h.converter.Device().RenderedVersionFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/devices/{name}/renderedversions/{version}/diff)
+func (h *TransportHandler) GetDeviceRenderedVersionDiff(w http.ResponseWriter, r *http.Request, name string, version string, params apiv1beta1.GetDeviceRenderedVersionDiffParams) {
+	domainParams := h.converter.Device().RenderedVersionDiffParamsToDomain(params)
+	body, status := h.device.GetDeviceRenderedVersionDiff(r.Context(), transport.OrgIDFromContext(r.Context()), name, version, domainParams)
+	apiResult := h.converter.Device().RenderedVersionDiffFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
 // (PUT /api/v1/devices/{name}/status)
 func (h *TransportHandler) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request, name string) {
 	var device apiv1beta1.Device
@@ -136,6 +167,27 @@ func (h *TransportHandler) DecommissionDevice(w http.ResponseWriter, r *http.Req
 	h.SetResponse(w, apiResult, status)
 }
 
+// (PUT /api/v1/devices/{name}/rollback)
+func (h *TransportHandler) RollbackDevice(w http.ResponseWriter, r *http.Request, name string) {
+	var rollback apiv1beta1.DeviceRollback
+	if err := json.NewDecoder(r.Body).Decode(&rollback); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRollback := h.converter.Device().RollbackToDomain(rollback)
+	body, status := h.device.RollbackDevice(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRollback)
+	apiResult := h.converter.Device().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/devices/{name}/rollback)
+func (h *TransportHandler) ClearDeviceRollback(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.device.ClearDeviceRollback(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.Device().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
 // (POST /api/v1/devices/{name}/applications/{appname}/actions/stop)
 func (h *TransportHandler) StopDeviceApplication(w http.ResponseWriter, r *http.Request, name string, appName string) {
 	body, status := h.device.StopDeviceApplication(r.Context(), transport.OrgIDFromContext(r.Context()), name, appName)
@@ -9,6 +9,7 @@ import (
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/authprovider"
+	"github.com/flightctl/flightctl/internal/service/bulkoperation"
 	"github.com/flightctl/flightctl/internal/service/certificatesigningrequest"
 	"github.com/flightctl/flightctl/internal/service/device"
 	"github.com/flightctl/flightctl/internal/service/enrollmentrequest"
@@ -17,6 +18,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service/organization"
 	"github.com/flightctl/flightctl/internal/service/repository"
 	"github.com/flightctl/flightctl/internal/service/resourcesync"
+	"github.com/flightctl/flightctl/internal/service/secret"
 	"github.com/flightctl/flightctl/internal/service/templateversion"
 	"github.com/go-chi/chi/v5"
 	"github.com/sirupsen/logrus"
@@ -28,6 +30,7 @@ import (
 // the auth-adjacent, non-resource endpoints in auth_token.go/auth_userinfo.go/checkpermission.go.
 type TransportHandler struct {
 	authprovider              authprovider.Service
+	bulkoperation             bulkoperation.Service
 	certificatesigningrequest certificatesigningrequest.Service
 	device                    device.Service
 	enrollmentrequest         enrollmentrequest.Service
@@ -36,6 +39,7 @@ type TransportHandler struct {
 	organization              organization.Service
 	repository                repository.Service
 	resourcesync              resourcesync.Service
+	secret                    secret.Service
 	templateversion           templateversion.Service
 	converter                 convertv1beta1.Converter
 	authN                     common.AuthNMiddleware
@@ -55,6 +59,7 @@ var _ server.Transport = (*TransportHandler)(nil)
 
 func NewTransportHandler(
 	authproviderSvc authprovider.Service,
+	bulkoperationSvc bulkoperation.Service,
 	certificatesigningrequestSvc certificatesigningrequest.Service,
 	deviceSvc device.Service,
 	enrollmentrequestSvc enrollmentrequest.Service,
@@ -63,6 +68,7 @@ func NewTransportHandler(
 	organizationSvc organization.Service,
 	repositorySvc repository.Service,
 	resourcesyncSvc resourcesync.Service,
+	secretSvc secret.Service,
 	templateversionSvc templateversion.Service,
 	converter convertv1beta1.Converter,
 	authN common.AuthNMiddleware,
@@ -72,6 +78,7 @@ func NewTransportHandler(
 ) *TransportHandler {
 	return &TransportHandler{
 		authprovider:              authproviderSvc,
+		bulkoperation:             bulkoperationSvc,
 		certificatesigningrequest: certificatesigningrequestSvc,
 		device:                    deviceSvc,
 		enrollmentrequest:         enrollmentrequestSvc,
@@ -80,6 +87,7 @@ func NewTransportHandler(
 		organization:              organizationSvc,
 		repository:                repositorySvc,
 		resourcesync:              resourcesyncSvc,
+		secret:                    secretSvc,
 		templateversion:           templateversionSvc,
 		converter:                 converter,
 		authN:                     authN,
@@ -204,6 +204,7 @@ var (
 	OciImageReferenceWithTemplatesRegexp = regexp.MustCompile("^" + OciImageReferenceWithTemplatesFmt + "$")
 	OciImageNameRegexp                   = regexp.MustCompile("^" + OciImageNameFmt + "$")
 	OciImageTagRegexp                    = regexp.MustCompile("^" + OciImageTagFmt + "$")
+	OciImageDigestRegexp                 = regexp.MustCompile("^" + OciImageDigestFmt + "$")
 )
 
 // Validates an OCI image reference.
@@ -0,0 +1,304 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/encryption"
+)
+
+const (
+	defaultKVMount      = "secret"
+	defaultAppRoleMount = "approle"
+	requestTimeout      = 30 * time.Second
+	maxResponseSize     = 4 << 20
+)
+
+// ErrSecretNotFound is returned when the requested path does not hold a secret, or its current
+// version has been deleted or destroyed.
+var ErrSecretNotFound = errors.New("vault secret not found")
+
+// Secret is a version of a secret read from a KV version 2 secrets engine.
+type Secret struct {
+	// Data maps each key of the secret to its value. String values are returned as-is; other
+	// JSON values are returned in their JSON encoding.
+	Data map[string][]byte
+	// Version is the KV version of the secret. It increases with every write.
+	Version int
+}
+
+// Fingerprint returns a value that changes whenever the secret is written.
+func (s *Secret) Fingerprint() string {
+	return Fingerprint(s.Version)
+}
+
+// Fingerprint formats a KV version as the fingerprint used by dependency sync.
+func Fingerprint(version int) string {
+	return strconv.Itoa(version)
+}
+
+// Client reads secrets from the KV version 2 secrets engine of a Vault repository.
+type Client struct {
+	baseURL    *url.URL
+	kvMount    string
+	namespace  string
+	auth       domain.VaultAuth
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient creates a client for the given Vault repository spec. Credentials are decrypted
+// here, but the client only authenticates on its first request.
+func NewClient(spec domain.VaultRepoSpec) (*Client, error) {
+	baseURL, err := url.Parse(spec.Url)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Vault URL: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if spec.SkipServerVerification != nil {
+		tlsConfig.InsecureSkipVerify = *spec.SkipServerVerification
+	}
+	if spec.CaCrt != nil {
+		ca, err := base64.StdEncoding.DecodeString(*spec.CaCrt)
+		if err != nil {
+			return nil, fmt.Errorf("decoding CA certificate: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("getting system cert pool: %w", err)
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		rootCAs.AppendCertsFromPEM(ca)
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	kvMount := defaultKVMount
+	if spec.KvMount != nil && *spec.KvMount != "" {
+		kvMount = strings.Trim(*spec.KvMount, "/")
+	}
+	namespace := ""
+	if spec.Namespace != nil {
+		namespace = *spec.Namespace
+	}
+
+	return &Client{
+		baseURL:   baseURL,
+		kvMount:   kvMount,
+		namespace: namespace,
+		auth:      spec.VaultAuth,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: tlsConfig,
+			},
+		},
+	}, nil
+}
+
+// NewClientForSpec creates a client for a repository spec of type vault.
+func NewClientForSpec(repoSpec domain.RepositorySpec) (*Client, error) {
+	repoType, err := repoSpec.Discriminator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine repository type: %w", err)
+	}
+	if domain.RepoSpecType(repoType) != domain.RepoSpecTypeVault {
+		return nil, fmt.Errorf("repository is of type %q, expected %q", repoType, domain.RepoSpecTypeVault)
+	}
+	spec, err := repoSpec.AsVaultRepoSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Vault repo spec: %w", err)
+	}
+	return NewClient(spec)
+}
+
+// ReadSecret reads the current version of the secret at path.
+func (c *Client) ReadSecret(ctx context.Context, secretPath string) (*Secret, error) {
+	var resp struct {
+		Data struct {
+			Data     map[string]json.RawMessage `json:"data"`
+			Metadata struct {
+				Version int `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.kvPath("data", secretPath), nil, &resp); err != nil {
+		return nil, err
+	}
+	// A deleted version returns null data with its metadata.
+	if resp.Data.Data == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
+
+	data := make(map[string][]byte, len(resp.Data.Data))
+	for key, raw := range resp.Data.Data {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			data[key] = []byte(s)
+		} else {
+			data[key] = []byte(raw)
+		}
+	}
+	return &Secret{Data: data, Version: resp.Data.Metadata.Version}, nil
+}
+
+// ReadSecretVersion reads the current version of the secret at path from its metadata, without
+// reading the secret itself.
+func (c *Client) ReadSecretVersion(ctx context.Context, secretPath string) (int, error) {
+	var resp struct {
+		Data struct {
+			CurrentVersion int `json:"current_version"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.kvPath("metadata", secretPath), nil, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Data.CurrentVersion, nil
+}
+
+// TestAccess verifies that the client can authenticate and that its token is valid.
+func (c *Client) TestAccess(ctx context.Context) error {
+	return c.do(ctx, http.MethodGet, "auth/token/lookup-self", nil, nil)
+}
+
+func (c *Client) kvPath(kind, secretPath string) string {
+	return c.kvMount + "/" + kind + "/" + strings.Trim(secretPath, "/")
+}
+
+func (c *Client) login(ctx context.Context) error {
+	if c.token != "" {
+		return nil
+	}
+
+	authType, err := c.auth.Discriminator()
+	if err != nil {
+		return fmt.Errorf("failed to determine Vault auth type: %w", err)
+	}
+	switch domain.VaultAuthType(authType) {
+	case domain.VaultAuthTypeToken:
+		tokenAuth, err := c.auth.AsVaultTokenAuth()
+		if err != nil {
+			return fmt.Errorf("failed to parse Vault token auth: %w", err)
+		}
+		token, _, err := encryption.Decrypt(ctx, encryption.Ciphertext(tokenAuth.Token))
+		if err != nil {
+			return fmt.Errorf("decrypt Vault token: %w", err)
+		}
+		c.token = string(token)
+		return nil
+	case domain.VaultAuthTypeAppRole:
+		appRoleAuth, err := c.auth.AsVaultAppRoleAuth()
+		if err != nil {
+			return fmt.Errorf("failed to parse Vault AppRole auth: %w", err)
+		}
+		secretID, _, err := encryption.Decrypt(ctx, encryption.Ciphertext(appRoleAuth.SecretId))
+		if err != nil {
+			return fmt.Errorf("decrypt Vault AppRole secret ID: %w", err)
+		}
+		mount := defaultAppRoleMount
+		if appRoleAuth.MountPath != nil && *appRoleAuth.MountPath != "" {
+			mount = strings.Trim(*appRoleAuth.MountPath, "/")
+		}
+		body, err := json.Marshal(map[string]string{"role_id": appRoleAuth.RoleId, "secret_id": string(secretID)})
+		if err != nil {
+			return err
+		}
+		var resp struct {
+			Auth struct {
+				ClientToken string `json:"client_token"`
+			} `json:"auth"`
+		}
+		if err := c.send(ctx, http.MethodPost, "auth/"+mount+"/login", body, &resp); err != nil {
+			return fmt.Errorf("AppRole login: %w", err)
+		}
+		if resp.Auth.ClientToken == "" {
+			return fmt.Errorf("AppRole login: no client token in response")
+		}
+		c.token = resp.Auth.ClientToken
+		return nil
+	default:
+		return fmt.Errorf("unsupported Vault auth type %q", authType)
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, apiPath string, body []byte, out any) error {
+	if err := c.login(ctx); err != nil {
+		return err
+	}
+	return c.send(ctx, method, apiPath, body, out)
+}
+
+func (c *Client) send(ctx context.Context, method, apiPath string, body []byte, out any) error {
+	reqURL := c.baseURL.JoinPath("v1", apiPath)
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), reader)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("reading response body: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, apiPath)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("unexpected status code %d from Vault%s", resp.StatusCode, vaultErrors(respBody))
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("parsing Vault response: %w", err)
+	}
+	return nil
+}
+
+// vaultErrors formats the errors Vault returns in the response body, if any.
+func vaultErrors(body []byte) string {
+	var resp struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || len(resp.Errors) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(resp.Errors, "; ")
+}
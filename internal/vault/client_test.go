@@ -0,0 +1,201 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/encryption"
+	"github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "vault-test-encryption-*")
+	if err != nil {
+		log.Fatalf("creating temp encryption dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	keyPath := filepath.Join(dir, "key")
+	key, err := crypto.GenerateAES256Key()
+	if err != nil {
+		log.Fatalf("generating test encryption key: %v", err)
+	}
+	if err := os.WriteFile(keyPath, []byte(key), 0600); err != nil {
+		log.Fatalf("writing test encryption key: %v", err)
+	}
+
+	cfg := config.NewDefault()
+	cfg.Encryption = &config.EncryptionConfig{
+		Keys:        []config.EncryptionKeyConfig{{ID: "default", Path: keyPath}},
+		ActiveKeyID: "default",
+	}
+	if err := encryption.InitGlobalEncryption(logrus.StandardLogger(), cfg); err != nil {
+		log.Fatalf("initializing test encryption: %v", err)
+	}
+
+	os.Exit(m.Run())
+}
+
+const (
+	testToken    = "s.root-token"
+	testRoleID   = "role-1"
+	testSecretID = "secret-1"
+)
+
+// fakeVault is a minimal stand-in for the Vault HTTP API: token and AppRole auth, and a KV
+// version 2 engine mounted at "kv".
+type fakeVault struct {
+	mu      sync.Mutex
+	secrets map[string][]map[string]any
+}
+
+func (f *fakeVault) put(path string, data map[string]any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.secrets[path] = append(f.secrets[path], data)
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/v1/auth/approle/login" {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role_id"] != testRoleID || body["secret_id"] != testSecretID {
+			writeVaultError(w, http.StatusBadRequest, "invalid role or secret ID")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{"client_token": testToken}})
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != testToken {
+		writeVaultError(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/v1/auth/token/lookup-self":
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": testToken}})
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/data/"):
+		versions := f.secrets[strings.TrimPrefix(r.URL.Path, "/v1/kv/data/")]
+		if len(versions) == 0 {
+			writeVaultError(w, http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"data":     versions[len(versions)-1],
+			"metadata": map[string]any{"version": len(versions)},
+		}})
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/metadata/"):
+		versions := f.secrets[strings.TrimPrefix(r.URL.Path, "/v1/kv/metadata/")]
+		if len(versions) == 0 {
+			writeVaultError(w, http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"current_version": len(versions)}})
+	default:
+		writeVaultError(w, http.StatusNotFound)
+	}
+}
+
+func writeVaultError(w http.ResponseWriter, code int, errs ...string) {
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": append([]string{}, errs...)})
+}
+
+func newTestVault(t *testing.T) (*fakeVault, *httptest.Server) {
+	fake := &fakeVault{secrets: map[string][]map[string]any{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func tokenAuth(t *testing.T, token string) domain.VaultAuth {
+	var auth domain.VaultAuth
+	require.NoError(t, auth.FromVaultTokenAuth(domain.VaultTokenAuth{AuthType: domain.VaultAuthTypeToken, Token: token}))
+	return auth
+}
+
+func appRoleAuth(t *testing.T, secretID string) domain.VaultAuth {
+	var auth domain.VaultAuth
+	require.NoError(t, auth.FromVaultAppRoleAuth(domain.VaultAppRoleAuth{AuthType: domain.VaultAuthTypeAppRole, RoleId: testRoleID, SecretId: secretID}))
+	return auth
+}
+
+func TestClientReadSecret(t *testing.T) {
+	fake, server := newTestVault(t)
+	fake.put("edge/site-a", map[string]any{"tls.crt": "cert-v1", "port": 8443})
+	fake.put("edge/site-a", map[string]any{"tls.crt": "cert-v2", "port": 8443})
+
+	tests := []struct {
+		name string
+		auth domain.VaultAuth
+	}{
+		{name: "When using token auth it should read the current version", auth: tokenAuth(t, testToken)},
+		{name: "When using AppRole auth it should log in and read the current version", auth: appRoleAuth(t, testSecretID)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			client, err := NewClient(domain.VaultRepoSpec{Url: server.URL, KvMount: lo.ToPtr("kv"), VaultAuth: tt.auth})
+			require.NoError(err)
+
+			secret, err := client.ReadSecret(context.Background(), "/edge/site-a")
+			require.NoError(err)
+			require.Equal(2, secret.Version)
+			require.Equal("2", secret.Fingerprint())
+			require.Equal([]byte("cert-v2"), secret.Data["tls.crt"])
+			require.Equal([]byte("8443"), secret.Data["port"])
+
+			version, err := client.ReadSecretVersion(context.Background(), "edge/site-a")
+			require.NoError(err)
+			require.Equal(2, version)
+		})
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	_, server := newTestVault(t)
+
+	t.Run("When the secret does not exist it should return ErrSecretNotFound", func(t *testing.T) {
+		client, err := NewClient(domain.VaultRepoSpec{Url: server.URL, KvMount: lo.ToPtr("kv"), VaultAuth: tokenAuth(t, testToken)})
+		require.NoError(t, err)
+		_, err = client.ReadSecret(context.Background(), "missing")
+		require.ErrorIs(t, err, ErrSecretNotFound)
+	})
+
+	t.Run("When the token is invalid it should surface the Vault error", func(t *testing.T) {
+		client, err := NewClient(domain.VaultRepoSpec{Url: server.URL, VaultAuth: tokenAuth(t, "wrong")})
+		require.NoError(t, err)
+		err = client.TestAccess(context.Background())
+		require.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("When the AppRole secret ID is wrong it should fail to log in", func(t *testing.T) {
+		client, err := NewClient(domain.VaultRepoSpec{Url: server.URL, VaultAuth: appRoleAuth(t, "wrong")})
+		require.NoError(t, err)
+		err = client.TestAccess(context.Background())
+		require.ErrorContains(t, err, "AppRole login")
+	})
+
+	t.Run("When the repository is not of type vault it should refuse to build a client", func(t *testing.T) {
+		var spec domain.RepositorySpec
+		require.NoError(t, spec.FromHttpRepoSpec(domain.HttpRepoSpec{Url: server.URL}))
+		_, err := NewClientForSpec(spec)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,122 @@
+package vulnscan
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// cvss3Weights holds the metric values of the CVSS v3.x base score equations.
+var cvss3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// cvss3BaseScore computes the base score of a CVSS v3.0 or v3.1 vector such as
+// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+func cvss3BaseScore(vector string) (float64, error) {
+	parts := strings.Split(vector, "/")
+	if len(parts) == 0 || !strings.HasPrefix(parts[0], "CVSS:3") {
+		return 0, fmt.Errorf("%q is not a CVSS v3 vector", vector)
+	}
+	metrics := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		k, v, ok := strings.Cut(part, ":")
+		if !ok {
+			return 0, fmt.Errorf("invalid CVSS metric %q", part)
+		}
+		metrics[k] = v
+	}
+
+	weight := func(metric string) (float64, error) {
+		w, ok := cvss3Weights[metric][metrics[metric]]
+		if !ok {
+			return 0, fmt.Errorf("CVSS vector %q has an invalid or missing %s metric", vector, metric)
+		}
+		return w, nil
+	}
+	var av, ac, ui, c, i, a float64
+	for _, m := range []struct {
+		name string
+		dst  *float64
+	}{{"AV", &av}, {"AC", &ac}, {"UI", &ui}, {"C", &c}, {"I", &i}, {"A", &a}} {
+		w, err := weight(m.name)
+		if err != nil {
+			return 0, err
+		}
+		*m.dst = w
+	}
+
+	var changed bool
+	switch metrics["S"] {
+	case "U":
+	case "C":
+		changed = true
+	default:
+		return 0, fmt.Errorf("CVSS vector %q has an invalid or missing S metric", vector)
+	}
+
+	var pr float64
+	switch metrics["PR"] {
+	case "N":
+		pr = 0.85
+	case "L":
+		pr = 0.62
+		if changed {
+			pr = 0.68
+		}
+	case "H":
+		pr = 0.27
+		if changed {
+			pr = 0.5
+		}
+	default:
+		return 0, fmt.Errorf("CVSS vector %q has an invalid or missing PR metric", vector)
+	}
+
+	iss := 1 - (1-c)*(1-i)*(1-a)
+	var impact float64
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	} else {
+		impact = 6.42 * iss
+	}
+	if impact <= 0 {
+		return 0, nil
+	}
+	exploitability := 8.22 * av * ac * pr * ui
+	if changed {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+	return roundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// roundUp returns the smallest number with one decimal place that is equal to or greater than
+// x, as defined by CVSS v3.1 with its correction for floating point inaccuracy.
+func roundUp(x float64) float64 {
+	i := int64(math.Round(x * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return float64(i/10000+1) / 10
+}
+
+// severityForScore returns the qualitative severity rating of a CVSS score.
+func severityForScore(score float64) string {
+	switch {
+	case score == 0:
+		return "None"
+	case score < 4:
+		return "Low"
+	case score < 7:
+		return "Medium"
+	case score < 9:
+		return "High"
+	default:
+		return "Critical"
+	}
+}
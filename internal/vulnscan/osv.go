@@ -0,0 +1,246 @@
+package vulnscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/flightctl/flightctl/internal/store/model"
+	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// OSVScanner matches the SBOMs the image builder stored for each image digest against the OSV
+// records imported into a local directory, so that vulnerability reporting works without
+// access to a Trustify server. OSV bundles can be downloaded from
+// https://osv-vulnerabilities.storage.googleapis.com/<ecosystem>/all.zip and copied into the
+// directory; they are picked up on the next sync.
+type OSVScanner struct {
+	sbomStore SBOMStore
+	log       logrus.FieldLogger
+
+	mu sync.Mutex
+	db *osvDatabase
+}
+
+var _ Scanner = (*OSVScanner)(nil)
+
+// NewOSVScanner returns a scanner that reads OSV records from databaseDir.
+func NewOSVScanner(databaseDir string, sbomStore SBOMStore, log logrus.FieldLogger) *OSVScanner {
+	return &OSVScanner{
+		sbomStore: sbomStore,
+		log:       log,
+		db:        newOSVDatabase(databaseDir, log),
+	}
+}
+
+// cycloneDXComponent is the subset of a CycloneDX component the scanner uses. Components can
+// be nested.
+type cycloneDXComponent struct {
+	Purl       string               `json:"purl"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXDocument struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+// GetVulnerabilitiesForDigests returns the findings for each digest that has a stored SBOM.
+// Digests without an SBOM map to a nil slice.
+func (s *OSVScanner) GetVulnerabilitiesForDigests(ctx context.Context, digests []string) (map[string][]trustifyv2.Finding, error) {
+	s.mu.Lock()
+	index, err := s.db.load()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	sboms, err := s.sbomStore.GetImageSBOMs(ctx, digests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SBOMs: %w", err)
+	}
+
+	result := make(map[string][]trustifyv2.Finding, len(digests))
+	for _, digest := range digests {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		document, ok := sboms[digest]
+		if !ok {
+			result[digest] = nil
+			continue
+		}
+		findings, err := s.scanSBOM(index, digest, document)
+		if err != nil {
+			s.log.WithError(err).Warnf("Failed to scan SBOM for digest %s", digest)
+			result[digest] = nil
+			continue
+		}
+		result[digest] = findings
+	}
+	return result, nil
+}
+
+// scanSBOM returns one finding per CVE affecting a component of the SBOM. When several
+// components or records report the same CVE, the most severe one is kept.
+func (s *OSVScanner) scanSBOM(index osvIndex, digest string, document []byte) ([]trustifyv2.Finding, error) {
+	var doc cycloneDXDocument
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return nil, fmt.Errorf("parsing CycloneDX SBOM: %w", err)
+	}
+
+	byCVE := make(map[string]trustifyv2.Finding)
+	var visit func(components []cycloneDXComponent)
+	visit = func(components []cycloneDXComponent) {
+		for _, c := range components {
+			visit(c.Components)
+			if c.Purl == "" {
+				continue
+			}
+			p, err := parsePURL(c.Purl)
+			if err != nil || p.Version == "" {
+				continue
+			}
+			for _, f := range matchPackage(index, digest, p) {
+				if existing, ok := byCVE[f.CVEID]; ok && !moreSevere(f, existing) {
+					continue
+				}
+				byCVE[f.CVEID] = f
+			}
+		}
+	}
+	visit(doc.Components)
+
+	findings := make([]trustifyv2.Finding, 0, len(byCVE))
+	for _, f := range byCVE {
+		findings = append(findings, f)
+	}
+	sort.Slice(findings, func(i, j int) bool { return findings[i].CVEID < findings[j].CVEID })
+	return findings, nil
+}
+
+// matchPackage returns the findings for every record that affects the package.
+func matchPackage(index osvIndex, digest string, p packageURL) []trustifyv2.Finding {
+	ecosystem, names := osvPackages(p)
+	byName, ok := index[ecosystem]
+	if !ok {
+		return nil
+	}
+	version := purlVersion(p)
+	release := distroRelease(p)
+
+	var findings []trustifyv2.Finding
+	seen := make(map[string]bool)
+	for _, name := range names {
+		for _, entry := range byName[indexName(ecosystem, name)] {
+			if seen[entry.record.ID] || !matchesRelease(entry.release, release) || !entry.affects(ecosystem, version) {
+				continue
+			}
+			seen[entry.record.ID] = true
+			findings = append(findings, entryFindings(digest, entry)...)
+		}
+	}
+	return findings
+}
+
+// entryFindings converts a matching record into one finding per CVE it covers. Records that
+// are not CVEs themselves, such as distribution advisories, are reported under the CVEs they
+// alias, with the record ID as the advisory ID. Records without any CVE alias are reported
+// under their own ID.
+func entryFindings(digest string, entry osvEntry) []trustifyv2.Finding {
+	r := entry.record
+	var cves []string
+	var advisoryID string
+	if isCVE(r.ID) {
+		cves = append(cves, r.ID)
+	} else {
+		advisoryID = r.ID
+	}
+	for _, id := range append(append([]string{}, r.Aliases...), r.Upstream...) {
+		if isCVE(id) && !slices.Contains(cves, id) {
+			cves = append(cves, id)
+		}
+	}
+	if len(cves) == 0 {
+		cves = []string{r.ID}
+	}
+
+	score, severity := entrySeverity(entry)
+	description := r.Summary
+	if description == "" {
+		description = r.Details
+	}
+
+	findings := make([]trustifyv2.Finding, 0, len(cves))
+	for _, cve := range cves {
+		findings = append(findings, trustifyv2.Finding{
+			ImageDigest: digest,
+			CVEID:       cve,
+			Status:      string(model.VulnerabilityStatusAffected),
+			Severity:    severity,
+			AdvisoryID:  advisoryID,
+			CVSSScore:   score,
+			Description: description,
+			PublishedAt: r.Published,
+		})
+	}
+	return findings
+}
+
+// entrySeverity returns the CVSS v3 base score and its rating, preferring the affected
+// package's own score over the record's. Without a CVSS v3 vector it falls back to the
+// qualitative severity some databases record, such as GitHub's "MODERATE" or Red Hat's
+// "Important".
+func entrySeverity(entry osvEntry) (*float64, string) {
+	for _, sev := range append(append([]osvSeverity{}, entry.affected.Severity...), entry.record.Severity...) {
+		if sev.Type != "CVSS_V3" {
+			continue
+		}
+		if score, err := cvss3BaseScore(sev.Score); err == nil {
+			return &score, severityForScore(score)
+		}
+	}
+	for _, m := range []map[string]any{entry.affected.EcosystemSpecific, entry.affected.DatabaseSpecific, entry.record.DatabaseSpecific} {
+		if label, ok := m["severity"].(string); ok {
+			if severity := normalizeSeverityLabel(label); severity != "" {
+				return nil, severity
+			}
+		}
+	}
+	return nil, string(model.VulnerabilitySeverityUnknown)
+}
+
+func normalizeSeverityLabel(label string) string {
+	switch strings.ToLower(strings.TrimSpace(label)) {
+	case "critical":
+		return string(model.VulnerabilitySeverityCritical)
+	case "high", "important":
+		return string(model.VulnerabilitySeverityHigh)
+	case "medium", "moderate":
+		return string(model.VulnerabilitySeverityMedium)
+	case "low":
+		return string(model.VulnerabilitySeverityLow)
+	case "none":
+		return string(model.VulnerabilitySeverityNone)
+	default:
+		return ""
+	}
+}
+
+// moreSevere reports whether a ranks above b by severity, then by CVSS score.
+func moreSevere(a, b trustifyv2.Finding) bool {
+	rankA := model.VulnerabilitySeverity(a.Severity).Rank()
+	rankB := model.VulnerabilitySeverity(b.Severity).Rank()
+	if rankA != rankB {
+		return rankA < rankB
+	}
+	return a.CVSSScore != nil && (b.CVSSScore == nil || *a.CVSSScore > *b.CVSSScore)
+}
+
+func isCVE(id string) bool {
+	return strings.HasPrefix(id, "CVE-")
+}
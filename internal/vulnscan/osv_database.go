@@ -0,0 +1,325 @@
+package vulnscan
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// osvRecord is the subset of the OSV schema (https://ossf.github.io/osv-schema/) the scanner
+// uses.
+type osvRecord struct {
+	ID               string         `json:"id"`
+	Summary          string         `json:"summary"`
+	Details          string         `json:"details"`
+	Aliases          []string       `json:"aliases"`
+	Upstream         []string       `json:"upstream"`
+	Published        *time.Time     `json:"published"`
+	Withdrawn        *time.Time     `json:"withdrawn"`
+	Severity         []osvSeverity  `json:"severity"`
+	Affected         []osvAffected  `json:"affected"`
+	DatabaseSpecific map[string]any `json:"database_specific"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvAffected struct {
+	Package           osvPackage     `json:"package"`
+	Ranges            []osvRange     `json:"ranges"`
+	Versions          []string       `json:"versions"`
+	Severity          []osvSeverity  `json:"severity"`
+	EcosystemSpecific map[string]any `json:"ecosystem_specific"`
+	DatabaseSpecific  map[string]any `json:"database_specific"`
+}
+
+type osvPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+type osvRange struct {
+	Type   string     `json:"type"`
+	Events []osvEvent `json:"events"`
+}
+
+type osvEvent struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// osvEntry is one affected package of a record.
+type osvEntry struct {
+	record   *osvRecord
+	affected *osvAffected
+	// release is the distribution release of the ecosystem, e.g. "12" for "Debian:12".
+	release string
+}
+
+// osvIndex holds the affected packages of all loaded records, keyed by ecosystem and
+// lowercased package name.
+type osvIndex map[string]map[string][]osvEntry
+
+// osvDatabase loads the OSV records found in a directory and reloads them when the directory's
+// contents change.
+type osvDatabase struct {
+	dir         string
+	log         logrus.FieldLogger
+	fingerprint string
+	index       osvIndex
+}
+
+func newOSVDatabase(dir string, log logrus.FieldLogger) *osvDatabase {
+	return &osvDatabase{dir: dir, log: log}
+}
+
+// load returns the index of the records in the database directory, re-reading the directory
+// only if a file was added, removed or modified since the last call.
+func (d *osvDatabase) load() (osvIndex, error) {
+	files, fingerprint, err := d.scan()
+	if err != nil {
+		return nil, err
+	}
+	if d.index != nil && fingerprint == d.fingerprint {
+		return d.index, nil
+	}
+
+	index := make(osvIndex)
+	var records int
+	for _, file := range files {
+		n, err := loadOSVFile(file, index)
+		if err != nil {
+			return nil, fmt.Errorf("loading OSV records from %s: %w", file, err)
+		}
+		records += n
+	}
+	d.log.Infof("Loaded %d OSV records from %d files in %s", records, len(files), d.dir)
+	d.index = index
+	d.fingerprint = fingerprint
+	return index, nil
+}
+
+// scan lists the database files and returns a fingerprint of their names, sizes and
+// modification times.
+func (d *osvDatabase) scan() ([]string, string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, "", fmt.Errorf("reading OSV database directory: %w", err)
+	}
+	var files []string
+	var fingerprint strings.Builder
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".json" && ext != ".zip") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, "", fmt.Errorf("reading OSV database directory: %w", err)
+		}
+		files = append(files, filepath.Join(d.dir, entry.Name()))
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", entry.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	return files, fingerprint.String(), nil
+}
+
+// loadOSVFile adds the records in a JSON file, or in the JSON files of a ZIP archive, to index.
+func loadOSVFile(path string, index osvIndex) (int, error) {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return 0, err
+		}
+		return addOSVRecords(data, index)
+	}
+
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return 0, err
+	}
+	defer zr.Close()
+	var total int
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !strings.EqualFold(filepath.Ext(f.Name), ".json") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return 0, err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return 0, fmt.Errorf("reading %s: %w", f.Name, err)
+		}
+		n, err := addOSVRecords(data, index)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", f.Name, err)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// addOSVRecords adds a single record, or an array of records, to index. Withdrawn records are
+// skipped.
+func addOSVRecords(data []byte, index osvIndex) (int, error) {
+	var records []*osvRecord
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &records); err != nil {
+			return 0, err
+		}
+	} else {
+		var r osvRecord
+		if err := json.Unmarshal(trimmed, &r); err != nil {
+			return 0, err
+		}
+		records = []*osvRecord{&r}
+	}
+
+	var added int
+	for _, r := range records {
+		if r == nil || r.ID == "" || r.Withdrawn != nil {
+			continue
+		}
+		for i := range r.Affected {
+			a := &r.Affected[i]
+			ecosystem, release, _ := strings.Cut(a.Package.Ecosystem, ":")
+			if ecosystem == "" || a.Package.Name == "" {
+				continue
+			}
+			byName, ok := index[ecosystem]
+			if !ok {
+				byName = make(map[string][]osvEntry)
+				index[ecosystem] = byName
+			}
+			name := indexName(ecosystem, a.Package.Name)
+			byName[name] = append(byName[name], osvEntry{record: r, affected: a, release: release})
+		}
+		added++
+	}
+	return added, nil
+}
+
+// indexName returns the key a package name is indexed under.
+func indexName(ecosystem, name string) string {
+	if ecosystem == "PyPI" {
+		return normalizePyPIName(name)
+	}
+	return strings.ToLower(name)
+}
+
+// affects reports whether the entry's ranges or explicit version list include version.
+func (e osvEntry) affects(ecosystem, version string) bool {
+	if slices.Contains(e.affected.Versions, version) {
+		return true
+	}
+	for _, r := range e.affected.Ranges {
+		if r.Type != "ECOSYSTEM" && r.Type != "SEMVER" {
+			continue
+		}
+		compare := func(a, b string) int { return compareVersions(ecosystem, a, b) }
+		if r.Type == "SEMVER" {
+			compare = compareSemver
+		}
+		if rangeAffects(compare, r.Events, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeAffects evaluates the events of an OSV range in version order, as described by the OSV
+// schema.
+func rangeAffects(compare func(a, b string) int, events []osvEvent, version string) bool {
+	eventVersion := func(e osvEvent) string {
+		switch {
+		case e.Introduced != "":
+			return e.Introduced
+		case e.Fixed != "":
+			return e.Fixed
+		case e.LastAffected != "":
+			return e.LastAffected
+		default:
+			return e.Limit
+		}
+	}
+	sorted := slices.Clone(events)
+	slices.SortStableFunc(sorted, func(a, b osvEvent) int {
+		va, vb := eventVersion(a), eventVersion(b)
+		switch {
+		case va == vb:
+			return 0
+		case va == "0":
+			return -1
+		case vb == "0":
+			return 1
+		default:
+			return compare(va, vb)
+		}
+	})
+
+	affected := false
+	for _, e := range sorted {
+		switch {
+		case e.Introduced != "":
+			if e.Introduced == "0" || compare(version, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if compare(version, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if compare(version, e.LastAffected) > 0 {
+				affected = false
+			}
+		case e.Limit != "":
+			if compare(version, e.Limit) >= 0 {
+				affected = false
+			}
+		}
+	}
+	return affected
+}
+
+// matchesRelease reports whether an ecosystem release such as "12" ("Debian:12"), "v3.18"
+// ("Alpine:v3.18") or "enterprise_linux:9::appstream" ("Red Hat:...") applies to the
+// distribution release of a package. Entries without a release apply to every release, as do
+// all entries when the package's release is unknown.
+func matchesRelease(entryRelease, pkgRelease string) bool {
+	if entryRelease == "" || pkgRelease == "" {
+		return true
+	}
+	want := firstVersionToken(entryRelease)
+	if want == "" {
+		return true
+	}
+	return pkgRelease == want || strings.HasPrefix(pkgRelease, want+".")
+}
+
+// firstVersionToken returns the first run of digits and dots in s, trimmed of dots.
+func firstVersionToken(s string) string {
+	start := strings.IndexFunc(s, isDigit)
+	if start < 0 {
+		return ""
+	}
+	end := start
+	for end < len(s) && (isDigit(rune(s[end])) || s[end] == '.') {
+		end++
+	}
+	return strings.Trim(s[start:end], ".")
+}
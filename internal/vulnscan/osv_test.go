@@ -0,0 +1,204 @@
+package vulnscan
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSBOMStore struct {
+	sboms map[string][]byte
+}
+
+func (f *fakeSBOMStore) GetImageSBOMs(_ context.Context, digests []string) (map[string][]byte, error) {
+	result := make(map[string][]byte)
+	for _, d := range digests {
+		if doc, ok := f.sboms[d]; ok {
+			result[d] = doc
+		}
+	}
+	return result, nil
+}
+
+const testSBOM = `{
+  "bomFormat": "CycloneDX",
+  "components": [
+    {"name": "openssl-libs", "purl": "pkg:rpm/redhat/openssl-libs@3.0.7-27.el9?arch=x86_64&distro=rhel-9.4"},
+    {"name": "bash", "purl": "pkg:rpm/redhat/bash@5.1.8-9.el9?arch=x86_64&distro=rhel-9.4"},
+    {"name": "app", "components": [
+      {"name": "lodash", "purl": "pkg:npm/lodash@4.17.20"}
+    ]}
+  ]
+}`
+
+// rhsaRecord is a distribution advisory covering two CVEs, with a fix in a later release.
+const rhsaRecord = `{
+  "id": "RHSA-2024:2447",
+  "summary": "openssl security update",
+  "upstream": ["CVE-2023-5678", "CVE-2024-0727"],
+  "published": "2024-04-30T00:00:00Z",
+  "severity": [{"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"}],
+  "affected": [
+    {"package": {"ecosystem": "Red Hat:enterprise_linux:9::baseos", "name": "openssl-libs"},
+     "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "1:3.0.7-28.el9_4"}]}]},
+    {"package": {"ecosystem": "Red Hat:enterprise_linux:8::baseos", "name": "bash"},
+     "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "0:9.9-1.el8"}]}]}
+  ]
+}`
+
+// ghsaRecords holds one record that affects the SBOM and one that is fixed in the SBOM's
+// version.
+const ghsaRecords = `[
+  {
+    "id": "GHSA-35jh-r3h4-6jhm",
+    "aliases": ["CVE-2021-23337"],
+    "summary": "Command Injection in lodash",
+    "database_specific": {"severity": "HIGH"},
+    "affected": [{"package": {"ecosystem": "npm", "name": "lodash"},
+      "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]}]
+  },
+  {
+    "id": "GHSA-p6mc-m468-83gw",
+    "aliases": ["CVE-2020-8203"],
+    "summary": "Prototype Pollution in lodash",
+    "affected": [{"package": {"ecosystem": "npm", "name": "lodash"},
+      "ranges": [{"type": "SEMVER", "events": [{"introduced": "3.7.0"}, {"fixed": "4.17.19"}]}]}]
+  }
+]`
+
+func writeZip(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+}
+
+func TestOSVScanner_GetVulnerabilitiesForDigests(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	require.NoError(os.WriteFile(filepath.Join(dir, "redhat.json"), []byte(rhsaRecord), 0o600))
+	writeZip(t, filepath.Join(dir, "npm.zip"), map[string]string{"ghsa.json": ghsaRecords})
+
+	store := &fakeSBOMStore{sboms: map[string][]byte{"sha256:aaa": []byte(testSBOM)}}
+	scanner := NewOSVScanner(dir, store, logrus.New())
+
+	result, err := scanner.GetVulnerabilitiesForDigests(context.Background(), []string{"sha256:aaa", "sha256:no-sbom"})
+	require.NoError(err)
+	require.Contains(result, "sha256:no-sbom")
+	require.Nil(result["sha256:no-sbom"])
+
+	findings := result["sha256:aaa"]
+	require.Len(findings, 3)
+
+	require.Equal("CVE-2021-23337", findings[0].CVEID)
+	require.Equal("High", findings[0].Severity)
+	require.Equal("Command Injection in lodash", findings[0].Description)
+
+	// The RHSA is reported under each CVE it covers. The bash entry is for another release.
+	for _, f := range findings[1:] {
+		require.Equal("sha256:aaa", f.ImageDigest)
+		require.Equal("RHSA-2024:2447", f.AdvisoryID)
+		require.Equal("affected", f.Status)
+		require.Equal("High", f.Severity)
+		require.NotNil(f.CVSSScore)
+		require.InDelta(7.5, *f.CVSSScore, 0.001)
+		require.NotNil(f.PublishedAt)
+	}
+	require.Equal("CVE-2023-5678", findings[1].CVEID)
+	require.Equal("CVE-2024-0727", findings[2].CVEID)
+}
+
+func TestOSVScanner_ReloadsChangedDatabase(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	store := &fakeSBOMStore{sboms: map[string][]byte{"sha256:aaa": []byte(testSBOM)}}
+	scanner := NewOSVScanner(dir, store, logrus.New())
+
+	result, err := scanner.GetVulnerabilitiesForDigests(context.Background(), []string{"sha256:aaa"})
+	require.NoError(err)
+	require.NotNil(result["sha256:aaa"])
+	require.Empty(result["sha256:aaa"])
+
+	path := filepath.Join(dir, "npm.json")
+	require.NoError(os.WriteFile(path, []byte(ghsaRecords), 0o600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(os.Chtimes(path, later, later))
+
+	result, err = scanner.GetVulnerabilitiesForDigests(context.Background(), []string{"sha256:aaa"})
+	require.NoError(err)
+	require.Len(result["sha256:aaa"], 1)
+}
+
+func TestOSVScanner_InvalidDatabaseFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{not json"), 0o600))
+	scanner := NewOSVScanner(dir, &fakeSBOMStore{}, logrus.New())
+
+	_, err := scanner.GetVulnerabilitiesForDigests(context.Background(), []string{"sha256:aaa"})
+	require.ErrorContains(t, err, "broken.json")
+}
+
+func TestOSVPackages(t *testing.T) {
+	tests := []struct {
+		purl      string
+		ecosystem string
+		names     []string
+		version   string
+	}{
+		{purl: "pkg:npm/%40babel/core@7.22.0", ecosystem: "npm", names: []string{"@babel/core"}, version: "7.22.0"},
+		{purl: "pkg:pypi/Django_Rest.Framework@3.14.0", ecosystem: "PyPI", names: []string{"django-rest-framework"}, version: "3.14.0"},
+		{purl: "pkg:golang/golang.org/x/net@v0.17.0", ecosystem: "Go", names: []string{"golang.org/x/net"}, version: "v0.17.0"},
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", ecosystem: "Maven", names: []string{"org.apache.logging.log4j:log4j-core"}, version: "2.14.1"},
+		{purl: "pkg:deb/debian/libssl3@3.0.11-1~deb12u2?upstream=openssl&distro=debian-12", ecosystem: "Debian", names: []string{"libssl3", "openssl"}, version: "3.0.11-1~deb12u2"},
+		{purl: "pkg:rpm/redhat/openssl@3.0.7-27.el9?epoch=1&distro=rhel-9.4", ecosystem: "Red Hat", names: []string{"openssl"}, version: "1:3.0.7-27.el9"},
+		{purl: "pkg:oci/flightctl-os@sha256%3Aabc", ecosystem: "", names: nil, version: "sha256:abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			p, err := parsePURL(tt.purl)
+			require.NoError(t, err)
+			ecosystem, names := osvPackages(p)
+			require.Equal(t, tt.ecosystem, ecosystem)
+			require.Equal(t, tt.names, names)
+			require.Equal(t, tt.version, purlVersion(p))
+		})
+	}
+
+	_, err := parsePURL("openssl@3.0.7")
+	require.Error(t, err)
+}
+
+func TestNewScanner(t *testing.T) {
+	log := logrus.New()
+	ctx := context.Background()
+
+	scanner, err := NewScanner(ctx, &config.VulnerabilityConfig{Scanner: config.VulnerabilityScannerOSV, OSV: &config.OSVScannerConfig{DatabaseDir: t.TempDir()}}, &fakeSBOMStore{}, log)
+	require.NoError(t, err)
+	require.IsType(t, &OSVScanner{}, scanner)
+
+	scanner, err = NewScanner(ctx, &config.VulnerabilityConfig{Scanner: config.VulnerabilityScannerOSV}, &fakeSBOMStore{}, log)
+	require.NoError(t, err)
+	require.Nil(t, scanner)
+
+	scanner, err = NewScanner(ctx, &config.VulnerabilityConfig{}, &fakeSBOMStore{}, log)
+	require.NoError(t, err)
+	require.Nil(t, scanner)
+
+	_, err = NewScanner(ctx, &config.VulnerabilityConfig{Scanner: "grype"}, &fakeSBOMStore{}, log)
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package vulnscan
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// packageURL is a parsed package URL (pkg:type/namespace/name@version?qualifiers).
+type packageURL struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers url.Values
+}
+
+// parsePURL parses a package URL. Namespace segments and the name are unescaped.
+func parsePURL(s string) (packageURL, error) {
+	rest, ok := strings.CutPrefix(s, "pkg:")
+	if !ok {
+		return packageURL{}, fmt.Errorf("package URL %q must start with pkg:", s)
+	}
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		rest = rest[:i]
+	}
+
+	var p packageURL
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		q, err := url.ParseQuery(rest[i+1:])
+		if err != nil {
+			return packageURL{}, fmt.Errorf("package URL %q has invalid qualifiers: %w", s, err)
+		}
+		p.Qualifiers = q
+		rest = rest[:i]
+	}
+	if i := strings.LastIndexByte(rest, '@'); i >= 0 {
+		v, err := url.PathUnescape(rest[i+1:])
+		if err != nil {
+			return packageURL{}, fmt.Errorf("package URL %q has an invalid version: %w", s, err)
+		}
+		p.Version = v
+		rest = rest[:i]
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(segments) < 2 || segments[0] == "" {
+		return packageURL{}, fmt.Errorf("package URL %q has no type or name", s)
+	}
+	for i, seg := range segments {
+		unescaped, err := url.PathUnescape(seg)
+		if err != nil {
+			return packageURL{}, fmt.Errorf("package URL %q has an invalid segment: %w", s, err)
+		}
+		segments[i] = unescaped
+	}
+	p.Type = strings.ToLower(segments[0])
+	p.Name = segments[len(segments)-1]
+	p.Namespace = strings.Join(segments[1:len(segments)-1], "/")
+	return p, nil
+}
+
+// qualifier returns the value of the named qualifier, or "".
+func (p packageURL) qualifier(key string) string {
+	if p.Qualifiers == nil {
+		return ""
+	}
+	return p.Qualifiers.Get(key)
+}
+
+// rpmEcosystems maps the namespace of rpm package URLs to the OSV ecosystem of the
+// distribution's advisories.
+var rpmEcosystems = map[string]string{
+	"redhat":     "Red Hat",
+	"rhel":       "Red Hat",
+	"rocky":      "Rocky Linux",
+	"rockylinux": "Rocky Linux",
+	"alma":       "AlmaLinux",
+	"almalinux":  "AlmaLinux",
+	"suse":       "SUSE",
+	"opensuse":   "openSUSE",
+	"mageia":     "Mageia",
+}
+
+// osvPackages returns the OSV ecosystem and the candidate package names that advisories for p
+// may use. It returns an empty ecosystem for package types OSV has no ecosystem for.
+func osvPackages(p packageURL) (string, []string) {
+	switch p.Type {
+	case "npm":
+		if p.Namespace != "" {
+			return "npm", []string{p.Namespace + "/" + p.Name}
+		}
+		return "npm", []string{p.Name}
+	case "pypi":
+		return "PyPI", []string{normalizePyPIName(p.Name)}
+	case "golang":
+		if p.Namespace != "" {
+			return "Go", []string{p.Namespace + "/" + p.Name}
+		}
+		return "Go", []string{p.Name}
+	case "maven":
+		return "Maven", []string{p.Namespace + ":" + p.Name}
+	case "cargo":
+		return "crates.io", []string{p.Name}
+	case "gem":
+		return "RubyGems", []string{p.Name}
+	case "nuget":
+		return "NuGet", []string{p.Name}
+	case "composer":
+		return "Packagist", []string{p.Namespace + "/" + p.Name}
+	case "hex":
+		return "Hex", []string{p.Name}
+	case "pub":
+		return "Pub", []string{p.Name}
+	case "deb":
+		eco := "Debian"
+		if strings.EqualFold(p.Namespace, "ubuntu") {
+			eco = "Ubuntu"
+		}
+		// Debian advisories are filed against source packages.
+		return eco, withSourcePackage(p.Name, p.qualifier("upstream"))
+	case "apk":
+		if strings.EqualFold(p.Namespace, "wolfi") {
+			return "Wolfi", []string{p.Name}
+		}
+		return "Alpine", withSourcePackage(p.Name, p.qualifier("upstream"))
+	case "rpm":
+		return rpmEcosystems[strings.ToLower(p.Namespace)], []string{p.Name}
+	default:
+		return "", nil
+	}
+}
+
+// withSourcePackage returns name followed by the source package named by an upstream
+// qualifier ("name" or "name@version"), if it differs.
+func withSourcePackage(name, upstream string) []string {
+	src, _, _ := strings.Cut(upstream, "@")
+	if src == "" || src == name {
+		return []string{name}
+	}
+	return []string{name, src}
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+// normalizePyPIName normalizes a Python package name as described by PEP 503.
+func normalizePyPIName(name string) string {
+	return pypiSeparators.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+// purlVersion returns the version of p in the form OSV advisories use, adding the epoch from
+// the epoch qualifier of rpm package URLs.
+func purlVersion(p packageURL) string {
+	if p.Type == "rpm" {
+		if epoch := p.qualifier("epoch"); epoch != "" && epoch != "0" {
+			return epoch + ":" + p.Version
+		}
+	}
+	return p.Version
+}
+
+// distroRelease returns the release from a distro qualifier such as "rhel-9.4" or "debian-12",
+// or "" if there is none.
+func distroRelease(p packageURL) string {
+	distro := p.qualifier("distro")
+	i := strings.LastIndexByte(distro, '-')
+	if i < 0 {
+		return ""
+	}
+	return strings.TrimPrefix(distro[i+1:], "v")
+}
@@ -0,0 +1,50 @@
+// Package vulnscan provides the backends that produce vulnerability findings for the OS image
+// digests deployed across devices.
+package vulnscan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/config"
+	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// Scanner returns the vulnerability findings for a set of image digests.
+type Scanner interface {
+	// GetVulnerabilitiesForDigests returns a map from image digest to findings. A digest the
+	// scanner knows nothing about (no SBOM) maps to a nil slice; a digest whose SBOM has no
+	// known vulnerabilities maps to an empty slice.
+	GetVulnerabilitiesForDigests(ctx context.Context, digests []string) (map[string][]trustifyv2.Finding, error)
+}
+
+// SBOMStore provides the SBOM documents stored by the image builder.
+type SBOMStore interface {
+	GetImageSBOMs(ctx context.Context, imageDigests []string) (map[string][]byte, error)
+}
+
+// NewScanner returns the scanner selected by cfg. It returns nil, nil when the selected backend
+// is not configured, in which case vulnerability syncing is skipped.
+func NewScanner(ctx context.Context, cfg *config.VulnerabilityConfig, sbomStore SBOMStore, log logrus.FieldLogger) (Scanner, error) {
+	switch cfg.ScannerType() {
+	case config.VulnerabilityScannerTrustify:
+		if cfg.Trustify == nil {
+			log.Warn("Vulnerability syncing is enabled but Trustify config is missing; vulnerability-sync executor will be skipped")
+			return nil, nil
+		}
+		client, err := trustifyv2.NewVulnerabilityClient(ctx, cfg.Trustify)
+		if err != nil || client == nil {
+			return nil, err
+		}
+		return client, nil
+	case config.VulnerabilityScannerOSV:
+		if cfg.OSV == nil || cfg.OSV.DatabaseDir == "" {
+			log.Warn("Vulnerability syncing is enabled with the osv scanner but osv.databaseDir is not set; vulnerability-sync executor will be skipped")
+			return nil, nil
+		}
+		return NewOSVScanner(cfg.OSV.DatabaseDir, sbomStore, log), nil
+	default:
+		return nil, fmt.Errorf("unsupported vulnerability scanner %q", cfg.Scanner)
+	}
+}
@@ -0,0 +1,201 @@
+package vulnscan
+
+import (
+	"strconv"
+	"strings"
+)
+
+// semverEcosystems are the OSV ecosystems whose versions follow semantic versioning, including
+// pre-release ordering.
+var semverEcosystems = map[string]bool{
+	"npm":       true,
+	"Go":        true,
+	"crates.io": true,
+	"Hex":       true,
+	"Pub":       true,
+	"NuGet":     true,
+	"Packagist": true,
+}
+
+// compareVersions compares two versions of a package in the given OSV ecosystem and returns a
+// negative number, zero or a positive number when a is older than, equal to or newer than b.
+func compareVersions(ecosystem, a, b string) int {
+	if semverEcosystems[ecosystem] {
+		return compareSemver(a, b)
+	}
+	return compareDistroVersions(a, b)
+}
+
+// compareDistroVersions compares versions of the [epoch:]version[-release] form used by rpm,
+// dpkg and apk, and is a reasonable ordering for the remaining ecosystems. Epochs are compared
+// only if both versions carry one, since package URLs often omit the epoch that advisories
+// include.
+func compareDistroVersions(a, b string) int {
+	epochA, restA, hasA := splitEpoch(a)
+	epochB, restB, hasB := splitEpoch(b)
+	if hasA && hasB && epochA != epochB {
+		if epochA < epochB {
+			return -1
+		}
+		return 1
+	}
+	return compareSegments(restA, restB)
+}
+
+// splitEpoch splits a leading "<digits>:" epoch from v.
+func splitEpoch(v string) (int, string, bool) {
+	before, after, found := strings.Cut(v, ":")
+	if !found {
+		return 0, v, false
+	}
+	epoch, err := strconv.Atoi(before)
+	if err != nil {
+		return 0, v, false
+	}
+	return epoch, after, true
+}
+
+// compareSegments implements the rpmvercmp algorithm: versions are split into alternating
+// numeric and alphabetic segments which are compared in turn, numeric segments being newer
+// than alphabetic ones, and a tilde sorting before anything, including the end of the version.
+func compareSegments(a, b string) int {
+	for {
+		a = strings.TrimLeftFunc(a, isSeparator)
+		b = strings.TrimLeftFunc(b, isSeparator)
+
+		tildeA, tildeB := strings.HasPrefix(a, "~"), strings.HasPrefix(b, "~")
+		if tildeA || tildeB {
+			if !tildeB {
+				return -1
+			}
+			if !tildeA {
+				return 1
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		if a == "" || b == "" {
+			switch {
+			case a == b:
+				return 0
+			case a == "":
+				return -1
+			default:
+				return 1
+			}
+		}
+
+		numeric := isDigit(rune(a[0]))
+		segA, restA := leadingSegment(a, numeric)
+		segB, restB := leadingSegment(b, numeric)
+		if segB == "" {
+			// Different segment types: the numeric one is newer.
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+		var c int
+		if numeric {
+			c = compareNumeric(segA, segB)
+		} else {
+			c = strings.Compare(segA, segB)
+		}
+		if c != 0 {
+			return c
+		}
+		a, b = restA, restB
+	}
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isAlpha(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isSeparator(r rune) bool {
+	return !isDigit(r) && !isAlpha(r) && r != '~'
+}
+
+// leadingSegment splits the leading run of digits (numeric) or letters from s.
+func leadingSegment(s string, numeric bool) (string, string) {
+	i := 0
+	for i < len(s) && ((numeric && isDigit(rune(s[i]))) || (!numeric && isAlpha(rune(s[i])))) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// compareNumeric compares two strings of digits by value without overflowing.
+func compareNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// compareSemver compares semantic versions. A leading "v" and build metadata are ignored, and a
+// pre-release sorts before the corresponding release.
+func compareSemver(a, b string) int {
+	coreA, preA := splitSemver(a)
+	coreB, preB := splitSemver(b)
+	if c := compareSegments(coreA, coreB); c != 0 {
+		return c
+	}
+	switch {
+	case preA == preB:
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	}
+	idsA, idsB := strings.Split(preA, "."), strings.Split(preB, ".")
+	for i := 0; i < len(idsA) && i < len(idsB); i++ {
+		if c := comparePrereleaseIdentifiers(idsA[i], idsB[i]); c != 0 {
+			return c
+		}
+	}
+	return len(idsA) - len(idsB)
+}
+
+func splitSemver(v string) (string, string) {
+	v = strings.TrimPrefix(v, "v")
+	v, _, _ = strings.Cut(v, "+")
+	core, pre, _ := strings.Cut(v, "-")
+	return core, pre
+}
+
+func comparePrereleaseIdentifiers(a, b string) int {
+	numA, numB := isNumeric(a), isNumeric(b)
+	switch {
+	case numA && numB:
+		return compareNumeric(a, b)
+	case numA:
+		return -1
+	case numB:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !isDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package vulnscan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		name      string
+		ecosystem string
+		a, b      string
+		want      int
+	}{
+		{name: "rpm release", ecosystem: "Red Hat", a: "3.0.7-27.el9", b: "3.0.7-28.el9_4", want: -1},
+		{name: "rpm numeric segments compare by value", ecosystem: "Red Hat", a: "1.10.0-1", b: "1.9.0-1", want: 1},
+		{name: "rpm epoch wins when both carry one", ecosystem: "Red Hat", a: "1:1.0-1", b: "0:2.0-1", want: 1},
+		{name: "rpm epoch is ignored when only one carries one", ecosystem: "Red Hat", a: "3.0.7-27.el9", b: "1:3.0.7-28.el9", want: -1},
+		{name: "debian tilde sorts before release", ecosystem: "Debian", a: "3.0.11-1~deb12u2", b: "3.0.11-1", want: -1},
+		{name: "alpine revision", ecosystem: "Alpine", a: "3.1.4-r5", b: "3.1.4-r6", want: -1},
+		{name: "equal versions", ecosystem: "Debian", a: "2.36-9+deb12u4", b: "2.36-9+deb12u4", want: 0},
+		{name: "semver pre-release sorts before release", ecosystem: "npm", a: "1.0.0-rc.1", b: "1.0.0", want: -1},
+		{name: "semver numeric pre-release identifiers", ecosystem: "npm", a: "1.0.0-beta.11", b: "1.0.0-beta.2", want: 1},
+		{name: "go leading v is ignored", ecosystem: "Go", a: "v0.17.0", b: "0.17.0", want: 0},
+		{name: "semver build metadata is ignored", ecosystem: "crates.io", a: "1.2.3+build.5", b: "1.2.3", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareVersions(tt.ecosystem, tt.a, tt.b)
+			switch {
+			case tt.want < 0:
+				require.Negative(t, got)
+			case tt.want > 0:
+				require.Positive(t, got)
+			default:
+				require.Zero(t, got)
+			}
+		})
+	}
+}
+
+func TestRangeAffects(t *testing.T) {
+	compare := func(a, b string) int { return compareVersions("Debian", a, b) }
+	events := []osvEvent{{Introduced: "0"}, {Fixed: "1.2-3"}, {Introduced: "2.0-1"}, {LastAffected: "2.1-1"}}
+
+	require.True(t, rangeAffects(compare, events, "1.0-1"))
+	require.False(t, rangeAffects(compare, events, "1.2-3"))
+	require.False(t, rangeAffects(compare, events, "1.5-1"))
+	require.True(t, rangeAffects(compare, events, "2.1-1"))
+	require.False(t, rangeAffects(compare, events, "2.2-1"))
+}
+
+func TestCVSS3BaseScore(t *testing.T) {
+	tests := []struct {
+		vector   string
+		score    float64
+		severity string
+	}{
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", score: 9.8, severity: "Critical"},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", score: 10.0, severity: "Critical"},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N", score: 6.1, severity: "Medium"},
+		{vector: "CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N", score: 5.5, severity: "Medium"},
+		{vector: "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:H/I:N/A:N", score: 5.9, severity: "Medium"},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", score: 0, severity: "None"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.vector, func(t *testing.T) {
+			score, err := cvss3BaseScore(tt.vector)
+			require.NoError(t, err)
+			require.InDelta(t, tt.score, score, 0.001)
+			require.Equal(t, tt.severity, severityForScore(score))
+		})
+	}
+
+	_, err := cvss3BaseScore("CVSS:3.1/AV:N/AC:L")
+	require.Error(t, err)
+	_, err = cvss3BaseScore("AV:N/AC:L/Au:N/C:P/I:P/A:P")
+	require.Error(t, err)
+}
@@ -82,6 +82,7 @@ var eventReasons = map[domain.EventReason]struct{}{
 	domain.EventReasonFleetRolloutDeviceSelected:  {},
 	domain.EventReasonFleetRolloutBatchDispatched: {},
 	domain.EventReasonDeviceConflictResolved:      {},
+	domain.EventReasonDeviceRollbackCleared:       {},
 	domain.EventReasonDeviceDecommissioned:        {},
 	domain.EventReasonApplicationLifecycleChanged: {},
 }
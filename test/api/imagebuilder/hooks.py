@@ -18,7 +18,9 @@ register_path_kinds({
 # ---------------------------------------------------------------------------
 _FIXTURES = {}
 
-_VALID_EXPORT_FORMATS = ("vmdk", "qcow2", "iso", "qcow2-disk-container")
+_VALID_EXPORT_FORMATS = (
+    "vmdk", "qcow2", "iso", "qcow2-disk-container", "raw", "ami", "vhd", "anaconda-iso",
+)
 
 
 def _create_resource(url, headers, body, name):
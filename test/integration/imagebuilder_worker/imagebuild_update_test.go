@@ -138,6 +138,7 @@ var _ = Describe("ImageBuild Update Integration Tests", func() {
 			repositoryStore,
 			catalogStore,
 			nil, // kvStore
+			nil, // sbomStore
 			nil, // serviceHandler
 			imageBuilderService,
 			mockQueueProducer,
@@ -137,6 +137,7 @@ var _ = Describe("Requeue Integration Tests", func() {
 			repositoryStore,
 			catalogStore,
 			nil, // kvStore
+			nil, // sbomStore
 			nil, // serviceHandler
 			imageBuilderService,
 			mockQueueProducer,
@@ -121,6 +121,7 @@ var _ = Describe("Timeout Check Integration Tests", func() {
 			repositoryStore,
 			catalogStore,
 			kvStoreInst,
+			nil, // sbomStore
 			nil, // serviceHandler
 			imageBuilderService,
 			nil, // queueProducer
@@ -0,0 +1,127 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	bulkoperationstore "github.com/flightctl/flightctl/internal/store/bulkoperation"
+	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/flightctl/flightctl/test/util/testdb"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("BulkOperationStore", func() {
+	var (
+		log               *logrus.Logger
+		ctx               context.Context
+		orgId             uuid.UUID
+		opStore           bulkoperationstore.Store
+		organizationStore organizationstore.Store
+		cfg               *config.Config
+		db                *gorm.DB
+		dbName            string
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		var err error
+		cfg, dbName, db, err = testdb.CreateTestDB(ctx, log, "", store.InitDB)
+		Expect(err).NotTo(HaveOccurred())
+		opStore = bulkoperationstore.NewBulkOperationStore(db, log.WithField("pkg", "bulkoperation-store"))
+		organizationStore = organizationstore.NewOrganizationStore(db)
+
+		orgId = uuid.New()
+		err = testutil.CreateTestOrganization(ctx, organizationStore, orgId)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(testdb.DeleteTestDB(ctx, log, cfg, db, dbName)).To(Succeed())
+	})
+
+	createOperation := func() *domain.BulkOperation {
+		op, err := opStore.Create(ctx, orgId, &domain.BulkOperation{
+			ApiVersion: domain.BulkOperationAPIVersion,
+			Kind:       domain.BulkOperationKind,
+			Metadata:   domain.ObjectMeta{Name: lo.ToPtr("bulk-op")},
+			Spec: domain.BulkOperationSpec{
+				LabelSelector: lo.ToPtr("site=factory-1"),
+				Operation:     domain.BulkOperationTypeSetOwner,
+				Owner:         lo.ToPtr("Fleet/new"),
+			},
+			Status: &domain.BulkOperationStatus{Phase: domain.BulkOperationPhasePending},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return op
+	}
+
+	It("UpdateStatus persists the progress and continue token of a running operation", func() {
+		op := createOperation()
+		startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		continueToken := store.BuildContinueString([]string{"mydevice-2"}, 3)
+		op.Status = &domain.BulkOperationStatus{
+			Phase:            domain.BulkOperationPhaseRunning,
+			StartTime:        &startTime,
+			MatchedDevices:   5,
+			SucceededDevices: 1,
+			FailedDevices:    1,
+			Failures:         &[]domain.BulkOperationDeviceFailure{{DeviceName: "mydevice-2", Message: "conflict"}},
+			Continue:         continueToken,
+		}
+		_, err := opStore.UpdateStatus(ctx, orgId, op)
+		Expect(err).ToNot(HaveOccurred())
+
+		got, err := opStore.Get(ctx, orgId, "bulk-op")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.Status.Phase).To(Equal(domain.BulkOperationPhaseRunning))
+		Expect(got.Status.StartTime.Equal(startTime)).To(BeTrue())
+		Expect(got.Status.MatchedDevices).To(Equal(int64(5)))
+		Expect(got.Status.SucceededDevices).To(Equal(int64(1)))
+		Expect(got.Status.FailedDevices).To(Equal(int64(1)))
+		Expect(*got.Status.Failures).To(Equal([]domain.BulkOperationDeviceFailure{{DeviceName: "mydevice-2", Message: "conflict"}}))
+		Expect(got.Status.Continue).To(Equal(continueToken))
+		Expect(got.Spec.Owner).To(Equal(lo.ToPtr("Fleet/new")))
+	})
+
+	It("UpdateStatus clears the continue token once the last page is processed", func() {
+		op := createOperation()
+		op.Status = &domain.BulkOperationStatus{
+			Phase:            domain.BulkOperationPhaseRunning,
+			SucceededDevices: 1,
+			Continue:         lo.ToPtr("token"),
+		}
+		op, err := opStore.UpdateStatus(ctx, orgId, op)
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Status.SucceededDevices = 2
+		op.Status.Continue = nil
+		_, err = opStore.UpdateStatus(ctx, orgId, op)
+		Expect(err).ToNot(HaveOccurred())
+
+		got, err := opStore.Get(ctx, orgId, "bulk-op")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.Status.SucceededDevices).To(Equal(int64(2)))
+		Expect(got.Status.Continue).To(BeNil())
+	})
+
+	It("UpdateStatus returns not found for a deleted operation", func() {
+		op := createOperation()
+		Expect(opStore.Delete(ctx, orgId, "bulk-op", nil)).To(Succeed())
+
+		op.Status = &domain.BulkOperationStatus{Phase: domain.BulkOperationPhaseRunning, Continue: lo.ToPtr("token")}
+		_, err := opStore.UpdateStatus(ctx, orgId, op)
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+	})
+})
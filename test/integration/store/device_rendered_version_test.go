@@ -0,0 +1,141 @@
+package store_test
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/flightctl/flightctl/test/util/testdb"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func newRenderedVersion(version int64, osImage string) devicestore.DeviceRenderedVersion {
+	return devicestore.DeviceRenderedVersion{
+		Version:         version,
+		TemplateVersion: "tv",
+		Rendered: devicestore.DeviceRendered{
+			Config:       "[]",
+			Applications: "[]",
+			OsImage:      osImage,
+		},
+	}
+}
+
+var _ = Describe("DeviceStore rendered versions", func() {
+	var (
+		log               *logrus.Logger
+		ctx               context.Context
+		orgId             uuid.UUID
+		devStore          devicestore.Store
+		organizationStore organizationstore.Store
+		cfg               *config.Config
+		db                *gorm.DB
+		dbName            string
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		var err error
+		cfg, dbName, db, err = testdb.CreateTestDB(ctx, log, "", store.InitDB)
+		Expect(err).NotTo(HaveOccurred())
+		devStore = devicestore.NewDeviceStore(db, log.WithField("pkg", "device-store"))
+		organizationStore = organizationstore.NewOrganizationStore(db)
+
+		orgId = uuid.New()
+		err = testutil.CreateTestOrganization(ctx, organizationStore, orgId)
+		Expect(err).ToNot(HaveOccurred())
+
+		testutil.CreateTestDevices(ctx, 2, devStore, orgId, nil, false)
+	})
+
+	AfterEach(func() {
+		Expect(testdb.DeleteTestDB(ctx, log, cfg, db, dbName)).To(Succeed())
+	})
+
+	It("GetRenderedVersion returns the retained spec", func() {
+		version := newRenderedVersion(3, "quay.io/os:v3")
+		version.RollbackOf = lo.ToPtr(int64(1))
+		Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-1", version)).To(Succeed())
+
+		got, err := devStore.GetRenderedVersion(ctx, orgId, "mydevice-1", 3)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.RenderedVersion).To(Equal("3"))
+		Expect(lo.FromPtr(got.TemplateVersion)).To(Equal("tv"))
+		Expect(lo.FromPtr(got.RollbackOf)).To(Equal("1"))
+		Expect(got.Spec).ToNot(BeNil())
+		Expect(got.Spec.Os.Image).To(Equal("quay.io/os:v3"))
+
+		_, err = devStore.GetRenderedVersion(ctx, orgId, "mydevice-2", 3)
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+	})
+
+	It("AppendRenderedVersion keeps the first row of a version rendered twice", func() {
+		Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-1", newRenderedVersion(1, "quay.io/os:first"))).To(Succeed())
+		Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-1", newRenderedVersion(1, "quay.io/os:second"))).To(Succeed())
+
+		got, err := devStore.GetRenderedVersion(ctx, orgId, "mydevice-1", 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.Spec.Os.Image).To(Equal("quay.io/os:first"))
+	})
+
+	It("GetPrecedingRenderedVersion returns the newest retained version older than the given one", func() {
+		for _, v := range []int64{1, 2, 5, 9} {
+			Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-1", newRenderedVersion(v, "quay.io/os:v"))).To(Succeed())
+		}
+		Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-2", newRenderedVersion(8, "quay.io/os:other"))).To(Succeed())
+
+		got, err := devStore.GetPrecedingRenderedVersion(ctx, orgId, "mydevice-1", 9)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.RenderedVersion).To(Equal("5"))
+		Expect(got.Spec).ToNot(BeNil())
+
+		got, err = devStore.GetPrecedingRenderedVersion(ctx, orgId, "mydevice-1", 5)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.RenderedVersion).To(Equal("2"))
+
+		got, err = devStore.GetPrecedingRenderedVersion(ctx, orgId, "mydevice-1", 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.RenderedVersion).To(Equal("9"))
+
+		_, err = devStore.GetPrecedingRenderedVersion(ctx, orgId, "mydevice-1", 1)
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+
+		_, err = devStore.GetPrecedingRenderedVersion(ctx, uuid.New(), "mydevice-1", 9)
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+	})
+
+	It("AppendRenderedVersion trims the oldest versions of the device only", func() {
+		Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-2", newRenderedVersion(1, "quay.io/os:v"))).To(Succeed())
+		total := devicestore.MaxRenderedVersionsPerDevice + 3
+		for v := 1; v <= total; v++ {
+			Expect(devStore.AppendRenderedVersion(ctx, orgId, "mydevice-1", newRenderedVersion(int64(v), "quay.io/os:v"))).To(Succeed())
+		}
+
+		versions, err := devStore.ListRenderedVersions(ctx, orgId, "mydevice-1", 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(HaveLen(devicestore.MaxRenderedVersionsPerDevice))
+		oldest := total - devicestore.MaxRenderedVersionsPerDevice + 1
+		Expect(versions[0].RenderedVersion).To(Equal(strconv.Itoa(total)))
+		Expect(versions[len(versions)-1].RenderedVersion).To(Equal(strconv.Itoa(oldest)))
+		Expect(versions[0].Spec).To(BeNil())
+
+		_, err = devStore.GetPrecedingRenderedVersion(ctx, orgId, "mydevice-1", int64(oldest))
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+
+		versions, err = devStore.ListRenderedVersions(ctx, orgId, "mydevice-2", 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(HaveLen(1))
+	})
+})
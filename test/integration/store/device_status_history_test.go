@@ -0,0 +1,159 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/flightctl/flightctl/test/util/testdb"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func newStatusHistoryEntry(timestamp time.Time, summary domain.DeviceSummaryStatusType) domain.DeviceStatusHistoryEntry {
+	return domain.DeviceStatusHistoryEntry{
+		Timestamp:     timestamp,
+		ChangedFields: []domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldSummary},
+		Summary:       domain.DeviceSummaryStatus{Status: summary},
+	}
+}
+
+var _ = Describe("DeviceStore status history", func() {
+	var (
+		log               *logrus.Logger
+		ctx               context.Context
+		orgId             uuid.UUID
+		devStore          devicestore.Store
+		organizationStore organizationstore.Store
+		cfg               *config.Config
+		db                *gorm.DB
+		dbName            string
+		start             time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		var err error
+		cfg, dbName, db, err = testdb.CreateTestDB(ctx, log, "", store.InitDB)
+		Expect(err).NotTo(HaveOccurred())
+		devStore = devicestore.NewDeviceStore(db, log.WithField("pkg", "device-store"))
+		organizationStore = organizationstore.NewOrganizationStore(db)
+
+		orgId = uuid.New()
+		err = testutil.CreateTestOrganization(ctx, organizationStore, orgId)
+		Expect(err).ToNot(HaveOccurred())
+
+		testutil.CreateTestDevices(ctx, 2, devStore, orgId, nil, false)
+		start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	AfterEach(func() {
+		Expect(testdb.DeleteTestDB(ctx, log, cfg, db, dbName)).To(Succeed())
+	})
+
+	It("AppendStatusHistory records transitions that ListStatusHistory returns newest first", func() {
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start, domain.DeviceSummaryStatusOnline))).To(Succeed())
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start.Add(time.Hour), domain.DeviceSummaryStatusDegraded))).To(Succeed())
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-2", newStatusHistoryEntry(start, domain.DeviceSummaryStatusError))).To(Succeed())
+
+		entries, err := devStore.ListStatusHistory(ctx, orgId, "mydevice-1", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Timestamp.Equal(start.Add(time.Hour))).To(BeTrue())
+		Expect(entries[0].Summary.Status).To(Equal(domain.DeviceSummaryStatusDegraded))
+		Expect(entries[0].ChangedFields).To(Equal([]domain.DeviceStatusHistoryField{domain.DeviceStatusHistoryFieldSummary}))
+		Expect(entries[1].Summary.Status).To(Equal(domain.DeviceSummaryStatusOnline))
+
+		entries, err = devStore.ListStatusHistory(ctx, uuid.New(), "mydevice-1", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("ListStatusHistory filters by time range and limits the result", func() {
+		for i := range 5 {
+			Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start.Add(time.Duration(i)*time.Hour), domain.DeviceSummaryStatusOnline))).To(Succeed())
+		}
+
+		entries, err := devStore.ListStatusHistory(ctx, orgId, "mydevice-1", lo.ToPtr(start.Add(time.Hour)), lo.ToPtr(start.Add(4*time.Hour)), 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(3))
+		Expect(entries[0].Timestamp.Equal(start.Add(3 * time.Hour))).To(BeTrue())
+		Expect(entries[2].Timestamp.Equal(start.Add(time.Hour))).To(BeTrue())
+
+		entries, err = devStore.ListStatusHistory(ctx, orgId, "mydevice-1", nil, nil, 2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Timestamp.Equal(start.Add(4 * time.Hour))).To(BeTrue())
+	})
+
+	It("AppendStatusHistory trims the oldest transitions of the device only", func() {
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-2", newStatusHistoryEntry(start, domain.DeviceSummaryStatusOnline))).To(Succeed())
+		total := devicestore.MaxStatusHistoryEntriesPerDevice + 5
+		for i := range total {
+			Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start.Add(time.Duration(i)*time.Minute), domain.DeviceSummaryStatusOnline))).To(Succeed())
+		}
+
+		entries, err := devStore.ListStatusHistory(ctx, orgId, "mydevice-1", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(devicestore.MaxStatusHistoryEntriesPerDevice))
+		Expect(entries[0].Timestamp.Equal(start.Add(time.Duration(total-1) * time.Minute))).To(BeTrue())
+		Expect(entries[len(entries)-1].Timestamp.Equal(start.Add(5 * time.Minute))).To(BeTrue())
+
+		entries, err = devStore.ListStatusHistory(ctx, orgId, "mydevice-2", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("AppendStatusHistory keeps the newest of transitions with the same timestamp", func() {
+		for range devicestore.MaxStatusHistoryEntriesPerDevice {
+			Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start, domain.DeviceSummaryStatusOnline))).To(Succeed())
+		}
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start, domain.DeviceSummaryStatusError))).To(Succeed())
+
+		entries, err := devStore.ListStatusHistory(ctx, orgId, "mydevice-1", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(devicestore.MaxStatusHistoryEntriesPerDevice))
+		Expect(entries[0].Summary.Status).To(Equal(domain.DeviceSummaryStatusError))
+	})
+
+	It("DeleteStatusHistoryOlderThan deletes old transitions of all devices", func() {
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start, domain.DeviceSummaryStatusOnline))).To(Succeed())
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start.Add(2*time.Hour), domain.DeviceSummaryStatusOnline))).To(Succeed())
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-2", newStatusHistoryEntry(start, domain.DeviceSummaryStatusOnline))).To(Succeed())
+
+		deleted, err := devStore.DeleteStatusHistoryOlderThan(ctx, start.Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(int64(2)))
+
+		entries, err := devStore.ListStatusHistory(ctx, orgId, "mydevice-1", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Timestamp.Equal(start.Add(2 * time.Hour))).To(BeTrue())
+
+		entries, err = devStore.ListStatusHistory(ctx, orgId, "mydevice-2", nil, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("deleting a device deletes its status history", func() {
+		Expect(devStore.AppendStatusHistory(ctx, orgId, "mydevice-1", newStatusHistoryEntry(start, domain.DeviceSummaryStatusOnline))).To(Succeed())
+
+		_, err := devStore.Delete(ctx, orgId, "mydevice-1", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		var count int64
+		Expect(db.Table("device_status_history").Where("org_id = ? AND device_name = ?", orgId, "mydevice-1").Count(&count).Error).To(Succeed())
+		Expect(count).To(BeZero())
+	})
+})
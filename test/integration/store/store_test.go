@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -179,6 +180,116 @@ var _ = Describe("DataStore Migration Tests", func() {
 		})
 	})
 
+	Context("Versioned schema migrations", func() {
+		// versionedTables are the tables created by the reversible versioned migrations.
+		versionedTables := []any{
+			&model.DeviceStatusHistory{},
+			&model.DeviceRenderedVersion{},
+			&model.VulnerabilityException{},
+			&model.ImageSBOM{},
+			&model.Secret{},
+			&model.BulkOperation{},
+		}
+		const lastIrreversibleVersion = 3
+
+		var (
+			freshCtx    context.Context
+			freshLog    *logrus.Logger
+			freshCfg    *config.Config
+			freshDbName string
+			freshGormDb *gorm.DB
+		)
+
+		BeforeEach(func() {
+			freshCtx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+			freshLog = flightlog.InitLogs()
+			var err error
+			freshCfg, freshDbName, freshGormDb, err = testdb.CreateEmptyTestDB(freshCtx, freshLog, "test_schema_version_", store.InitDB)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(testdb.DeleteTestDB(freshCtx, freshLog, freshCfg, freshGormDb, freshDbName)).To(Succeed())
+		})
+
+		schemaVersion := func() int64 {
+			status, err := migration.GetStatus(freshCtx, freshGormDb)
+			Expect(err).ToNot(HaveOccurred())
+			return status.CurrentVersion
+		}
+
+		expectVersionedSchema := func(present bool) {
+			migrator := freshGormDb.WithContext(freshCtx).Migrator()
+			for _, table := range versionedTables {
+				Expect(migrator.HasTable(table)).To(Equal(present), "table of %T", table)
+			}
+			Expect(migrator.HasColumn(&model.DependencyRef{}, "vault_path")).To(Equal(present))
+			Expect(migrator.HasColumn(&model.DependencyRef{}, "oci_artifact")).To(Equal(present))
+		}
+
+		It("When migrating up, down and up again, it should revert and reapply the schema changes and record the schema version", func() {
+			log := freshLog.WithField("pkg", "migration")
+			latest := migration.LatestVersion()
+
+			Expect(migration.Up(freshCtx, freshGormDb, log, latest, false)).To(Succeed())
+			Expect(schemaVersion()).To(Equal(latest))
+			expectVersionedSchema(true)
+
+			db := freshGormDb.WithContext(freshCtx)
+			orgID := uuid.New()
+			vaultPath := "secret/data/app"
+			Expect(db.Create(&model.DependencyRef{
+				OrgID: orgID, ResourceKey: "vault:repo/secret/data/app", FleetName: lo.ToPtr("fleet1"), DeviceName: lo.ToPtr(""),
+				RefType: "vault", VaultPath: &vaultPath,
+			}).Error).To(Succeed())
+			Expect(db.Create(&model.DependencyRef{
+				OrgID: orgID, ResourceKey: "git:repo/main", FleetName: lo.ToPtr("fleet1"), DeviceName: lo.ToPtr(""),
+				RefType: "git",
+			}).Error).To(Succeed())
+
+			for version := latest - 1; version >= lastIrreversibleVersion; version-- {
+				Expect(migration.Down(freshCtx, freshGormDb, log, version, false)).To(Succeed())
+				Expect(schemaVersion()).To(Equal(version))
+			}
+			expectVersionedSchema(false)
+
+			var refTypes []string
+			Expect(db.Model(&model.DependencyRef{}).Where("org_id = ?", orgID).Pluck("ref_type", &refTypes).Error).To(Succeed())
+			Expect(refTypes).To(ConsistOf("git"), "references unknown to the previous release must be removed")
+
+			Expect(migration.Up(freshCtx, freshGormDb, log, latest, true)).To(MatchError(migration.ErrDryRunComplete))
+			Expect(schemaVersion()).To(Equal(int64(lastIrreversibleVersion)))
+			expectVersionedSchema(false)
+
+			Expect(migration.Up(freshCtx, freshGormDb, log, latest, false)).To(Succeed())
+			Expect(schemaVersion()).To(Equal(latest))
+			expectVersionedSchema(true)
+		})
+
+		It("When a migration to revert is irreversible, it should fail without changing the database", func() {
+			log := freshLog.WithField("pkg", "migration")
+			latest := migration.LatestVersion()
+			Expect(migration.Up(freshCtx, freshGormDb, log, latest, false)).To(Succeed())
+
+			err := migration.Down(freshCtx, freshGormDb, log, lastIrreversibleVersion-1, false)
+			Expect(err).To(MatchError(migration.ErrIrreversibleMigration))
+			Expect(schemaVersion()).To(Equal(latest))
+			expectVersionedSchema(true)
+		})
+
+		It("When the database was migrated by a newer release, it should refuse to use it", func() {
+			log := freshLog.WithField("pkg", "migration")
+			Expect(migration.Up(freshCtx, freshGormDb, log, migration.LatestVersion(), false)).To(Succeed())
+			Expect(freshGormDb.WithContext(freshCtx).Create(&model.SchemaVersion{
+				Version:     migration.LatestVersion() + 1,
+				Description: "from a newer release",
+			}).Error).To(Succeed())
+
+			Expect(migration.CheckSchemaVersion(freshCtx, freshGormDb)).To(MatchError(migration.ErrUnknownSchemaVersion))
+			Expect(migration.Run(freshCtx, freshGormDb, log, false)).To(MatchError(migration.ErrUnknownSchemaVersion))
+		})
+	})
+
 	Context("Auth provider URL normalization", func() {
 		It("When upgrading, it should strip trailing slashes from stored OIDC and OAuth2 URL fields", func() {
 			freshCtx := testutil.StartSpecTracerForGinkgo(suiteCtx)
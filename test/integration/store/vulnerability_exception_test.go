@@ -0,0 +1,348 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	"github.com/flightctl/flightctl/internal/store/model"
+	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	vulnerabilityexceptionstore "github.com/flightctl/flightctl/internal/store/vulnerabilityexception"
+	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/flightctl/flightctl/test/util/testdb"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func newVulnerabilityException(name, cveID string, state domain.VulnerabilityExceptionState, scope domain.VulnerabilityExceptionScope, labels map[string]string) *domain.VulnerabilityException {
+	return &domain.VulnerabilityException{
+		ApiVersion: model.VulnerabilityExceptionAPIVersion(),
+		Kind:       domain.VulnerabilityExceptionKind,
+		Metadata: domain.ObjectMeta{
+			Name:   lo.ToPtr(name),
+			Labels: &labels,
+		},
+		Spec: domain.VulnerabilityExceptionSpec{
+			CveId:         cveID,
+			Justification: "reviewed by the security team",
+			Scope:         scope,
+			State:         state,
+		},
+	}
+}
+
+// exclusionsFromExceptions maps digest and fleet scoped exceptions to the store filter, like the
+// vulnerability service does for exceptions that apply.
+func exclusionsFromExceptions(exceptions []domain.VulnerabilityException) *vulnerabilityfindingstore.FindingExclusions {
+	exclusions := &vulnerabilityfindingstore.FindingExclusions{
+		ByDigest: map[string][]string{},
+		ByOwner:  map[string][]string{},
+	}
+	for _, exception := range exceptions {
+		scope := exception.Spec.Scope
+		if scope.ImageDigest != nil {
+			exclusions.ByDigest[*scope.ImageDigest] = append(exclusions.ByDigest[*scope.ImageDigest], exception.Spec.CveId)
+		}
+		if scope.Fleet != nil {
+			owner := "Fleet/" + *scope.Fleet
+			exclusions.ByOwner[owner] = append(exclusions.ByOwner[owner], exception.Spec.CveId)
+		}
+	}
+	return exclusions
+}
+
+var _ = Describe("VulnerabilityExceptionStore", func() {
+	var (
+		log                 *logrus.Logger
+		ctx                 context.Context
+		orgId               uuid.UUID
+		exceptionStore      vulnerabilityexceptionstore.Store
+		findingStore        vulnerabilityfindingstore.Store
+		deviceStore         devicestore.Store
+		organizationStore   organizationstore.Store
+		cfg                 *config.Config
+		dbName              string
+		db                  *gorm.DB
+		eventCallbackCalled bool
+		eventCallback       store.EventCallback
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		var err error
+		cfg, dbName, db, err = testdb.CreateTestDB(ctx, log, "", store.InitDB)
+		Expect(err).NotTo(HaveOccurred())
+		exceptionStore = vulnerabilityexceptionstore.NewVulnerabilityExceptionStore(db, log.WithField("pkg", "vulnerabilityexception-store"))
+		findingStore = vulnerabilityfindingstore.NewVulnerabilityFindingStore(db, log.WithField("pkg", "vulnerabilityfinding-store"))
+		deviceStore = devicestore.NewDeviceStore(db, log.WithField("pkg", "device-store"))
+		organizationStore = organizationstore.NewOrganizationStore(db)
+		eventCallbackCalled = false
+		eventCallback = store.EventCallback(func(context.Context, api.ResourceKind, uuid.UUID, string, interface{}, interface{}, bool, error) {
+			eventCallbackCalled = true
+		})
+
+		orgId = uuid.New()
+		err = testutil.CreateTestOrganization(ctx, organizationStore, orgId)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(testdb.DeleteTestDB(ctx, log, cfg, db, dbName)).To(Succeed())
+	})
+
+	Context("Create and Get", func() {
+		It("Create stores the exception and Get returns it", func() {
+			exception := newVulnerabilityException("digest-exception", "CVE-2024-0001", domain.VulnerabilityExceptionStateNotAffected,
+				domain.VulnerabilityExceptionScope{ImageDigest: lo.ToPtr("sha256:aaaa")}, map[string]string{"team": "os"})
+			exception.Spec.ExpiresAt = lo.ToPtr(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			created, err := exceptionStore.Create(ctx, orgId, exception, eventCallback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eventCallbackCalled).To(BeTrue())
+			Expect(created.Kind).To(Equal(domain.VulnerabilityExceptionKind))
+			Expect(created.Metadata.ResourceVersion).ToNot(BeNil())
+
+			got, err := exceptionStore.Get(ctx, orgId, "digest-exception")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(got.Spec.CveId).To(Equal("CVE-2024-0001"))
+			Expect(got.Spec.State).To(Equal(domain.VulnerabilityExceptionStateNotAffected))
+			Expect(got.Spec.Scope.ImageDigest).To(Equal(lo.ToPtr("sha256:aaaa")))
+			Expect(got.Spec.Scope.Fleet).To(BeNil())
+			Expect(got.Spec.ExpiresAt.Equal(*exception.Spec.ExpiresAt)).To(BeTrue())
+			Expect(*got.Metadata.Labels).To(Equal(map[string]string{"team": "os"}))
+		})
+
+		It("Create fails for an existing name", func() {
+			exception := newVulnerabilityException("dup", "CVE-2024-0001", domain.VulnerabilityExceptionStateRiskAccepted,
+				domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}, nil)
+			_, err := exceptionStore.Create(ctx, orgId, exception, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = exceptionStore.Create(ctx, orgId, exception, nil)
+			Expect(err).To(MatchError(flterrors.ErrDuplicateName))
+		})
+
+		It("Get returns not found for a missing exception or another organization's", func() {
+			_, err := exceptionStore.Create(ctx, orgId, newVulnerabilityException("mine", "CVE-2024-0001", domain.VulnerabilityExceptionStateRiskAccepted,
+				domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}, nil), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = exceptionStore.Get(ctx, orgId, "missing")
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+
+			otherOrg := uuid.New()
+			Expect(testutil.CreateTestOrganization(ctx, organizationStore, otherOrg)).To(Succeed())
+			_, err = exceptionStore.Get(ctx, otherOrg, "mine")
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+	})
+
+	Context("List", func() {
+		BeforeEach(func() {
+			for _, exception := range []*domain.VulnerabilityException{
+				newVulnerabilityException("digest-not-affected", "CVE-2024-0001", domain.VulnerabilityExceptionStateNotAffected,
+					domain.VulnerabilityExceptionScope{ImageDigest: lo.ToPtr("sha256:aaaa")}, map[string]string{"team": "os"}),
+				newVulnerabilityException("fleet-risk-accepted", "CVE-2024-0001", domain.VulnerabilityExceptionStateRiskAccepted,
+					domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}, map[string]string{"team": "apps"}),
+				newVulnerabilityException("digest-risk-accepted", "CVE-2024-0002", domain.VulnerabilityExceptionStateRiskAccepted,
+					domain.VulnerabilityExceptionScope{ImageDigest: lo.ToPtr("sha256:bbbb")}, map[string]string{"team": "os"}),
+			} {
+				_, err := exceptionStore.Create(ctx, orgId, exception, nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		names := func(list *domain.VulnerabilityExceptionList) []string {
+			return lo.Map(list.Items, func(e domain.VulnerabilityException, _ int) string { return *e.Metadata.Name })
+		}
+
+		It("List returns all exceptions of the organization", func() {
+			list, err := exceptionStore.List(ctx, orgId, store.ListParams{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("digest-not-affected", "fleet-risk-accepted", "digest-risk-accepted"))
+
+			otherOrg := uuid.New()
+			Expect(testutil.CreateTestOrganization(ctx, organizationStore, otherOrg)).To(Succeed())
+			list, err = exceptionStore.List(ctx, otherOrg, store.ListParams{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(BeEmpty())
+		})
+
+		It("List paginates", func() {
+			list, err := exceptionStore.List(ctx, orgId, store.ListParams{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(HaveLen(2))
+			Expect(list.Metadata.Continue).ToNot(BeNil())
+
+			cont, err := store.ParseContinueString(list.Metadata.Continue)
+			Expect(err).ToNot(HaveOccurred())
+			next, err := exceptionStore.List(ctx, orgId, store.ListParams{Limit: 2, Continue: cont})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next.Items).To(HaveLen(1))
+			Expect(append(names(list), names(next)...)).To(ConsistOf("digest-not-affected", "fleet-risk-accepted", "digest-risk-accepted"))
+		})
+
+		It("List selects by labels", func() {
+			list, err := exceptionStore.List(ctx, orgId, store.ListParams{
+				LabelSelector: selector.NewLabelSelectorFromMapOrDie(map[string]string{"team": "os"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("digest-not-affected", "digest-risk-accepted"))
+		})
+
+		It("List selects by the CVE, state and scope fields", func() {
+			list, err := exceptionStore.List(ctx, orgId, store.ListParams{
+				FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{"spec.cveId": "CVE-2024-0001"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("digest-not-affected", "fleet-risk-accepted"))
+
+			list, err = exceptionStore.List(ctx, orgId, store.ListParams{
+				FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{"spec.state": "RiskAccepted"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("fleet-risk-accepted", "digest-risk-accepted"))
+
+			list, err = exceptionStore.List(ctx, orgId, store.ListParams{
+				FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{"spec.scope.fleet": "edge"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("fleet-risk-accepted"))
+
+			list, err = exceptionStore.List(ctx, orgId, store.ListParams{
+				FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{
+					"spec.scope.imageDigest": "sha256:bbbb",
+					"spec.state":             "RiskAccepted",
+				}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(list)).To(ConsistOf("digest-risk-accepted"))
+		})
+
+		It("List fails for an unknown field", func() {
+			_, err := exceptionStore.List(ctx, orgId, store.ListParams{
+				FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{"spec.justification": "none"}),
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Delete", func() {
+		It("Delete removes the exception", func() {
+			_, err := exceptionStore.Create(ctx, orgId, newVulnerabilityException("to-delete", "CVE-2024-0001", domain.VulnerabilityExceptionStateNotAffected,
+				domain.VulnerabilityExceptionScope{ImageDigest: lo.ToPtr("sha256:aaaa")}, nil), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(exceptionStore.Delete(ctx, orgId, "to-delete", eventCallback)).To(Succeed())
+			Expect(eventCallbackCalled).To(BeTrue())
+			_, err = exceptionStore.Get(ctx, orgId, "to-delete")
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("Delete of a missing exception succeeds without an event", func() {
+			Expect(exceptionStore.Delete(ctx, orgId, "missing", eventCallback)).To(Succeed())
+			Expect(eventCallbackCalled).To(BeFalse())
+		})
+	})
+
+	Context("Excluding excepted findings", func() {
+		const (
+			digest      = "sha256:exception-d1"
+			otherDigest = "sha256:exception-d2"
+		)
+
+		BeforeEach(func() {
+			now := time.Now().UTC()
+			_, err := findingStore.UpsertFindings(ctx, []model.VulnerabilityFinding{
+				{ImageDigest: digest, CveID: "CVE-2024-0001", Status: model.VulnerabilityStatusAffected, Severity: model.VulnerabilitySeverityCritical, FirstSeenAt: now},
+				{ImageDigest: digest, CveID: "CVE-2024-0002", Status: model.VulnerabilityStatusAffected, Severity: model.VulnerabilitySeverityHigh, FirstSeenAt: now},
+				{ImageDigest: otherDigest, CveID: "CVE-2024-0001", Status: model.VulnerabilityStatusAffected, Severity: model.VulnerabilitySeverityCritical, FirstSeenAt: now},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		listExclusions := func() *vulnerabilityfindingstore.FindingExclusions {
+			list, err := exceptionStore.List(ctx, orgId, store.ListParams{})
+			Expect(err).ToNot(HaveOccurred())
+			return exclusionsFromExceptions(list.Items)
+		}
+
+		It("A digest scoped exception hides the CVE of that digest only", func() {
+			_, err := exceptionStore.Create(ctx, orgId, newVulnerabilityException("except-d1", "CVE-2024-0001", domain.VulnerabilityExceptionStateNotAffected,
+				domain.VulnerabilityExceptionScope{ImageDigest: lo.ToPtr(digest)}, nil), nil)
+			Expect(err).ToNot(HaveOccurred())
+			exclusions := listExclusions()
+
+			result, err := findingStore.GetVulnerabilities(ctx, digest, store.ListParams{Limit: 100}, exclusions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lo.Map(result.Items, func(f model.VulnerabilityFinding, _ int) string { return f.CveID })).To(ConsistOf("CVE-2024-0002"))
+
+			counts, err := findingStore.GetVulnerabilitySummary(ctx, digest, nil, exclusions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts.Total).To(Equal(int64(1)))
+			Expect(counts.Critical).To(Equal(int64(0)))
+
+			counts, err = findingStore.GetVulnerabilitySummary(ctx, otherDigest, nil, exclusions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts.Critical).To(Equal(int64(1)))
+		})
+
+		It("A fleet scoped exception hides the CVE of the fleet's devices only", func() {
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "fleet-dev", lo.ToPtr("Fleet/edge"), nil, nil)
+			setDeviceOsDigest(ctx, deviceStore, orgId, "fleet-dev", digest)
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "fleetless-dev", nil, nil, nil)
+			setDeviceOsDigest(ctx, deviceStore, orgId, "fleetless-dev", otherDigest)
+
+			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, orgId, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary.CvesBySeverity.Total).To(Equal(int64(2)))
+
+			_, err = exceptionStore.Create(ctx, orgId, newVulnerabilityException("except-edge", "CVE-2024-0001", domain.VulnerabilityExceptionStateRiskAccepted,
+				domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}, nil), nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = exceptionStore.Create(ctx, orgId, newVulnerabilityException("except-other-fleet", "CVE-2024-0002", domain.VulnerabilityExceptionStateRiskAccepted,
+				domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("other")}, nil), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			summary, err = findingStore.GetOrgVulnerabilitySummary(ctx, orgId, listExclusions())
+			Expect(err).ToNot(HaveOccurred())
+			// CVE-2024-0001 is excepted on the fleet's device but still found on the fleetless
+			// device, and CVE-2024-0002 is excepted for a fleet without devices.
+			Expect(summary.CvesBySeverity.Total).To(Equal(int64(2)))
+			Expect(summary.CvesBySeverity.Critical).To(Equal(int64(1)))
+			Expect(summary.CvesBySeverity.High).To(Equal(int64(1)))
+
+			_, err = exceptionStore.Create(ctx, orgId, newVulnerabilityException("except-edge-high", "CVE-2024-0002", domain.VulnerabilityExceptionStateRiskAccepted,
+				domain.VulnerabilityExceptionScope{Fleet: lo.ToPtr("edge")}, nil), nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			summary, err = findingStore.GetOrgVulnerabilitySummary(ctx, orgId, listExclusions())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary.CvesBySeverity.Total).To(Equal(int64(1)))
+			Expect(summary.CvesBySeverity.Critical).To(Equal(int64(1)))
+			Expect(summary.CvesBySeverity.High).To(Equal(int64(0)))
+		})
+
+		It("No exceptions hide nothing", func() {
+			exclusions := listExclusions()
+			Expect(exclusions.IsEmpty()).To(BeTrue())
+
+			result, err := findingStore.GetVulnerabilities(ctx, digest, store.ListParams{Limit: 100}, exclusions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Items).To(HaveLen(2))
+		})
+	})
+})
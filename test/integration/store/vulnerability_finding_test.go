@@ -15,11 +15,13 @@ import (
 	"github.com/flightctl/flightctl/internal/service/events"
 	vulnerabilityfindingservice "github.com/flightctl/flightctl/internal/service/vulnerabilityfinding"
 	"github.com/flightctl/flightctl/internal/store"
+	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	eventstore "github.com/flightctl/flightctl/internal/store/event"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/model"
 	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
+	vulnerabilityexceptionstore "github.com/flightctl/flightctl/internal/store/vulnerabilityexception"
 	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
 	"github.com/flightctl/flightctl/internal/tasks"
 	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
@@ -244,6 +246,25 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 	})
 
+	Context("ImageSBOMs", func() {
+		It("When no SBOM is stored for a digest it should be absent from the result", func() {
+			sboms, err := findingStore.GetImageSBOMs(ctx, []string{"sha256:missing"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sboms).To(BeEmpty())
+		})
+
+		It("When an SBOM is stored twice for a digest it should return the latest document", func() {
+			Expect(findingStore.UpsertImageSBOM(ctx, "sha256:aaaa1111", []byte(`{"bomFormat":"CycloneDX","version":1}`))).To(Succeed())
+			Expect(findingStore.UpsertImageSBOM(ctx, "sha256:aaaa1111", []byte(`{"bomFormat":"CycloneDX","version":2}`))).To(Succeed())
+			Expect(findingStore.UpsertImageSBOM(ctx, "sha256:bbbb2222", []byte(`{"bomFormat":"CycloneDX","version":1}`))).To(Succeed())
+
+			sboms, err := findingStore.GetImageSBOMs(ctx, []string{"sha256:aaaa1111", "sha256:cccc3333"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sboms).To(HaveLen(1))
+			Expect(string(sboms["sha256:aaaa1111"])).To(Equal(`{"bomFormat":"CycloneDX","version":2}`))
+		})
+	})
+
 	Context("ComputeAllCVEActions", func() {
 		It("When there are no changed findings and no device changes it should return empty actions", func() {
 			actions, err := findingStore.ComputeAllCVEActions(ctx, nil, time.Now().Add(-time.Hour))
@@ -766,7 +787,9 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			newDeviceStore := devicestore.NewDeviceStore(db, log.WithField("pkg", "device-store"))
 			fleetStore := fleetstore.NewFleetStore(db, log.WithField("pkg", "fleet-store"))
 			newFindingStore := vulnerabilityfindingstore.NewVulnerabilityFindingStore(db, log.WithField("pkg", "vulnerabilityfinding-store"))
-			apiHandler := vulnerabilityfindingservice.NewServiceHandler(newFindingStore, newDeviceStore, fleetStore, eventsSvc, true, log)
+			newExceptionStore := vulnerabilityexceptionstore.NewVulnerabilityExceptionStore(db, log.WithField("pkg", "vulnerabilityexception-store"))
+			newCatalogStore := catalogstore.NewCatalogStore(db, log.WithField("pkg", "catalog-store"))
+			apiHandler := vulnerabilityfindingservice.NewServiceHandler(newFindingStore, newExceptionStore, newDeviceStore, fleetStore, newCatalogStore, eventsSvc, true, log)
 			err := apiHandler.SyncDeviceCVELifecycleEvents(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -814,7 +837,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortBySeverity},
 				SortOrder:   &sortOrder,
 				Limit:       100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Items).To(HaveLen(2))
 			digests := []string{result.Items[0].ImageDigest, result.Items[1].ImageDigest}
@@ -827,7 +850,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortBySeverity},
 				SortOrder:   &sortOrder,
 				Limit:       1,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result1.Items).To(HaveLen(1))
 			Expect(result1.Continue).ToNot(BeNil())
@@ -840,7 +863,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortOrder:   &sortOrder,
 				Limit:       1,
 				Continue:    cont,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result2.Items).To(HaveLen(1))
 			Expect(result1.Items[0].CveID).ToNot(Equal(result2.Items[0].CveID))
@@ -852,7 +875,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortByCvssScore},
 				SortOrder:   &sortOrder,
 				Limit:       100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Items).To(HaveLen(2))
 			Expect(*result.Items[0].CvssScore).To(BeNumerically(">", *result.Items[1].CvssScore))
@@ -864,7 +887,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortBySeverity},
 				SortOrder:   &sortOrder,
 				Limit:       100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Items).To(BeEmpty())
 		})
@@ -886,7 +909,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When computing summary it should count distinct CVEs by severity", func() {
-			counts, err := findingStore.GetVulnerabilitySummary(ctx, digest, nil)
+			counts, err := findingStore.GetVulnerabilitySummary(ctx, digest, nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(counts.Total).To(Equal(int64(4)))
 			Expect(counts.Critical).To(Equal(int64(1)))
@@ -896,7 +919,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When the digest has no findings it should return all-zero counts", func() {
-			counts, err := findingStore.GetVulnerabilitySummary(ctx, "sha256:none", nil)
+			counts, err := findingStore.GetVulnerabilitySummary(ctx, "sha256:none", nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(counts.Total).To(Equal(int64(0)))
 		})
@@ -1042,7 +1065,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			Expect(digestsOrgA).To(HaveLen(1))
 			Expect(digestsOrgA[0].ImageDigest).To(Equal(digestA))
 
-			resultA, err := findingStore.GetVulnerabilityGroups(ctx, digestsOrgA, listParams)
+			resultA, err := findingStore.GetVulnerabilityGroups(ctx, digestsOrgA, listParams, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultA.Groups).To(HaveLen(1))
 			Expect(resultA.Groups[0].CveID).To(Equal("CVE-2024-TENANT-A"))
@@ -1055,7 +1078,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			Expect(digestsOrgB).To(HaveLen(1))
 			Expect(digestsOrgB[0].ImageDigest).To(Equal(digestB))
 
-			resultB, err := findingStore.GetVulnerabilityGroups(ctx, digestsOrgB, listParams)
+			resultB, err := findingStore.GetVulnerabilityGroups(ctx, digestsOrgB, listParams, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultB.Groups[0].CveID).To(Equal("CVE-2024-TENANT-B"))
 		})
@@ -1143,7 +1166,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortDesc
 			result, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortBySeverity}, SortOrder: &sortOrder, Limit: 100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			// 3 distinct CVEs across the two fleet digests; CVE-0099 (unrelated) excluded
 			Expect(result.Groups).To(HaveLen(3))
@@ -1156,7 +1179,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortDesc
 			result, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortBySeverity}, SortOrder: &sortOrder, Limit: 100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			// CVE-2024-0001 has findings for both digest1 and digest2
@@ -1186,7 +1209,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortDesc
 			result, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortBySeverity}, SortOrder: &sortOrder, Limit: 100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			// CVE-2024-0002 (High) — only digest1
@@ -1206,7 +1229,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortDesc
 			result, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortByCvssScore}, SortOrder: &sortOrder, Limit: 100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Groups).To(HaveLen(3))
 			// CVE-2024-0001 has maxCvssScore 9.8 > CVE-2024-0002 (7.5) > CVE-2024-0003 (3.1)
@@ -1219,7 +1242,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortDesc
 			result1, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortBySeverity}, SortOrder: &sortOrder, Limit: 2,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result1.Groups).To(HaveLen(2))
 			Expect(result1.Groups[0].CveID).To(Equal("CVE-2024-0001"))
@@ -1230,7 +1253,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 			result2, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortBySeverity}, SortOrder: &sortOrder, Limit: 2, Continue: cont,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result2.Groups).To(HaveLen(1))
 			Expect(result2.Groups[0].CveID).To(Equal("CVE-2024-0003"))
@@ -1240,7 +1263,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortDesc
 			result1, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortByCveId}, SortOrder: &sortOrder, Limit: 2,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result1.Groups).To(HaveLen(2))
 			// DESC order: CVE-2024-0003 > CVE-2024-0002 > CVE-2024-0001
@@ -1252,7 +1275,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 			result2, err := findingStore.GetVulnerabilityGroups(ctx, digests, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortByCveId}, SortOrder: &sortOrder, Limit: 2, Continue: cont,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result2.Groups).To(HaveLen(1))
 			Expect(result2.Groups[0].CveID).To(Equal("CVE-2024-0001"))
@@ -1262,7 +1285,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			sortOrder := store.SortAsc
 			result, err := findingStore.GetVulnerabilityGroups(ctx, []vulnerabilityfindingstore.DeviceImageDigest{}, store.ListParams{
 				SortColumns: []store.SortColumn{store.SortBySeverity}, SortOrder: &sortOrder, Limit: 100,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Groups).To(BeEmpty())
 		})
@@ -1292,7 +1315,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When computing fleet summary it should count distinct CVEs by worst severity", func() {
-			counts, err := findingStore.GetFleetVulnerabilitySummary(ctx, digests, nil)
+			counts, err := findingStore.GetFleetVulnerabilitySummary(ctx, digests, nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 			// 3 distinct CVEs across the two fleet digests
 			Expect(counts.Total).To(Equal(int64(3)))
@@ -1303,7 +1326,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When no digests are provided it should return all-zero counts", func() {
-			counts, err := findingStore.GetFleetVulnerabilitySummary(ctx, []vulnerabilityfindingstore.DeviceImageDigest{}, nil)
+			counts, err := findingStore.GetFleetVulnerabilitySummary(ctx, []vulnerabilityfindingstore.DeviceImageDigest{}, nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(counts.Total).To(Equal(int64(0)))
 		})
@@ -1331,7 +1354,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			})
 			Expect(upsertErr).ToNot(HaveOccurred())
 
-			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, orgId)
+			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, orgId, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Total should be 2 CVEs (CVE-2024-SAME counted once + CVE-2024-UNIQUE)
@@ -1357,7 +1380,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			})
 			Expect(upsertErr).ToNot(HaveOccurred())
 
-			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, orgId)
+			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, orgId, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Only the affected CVE should be counted
@@ -1370,7 +1393,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			emptyOrg := uuid.New()
 			Expect(testutil.CreateTestOrganization(ctx, organizationStore, emptyOrg)).To(Succeed())
 
-			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, emptyOrg)
+			summary, err := findingStore.GetOrgVulnerabilitySummary(ctx, emptyOrg, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(summary.CvesBySeverity.Total).To(Equal(int64(0)))
@@ -1425,14 +1448,14 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When no devices have the CVE it should return empty results", func() {
-			result, err := findingStore.GetVulnerabilityImpactPage(ctx, orgId, "CVE-NONEXISTENT", store.ListParams{Limit: 10})
+			result, err := findingStore.GetVulnerabilityImpactPage(ctx, orgId, "CVE-NONEXISTENT", store.ListParams{Limit: 10}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.TotalFleets).To(Equal(int64(0)))
 			Expect(result.Rows).To(BeEmpty())
 		})
 
 		It("When devices have the CVE it should return aggregated fleet data", func() {
-			result, err := findingStore.GetVulnerabilityImpactPage(ctx, orgId, cveID, store.ListParams{Limit: 10})
+			result, err := findingStore.GetVulnerabilityImpactPage(ctx, orgId, cveID, store.ListParams{Limit: 10}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			// 3 distinct owners: fleet1, fleet2, and NULL (fleetless)
 			Expect(result.TotalFleets).To(Equal(int64(3)))
@@ -1446,7 +1469,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortByDeviceAffected},
 				SortOrder:   &sortOrder,
 				Limit:       10,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Rows).To(HaveLen(3))
 			// fleet1 has 2 devices, others have 1 each
@@ -1460,7 +1483,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortByOwner},
 				SortOrder:   &sortOrder,
 				Limit:       10,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Rows).To(HaveLen(3))
 			// Fleets sorted alphabetically by owner, NULL (fleetless) last
@@ -1475,7 +1498,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortByOwner},
 				SortOrder:   &sortOrder,
 				Limit:       2,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result1.Rows).To(HaveLen(2))
 			// DESC order: fleet-beta > fleet-alpha (NULL still last)
@@ -1491,7 +1514,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortOrder:   &sortOrder,
 				Limit:       2,
 				Continue:    cont,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result2.Rows).To(HaveLen(1))
 			Expect(result2.Rows[0].Owner).To(BeNil())
@@ -1504,7 +1527,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortColumns: []store.SortColumn{store.SortByDeviceAffected},
 				SortOrder:   &sortOrder,
 				Limit:       2,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result1.TotalFleets).To(Equal(int64(3)))
 			Expect(result1.Rows).To(HaveLen(2))
@@ -1518,7 +1541,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 				SortOrder:   &sortOrder,
 				Limit:       2,
 				Continue:    cont,
-			})
+			}, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result2.TotalFleets).To(Equal(int64(3)))
 			Expect(result2.Rows).To(HaveLen(1))
@@ -1564,14 +1587,14 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When owners are provided it should return per-digest details for those owners", func() {
-			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, []string{fleetOwner}, false)
+			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, []string{fleetOwner}, false, nil)
 			Expect(err).ToNot(HaveOccurred())
 			// fleet has 2 distinct digests
 			Expect(details).To(HaveLen(2))
 		})
 
 		It("When includeNull is true it should include fleetless devices", func() {
-			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, []string{fleetOwner}, true)
+			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, []string{fleetOwner}, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 			// fleet has 2 digests + fleetless has 1 digest = 3 rows
 			Expect(details).To(HaveLen(3))
@@ -1590,20 +1613,20 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 		})
 
 		It("When includeNull is true with no owners it should return only fleetless details", func() {
-			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, nil, true)
+			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, nil, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(details).To(HaveLen(1))
 			Expect(details[0].Owner).To(BeNil())
 		})
 
 		It("When no owners and includeNull is false it should return nil", func() {
-			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, nil, false)
+			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, nil, false, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(details).To(BeNil())
 		})
 
 		It("When fetching details it should aggregate image refs per digest", func() {
-			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, []string{fleetOwner}, false)
+			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, cveID, []string{fleetOwner}, false, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Find detail for digest1 which has 2 devices with different images
@@ -1638,7 +1661,7 @@ var _ = Describe("VulnerabilityFinding Store Integration Tests", func() {
 			})
 			Expect(upsertErr).ToNot(HaveOccurred())
 
-			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, "CVE-2024-EMPTY-IMAGE", []string{emptyImageFleet}, false)
+			details, err := findingStore.GetImpactDigestDetails(ctx, orgId, "CVE-2024-EMPTY-IMAGE", []string{emptyImageFleet}, false, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(details).To(HaveLen(1))
 
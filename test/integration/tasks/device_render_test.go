@@ -211,7 +211,7 @@ var _ = Describe("DeviceRender", func() {
 					Reason:         api.EventReasonResourceUpdated,
 					InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 				}
-				logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, mockK8s, kvStoreInst, nil, orgId, event)
+				logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, mockK8s, kvStoreInst, nil, orgId, event)
 				err = logic.RenderDevice(ctx)
 
 				// Should succeed - safe paths pass validation
@@ -246,7 +246,7 @@ var _ = Describe("DeviceRender", func() {
 					Reason:         api.EventReasonResourceUpdated,
 					InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 				}
-				logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, mockK8s, kvStoreInst, nil, orgId, event)
+				logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, mockK8s, kvStoreInst, nil, orgId, event)
 				err = logic.RenderDevice(ctx)
 
 				// Should fail - derived paths under forbidden root are rejected
@@ -293,7 +293,7 @@ var _ = Describe("DeviceRender", func() {
 					Reason:         api.EventReasonResourceUpdated,
 					InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 				}
-				logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, mockK8s, kvStoreInst, nil, orgId, event)
+				logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, mockK8s, kvStoreInst, nil, orgId, event)
 				err = logic.RenderDevice(ctx)
 
 				Expect(err).To(HaveOccurred())
@@ -625,7 +625,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -667,7 +667,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -713,7 +713,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -753,7 +753,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &failingK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &failingK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).To(HaveOccurred())
 
@@ -862,7 +862,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -908,7 +908,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -967,7 +967,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -1010,7 +1010,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("invalid catalog item reference"))
@@ -1040,7 +1040,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("cannot use catalog item of type"))
@@ -1070,7 +1070,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceUpdated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, catalogSvc, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			err = logic.RenderDevice(ctx)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("unknown version 99.0.0"))
@@ -1136,7 +1136,7 @@ var _ = Describe("DeviceRender", func() {
 			status := deviceSvc.UpdateDeviceAnnotations(ctx, orgId, testDeviceName, annotations, nil)
 			Expect(status.Code).To(Equal(int32(200)))
 
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, firstEvent)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, firstEvent)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -1168,7 +1168,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonFleetRolloutDeviceSelected,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, secondEvent)
+			logic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, secondEvent)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -1251,7 +1251,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonResourceCreated,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, firstEvent)
+			logic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, firstEvent)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -1266,7 +1266,7 @@ var _ = Describe("DeviceRender", func() {
 				Reason:         api.EventReasonFleetRolloutDeviceSelected,
 				InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: testDeviceName},
 			}
-			logic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, redundantEvent)
+			logic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, redundantEvent)
 			err = logic.RenderDevice(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -147,7 +147,7 @@ var _ = Describe("Application lifecycle overlay at render time", func() {
 		Expect(rolloutLogic.RolloutDevice(ctx)).To(Succeed())
 
 		By("rendering the device for the first time with no lifecycle override")
-		renderLogic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+		renderLogic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 		Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 
 		renderedDevice, status := deviceSvc.GetRenderedDevice(ctx, orgId, deviceName, api.GetRenderedDeviceParams{})
@@ -174,7 +174,7 @@ var _ = Describe("Application lifecycle overlay at render time", func() {
 				Name: deviceName,
 			},
 		}
-		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
+		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
 		Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 
 		renderedDevice, status = deviceSvc.GetRenderedDevice(ctx, orgId, deviceName, api.GetRenderedDeviceParams{})
@@ -192,7 +192,7 @@ var _ = Describe("Application lifecycle overlay at render time", func() {
 		_, restartStatus := deviceSvc.RestartDeviceApplication(ctx, orgId, deviceName, "app-1")
 		Expect(restartStatus.Code).To(Equal(int32(200)))
 
-		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
+		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
 		Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 
 		renderedDevice, status = deviceSvc.GetRenderedDevice(ctx, orgId, deviceName, api.GetRenderedDeviceParams{})
@@ -205,7 +205,7 @@ var _ = Describe("Application lifecycle overlay at render time", func() {
 		_, startStatus := deviceSvc.StartDeviceApplication(ctx, orgId, deviceName, "app-1")
 		Expect(startStatus.Code).To(Equal(int32(200)))
 
-		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
+		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
 		Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 
 		renderedDevice, status = deviceSvc.GetRenderedDevice(ctx, orgId, deviceName, api.GetRenderedDeviceParams{})
@@ -218,7 +218,7 @@ var _ = Describe("Application lifecycle overlay at render time", func() {
 		_, restartStatus = deviceSvc.RestartDeviceApplication(ctx, orgId, deviceName, "app-1")
 		Expect(restartStatus.Code).To(Equal(int32(200)))
 
-		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
+		renderLogic = tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, lifecycleEvent)
 		Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 
 		renderedDevice, status = deviceSvc.GetRenderedDevice(ctx, orgId, deviceName, api.GetRenderedDeviceParams{})
@@ -257,7 +257,7 @@ var _ = Describe("Application lifecycle overlay at render time", func() {
 			}
 		}
 		renderDevice := func(event api.Event) {
-			renderLogic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
+			renderLogic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, nil, orgId, event)
 			Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 		}
 		rolloutAndRender := func(name string, event api.Event) {
@@ -218,7 +218,7 @@ var _ = Describe("Enrollment VM launcher image selection", func() {
 			rolloutLogic := tasks.NewFleetRolloutsLogic(log, fleetSvc, templateVersionSvc, deviceSvc, dependencyrefSvc, orgId, event)
 			Expect(rolloutLogic.RolloutDevice(ctx)).To(Succeed())
 
-			renderLogic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, &mockK8sClient{}, kvStoreInst, cfg, orgId, event)
+			renderLogic := tasks.NewDeviceRenderLogic(log, deviceSvc, repositorySvc, nil, nil, &mockK8sClient{}, kvStoreInst, cfg, orgId, event)
 			Expect(renderLogic.RenderDevice(ctx)).To(Succeed())
 
 			renderedDevice, getStatus := deviceSvc.GetRenderedDevice(ctx, orgId, deviceName, api.GetRenderedDeviceParams{})